@@ -0,0 +1,104 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileSink", func() {
+	var (
+		logger  *lagertest.TestLogger
+		tempDir string
+		logPath string
+		sink    *audit.FileSink
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		sink = nil
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "audit")
+		Expect(err).NotTo(HaveOccurred())
+		logPath = filepath.Join(tempDir, "audit.log")
+	})
+
+	AfterEach(func() {
+		if sink != nil {
+			Expect(sink.Close()).To(Succeed())
+		}
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	readEntries := func(path string) []*models.AuditEntry {
+		data, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		entries := []*models.AuditEntry{}
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			entry := &models.AuditEntry{}
+			Expect(json.Unmarshal([]byte(line), entry)).To(Succeed())
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	It("writes entries as JSON lines", func() {
+		var err error
+		sink, err = audit.NewFileSink(logPath, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		first := &models.AuditEntry{Timestamp: 1, Route: "CancelTask", TargetGuid: "task-guid"}
+		second := &models.AuditEntry{
+			Timestamp:             2,
+			Route:                 "UpdateDesiredLRP",
+			ModificationTagBefore: &models.ModificationTag{Epoch: "epoch", Index: 1},
+			ModificationTagAfter:  &models.ModificationTag{Epoch: "epoch", Index: 2},
+		}
+		Expect(sink.Record(logger, first)).To(Succeed())
+		Expect(sink.Record(logger, second)).To(Succeed())
+
+		Expect(readEntries(logPath)).To(Equal([]*models.AuditEntry{first, second}))
+	})
+
+	It("appends to an existing file", func() {
+		Expect(ioutil.WriteFile(logPath, []byte(`{"timestamp":1,"route":"DesireTask","identity":""}`+"\n"), 0600)).To(Succeed())
+
+		var err error
+		sink, err = audit.NewFileSink(logPath, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sink.Record(logger, &models.AuditEntry{Timestamp: 2, Route: "CancelTask"})).To(Succeed())
+
+		Expect(readEntries(logPath)).To(HaveLen(2))
+	})
+
+	It("rotates the file once it reaches the maximum size", func() {
+		var err error
+		sink, err = audit.NewFileSink(logPath, 100, 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		for i := 1; i <= 4; i++ {
+			Expect(sink.Record(logger, &models.AuditEntry{Timestamp: int64(i), Route: "CancelTask", TargetGuid: "some-task-guid"})).To(Succeed())
+		}
+
+		Expect(readEntries(logPath)[0].Timestamp).To(BeEquivalentTo(4))
+		Expect(readEntries(logPath + ".1")[0].Timestamp).To(BeEquivalentTo(3))
+		Expect(readEntries(logPath + ".2")[0].Timestamp).To(BeEquivalentTo(2))
+		Expect(logPath + ".3").NotTo(BeAnExistingFile())
+	})
+
+	It("fails when the file cannot be opened", func() {
+		_, err := audit.NewFileSink(filepath.Join(tempDir, "missing", "audit.log"), 0, 0)
+		Expect(err).To(HaveOccurred())
+	})
+})
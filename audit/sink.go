@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . Sink
+
+// Sink stores the audit entries of mutating API calls.
+type Sink interface {
+	Record(logger lager.Logger, entry *models.AuditEntry) error
+}
+
+type multiSink []Sink
+
+// NewMultiSink records every entry in all of the sinks.
+func NewMultiSink(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+func (sinks multiSink) Record(logger lager.Logger, entry *models.AuditEntry) error {
+	var firstErr error
+	for _, sink := range sinks {
+		err := sink.Record(logger, entry)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+type dbSink struct {
+	db db.AuditDB
+}
+
+// NewDBSink stores entries in the audit_entries table, where they can be
+// queried through the AuditEntries route.
+func NewDBSink(auditDB db.AuditDB) Sink {
+	return &dbSink{db: auditDB}
+}
+
+func (s *dbSink) Record(logger lager.Logger, entry *models.AuditEntry) error {
+	return s.db.InsertAuditEntry(context.Background(), logger, entry)
+}
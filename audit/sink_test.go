@@ -0,0 +1,62 @@
+package audit_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/audit/auditfakes"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sinks", func() {
+	var (
+		logger *lagertest.TestLogger
+		entry  *models.AuditEntry
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		entry = &models.AuditEntry{Timestamp: 1, Route: "DeleteTask", TargetGuid: "task-guid"}
+	})
+
+	Describe("MultiSink", func() {
+		var first, second *auditfakes.FakeSink
+
+		BeforeEach(func() {
+			first = new(auditfakes.FakeSink)
+			second = new(auditfakes.FakeSink)
+		})
+
+		It("records the entry in every sink", func() {
+			Expect(audit.NewMultiSink(first, second).Record(logger, entry)).To(Succeed())
+
+			Expect(first.RecordCallCount()).To(Equal(1))
+			_, recorded := first.RecordArgsForCall(0)
+			Expect(recorded).To(Equal(entry))
+			Expect(second.RecordCallCount()).To(Equal(1))
+		})
+
+		It("returns the first error after recording in the remaining sinks", func() {
+			first.RecordReturns(errors.New("boom"))
+
+			Expect(audit.NewMultiSink(first, second).Record(logger, entry)).To(MatchError("boom"))
+			Expect(second.RecordCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("DBSink", func() {
+		It("inserts the entry", func() {
+			fakeAuditDB := new(dbfakes.FakeAuditDB)
+			Expect(audit.NewDBSink(fakeAuditDB).Record(logger, entry)).To(Succeed())
+
+			Expect(fakeAuditDB.InsertAuditEntryCallCount()).To(Equal(1))
+			_, _, inserted := fakeAuditDB.InsertAuditEntryArgsForCall(0)
+			Expect(inserted).To(Equal(entry))
+		})
+	})
+})
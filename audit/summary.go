@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// SummarizeRequest renders only the given fields of the request as JSON.
+// Fields are dotted paths of JSON field names, e.g. desired_lrp.process_guid,
+// so environment variables, credentials and other request contents never
+// reach the audit log.
+func SummarizeRequest(request interface{}, fields []string) string {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return ""
+	}
+
+	var value map[string]interface{}
+	err = json.Unmarshal(data, &value)
+	if err != nil {
+		return ""
+	}
+
+	summary := map[string]interface{}{}
+	for _, field := range fields {
+		copyField(summary, value, strings.Split(field, "."))
+	}
+
+	data, err = json.Marshal(summary)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func copyField(summary, value map[string]interface{}, path []string) {
+	field, ok := value[path[0]]
+	if !ok {
+		return
+	}
+
+	if len(path) == 1 {
+		summary[path[0]] = field
+		return
+	}
+
+	nestedValue, ok := field.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	nestedSummary, ok := summary[path[0]].(map[string]interface{})
+	if !ok {
+		nestedSummary = map[string]interface{}{}
+	}
+	copyField(nestedSummary, nestedValue, path[1:])
+	if len(nestedSummary) > 0 {
+		summary[path[0]] = nestedSummary
+	}
+}
@@ -0,0 +1,36 @@
+package audit_test
+
+import (
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SummarizeRequest", func() {
+	It("renders the fields as JSON", func() {
+		summary := audit.SummarizeRequest(&models.TaskGuidRequest{TaskGuid: "task-guid"}, []string{"task_guid"})
+		Expect(summary).To(MatchJSON(`{"task_guid":"task-guid"}`))
+	})
+
+	It("renders nested fields and leaves out everything else", func() {
+		desiredLRP := model_helpers.NewValidDesiredLRP("process-guid")
+		desiredLRP.ImageUsername = "user"
+		desiredLRP.ImagePassword = "hunter2"
+		desiredLRP.EnvironmentVariables = []*models.EnvironmentVariable{{Name: "VCAP_SERVICES", Value: "credentials"}}
+
+		summary := audit.SummarizeRequest(&models.DesireLRPRequest{DesiredLrp: desiredLRP}, []string{
+			"desired_lrp.process_guid",
+			"desired_lrp.domain",
+			"desired_lrp.instances",
+		})
+		Expect(summary).To(MatchJSON(`{"desired_lrp":{"process_guid":"process-guid","domain":"some-domain","instances":1}}`))
+	})
+
+	It("skips fields the request does not have", func() {
+		summary := audit.SummarizeRequest(&models.TaskGuidRequest{TaskGuid: "task-guid"}, []string{"task_guid.nested", "missing"})
+		Expect(summary).To(MatchJSON(`{}`))
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"code.cloudfoundry.org/bbs/events"
@@ -24,11 +25,18 @@ import (
 
 const (
 	ContentTypeHeader    = "Content-Type"
+	AcceptHeader         = "Accept"
 	XCfRouterErrorHeader = "X-Cf-Routererror"
+	RetryAfterHeader     = "Retry-After"
 	ProtoContentType     = "application/x-protobuf"
+	JSONContentType      = "application/json"
 	KeepContainer        = true
 	DeleteContainer      = false
 	DefaultRetryCount    = 3
+
+	// MaxRetryAfter caps how long the client waits before retrying a rate
+	// limited request.
+	MaxRetryAfter = 10 * time.Second
 )
 
 //go:generate counterfeiter -o fake_bbs/fake_internal_client.go . InternalClient
@@ -55,10 +63,56 @@ type InternalClient interface {
 	EvacuateCrashedActualLRP(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) (bool, error)
 	RemoveEvacuatingActualLRP(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error
 
+	// Explains the state of the ActualLRP instances at the given index, and what convergence will do with them
+	ExplainActualLRP(logger lager.Logger, processGuid string, index int32) (*models.ActualLRPExplanation, error)
+
 	StartTask(logger lager.Logger, taskGuid string, cellID string) (bool, error)
 	FailTask(logger lager.Logger, taskGuid, failureReason string) error
 	RejectTask(logger lager.Logger, taskGuid, failureReason string) error
 	CompleteTask(logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) error
+
+	// Switches the active encryption key and re-encrypts all records in the background
+	RotateEncryptionKey(logger lager.Logger, keyLabel string) error
+	// Returns the progress of the current or most recent re-encryption
+	EncryptionStatus(logger lager.Logger) (*models.EncryptionStatus, error)
+	// Returns, per table, the number of rows still encrypted with the given key
+	EncryptionKeyUsage(logger lager.Logger, keyLabel string) (map[string]int32, error)
+
+	// Returns the audit log entries matching the request, most recent first
+	AuditEntries(logger lager.Logger, request *models.AuditEntriesRequest) ([]*models.AuditEntry, error)
+
+	// Re-reads the BBS configuration file and applies the fields that can change at runtime
+	ReloadConfig(logger lager.Logger) (*models.ConfigReloadReport, error)
+
+	// Reports what the next convergence run would do, listing up to sampleSize keys or guids per step
+	ConvergencePlan(logger lager.Logger, sampleSize int32) (*models.ConvergencePlan, error)
+
+	// Reports the state of the convergence safety valves
+	ConvergenceSafetyValve(logger lager.Logger) (*models.ConvergenceSafetyValveStatus, error)
+
+	// Makes the next convergence pass accept the cell set, even when a safety valve trips
+	OverrideConvergenceSafetyValve(logger lager.Logger) (*models.ConvergenceSafetyValveStatus, error)
+
+	// Reports whether the BBS is in maintenance mode
+	MaintenanceMode(logger lager.Logger) (*models.MaintenanceMode, error)
+
+	// Turns maintenance mode on or off. The reason is required when turning it on
+	SetMaintenanceMode(logger lager.Logger, enabled bool, reason string) (*models.MaintenanceMode, error)
+
+	// Lists the registered cells with their workload and reserved resources, and totals per zone
+	CellSummaries(logger lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error)
+
+	// Lists the cordoned cells, including those that are not registered
+	CellCordons(logger lager.Logger) ([]*models.CellCordon, error)
+
+	// Marks the cell unschedulable
+	CordonCell(logger lager.Logger, cellID, reason string) (*models.CellCordon, error)
+
+	// Cordons the cell and has convergence move its LRP instances to other cells
+	DrainCell(logger lager.Logger, cellID, reason string) (*models.CellCordon, error)
+
+	// Makes the cell schedulable again, stopping any drain of it
+	UncordonCell(logger lager.Logger, cellID string) error
 }
 
 /*
@@ -170,6 +224,10 @@ type ExternalDesiredLRPClient interface {
 
 	// Removes the DesiredLRP matching the given process guid
 	RemoveDesiredLRP(logger lager.Logger, processGuid string) error
+
+	// Returns how many voluntary disruptions the disruption budget of the
+	// DesiredLRP matching the given process guid allows
+	DisruptionBudgetStatus(logger lager.Logger, processGuid string) (*models.DisruptionBudgetStatus, error)
 }
 
 /*
@@ -394,6 +452,20 @@ func (c *client) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, proces
 	return response.ActualLrpGroup, response.Error.ToError()
 }
 
+func (c *client) ExplainActualLRP(logger lager.Logger, processGuid string, index int32) (*models.ActualLRPExplanation, error) {
+	request := models.ExplainActualLRPRequest{
+		ProcessGuid: processGuid,
+		Index:       index,
+	}
+	response := models.ExplainActualLRPResponse{}
+	err := c.doRequest(logger, ExplainActualLRPRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Explanation, response.Error.ToError()
+}
+
 func (c *client) ClaimActualLRP(logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
 	request := models.ClaimActualLRPRequest{
 		ProcessGuid:          key.ProcessGuid,
@@ -597,6 +669,19 @@ func (c *client) RemoveDesiredLRP(logger lager.Logger, processGuid string) error
 	return c.doDesiredLRPLifecycleRequest(logger, RemoveDesiredLRPRoute_r0, &request)
 }
 
+func (c *client) DisruptionBudgetStatus(logger lager.Logger, processGuid string) (*models.DisruptionBudgetStatus, error) {
+	request := models.DisruptionBudgetStatusRequest{
+		ProcessGuid: processGuid,
+	}
+	response := models.DisruptionBudgetStatusResponse{}
+	err := c.doRequest(logger, DisruptionBudgetStatusRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Status, response.Error.ToError()
+}
+
 func (c *client) Tasks(logger lager.Logger) ([]*models.Task, error) {
 	request := models.TasksRequest{}
 	response := models.TasksResponse{}
@@ -801,6 +886,165 @@ func (c *client) Cells(logger lager.Logger) ([]*models.CellPresence, error) {
 	return response.Cells, response.Error.ToError()
 }
 
+func (c *client) RotateEncryptionKey(logger lager.Logger, keyLabel string) error {
+	request := models.RotateEncryptionKeyRequest{
+		KeyLabel: keyLabel,
+	}
+	response := models.RotateEncryptionKeyResponse{}
+	err := c.doRequest(logger, RotateEncryptionKeyRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
+func (c *client) EncryptionStatus(logger lager.Logger) (*models.EncryptionStatus, error) {
+	response := models.EncryptionStatusResponse{}
+	err := c.doRequest(logger, EncryptionStatusRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, response.Error.ToError()
+}
+
+func (c *client) EncryptionKeyUsage(logger lager.Logger, keyLabel string) (map[string]int32, error) {
+	request := models.EncryptionKeyUsageRequest{
+		KeyLabel: keyLabel,
+	}
+	response := models.EncryptionKeyUsageResponse{}
+	err := c.doRequest(logger, EncryptionKeyUsageRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.RowsByTable, response.Error.ToError()
+}
+
+func (c *client) AuditEntries(logger lager.Logger, request *models.AuditEntriesRequest) ([]*models.AuditEntry, error) {
+	response := models.AuditEntriesResponse{}
+	err := c.doRequest(logger, AuditEntriesRoute_r0, nil, nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Entries, response.Error.ToError()
+}
+
+func (c *client) ReloadConfig(logger lager.Logger) (*models.ConfigReloadReport, error) {
+	response := models.ReloadConfigResponse{}
+	err := c.doRequest(logger, ReloadConfigRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Report, response.Error.ToError()
+}
+
+func (c *client) ConvergencePlan(logger lager.Logger, sampleSize int32) (*models.ConvergencePlan, error) {
+	request := models.ConvergencePlanRequest{
+		SampleSize: sampleSize,
+	}
+	response := models.ConvergencePlanResponse{}
+	err := c.doRequest(logger, ConvergencePlanRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Plan, response.Error.ToError()
+}
+
+func (c *client) ConvergenceSafetyValve(logger lager.Logger) (*models.ConvergenceSafetyValveStatus, error) {
+	response := models.ConvergenceSafetyValveResponse{}
+	err := c.doRequest(logger, ConvergenceSafetyValveRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, response.Error.ToError()
+}
+
+func (c *client) OverrideConvergenceSafetyValve(logger lager.Logger) (*models.ConvergenceSafetyValveStatus, error) {
+	response := models.ConvergenceSafetyValveResponse{}
+	err := c.doRequest(logger, OverrideConvergenceSafetyValveRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, response.Error.ToError()
+}
+
+func (c *client) MaintenanceMode(logger lager.Logger) (*models.MaintenanceMode, error) {
+	response := models.MaintenanceModeResponse{}
+	err := c.doRequest(logger, MaintenanceModeRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.MaintenanceMode, response.Error.ToError()
+}
+
+func (c *client) SetMaintenanceMode(logger lager.Logger, enabled bool, reason string) (*models.MaintenanceMode, error) {
+	request := models.SetMaintenanceModeRequest{
+		Enabled: enabled,
+		Reason:  reason,
+	}
+	response := models.MaintenanceModeResponse{}
+	err := c.doRequest(logger, SetMaintenanceModeRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.MaintenanceMode, response.Error.ToError()
+}
+
+func (c *client) CellSummaries(logger lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error) {
+	response := models.CellSummariesResponse{}
+	err := c.doRequest(logger, CellSummariesRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Cells, response.Zones, response.Error.ToError()
+}
+
+func (c *client) CellCordons(logger lager.Logger) ([]*models.CellCordon, error) {
+	response := models.CellCordonsResponse{}
+	err := c.doRequest(logger, CellCordonsRoute_r0, nil, nil, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Cordons, response.Error.ToError()
+}
+
+func (c *client) CordonCell(logger lager.Logger, cellID, reason string) (*models.CellCordon, error) {
+	request := models.CordonCellRequest{
+		CellId: cellID,
+		Reason: reason,
+	}
+	response := models.CellCordonResponse{}
+	err := c.doRequest(logger, CordonCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Cordon, response.Error.ToError()
+}
+
+func (c *client) DrainCell(logger lager.Logger, cellID, reason string) (*models.CellCordon, error) {
+	request := models.DrainCellRequest{
+		CellId: cellID,
+		Reason: reason,
+	}
+	response := models.CellCordonResponse{}
+	err := c.doRequest(logger, DrainCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Cordon, response.Error.ToError()
+}
+
+func (c *client) UncordonCell(logger lager.Logger, cellID string) error {
+	request := models.UncordonCellRequest{
+		CellId: cellID,
+	}
+	response := models.CellCordonResponse{}
+	err := c.doRequest(logger, UncordonCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return response.Error.ToError()
+}
+
 func (c *client) createRequest(requestName string, params rata.Params, queryParams url.Values, message proto.Message) (*http.Request, error) {
 	var messageBody []byte
 	var err error
@@ -851,7 +1095,13 @@ func (c *client) doRequest(logger lager.Logger, requestName string, params rata.
 		err = c.do(request, responseBody)
 		finish := time.Now().UnixNano()
 
-		if err != nil {
+		if rateLimited, ok := err.(*tooManyRequestsError); ok {
+			logger.Info("rate-limited", lager.Data{"attempt": attempts + 1, "retry_after": rateLimited.retryAfter.String()})
+			err = models.ErrTooManyRequests
+			if attempts+1 < c.requestRetryCount {
+				time.Sleep(rateLimited.retryAfter)
+			}
+		} else if err != nil {
 			logger.Error("failed-doing-request", err)
 			if netErr, ok := err.(net.Error); ok {
 				if netErr.Timeout() {
@@ -886,6 +1136,10 @@ func (c *client) do(request *http.Request, responseObject proto.Message) error {
 		return models.NewError(models.Error_RouterError, routerError[0])
 	}
 
+	if response.StatusCode == http.StatusTooManyRequests {
+		return &tooManyRequestsError{retryAfter: parseRetryAfter(response.Header.Get(RetryAfterHeader))}
+	}
+
 	if parsedContentType == ProtoContentType {
 		return handleProtoResponse(response, responseObject)
 	} else {
@@ -893,6 +1147,30 @@ func (c *client) do(request *http.Request, responseObject proto.Message) error {
 	}
 }
 
+// tooManyRequestsError is returned by do when the BBS rate limits the request.
+type tooManyRequestsError struct {
+	retryAfter time.Duration
+}
+
+func (e *tooManyRequestsError) Error() string {
+	return fmt.Sprintf("too many requests, retry after %s", e.retryAfter)
+}
+
+// parseRetryAfter reads the number of seconds in a Retry-After header,
+// defaulting to one second and capped at MaxRetryAfter.
+func parseRetryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds < 1 {
+		return time.Second
+	}
+
+	retryAfter := time.Duration(seconds) * time.Second
+	if retryAfter > MaxRetryAfter {
+		return MaxRetryAfter
+	}
+	return retryAfter
+}
+
 func handleProtoResponse(response *http.Response, responseObject proto.Message) error {
 	if responseObject == nil {
 		return models.NewError(models.Error_InvalidRequest, "responseObject cannot be nil")
@@ -206,6 +206,52 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Context("when the server rate limits the request", func() {
+		var rateLimited http.HandlerFunc
+
+		BeforeEach(func() {
+			rateLimited = ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/actual_lrp_groups/list"),
+				ghttp.RespondWithProto(http.StatusTooManyRequests, &models.ActualLRPGroupsResponse{
+					Error: models.ErrTooManyRequests,
+				}, http.Header{"Retry-After": []string{"1"}}),
+			)
+		})
+
+		Context("when the client has retries left", func() {
+			BeforeEach(func() {
+				cfg.Retries = 2
+				bbsServer.AppendHandlers(
+					rateLimited,
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/v1/actual_lrp_groups/list"),
+						ghttp.RespondWithProto(200, &models.ActualLRPGroupsResponse{}),
+					),
+				)
+			})
+
+			It("waits for the retry hint and retries the request", func() {
+				start := time.Now()
+				_, err := client.ActualLRPGroups(logger, models.ActualLRPFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+				Expect(bbsServer.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when the client has no retries left", func() {
+			BeforeEach(func() {
+				bbsServer.AppendHandlers(rateLimited)
+			})
+
+			It("returns a too many requests error", func() {
+				_, err := client.ActualLRPGroups(logger, models.ActualLRPFilter{})
+				Expect(err).To(Equal(models.ErrTooManyRequests))
+				Expect(bbsServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
 	Context("when an http URL is provided to the secure client", func() {
 		It("creating the client returns an error", func() {
 			_, err := bbs.NewClient(bbsServer.URL(), "", "", "", 1, 1)
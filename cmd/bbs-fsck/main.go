@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/bbs/cmd/bbs/config"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/fsck"
+	"code.cloudfoundry.org/lager"
+)
+
+var configFilePath = flag.String(
+	"config",
+	"",
+	"The path to the BBS JSON configuration file.",
+)
+
+var jsonOutput = flag.Bool(
+	"json",
+	false,
+	"Print the report as JSON instead of text.",
+)
+
+var printRepairs = flag.Bool(
+	"repair-plan",
+	false,
+	"Include the repair plan in the report.",
+)
+
+var applyRepairs = flag.Bool(
+	"apply-repairs",
+	false,
+	"Apply the repair plan after reporting. The BBS must not be running.",
+)
+
+func main() {
+	flag.Parse()
+
+	logger := lager.NewLogger("bbs-fsck")
+	logger.RegisterSink(lager.NewWriterSink(os.Stderr, lager.INFO))
+
+	bbsConfig, err := config.NewBBSConfig(*configFilePath)
+	if err != nil {
+		logger.Fatal("failed-to-load-config", err)
+	}
+
+	key, keys, err := bbsConfig.EncryptionConfig.Parse()
+	if err != nil {
+		logger.Fatal("cannot-setup-encryption", err)
+	}
+	keyManager, err := encryption.NewKeyManager(key, keys)
+	if err != nil {
+		logger.Fatal("cannot-setup-encryption", err)
+	}
+	cryptor := encryption.NewCryptor(keyManager, rand.Reader)
+
+	if bbsConfig.DatabaseDriver == "" || bbsConfig.DatabaseConnectionString == "" {
+		logger.Fatal("no-database-configured", errors.New("no database configured"))
+	}
+
+	sqlConn, err := helpers.Connect(
+		logger,
+		bbsConfig.DatabaseDriver,
+		bbsConfig.DatabaseConnectionString,
+		bbsConfig.SQLCACertFile,
+		bbsConfig.SQLEnableIdentityVerification,
+	)
+	if err != nil {
+		logger.Fatal("failed-to-open-sql", err)
+	}
+	defer sqlConn.Close()
+
+	err = sqlConn.Ping()
+	if err != nil {
+		logger.Fatal("sql-failed-to-connect", err)
+	}
+
+	checker := fsck.NewChecker(helpers.NewMonitoredDB(sqlConn, monitor.New()), bbsConfig.DatabaseDriver, cryptor)
+
+	ctx := context.Background()
+	report, err := checker.Check(ctx, logger)
+	if err != nil {
+		logger.Fatal("failed-to-check-database", err)
+	}
+
+	if *jsonOutput {
+		if !*printRepairs && !*applyRepairs {
+			report.Repairs = nil
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+		if err != nil {
+			logger.Fatal("failed-to-write-report", err)
+		}
+	} else {
+		report.WriteText(os.Stdout, *printRepairs || *applyRepairs)
+	}
+
+	if report.Clean() {
+		return
+	}
+
+	if !*applyRepairs {
+		os.Exit(1)
+	}
+
+	err = checker.Repair(ctx, logger, report.Repairs)
+	if err != nil {
+		logger.Fatal("failed-to-apply-repairs", err)
+	}
+	fmt.Fprintf(os.Stdout, "applied %d repairs\n", len(report.Repairs))
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/openapi"
+)
+
+var outputPath = flag.String(
+	"output",
+	"",
+	"The path to write the OpenAPI document to. Defaults to stdout.",
+)
+
+func main() {
+	flag.Parse()
+
+	document, err := openapi.Generate(bbs.Routes, bbs.RouteMessages)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate the OpenAPI document: %s\n", err)
+		os.Exit(1)
+	}
+
+	if *outputPath == "" {
+		os.Stdout.Write(document)
+		return
+	}
+
+	err = ioutil.WriteFile(*outputPath, document, 0644)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write the OpenAPI document: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -15,11 +15,16 @@ import (
 type BBSConfig struct {
 	AccessLogPath                   string                `json:"access_log_path,omitempty"`
 	AdvertiseURL                    string                `json:"advertise_url,omitempty"`
+	AuditDatabaseEnabled            bool                  `json:"audit_database_enabled,omitempty"`
+	AuditLogMaxBackups              int                   `json:"audit_log_max_backups,omitempty"`
+	AuditLogMaxSizeMB               int                   `json:"audit_log_max_size_mb,omitempty"`
+	AuditLogPath                    string                `json:"audit_log_path,omitempty"`
 	AuctioneerAddress               string                `json:"auctioneer_address,omitempty"`
 	AuctioneerCACert                string                `json:"auctioneer_ca_cert,omitempty"`
 	AuctioneerClientCert            string                `json:"auctioneer_client_cert,omitempty"`
 	AuctioneerClientKey             string                `json:"auctioneer_client_key,omitempty"`
 	AuctioneerRequireTLS            bool                  `json:"auctioneer_require_tls,omitempty"`
+	AuthorizationPolicyFile         string                `json:"authorization_policy_file,omitempty"`
 	UUID                            string                `json:"uuid,omitempty"`
 	CaFile                          string                `json:"ca_file,omitempty"`
 	CellRegistrationsLocketEnabled  bool                  `json:"cell_registrations_locket_enabled"`
@@ -35,6 +40,7 @@ type BBSConfig struct {
 	EnableConsulServiceRegistration bool                  `json:"enable_consul_service_registration"`
 	ExpireCompletedTaskDuration     durationjson.Duration `json:"expire_completed_task_duration,omitempty"`
 	ExpirePendingTaskDuration       durationjson.Duration `json:"expire_pending_task_duration,omitempty"`
+	GRPCListenAddress               string                `json:"grpc_listen_address,omitempty"`
 	HealthAddress                   string                `json:"health_address,omitempty"`
 	KeyFile                         string                `json:"key_file,omitempty"`
 	KickTaskDuration                durationjson.Duration `json:"kick_task_duration,omitempty"`
@@ -42,9 +48,12 @@ type BBSConfig struct {
 	LocksLocketEnabled              bool                  `json:"locks_locket_enabled"`
 	LockRetryInterval               durationjson.Duration `json:"lock_retry_interval,omitempty"`
 	LockTTL                         durationjson.Duration `json:"lock_ttl,omitempty"`
+	LRPConvergenceShards            int                   `json:"lrp_convergence_shards,omitempty"`
 	MaxIdleDatabaseConnections      int                   `json:"max_idle_database_connections,omitempty"`
 	MaxOpenDatabaseConnections      int                   `json:"max_open_database_connections,omitempty"`
 	MaxTaskRetries                  int                   `json:"max_task_retries,omitempty"`
+	PayloadCompression              string                `json:"payload_compression,omitempty"`
+	RateLimitPolicyFile             string                `json:"rate_limit_policy_file,omitempty"`
 	RepCACert                       string                `json:"rep_ca_cert,omitempty"`
 	RepClientCert                   string                `json:"rep_client_cert,omitempty"`
 	RepClientKey                    string                `json:"rep_client_key,omitempty"`
@@ -54,9 +63,12 @@ type BBSConfig struct {
 	RequireSSL                      bool                  `json:"require_ssl,omitempty"`
 	SQLCACertFile                   string                `json:"sql_ca_cert_file,omitempty"`
 	SQLEnableIdentityVerification   bool                  `json:"sql_enable_identity_verification,omitempty"`
+	SafetyValveConfirmPasses        int                   `json:"safety_valve_confirm_passes,omitempty"`
+	SafetyValveMissingCellPercent   int                   `json:"safety_valve_missing_cell_percent,omitempty"`
 	SessionName                     string                `json:"session_name,omitempty"`
 	SkipConsulLock                  bool                  `json:"skip_consul_lock,omitempty"`
 	TaskCallbackWorkers             int                   `json:"task_callback_workers,omitempty"`
+	TLSReloadInterval               durationjson.Duration `json:"tls_reload_interval,omitempty"`
 	UpdateWorkers                   int                   `json:"update_workers,omitempty"`
 	LoggregatorConfig               loggingclient.Config  `json:"loggregator"`
 	debugserver.DebugServerConfig
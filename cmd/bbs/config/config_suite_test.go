@@ -1,6 +1,9 @@
 package config_test
 
 import (
+	"encoding/json"
+	"io/ioutil"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -11,3 +14,39 @@ func TestConfig(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "BBS Config Suite")
 }
+
+// validConfig returns the fields of a configuration file that passes
+// Validate.
+func validConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"listen_address":                 "0.0.0.0:8889",
+		"health_address":                 "127.0.0.1:8890",
+		"advertise_url":                  "bbs.service.cf.internal",
+		"auctioneer_address":             "https://auctioneer.service.cf.internal:9016",
+		"database_driver":                "postgres",
+		"database_connection_string":     "secret",
+		"active_key_label":               "label",
+		"encryption_keys":                map[string]string{"label": "key"},
+		"cert_file":                      "/var/vcap/jobs/bbs/config/bbs.crt",
+		"key_file":                       "/var/vcap/jobs/bbs/config/bbs.key",
+		"ca_file":                        "/var/vcap/jobs/bbs/config/ca.crt",
+		"lock_ttl":                       "15s",
+		"lock_retry_interval":            "5s",
+		"converge_repeat_interval":       "30s",
+		"kick_task_duration":             "30s",
+		"expire_pending_task_duration":   "30m",
+		"expire_completed_task_duration": "2m",
+		"report_interval":                "1m",
+		"convergence_workers":            20,
+		"update_workers":                 1000,
+		"task_callback_workers":          1000,
+		"max_task_retries":               3,
+		"loggregator":                    map[string]interface{}{"loggregator_job_name": "bbs"},
+	}
+}
+
+func writeConfig(configPath string, fields map[string]interface{}) {
+	data, err := json.Marshal(fields)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(ioutil.WriteFile(configPath, data, 0600)).To(Succeed())
+}
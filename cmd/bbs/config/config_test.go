@@ -26,11 +26,16 @@ var _ = Describe("BBSConfig", func() {
 			"access_log_path": "/var/vcap/sys/log/bbs/access.log",
 			"active_key_label": "label",
 			"advertise_url": "bbs.service.cf.internal",
+			"audit_database_enabled": true,
+			"audit_log_max_backups": 3,
+			"audit_log_max_size_mb": 50,
+			"audit_log_path": "/var/vcap/sys/log/bbs/audit.log",
 			"auctioneer_address": "https://auctioneer.service.cf.internal:9016",
 			"auctioneer_ca_cert": "/var/vcap/jobs/bbs/config/auctioneer.ca",
 			"auctioneer_client_cert": "/var/vcap/jobs/bbs/config/auctioneer.crt",
 			"auctioneer_client_key": "/var/vcap/jobs/bbs/config/auctioneer.key",
 			"auctioneer_require_tls": true,
+			"authorization_policy_file": "/var/vcap/jobs/bbs/config/authorization_policy.json",
 			"uuid": "bosh-boshy-bosh-bosh",
 			"ca_file": "/var/vcap/jobs/bbs/config/ca.crt",
 			"cell_registrations_locket_enabled": true,
@@ -48,6 +53,7 @@ var _ = Describe("BBSConfig", func() {
 			"encryption_keys": {"label": "key"},
 			"expire_completed_task_duration": "2m0s",
 			"expire_pending_task_duration": "30m0s",
+			"grpc_listen_address": "0.0.0.0:8891",
 			"health_address": "127.0.0.1:8890",
 			"key_file": "/var/vcap/jobs/bbs/config/bbs.key",
 			"kick_task_duration": "30s",
@@ -74,6 +80,7 @@ var _ = Describe("BBSConfig", func() {
       },
 			"max_idle_database_connections": 50,
 			"max_open_database_connections": 200,
+			"rate_limit_policy_file": "/var/vcap/jobs/bbs/config/rate_limit_policy.json",
 			"rep_ca_cert": "/var/vcap/jobs/bbs/config/rep.ca",
 			"rep_client_cert": "/var/vcap/jobs/bbs/config/rep.crt",
 			"rep_client_key": "/var/vcap/jobs/bbs/config/rep.key",
@@ -86,8 +93,10 @@ var _ = Describe("BBSConfig", func() {
 			"sql_ca_cert_file": "/var/vcap/jobs/bbs/config/sql.ca",
 			"sql_enable_identity_verification": true,
 			"task_callback_workers": 1000,
+			"tls_reload_interval": "5m",
 			"update_workers": 1000,
-			"max_task_retries": 3
+			"max_task_retries": 3,
+			"payload_compression": "gzip"
 		}`
 	})
 
@@ -114,11 +123,16 @@ var _ = Describe("BBSConfig", func() {
 		config := config.BBSConfig{
 			AccessLogPath:                  "/var/vcap/sys/log/bbs/access.log",
 			AdvertiseURL:                   "bbs.service.cf.internal",
+			AuditDatabaseEnabled:           true,
+			AuditLogMaxBackups:             3,
+			AuditLogMaxSizeMB:              50,
+			AuditLogPath:                   "/var/vcap/sys/log/bbs/audit.log",
 			AuctioneerAddress:              "https://auctioneer.service.cf.internal:9016",
 			AuctioneerCACert:               "/var/vcap/jobs/bbs/config/auctioneer.ca",
 			AuctioneerClientCert:           "/var/vcap/jobs/bbs/config/auctioneer.crt",
 			AuctioneerClientKey:            "/var/vcap/jobs/bbs/config/auctioneer.key",
 			AuctioneerRequireTLS:           true,
+			AuthorizationPolicyFile:        "/var/vcap/jobs/bbs/config/authorization_policy.json",
 			UUID:                           "bosh-boshy-bosh-bosh",
 			CaFile:                         "/var/vcap/jobs/bbs/config/ca.crt",
 			CellRegistrationsLocketEnabled: true,
@@ -148,6 +162,7 @@ var _ = Describe("BBSConfig", func() {
 			},
 			ExpireCompletedTaskDuration: durationjson.Duration(2 * time.Minute),
 			ExpirePendingTaskDuration:   durationjson.Duration(30 * time.Minute),
+			GRPCListenAddress:           "0.0.0.0:8891",
 			HealthAddress:               "127.0.0.1:8890",
 			KeyFile:                     "/var/vcap/jobs/bbs/config/bbs.key",
 			KickTaskDuration:            durationjson.Duration(30 * time.Second),
@@ -171,6 +186,7 @@ var _ = Describe("BBSConfig", func() {
 			LockTTL:                       durationjson.Duration(locket.DefaultSessionTTL),
 			MaxIdleDatabaseConnections:    50,
 			MaxOpenDatabaseConnections:    200,
+			RateLimitPolicyFile:           "/var/vcap/jobs/bbs/config/rate_limit_policy.json",
 			RepCACert:                     "/var/vcap/jobs/bbs/config/rep.ca",
 			RepClientCert:                 "/var/vcap/jobs/bbs/config/rep.crt",
 			RepClientKey:                  "/var/vcap/jobs/bbs/config/rep.key",
@@ -182,9 +198,11 @@ var _ = Describe("BBSConfig", func() {
 			SQLEnableIdentityVerification: true,
 			SessionName:                   "bbs-session",
 			TaskCallbackWorkers:           1000,
+			TLSReloadInterval:             durationjson.Duration(5 * time.Minute),
 			UpdateWorkers:                 1000,
 			SkipConsulLock:                true,
 			MaxTaskRetries:                3,
+			PayloadCompression:            "gzip",
 		}
 
 		Expect(bbsConfig).To(test_helpers.DeepEqual(config))
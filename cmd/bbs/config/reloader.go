@@ -0,0 +1,190 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/durationjson"
+	"code.cloudfoundry.org/lager"
+)
+
+// reloadableFields are the JSON names of the fields that running components
+// can pick up without a restart.
+var reloadableFields = map[string]bool{
+	"converge_repeat_interval":          true,
+	"kick_task_duration":                true,
+	"expire_pending_task_duration":      true,
+	"expire_completed_task_duration":    true,
+	"convergence_workers":               true,
+	"update_workers":                    true,
+	"max_task_retries":                  true,
+	"safety_valve_missing_cell_percent": true,
+	"safety_valve_confirm_passes":       true,
+}
+
+// Reloader re-reads the configuration file and hands the new configuration to
+// its callbacks when only reloadable fields have changed.
+type Reloader struct {
+	configPath string
+
+	lock     sync.Mutex
+	current  BBSConfig
+	onReload []func(BBSConfig)
+}
+
+func NewReloader(configPath string, current BBSConfig) *Reloader {
+	return &Reloader{
+		configPath: configPath,
+		current:    current,
+	}
+}
+
+// OnReload registers a callback to run with the new configuration after
+// every successful reload.
+func (r *Reloader) OnReload(callback func(BBSConfig)) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.onReload = append(r.onReload, callback)
+}
+
+// Reload reads the configuration file again. When a field that cannot change
+// at runtime differs, or the new configuration does not pass Validate, nothing
+// is applied and the report lists the rejected fields. Values are only reported
+// for reloadable fields, as the others may hold secrets.
+func (r *Reloader) Reload(logger lager.Logger) (*models.ConfigReloadReport, error) {
+	logger = logger.Session("reload-config")
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	newConfig, err := NewBBSConfig(r.configPath)
+	if err != nil {
+		logger.Error("failed-to-read-config", err)
+		return nil, models.NewError(models.Error_InvalidRequest, err.Error())
+	}
+
+	oldFields := flattenFields(reflect.ValueOf(r.current), "")
+	newFields := flattenFields(reflect.ValueOf(newConfig), "")
+
+	invalidFields := map[string]string{}
+	if err := newConfig.Validate(); err != nil {
+		for _, validationErr := range err.(ValidationErrors) {
+			invalidFields[validationErr.Field] = validationErr.Message
+		}
+	}
+
+	names := make([]string, 0, len(oldFields))
+	for name := range oldFields {
+		names = append(names, name)
+	}
+	for name := range invalidFields {
+		if _, ok := oldFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	report := &models.ConfigReloadReport{}
+	problems := []string{}
+	for _, name := range names {
+		oldValue, newValue := oldFields[name], newFields[name]
+		message, invalid := invalidFields[name]
+		if reflect.DeepEqual(oldValue, newValue) && !invalid {
+			continue
+		}
+
+		change := &models.ConfigChange{Field: name}
+		if reloadableFields[name] {
+			change.OldValue = formatValue(oldValue)
+			change.NewValue = formatValue(newValue)
+		}
+
+		switch {
+		case invalid:
+			report.Rejected = append(report.Rejected, change)
+			problems = append(problems, ValidationError{Field: name, Message: message}.Error())
+		case !reloadableFields[name]:
+			report.Rejected = append(report.Rejected, change)
+			problems = append(problems, fmt.Sprintf("%s: cannot change without a restart", name))
+		default:
+			report.Applied = append(report.Applied, change)
+		}
+	}
+
+	if len(report.Rejected) > 0 {
+		report.Applied = nil
+		logger.Info("rejected", lager.Data{"problems": problems})
+		return report, models.NewError(models.Error_InvalidRequest, strings.Join(problems, "; "))
+	}
+
+	if len(report.Applied) == 0 {
+		return report, nil
+	}
+
+	r.current = newConfig
+	for _, callback := range r.onReload {
+		callback(newConfig)
+	}
+
+	applied := lager.Data{}
+	for _, change := range report.Applied {
+		applied[change.Field] = change.NewValue
+	}
+	logger.Info("reloaded", applied)
+
+	return report, nil
+}
+
+// flattenFields maps the JSON name of every field, including those of nested
+// structs, to its value. Fields of embedded structs keep their own names, as
+// they do when decoding.
+func flattenFields(value reflect.Value, prefix string) map[string]interface{} {
+	fields := map[string]interface{}{}
+	valueType := value.Type()
+
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			nestedPrefix := prefix
+			if !field.Anonymous || name != "" {
+				if name == "" {
+					name = field.Name
+				}
+				nestedPrefix = prefix + name + "."
+			}
+			for nestedName, nestedValue := range flattenFields(fieldValue, nestedPrefix) {
+				fields[nestedName] = nestedValue
+			}
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[prefix+name] = fieldValue.Interface()
+	}
+
+	return fields
+}
+
+func formatValue(value interface{}) string {
+	if duration, ok := value.(durationjson.Duration); ok {
+		return time.Duration(duration).String()
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,144 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/cmd/bbs/config"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/durationjson"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Reloader", func() {
+	var (
+		logger         *lagertest.TestLogger
+		configFilePath string
+		fields         map[string]interface{}
+		reloader       *config.Reloader
+		reloaded       []config.BBSConfig
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		configFile, err := ioutil.TempFile("", "config-file")
+		Expect(err).NotTo(HaveOccurred())
+		configFilePath = configFile.Name()
+		Expect(configFile.Close()).To(Succeed())
+
+		fields = validConfig()
+		writeConfig(configFilePath, fields)
+
+		bbsConfig, err := config.NewBBSConfig(configFilePath)
+		Expect(err).NotTo(HaveOccurred())
+
+		reloaded = nil
+		reloader = config.NewReloader(configFilePath, bbsConfig)
+		reloader.OnReload(func(newConfig config.BBSConfig) {
+			reloaded = append(reloaded, newConfig)
+		})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(configFilePath)).To(Succeed())
+	})
+
+	It("does nothing when the file has not changed", func() {
+		report, err := reloader.Reload(logger)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Applied).To(BeEmpty())
+		Expect(report.Rejected).To(BeEmpty())
+		Expect(reloaded).To(BeEmpty())
+	})
+
+	Context("when reloadable fields change", func() {
+		BeforeEach(func() {
+			fields["converge_repeat_interval"] = "10s"
+			fields["max_task_retries"] = 5
+			writeConfig(configFilePath, fields)
+		})
+
+		It("applies them and reports the changes", func() {
+			report, err := reloader.Reload(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Applied).To(ConsistOf(
+				&models.ConfigChange{Field: "converge_repeat_interval", OldValue: "30s", NewValue: "10s"},
+				&models.ConfigChange{Field: "max_task_retries", OldValue: "3", NewValue: "5"},
+			))
+			Expect(report.Rejected).To(BeEmpty())
+
+			Expect(reloaded).To(HaveLen(1))
+			Expect(reloaded[0].ConvergeRepeatInterval).To(Equal(durationjson.Duration(10 * time.Second)))
+			Expect(reloaded[0].MaxTaskRetries).To(Equal(5))
+			Expect(logger).To(gbytes.Say("reloaded"))
+		})
+
+		It("compares later reloads against the applied configuration", func() {
+			_, err := reloader.Reload(logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			report, err := reloader.Reload(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Applied).To(BeEmpty())
+		})
+	})
+
+	Context("when fields that need a restart change", func() {
+		BeforeEach(func() {
+			fields["converge_repeat_interval"] = "10s"
+			fields["listen_address"] = "0.0.0.0:9999"
+			fields["database_connection_string"] = "other-secret"
+			fields["loggregator"] = map[string]interface{}{"loggregator_job_name": "other"}
+			writeConfig(configFilePath, fields)
+		})
+
+		It("rejects the reload without applying anything or revealing values", func() {
+			report, err := reloader.Reload(logger)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*models.Error).Type).To(Equal(models.Error_InvalidRequest))
+
+			Expect(report.Applied).To(BeEmpty())
+			Expect(report.Rejected).To(ConsistOf(
+				&models.ConfigChange{Field: "database_connection_string"},
+				&models.ConfigChange{Field: "listen_address"},
+				&models.ConfigChange{Field: "loggregator.loggregator_job_name"},
+			))
+			Expect(reloaded).To(BeEmpty())
+			Expect(logger).NotTo(gbytes.Say("other-secret"))
+		})
+	})
+
+	Context("when the new configuration is invalid", func() {
+		BeforeEach(func() {
+			fields["convergence_workers"] = 0
+			writeConfig(configFilePath, fields)
+		})
+
+		It("rejects the reload", func() {
+			report, err := reloader.Reload(logger)
+			Expect(err).To(MatchError(ContainSubstring("convergence_workers: must be positive")))
+			Expect(report.Rejected).To(ConsistOf(
+				&models.ConfigChange{Field: "convergence_workers", OldValue: "20", NewValue: "0"},
+			))
+			Expect(reloaded).To(BeEmpty())
+		})
+	})
+
+	Context("when the file cannot be parsed", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(configFilePath, []byte("{"), 0600)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			_, err := reloader.Reload(logger)
+			Expect(err).To(HaveOccurred())
+			Expect(reloaded).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,195 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/durationjson"
+)
+
+// ValidationError describes a problem with the field of BBSConfig with the
+// given JSON name.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors are all the problems found by Validate.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks the configuration without connecting to any other
+// component or building encryption keys, and returns ValidationErrors listing
+// every problem it finds. It only reads the policy files from disk.
+func (c BBSConfig) Validate() error {
+	errs := ValidationErrors{}
+	add := func(field, message string) {
+		errs = append(errs, ValidationError{Field: field, Message: message})
+	}
+	required := func(field, value string) {
+		if value == "" {
+			add(field, "is required")
+		}
+	}
+
+	if err := validateAddress(c.ListenAddress); err != nil {
+		add("listen_address", err.Error())
+	}
+	if err := validateAddress(c.HealthAddress); err != nil {
+		add("health_address", err.Error())
+	}
+	if c.GRPCListenAddress != "" {
+		if err := validateAddress(c.GRPCListenAddress); err != nil {
+			add("grpc_listen_address", err.Error())
+		}
+	}
+
+	switch c.DatabaseDriver {
+	case helpers.MySQL, helpers.Postgres:
+	case "":
+		add("database_driver", "is required")
+	default:
+		add("database_driver", fmt.Sprintf("must be %q or %q", helpers.MySQL, helpers.Postgres))
+	}
+	required("database_connection_string", c.DatabaseConnectionString)
+
+	if err := c.EncryptionConfig.Validate(); err != nil {
+		add("encryption_keys", err.Error())
+	}
+
+	if err := format.Compression(c.PayloadCompression).Validate(); err != nil {
+		add("payload_compression", err.Error())
+	}
+
+	required("cert_file", c.CertFile)
+	required("key_file", c.KeyFile)
+	required("ca_file", c.CaFile)
+
+	required("auctioneer_address", c.AuctioneerAddress)
+	if (c.AuctioneerClientCert == "") != (c.AuctioneerClientKey == "") {
+		add("auctioneer_client_key", "must be set together with auctioneer_client_cert")
+	}
+	if (c.RepClientCert == "") != (c.RepClientKey == "") {
+		add("rep_client_key", "must be set together with rep_client_cert")
+	}
+
+	if c.SkipConsulLock && !c.LocksLocketEnabled {
+		add("locks_locket_enabled", "must be set when skip_consul_lock is set, as a lock is required")
+	}
+	if !c.SkipConsulLock {
+		required("advertise_url", c.AdvertiseURL)
+		positiveDuration(add, "lock_ttl", c.LockTTL)
+		positiveDuration(add, "lock_retry_interval", c.LockRetryInterval)
+	}
+	if c.LocksLocketEnabled {
+		required("uuid", c.UUID)
+	}
+	if c.LocksLocketEnabled || c.CellRegistrationsLocketEnabled {
+		required("locket_address", c.LocketAddress)
+	}
+
+	positiveInt(add, "convergence_workers", c.ConvergenceWorkers)
+	positiveInt(add, "update_workers", c.UpdateWorkers)
+	positiveInt(add, "task_callback_workers", c.TaskCallbackWorkers)
+
+	nonNegativeInt(add, "max_task_retries", c.MaxTaskRetries)
+	nonNegativeInt(add, "lrp_convergence_shards", c.LRPConvergenceShards)
+	nonNegativeInt(add, "max_open_database_connections", c.MaxOpenDatabaseConnections)
+	nonNegativeInt(add, "max_idle_database_connections", c.MaxIdleDatabaseConnections)
+	nonNegativeInt(add, "rep_client_session_cache_size", c.RepClientSessionCacheSize)
+	nonNegativeInt(add, "audit_log_max_backups", c.AuditLogMaxBackups)
+	nonNegativeInt(add, "audit_log_max_size_mb", c.AuditLogMaxSizeMB)
+	nonNegativeInt(add, "safety_valve_confirm_passes", c.SafetyValveConfirmPasses)
+	if c.SafetyValveMissingCellPercent < 0 || c.SafetyValveMissingCellPercent > 100 {
+		add("safety_valve_missing_cell_percent", "must be between 0 and 100")
+	}
+
+	positiveDuration(add, "converge_repeat_interval", c.ConvergeRepeatInterval)
+	positiveDuration(add, "kick_task_duration", c.KickTaskDuration)
+	positiveDuration(add, "expire_pending_task_duration", c.ExpirePendingTaskDuration)
+	positiveDuration(add, "expire_completed_task_duration", c.ExpireCompletedTaskDuration)
+	positiveDuration(add, "report_interval", c.ReportInterval)
+
+	nonNegativeDuration(add, "communication_timeout", c.CommunicationTimeout)
+	nonNegativeDuration(add, "desired_lrp_creation_timeout", c.DesiredLRPCreationTimeout)
+	nonNegativeDuration(add, "tls_reload_interval", c.TLSReloadInterval)
+
+	if c.AuthorizationPolicyFile != "" {
+		policy, err := middleware.LoadAuthorizationPolicy(c.AuthorizationPolicyFile)
+		if err == nil {
+			err = policy.Validate(bbs.Routes)
+		}
+		if err != nil {
+			add("authorization_policy_file", err.Error())
+		}
+	}
+
+	if c.RateLimitPolicyFile != "" {
+		policy, err := middleware.LoadRateLimitPolicy(c.RateLimitPolicyFile)
+		if err == nil {
+			err = policy.Validate(bbs.Routes)
+		}
+		if err != nil {
+			add("rate_limit_policy_file", err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateAddress(address string) error {
+	if address == "" {
+		return fmt.Errorf("is required")
+	}
+
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	_, err = net.LookupPort("tcp", port)
+	return err
+}
+
+func positiveInt(add func(string, string), field string, value int) {
+	if value <= 0 {
+		add(field, "must be positive")
+	}
+}
+
+func nonNegativeInt(add func(string, string), field string, value int) {
+	if value < 0 {
+		add(field, "must not be negative")
+	}
+}
+
+func positiveDuration(add func(string, string), field string, value durationjson.Duration) {
+	if value <= 0 {
+		add(field, "must be a positive duration")
+	}
+}
+
+func nonNegativeDuration(add func(string, string), field string, value durationjson.Duration) {
+	if value < 0 {
+		add(field, "must not be negative")
+	}
+}
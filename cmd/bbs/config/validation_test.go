@@ -0,0 +1,160 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/bbs/cmd/bbs/config"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	var (
+		tempDir string
+		fields  map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "config-validation")
+		Expect(err).NotTo(HaveOccurred())
+		fields = validConfig()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	validate := func() error {
+		configPath := filepath.Join(tempDir, "config.json")
+		writeConfig(configPath, fields)
+		bbsConfig, err := config.NewBBSConfig(configPath)
+		Expect(err).NotTo(HaveOccurred())
+		return bbsConfig.Validate()
+	}
+
+	invalidFields := func() []string {
+		err := validate()
+		Expect(err).To(BeAssignableToTypeOf(config.ValidationErrors{}))
+		names := []string{}
+		for _, validationErr := range err.(config.ValidationErrors) {
+			names = append(names, validationErr.Field)
+		}
+		return names
+	}
+
+	It("accepts a valid configuration", func() {
+		Expect(validate()).To(Succeed())
+	})
+
+	It("returns every problem at once", func() {
+		fields["listen_address"] = "no-port"
+		delete(fields, "health_address")
+		fields["grpc_listen_address"] = "no-port"
+		fields["database_driver"] = "sqlite"
+		fields["active_key_label"] = "missing"
+		fields["payload_compression"] = "zip"
+		fields["update_workers"] = 0
+		fields["max_task_retries"] = -1
+		fields["lrp_convergence_shards"] = -1
+		fields["safety_valve_missing_cell_percent"] = 101
+		fields["safety_valve_confirm_passes"] = -1
+		fields["kick_task_duration"] = "-1s"
+		fields["communication_timeout"] = "-1s"
+
+		Expect(invalidFields()).To(ConsistOf(
+			"listen_address",
+			"health_address",
+			"grpc_listen_address",
+			"database_driver",
+			"encryption_keys",
+			"payload_compression",
+			"update_workers",
+			"max_task_retries",
+			"lrp_convergence_shards",
+			"safety_valve_missing_cell_percent",
+			"safety_valve_confirm_passes",
+			"kick_task_duration",
+			"communication_timeout",
+		))
+	})
+
+	It("validates envelope keys without building them", func() {
+		fields["active_key_label"] = "envelope"
+		fields["envelope_encryption_keys"] = map[string]interface{}{
+			"envelope": map[string]interface{}{"provider": "keystore", "keystore_path": filepath.Join(tempDir, "missing")},
+		}
+		Expect(validate()).To(Succeed())
+
+		fields["envelope_encryption_keys"] = map[string]interface{}{
+			"envelope": map[string]interface{}{"provider": "vault-transit"},
+		}
+		Expect(invalidFields()).To(ConsistOf("encryption_keys"))
+	})
+
+	It("describes each problem", func() {
+		fields["convergence_workers"] = 0
+		err := validate()
+		Expect(err).To(MatchError("convergence_workers: must be positive"))
+		Expect(err.(config.ValidationErrors)[0]).To(Equal(config.ValidationError{
+			Field:   "convergence_workers",
+			Message: "must be positive",
+		}))
+	})
+
+	Describe("locks", func() {
+		It("requires a lock", func() {
+			fields["skip_consul_lock"] = true
+			Expect(invalidFields()).To(ConsistOf("locks_locket_enabled"))
+		})
+
+		It("requires an advertise URL for the consul lock", func() {
+			delete(fields, "advertise_url")
+			Expect(invalidFields()).To(ConsistOf("advertise_url"))
+		})
+
+		It("requires a UUID and locket address for the locket lock", func() {
+			fields["skip_consul_lock"] = true
+			fields["locks_locket_enabled"] = true
+			Expect(invalidFields()).To(ConsistOf("uuid", "locket_address"))
+
+			fields["uuid"] = "bbs-uuid"
+			fields["locket_address"] = "127.0.0.1:8891"
+			Expect(validate()).To(Succeed())
+		})
+
+		It("requires a locket address for locket cell registrations", func() {
+			fields["cell_registrations_locket_enabled"] = true
+			Expect(invalidFields()).To(ConsistOf("locket_address"))
+		})
+	})
+
+	Describe("TLS", func() {
+		It("requires the server certificate, key and CA", func() {
+			delete(fields, "cert_file")
+			delete(fields, "key_file")
+			delete(fields, "ca_file")
+			Expect(invalidFields()).To(ConsistOf("cert_file", "key_file", "ca_file"))
+		})
+
+		It("requires client certificates and keys in pairs", func() {
+			fields["rep_client_cert"] = "rep.crt"
+			fields["auctioneer_client_cert"] = "auctioneer.crt"
+			Expect(invalidFields()).To(ConsistOf("rep_client_key", "auctioneer_client_key"))
+		})
+	})
+
+	Describe("policy files", func() {
+		It("checks that they load and name known routes", func() {
+			authorizationPolicyPath := filepath.Join(tempDir, "authorization.json")
+			Expect(ioutil.WriteFile(authorizationPolicyPath, []byte(`{"roles": {"admin": ["NoSuchRoute"]}}`), 0600)).To(Succeed())
+			fields["authorization_policy_file"] = authorizationPolicyPath
+			fields["rate_limit_policy_file"] = filepath.Join(tempDir, "missing.json")
+
+			Expect(invalidFields()).To(ConsistOf("authorization_policy_file", "rate_limit_policy_file"))
+		})
+	})
+})
@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/guidprovider"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
@@ -106,6 +107,7 @@ var _ = Describe("Convergence API", func() {
 						1,
 						1,
 						cryptor,
+						format.NoCompression,
 						guidprovider.DefaultGuidProvider,
 						clock.NewClock(),
 						sqlRunner.DriverName(),
@@ -3,15 +3,22 @@ package main
 import (
 	"context"
 	"crypto/rand"
+	"crypto/tls"
 	"errors"
 	"flag"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/audit"
 	"code.cloudfoundry.org/bbs/cmd/bbs/config"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/converger"
@@ -22,13 +29,17 @@ import (
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/encryptor"
 	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/grpcserver"
 	"code.cloudfoundry.org/bbs/guidprovider"
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/metrics"
 	"code.cloudfoundry.org/bbs/migration"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/serviceclient"
 	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/bbs/tlsreloader"
 	cfhttp "code.cloudfoundry.org/cfhttp/v2"
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/consuladapter"
@@ -59,14 +70,46 @@ var configFilePath = flag.String(
 	"The path to the JSON configuration file.",
 )
 
+var migrationDryRun = flag.Bool(
+	"migration-dry-run",
+	false,
+	"Print the ordered migration plan and its SQL without running it, then exit.",
+)
+
+var migrateToVersion = flag.Int64(
+	"migrate-to-version",
+	-1,
+	"Migrate the database up or down to the given version (0 reverts every migration), then exit.",
+)
+
+var validateConfig = flag.Bool(
+	"validate-config",
+	false,
+	"Validate the configuration file, print any problems, then exit.",
+)
+
 const (
 	bbsLockKey = "bbs"
+
+	migrationLockTimeout = 2 * locket.DefaultSessionTTL
+
+	retirementReservationConvergeIntervals = 3
 )
 
+var errMigrationLockHeld = errors.New("another BBS holds the lock, stop every BBS before migrating")
+
 func main() {
 	flag.Parse()
 
+	// SIGHUP terminates the process unless it is caught, so catch it before
+	// waiting for the lock or migrating, not only once the reloader runs.
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+
 	bbsConfig, err := config.NewBBSConfig(*configFilePath)
+	if *validateConfig {
+		os.Exit(runValidateConfigCommand(bbsConfig, err))
+	}
 	if err != nil {
 		panic(err.Error())
 	}
@@ -74,6 +117,11 @@ func main() {
 	logger, reconfigurableSink := lagerflags.NewFromConfig(bbsConfig.SessionName, bbsConfig.LagerConfig)
 	logger.Info("starting")
 
+	err = bbsConfig.Validate()
+	if err != nil {
+		logger.Fatal("invalid-configuration", err)
+	}
+
 	metronClient, err := initializeMetron(logger, bbsConfig)
 	if err != nil {
 		logger.Error("failed-to-initialize-metron-client", err)
@@ -113,6 +161,13 @@ func main() {
 	if err != nil {
 		logger.Fatal("cannot-setup-encryption", err)
 	}
+
+	compression := format.Compression(bbsConfig.PayloadCompression)
+	err = compression.Validate()
+	if err != nil {
+		logger.Fatal("invalid-payload-compression", err)
+	}
+
 	cryptor := encryption.NewCryptor(keyManager, rand.Reader)
 
 	if bbsConfig.DatabaseDriver == "" || bbsConfig.DatabaseConnectionString == "" {
@@ -146,26 +201,32 @@ func main() {
 		bbsConfig.ConvergenceWorkers,
 		bbsConfig.UpdateWorkers,
 		cryptor,
+		compression,
 		guidprovider.DefaultGuidProvider,
 		clock,
 		bbsConfig.DatabaseDriver,
 		metronClient,
 	)
-	err = sqlDB.CreateConfigurationsTable(context.Background(), logger)
-	if err != nil {
-		logger.Fatal("sql-failed-create-configurations-table", err)
+	// A migration dry run must not write to the database; it plans from a
+	// missing configurations table as version 0.
+	if !*migrationDryRun {
+		err = sqlDB.CreateConfigurationsTable(context.Background(), logger)
+		if err != nil {
+			logger.Fatal("sql-failed-create-configurations-table", err)
+		}
 	}
 
 	encryptor := encryptor.New(logger, sqlDB, keyManager, cryptor, clock, metronClient)
 
 	migrationsDone := make(chan struct{})
 
+	allMigrations := migrations.AllMigrations()
 	migrationManager := migration.NewManager(
 		logger,
 		sqlDB,
 		sqlConn,
 		cryptor,
-		migrations.AllMigrations(),
+		allMigrations,
 		migrationsDone,
 		clock,
 		bbsConfig.DatabaseDriver,
@@ -177,6 +238,20 @@ func main() {
 	actualLRPInstanceHub := events.NewHub(logger)
 	taskHub := events.NewHub(logger)
 
+	var lrpConvergenceScheduler *controllers.LRPConvergenceScheduler
+	if bbsConfig.LRPConvergenceShards > 1 {
+		lrpConvergenceScheduler = controllers.NewLRPConvergenceScheduler(bbsConfig.LRPConvergenceShards)
+		desiredHub = lrpConvergenceScheduler.TrackHub(desiredHub)
+		actualHub = lrpConvergenceScheduler.TrackHub(actualHub)
+		actualLRPInstanceHub = lrpConvergenceScheduler.TrackHub(actualLRPInstanceHub)
+	}
+
+	tlsReloadInterval := time.Duration(bbsConfig.TLSReloadInterval)
+	if tlsReloadInterval == 0 {
+		tlsReloadInterval = tlsreloader.DefaultInterval
+	}
+	tlsReloaders := grouper.Members{}
+
 	repTLSConfig := &rep.TLSConfig{
 		RequireTLS:      bbsConfig.RepRequireTLS,
 		CaCertFile:      bbsConfig.RepCACert,
@@ -192,8 +267,21 @@ func main() {
 	if err != nil {
 		logger.Fatal("new-rep-client-factory-failed", err)
 	}
+	if bbsConfig.RepCACert != "" && bbsConfig.RepClientCert != "" && bbsConfig.RepClientKey != "" {
+		repTLSReloader, err := tlsreloader.New(logger, "rep", bbsConfig.RepClientCert, bbsConfig.RepClientKey, bbsConfig.RepCACert, clock, tlsReloadInterval, metronClient)
+		if err != nil {
+			logger.Fatal("new-rep-tls-reloader-failed", err)
+		}
+		if transport, ok := httpClient.Transport.(*http.Transport); ok {
+			transport.DialTLSContext = repTLSReloader.DialTLSContext(transport.TLSClientConfig)
+		}
+		tlsReloaders = append(tlsReloaders, grouper.Member{"rep-tls-reloader", repTLSReloader})
+	}
 
-	auctioneerClient := initializeAuctioneerClient(logger, &bbsConfig)
+	auctioneerClient, auctioneerTLSReloader := initializeAuctioneerClient(logger, &bbsConfig, clock, tlsReloadInterval, metronClient)
+	if auctioneerTLSReloader != nil {
+		tlsReloaders = append(tlsReloaders, grouper.Member{"auctioneer-tls-reloader", auctioneerTLSReloader})
+	}
 
 	exitChan := make(chan struct{})
 
@@ -215,15 +303,63 @@ func main() {
 	if err != nil {
 		logger.Fatal("tls-configuration-failed", err)
 	}
-	// the BBS server performs requests as a client
-	tlsConfig.RootCAs = tlsConfig.ClientCAs
 
+	serverTLSReloader, err := tlsreloader.New(logger, "bbs", bbsConfig.CertFile, bbsConfig.KeyFile, bbsConfig.CaFile, clock, tlsReloadInterval, metronClient)
+	if err != nil {
+		logger.Fatal("tls-configuration-failed", err)
+	}
+	tlsReloaders = append(tlsReloaders, grouper.Member{"bbs-tls-reloader", serverTLSReloader})
+
+	// the BBS server performs requests as a client
 	cbWorkPool := taskworkpool.New(logger,
 		bbsConfig.TaskCallbackWorkers,
 		taskworkpool.HandleCompletedTask,
-		tlsConfig,
+		serverTLSReloader.DialTLSContext(tlsConfig),
 		time.Duration(bbsConfig.CommunicationTimeout))
 
+	var authorizationPolicy *middleware.AuthorizationPolicy
+	if bbsConfig.AuthorizationPolicyFile != "" {
+		authorizationPolicy, err = middleware.LoadAuthorizationPolicy(bbsConfig.AuthorizationPolicyFile)
+		if err != nil {
+			logger.Fatal("failed-to-load-authorization-policy", err)
+		}
+		err = authorizationPolicy.Validate(bbs.Routes)
+		if err != nil {
+			logger.Fatal("invalid-authorization-policy", err)
+		}
+	}
+
+	var rateLimiter *middleware.RateLimiter
+	if bbsConfig.RateLimitPolicyFile != "" {
+		rateLimitPolicy, err := middleware.LoadRateLimitPolicy(bbsConfig.RateLimitPolicyFile)
+		if err != nil {
+			logger.Fatal("failed-to-load-rate-limit-policy", err)
+		}
+		err = rateLimitPolicy.Validate(bbs.Routes)
+		if err != nil {
+			logger.Fatal("invalid-rate-limit-policy", err)
+		}
+		rateLimiter = middleware.NewRateLimiter(rateLimitPolicy, clock)
+	}
+
+	auditSinks := []audit.Sink{}
+	if bbsConfig.AuditLogPath != "" {
+		fileSink, err := audit.NewFileSink(bbsConfig.AuditLogPath, int64(bbsConfig.AuditLogMaxSizeMB)*1024*1024, bbsConfig.AuditLogMaxBackups)
+		if err != nil {
+			logger.Fatal("failed-to-open-audit-log", err)
+		}
+		defer fileSink.Close()
+		auditSinks = append(auditSinks, fileSink)
+	}
+	if bbsConfig.AuditDatabaseEnabled {
+		auditSinks = append(auditSinks, audit.NewDBSink(sqlDB))
+	}
+
+	var auditSink audit.Sink
+	if len(auditSinks) > 0 {
+		auditSink = audit.NewMultiSink(auditSinks...)
+	}
+
 	locks := []grouper.Member{}
 
 	if !bbsConfig.SkipConsulLock {
@@ -269,6 +405,10 @@ func main() {
 		lock = jointlock.NewJointLock(clock, locket.DefaultSessionTTL, locks...)
 	}
 
+	if *migrationDryRun || *migrateToVersion >= 0 {
+		os.Exit(runMigrationCommand(logger, migrationManager, allMigrations, lock, clock))
+	}
+
 	var cellPresenceClient maintain.CellPresenceClient
 	if bbsConfig.DetectConsulCellRegistrations {
 		cellPresenceClient = maintain.NewCellPresenceClient(consulClient, clock)
@@ -298,7 +438,37 @@ func main() {
 	taskStatMetronNotifier := metrics.NewTaskStatMetronNotifier(logger, clock, metronClient)
 	dbStatMetronNotifier := metrics.NewDBStatMetronNotifier(logger, clock, monitoredDB, metronClient, queryMonitor)
 
-	handler := handlers.New(
+	configReloader := config.NewReloader(*configFilePath, bbsConfig)
+
+	cellCordonController := controllers.NewCellCordonController(sqlDB, clock)
+
+	maintenanceController := controllers.NewMaintenanceController(sqlDB, clock, metronClient)
+
+	convergenceSafetyValve := controllers.NewConvergenceSafetyValve(
+		sqlDB,
+		clock,
+		metronClient,
+		auditSink,
+		bbsConfig.SafetyValveMissingCellPercent,
+		bbsConfig.SafetyValveConfirmPasses,
+	)
+
+	retirementReservations := controllers.NewRetirementReservations(clock, retirementReservationTTL(bbsConfig))
+
+	convergencePlanner := controllers.NewConvergencePlanner(
+		sqlDB,
+		sqlDB,
+		serviceClient,
+		clock,
+		time.Duration(bbsConfig.KickTaskDuration),
+		time.Duration(bbsConfig.ExpirePendingTaskDuration),
+		time.Duration(bbsConfig.ExpireCompletedTaskDuration),
+		cellCordonController,
+		convergenceSafetyValve,
+		maintenanceController,
+	)
+
+	handler, applyHandlerSettings := handlers.New(
 		logger,
 		accessLogger,
 		bbsConfig.UpdateWorkers,
@@ -315,6 +485,20 @@ func main() {
 		auctioneerClient,
 		repClientFactory,
 		taskStatMetronNotifier,
+		encryptor,
+		configReloader,
+		controllers.NewActualLRPExplainer(sqlDB, sqlDB, convergencePlanner, clock),
+		convergencePlanner,
+		convergenceSafetyValve,
+		maintenanceController,
+		cellCordonController,
+		controllers.NewDisruptionBudgetController(sqlDB, serviceClient, cellCordonController, retirementReservations),
+		retirementReservations,
+		controllers.NewCellSummaryController(sqlDB, serviceClient, cellCordonController),
+		authorizationPolicy,
+		rateLimiter,
+		auditSink,
+		clock,
 		migrationsDone,
 		exitChan,
 	)
@@ -326,11 +510,14 @@ func main() {
 		sqlDB,
 		sqlDB,
 		sqlDB,
+		sqlDB,
 		auctioneerClient,
 		serviceClient,
 		repClientFactory,
 		actualHub,
 		actualLRPInstanceHub,
+		maintenanceController,
+		retirementReservations,
 	)
 
 	lrpStatMetronNotifier := metrics.NewLRPStatMetronNotifier(logger, clock, metronClient)
@@ -348,14 +535,18 @@ func main() {
 		actualLRPController,
 		bbsConfig.ConvergenceWorkers,
 		lrpStatMetronNotifier,
+		lrpConvergenceScheduler,
+		convergenceSafetyValve,
+		cellCordonController,
 	)
-	taskController := controllers.NewTaskController(sqlDB, cbWorkPool, auctioneerClient, serviceClient, repClientFactory, taskHub, taskStatMetronNotifier, bbsConfig.MaxTaskRetries)
+	taskController := controllers.NewTaskController(sqlDB, cbWorkPool, auctioneerClient, serviceClient, repClientFactory, taskHub, taskStatMetronNotifier, bbsConfig.MaxTaskRetries, convergenceSafetyValve)
 
 	convergerProcess := converger.New(
 		logger,
 		clock,
 		lrpConvergenceController,
 		taskController,
+		maintenanceController,
 		serviceClient,
 		time.Duration(bbsConfig.ConvergeRepeatInterval),
 		time.Duration(bbsConfig.KickTaskDuration),
@@ -363,13 +554,44 @@ func main() {
 		time.Duration(bbsConfig.ExpireCompletedTaskDuration),
 	)
 
+	configReloader.OnReload(func(newConfig config.BBSConfig) {
+		applyHandlerSettings(handlers.Settings{
+			UpdateWorkers:  newConfig.UpdateWorkers,
+			MaxTaskRetries: newConfig.MaxTaskRetries,
+		})
+		lrpConvergenceController.SetConvergenceWorkers(newConfig.ConvergenceWorkers)
+		taskController.SetMaxRetries(newConfig.MaxTaskRetries)
+		convergerProcess.SetIntervals(
+			time.Duration(newConfig.ConvergeRepeatInterval),
+			time.Duration(newConfig.KickTaskDuration),
+			time.Duration(newConfig.ExpirePendingTaskDuration),
+			time.Duration(newConfig.ExpireCompletedTaskDuration),
+		)
+		convergencePlanner.SetTaskDurations(
+			time.Duration(newConfig.KickTaskDuration),
+			time.Duration(newConfig.ExpirePendingTaskDuration),
+			time.Duration(newConfig.ExpireCompletedTaskDuration),
+		)
+		convergenceSafetyValve.SetThresholds(newConfig.SafetyValveMissingCellPercent, newConfig.SafetyValveConfirmPasses)
+		retirementReservations.SetTTL(retirementReservationTTL(newConfig))
+	})
+
 	var server ifrit.Runner
 	if tlsConfig != nil {
-		server = http_server.NewTLSServer(bbsConfig.ListenAddress, handler, tlsConfig)
+		server = http_server.NewTLSServer(bbsConfig.ListenAddress, handler, serverTLSReloader.ServerConfig(tlsConfig))
 	} else {
 		server = http_server.New(bbsConfig.ListenAddress, handler)
 	}
 
+	var grpcServer ifrit.Runner
+	if bbsConfig.GRPCListenAddress != "" {
+		var grpcTLSConfig *tls.Config
+		if tlsConfig != nil {
+			grpcTLSConfig = serverTLSReloader.ServerConfig(tlsConfig)
+		}
+		grpcServer = grpcserver.NewRunner(logger, bbsConfig.GRPCListenAddress, grpcTLSConfig, grpcserver.NewServer(logger, handler))
+	}
+
 	healthcheckServer := http_server.New(bbsConfig.HealthAddress, http.HandlerFunc(healthCheckHandler))
 
 	members := grouper.Members{
@@ -389,6 +611,11 @@ func main() {
 		{"lrp-stat-metron-notifier", lrpStatMetronNotifier},
 		{"task-stat-metron-notifier", taskStatMetronNotifier},
 		{"db-stat-metron-notifier", dbStatMetronNotifier},
+		{"config-reloader", configReloadRunner(logger, configReloader, hangups)},
+	}
+
+	if grpcServer != nil {
+		members = append(members, grouper.Member{"grpc-server", grpcServer})
 	}
 
 	if bbsConfig.EnableConsulServiceRegistration {
@@ -396,6 +623,8 @@ func main() {
 		members = append(members, grouper.Member{"registration-runner", registrationRunner})
 	}
 
+	members = append(tlsReloaders, members...)
+
 	if bbsConfig.DebugAddress != "" {
 		members = append(grouper.Members{
 			{"debug-server", debugserver.Runner(bbsConfig.DebugAddress, reconfigurableSink)},
@@ -426,6 +655,104 @@ func main() {
 	logger.Info("exited")
 }
 
+func runMigrationCommand(logger lager.Logger, manager migration.Manager, allMigrations migration.Migrations, lock ifrit.Runner, clock clock.Clock) int {
+	targetVersion := *migrateToVersion
+	if targetVersion < 0 {
+		targetVersion = 0
+		if len(allMigrations) > 0 {
+			targetVersion = allMigrations[len(allMigrations)-1].Version()
+		}
+	}
+
+	steps, err := manager.Plan(logger, targetVersion)
+	if err != nil {
+		logger.Error("failed-to-plan-migrations", err)
+		return 1
+	}
+
+	fmt.Printf("-- %d migrations to reach version %d\n", len(steps), targetVersion)
+	for _, step := range steps {
+		fmt.Printf("-- %s %s (version %d)\n", step.Direction, step.Migration, step.Version)
+		if step.SQL == nil {
+			fmt.Println("-- SQL not available for this migration")
+		}
+		for _, statement := range step.SQL {
+			fmt.Println(strings.TrimSuffix(strings.TrimSpace(statement), ";") + ";")
+		}
+	}
+
+	if *migrationDryRun {
+		return 0
+	}
+
+	lockProcess, err := acquireMigrationLock(logger, lock, clock)
+	if err != nil {
+		logger.Error("failed-to-acquire-lock", err)
+		return 1
+	}
+	defer func() {
+		lockProcess.Signal(os.Interrupt)
+		<-lockProcess.Wait()
+	}()
+
+	err = manager.MigrateTo(logger, targetVersion)
+	if err != nil {
+		logger.Error("failed-to-migrate", err)
+		return 1
+	}
+	return 0
+}
+
+// acquireMigrationLock holds the BBS lock so that no BBS is active while the
+// schema changes. It waits long enough for the lock of a BBS that was just
+// stopped to expire, and gives up if another BBS still holds it.
+func acquireMigrationLock(logger lager.Logger, lock ifrit.Runner, clock clock.Clock) (ifrit.Process, error) {
+	logger = logger.Session("migration-lock")
+	logger.Info("acquiring-lock")
+
+	process := ifrit.Background(lock)
+	select {
+	case <-process.Ready():
+		logger.Info("acquired-lock")
+		return process, nil
+	case err := <-process.Wait():
+		if err == nil {
+			err = errors.New("lock exited before it was acquired")
+		}
+		return nil, err
+	case <-clock.After(migrationLockTimeout):
+		process.Signal(os.Interrupt)
+		<-process.Wait()
+		logger.Error("migration-lock-held", errMigrationLockHeld)
+		return nil, errMigrationLockHeld
+	}
+}
+
+func runValidateConfigCommand(bbsConfig config.BBSConfig, loadErr error) int {
+	if loadErr != nil {
+		fmt.Fprintf(os.Stderr, "failed to read configuration: %s\n", loadErr)
+		return 1
+	}
+
+	err := bbsConfig.Validate()
+	if validationErrs, ok := err.(config.ValidationErrors); ok {
+		for _, validationErr := range validationErrs {
+			fmt.Fprintln(os.Stderr, validationErr.Error())
+		}
+		return 1
+	}
+
+	fmt.Println("configuration is valid")
+	return 0
+}
+
+// retirementReservationTTL is how long a retire holds a disruption of the
+// budget for an instance that the database still reports as running. A few
+// convergence intervals leave a stop that landed time to be reported.
+func retirementReservationTTL(bbsConfig config.BBSConfig) time.Duration {
+	return retirementReservationConvergeIntervals * time.Duration(bbsConfig.ConvergeRepeatInterval)
+}
+
 func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
@@ -454,6 +781,27 @@ func hubMaintainer(logger lager.Logger, desiredHub, actualHub, taskHub events.Hu
 	}
 }
 
+// configReloadRunner reloads the configuration file whenever the process
+// receives SIGHUP. A SIGHUP received before it runs is handled once it does.
+func configReloadRunner(logger lager.Logger, reloader *config.Reloader, hangups <-chan os.Signal) ifrit.RunFunc {
+	return func(signals <-chan os.Signal, ready chan<- struct{}) error {
+		logger := logger.Session("config-reload-runner")
+
+		close(ready)
+		logger.Info("started")
+		defer logger.Info("finished")
+
+		for {
+			select {
+			case <-signals:
+				return nil
+			case <-hangups:
+				reloader.Reload(logger)
+			}
+		}
+	}
+}
+
 func initializeRegistrationRunner(
 	logger lager.Logger,
 	consulClient consuladapter.Client,
@@ -503,26 +851,82 @@ func initializeLockMaintainer(
 	)
 }
 
-func initializeAuctioneerClient(logger lager.Logger, bbsConfig *config.BBSConfig) auctioneer.Client {
+func initializeAuctioneerClient(
+	logger lager.Logger,
+	bbsConfig *config.BBSConfig,
+	clock clock.Clock,
+	tlsReloadInterval time.Duration,
+	metronClient loggingclient.IngressClient,
+) (auctioneer.Client, *tlsreloader.Reloader) {
 	if bbsConfig.AuctioneerAddress == "" {
 		logger.Fatal("auctioneer-address-validation-failed", errors.New("auctioneerAddress is required"))
 	}
 
 	if bbsConfig.AuctioneerCACert != "" || bbsConfig.AuctioneerClientCert != "" || bbsConfig.AuctioneerClientKey != "" {
-		client, err := auctioneer.NewSecureClient(bbsConfig.AuctioneerAddress,
-			bbsConfig.AuctioneerCACert,
-			bbsConfig.AuctioneerClientCert,
-			bbsConfig.AuctioneerClientKey,
-			bbsConfig.AuctioneerRequireTLS,
-			time.Duration(bbsConfig.CommunicationTimeout),
-		)
+		newClient := func() (auctioneer.Client, error) {
+			return auctioneer.NewSecureClient(bbsConfig.AuctioneerAddress,
+				bbsConfig.AuctioneerCACert,
+				bbsConfig.AuctioneerClientCert,
+				bbsConfig.AuctioneerClientKey,
+				bbsConfig.AuctioneerRequireTLS,
+				time.Duration(bbsConfig.CommunicationTimeout),
+			)
+		}
+
+		client, err := newClient()
 		if err != nil {
 			logger.Fatal("failed-to-construct-auctioneer-client", err)
 		}
-		return client
+
+		if bbsConfig.AuctioneerClientCert == "" || bbsConfig.AuctioneerClientKey == "" {
+			return client, nil
+		}
+
+		reloader, err := tlsreloader.New(logger, "auctioneer", bbsConfig.AuctioneerClientCert, bbsConfig.AuctioneerClientKey, bbsConfig.AuctioneerCACert, clock, tlsReloadInterval, metronClient)
+		if err != nil {
+			logger.Fatal("failed-to-construct-auctioneer-tls-reloader", err)
+		}
+
+		// the auctioneer client reads its certificates when it is constructed,
+		// so it is replaced whenever they change
+		reloadingClient := &reloadingAuctioneerClient{client: client}
+		reloader.OnReload(func() {
+			client, err := newClient()
+			if err != nil {
+				logger.Error("failed-to-reconstruct-auctioneer-client", err)
+				return
+			}
+			reloadingClient.setClient(client)
+		})
+		return reloadingClient, reloader
 	}
 
-	return auctioneer.NewClient(bbsConfig.AuctioneerAddress, time.Duration(bbsConfig.CommunicationTimeout))
+	return auctioneer.NewClient(bbsConfig.AuctioneerAddress, time.Duration(bbsConfig.CommunicationTimeout)), nil
+}
+
+type reloadingAuctioneerClient struct {
+	lock   sync.RWMutex
+	client auctioneer.Client
+}
+
+func (c *reloadingAuctioneerClient) setClient(client auctioneer.Client) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.client = client
+}
+
+func (c *reloadingAuctioneerClient) currentClient() auctioneer.Client {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.client
+}
+
+func (c *reloadingAuctioneerClient) RequestLRPAuctions(logger lager.Logger, lrpStarts []*auctioneer.LRPStartRequest) error {
+	return c.currentClient().RequestLRPAuctions(logger, lrpStarts)
+}
+
+func (c *reloadingAuctioneerClient) RequestTaskAuctions(logger lager.Logger, tasks []*auctioneer.TaskStartRequest) error {
+	return c.currentClient().RequestTaskAuctions(logger, tasks)
 }
 
 func initializeMetron(logger lager.Logger, bbsConfig config.BBSConfig) (loggingclient.IngressClient, error) {
@@ -1,9 +1,16 @@
 package main_test
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+
 	"code.cloudfoundry.org/bbs/cmd/bbs/testrunner"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
 	"github.com/tedsuo/ifrit"
 )
 
@@ -34,4 +41,38 @@ var _ = Describe("BBS main test", func() {
 			Eventually(bbsProcess.Wait()).Should(Receive(HaveOccurred()))
 		})
 	})
+
+	Context("when run with -validate-config", func() {
+		var session *gexec.Session
+
+		JustBeforeEach(func() {
+			configFile, err := ioutil.TempFile("", "bbs.config")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(configFile.Name())
+			Expect(json.NewEncoder(configFile).Encode(bbsConfig)).To(Succeed())
+			Expect(configFile.Close()).To(Succeed())
+
+			session, err = gexec.Start(exec.Command(bbsBinPath, "-config", configFile.Name(), "-validate-config"), GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(session).Should(gexec.Exit())
+		})
+
+		It("exits successfully when the configuration is valid", func() {
+			Expect(session.ExitCode()).To(Equal(0))
+			Expect(session.Out).To(gbytes.Say("configuration is valid"))
+		})
+
+		Context("when the configuration is invalid", func() {
+			BeforeEach(func() {
+				bbsConfig.DatabaseDriver = ""
+				bbsConfig.UpdateWorkers = 0
+			})
+
+			It("prints every problem and exits with an error", func() {
+				Expect(session.ExitCode()).To(Equal(1))
+				Expect(session.Err).To(gbytes.Say("database_driver: is required"))
+				Expect(session.Err).To(gbytes.Say("update_workers: must be positive"))
+			})
+		})
+	})
 })
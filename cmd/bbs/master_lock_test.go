@@ -1,10 +1,13 @@
 package main_test
 
 import (
+	"syscall"
+
 	"code.cloudfoundry.org/bbs/cmd/bbs/testrunner"
 	"code.cloudfoundry.org/clock"
 	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
 	"code.cloudfoundry.org/locket"
+	"github.com/onsi/gomega/gbytes"
 	"github.com/tedsuo/ifrit"
 	"github.com/tedsuo/ifrit/ginkgomon"
 
@@ -43,6 +46,43 @@ var _ = Describe("MasterLock", func() {
 				return err
 			}).ShouldNot(HaveOccurred())
 		})
+
+		It("does not exit when asked to reload its configuration", func() {
+			bbsProcess.Signal(syscall.SIGHUP)
+			Consistently(bbsRunner.ExitCode).Should(Equal(-1))
+
+			ginkgomon.Kill(competingBBSLockProcess)
+
+			Eventually(func() error {
+				_, err := client.Domains(logger)
+				return err
+			}).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("when migrating while another bbs holds the lock", func() {
+		var competingBBSLockProcess ifrit.Process
+
+		BeforeEach(func() {
+			competingBBSLock := locket.NewLock(logger, consulClient, locket.LockSchemaPath("bbs_lock"), []byte{}, clock.NewClock(), locket.RetryInterval, locket.DefaultSessionTTL, locket.WithMetronClient(&mfakes.FakeIngressClient{}))
+			competingBBSLockProcess = ifrit.Invoke(competingBBSLock)
+
+			bbsRunner = testrunner.New(bbsBinPath, bbsConfig)
+			bbsRunner.Command.Args = append(bbsRunner.Command.Args, "-migrate-to-version", "0")
+			bbsRunner.StartCheck = "bbs.migration-lock.acquiring-lock"
+
+			bbsProcess = ginkgomon.Invoke(bbsRunner)
+		})
+
+		AfterEach(func() {
+			ginkgomon.Kill(competingBBSLockProcess)
+		})
+
+		It("refuses to migrate", func() {
+			Eventually(bbsRunner.ExitCode, 3*locket.DefaultSessionTTL).Should(Equal(1))
+			Expect(bbsRunner).To(gbytes.Say("migration-lock-held"))
+			Expect(bbsRunner).NotTo(gbytes.Say("failed-to-migrate"))
+		})
 	})
 
 	Context("when the bbs loses the master lock", func() {
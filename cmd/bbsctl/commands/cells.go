@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+func cells(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	cells, err := ctx.Client.Cells(ctx.Logger)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{}
+	for _, cell := range cells {
+		capacity := cell.Capacity
+		rows = append(rows, []string{
+			cell.CellId,
+			cell.Zone,
+			cell.RepAddress,
+			strconv.Itoa(int(capacity.GetMemoryMb())),
+			strconv.Itoa(int(capacity.GetDiskMb())),
+			strconv.Itoa(int(capacity.GetContainers())),
+			strings.Join(cell.PlacementTags, ","),
+			cellState(cell.Cordoned, cell.Draining),
+		})
+	}
+
+	return ctx.write(cells, []string{"CELL ID", "ZONE", "REP ADDRESS", "MEMORY MB", "DISK MB", "CONTAINERS", "PLACEMENT TAGS", "STATE"}, rows)
+}
+
+func cellSummaries(ctx *Context, flags *flag.FlagSet, args []string) error {
+	byZone := flags.Bool("zones", false, "show the totals of each zone instead of each cell")
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	cells, zones, err := ctx.Client.CellSummaries(ctx.Logger)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{}
+	if *byZone {
+		for _, zone := range zones {
+			rows = append(rows, append([]string{
+				zone.Zone,
+				strconv.Itoa(int(zone.CellCount)),
+				strconv.Itoa(int(zone.CordonedCellCount)),
+			}, usageColumns(zone.Reserved, zone.Capacity, zone.ActualLrps, zone.Tasks)...))
+		}
+
+		return ctx.write(zones, []string{"ZONE", "CELLS", "CORDONED", "MEMORY MB", "DISK MB", "CONTAINERS", "LRPS", "SUSPECT", "EVACUATING", "TASKS"}, rows)
+	}
+
+	for _, cell := range cells {
+		rows = append(rows, append([]string{
+			cell.CellId,
+			cell.Zone,
+			cellState(cell.Cordoned, cell.Draining),
+		}, usageColumns(cell.Reserved, cell.Capacity, cell.ActualLrps, cell.Tasks)...))
+	}
+
+	return ctx.write(cells, []string{"CELL ID", "ZONE", "STATE", "MEMORY MB", "DISK MB", "CONTAINERS", "LRPS", "SUSPECT", "EVACUATING", "TASKS"}, rows)
+}
+
+// usageColumns shows reserved resources against capacity, and the claimed
+// and running LRP instances and running tasks.
+func usageColumns(reserved, capacity *models.CellCapacity, lrps *models.CellActualLRPCounts, tasks *models.CellTaskCounts) []string {
+	return []string{
+		fmt.Sprintf("%d/%d", reserved.GetMemoryMb(), capacity.GetMemoryMb()),
+		fmt.Sprintf("%d/%d", reserved.GetDiskMb(), capacity.GetDiskMb()),
+		fmt.Sprintf("%d/%d", reserved.GetContainers(), capacity.GetContainers()),
+		strconv.Itoa(int(lrps.GetClaimed() + lrps.GetRunning())),
+		strconv.Itoa(int(lrps.GetSuspect())),
+		strconv.Itoa(int(lrps.GetEvacuating())),
+		strconv.Itoa(int(tasks.GetRunning())),
+	}
+}
+
+func cellState(cordoned, draining bool) string {
+	switch {
+	case draining:
+		return "draining"
+	case cordoned:
+		return "cordoned"
+	default:
+		return "active"
+	}
+}
+
+func cellCordons(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	cordons, err := ctx.Client.CellCordons(ctx.Logger)
+	if err != nil {
+		return err
+	}
+
+	return writeCellCordons(ctx, cordons, cordons)
+}
+
+func cordonCell(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, -1)
+	if err != nil {
+		return err
+	}
+
+	cordon, err := ctx.Client.CordonCell(ctx.Logger, flags.Arg(0), strings.Join(flags.Args()[1:], " "))
+	if err != nil {
+		return err
+	}
+
+	return writeCellCordons(ctx, cordon, []*models.CellCordon{cordon})
+}
+
+func drainCell(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, -1)
+	if err != nil {
+		return err
+	}
+
+	cordon, err := ctx.Client.DrainCell(ctx.Logger, flags.Arg(0), strings.Join(flags.Args()[1:], " "))
+	if err != nil {
+		return err
+	}
+
+	return writeCellCordons(ctx, cordon, []*models.CellCordon{cordon})
+}
+
+func uncordonCell(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Client.UncordonCell(ctx.Logger, flags.Arg(0))
+}
+
+func writeCellCordons(ctx *Context, value interface{}, cordons []*models.CellCordon) error {
+	rows := [][]string{}
+	for _, cordon := range cordons {
+		rows = append(rows, []string{
+			cordon.CellId,
+			cellState(true, cordon.Draining),
+			cordon.Reason,
+			formatTimestamp(cordon.UpdatedAt),
+		})
+	}
+
+	return ctx.write(value, []string{"CELL ID", "STATE", "REASON", "UPDATED AT"}, rows)
+}
+
+func domains(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	domains, err := ctx.Client.Domains(ctx.Logger)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{}
+	for _, domain := range domains {
+		rows = append(rows, []string{domain})
+	}
+
+	return ctx.write(domains, []string{"DOMAIN"}, rows)
+}
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/lager"
+)
+
+// Context is what commands run against.
+type Context struct {
+	Logger lager.Logger
+	Client bbs.InternalClient
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	JSON   bool
+}
+
+type Command struct {
+	Name        string
+	Usage       string
+	Description string
+	Run         func(ctx *Context, flags *flag.FlagSet, args []string) error
+}
+
+// UsageError is returned when a command is called with the wrong arguments.
+type UsageError struct {
+	Message string
+}
+
+func (e UsageError) Error() string {
+	return e.Message
+}
+
+var ErrHelp = flag.ErrHelp
+
+var Commands = []Command{
+	{Name: "actual-lrps", Usage: "[-domain DOMAIN] [-cell-id CELL_ID] [-process-guid GUID [-index INDEX]]", Description: "List actual LRPs.", Run: actualLRPs},
+	{Name: "desired-lrps", Usage: "[-domain DOMAIN] [PROCESS_GUID...]", Description: "List desired LRPs.", Run: desiredLRPs},
+	{Name: "desired-lrp", Usage: "PROCESS_GUID", Description: "Get a desired LRP.", Run: desiredLRP},
+	{Name: "disruption-budget", Usage: "PROCESS_GUID", Description: "Show how many voluntary disruptions the disruption budget of a desired LRP allows.", Run: disruptionBudget},
+	{Name: "desire-lrp", Usage: "FILE", Description: "Desire the LRP described by a JSON file, or stdin when FILE is -.", Run: desireLRP},
+	{Name: "retire-actual-lrp", Usage: "PROCESS_GUID INDEX", Description: "Retire an actual LRP instance.", Run: retireActualLRP},
+	{Name: "explain-actual-lrp", Usage: "PROCESS_GUID INDEX", Description: "Explain the state of an actual LRP and what convergence will do with it.", Run: explainActualLRP},
+	{Name: "tasks", Usage: "[-domain DOMAIN] [-cell-id CELL_ID]", Description: "List tasks.", Run: tasks},
+	{Name: "task", Usage: "TASK_GUID", Description: "Get a task.", Run: task},
+	{Name: "desire-task", Usage: "FILE", Description: "Desire the task described by a JSON file, or stdin when FILE is -.", Run: desireTask},
+	{Name: "cancel-task", Usage: "TASK_GUID", Description: "Cancel a task.", Run: cancelTask},
+	{Name: "delete-task", Usage: "TASK_GUID", Description: "Delete a completed task.", Run: deleteTask},
+	{Name: "convergence-plan", Usage: "[-sample-size N]", Description: "Show what the next convergence run would change, without changing it.", Run: convergencePlan},
+	{Name: "safety-valve", Usage: "", Description: "Show the state of the convergence safety valves.", Run: safetyValve},
+	{Name: "override-safety-valve", Usage: "", Description: "Make the next convergence run act on the cells it finds, even if a safety valve trips.", Run: overrideSafetyValve},
+	{Name: "maintenance", Usage: "[on REASON | off]", Description: "Show maintenance mode, or turn it on or off.", Run: maintenance},
+	{Name: "cells", Usage: "", Description: "List cells.", Run: cells},
+	{Name: "cell-summaries", Usage: "[-zones]", Description: "Show the work on each cell, or zone, and the resources it reserves.", Run: cellSummaries},
+	{Name: "cordons", Usage: "", Description: "List cordoned cells.", Run: cellCordons},
+	{Name: "cordon", Usage: "CELL_ID [REASON]", Description: "Cordon a cell so that it gets no new work.", Run: cordonCell},
+	{Name: "drain", Usage: "CELL_ID [REASON]", Description: "Cordon a cell and move its LRP instances to other cells.", Run: drainCell},
+	{Name: "uncordon", Usage: "CELL_ID", Description: "Remove the cordon of a cell.", Run: uncordonCell},
+	{Name: "domains", Usage: "", Description: "List fresh domains.", Run: domains},
+	{Name: "events", Usage: "[-tasks] [-cell-id CELL_ID]", Description: "Tail LRP instance events, or task events.", Run: tailEvents},
+}
+
+func Find(name string) (Command, bool) {
+	for _, command := range Commands {
+		if command.Name == name {
+			return command, true
+		}
+	}
+	return Command{}, false
+}
+
+// WriteUsage lists the commands.
+func WriteUsage(w io.Writer) {
+	names := []string{}
+	byName := map[string]Command{}
+	for _, command := range Commands {
+		names = append(names, command.Name)
+		byName[command.Name] = command
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, name := range names {
+		fmt.Fprintf(tw, "  %s\t%s\n", name, byName[name].Description)
+	}
+	tw.Flush()
+}
+
+// Run runs the command with its own flags.
+func (c *Context) Run(command Command, args []string) error {
+	flags := flag.NewFlagSet(command.Name, flag.ContinueOnError)
+	flags.SetOutput(c.Stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(c.Stderr, "usage: bbsctl %s %s\n\n%s\n", command.Name, command.Usage, command.Description)
+		flags.PrintDefaults()
+	}
+	return command.Run(c, flags, args)
+}
+
+func parseFlags(flags *flag.FlagSet, args []string, minArgs, maxArgs int) error {
+	err := flags.Parse(args)
+	if err != nil {
+		if err == flag.ErrHelp {
+			return ErrHelp
+		}
+		return UsageError{Message: err.Error()}
+	}
+
+	if flags.NArg() < minArgs || (maxArgs >= 0 && flags.NArg() > maxArgs) {
+		flags.Usage()
+		return UsageError{Message: fmt.Sprintf("%s: wrong number of arguments", flags.Name())}
+	}
+
+	return nil
+}
+
+// write prints the value as JSON, or the rows as a table.
+func (c *Context) write(value interface{}, header []string, rows [][]string) error {
+	if c.JSON {
+		encoder := json.NewEncoder(c.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	}
+
+	tw := tabwriter.NewWriter(c.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func (c *Context) readJSON(path string, value interface{}) error {
+	var payload []byte
+	var err error
+	if path == "-" {
+		payload, err = ioutil.ReadAll(c.Stdin)
+	} else {
+		payload, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(payload, value)
+	if err != nil {
+		return fmt.Errorf("invalid JSON in %s: %s", path, err)
+	}
+	return nil
+}
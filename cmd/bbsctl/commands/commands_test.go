@@ -0,0 +1,442 @@
+package commands_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/cmd/bbsctl/commands"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/fake_bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Commands", func() {
+	var (
+		client         *fake_bbs.FakeInternalClient
+		stdin          *bytes.Buffer
+		stdout, stderr *bytes.Buffer
+		ctx            *commands.Context
+	)
+
+	BeforeEach(func() {
+		client = &fake_bbs.FakeInternalClient{}
+		stdin = &bytes.Buffer{}
+		stdout = &bytes.Buffer{}
+		stderr = &bytes.Buffer{}
+		ctx = &commands.Context{
+			Logger: lagertest.NewTestLogger("bbsctl"),
+			Client: client,
+			Stdin:  stdin,
+			Stdout: stdout,
+			Stderr: stderr,
+		}
+	})
+
+	run := func(args ...string) error {
+		command, ok := commands.Find(args[0])
+		Expect(ok).To(BeTrue())
+		return ctx.Run(command, args[1:])
+	}
+
+	Describe("actual-lrps", func() {
+		BeforeEach(func() {
+			lrp := model_helpers.NewValidActualLRP("some-guid", 1)
+			client.ActualLRPsReturns([]*models.ActualLRP{lrp}, nil)
+		})
+
+		It("lists the actual LRPs matching the filter as a table", func() {
+			Expect(run("actual-lrps", "-domain", "some-domain", "-cell-id", "cell-1", "-process-guid", "some-guid", "-index", "1")).To(Succeed())
+
+			_, filter := client.ActualLRPsArgsForCall(0)
+			index := int32(1)
+			Expect(filter).To(Equal(models.ActualLRPFilter{Domain: "some-domain", CellID: "cell-1", ProcessGuid: "some-guid", Index: &index}))
+
+			lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(HavePrefix("PROCESS GUID"))
+			Expect(strings.Fields(lines[1])[:2]).To(Equal([]string{"some-guid", "1"}))
+		})
+
+		It("prints JSON when asked", func() {
+			ctx.JSON = true
+			Expect(run("actual-lrps")).To(Succeed())
+
+			var lrps []*models.ActualLRP
+			Expect(json.Unmarshal(stdout.Bytes(), &lrps)).To(Succeed())
+			Expect(lrps).To(HaveLen(1))
+			Expect(lrps[0].ProcessGuid).To(Equal("some-guid"))
+		})
+
+		It("requires a process guid to filter by index", func() {
+			err := run("actual-lrps", "-index", "1")
+			Expect(err).To(BeAssignableToTypeOf(commands.UsageError{}))
+			Expect(client.ActualLRPsCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("disruption-budget", func() {
+		It("shows the status of the budget", func() {
+			client.DisruptionBudgetStatusReturns(&models.DisruptionBudgetStatus{
+				ProcessGuid:            "some-guid",
+				Budget:                 &models.DisruptionBudget{MinAvailable: 2},
+				DesiredInstances:       4,
+				AvailableInstances:     3,
+				AllowedDisruptions:     1,
+				DelayedDrainingIndices: []int32{2, 3},
+			}, nil)
+
+			Expect(run("disruption-budget", "some-guid")).To(Succeed())
+
+			_, processGuid := client.DisruptionBudgetStatusArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+			Expect(stdout.String()).To(MatchRegexp(`some-guid\s+min available 2\s+4\s+3\s+1\s+2,3`))
+		})
+	})
+
+	Describe("retire-actual-lrp", func() {
+		It("retires the instance by its full key", func() {
+			lrp := model_helpers.NewValidActualLRP("some-guid", 2)
+			client.ActualLRPsReturns([]*models.ActualLRP{lrp}, nil)
+
+			Expect(run("retire-actual-lrp", "some-guid", "2")).To(Succeed())
+
+			_, filter := client.ActualLRPsArgsForCall(0)
+			Expect(filter.ProcessGuid).To(Equal("some-guid"))
+			Expect(*filter.Index).To(BeEquivalentTo(2))
+
+			_, key := client.RetireActualLRPArgsForCall(0)
+			Expect(*key).To(Equal(lrp.ActualLRPKey))
+		})
+
+		It("errors when the instance does not exist", func() {
+			Expect(run("retire-actual-lrp", "some-guid", "2")).To(Equal(models.ErrResourceNotFound))
+			Expect(client.RetireActualLRPCallCount()).To(Equal(0))
+		})
+
+		It("rejects invalid indices", func() {
+			Expect(run("retire-actual-lrp", "some-guid", "two")).To(BeAssignableToTypeOf(commands.UsageError{}))
+		})
+	})
+
+	Describe("explain-actual-lrp", func() {
+		BeforeEach(func() {
+			lrp := model_helpers.NewValidActualLRP("some-guid", 2)
+			lrp.State = models.ActualLRPStateCrashed
+			client.ExplainActualLRPReturns(&models.ActualLRPExplanation{
+				ProcessGuid:      "some-guid",
+				Index:            2,
+				Domain:           "some-domain",
+				Desired:          true,
+				DesiredInstances: 3,
+				Instances: []*models.ActualLRPInstanceExplanation{{
+					ActualLrp:         lrp,
+					CellPresent:       true,
+					RestartsExhausted: true,
+					ConvergenceAction: models.ConvergenceActionNone,
+					ConvergenceReason: "it crashed 200 times and is not restarted again",
+				}},
+				ConvergenceAction: models.ConvergenceActionNone,
+				ConvergenceReason: "it crashed 200 times and is not restarted again",
+			}, nil)
+		})
+
+		It("describes the instance and what convergence will do", func() {
+			Expect(run("explain-actual-lrp", "some-guid", "2")).To(Succeed())
+
+			_, processGuid, index := client.ExplainActualLRPArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+			Expect(index).To(BeEquivalentTo(2))
+
+			Expect(stdout.String()).To(ContainSubstring("desired: yes, 3 instances"))
+			Expect(stdout.String()).To(ContainSubstring("convergence: none: it crashed 200 times"))
+			Expect(stdout.String()).To(MatchRegexp(`ORDINARY\s+CRASHED\s+some-cell\s+true\s+33\s+never`))
+		})
+
+		It("prints the explanation as JSON", func() {
+			ctx.JSON = true
+			Expect(run("explain-actual-lrp", "some-guid", "2")).To(Succeed())
+
+			var explanation models.ActualLRPExplanation
+			Expect(json.Unmarshal(stdout.Bytes(), &explanation)).To(Succeed())
+			Expect(explanation.DesiredInstances).To(BeEquivalentTo(3))
+			Expect(explanation.Instances).To(HaveLen(1))
+		})
+
+		It("rejects invalid indices", func() {
+			Expect(run("explain-actual-lrp", "some-guid", "one")).To(BeAssignableToTypeOf(commands.UsageError{}))
+			Expect(client.ExplainActualLRPCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("convergence-plan", func() {
+		BeforeEach(func() {
+			client.ConvergencePlanReturns(&models.ConvergencePlan{
+				CellCount:      2,
+				MissingCellIds: []string{"missing-cell"},
+				LRPs: &models.LRPConvergencePlan{
+					InstancesToRetire: &models.ActualLRPKeySample{
+						Count:   4,
+						Samples: []*models.ActualLRPKey{{ProcessGuid: "some-guid", Index: 3, Domain: "some-domain"}},
+					},
+				},
+				Tasks: &models.TaskConvergencePlan{
+					PendingTasksToKick: &models.TaskGuidSample{Count: 1, TaskGuids: []string{"some-task"}},
+				},
+			}, nil)
+		})
+
+		It("lists the count and samples of every step", func() {
+			Expect(run("convergence-plan", "-sample-size", "1")).To(Succeed())
+
+			_, sampleSize := client.ConvergencePlanArgsForCall(0)
+			Expect(sampleSize).To(BeEquivalentTo(1))
+
+			Expect(stdout.String()).To(ContainSubstring("missing cells: missing-cell"))
+			Expect(stdout.String()).To(MatchRegexp(`retire extra LRPs\s+4\s+some-guid/3`))
+			Expect(stdout.String()).To(MatchRegexp(`kick pending tasks\s+1\s+some-task`))
+			Expect(stdout.String()).To(MatchRegexp(`create missing LRPs\s+0`))
+		})
+
+		It("rejects negative sample sizes", func() {
+			Expect(run("convergence-plan", "-sample-size", "-1")).To(BeAssignableToTypeOf(commands.UsageError{}))
+			Expect(client.ConvergencePlanCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("safety-valve", func() {
+		BeforeEach(func() {
+			status := &models.ConvergenceSafetyValveStatus{
+				MaxMissingCellPercent: 50,
+				ConfirmPasses:         3,
+				Valves: []*models.ConvergenceSafetyValveState{
+					{Convergence: "lrps", Tripped: true, TrippedPasses: 1, AcceptedCellCount: 4, MissingCellIds: []string{"cell-1", "cell-2", "cell-3"}},
+					{Convergence: "tasks", AcceptedCellCount: 4},
+				},
+			}
+			client.ConvergenceSafetyValveReturns(status, nil)
+			client.OverrideConvergenceSafetyValveReturns(status, nil)
+		})
+
+		It("lists the state of every valve", func() {
+			Expect(run("safety-valve")).To(Succeed())
+
+			Expect(client.OverrideConvergenceSafetyValveCallCount()).To(Equal(0))
+			Expect(stdout.String()).To(ContainSubstring("max missing cell percent: 50"))
+			Expect(stdout.String()).To(MatchRegexp(`lrps\s+true\s+1\s+false\s+4\s+cell-1,cell-2,cell-3`))
+			Expect(stdout.String()).To(MatchRegexp(`tasks\s+false\s+0\s+false\s+4`))
+		})
+
+		It("overrides the valves", func() {
+			Expect(run("override-safety-valve")).To(Succeed())
+			Expect(client.OverrideConvergenceSafetyValveCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("maintenance", func() {
+		BeforeEach(func() {
+			client.MaintenanceModeReturns(&models.MaintenanceMode{}, nil)
+			client.SetMaintenanceModeReturns(&models.MaintenanceMode{Enabled: true, Reason: "database upgrade"}, nil)
+		})
+
+		It("shows the maintenance mode", func() {
+			Expect(run("maintenance")).To(Succeed())
+			Expect(client.MaintenanceModeCallCount()).To(Equal(1))
+			Expect(stdout.String()).To(MatchRegexp(`ENABLED\s+REASON\s+UPDATED AT\nfalse`))
+		})
+
+		It("turns maintenance mode on with the reason", func() {
+			Expect(run("maintenance", "on", "database", "upgrade")).To(Succeed())
+
+			_, enabled, reason := client.SetMaintenanceModeArgsForCall(0)
+			Expect(enabled).To(BeTrue())
+			Expect(reason).To(Equal("database upgrade"))
+			Expect(stdout.String()).To(MatchRegexp(`true\s+database upgrade`))
+		})
+
+		It("turns maintenance mode off", func() {
+			Expect(run("maintenance", "off")).To(Succeed())
+
+			_, enabled, _ := client.SetMaintenanceModeArgsForCall(0)
+			Expect(enabled).To(BeFalse())
+		})
+
+		It("requires a reason to turn maintenance mode on", func() {
+			Expect(run("maintenance", "on")).To(BeAssignableToTypeOf(commands.UsageError{}))
+			Expect(client.SetMaintenanceModeCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("cells", func() {
+		BeforeEach(func() {
+			cell := models.NewCellPresence("cell-1", "1.1.1.1", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+			cell.Cordoned = true
+			cell.Draining = true
+			client.CellsReturns([]*models.CellPresence{&cell}, nil)
+		})
+
+		It("shows whether the cells are cordoned", func() {
+			Expect(run("cells")).To(Succeed())
+			Expect(stdout.String()).To(MatchRegexp(`cell-1\s+z1.*draining`))
+		})
+	})
+
+	Describe("cell-summaries", func() {
+		BeforeEach(func() {
+			client.CellSummariesReturns(
+				[]*models.CellSummary{{
+					CellId:     "cell-1",
+					Zone:       "z1",
+					Cordoned:   true,
+					Capacity:   &models.CellCapacity{MemoryMb: 1024, DiskMb: 2048, Containers: 10},
+					Reserved:   &models.CellCapacity{MemoryMb: 256, DiskMb: 512, Containers: 3},
+					ActualLrps: &models.CellActualLRPCounts{Claimed: 1, Running: 1, Suspect: 1},
+					Tasks:      &models.CellTaskCounts{Running: 1},
+				}},
+				[]*models.ZoneSummary{{
+					Zone:              "z1",
+					CellCount:         1,
+					CordonedCellCount: 1,
+					Capacity:          &models.CellCapacity{MemoryMb: 1024, DiskMb: 2048, Containers: 10},
+					Reserved:          &models.CellCapacity{MemoryMb: 256, DiskMb: 512, Containers: 3},
+					ActualLrps:        &models.CellActualLRPCounts{Claimed: 1, Running: 1, Suspect: 1},
+					Tasks:             &models.CellTaskCounts{Running: 1},
+				}},
+				nil,
+			)
+		})
+
+		It("shows the reserved resources of each cell against its capacity", func() {
+			Expect(run("cell-summaries")).To(Succeed())
+			Expect(stdout.String()).To(MatchRegexp(`cell-1\s+z1\s+cordoned\s+256/1024\s+512/2048\s+3/10\s+2\s+1\s+0\s+1`))
+		})
+
+		It("shows the totals of each zone", func() {
+			Expect(run("cell-summaries", "-zones")).To(Succeed())
+			Expect(stdout.String()).To(MatchRegexp(`z1\s+1\s+1\s+256/1024\s+512/2048\s+3/10\s+2\s+1\s+0\s+1`))
+		})
+	})
+
+	Describe("cordon", func() {
+		BeforeEach(func() {
+			client.CordonCellReturns(&models.CellCordon{CellId: "cell-1", Reason: "kernel upgrade"}, nil)
+		})
+
+		It("cordons the cell with the reason", func() {
+			Expect(run("cordon", "cell-1", "kernel", "upgrade")).To(Succeed())
+
+			_, cellID, reason := client.CordonCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+			Expect(reason).To(Equal("kernel upgrade"))
+			Expect(stdout.String()).To(MatchRegexp(`cell-1\s+cordoned\s+kernel upgrade`))
+		})
+
+		It("requires a cell id", func() {
+			Expect(run("cordon")).To(BeAssignableToTypeOf(commands.UsageError{}))
+			Expect(client.CordonCellCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("drain", func() {
+		It("drains the cell", func() {
+			client.DrainCellReturns(&models.CellCordon{CellId: "cell-1", Draining: true}, nil)
+			Expect(run("drain", "cell-1")).To(Succeed())
+
+			_, cellID, reason := client.DrainCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+			Expect(reason).To(BeEmpty())
+			Expect(stdout.String()).To(MatchRegexp(`cell-1\s+draining`))
+		})
+	})
+
+	Describe("uncordon", func() {
+		It("surfaces errors from the BBS", func() {
+			client.UncordonCellReturns(models.ErrResourceNotFound)
+			Expect(run("uncordon", "cell-1")).To(Equal(models.ErrResourceNotFound))
+
+			_, cellID := client.UncordonCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+		})
+	})
+
+	Describe("desire-task", func() {
+		It("desires the task read from stdin", func() {
+			task := model_helpers.NewValidTask("some-task")
+			payload, err := json.Marshal(task)
+			Expect(err).NotTo(HaveOccurred())
+			stdin.Write(payload)
+
+			Expect(run("desire-task", "-")).To(Succeed())
+
+			Expect(client.DesireTaskCallCount()).To(Equal(1))
+			_, guid, domain, definition := client.DesireTaskArgsForCall(0)
+			Expect(guid).To(Equal("some-task"))
+			Expect(domain).To(Equal(task.Domain))
+			Expect(definition).To(Equal(task.TaskDefinition))
+		})
+
+		It("does not desire invalid tasks", func() {
+			stdin.WriteString(`{"task_guid": "some-task"}`)
+			Expect(run("desire-task", "-")).NotTo(Succeed())
+			Expect(client.DesireTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("cancel-task", func() {
+		It("surfaces errors from the BBS", func() {
+			client.CancelTaskReturns(models.ErrResourceNotFound)
+			Expect(run("cancel-task", "some-task")).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("requires a task guid", func() {
+			Expect(run("cancel-task")).To(BeAssignableToTypeOf(commands.UsageError{}))
+		})
+	})
+
+	Describe("events", func() {
+		var source *eventfakes.FakeEventSource
+
+		BeforeEach(func() {
+			source = &eventfakes.FakeEventSource{}
+			task := model_helpers.NewValidTask("some-task")
+			source.NextReturnsOnCall(0, models.NewTaskCreatedEvent(task), nil)
+			source.NextReturnsOnCall(1, nil, events.ErrSourceClosed)
+			client.SubscribeToTaskEventsReturns(source, nil)
+		})
+
+		It("prints each event until the stream closes", func() {
+			Expect(run("events", "-tasks")).To(Succeed())
+
+			Expect(stdout.String()).To(ContainSubstring("task_created"))
+			Expect(stdout.String()).To(ContainSubstring("some-task"))
+			Expect(source.CloseCallCount()).To(Equal(1))
+		})
+
+		It("prints an object per event when asked for JSON", func() {
+			ctx.JSON = true
+			Expect(run("events", "-tasks")).To(Succeed())
+
+			var event struct {
+				Type  string                  `json:"type"`
+				Event models.TaskCreatedEvent `json:"event"`
+			}
+			Expect(json.Unmarshal(stdout.Bytes(), &event)).To(Succeed())
+			Expect(event.Type).To(Equal(models.EventTypeTaskCreated))
+			Expect(event.Event.Task.TaskGuid).To(Equal("some-task"))
+		})
+
+		It("filters instance events by cell", func() {
+			client.SubscribeToInstanceEventsByCellIDReturns(source, nil)
+			Expect(run("events", "-cell-id", "cell-1")).To(Succeed())
+
+			_, cellID := client.SubscribeToInstanceEventsByCellIDArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+		})
+	})
+})
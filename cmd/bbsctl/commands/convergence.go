@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+func convergencePlan(ctx *Context, flags *flag.FlagSet, args []string) error {
+	sampleSize := flags.Int("sample-size", models.DefaultConvergencePlanSampleSize, "number of keys or guids to list for each step")
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+	if *sampleSize < 0 {
+		return UsageError{Message: fmt.Sprintf("convergence-plan: invalid sample size %d", *sampleSize)}
+	}
+
+	plan, err := ctx.Client.ConvergencePlan(ctx.Logger, int32(*sampleSize))
+	if err != nil {
+		return err
+	}
+
+	if !ctx.JSON {
+		fmt.Fprintf(ctx.Stdout, "planned at: %s\n", formatTimestamp(plan.PlannedAt))
+		fmt.Fprintf(ctx.Stdout, "cells: %d\n", plan.CellCount)
+		fmt.Fprintf(ctx.Stdout, "missing cells: %s\n", strings.Join(plan.MissingCellIds, ","))
+		fmt.Fprintf(ctx.Stdout, "expired domains: %s\n", strings.Join(plan.ExpiredDomains, ","))
+		if plan.ConvergencePaused {
+			fmt.Fprintln(ctx.Stdout, "convergence: paused in maintenance mode")
+		}
+		fmt.Fprintln(ctx.Stdout)
+	}
+
+	rows := [][]string{}
+	lrpRow := func(step string, sample *models.ActualLRPKeySample) {
+		keys := []string{}
+		for _, key := range sample.GetSamples() {
+			keys = append(keys, fmt.Sprintf("%s/%d", key.ProcessGuid, key.Index))
+		}
+		rows = append(rows, []string{step, strconv.Itoa(int(sample.GetCount())), strings.Join(keys, ",")})
+	}
+	taskRow := func(step string, sample *models.TaskGuidSample) {
+		rows = append(rows, []string{step, strconv.Itoa(int(sample.GetCount())), strings.Join(sample.GetTaskGuids(), ",")})
+	}
+
+	lrps := plan.LRPs
+	lrpRow("create missing LRPs", lrps.GetMissingInstancesToCreate())
+	lrpRow("start unclaimed LRPs", lrps.GetInstancesToStart())
+	lrpRow("retire extra LRPs", lrps.GetInstancesToRetire())
+	lrpRow("mark LRPs suspect", lrps.GetInstancesToMarkSuspect())
+	lrpRow("unclaim LRPs", lrps.GetInstancesToUnclaim())
+	lrpRow("restore suspect LRPs", lrps.GetSuspectInstancesToRestore())
+	lrpRow("remove suspect LRPs", lrps.GetSuspectInstancesToRemove())
+	lrpRow("remove evacuating LRPs", lrps.GetEvacuatingInstancesToRemove())
+	lrpRow("replace LRPs on draining cells", lrps.GetDrainingInstancesToReplace())
+	lrpRow("delay LRPs on draining cells", lrps.GetDrainingInstancesDelayed())
+
+	tasks := plan.Tasks
+	taskRow("fail expired pending tasks", tasks.GetExpiredPendingTasksToFail())
+	taskRow("kick pending tasks", tasks.GetPendingTasksToKick())
+	taskRow("fail tasks on missing cells", tasks.GetTasksWithMissingCellsToFail())
+	taskRow("demote resolving tasks", tasks.GetResolvingTasksToDemote())
+	taskRow("delete completed tasks", tasks.GetCompletedTasksToDelete())
+	taskRow("kick completed tasks", tasks.GetCompletedTasksToKick())
+	taskRow("delete invalid tasks", tasks.GetInvalidTasksToDelete())
+
+	return ctx.write(plan, []string{"STEP", "COUNT", "SAMPLES"}, rows)
+}
+
+func safetyValve(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	status, err := ctx.Client.ConvergenceSafetyValve(ctx.Logger)
+	if err != nil {
+		return err
+	}
+	return writeSafetyValveStatus(ctx, status)
+}
+
+func overrideSafetyValve(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	status, err := ctx.Client.OverrideConvergenceSafetyValve(ctx.Logger)
+	if err != nil {
+		return err
+	}
+	return writeSafetyValveStatus(ctx, status)
+}
+
+func writeSafetyValveStatus(ctx *Context, status *models.ConvergenceSafetyValveStatus) error {
+	if !ctx.JSON {
+		fmt.Fprintf(ctx.Stdout, "max missing cell percent: %d\n", status.MaxMissingCellPercent)
+		fmt.Fprintf(ctx.Stdout, "confirm passes: %d\n", status.ConfirmPasses)
+		fmt.Fprintln(ctx.Stdout)
+	}
+
+	rows := [][]string{}
+	for _, valve := range status.Valves {
+		rows = append(rows, []string{
+			valve.Convergence,
+			strconv.FormatBool(valve.Tripped),
+			strconv.Itoa(int(valve.TrippedPasses)),
+			strconv.FormatBool(valve.Overridden),
+			strconv.Itoa(int(valve.AcceptedCellCount)),
+			strings.Join(valve.MissingCellIds, ","),
+		})
+	}
+
+	return ctx.write(status, []string{"CONVERGENCE", "TRIPPED", "PASSES", "OVERRIDDEN", "CELLS", "MISSING CELLS"}, rows)
+}
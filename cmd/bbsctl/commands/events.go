@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+type jsonEvent struct {
+	Type  string       `json:"type"`
+	Event models.Event `json:"event"`
+}
+
+func tailEvents(ctx *Context, flags *flag.FlagSet, args []string) error {
+	taskEvents := flags.Bool("tasks", false, "tail task events rather than LRP instance events")
+	cellID := flags.String("cell-id", "", "only tail LRP instance events for this cell")
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	var source events.EventSource
+	switch {
+	case *taskEvents && *cellID != "":
+		return UsageError{Message: "events: -cell-id cannot be used with -tasks"}
+	case *taskEvents:
+		source, err = ctx.Client.SubscribeToTaskEvents(ctx.Logger)
+	case *cellID != "":
+		source, err = ctx.Client.SubscribeToInstanceEventsByCellID(ctx.Logger, *cellID)
+	default:
+		source, err = ctx.Client.SubscribeToInstanceEvents(ctx.Logger)
+	}
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	encoder := json.NewEncoder(ctx.Stdout)
+	for {
+		event, err := source.Next()
+		if err == events.ErrSourceClosed {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if ctx.JSON {
+			err = encoder.Encode(jsonEvent{Type: event.EventType(), Event: event})
+		} else {
+			_, err = fmt.Fprintf(ctx.Stdout, "%s  %-27s  %s  %s\n", time.Now().UTC().Format(time.RFC3339), event.EventType(), event.Key(), describeEvent(event))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// describeEvent summarises what changed.
+func describeEvent(event models.Event) string {
+	switch event := event.(type) {
+	case *models.DesiredLRPCreatedEvent:
+		return fmt.Sprintf("instances=%d", event.DesiredLrp.GetInstances())
+	case *models.DesiredLRPChangedEvent:
+		return fmt.Sprintf("instances=%d->%d", event.Before.GetInstances(), event.After.GetInstances())
+	case *models.DesiredLRPRemovedEvent:
+		return fmt.Sprintf("process_guid=%s", event.DesiredLrp.GetProcessGuid())
+	case *models.ActualLRPInstanceCreatedEvent:
+		return describeActualLRP(event.ActualLrp)
+	case *models.ActualLRPInstanceRemovedEvent:
+		return describeActualLRP(event.ActualLrp)
+	case *models.ActualLRPInstanceChangedEvent:
+		return fmt.Sprintf("process_guid=%s index=%d cell=%s state=%s->%s",
+			event.ProcessGuid, event.Index, event.CellId, event.Before.GetState(), event.After.GetState())
+	case *models.ActualLRPCrashedEvent:
+		return fmt.Sprintf("process_guid=%s index=%d cell=%s crash_count=%d reason=%q",
+			event.ProcessGuid, event.Index, event.CellId, event.CrashCount, event.CrashReason)
+	case *models.TaskCreatedEvent:
+		return fmt.Sprintf("domain=%s state=%s", event.Task.GetDomain(), event.Task.GetState())
+	case *models.TaskChangedEvent:
+		return fmt.Sprintf("state=%s->%s", event.Before.GetState(), event.After.GetState())
+	case *models.TaskRemovedEvent:
+		return fmt.Sprintf("state=%s", event.Task.GetState())
+	default:
+		return ""
+	}
+}
+
+func describeActualLRP(lrp *models.ActualLRP) string {
+	if lrp == nil {
+		return ""
+	}
+	return fmt.Sprintf("process_guid=%s index=%d cell=%s state=%s", lrp.ProcessGuid, lrp.Index, lrp.CellId, lrp.State)
+}
@@ -0,0 +1,264 @@
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+func actualLRPs(ctx *Context, flags *flag.FlagSet, args []string) error {
+	domain := flags.String("domain", "", "only list LRPs in this domain")
+	cellID := flags.String("cell-id", "", "only list LRPs on this cell")
+	processGuid := flags.String("process-guid", "", "only list LRPs with this process guid")
+	index := flags.Int("index", -1, "only list the instance with this index, requires -process-guid")
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	filter := models.ActualLRPFilter{Domain: *domain, CellID: *cellID, ProcessGuid: *processGuid}
+	if *index >= 0 {
+		if *processGuid == "" {
+			return UsageError{Message: "actual-lrps: -index requires -process-guid"}
+		}
+		i := int32(*index)
+		filter.Index = &i
+	}
+
+	lrps, err := ctx.Client.ActualLRPs(ctx.Logger, filter)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{}
+	for _, lrp := range lrps {
+		rows = append(rows, []string{
+			lrp.ProcessGuid,
+			strconv.Itoa(int(lrp.Index)),
+			lrp.Domain,
+			lrp.State,
+			lrp.Presence.String(),
+			lrp.CellId,
+			lrp.Address,
+			strconv.Itoa(int(lrp.CrashCount)),
+			formatTimestamp(lrp.Since),
+		})
+	}
+
+	return ctx.write(lrps, []string{"PROCESS GUID", "INDEX", "DOMAIN", "STATE", "PRESENCE", "CELL", "ADDRESS", "CRASHES", "SINCE"}, rows)
+}
+
+func desiredLRPs(ctx *Context, flags *flag.FlagSet, args []string) error {
+	domain := flags.String("domain", "", "only list LRPs in this domain")
+	err := parseFlags(flags, args, 0, -1)
+	if err != nil {
+		return err
+	}
+
+	lrps, err := ctx.Client.DesiredLRPs(ctx.Logger, models.DesiredLRPFilter{Domain: *domain, ProcessGuids: flags.Args()})
+	if err != nil {
+		return err
+	}
+
+	return ctx.writeDesiredLRPs(lrps, lrps)
+}
+
+func desiredLRP(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	lrp, err := ctx.Client.DesiredLRPByProcessGuid(ctx.Logger, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return ctx.writeDesiredLRPs(lrp, []*models.DesiredLRP{lrp})
+}
+
+func (c *Context) writeDesiredLRPs(value interface{}, lrps []*models.DesiredLRP) error {
+	rows := [][]string{}
+	for _, lrp := range lrps {
+		rows = append(rows, []string{
+			lrp.ProcessGuid,
+			lrp.Domain,
+			strconv.Itoa(int(lrp.Instances)),
+			strconv.Itoa(int(lrp.MemoryMb)),
+			strconv.Itoa(int(lrp.DiskMb)),
+			lrp.RootFs,
+		})
+	}
+
+	return c.write(value, []string{"PROCESS GUID", "DOMAIN", "INSTANCES", "MEMORY MB", "DISK MB", "ROOTFS"}, rows)
+}
+
+func disruptionBudget(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	status, err := ctx.Client.DisruptionBudgetStatus(ctx.Logger, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	budget := "none"
+	switch {
+	case status.Budget.GetMinAvailable() > 0:
+		budget = fmt.Sprintf("min available %d", status.Budget.GetMinAvailable())
+	case status.Budget.GetMaxUnavailable() > 0:
+		budget = fmt.Sprintf("max unavailable %d", status.Budget.GetMaxUnavailable())
+	}
+
+	delayed := []string{}
+	for _, index := range status.DelayedDrainingIndices {
+		delayed = append(delayed, strconv.Itoa(int(index)))
+	}
+
+	rows := [][]string{{
+		status.ProcessGuid,
+		budget,
+		strconv.Itoa(int(status.DesiredInstances)),
+		strconv.Itoa(int(status.AvailableInstances)),
+		strconv.Itoa(int(status.AllowedDisruptions)),
+		strings.Join(delayed, ","),
+		strings.Join(status.ReservedInstanceGuids, ","),
+	}}
+
+	return ctx.write(status, []string{"PROCESS GUID", "BUDGET", "DESIRED", "AVAILABLE", "ALLOWED DISRUPTIONS", "DELAYED DRAINING", "RESERVED"}, rows)
+}
+
+func desireLRP(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	lrp := &models.DesiredLRP{}
+	err = ctx.readJSON(flags.Arg(0), lrp)
+	if err != nil {
+		return err
+	}
+
+	err = lrp.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Client.DesireLRP(ctx.Logger, lrp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Stderr, "desired LRP %s\n", lrp.ProcessGuid)
+	return nil
+}
+
+func retireActualLRP(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 2, 2)
+	if err != nil {
+		return err
+	}
+
+	processGuid := flags.Arg(0)
+	index, err := strconv.ParseInt(flags.Arg(1), 10, 32)
+	if err != nil || index < 0 {
+		return UsageError{Message: fmt.Sprintf("retire-actual-lrp: invalid index %q", flags.Arg(1))}
+	}
+	i := int32(index)
+
+	lrps, err := ctx.Client.ActualLRPs(ctx.Logger, models.ActualLRPFilter{ProcessGuid: processGuid, Index: &i})
+	if err != nil {
+		return err
+	}
+	if len(lrps) == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	key := lrps[0].ActualLRPKey
+	err = ctx.Client.RetireActualLRP(ctx.Logger, &key)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Stderr, "retired %s/%d\n", processGuid, index)
+	return nil
+}
+
+func explainActualLRP(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 2, 2)
+	if err != nil {
+		return err
+	}
+
+	processGuid := flags.Arg(0)
+	index, err := strconv.ParseInt(flags.Arg(1), 10, 32)
+	if err != nil || index < 0 {
+		return UsageError{Message: fmt.Sprintf("explain-actual-lrp: invalid index %q", flags.Arg(1))}
+	}
+
+	explanation, err := ctx.Client.ExplainActualLRP(ctx.Logger, processGuid, int32(index))
+	if err != nil {
+		return err
+	}
+
+	if !ctx.JSON {
+		desired := "no"
+		if explanation.Desired {
+			desired = fmt.Sprintf("yes, %d instances", explanation.DesiredInstances)
+		}
+		fresh := "not fresh"
+		if explanation.FreshDomain {
+			fresh = "fresh"
+		}
+		fmt.Fprintf(ctx.Stdout, "%s/%d\n", explanation.ProcessGuid, explanation.Index)
+		fmt.Fprintf(ctx.Stdout, "desired: %s\n", desired)
+		fmt.Fprintf(ctx.Stdout, "domain: %s (%s)\n", explanation.Domain, fresh)
+		fmt.Fprintf(ctx.Stdout, "convergence: %s\n", describeConvergence(explanation.ConvergenceAction, explanation.ConvergenceReason))
+		if explanation.ConvergencePaused {
+			fmt.Fprintln(ctx.Stdout, "convergence is paused in maintenance mode")
+		}
+		fmt.Fprintln(ctx.Stdout)
+	}
+
+	rows := [][]string{}
+	for _, instance := range explanation.Instances {
+		lrp := instance.ActualLrp
+		nextRestart := formatTimestamp(instance.NextRestartAt)
+		if instance.RestartsExhausted {
+			nextRestart = "never"
+		}
+		rows = append(rows, []string{
+			lrp.Presence.String(),
+			lrp.State,
+			lrp.CellId,
+			strconv.FormatBool(instance.CellPresent),
+			instance.AuctionStatus,
+			strconv.Itoa(int(lrp.CrashCount)),
+			nextRestart,
+			describeConvergence(instance.ConvergenceAction, instance.ConvergenceReason),
+		})
+	}
+
+	return ctx.write(explanation, []string{"PRESENCE", "STATE", "CELL", "CELL PRESENT", "AUCTION", "CRASHES", "NEXT RESTART", "CONVERGENCE"}, rows)
+}
+
+func describeConvergence(action, reason string) string {
+	if reason == "" {
+		return action
+	}
+	return fmt.Sprintf("%s: %s", action, reason)
+}
+
+func formatTimestamp(nanos int64) string {
+	if nanos == 0 {
+		return ""
+	}
+	return time.Unix(0, nanos).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"flag"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+func maintenance(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 0, -1)
+	if err != nil {
+		return err
+	}
+
+	var mode *models.MaintenanceMode
+	switch {
+	case flags.NArg() == 0:
+		mode, err = ctx.Client.MaintenanceMode(ctx.Logger)
+	case flags.Arg(0) == "on" && flags.NArg() > 1:
+		mode, err = ctx.Client.SetMaintenanceMode(ctx.Logger, true, strings.Join(flags.Args()[1:], " "))
+	case flags.Arg(0) == "off" && flags.NArg() == 1:
+		mode, err = ctx.Client.SetMaintenanceMode(ctx.Logger, false, "")
+	default:
+		flags.Usage()
+		return UsageError{Message: "maintenance: expected no arguments, on REASON, or off"}
+	}
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{{strconv.FormatBool(mode.Enabled), mode.Reason, formatTimestamp(mode.UpdatedAt)}}
+
+	return ctx.write(mode, []string{"ENABLED", "REASON", "UPDATED AT"}, rows)
+}
@@ -0,0 +1 @@
+package commands // import "code.cloudfoundry.org/bbs/cmd/bbsctl/commands"
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/durationjson"
+)
+
+// Profile holds the address of a BBS and the TLS settings to reach it.
+type Profile struct {
+	URL            string                `json:"url"`
+	CACertFile     string                `json:"ca_cert_file,omitempty"`
+	ClientCertFile string                `json:"client_cert_file,omitempty"`
+	ClientKeyFile  string                `json:"client_key_file,omitempty"`
+	SkipCertVerify bool                  `json:"skip_cert_verify,omitempty"`
+	RequestTimeout durationjson.Duration `json:"request_timeout,omitempty"`
+}
+
+func LoadProfile(path string) (Profile, error) {
+	profile := Profile{}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return profile, err
+	}
+	defer file.Close()
+
+	err = json.NewDecoder(file).Decode(&profile)
+	if err != nil {
+		return profile, err
+	}
+
+	return profile, profile.Validate()
+}
+
+func (p Profile) Validate() error {
+	if p.URL == "" {
+		return errors.New("url is required")
+	}
+
+	bbsURL, err := url.Parse(p.URL)
+	if err != nil {
+		return err
+	}
+
+	switch bbsURL.Scheme {
+	case "http":
+	case "https":
+		if p.ClientCertFile == "" || p.ClientKeyFile == "" {
+			return errors.New("client_cert_file and client_key_file are required for https urls")
+		}
+		if p.CACertFile == "" && !p.SkipCertVerify {
+			return errors.New("ca_cert_file is required for https urls unless skip_cert_verify is set")
+		}
+	default:
+		return errors.New("url must be http or https")
+	}
+
+	return nil
+}
+
+func (p Profile) NewClient() (bbs.InternalClient, error) {
+	bbsURL, err := url.Parse(p.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	return bbs.NewClientWithConfig(bbs.ClientConfig{
+		URL:                p.URL,
+		IsTLS:              bbsURL.Scheme == "https",
+		CAFile:             p.CACertFile,
+		CertFile:           p.ClientCertFile,
+		KeyFile:            p.ClientKeyFile,
+		InsecureSkipVerify: p.SkipCertVerify,
+		RequestTimeout:     time.Duration(p.RequestTimeout),
+	})
+}
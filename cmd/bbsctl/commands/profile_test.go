@@ -0,0 +1,99 @@
+package commands_test
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/cmd/bbsctl/commands"
+	"code.cloudfoundry.org/durationjson"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profile", func() {
+	var profilePath string
+
+	writeProfile := func(data string) {
+		err := ioutil.WriteFile(profilePath, []byte(data), 0600)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		file, err := ioutil.TempFile("", "bbsctl-profile")
+		Expect(err).NotTo(HaveOccurred())
+		profilePath = file.Name()
+		file.Close()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(profilePath)
+	})
+
+	It("loads the URL and TLS settings", func() {
+		writeProfile(`{
+			"url": "https://bbs.service.cf.internal:8889",
+			"ca_cert_file": "/ca.crt",
+			"client_cert_file": "/client.crt",
+			"client_key_file": "/client.key",
+			"request_timeout": "10s"
+		}`)
+
+		profile, err := commands.LoadProfile(profilePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile).To(Equal(commands.Profile{
+			URL:            "https://bbs.service.cf.internal:8889",
+			CACertFile:     "/ca.crt",
+			ClientCertFile: "/client.crt",
+			ClientKeyFile:  "/client.key",
+			RequestTimeout: durationjson.Duration(10 * time.Second),
+		}))
+	})
+
+	It("creates a client for plain http URLs", func() {
+		profile := commands.Profile{URL: "http://127.0.0.1:8889"}
+		Expect(profile.Validate()).To(Succeed())
+
+		client, err := profile.NewClient()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client).NotTo(BeNil())
+	})
+
+	Context("when the file is missing", func() {
+		It("errors", func() {
+			_, err := commands.LoadProfile("/does/not/exist")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when the profile is invalid", func() {
+		It("requires a URL", func() {
+			writeProfile(`{}`)
+			_, err := commands.LoadProfile(profilePath)
+			Expect(err).To(MatchError("url is required"))
+		})
+
+		It("requires a client certificate for https URLs", func() {
+			writeProfile(`{"url": "https://bbs.service.cf.internal:8889", "ca_cert_file": "/ca.crt"}`)
+			_, err := commands.LoadProfile(profilePath)
+			Expect(err).To(MatchError(ContainSubstring("client_cert_file and client_key_file are required")))
+		})
+
+		It("requires a CA certificate unless verification is skipped", func() {
+			writeProfile(`{"url": "https://bbs.service.cf.internal:8889", "client_cert_file": "/client.crt", "client_key_file": "/client.key"}`)
+			_, err := commands.LoadProfile(profilePath)
+			Expect(err).To(MatchError(ContainSubstring("ca_cert_file is required")))
+
+			writeProfile(`{"url": "https://bbs.service.cf.internal:8889", "client_cert_file": "/client.crt", "client_key_file": "/client.key", "skip_cert_verify": true}`)
+			_, err = commands.LoadProfile(profilePath)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects other schemes", func() {
+			writeProfile(`{"url": "ftp://bbs.service.cf.internal"}`)
+			_, err := commands.LoadProfile(profilePath)
+			Expect(err).To(MatchError("url must be http or https"))
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+func tasks(ctx *Context, flags *flag.FlagSet, args []string) error {
+	domain := flags.String("domain", "", "only list tasks in this domain")
+	cellID := flags.String("cell-id", "", "only list tasks on this cell")
+	err := parseFlags(flags, args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	tasks, err := ctx.Client.TasksWithFilter(ctx.Logger, models.TaskFilter{Domain: *domain, CellID: *cellID})
+	if err != nil {
+		return err
+	}
+
+	return ctx.writeTasks(tasks, tasks)
+}
+
+func task(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	task, err := ctx.Client.TaskByGuid(ctx.Logger, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return ctx.writeTasks(task, []*models.Task{task})
+}
+
+func (c *Context) writeTasks(value interface{}, tasks []*models.Task) error {
+	rows := [][]string{}
+	for _, task := range tasks {
+		rows = append(rows, []string{
+			task.TaskGuid,
+			task.Domain,
+			task.State.String(),
+			task.CellId,
+			strconv.FormatBool(task.Failed),
+			task.FailureReason,
+			formatTimestamp(task.CreatedAt),
+		})
+	}
+
+	return c.write(value, []string{"TASK GUID", "DOMAIN", "STATE", "CELL", "FAILED", "FAILURE REASON", "CREATED"}, rows)
+}
+
+func desireTask(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	task := &models.Task{}
+	err = ctx.readJSON(flags.Arg(0), task)
+	if err != nil {
+		return err
+	}
+
+	request := &models.DesireTaskRequest{TaskGuid: task.TaskGuid, Domain: task.Domain, TaskDefinition: task.TaskDefinition}
+	err = request.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Client.DesireTask(ctx.Logger, task.TaskGuid, task.Domain, task.TaskDefinition)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Stderr, "desired task %s\n", task.TaskGuid)
+	return nil
+}
+
+func cancelTask(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Client.CancelTask(ctx.Logger, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Stderr, "cancelled task %s\n", flags.Arg(0))
+	return nil
+}
+
+func deleteTask(ctx *Context, flags *flag.FlagSet, args []string) error {
+	err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.Client.DeleteTask(ctx.Logger, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.Stderr, "deleted task %s\n", flags.Arg(0))
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/bbs/cmd/bbsctl/commands"
+	"code.cloudfoundry.org/lager"
+)
+
+const profileEnvVar = "BBSCTL_PROFILE"
+
+var profilePath = flag.String(
+	"profile",
+	defaultProfilePath(),
+	"The path to the JSON profile holding the BBS URL and TLS settings, $"+profileEnvVar+" when set.",
+)
+
+var jsonOutput = flag.Bool(
+	"json",
+	false,
+	"Print JSON instead of tables.",
+)
+
+var debug = flag.Bool(
+	"debug",
+	false,
+	"Log the requests to the BBS to stderr.",
+)
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	command, ok := commands.Find(flag.Arg(0))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "bbsctl: unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	logger := lager.NewLogger("bbsctl")
+	if *debug {
+		logger.RegisterSink(lager.NewWriterSink(os.Stderr, lager.DEBUG))
+	}
+
+	profile, err := commands.LoadProfile(*profilePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bbsctl: failed to load profile %s: %s\n", *profilePath, err)
+		os.Exit(1)
+	}
+
+	client, err := profile.NewClient()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bbsctl: failed to create client: %s\n", err)
+		os.Exit(1)
+	}
+
+	ctx := &commands.Context{
+		Logger: logger,
+		Client: client,
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		JSON:   *jsonOutput,
+	}
+
+	err = ctx.Run(command, flag.Args()[1:])
+	if err == commands.ErrHelp {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bbsctl: %s\n", err)
+		if _, ok := err.(commands.UsageError); ok {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: bbsctl [-profile PATH] [-json] [-debug] COMMAND [ARGS]\n\nCommands:\n")
+	commands.WriteUsage(os.Stderr)
+	fmt.Fprintf(os.Stderr, "\nRun bbsctl COMMAND -h for the flags of a command.\n\nGlobal flags:\n")
+	flag.PrintDefaults()
+}
+
+func defaultProfilePath() string {
+	if path := os.Getenv(profileEnvVar); path != "" {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".bbsctl.json"
+	}
+	return filepath.Join(home, ".bbsctl.json")
+}
@@ -0,0 +1,283 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+// ActualLRPExplainer explains the state of an ActualLRP, and what the next
+// convergence run would do to it according to the ConvergencePlanner.
+type ActualLRPExplainer struct {
+	lrpDB    db.LRPDB
+	domainDB db.DomainDB
+	planner  *ConvergencePlanner
+	clock    clock.Clock
+}
+
+func NewActualLRPExplainer(
+	lrpDB db.LRPDB,
+	domainDB db.DomainDB,
+	planner *ConvergencePlanner,
+	clock clock.Clock,
+) *ActualLRPExplainer {
+	return &ActualLRPExplainer{
+		lrpDB:    lrpDB,
+		domainDB: domainDB,
+		planner:  planner,
+		clock:    clock,
+	}
+}
+
+// ExplainActualLRP explains the instances at the index of the process guid,
+// restricted to the given domains unless they are nil.
+func (e *ActualLRPExplainer) ExplainActualLRP(ctx context.Context, logger lager.Logger, processGuid string, index int32, domains []string) (*models.ActualLRPExplanation, error) {
+	logger = logger.Session("explain-actual-lrp", lager.Data{"process_guid": processGuid, "index": index})
+
+	schedulingInfos, err := e.lrpDB.DesiredLRPSchedulingInfos(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{processGuid}, Domains: domains})
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return nil, err
+	}
+
+	lrps, err := e.lrpDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: processGuid, Domains: domains})
+	if err != nil {
+		logger.Error("failed-fetching-actual-lrps", err)
+		return nil, err
+	}
+
+	var schedulingInfo *models.DesiredLRPSchedulingInfo
+	if len(schedulingInfos) > 0 {
+		schedulingInfo = schedulingInfos[0]
+	}
+
+	indexLRPs := []*models.ActualLRP{}
+	for _, lrp := range lrps {
+		if lrp.Index == index {
+			indexLRPs = append(indexLRPs, lrp)
+		}
+	}
+
+	if schedulingInfo == nil && len(indexLRPs) == 0 {
+		return nil, models.ErrResourceNotFound
+	}
+
+	freshDomains, err := e.domainDB.FreshDomains(ctx, logger)
+	if err != nil {
+		logger.Error("failed-fetching-domains", err)
+		return nil, err
+	}
+
+	plan, cellSet, err := e.planner.PlanActualLRPConvergence(ctx, logger, processGuid)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &explanation{
+		now:               e.clock.Now(),
+		restartCalculator: models.NewDefaultRestartCalculator(),
+		schedulingInfo:    schedulingInfo,
+		lrps:              lrps,
+		cellSet:           cellSet,
+		freshDomains:      map[string]bool{},
+		plan:              plan,
+	}
+	for _, domain := range freshDomains {
+		explanation.freshDomains[domain] = true
+	}
+
+	result := explanation.explain(processGuid, index, indexLRPs)
+	result.ConvergencePaused = e.planner.ConvergencePaused(ctx, logger)
+	return result, nil
+}
+
+// explanation takes the convergence actions from the plan of the process
+// guid, and explains why instances the plan leaves alone are left alone.
+type explanation struct {
+	now               time.Time
+	restartCalculator models.RestartCalculator
+	schedulingInfo    *models.DesiredLRPSchedulingInfo
+	lrps              []*models.ActualLRP
+	cellSet           models.CellSet
+	freshDomains      map[string]bool
+	plan              *models.LRPConvergencePlan
+}
+
+func (e *explanation) explain(processGuid string, index int32, indexLRPs []*models.ActualLRP) *models.ActualLRPExplanation {
+	result := &models.ActualLRPExplanation{
+		ProcessGuid: processGuid,
+		Index:       index,
+		Desired:     e.schedulingInfo != nil,
+		ExplainedAt: e.now.UnixNano(),
+	}
+
+	if e.schedulingInfo != nil {
+		result.Domain = e.schedulingInfo.Domain
+		result.DesiredInstances = e.schedulingInfo.Instances
+	} else {
+		result.Domain = indexLRPs[0].Domain
+	}
+	result.FreshDomain = e.freshDomains[result.Domain]
+
+	var ordinary *models.ActualLRPInstanceExplanation
+	for _, lrp := range indexLRPs {
+		instance := e.explainInstance(lrp, indexLRPs)
+		if lrp.Presence == models.ActualLRP_Ordinary {
+			ordinary = instance
+		}
+		result.Instances = append(result.Instances, instance)
+	}
+
+	switch {
+	case ordinary != nil:
+		result.ConvergenceAction = ordinary.ConvergenceAction
+		result.ConvergenceReason = ordinary.ConvergenceReason
+	case e.plan.MissingInstancesToCreate.HasIndex(index):
+		result.ConvergenceAction = models.ConvergenceActionCreateMissing
+		result.ConvergenceReason = "the instance is missing, so an UNCLAIMED instance is created and auctioned"
+	case e.schedulingInfo == nil:
+		result.ConvergenceAction = models.ConvergenceActionNone
+		result.ConvergenceReason = "the LRP is not desired"
+	case index >= e.schedulingInfo.Instances:
+		result.ConvergenceAction = models.ConvergenceActionNone
+		result.ConvergenceReason = fmt.Sprintf("only %d instances are desired", e.schedulingInfo.Instances)
+	case e.ordinaryCount() == e.schedulingInfo.Instances:
+		result.ConvergenceAction = models.ConvergenceActionNone
+		result.ConvergenceReason = "the number of instances matches the desired instances, so convergence does not look for missing indices"
+	default:
+		result.ConvergenceAction = models.ConvergenceActionNone
+		result.ConvergenceReason = "the instance is missing, but was not when convergence was planned"
+	}
+
+	return result
+}
+
+func (e *explanation) explainInstance(lrp *models.ActualLRP, indexLRPs []*models.ActualLRP) *models.ActualLRPInstanceExplanation {
+	instance := &models.ActualLRPInstanceExplanation{
+		ActualLrp:   lrp,
+		CellPresent: lrp.CellId != "" && e.cellSet.HasCellID(lrp.CellId),
+	}
+
+	if lrp.Presence == models.ActualLRP_Ordinary {
+		switch lrp.State {
+		case models.ActualLRPStateUnclaimed:
+			if lrp.PlacementError != "" {
+				instance.AuctionStatus = models.AuctionStatusFailed
+			} else {
+				instance.AuctionStatus = models.AuctionStatusPending
+			}
+		case models.ActualLRPStateClaimed, models.ActualLRPStateRunning:
+			instance.AuctionStatus = models.AuctionStatusPlaced
+		}
+	}
+
+	if lrp.State == models.ActualLRPStateCrashed {
+		instance.RestartEligible = lrp.ShouldRestartCrash(e.now, e.restartCalculator)
+		nextRestartAt, ok := e.restartCalculator.NextRestartTime(lrp.Since, lrp.CrashCount)
+		if ok {
+			instance.NextRestartAt = nextRestartAt
+		} else {
+			instance.RestartsExhausted = true
+		}
+	}
+
+	instance.ConvergenceAction, instance.ConvergenceReason = e.convergenceAction(lrp, indexLRPs)
+	return instance
+}
+
+// convergenceAction returns the action the plan takes on the instance, or
+// explains why it takes none.
+func (e *explanation) convergenceAction(lrp *models.ActualLRP, indexLRPs []*models.ActualLRP) (string, string) {
+	switch lrp.Presence {
+	case models.ActualLRP_Evacuating:
+		if e.plan.EvacuatingInstancesToRemove.HasIndex(lrp.Index) {
+			return models.ConvergenceActionRemoveEvacuating, "the evacuating cell is gone"
+		}
+		return models.ConvergenceActionNone, "the evacuating cell stops the instance once its replacement is running"
+
+	case models.ActualLRP_Suspect:
+		switch {
+		case e.plan.SuspectInstancesToRemove.HasIndex(lrp.Index):
+			if e.schedulingInfo == nil {
+				return models.ConvergenceActionRemoveSuspect, "the LRP is no longer desired"
+			}
+			return models.ConvergenceActionRemoveSuspect, "its replacement is running"
+		case e.plan.SuspectInstancesToRestore.HasIndex(lrp.Index):
+			return models.ConvergenceActionRestoreSuspect, "its cell is back, so the replacement is removed and the instance becomes ordinary again"
+		case e.draining(lrp.CellId):
+			return models.ConvergenceActionNone, "its cell is draining, so it is stopped once its replacement is running"
+		}
+		return models.ConvergenceActionNone, "waiting for the replacement to start"
+	}
+
+	switch {
+	case e.plan.InstancesToRetire.HasIndex(lrp.Index):
+		if e.schedulingInfo == nil {
+			return models.ConvergenceActionRetire, "the LRP is no longer desired"
+		}
+		return models.ConvergenceActionRetire, fmt.Sprintf("only %d instances are desired", e.schedulingInfo.Instances)
+	case e.plan.SuspectInstancesToRestore.HasIndex(lrp.Index):
+		return models.ConvergenceActionRemove, "the cell of the suspect instance it replaces is back"
+	case e.plan.InstancesToStart.HasIndex(lrp.Index):
+		if lrp.State == models.ActualLRPStateCrashed {
+			return models.ConvergenceActionRestartCrashed, "the crash backoff has passed, so it is unclaimed and auctioned"
+		}
+		return models.ConvergenceActionStartUnclaimed, fmt.Sprintf("UNCLAIMED for longer than %s, so the auction is requested again", models.StaleUnclaimedActualLRPDuration)
+	case e.plan.InstancesToUnclaim.HasIndex(lrp.Index):
+		return models.ConvergenceActionUnclaim, "its cell is gone and a suspect instance already exists, so it is unclaimed and auctioned"
+	case e.plan.InstancesToMarkSuspect.HasIndex(lrp.Index):
+		return models.ConvergenceActionMarkSuspect, "its cell is gone, so it becomes suspect and a replacement is auctioned"
+	case e.plan.DrainingInstancesToReplace.HasIndex(lrp.Index):
+		return models.ConvergenceActionMarkSuspect, "its cell is draining, so it becomes suspect and a replacement is auctioned"
+	case e.plan.DrainingInstancesDelayed.HasIndex(lrp.Index):
+		return models.ConvergenceActionNone, "its cell is draining, but the disruption budget of the LRP allows no more disruptions"
+	}
+
+	return models.ConvergenceActionNone, e.noActionReason(lrp)
+}
+
+// noActionReason explains why the plan leaves the ordinary instance alone.
+func (e *explanation) noActionReason(lrp *models.ActualLRP) string {
+	switch {
+	case e.schedulingInfo == nil:
+		return "the LRP is no longer desired, but its domain is not fresh"
+	case lrp.Index >= e.schedulingInfo.Instances && e.ordinaryCount() != e.schedulingInfo.Instances:
+		return fmt.Sprintf("only %d instances are desired, but the domain is not fresh", e.schedulingInfo.Instances)
+	}
+
+	switch lrp.State {
+	case models.ActualLRPStateUnclaimed:
+		return "waiting for the auction to place it"
+	case models.ActualLRPStateCrashed:
+		if _, ok := e.restartCalculator.NextRestartTime(lrp.Since, lrp.CrashCount); !ok {
+			return fmt.Sprintf("it crashed %d times and is not restarted again", lrp.CrashCount)
+		}
+		return "waiting for the crash backoff"
+	}
+
+	if lrp.State == models.ActualLRPStateRunning && e.draining(lrp.CellId) {
+		return "its cell is draining, and another instance of the LRP is moved first"
+	}
+
+	return ""
+}
+
+func (e *explanation) ordinaryCount() int32 {
+	count := int32(0)
+	for _, lrp := range e.lrps {
+		if lrp.Presence == models.ActualLRP_Ordinary {
+			count++
+		}
+	}
+	return count
+}
+
+func (e *explanation) draining(cellID string) bool {
+	cell, ok := e.cellSet[cellID]
+	return ok && cell.Draining
+}
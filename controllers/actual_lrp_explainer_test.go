@@ -0,0 +1,451 @@
+package controllers_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/controllers/fakes"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ActualLRPExplainer", func() {
+	var (
+		fakeClock    *fakeclock.FakeClock
+		fakeLRPDB    *dbfakes.FakeLRPDB
+		fakeDomainDB *dbfakes.FakeDomainDB
+
+		fakeCellCordonDB    *dbfakes.FakeCellCordonDB
+		fakeMaintenanceMode *fakes.FakeMaintenanceMode
+
+		schedulingInfo models.DesiredLRPSchedulingInfo
+		actualLRP      *models.ActualLRP
+		cellSet        models.CellSet
+		key            *models.ActualLRPKey
+		plan           db.LRPConvergencePlan
+
+		explainer   *controllers.ActualLRPExplainer
+		explanation *models.ActualLRPExplanation
+		err         error
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Unix(0, 1000*int64(time.Minute)))
+		fakeLRPDB = new(dbfakes.FakeLRPDB)
+		fakeDomainDB = new(dbfakes.FakeDomainDB)
+		fakeCellCordonDB = new(dbfakes.FakeCellCordonDB)
+		fakeMaintenanceMode = new(fakes.FakeMaintenanceMode)
+
+		schedulingInfo = model_helpers.NewValidDesiredLRP("some-guid").DesiredLRPSchedulingInfo()
+		schedulingInfo.Instances = 1
+		fakeLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+
+		actualLRP = model_helpers.NewValidActualLRP("some-guid", 0)
+		actualLRP.CellId = "cell-id"
+		fakeLRPDB.ActualLRPsReturns([]*models.ActualLRP{actualLRP}, nil)
+
+		fakeDomainDB.FreshDomainsReturns([]string{"some-domain"}, nil)
+
+		cellPresence := models.NewCellPresence("cell-id", "1.1.1.1", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+		cellSet = models.CellSet{"cell-id": &cellPresence}
+		fakeServiceClient.CellsReturns(cellSet, nil)
+
+		key = &models.ActualLRPKey{ProcessGuid: "some-guid", Index: 0, Domain: "some-domain"}
+		plan = db.LRPConvergencePlan{}
+
+		cellCordons := controllers.NewCellCordonController(fakeCellCordonDB, fakeClock)
+		planner := controllers.NewConvergencePlanner(fakeLRPDB, new(dbfakes.FakeTaskDB), fakeServiceClient, fakeClock, 30*time.Second, time.Minute, 2*time.Minute, cellCordons, nil, fakeMaintenanceMode)
+		explainer = controllers.NewActualLRPExplainer(fakeLRPDB, fakeDomainDB, planner, fakeClock)
+	})
+
+	JustBeforeEach(func() {
+		fakeLRPDB.PlanLRPConvergenceInScopeReturns(plan)
+		explanation, err = explainer.ExplainActualLRP(ctx, logger, "some-guid", 0, []string{"some-domain"})
+	})
+
+	It("reports that convergence runs", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(explanation.ConvergencePaused).To(BeFalse())
+	})
+
+	Context("when the BBS is in maintenance mode", func() {
+		BeforeEach(func() {
+			fakeMaintenanceMode.EnabledReturns(true)
+		})
+
+		It("reports that convergence is paused", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.ConvergencePaused).To(BeTrue())
+		})
+	})
+
+	It("fetches the desired and actual LRPs of the process guid in the domains", func() {
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+		_, _, desiredFilter := fakeLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+		Expect(desiredFilter).To(Equal(models.DesiredLRPFilter{ProcessGuids: []string{"some-guid"}, Domains: []string{"some-domain"}}))
+
+		Expect(fakeLRPDB.ActualLRPsCallCount()).To(Equal(1))
+		_, _, actualFilter := fakeLRPDB.ActualLRPsArgsForCall(0)
+		Expect(actualFilter).To(Equal(models.ActualLRPFilter{ProcessGuid: "some-guid", Domains: []string{"some-domain"}}))
+	})
+
+	It("explains a running instance on a present cell", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(explanation.ProcessGuid).To(Equal("some-guid"))
+		Expect(explanation.Index).To(BeEquivalentTo(0))
+		Expect(explanation.Domain).To(Equal("some-domain"))
+		Expect(explanation.Desired).To(BeTrue())
+		Expect(explanation.DesiredInstances).To(BeEquivalentTo(1))
+		Expect(explanation.FreshDomain).To(BeTrue())
+		Expect(explanation.ExplainedAt).To(Equal(fakeClock.Now().UnixNano()))
+		Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+
+		Expect(explanation.Instances).To(HaveLen(1))
+		instance := explanation.Instances[0]
+		Expect(instance.ActualLrp).To(Equal(actualLRP))
+		Expect(instance.CellPresent).To(BeTrue())
+		Expect(instance.AuctionStatus).To(Equal(models.AuctionStatusPlaced))
+	})
+
+	It("plans convergence of the process guid with the current cells", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeLRPDB.PlanLRPConvergenceCallCount()).To(Equal(0))
+		Expect(fakeLRPDB.PlanLRPConvergenceInScopeCallCount()).To(Equal(1))
+		_, _, plannedCells, scope := fakeLRPDB.PlanLRPConvergenceInScopeArgsForCall(0)
+		Expect(plannedCells).To(Equal(cellSet))
+		Expect(scope).To(Equal(db.LRPConvergenceScope{ProcessGuids: []string{"some-guid"}, AllCells: true}))
+	})
+
+	Context("when the plan acts on another LRP with the same index", func() {
+		BeforeEach(func() {
+			otherKey := models.NewActualLRPKey("other-guid", 0, "some-domain")
+			plan.KeysToRetire = []*models.ActualLRPKey{&otherKey}
+		})
+
+		It("does not attribute the action to this LRP", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+		})
+	})
+
+	Context("when planning fails to list the cells", func() {
+		BeforeEach(func() {
+			fakeServiceClient.CellsReturns(nil, errors.New("boom"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+
+	Context("when neither a desired LRP nor an instance exists", func() {
+		BeforeEach(func() {
+			fakeLRPDB.DesiredLRPSchedulingInfosReturns(nil, nil)
+			fakeLRPDB.ActualLRPsReturns(nil, nil)
+		})
+
+		It("returns a resource not found error", func() {
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Context("when fetching the actual LRPs fails", func() {
+		BeforeEach(func() {
+			fakeLRPDB.ActualLRPsReturns(nil, errors.New("boom"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+
+	Context("when the instance is crashed", func() {
+		BeforeEach(func() {
+			actualLRP.State = models.ActualLRPStateCrashed
+			actualLRP.CrashCount = 3
+			actualLRP.Since = fakeClock.Now().Add(-10 * time.Second).UnixNano()
+		})
+
+		It("reports when it restarts", func() {
+			Expect(err).NotTo(HaveOccurred())
+			instance := explanation.Instances[0]
+			Expect(instance.RestartEligible).To(BeFalse())
+			Expect(instance.NextRestartAt).To(Equal(fakeClock.Now().Add(20 * time.Second).UnixNano()))
+			Expect(instance.RestartsExhausted).To(BeFalse())
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+		})
+
+		Context("and the backoff has passed", func() {
+			BeforeEach(func() {
+				actualLRP.Since = fakeClock.Now().Add(-time.Minute).UnixNano()
+				plan.UnstartedLRPKeys = []*models.ActualLRPKeyWithSchedulingInfo{{Key: key}}
+			})
+
+			It("restarts it", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation.Instances[0].RestartEligible).To(BeTrue())
+				Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionRestartCrashed))
+			})
+		})
+
+		Context("and it has used up its restarts", func() {
+			BeforeEach(func() {
+				actualLRP.CrashCount = models.DefaultMaxRestarts
+			})
+
+			It("reports that it is not restarted again", func() {
+				Expect(err).NotTo(HaveOccurred())
+				instance := explanation.Instances[0]
+				Expect(instance.RestartEligible).To(BeFalse())
+				Expect(instance.NextRestartAt).To(BeZero())
+				Expect(instance.RestartsExhausted).To(BeTrue())
+				Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+			})
+		})
+	})
+
+	Context("when the instance is unclaimed", func() {
+		BeforeEach(func() {
+			actualLRP.State = models.ActualLRPStateUnclaimed
+			actualLRP.ActualLRPInstanceKey = models.ActualLRPInstanceKey{}
+			actualLRP.Since = fakeClock.Now().UnixNano()
+		})
+
+		It("reports a pending auction", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.Instances[0].AuctionStatus).To(Equal(models.AuctionStatusPending))
+			Expect(explanation.Instances[0].CellPresent).To(BeFalse())
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+		})
+
+		Context("and the auction failed to place it", func() {
+			BeforeEach(func() {
+				actualLRP.PlacementError = "insufficient resources"
+			})
+
+			It("reports a failed auction", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation.Instances[0].AuctionStatus).To(Equal(models.AuctionStatusFailed))
+			})
+		})
+
+		Context("and it is stale", func() {
+			BeforeEach(func() {
+				actualLRP.Since = fakeClock.Now().Add(-time.Minute).UnixNano()
+				plan.UnstartedLRPKeys = []*models.ActualLRPKeyWithSchedulingInfo{{Key: key}}
+			})
+
+			It("requests the auction again", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionStartUnclaimed))
+			})
+		})
+	})
+
+	Context("when the cell of the instance is missing", func() {
+		BeforeEach(func() {
+			actualLRP.CellId = "missing-cell"
+			plan.KeysWithMissingCells = []*models.ActualLRPKeyWithSchedulingInfo{{Key: key}}
+		})
+
+		It("marks the instance suspect", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.Instances[0].CellPresent).To(BeFalse())
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionMarkSuspect))
+		})
+
+		Context("and a suspect instance already exists", func() {
+			BeforeEach(func() {
+				suspect := model_helpers.NewValidActualLRP("some-guid", 0)
+				suspect.Presence = models.ActualLRP_Suspect
+				suspect.CellId = "other-missing-cell"
+				fakeLRPDB.ActualLRPsReturns([]*models.ActualLRP{actualLRP, suspect}, nil)
+				plan.SuspectRunningKeys = []*models.ActualLRPKey{key}
+			})
+
+			It("unclaims the instance", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation.Instances).To(HaveLen(2))
+				Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionUnclaim))
+			})
+		})
+	})
+
+	Context("when listing the cells finds none", func() {
+		BeforeEach(func() {
+			fakeServiceClient.CellsReturns(nil, models.ErrResourceNotFound)
+		})
+
+		It("plans with an empty cell set", func() {
+			Expect(err).NotTo(HaveOccurred())
+			_, _, plannedCells, _ := fakeLRPDB.PlanLRPConvergenceInScopeArgsForCall(0)
+			Expect(plannedCells).To(BeEmpty())
+			Expect(explanation.Instances[0].CellPresent).To(BeFalse())
+		})
+	})
+
+	Context("when a suspect instance's cell is back", func() {
+		var suspect *models.ActualLRP
+
+		BeforeEach(func() {
+			suspect = model_helpers.NewValidActualLRP("some-guid", 0)
+			suspect.Presence = models.ActualLRP_Suspect
+			suspect.CellId = "cell-id"
+			actualLRP.State = models.ActualLRPStateClaimed
+			actualLRP.CellId = "other-cell"
+			cellPresence := models.NewCellPresence("other-cell", "2.2.2.2", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+			cellSet.Add(&cellPresence)
+			fakeLRPDB.ActualLRPsReturns([]*models.ActualLRP{suspect, actualLRP}, nil)
+			plan.SuspectKeysWithExistingCells = []*models.ActualLRPKey{key}
+		})
+
+		It("restores the suspect and removes its replacement", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.Instances).To(HaveLen(2))
+			Expect(explanation.Instances[0].ConvergenceAction).To(Equal(models.ConvergenceActionRestoreSuspect))
+			Expect(explanation.Instances[1].ConvergenceAction).To(Equal(models.ConvergenceActionRemove))
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionRemove))
+		})
+	})
+
+	Context("when the cell of the instance is draining", func() {
+		BeforeEach(func() {
+			fakeCellCordonDB.CellCordonsReturns([]*models.CellCordon{{CellId: "cell-id", Draining: true}}, nil)
+			plan.KeysOnDrainingCells = []*models.ActualLRPKeyWithSchedulingInfo{{Key: key}}
+		})
+
+		It("plans with the draining cell flagged", func() {
+			Expect(err).NotTo(HaveOccurred())
+			_, _, plannedCells, _ := fakeLRPDB.PlanLRPConvergenceInScopeArgsForCall(0)
+			Expect(plannedCells["cell-id"].Draining).To(BeTrue())
+		})
+
+		It("marks the instance suspect", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionMarkSuspect))
+			Expect(explanation.ConvergenceReason).To(ContainSubstring("draining"))
+		})
+
+		Context("and another instance of the LRP is being moved", func() {
+			BeforeEach(func() {
+				suspect := model_helpers.NewValidActualLRP("some-guid", 1)
+				suspect.Presence = models.ActualLRP_Suspect
+				fakeLRPDB.ActualLRPsReturns([]*models.ActualLRP{actualLRP, suspect}, nil)
+				plan.KeysOnDrainingCells = nil
+			})
+
+			It("waits", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+				Expect(explanation.ConvergenceReason).To(ContainSubstring("moved first"))
+			})
+		})
+
+		Context("and the disruption budget allows no more disruptions", func() {
+			BeforeEach(func() {
+				schedulingInfo.DisruptionBudget = &models.DisruptionBudget{MinAvailable: 1}
+				plan.KeysOnDrainingCells = nil
+				plan.KeysDelayedByBudget = []*models.ActualLRPKey{key}
+			})
+
+			It("delays the move", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+				Expect(explanation.ConvergenceReason).To(ContainSubstring("disruption budget"))
+			})
+		})
+
+		Context("and the instance is a suspect waiting for its replacement", func() {
+			BeforeEach(func() {
+				suspect := model_helpers.NewValidActualLRP("some-guid", 0)
+				suspect.Presence = models.ActualLRP_Suspect
+				suspect.CellId = "cell-id"
+				actualLRP.State = models.ActualLRPStateUnclaimed
+				actualLRP.CellId = ""
+				actualLRP.Since = fakeClock.Now().UnixNano()
+				fakeLRPDB.ActualLRPsReturns([]*models.ActualLRP{suspect, actualLRP}, nil)
+				plan.KeysOnDrainingCells = nil
+			})
+
+			It("does not restore the suspect", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation.Instances).To(HaveLen(2))
+				Expect(explanation.Instances[0].ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+				Expect(explanation.Instances[1].ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+			})
+		})
+	})
+
+	Context("when fetching the cell cordons fails", func() {
+		BeforeEach(func() {
+			fakeCellCordonDB.CellCordonsReturns(nil, errors.New("boom"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+
+	Context("when an evacuating instance's cell is gone", func() {
+		BeforeEach(func() {
+			evacuating := model_helpers.NewValidEvacuatingActualLRP("some-guid", 0)
+			fakeLRPDB.ActualLRPsReturns([]*models.ActualLRP{actualLRP, evacuating}, nil)
+			plan.EvacuatingKeysToRemove = []*models.ActualLRPKey{key}
+		})
+
+		It("removes the evacuating instance", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.Instances).To(HaveLen(2))
+			Expect(explanation.Instances[1].ConvergenceAction).To(Equal(models.ConvergenceActionRemoveEvacuating))
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+		})
+	})
+
+	Context("when the instance is missing", func() {
+		BeforeEach(func() {
+			fakeLRPDB.ActualLRPsReturns(nil, nil)
+			plan.MissingLRPKeys = []*models.ActualLRPKeyWithSchedulingInfo{{Key: key}}
+		})
+
+		It("creates it", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.Instances).To(BeEmpty())
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionCreateMissing))
+		})
+	})
+
+	Context("when the LRP is no longer desired", func() {
+		BeforeEach(func() {
+			fakeLRPDB.DesiredLRPSchedulingInfosReturns(nil, nil)
+			plan.KeysToRetire = []*models.ActualLRPKey{key}
+		})
+
+		It("retires the instance", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.Desired).To(BeFalse())
+			Expect(explanation.Domain).To(Equal("some-domain"))
+			Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionRetire))
+		})
+
+		Context("and the domain is not fresh", func() {
+			BeforeEach(func() {
+				fakeDomainDB.FreshDomainsReturns(nil, nil)
+				plan.KeysToRetire = nil
+			})
+
+			It("leaves the instance alone", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(explanation.FreshDomain).To(BeFalse())
+				Expect(explanation.ConvergenceAction).To(Equal(models.ConvergenceActionNone))
+			})
+		})
+	})
+})
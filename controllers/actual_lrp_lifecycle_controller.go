@@ -18,11 +18,14 @@ type ActualLRPLifecycleController struct {
 	suspectDB            db.SuspectDB
 	evacuationDB         db.EvacuationDB
 	desiredLRPDB         db.DesiredLRPDB
+	cellCordonDB         db.CellCordonDB
 	auctioneerClient     auctioneer.Client
 	serviceClient        serviceclient.ServiceClient
 	repClientFactory     rep.ClientFactory
 	actualHub            events.Hub
 	actualLRPInstanceHub events.Hub
+	maintenanceMode      MaintenanceMode
+	retirements          *RetirementReservations
 }
 
 func NewActualLRPLifecycleController(
@@ -30,22 +33,28 @@ func NewActualLRPLifecycleController(
 	suspectDB db.SuspectDB,
 	evacuationDB db.EvacuationDB,
 	desiredLRPDB db.DesiredLRPDB,
+	cellCordonDB db.CellCordonDB,
 	auctioneerClient auctioneer.Client,
 	serviceClient serviceclient.ServiceClient,
 	repClientFactory rep.ClientFactory,
 	actualHub events.Hub,
 	actualLRPInstanceHub events.Hub,
+	maintenanceMode MaintenanceMode,
+	retirements *RetirementReservations,
 ) *ActualLRPLifecycleController {
 	return &ActualLRPLifecycleController{
 		db:                   db,
 		suspectDB:            suspectDB,
 		evacuationDB:         evacuationDB,
 		desiredLRPDB:         desiredLRPDB,
+		cellCordonDB:         cellCordonDB,
 		auctioneerClient:     auctioneerClient,
 		serviceClient:        serviceClient,
 		repClientFactory:     repClientFactory,
 		actualHub:            actualHub,
 		actualLRPInstanceHub: actualLRPInstanceHub,
+		maintenanceMode:      maintenanceMode,
+		retirements:          retirements,
 	}
 }
 
@@ -89,6 +98,23 @@ func (h *ActualLRPLifecycleController) ClaimActualLRP(ctx context.Context, logge
 		return nil
 	}
 
+	// An unclaimed instance next to a suspect is the replacement of an
+	// instance moved off a cordoned cell, it must not land on a cordoned cell.
+	if lrp == nil && findWithPresence(lrps, models.ActualLRP_Suspect) != nil {
+		cordoned, err := h.cellCordoned(ctx, logger, actualLRPInstanceKey.CellId)
+		if err != nil {
+			return err
+		}
+		if cordoned {
+			logger.Info("rejected-claim-from-cordoned-cell", lager.Data{
+				"process_guid":  processGUID,
+				"index":         index,
+				"instance_guid": actualLRPInstanceKey,
+			})
+			return models.ErrActualLRPCannotBeClaimed
+		}
+	}
+
 	before, after, err := h.db.ClaimActualLRP(ctx, logger, processGUID, index, actualLRPInstanceKey)
 	if err != nil {
 		return err
@@ -100,6 +126,20 @@ func (h *ActualLRPLifecycleController) ClaimActualLRP(ctx context.Context, logge
 	return nil
 }
 
+func (h *ActualLRPLifecycleController) cellCordoned(ctx context.Context, logger lager.Logger, cellID string) (bool, error) {
+	cordons, err := h.cellCordonDB.CellCordons(ctx, logger)
+	if err != nil {
+		logger.Error("failed-fetching-cell-cordons", err)
+		return false, err
+	}
+	for _, cordon := range cordons {
+		if cordon.CellId == cellID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (h *ActualLRPLifecycleController) StartActualLRP(ctx context.Context, logger lager.Logger, actualLRPKey *models.ActualLRPKey, actualLRPInstanceKey *models.ActualLRPInstanceKey, actualLRPNetInfo *models.ActualLRPNetInfo) error {
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
@@ -150,12 +190,38 @@ func (h *ActualLRPLifecycleController) StartActualLRP(ctx context.Context, logge
 			logger.Error("failed-to-remove-suspect-lrp", err)
 		} else {
 			newLRPs = eventCalculator.RecordChange(suspectLRP, nil, newLRPs)
+			// the cell registry and the rep must not hold up the cell
+			// that started the replacement
+			go h.stopSuspectActualLRP(logger, suspectLRP)
 		}
 	}
 
 	return nil
 }
 
+// stopSuspectActualLRP stops the replaced suspect instance when its cell is
+// still registered, as when the instance was moved off a draining cell.
+func (h *ActualLRPLifecycleController) stopSuspectActualLRP(logger lager.Logger, lrp *models.ActualLRP) {
+	cell, err := h.serviceClient.CellById(logger, lrp.CellId)
+	if err != nil {
+		if models.ConvertError(err).Type != models.Error_ResourceNotFound {
+			logger.Error("failed-fetching-suspect-lrp-cell", err)
+		}
+		return
+	}
+
+	client, err := h.repClientFactory.CreateClient(cell.RepAddress, cell.RepUrl)
+	if err != nil {
+		logger.Error("failed-creating-rep-client", err)
+		return
+	}
+
+	err = client.StopLRPInstance(logger, lrp.ActualLRPKey, lrp.ActualLRPInstanceKey)
+	if err != nil {
+		logger.Error("failed-stopping-suspect-lrp", err)
+	}
+}
+
 func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logger lager.Logger, actualLRPKey *models.ActualLRPKey, actualLRPInstanceKey *models.ActualLRPInstanceKey, errorMessage string) error {
 	lrps, err := h.db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: actualLRPKey.ProcessGuid, Index: &actualLRPKey.Index})
 	if err != nil {
@@ -193,6 +259,13 @@ func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logge
 		return nil
 	}
 
+	// the LRP stays unclaimed, and convergence restarts it once maintenance
+	// ends
+	if h.maintenanceMode.Enabled(ctx, logger) {
+		logger.Info("skipping-restart-in-maintenance", lager.Data{"process_guid": actualLRPKey.ProcessGuid, "index": actualLRPKey.Index})
+		return nil
+	}
+
 	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(ctx, logger, actualLRPKey.ProcessGuid)
 	if err != nil {
 		logger.Error("failed-fetching-desired-lrp", err)
@@ -280,6 +353,18 @@ func (h *ActualLRPLifecycleController) RetireActualLRP(ctx context.Context, logg
 		return models.ErrResourceNotFound
 	}
 
+	if lrp.State == models.ActualLRPStateRunning {
+		err = h.checkDisruptionBudget(ctx, logger, lrp)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				h.retirements.release(lrp.ProcessGuid, lrp.InstanceGuid)
+			}
+		}()
+	}
+
 	newLRPs := make([]*models.ActualLRP, len(lrps))
 	copy(newLRPs, lrps)
 
@@ -326,3 +411,43 @@ func (h *ActualLRPLifecycleController) RetireActualLRP(ctx context.Context, logg
 
 	return err
 }
+
+// checkDisruptionBudget refuses to retire a running instance at a desired
+// index when the disruption budget of its LRP allows no more disruptions.
+// The check and the reservation of the disruption are serialised per process
+// guid, and the instances already let go count as unavailable until they stop
+// running or their reservation expires, so concurrent retires cannot spend the
+// same disruption.
+func (h *ActualLRPLifecycleController) checkDisruptionBudget(ctx context.Context, logger lager.Logger, lrp *models.ActualLRP) error {
+	unlock := h.retirements.lock(lrp.ProcessGuid)
+	defer unlock()
+
+	schedulingInfos, err := h.desiredLRPDB.DesiredLRPSchedulingInfos(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{lrp.ProcessGuid}})
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return err
+	}
+	if len(schedulingInfos) == 0 {
+		return nil
+	}
+
+	schedulingInfo := schedulingInfos[0]
+	if !schedulingInfo.DisruptionBudget.IsSet() || lrp.Index >= schedulingInfo.Instances {
+		return nil
+	}
+
+	lrps, err := h.db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: lrp.ProcessGuid})
+	if err != nil {
+		return err
+	}
+
+	lrps, _ = h.retirements.withoutReserved(lrp.ProcessGuid, lrps)
+	available := models.AvailableInstances(lrps, schedulingInfo.Instances)
+	if schedulingInfo.DisruptionBudget.AllowedDisruptions(schedulingInfo.Instances, available) < 1 {
+		logger.Info("refused-by-disruption-budget", lager.Data{"instances": schedulingInfo.Instances, "available": available})
+		return models.ErrDisruptionBudgetExceeded
+	}
+
+	h.retirements.reserve(lrp.ProcessGuid, lrp.InstanceGuid)
+	return nil
+}
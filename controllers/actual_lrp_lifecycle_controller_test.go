@@ -1,16 +1,21 @@
 package controllers_test
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
 	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/controllers/fakes"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"code.cloudfoundry.org/rep/repfakes"
 	. "github.com/onsi/ginkgo"
@@ -24,10 +29,13 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 		fakeActualLRPDB      *dbfakes.FakeActualLRPDB
 		fakeDesiredLRPDB     *dbfakes.FakeDesiredLRPDB
 		fakeEvacuationDB     *dbfakes.FakeEvacuationDB
+		fakeCellCordonDB     *dbfakes.FakeCellCordonDB
 		fakeSuspectDB        *dbfakes.FakeSuspectDB
 		fakeAuctioneerClient *auctioneerfakes.FakeClient
+		fakeMaintenanceMode  *fakes.FakeMaintenanceMode
 		actualHub            *eventfakes.FakeHub
 		actualLRPInstanceHub *eventfakes.FakeHub
+		fakeClock            *fakeclock.FakeClock
 
 		controller *controllers.ActualLRPLifecycleController
 		err        error
@@ -55,7 +63,9 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 		fakeSuspectDB = new(dbfakes.FakeSuspectDB)
 		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
 		fakeEvacuationDB = new(dbfakes.FakeEvacuationDB)
+		fakeCellCordonDB = new(dbfakes.FakeCellCordonDB)
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
+		fakeMaintenanceMode = new(fakes.FakeMaintenanceMode)
 		logger = lagertest.NewTestLogger("test")
 
 		fakeServiceClient = new(serviceclientfakes.FakeServiceClient)
@@ -65,16 +75,20 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 
 		actualHub = &eventfakes.FakeHub{}
 		actualLRPInstanceHub = &eventfakes.FakeHub{}
+		fakeClock = fakeclock.NewFakeClock(time.Now())
 		controller = controllers.NewActualLRPLifecycleController(
 			fakeActualLRPDB,
 			fakeSuspectDB,
 			fakeEvacuationDB,
 			fakeDesiredLRPDB,
+			fakeCellCordonDB,
 			fakeAuctioneerClient,
 			fakeServiceClient,
 			fakeRepClientFactory,
 			actualHub,
 			actualLRPInstanceHub,
+			fakeMaintenanceMode,
+			controllers.NewRetirementReservations(fakeClock, time.Minute),
 		)
 
 		beforeInstanceKey = models.NewActualLRPInstanceKey(
@@ -242,6 +256,60 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 					Eventually(actualLRPInstanceHub.EmitCallCount).Should(Equal(1))
 					Expect(fakeActualLRPDB.ClaimActualLRPCallCount()).To(Equal(1))
 				})
+
+				Context("when the claiming cell is cordoned", func() {
+					BeforeEach(func() {
+						fakeCellCordonDB.CellCordonsReturns([]*models.CellCordon{
+							{CellId: "suspect-cell-id", Draining: true},
+						}, nil)
+					})
+
+					It("rejects the claim", func() {
+						err = controller.ClaimActualLRP(ctx, logger, processGuid, index, &models.ActualLRPInstanceKey{
+							InstanceGuid: "new-instance-guid",
+							CellId:       "suspect-cell-id",
+						})
+						Expect(err).To(MatchError(models.ErrActualLRPCannotBeClaimed))
+						Expect(fakeActualLRPDB.ClaimActualLRPCallCount()).To(Equal(0))
+						Consistently(actualHub.EmitCallCount).Should(BeZero())
+						Expect(logger).To(gbytes.Say("rejected-claim-from-cordoned-cell"))
+					})
+
+					It("lets an uncordoned cell claim the replacement", func() {
+						err = controller.ClaimActualLRP(ctx, logger, processGuid, index, &models.ActualLRPInstanceKey{
+							InstanceGuid: "new-instance-guid",
+							CellId:       "new-cell-id",
+						})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(fakeActualLRPDB.ClaimActualLRPCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when fetching the cell cordons fails", func() {
+					BeforeEach(func() {
+						fakeCellCordonDB.CellCordonsReturns(nil, errors.New("boom"))
+					})
+
+					It("returns the error without claiming", func() {
+						err = controller.ClaimActualLRP(ctx, logger, processGuid, index, &models.ActualLRPInstanceKey{
+							InstanceGuid: "new-instance-guid",
+							CellId:       "new-cell-id",
+						})
+						Expect(err).To(MatchError("boom"))
+						Expect(fakeActualLRPDB.ClaimActualLRPCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
+		Context("when there is no Suspect LRP", func() {
+			It("does not look up the cell cordons", func() {
+				err = controller.ClaimActualLRP(ctx, logger, processGuid, index, &models.ActualLRPInstanceKey{
+					InstanceGuid: "new-instance-guid",
+					CellId:       "new-cell-id",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeCellCordonDB.CellCordonsCallCount()).To(Equal(0))
 			})
 		})
 	})
@@ -280,6 +348,7 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				}
 
 				fakeSuspectDB.RemoveSuspectActualLRPReturns(suspect, nil)
+				fakeServiceClient.CellByIdReturns(nil, models.ErrResourceNotFound)
 			})
 
 			JustBeforeEach(func() {
@@ -321,6 +390,60 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				Expect(event).To(Equal(models.NewActualLRPInstanceRemovedEvent(suspect)))
 			})
 
+			It("does not stop the suspect lrp when its cell is gone", func() {
+				err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo)
+				Eventually(fakeServiceClient.CellByIdCallCount).Should(Equal(1))
+				_, cellID := fakeServiceClient.CellByIdArgsForCall(0)
+				Expect(cellID).To(Equal("cell-id-1"))
+				Consistently(fakeRepClientFactory.CreateClientCallCount).Should(Equal(0))
+			})
+
+			It("does not wait for the cell registry", func() {
+				blockCellByID := make(chan struct{})
+				defer close(blockCellByID)
+				fakeServiceClient.CellByIdStub = func(lager.Logger, string) (*models.CellPresence, error) {
+					<-blockCellByID
+					return nil, models.ErrResourceNotFound
+				}
+
+				err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when the cell of the suspect lrp is registered", func() {
+				BeforeEach(func() {
+					cellPresence := models.NewCellPresence("cell-id-1", "cell1.addr", "cell1.url", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+					fakeServiceClient.CellByIdReturns(&cellPresence, nil)
+				})
+
+				It("stops the suspect lrp on its cell", func() {
+					err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(fakeRepClient.StopLRPInstanceCallCount).Should(Equal(1))
+					Expect(fakeRepClientFactory.CreateClientCallCount()).To(Equal(1))
+					repAddr, repURL := fakeRepClientFactory.CreateClientArgsForCall(0)
+					Expect(repAddr).To(Equal("cell1.addr"))
+					Expect(repURL).To(Equal("cell1.url"))
+
+					_, key, instanceKey := fakeRepClient.StopLRPInstanceArgsForCall(0)
+					Expect(key).To(Equal(suspect.ActualLRPKey))
+					Expect(instanceKey).To(Equal(suspect.ActualLRPInstanceKey))
+				})
+
+				Context("when stopping the suspect lrp fails", func() {
+					BeforeEach(func() {
+						fakeRepClient.StopLRPInstanceReturns(errors.New("rep down"))
+					})
+
+					It("logs the error and still starts the lrp", func() {
+						err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo)
+						Expect(err).NotTo(HaveOccurred())
+						Eventually(logger.Buffer()).Should(gbytes.Say("failed-stopping-suspect-lrp"))
+					})
+				})
+			})
+
 			Context("when RemoveSuspectActualLRP returns an error", func() {
 				BeforeEach(func() {
 					fakeSuspectDB.RemoveSuspectActualLRPReturns(nil, errors.New("boooom!"))
@@ -635,6 +758,20 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 					Expect(startRequests[0]).To(BeEquivalentTo(&expectedStartRequest))
 				})
 
+				Context("when the BBS is in maintenance mode", func() {
+					BeforeEach(func() {
+						fakeMaintenanceMode.EnabledReturns(true)
+					})
+
+					It("leaves the restart to convergence", func() {
+						err = controller.CrashActualLRP(ctx, logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeActualLRPDB.CrashActualLRPCallCount()).To(Equal(1))
+						Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+					})
+				})
+
 				It("emits crashed, created & removed events to the actual instance hub", func() {
 					err = controller.CrashActualLRP(ctx, logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
 					Expect(err).NotTo(HaveOccurred())
@@ -1565,5 +1702,150 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				})
 			})
 		})
+		Context("when the LRP is running and has a disruption budget", func() {
+			var otherLRP *models.ActualLRP
+
+			BeforeEach(func() {
+				actualLRPState = models.ActualLRPStateRunning
+
+				schedulingInfo := model_helpers.NewValidDesiredLRP(processGuid).DesiredLRPSchedulingInfo()
+				schedulingInfo.Instances = 2
+				schedulingInfo.DisruptionBudget = &models.DisruptionBudget{MinAvailable: 1}
+				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+
+				cellPresence := models.NewCellPresence("cell-id-0", "cell1.addr", "", "the-zone", models.NewCellCapacity(128, 1024, 6), nil, nil, nil, nil)
+				fakeServiceClient.CellByIdReturns(&cellPresence, nil)
+
+				otherLRP = &models.ActualLRP{
+					ActualLRPKey:         models.NewActualLRPKey(processGuid, 0, "domain-0"),
+					ActualLRPInstanceKey: models.NewActualLRPInstanceKey("instance-guid-1", "cell-id-1"),
+					State:                models.ActualLRPStateRunning,
+					Presence:             models.ActualLRP_Ordinary,
+				}
+			})
+
+			JustBeforeEach(func() {
+				fakeActualLRPDB.ActualLRPsReturnsOnCall(0, []*models.ActualLRP{actualLRP}, nil)
+				fakeActualLRPDB.ActualLRPsReturnsOnCall(1, []*models.ActualLRP{otherLRP, actualLRP}, nil)
+			})
+
+			It("stops the LRP when the budget allows it", func() {
+				err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(1))
+
+				_, _, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+				Expect(filter.ProcessGuids).To(ConsistOf(processGuid))
+			})
+
+			Context("when another instance was retired and is still running", func() {
+				var otherKey models.ActualLRPKey
+
+				BeforeEach(func() {
+					otherKey = otherLRP.ActualLRPKey
+				})
+
+				JustBeforeEach(func() {
+					fakeActualLRPDB.ActualLRPsStub = func(_ context.Context, _ lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+						if filter.Index == nil {
+							return []*models.ActualLRP{otherLRP, actualLRP}, nil
+						}
+						if *filter.Index == otherLRP.Index {
+							return []*models.ActualLRP{otherLRP}, nil
+						}
+						return []*models.ActualLRP{actualLRP}, nil
+					}
+				})
+
+				It("refuses to spend the same disruption twice", func() {
+					Expect(controller.RetireActualLRP(ctx, logger, &otherKey)).To(Succeed())
+					err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+					Expect(err).To(Equal(models.ErrDisruptionBudgetExceeded))
+					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(1))
+				})
+
+				It("allows the disruption again once the retired instance stops running", func() {
+					Expect(controller.RetireActualLRP(ctx, logger, &otherKey)).To(Succeed())
+					restarted := *otherLRP
+					restarted.ActualLRPInstanceKey = models.NewActualLRPInstanceKey("instance-guid-2", "cell-id-2")
+					otherLRP = &restarted
+
+					err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(2))
+				})
+
+				It("allows the disruption again once the reservation expires", func() {
+					Expect(controller.RetireActualLRP(ctx, logger, &otherKey)).To(Succeed())
+
+					fakeClock.Increment(time.Minute - time.Second)
+					err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+					Expect(err).To(Equal(models.ErrDisruptionBudgetExceeded))
+
+					fakeClock.Increment(time.Second)
+					err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(2))
+				})
+
+				Context("when stopping the retired instance fails", func() {
+					BeforeEach(func() {
+						fakeRepClient.StopLRPInstanceStub = func(_ lager.Logger, key models.ActualLRPKey, _ models.ActualLRPInstanceKey) error {
+							if key == otherKey {
+								return errors.New("boom")
+							}
+							return nil
+						}
+					})
+
+					It("releases the disruption", func() {
+						Expect(controller.RetireActualLRP(ctx, logger, &otherKey)).To(MatchError("boom"))
+						err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+						Expect(err).NotTo(HaveOccurred())
+					})
+				})
+			})
+
+			Context("when the budget allows no more disruptions", func() {
+				BeforeEach(func() {
+					otherLRP.State = models.ActualLRPStateCrashed
+				})
+
+				It("refuses to retire the LRP", func() {
+					err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+					Expect(err).To(Equal(models.ErrDisruptionBudgetExceeded))
+					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(0))
+					Expect(fakeActualLRPDB.RemoveActualLRPCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the instance is beyond the desired instances", func() {
+				BeforeEach(func() {
+					otherLRP.State = models.ActualLRPStateCrashed
+					schedulingInfo := model_helpers.NewValidDesiredLRP(processGuid).DesiredLRPSchedulingInfo()
+					schedulingInfo.Instances = 1
+					schedulingInfo.DisruptionBudget = &models.DisruptionBudget{MinAvailable: 1}
+					fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+				})
+
+				It("retires it regardless of the budget", func() {
+					err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when fetching the desired LRP fails", func() {
+				BeforeEach(func() {
+					fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns(nil, errors.New("boom"))
+				})
+
+				It("returns the error", func() {
+					err = controller.RetireActualLRP(ctx, logger, &actualLRPKey)
+					Expect(err).To(MatchError("boom"))
+					Expect(fakeRepClient.StopLRPInstanceCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
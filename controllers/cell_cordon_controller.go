@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+// CellCordonController keeps the cordons of cells. A cordoned cell should not
+// be given new work, and convergence moves the LRP instances off a cordoned
+// cell that is draining.
+type CellCordonController struct {
+	db    db.CellCordonDB
+	clock clock.Clock
+}
+
+func NewCellCordonController(db db.CellCordonDB, clock clock.Clock) *CellCordonController {
+	return &CellCordonController{
+		db:    db,
+		clock: clock,
+	}
+}
+
+func (c *CellCordonController) CellCordons(ctx context.Context, logger lager.Logger) ([]*models.CellCordon, error) {
+	logger = logger.Session("cell-cordons")
+	return c.db.CellCordons(ctx, logger)
+}
+
+// CordonCell cordons the cell. Cordoning a draining cell stops the drain,
+// the instances already moved stay where they are.
+func (c *CellCordonController) CordonCell(ctx context.Context, logger lager.Logger, cellID, reason string) (*models.CellCordon, error) {
+	logger = logger.Session("cordon-cell", lager.Data{"cell_id": cellID, "reason": reason})
+	return c.setCordon(ctx, logger, cellID, false, reason)
+}
+
+func (c *CellCordonController) DrainCell(ctx context.Context, logger lager.Logger, cellID, reason string) (*models.CellCordon, error) {
+	logger = logger.Session("drain-cell", lager.Data{"cell_id": cellID, "reason": reason})
+	return c.setCordon(ctx, logger, cellID, true, reason)
+}
+
+func (c *CellCordonController) UncordonCell(ctx context.Context, logger lager.Logger, cellID string) error {
+	logger = logger.Session("uncordon-cell", lager.Data{"cell_id": cellID})
+
+	err := c.db.RemoveCellCordon(ctx, logger, cellID)
+	if err != nil {
+		logger.Error("failed-to-remove-cell-cordon", err)
+		return err
+	}
+	logger.Info("uncordoned")
+
+	return nil
+}
+
+func (c *CellCordonController) setCordon(ctx context.Context, logger lager.Logger, cellID string, draining bool, reason string) (*models.CellCordon, error) {
+	cordon := &models.CellCordon{
+		CellId:    cellID,
+		Draining:  draining,
+		Reason:    reason,
+		UpdatedAt: c.clock.Now().UnixNano(),
+	}
+
+	err := c.db.UpsertCellCordon(ctx, logger, cordon)
+	if err != nil {
+		logger.Error("failed-to-upsert-cell-cordon", err)
+		return nil, err
+	}
+	logger.Info("cordoned", lager.Data{"draining": draining})
+
+	return cordon, nil
+}
+
+// FlagCells sets Cordoned and Draining on the presences in cellSet from the
+// cordons of their cells.
+func (c *CellCordonController) FlagCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) error {
+	cordons, err := c.db.CellCordons(ctx, logger)
+	if err != nil {
+		logger.Error("failed-to-fetch-cell-cordons", err)
+		return err
+	}
+
+	for _, cell := range cellSet {
+		cell.Cordoned = false
+		cell.Draining = false
+	}
+
+	for _, cordon := range cordons {
+		cell, ok := cellSet[cordon.CellId]
+		if !ok {
+			continue
+		}
+		cell.Cordoned = true
+		cell.Draining = cordon.Draining
+	}
+
+	return nil
+}
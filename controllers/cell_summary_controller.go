@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/serviceclient"
+	"code.cloudfoundry.org/lager"
+)
+
+// CellSummaryController joins the presences of the registered cells with the
+// work the database has placed on them, so that operators can see how much
+// of each cell, and of each zone, is in use.
+type CellSummaryController struct {
+	db            db.CellWorkloadDB
+	serviceClient serviceclient.ServiceClient
+	cellCordons   *CellCordonController
+}
+
+func NewCellSummaryController(
+	db db.CellWorkloadDB,
+	serviceClient serviceclient.ServiceClient,
+	cellCordons *CellCordonController,
+) *CellSummaryController {
+	return &CellSummaryController{
+		db:            db,
+		serviceClient: serviceClient,
+		cellCordons:   cellCordons,
+	}
+}
+
+// CellSummaries returns a summary of every registered cell ordered by cell
+// id, and their totals per zone ordered by zone. Work on cells that are not
+// registered is left out.
+func (c *CellSummaryController) CellSummaries(ctx context.Context, logger lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error) {
+	logger = logger.Session("cell-summaries")
+
+	cellSet, err := c.serviceClient.Cells(logger)
+	if err == models.ErrResourceNotFound {
+		cellSet = models.CellSet{}
+	} else if err != nil {
+		logger.Error("failed-listing-cells", err)
+		return nil, nil, err
+	}
+
+	if c.cellCordons != nil {
+		err = c.cellCordons.FlagCells(ctx, logger, cellSet)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	workloads, err := c.db.CellWorkloads(ctx, logger)
+	if err != nil {
+		logger.Error("failed-fetching-cell-workloads", err)
+		return nil, nil, err
+	}
+
+	workloadsByCell := make(map[string]*models.CellWorkload, len(workloads))
+	for _, workload := range workloads {
+		workloadsByCell[workload.CellId] = workload
+	}
+
+	cells := make([]*models.CellSummary, 0, len(cellSet))
+	for _, presence := range cellSet {
+		cells = append(cells, newCellSummary(presence, workloadsByCell[presence.CellId]))
+	}
+	sort.Slice(cells, func(i, j int) bool { return cells[i].CellId < cells[j].CellId })
+
+	return cells, summarizeZones(cells), nil
+}
+
+func newCellSummary(presence *models.CellPresence, workload *models.CellWorkload) *models.CellSummary {
+	summary := &models.CellSummary{
+		CellId:                presence.CellId,
+		Zone:                  presence.Zone,
+		PlacementTags:         presence.PlacementTags,
+		OptionalPlacementTags: presence.OptionalPlacementTags,
+		Cordoned:              presence.Cordoned,
+		Draining:              presence.Draining,
+		Capacity:              &models.CellCapacity{},
+		Reserved:              &models.CellCapacity{},
+		ActualLrps:            &models.CellActualLRPCounts{},
+		Tasks:                 &models.CellTaskCounts{},
+	}
+
+	if presence.Capacity != nil {
+		*summary.Capacity = *presence.Capacity
+	}
+
+	if workload != nil {
+		*summary.Reserved = *workload.Reserved
+		*summary.ActualLrps = *workload.ActualLrps
+		*summary.Tasks = *workload.Tasks
+	}
+
+	return summary
+}
+
+func summarizeZones(cells []*models.CellSummary) []*models.ZoneSummary {
+	zonesByName := map[string]*models.ZoneSummary{}
+	for _, cell := range cells {
+		zone, ok := zonesByName[cell.Zone]
+		if !ok {
+			zone = &models.ZoneSummary{
+				Zone:       cell.Zone,
+				Capacity:   &models.CellCapacity{},
+				Reserved:   &models.CellCapacity{},
+				ActualLrps: &models.CellActualLRPCounts{},
+				Tasks:      &models.CellTaskCounts{},
+			}
+			zonesByName[cell.Zone] = zone
+		}
+
+		zone.CellCount++
+		if cell.Cordoned {
+			zone.CordonedCellCount++
+		}
+		addCapacity(zone.Capacity, cell.Capacity)
+		addCapacity(zone.Reserved, cell.Reserved)
+
+		zone.ActualLrps.Claimed += cell.ActualLrps.Claimed
+		zone.ActualLrps.Running += cell.ActualLrps.Running
+		zone.ActualLrps.Suspect += cell.ActualLrps.Suspect
+		zone.ActualLrps.Evacuating += cell.ActualLrps.Evacuating
+
+		zone.Tasks.Running += cell.Tasks.Running
+		zone.Tasks.Completed += cell.Tasks.Completed
+		zone.Tasks.Resolving += cell.Tasks.Resolving
+	}
+
+	zones := make([]*models.ZoneSummary, 0, len(zonesByName))
+	for _, zone := range zonesByName {
+		zones = append(zones, zone)
+	}
+	sort.Slice(zones, func(i, j int) bool { return zones[i].Zone < zones[j].Zone })
+	return zones
+}
+
+func addCapacity(total, capacity *models.CellCapacity) {
+	total.MemoryMb += capacity.MemoryMb
+	total.DiskMb += capacity.DiskMb
+	total.Containers += capacity.Containers
+}
@@ -0,0 +1,153 @@
+package controllers_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CellSummaryController", func() {
+	var (
+		fakeCellWorkloadDB *dbfakes.FakeCellWorkloadDB
+		fakeCellCordonDB   *dbfakes.FakeCellCordonDB
+
+		controller *controllers.CellSummaryController
+		cells      []*models.CellSummary
+		zones      []*models.ZoneSummary
+		err        error
+	)
+
+	BeforeEach(func() {
+		fakeCellWorkloadDB = new(dbfakes.FakeCellWorkloadDB)
+		fakeCellCordonDB = new(dbfakes.FakeCellCordonDB)
+
+		cell1 := models.NewCellPresence("cell-1", "1.1.1.1", "", "z1", models.NewCellCapacity(1024, 2048, 10), nil, nil, []string{"tag-1"}, nil)
+		cell2 := models.NewCellPresence("cell-2", "2.2.2.2", "", "z1", models.NewCellCapacity(1024, 2048, 10), nil, nil, nil, []string{"tag-2"})
+		cell3 := models.NewCellPresence("cell-3", "3.3.3.3", "", "z2", models.NewCellCapacity(2048, 4096, 20), nil, nil, nil, nil)
+		fakeServiceClient.CellsReturns(models.CellSet{"cell-1": &cell1, "cell-2": &cell2, "cell-3": &cell3}, nil)
+		fakeCellCordonDB.CellCordonsReturns([]*models.CellCordon{{CellId: "cell-2", Draining: true}}, nil)
+
+		fakeCellWorkloadDB.CellWorkloadsReturns([]*models.CellWorkload{
+			{
+				CellId:     "cell-1",
+				ActualLrps: &models.CellActualLRPCounts{Claimed: 1, Running: 2, Suspect: 1},
+				Tasks:      &models.CellTaskCounts{Running: 1, Completed: 3},
+				Reserved:   &models.CellCapacity{MemoryMb: 512, DiskMb: 1024, Containers: 4},
+			},
+			{
+				CellId:     "cell-2",
+				ActualLrps: &models.CellActualLRPCounts{Running: 1, Evacuating: 1},
+				Tasks:      &models.CellTaskCounts{Resolving: 1},
+				Reserved:   &models.CellCapacity{MemoryMb: 128, DiskMb: 256, Containers: 1},
+			},
+			{
+				CellId:     "missing-cell",
+				ActualLrps: &models.CellActualLRPCounts{Running: 5},
+				Tasks:      &models.CellTaskCounts{},
+				Reserved:   &models.CellCapacity{MemoryMb: 640, DiskMb: 1280, Containers: 5},
+			},
+		}, nil)
+
+		cellCordons := controllers.NewCellCordonController(fakeCellCordonDB, fakeclock.NewFakeClock(time.Now()))
+		controller = controllers.NewCellSummaryController(fakeCellWorkloadDB, fakeServiceClient, cellCordons)
+	})
+
+	JustBeforeEach(func() {
+		cells, zones, err = controller.CellSummaries(ctx, logger)
+	})
+
+	It("summarizes every registered cell ordered by cell id", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cells).To(Equal([]*models.CellSummary{
+			{
+				CellId:        "cell-1",
+				Zone:          "z1",
+				PlacementTags: []string{"tag-1"},
+				Capacity:      &models.CellCapacity{MemoryMb: 1024, DiskMb: 2048, Containers: 10},
+				Reserved:      &models.CellCapacity{MemoryMb: 512, DiskMb: 1024, Containers: 4},
+				ActualLrps:    &models.CellActualLRPCounts{Claimed: 1, Running: 2, Suspect: 1},
+				Tasks:         &models.CellTaskCounts{Running: 1, Completed: 3},
+			},
+			{
+				CellId:                "cell-2",
+				Zone:                  "z1",
+				OptionalPlacementTags: []string{"tag-2"},
+				Cordoned:              true,
+				Draining:              true,
+				Capacity:              &models.CellCapacity{MemoryMb: 1024, DiskMb: 2048, Containers: 10},
+				Reserved:              &models.CellCapacity{MemoryMb: 128, DiskMb: 256, Containers: 1},
+				ActualLrps:            &models.CellActualLRPCounts{Running: 1, Evacuating: 1},
+				Tasks:                 &models.CellTaskCounts{Resolving: 1},
+			},
+			{
+				CellId:     "cell-3",
+				Zone:       "z2",
+				Capacity:   &models.CellCapacity{MemoryMb: 2048, DiskMb: 4096, Containers: 20},
+				Reserved:   &models.CellCapacity{},
+				ActualLrps: &models.CellActualLRPCounts{},
+				Tasks:      &models.CellTaskCounts{},
+			},
+		}))
+	})
+
+	It("totals the cells of each zone", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zones).To(Equal([]*models.ZoneSummary{
+			{
+				Zone:              "z1",
+				CellCount:         2,
+				CordonedCellCount: 1,
+				Capacity:          &models.CellCapacity{MemoryMb: 2048, DiskMb: 4096, Containers: 20},
+				Reserved:          &models.CellCapacity{MemoryMb: 640, DiskMb: 1280, Containers: 5},
+				ActualLrps:        &models.CellActualLRPCounts{Claimed: 1, Running: 3, Suspect: 1, Evacuating: 1},
+				Tasks:             &models.CellTaskCounts{Running: 1, Completed: 3, Resolving: 1},
+			},
+			{
+				Zone:       "z2",
+				CellCount:  1,
+				Capacity:   &models.CellCapacity{MemoryMb: 2048, DiskMb: 4096, Containers: 20},
+				Reserved:   &models.CellCapacity{},
+				ActualLrps: &models.CellActualLRPCounts{},
+				Tasks:      &models.CellTaskCounts{},
+			},
+		}))
+	})
+
+	Context("when no cells are registered", func() {
+		BeforeEach(func() {
+			fakeServiceClient.CellsReturns(nil, models.ErrResourceNotFound)
+		})
+
+		It("returns no summaries", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cells).To(BeEmpty())
+			Expect(zones).To(BeEmpty())
+		})
+	})
+
+	Context("when listing the cells fails", func() {
+		BeforeEach(func() {
+			fakeServiceClient.CellsReturns(nil, errors.New("boom"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+
+	Context("when fetching the workloads fails", func() {
+		BeforeEach(func() {
+			fakeCellWorkloadDB.CellWorkloadsReturns(nil, errors.New("boom"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+})
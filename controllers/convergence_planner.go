@@ -0,0 +1,275 @@
+package controllers
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/serviceclient"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+// ConvergencePlanner reports what the next convergence run would do to LRPs
+// and tasks, without doing it.
+type ConvergencePlanner struct {
+	lrpDB                       db.LRPDB
+	taskDB                      db.TaskDB
+	serviceClient               serviceclient.ServiceClient
+	clock                       clock.Clock
+	kickTaskDuration            time.Duration
+	expirePendingTaskDuration   time.Duration
+	expireCompletedTaskDuration time.Duration
+	cellCordons                 *CellCordonController
+	safetyValve                 *ConvergenceSafetyValve
+	maintenanceMode             MaintenanceMode
+	settingsLock                sync.RWMutex
+}
+
+func NewConvergencePlanner(
+	lrpDB db.LRPDB,
+	taskDB db.TaskDB,
+	serviceClient serviceclient.ServiceClient,
+	clock clock.Clock,
+	kickTaskDuration,
+	expirePendingTaskDuration,
+	expireCompletedTaskDuration time.Duration,
+	cellCordons *CellCordonController,
+	safetyValve *ConvergenceSafetyValve,
+	maintenanceMode MaintenanceMode,
+) *ConvergencePlanner {
+	return &ConvergencePlanner{
+		lrpDB:                       lrpDB,
+		taskDB:                      taskDB,
+		serviceClient:               serviceClient,
+		clock:                       clock,
+		kickTaskDuration:            kickTaskDuration,
+		expirePendingTaskDuration:   expirePendingTaskDuration,
+		expireCompletedTaskDuration: expireCompletedTaskDuration,
+		cellCordons:                 cellCordons,
+		safetyValve:                 safetyValve,
+		maintenanceMode:             maintenanceMode,
+	}
+}
+
+// SetTaskDurations changes the durations later plans of task convergence
+// use, so that they match the converger.
+func (p *ConvergencePlanner) SetTaskDurations(kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) {
+	p.settingsLock.Lock()
+	defer p.settingsLock.Unlock()
+
+	p.kickTaskDuration = kickTaskDuration
+	p.expirePendingTaskDuration = expirePendingTaskDuration
+	p.expireCompletedTaskDuration = expireCompletedTaskDuration
+}
+
+func (p *ConvergencePlanner) PlanConvergence(ctx context.Context, logger lager.Logger, sampleSize int) (*models.ConvergencePlan, error) {
+	logger = logger.Session("plan-convergence")
+
+	if sampleSize <= 0 {
+		sampleSize = models.DefaultConvergencePlanSampleSize
+	}
+
+	cellSet, err := p.cells(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	p.settingsLock.RLock()
+	kickTaskDuration := p.kickTaskDuration
+	expirePendingTaskDuration := p.expirePendingTaskDuration
+	expireCompletedTaskDuration := p.expireCompletedTaskDuration
+	p.settingsLock.RUnlock()
+
+	lrpCellSet, err := p.planCellSet(ctx, logger, LRPConvergenceSafetyValve, cellSet)
+	if err != nil {
+		return nil, err
+	}
+	taskCellSet, err := p.planCellSet(ctx, logger, TaskConvergenceSafetyValve, cellSet)
+	if err != nil {
+		return nil, err
+	}
+
+	lrpPlan := p.lrpDB.PlanLRPConvergence(ctx, logger, lrpCellSet)
+	taskPlan := p.taskDB.PlanTaskConvergence(ctx, logger, taskCellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+
+	return &models.ConvergencePlan{
+		PlannedAt:         p.clock.Now().UnixNano(),
+		CellCount:         int32(len(cellSet)),
+		MissingCellIds:    lrpPlan.MissingCellIds,
+		ExpiredDomains:    lrpPlan.ExpiredDomains,
+		LRPs:              lrpConvergencePlan(lrpPlan, sampleSize),
+		Tasks:             taskConvergencePlan(taskPlan, sampleSize),
+		ConvergencePaused: p.ConvergencePaused(ctx, logger),
+	}, nil
+}
+
+// ConvergencePaused reports whether the converger skips its runs because the
+// BBS is in maintenance mode. Plans still report what a run would do once it
+// ends.
+func (p *ConvergencePlanner) ConvergencePaused(ctx context.Context, logger lager.Logger) bool {
+	return p.maintenanceMode != nil && p.maintenanceMode.Enabled(ctx, logger)
+}
+
+// PlanActualLRPConvergence returns what the next convergence run would do to
+// the instances of the process guid, with all of their keys rather than a
+// sample, and the cells it planned with. It only plans the process guid,
+// apart from the checks convergence makes whatever its scope.
+func (p *ConvergencePlanner) PlanActualLRPConvergence(ctx context.Context, logger lager.Logger, processGuid string) (*models.LRPConvergencePlan, models.CellSet, error) {
+	logger = logger.Session("plan-actual-lrp-convergence", lager.Data{"process_guid": processGuid})
+
+	cellSet, err := p.cells(ctx, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	cellSet, err = p.planCellSet(ctx, logger, LRPConvergenceSafetyValve, cellSet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scope := db.LRPConvergenceScope{ProcessGuids: []string{processGuid}, AllCells: true}
+	plan := p.lrpDB.PlanLRPConvergenceInScope(ctx, logger, cellSet, scope)
+	plan = lrpConvergencePlanFor(plan, processGuid)
+
+	return lrpConvergencePlan(plan, math.MaxInt32), cellSet, nil
+}
+
+func (p *ConvergencePlanner) cells(ctx context.Context, logger lager.Logger) (models.CellSet, error) {
+	cellSet, err := p.serviceClient.Cells(logger)
+	if err == models.ErrResourceNotFound {
+		logger.Info("no-cells-found")
+		cellSet = models.CellSet{}
+	} else if err != nil {
+		logger.Error("failed-listing-cells", err)
+		return nil, err
+	}
+
+	return cellSet, nil
+}
+
+// planCellSet returns the cells the next pass of the named convergence would
+// act on: those its safety valve keeps, flagged with their cordons.
+func (p *ConvergencePlanner) planCellSet(ctx context.Context, logger lager.Logger, name string, cellSet models.CellSet) (models.CellSet, error) {
+	if p.safetyValve != nil {
+		var err error
+		cellSet, err = p.safetyValve.PlanCellSet(ctx, logger, name, cellSet)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.cellCordons != nil {
+		err := p.cellCordons.FlagCells(ctx, logger, cellSet)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cellSet, nil
+}
+
+// lrpConvergencePlanFor keeps the keys of the plan with the process guid.
+func lrpConvergencePlanFor(plan db.LRPConvergencePlan, processGuid string) db.LRPConvergencePlan {
+	return db.LRPConvergencePlan{
+		ConvergenceResult: db.ConvergenceResult{
+			MissingLRPKeys:               scheduledKeysFor(plan.MissingLRPKeys, processGuid),
+			UnstartedLRPKeys:             scheduledKeysFor(plan.UnstartedLRPKeys, processGuid),
+			KeysToRetire:                 keysFor(plan.KeysToRetire, processGuid),
+			SuspectLRPKeysToRetire:       keysFor(plan.SuspectLRPKeysToRetire, processGuid),
+			KeysWithMissingCells:         scheduledKeysFor(plan.KeysWithMissingCells, processGuid),
+			KeysOnDrainingCells:          scheduledKeysFor(plan.KeysOnDrainingCells, processGuid),
+			KeysDelayedByBudget:          keysFor(plan.KeysDelayedByBudget, processGuid),
+			MissingCellIds:               plan.MissingCellIds,
+			SuspectKeysWithExistingCells: keysFor(plan.SuspectKeysWithExistingCells, processGuid),
+			SuspectRunningKeys:           keysFor(plan.SuspectRunningKeys, processGuid),
+			SuspectClaimedKeys:           keysFor(plan.SuspectClaimedKeys, processGuid),
+		},
+		ExpiredDomains:         plan.ExpiredDomains,
+		EvacuatingKeysToRemove: keysFor(plan.EvacuatingKeysToRemove, processGuid),
+	}
+}
+
+func lrpConvergencePlan(plan db.LRPConvergencePlan, sampleSize int) *models.LRPConvergencePlan {
+	// LRPs with missing cells are unclaimed instead of marked suspect when a
+	// suspect LRP already exists, as LRPConvergenceController does
+	suspectKeys := map[models.ActualLRPKey]struct{}{}
+	for _, key := range plan.SuspectRunningKeys {
+		suspectKeys[*key] = struct{}{}
+	}
+	for _, key := range plan.SuspectClaimedKeys {
+		suspectKeys[*key] = struct{}{}
+	}
+
+	var keysToMarkSuspect, keysToUnclaim []*models.ActualLRPKey
+	for _, key := range plan.KeysWithMissingCells {
+		if _, ok := suspectKeys[*key.Key]; ok {
+			keysToUnclaim = append(keysToUnclaim, key.Key)
+		} else {
+			keysToMarkSuspect = append(keysToMarkSuspect, key.Key)
+		}
+	}
+
+	return &models.LRPConvergencePlan{
+		MissingInstancesToCreate:    models.NewActualLRPKeySample(scheduledKeys(plan.MissingLRPKeys), sampleSize),
+		InstancesToStart:            models.NewActualLRPKeySample(scheduledKeys(plan.UnstartedLRPKeys), sampleSize),
+		InstancesToRetire:           models.NewActualLRPKeySample(plan.KeysToRetire, sampleSize),
+		InstancesToMarkSuspect:      models.NewActualLRPKeySample(keysToMarkSuspect, sampleSize),
+		InstancesToUnclaim:          models.NewActualLRPKeySample(keysToUnclaim, sampleSize),
+		SuspectInstancesToRestore:   models.NewActualLRPKeySample(plan.SuspectKeysWithExistingCells, sampleSize),
+		SuspectInstancesToRemove:    models.NewActualLRPKeySample(plan.SuspectLRPKeysToRetire, sampleSize),
+		EvacuatingInstancesToRemove: models.NewActualLRPKeySample(plan.EvacuatingKeysToRemove, sampleSize),
+		DrainingInstancesToReplace:  models.NewActualLRPKeySample(scheduledKeys(plan.KeysOnDrainingCells), sampleSize),
+		DrainingInstancesDelayed:    models.NewActualLRPKeySample(plan.KeysDelayedByBudget, sampleSize),
+	}
+}
+
+func taskConvergencePlan(plan db.TaskConvergencePlan, sampleSize int) *models.TaskConvergencePlan {
+	return &models.TaskConvergencePlan{
+		ExpiredPendingTasksToFail:   models.NewTaskGuidSample(taskGuids(plan.ExpiredPendingTasks), sampleSize),
+		PendingTasksToKick:          models.NewTaskGuidSample(taskGuids(plan.PendingTasksToKick), sampleSize),
+		TasksWithMissingCellsToFail: models.NewTaskGuidSample(taskGuids(plan.TasksWithMissingCells), sampleSize),
+		ResolvingTasksToDemote:      models.NewTaskGuidSample(taskGuids(plan.ResolvingTasksToDemote), sampleSize),
+		CompletedTasksToDelete:      models.NewTaskGuidSample(taskGuids(plan.ExpiredCompletedTasks), sampleSize),
+		CompletedTasksToKick:        models.NewTaskGuidSample(taskGuids(plan.CompletedTasksToKick), sampleSize),
+		InvalidTasksToDelete:        models.NewTaskGuidSample(plan.InvalidTaskGuids, sampleSize),
+	}
+}
+
+func scheduledKeys(keys []*models.ActualLRPKeyWithSchedulingInfo) []*models.ActualLRPKey {
+	result := make([]*models.ActualLRPKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, key.Key)
+	}
+	return result
+}
+
+func keysFor(keys []*models.ActualLRPKey, processGuid string) []*models.ActualLRPKey {
+	var result []*models.ActualLRPKey
+	for _, key := range keys {
+		if key.ProcessGuid == processGuid {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+func scheduledKeysFor(keys []*models.ActualLRPKeyWithSchedulingInfo, processGuid string) []*models.ActualLRPKeyWithSchedulingInfo {
+	var result []*models.ActualLRPKeyWithSchedulingInfo
+	for _, key := range keys {
+		if key.Key.ProcessGuid == processGuid {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+func taskGuids(tasks []*models.Task) []string {
+	guids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		guids = append(guids, task.TaskGuid)
+	}
+	return guids
+}
@@ -0,0 +1,303 @@
+package controllers_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/controllers/fakes"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/diego-logging-client/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConvergencePlanner", func() {
+	var (
+		fakeClock  *fakeclock.FakeClock
+		fakeLRPDB  *dbfakes.FakeLRPDB
+		fakeTaskDB *dbfakes.FakeTaskDB
+
+		fakeCellCordonDB    *dbfakes.FakeCellCordonDB
+		fakeMaintenanceMode *fakes.FakeMaintenanceMode
+
+		cellSet models.CellSet
+		keys    []*models.ActualLRPKey
+
+		planner    *controllers.ConvergencePlanner
+		sampleSize int
+		plan       *models.ConvergencePlan
+		err        error
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Unix(0, 1000*int64(time.Minute)))
+		fakeLRPDB = new(dbfakes.FakeLRPDB)
+		fakeTaskDB = new(dbfakes.FakeTaskDB)
+		fakeCellCordonDB = new(dbfakes.FakeCellCordonDB)
+		fakeMaintenanceMode = new(fakes.FakeMaintenanceMode)
+
+		cellPresence := models.NewCellPresence("cell-id", "1.1.1.1", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+		cellSet = models.CellSet{"cell-id": &cellPresence}
+		fakeServiceClient.CellsReturns(cellSet, nil)
+
+		keys = nil
+		for i := 0; i < 3; i++ {
+			key := models.NewActualLRPKey("some-guid", int32(i), "some-domain")
+			keys = append(keys, &key)
+		}
+
+		fakeLRPDB.PlanLRPConvergenceReturns(db.LRPConvergencePlan{
+			ConvergenceResult: db.ConvergenceResult{
+				MissingLRPKeys: []*models.ActualLRPKeyWithSchedulingInfo{{Key: keys[0]}},
+				KeysToRetire:   keys,
+				KeysWithMissingCells: []*models.ActualLRPKeyWithSchedulingInfo{
+					{Key: keys[1]},
+					{Key: keys[2]},
+				},
+				SuspectRunningKeys: []*models.ActualLRPKey{keys[2]},
+				MissingCellIds:     []string{"missing-cell"},
+			},
+			ExpiredDomains:         []string{"expired-domain"},
+			EvacuatingKeysToRemove: []*models.ActualLRPKey{keys[0]},
+		})
+
+		fakeTaskDB.PlanTaskConvergenceReturns(db.TaskConvergencePlan{
+			ExpiredPendingTasks:   []*models.Task{{TaskGuid: "task-1"}, {TaskGuid: "task-2"}},
+			CompletedTasksToKick:  []*models.Task{{TaskGuid: "task-3"}},
+			InvalidTaskGuids:      []string{"invalid-task"},
+			ExpiredCompletedTasks: []*models.Task{},
+		})
+
+		cellCordons := controllers.NewCellCordonController(fakeCellCordonDB, fakeClock)
+		planner = controllers.NewConvergencePlanner(fakeLRPDB, fakeTaskDB, fakeServiceClient, fakeClock, 30*time.Second, time.Minute, 2*time.Minute, cellCordons, nil, fakeMaintenanceMode)
+		sampleSize = 2
+	})
+
+	JustBeforeEach(func() {
+		plan, err = planner.PlanConvergence(ctx, logger, sampleSize)
+	})
+
+	It("plans LRP convergence with the current cells", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeLRPDB.PlanLRPConvergenceCallCount()).To(Equal(1))
+		_, _, actualCellSet := fakeLRPDB.PlanLRPConvergenceArgsForCall(0)
+		Expect(actualCellSet).To(Equal(cellSet))
+
+		Expect(plan.PlannedAt).To(Equal(fakeClock.Now().UnixNano()))
+		Expect(plan.CellCount).To(BeEquivalentTo(1))
+		Expect(plan.MissingCellIds).To(ConsistOf("missing-cell"))
+		Expect(plan.ExpiredDomains).To(ConsistOf("expired-domain"))
+		Expect(plan.ConvergencePaused).To(BeFalse())
+	})
+
+	It("plans task convergence with the current cells and durations", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeTaskDB.PlanTaskConvergenceCallCount()).To(Equal(1))
+		_, _, actualCellSet, kick, expirePending, expireCompleted := fakeTaskDB.PlanTaskConvergenceArgsForCall(0)
+		Expect(actualCellSet).To(Equal(cellSet))
+		Expect(kick).To(Equal(30 * time.Second))
+		Expect(expirePending).To(Equal(time.Minute))
+		Expect(expireCompleted).To(Equal(2 * time.Minute))
+	})
+
+	It("counts every step and samples up to the sample size", func() {
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(plan.LRPs.MissingInstancesToCreate.Count).To(BeEquivalentTo(1))
+		Expect(plan.LRPs.MissingInstancesToCreate.Samples).To(Equal([]*models.ActualLRPKey{keys[0]}))
+		Expect(plan.LRPs.InstancesToRetire.Count).To(BeEquivalentTo(3))
+		Expect(plan.LRPs.InstancesToRetire.Samples).To(Equal(keys[:2]))
+		Expect(plan.LRPs.EvacuatingInstancesToRemove.Count).To(BeEquivalentTo(1))
+		Expect(plan.LRPs.InstancesToStart.Count).To(BeZero())
+		Expect(plan.LRPs.InstancesToStart.Samples).To(BeEmpty())
+
+		Expect(plan.Tasks.ExpiredPendingTasksToFail.Count).To(BeEquivalentTo(2))
+		Expect(plan.Tasks.ExpiredPendingTasksToFail.TaskGuids).To(Equal([]string{"task-1", "task-2"}))
+		Expect(plan.Tasks.CompletedTasksToKick.TaskGuids).To(Equal([]string{"task-3"}))
+		Expect(plan.Tasks.InvalidTasksToDelete.TaskGuids).To(Equal([]string{"invalid-task"}))
+		Expect(plan.Tasks.CompletedTasksToDelete.Count).To(BeZero())
+	})
+
+	It("unclaims LRPs on missing cells that already have a suspect instance and marks the rest suspect", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.LRPs.InstancesToMarkSuspect.Samples).To(Equal([]*models.ActualLRPKey{keys[1]}))
+		Expect(plan.LRPs.InstancesToUnclaim.Samples).To(Equal([]*models.ActualLRPKey{keys[2]}))
+	})
+
+	Context("when the BBS is in maintenance mode", func() {
+		BeforeEach(func() {
+			fakeMaintenanceMode.EnabledReturns(true)
+		})
+
+		It("reports that convergence is paused", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.ConvergencePaused).To(BeTrue())
+			Expect(plan.LRPs.InstancesToRetire.Count).To(BeEquivalentTo(3))
+		})
+	})
+
+	Context("when the sample size is not set", func() {
+		BeforeEach(func() {
+			sampleSize = 0
+		})
+
+		It("uses the default sample size", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.LRPs.InstancesToRetire.Samples).To(Equal(keys))
+		})
+	})
+
+	Context("when a cell is draining", func() {
+		BeforeEach(func() {
+			fakeCellCordonDB.CellCordonsReturns([]*models.CellCordon{{CellId: "cell-id", Draining: true}}, nil)
+
+			fakeLRPDB.PlanLRPConvergenceReturns(db.LRPConvergencePlan{
+				ConvergenceResult: db.ConvergenceResult{
+					KeysOnDrainingCells: []*models.ActualLRPKeyWithSchedulingInfo{{Key: keys[1]}},
+					KeysDelayedByBudget: []*models.ActualLRPKey{keys[2]},
+				},
+			})
+		})
+
+		It("plans with the cell flagged and lists the LRPs to replace", func() {
+			Expect(err).NotTo(HaveOccurred())
+			_, _, actualCellSet := fakeLRPDB.PlanLRPConvergenceArgsForCall(0)
+			Expect(actualCellSet["cell-id"].Cordoned).To(BeTrue())
+			Expect(actualCellSet["cell-id"].Draining).To(BeTrue())
+			Expect(plan.LRPs.DrainingInstancesToReplace.Samples).To(Equal([]*models.ActualLRPKey{keys[1]}))
+			Expect(plan.LRPs.DrainingInstancesDelayed.Samples).To(Equal([]*models.ActualLRPKey{keys[2]}))
+		})
+	})
+
+	Context("when fetching the cell cordons fails", func() {
+		BeforeEach(func() {
+			fakeCellCordonDB.CellCordonsReturns(nil, errors.New("kaboom"))
+		})
+
+		It("returns the error without planning", func() {
+			Expect(err).To(MatchError("kaboom"))
+			Expect(fakeLRPDB.PlanLRPConvergenceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the task durations change", func() {
+		BeforeEach(func() {
+			planner.SetTaskDurations(time.Second, 2*time.Second, 3*time.Second)
+		})
+
+		It("plans task convergence with the new durations", func() {
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, kick, expirePending, expireCompleted := fakeTaskDB.PlanTaskConvergenceArgsForCall(0)
+			Expect(kick).To(Equal(time.Second))
+			Expect(expirePending).To(Equal(2 * time.Second))
+			Expect(expireCompleted).To(Equal(3 * time.Second))
+		})
+	})
+
+	Context("when there are no cells", func() {
+		BeforeEach(func() {
+			fakeServiceClient.CellsReturns(nil, models.ErrResourceNotFound)
+		})
+
+		It("plans with an empty cell set", func() {
+			Expect(err).NotTo(HaveOccurred())
+			_, _, actualCellSet := fakeLRPDB.PlanLRPConvergenceArgsForCall(0)
+			Expect(actualCellSet).To(BeEmpty())
+			Expect(plan.CellCount).To(BeZero())
+		})
+	})
+
+	Context("when listing cells fails", func() {
+		BeforeEach(func() {
+			fakeServiceClient.CellsReturns(nil, errors.New("kaboom"))
+		})
+
+		It("returns the error without planning", func() {
+			Expect(err).To(MatchError("kaboom"))
+			Expect(plan).To(BeNil())
+			Expect(fakeLRPDB.PlanLRPConvergenceCallCount()).To(Equal(0))
+			Expect(fakeTaskDB.PlanTaskConvergenceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the safety valve of LRP convergence is tripped", func() {
+		var acceptedCellSet models.CellSet
+
+		BeforeEach(func() {
+			otherCellPresence := models.NewCellPresence("other-cell-id", "1.1.1.2", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+			acceptedCellSet = models.CellSet{"cell-id": cellSet["cell-id"], "other-cell-id": &otherCellPresence}
+
+			safetyValve := controllers.NewConvergenceSafetyValve(nil, fakeClock, new(testhelpers.FakeIngressClient), nil, 40, 0)
+			safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, acceptedCellSet)
+			safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, cellSet)
+
+			cellCordons := controllers.NewCellCordonController(fakeCellCordonDB, fakeClock)
+			planner = controllers.NewConvergencePlanner(fakeLRPDB, fakeTaskDB, fakeServiceClient, fakeClock, 30*time.Second, time.Minute, 2*time.Minute, cellCordons, safetyValve, fakeMaintenanceMode)
+		})
+
+		It("plans LRP convergence with the cells the valve keeps", func() {
+			Expect(err).NotTo(HaveOccurred())
+			_, _, actualCellSet := fakeLRPDB.PlanLRPConvergenceArgsForCall(0)
+			Expect(actualCellSet).To(Equal(acceptedCellSet))
+		})
+
+		It("plans task convergence with the current cells", func() {
+			Expect(err).NotTo(HaveOccurred())
+			_, _, actualCellSet, _, _, _ := fakeTaskDB.PlanTaskConvergenceArgsForCall(0)
+			Expect(actualCellSet).To(Equal(cellSet))
+		})
+
+		It("plans the instances of a process guid with the cells the valve keeps", func() {
+			_, plannedWith, err := planner.PlanActualLRPConvergence(ctx, logger, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plannedWith).To(Equal(acceptedCellSet))
+		})
+	})
+
+	Describe("PlanActualLRPConvergence", func() {
+		var (
+			lrpPlan     *models.LRPConvergencePlan
+			plannedWith models.CellSet
+			lrpErr      error
+		)
+
+		BeforeEach(func() {
+			for i := 0; i < 12; i++ {
+				key := models.NewActualLRPKey("some-guid", int32(i+3), "some-domain")
+				keys = append(keys, &key)
+			}
+			otherKey := models.NewActualLRPKey("other-guid", 0, "some-domain")
+
+			fakeLRPDB.PlanLRPConvergenceInScopeReturns(db.LRPConvergencePlan{
+				ConvergenceResult: db.ConvergenceResult{
+					KeysToRetire: append([]*models.ActualLRPKey{&otherKey}, keys...),
+				},
+				EvacuatingKeysToRemove: []*models.ActualLRPKey{&otherKey},
+			})
+		})
+
+		JustBeforeEach(func() {
+			lrpPlan, plannedWith, lrpErr = planner.PlanActualLRPConvergence(ctx, logger, "some-guid")
+		})
+
+		It("only plans the process guid", func() {
+			Expect(lrpErr).NotTo(HaveOccurred())
+			Expect(fakeLRPDB.PlanLRPConvergenceInScopeCallCount()).To(Equal(1))
+			_, _, _, scope := fakeLRPDB.PlanLRPConvergenceInScopeArgsForCall(0)
+			Expect(scope).To(Equal(db.LRPConvergenceScope{ProcessGuids: []string{"some-guid"}, AllCells: true}))
+		})
+
+		It("keeps every key of the process guid", func() {
+			Expect(lrpErr).NotTo(HaveOccurred())
+			Expect(plannedWith).To(Equal(cellSet))
+			Expect(lrpPlan.InstancesToRetire.Count).To(BeEquivalentTo(15))
+			Expect(lrpPlan.InstancesToRetire.Samples).To(Equal(keys))
+			Expect(lrpPlan.InstancesToRetire.HasIndex(14)).To(BeTrue())
+			Expect(lrpPlan.EvacuatingInstancesToRemove.Count).To(BeZero())
+		})
+	})
+})
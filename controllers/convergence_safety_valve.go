@@ -0,0 +1,335 @@
+package controllers
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	loggingclient "code.cloudfoundry.org/diego-logging-client"
+	"code.cloudfoundry.org/lager"
+)
+
+const (
+	LRPConvergenceSafetyValve  = "lrps"
+	TaskConvergenceSafetyValve = "tasks"
+
+	ConvergenceLRPSafetyValveTrippedMetric  = "ConvergenceLRPSafetyValveTripped"
+	ConvergenceTaskSafetyValveTrippedMetric = "ConvergenceTaskSafetyValveTripped"
+
+	// ConvergenceSafetyValveTrippedAuditRoute is the route of the audit entries
+	// recorded when a valve trips.
+	ConvergenceSafetyValveTrippedAuditRoute = "ConvergenceSafetyValveTripped"
+)
+
+var safetyValveMetrics = map[string]string{
+	LRPConvergenceSafetyValve:  ConvergenceLRPSafetyValveTrippedMetric,
+	TaskConvergenceSafetyValve: ConvergenceTaskSafetyValveTrippedMetric,
+}
+
+// ConvergenceSafetyValve keeps convergence from acting on a cell set that lost
+// too many cells in one pass, as when the cell registry briefly returns an
+// empty or partial cell set. LRP and task convergence each have their own
+// valve, as they list the cells separately. The cells each valve accepted are
+// stored in the database, so that a restarted BBS, or the one taking over the
+// lock, compares its first pass with them.
+type ConvergenceSafetyValve struct {
+	db           db.SafetyValveDB
+	clock        clock.Clock
+	metronClient loggingclient.IngressClient
+	auditSink    audit.Sink
+
+	lock                  sync.Mutex
+	maxMissingCellPercent int
+	confirmPasses         int
+	valves                map[string]*safetyValve
+}
+
+type safetyValve struct {
+	loaded             bool
+	acceptedCells      models.CellSet
+	missingCellIds     []string
+	missingCellPercent int
+	trippedPasses      int
+	overridden         bool
+}
+
+// NewConvergenceSafetyValve returns valves that store their accepted cells in
+// db unless it is nil, report trips through metronClient, and record them in
+// auditSink unless it is nil.
+func NewConvergenceSafetyValve(db db.SafetyValveDB, clock clock.Clock, metronClient loggingclient.IngressClient, auditSink audit.Sink, maxMissingCellPercent, confirmPasses int) *ConvergenceSafetyValve {
+	return &ConvergenceSafetyValve{
+		db:                    db,
+		clock:                 clock,
+		metronClient:          metronClient,
+		auditSink:             auditSink,
+		maxMissingCellPercent: maxMissingCellPercent,
+		confirmPasses:         confirmPasses,
+		valves: map[string]*safetyValve{
+			LRPConvergenceSafetyValve:  {},
+			TaskConvergenceSafetyValve: {},
+		},
+	}
+}
+
+// SetThresholds changes the thresholds later passes use.
+func (v *ConvergenceSafetyValve) SetThresholds(maxMissingCellPercent, confirmPasses int) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.maxMissingCellPercent = maxMissingCellPercent
+	v.confirmPasses = confirmPasses
+}
+
+// CellSet returns the cells the named convergence should act on. When more
+// than maxMissingCellPercent of the cells of the last accepted pass are
+// missing from cellSet, the valve trips and keeps those cells, so that
+// nothing on them is treated as lost. The valve accepts the cell set once it
+// has tripped for confirmPasses passes in a row, or after Override. A
+// maxMissingCellPercent of 0 disables the valve. It returns an error when the
+// cells of the last accepted pass cannot be loaded, and the pass should then
+// be skipped, as it cannot tell which cells are missing.
+func (v *ConvergenceSafetyValve) CellSet(ctx context.Context, logger lager.Logger, name string, cellSet models.CellSet) (models.CellSet, error) {
+	logger = logger.Session("safety-valve", lager.Data{"convergence": name})
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	valve := v.valves[name]
+	err := v.load(ctx, logger, name, valve)
+	if err != nil {
+		return nil, err
+	}
+
+	missingCellIds, missingCellPercent, tripped := v.compare(valve, cellSet)
+	if tripped {
+		valve.trippedPasses++
+	}
+
+	switch {
+	case !tripped:
+	case valve.overridden:
+		logger.Info("overridden", lager.Data{"missing_cell_ids": missingCellIds})
+	case v.confirmPasses > 0 && valve.trippedPasses >= v.confirmPasses:
+		logger.Info("confirmed", lager.Data{"missing_cell_ids": missingCellIds, "tripped_passes": valve.trippedPasses})
+	default:
+		valve.missingCellIds = missingCellIds
+		valve.missingCellPercent = missingCellPercent
+		logger.Error("tripped", nil, lager.Data{
+			"missing_cell_ids":         missingCellIds,
+			"missing_cell_percent":     missingCellPercent,
+			"max_missing_cell_percent": v.maxMissingCellPercent,
+			"tripped_passes":           valve.trippedPasses,
+		})
+		v.sendMetric(logger, name, 1)
+		if valve.trippedPasses == 1 {
+			v.recordTrip(logger, name, valve)
+		}
+
+		return keptCellSet(valve, cellSet), nil
+	}
+
+	v.store(ctx, logger, name, valve, cellSet)
+	valve.acceptedCells = cellSet
+	valve.missingCellIds = nil
+	valve.missingCellPercent = 0
+	valve.trippedPasses = 0
+	valve.overridden = false
+	v.sendMetric(logger, name, 0)
+
+	return cellSet, nil
+}
+
+// PlanCellSet returns the cells the next pass of the named convergence would
+// act on, without counting a pass. Plans use it so that they match what the
+// next pass does.
+func (v *ConvergenceSafetyValve) PlanCellSet(ctx context.Context, logger lager.Logger, name string, cellSet models.CellSet) (models.CellSet, error) {
+	logger = logger.Session("plan-safety-valve", lager.Data{"convergence": name})
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	valve := v.valves[name]
+	err := v.load(ctx, logger, name, valve)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, tripped := v.compare(valve, cellSet)
+	confirmed := v.confirmPasses > 0 && valve.trippedPasses+1 >= v.confirmPasses
+	if !tripped || valve.overridden || confirmed {
+		return cellSet, nil
+	}
+
+	return keptCellSet(valve, cellSet), nil
+}
+
+// load reads the cells the valve last accepted from the database, once. When
+// none were ever stored, the first pass is accepted. It is tried again on the
+// next pass after an error.
+func (v *ConvergenceSafetyValve) load(ctx context.Context, logger lager.Logger, name string, valve *safetyValve) error {
+	if valve.loaded || v.db == nil {
+		return nil
+	}
+
+	cellIds, err := v.db.SafetyValveCellIds(ctx, logger, name)
+	if err == models.ErrResourceNotFound {
+		valve.loaded = true
+		return nil
+	} else if err != nil {
+		logger.Error("failed-to-load-accepted-cells", err)
+		return err
+	}
+
+	acceptedCells := models.CellSet{}
+	for _, cellID := range cellIds {
+		acceptedCells[cellID] = &models.CellPresence{CellId: cellID}
+	}
+	valve.acceptedCells = acceptedCells
+	valve.loaded = true
+	logger.Info("loaded-accepted-cells", lager.Data{"accepted_cell_count": len(acceptedCells)})
+	return nil
+}
+
+// store writes the accepted cells to the database when they changed.
+func (v *ConvergenceSafetyValve) store(ctx context.Context, logger lager.Logger, name string, valve *safetyValve, cellSet models.CellSet) {
+	if v.db == nil || sameCells(valve.acceptedCells, cellSet) {
+		return
+	}
+
+	cellIds := make([]string, 0, len(cellSet))
+	for cellID := range cellSet {
+		cellIds = append(cellIds, cellID)
+	}
+	sort.Strings(cellIds)
+
+	err := v.db.SetSafetyValveCellIds(ctx, logger, name, cellIds)
+	if err != nil {
+		logger.Error("failed-to-store-accepted-cells", err)
+	}
+}
+
+func (v *ConvergenceSafetyValve) compare(valve *safetyValve, cellSet models.CellSet) ([]string, int, bool) {
+	var missingCellIds []string
+	for cellID := range valve.acceptedCells {
+		if _, ok := cellSet[cellID]; !ok {
+			missingCellIds = append(missingCellIds, cellID)
+		}
+	}
+	sort.Strings(missingCellIds)
+
+	missingCellPercent := 0
+	if len(valve.acceptedCells) > 0 {
+		missingCellPercent = len(missingCellIds) * 100 / len(valve.acceptedCells)
+	}
+
+	tripped := v.maxMissingCellPercent > 0 && len(missingCellIds)*100 > v.maxMissingCellPercent*len(valve.acceptedCells)
+	return missingCellIds, missingCellPercent, tripped
+}
+
+func keptCellSet(valve *safetyValve, cellSet models.CellSet) models.CellSet {
+	keptCellSet := models.CellSet{}
+	for cellID, cell := range valve.acceptedCells {
+		keptCellSet[cellID] = cell
+	}
+	for cellID, cell := range cellSet {
+		keptCellSet[cellID] = cell
+	}
+	return keptCellSet
+}
+
+func sameCells(a, b models.CellSet) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for cellID := range a {
+		if _, ok := b[cellID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Override makes the next pass of every convergence accept its cell set, even
+// when the valve trips.
+func (v *ConvergenceSafetyValve) Override(logger lager.Logger) *models.ConvergenceSafetyValveStatus {
+	logger = logger.Session("override-safety-valve")
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	for name, valve := range v.valves {
+		valve.overridden = true
+		logger.Info("overriding", lager.Data{"convergence": name, "tripped_passes": valve.trippedPasses})
+	}
+
+	return v.status()
+}
+
+func (v *ConvergenceSafetyValve) Status() *models.ConvergenceSafetyValveStatus {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	return v.status()
+}
+
+func (v *ConvergenceSafetyValve) status() *models.ConvergenceSafetyValveStatus {
+	status := &models.ConvergenceSafetyValveStatus{
+		MaxMissingCellPercent: int32(v.maxMissingCellPercent),
+		ConfirmPasses:         int32(v.confirmPasses),
+	}
+
+	names := make([]string, 0, len(v.valves))
+	for name := range v.valves {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		valve := v.valves[name]
+		status.Valves = append(status.Valves, &models.ConvergenceSafetyValveState{
+			Convergence:        name,
+			Tripped:            len(valve.missingCellIds) > 0,
+			MissingCellIds:     valve.missingCellIds,
+			MissingCellPercent: int32(valve.missingCellPercent),
+			TrippedPasses:      int32(valve.trippedPasses),
+			Overridden:         valve.overridden,
+			AcceptedCellCount:  int32(len(valve.acceptedCells)),
+		})
+	}
+
+	return status
+}
+
+func (v *ConvergenceSafetyValve) recordTrip(logger lager.Logger, name string, valve *safetyValve) {
+	if v.auditSink == nil {
+		return
+	}
+
+	err := v.auditSink.Record(logger, &models.AuditEntry{
+		Timestamp:  v.clock.Now().UnixNano(),
+		Route:      ConvergenceSafetyValveTrippedAuditRoute,
+		TargetGuid: name,
+		Request: audit.SummarizeRequest(&models.ConvergenceSafetyValveState{
+			Convergence:        name,
+			Tripped:            true,
+			MissingCellIds:     valve.missingCellIds,
+			MissingCellPercent: int32(valve.missingCellPercent),
+			TrippedPasses:      int32(valve.trippedPasses),
+			AcceptedCellCount:  int32(len(valve.acceptedCells)),
+		}, []string{"convergence", "tripped", "missing_cell_ids", "missing_cell_percent", "tripped_passes", "accepted_cell_count"}),
+	})
+	if err != nil {
+		logger.Error("failed-to-record-audit-entry", err)
+	}
+}
+
+func (v *ConvergenceSafetyValve) sendMetric(logger lager.Logger, name string, value int) {
+	err := v.metronClient.SendMetric(safetyValveMetrics[name], value)
+	if err != nil {
+		logger.Error("failed-sending-metric", err)
+	}
+}
@@ -0,0 +1,230 @@
+package controllers_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/bbs/audit/auditfakes"
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/diego-logging-client/testhelpers"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConvergenceSafetyValve", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeMetronClient *testhelpers.FakeIngressClient
+		fakeAuditSink    *auditfakes.FakeSink
+		fakeDB           *dbfakes.FakeSafetyValveDB
+		fakeClock        *fakeclock.FakeClock
+		safetyValve      *controllers.ConvergenceSafetyValve
+		fourCells        models.CellSet
+		oneCell          models.CellSet
+	)
+
+	newCellSet := func(count int) models.CellSet {
+		cellSet := models.CellSet{}
+		for i := 0; i < count; i++ {
+			cellID := fmt.Sprintf("cell-%d", i)
+			cellPresence := models.NewCellPresence(cellID, "1.1.1.1", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+			cellSet[cellID] = &cellPresence
+		}
+		return cellSet
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeMetronClient = new(testhelpers.FakeIngressClient)
+		fakeAuditSink = new(auditfakes.FakeSink)
+		fakeDB = new(dbfakes.FakeSafetyValveDB)
+		fakeDB.SafetyValveCellIdsReturns(nil, models.ErrResourceNotFound)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(0, 1000))
+		safetyValve = controllers.NewConvergenceSafetyValve(fakeDB, fakeClock, fakeMetronClient, fakeAuditSink, 50, 2)
+
+		fourCells = newCellSet(4)
+		oneCell = newCellSet(1)
+
+		Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, fourCells)).To(Equal(fourCells))
+	})
+
+	It("stores the accepted cells", func() {
+		Expect(fakeDB.SetSafetyValveCellIdsCallCount()).To(Equal(1))
+		_, _, name, cellIds := fakeDB.SetSafetyValveCellIdsArgsForCall(0)
+		Expect(name).To(Equal(controllers.LRPConvergenceSafetyValve))
+		Expect(cellIds).To(Equal([]string{"cell-0", "cell-1", "cell-2", "cell-3"}))
+	})
+
+	It("only stores the accepted cells when they change", func() {
+		Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, fourCells)).To(Equal(fourCells))
+		Expect(fakeDB.SafetyValveCellIdsCallCount()).To(Equal(1))
+		Expect(fakeDB.SetSafetyValveCellIdsCallCount()).To(Equal(1))
+	})
+
+	Context("when the accepted cells were stored by an earlier BBS", func() {
+		BeforeEach(func() {
+			fakeDB.SafetyValveCellIdsReturns([]string{"cell-0", "cell-1", "cell-2", "cell-3"}, nil)
+			safetyValve = controllers.NewConvergenceSafetyValve(fakeDB, fakeClock, fakeMetronClient, fakeAuditSink, 50, 2)
+		})
+
+		It("trips on a first pass that lost too many cells", func() {
+			cellSet, err := safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, models.CellSet{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cellSet).To(HaveLen(4))
+			Expect(cellSet).To(HaveKey("cell-3"))
+			Expect(safetyValve.Status().Valves[0].Tripped).To(BeTrue())
+			Expect(fakeDB.SetSafetyValveCellIdsCallCount()).To(Equal(1))
+		})
+
+		It("accepts a first pass with the same cells without storing them again", func() {
+			Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, fourCells)).To(Equal(fourCells))
+			Expect(fakeDB.SetSafetyValveCellIdsCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the accepted cells cannot be loaded", func() {
+		var err error
+
+		BeforeEach(func() {
+			fakeDB.SafetyValveCellIdsReturns(nil, errors.New("boom"))
+			safetyValve = controllers.NewConvergenceSafetyValve(fakeDB, fakeClock, fakeMetronClient, fakeAuditSink, 50, 2)
+			_, err = safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)
+		})
+
+		It("returns the error rather than accepting the cell set", func() {
+			Expect(err).To(MatchError("boom"))
+			Expect(fakeDB.SetSafetyValveCellIdsCallCount()).To(Equal(1))
+			Expect(safetyValve.Status().Valves[0].AcceptedCellCount).To(BeZero())
+		})
+
+		It("returns the error from plans", func() {
+			_, err := safetyValve.PlanCellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)
+			Expect(err).To(MatchError("boom"))
+		})
+
+		It("tries again on the next pass", func() {
+			Expect(fakeDB.SafetyValveCellIdsCallCount()).To(Equal(2))
+
+			fakeDB.SafetyValveCellIdsReturns([]string{"cell-0", "cell-1", "cell-2", "cell-3"}, nil)
+			Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)).To(HaveLen(4))
+			Expect(fakeDB.SafetyValveCellIdsCallCount()).To(Equal(3))
+		})
+	})
+
+	It("accepts cell sets that lose few enough cells", func() {
+		threeCells := newCellSet(3)
+		Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, threeCells)).To(Equal(threeCells))
+
+		name, value, _ := fakeMetronClient.SendMetricArgsForCall(fakeMetronClient.SendMetricCallCount() - 1)
+		Expect(name).To(Equal(controllers.ConvergenceLRPSafetyValveTrippedMetric))
+		Expect(value).To(Equal(0))
+	})
+
+	Context("when too many cells disappear", func() {
+		var cellSet models.CellSet
+
+		BeforeEach(func() {
+			var err error
+			cellSet, err = safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("keeps the cells of the last accepted pass", func() {
+			Expect(cellSet).To(Equal(fourCells))
+		})
+
+		It("emits the tripped metric", func() {
+			name, value, _ := fakeMetronClient.SendMetricArgsForCall(fakeMetronClient.SendMetricCallCount() - 1)
+			Expect(name).To(Equal(controllers.ConvergenceLRPSafetyValveTrippedMetric))
+			Expect(value).To(Equal(1))
+		})
+
+		It("records an audit entry", func() {
+			Expect(fakeAuditSink.RecordCallCount()).To(Equal(1))
+			_, entry := fakeAuditSink.RecordArgsForCall(0)
+			Expect(entry.Timestamp).To(Equal(fakeClock.Now().UnixNano()))
+			Expect(entry.Route).To(Equal(controllers.ConvergenceSafetyValveTrippedAuditRoute))
+			Expect(entry.TargetGuid).To(Equal(controllers.LRPConvergenceSafetyValve))
+			Expect(entry.Request).To(ContainSubstring("cell-3"))
+		})
+
+		It("reports the tripped valve", func() {
+			status := safetyValve.Status()
+			Expect(status.MaxMissingCellPercent).To(BeEquivalentTo(50))
+			Expect(status.Valves).To(HaveLen(2))
+
+			lrpValve := status.Valves[0]
+			Expect(lrpValve.Convergence).To(Equal(controllers.LRPConvergenceSafetyValve))
+			Expect(lrpValve.Tripped).To(BeTrue())
+			Expect(lrpValve.MissingCellIds).To(Equal([]string{"cell-1", "cell-2", "cell-3"}))
+			Expect(lrpValve.MissingCellPercent).To(BeEquivalentTo(75))
+			Expect(lrpValve.TrippedPasses).To(BeEquivalentTo(1))
+
+			Expect(status.Valves[1].Tripped).To(BeFalse())
+		})
+
+		It("does not trip the valve of task convergence", func() {
+			Expect(safetyValve.CellSet(ctx, logger, controllers.TaskConvergenceSafetyValve, oneCell)).To(Equal(oneCell))
+		})
+
+		It("plans with the cell set the confirming pass accepts", func() {
+			Expect(safetyValve.PlanCellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)).To(Equal(oneCell))
+		})
+
+		It("accepts the cell set once the condition persists for the confirm passes", func() {
+			Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)).To(Equal(oneCell))
+			Expect(fakeAuditSink.RecordCallCount()).To(Equal(1))
+			Expect(safetyValve.Status().Valves[0].Tripped).To(BeFalse())
+			Expect(safetyValve.Status().Valves[0].AcceptedCellCount).To(BeEquivalentTo(1))
+		})
+
+		It("starts over when the cells come back", func() {
+			Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, fourCells)).To(Equal(fourCells))
+			Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)).To(Equal(fourCells))
+		})
+
+		Context("when an operator overrides the valve", func() {
+			BeforeEach(func() {
+				status := safetyValve.Override(logger)
+				Expect(status.Valves[0].Overridden).To(BeTrue())
+			})
+
+			It("accepts the cell set of the next pass", func() {
+				Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)).To(Equal(oneCell))
+				Expect(safetyValve.Status().Valves[0].Overridden).To(BeFalse())
+			})
+		})
+
+		Context("when no confirm passes are set", func() {
+			BeforeEach(func() {
+				safetyValve.SetThresholds(50, 0)
+			})
+
+			It("plans with the cells of the last accepted pass without counting a pass", func() {
+				Expect(safetyValve.PlanCellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)).To(Equal(fourCells))
+				Expect(safetyValve.Status().Valves[0].TrippedPasses).To(BeEquivalentTo(1))
+			})
+
+			It("waits for an override", func() {
+				for i := 0; i < 5; i++ {
+					Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, oneCell)).To(Equal(fourCells))
+				}
+			})
+		})
+	})
+
+	Context("when the valve is disabled", func() {
+		BeforeEach(func() {
+			safetyValve.SetThresholds(0, 0)
+		})
+
+		It("accepts every cell set", func() {
+			Expect(safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, models.CellSet{})).To(Equal(models.CellSet{}))
+		})
+	})
+})
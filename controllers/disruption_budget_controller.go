@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/serviceclient"
+	"code.cloudfoundry.org/lager"
+)
+
+// DisruptionBudgetController reports how many voluntary disruptions the
+// disruption budget of a DesiredLRP allows, so that clients rolling an update
+// out can pace their scale-downs against it.
+type DisruptionBudgetController struct {
+	lrpDB         db.LRPDB
+	serviceClient serviceclient.ServiceClient
+	cellCordons   *CellCordonController
+	retirements   *RetirementReservations
+}
+
+func NewDisruptionBudgetController(
+	lrpDB db.LRPDB,
+	serviceClient serviceclient.ServiceClient,
+	cellCordons *CellCordonController,
+	retirements *RetirementReservations,
+) *DisruptionBudgetController {
+	return &DisruptionBudgetController{
+		lrpDB:         lrpDB,
+		serviceClient: serviceClient,
+		cellCordons:   cellCordons,
+		retirements:   retirements,
+	}
+}
+
+// DisruptionBudgetStatus returns the status of the budget of the process
+// guid, restricted to the given domains unless they are nil. The running
+// instances whose retire holds a disruption are reported as reserved.
+func (c *DisruptionBudgetController) DisruptionBudgetStatus(ctx context.Context, logger lager.Logger, processGuid string, domains []string) (*models.DisruptionBudgetStatus, error) {
+	logger = logger.Session("disruption-budget-status", lager.Data{"process_guid": processGuid})
+
+	schedulingInfos, err := c.lrpDB.DesiredLRPSchedulingInfos(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{processGuid}, Domains: domains})
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return nil, err
+	}
+	if len(schedulingInfos) == 0 {
+		return nil, models.ErrResourceNotFound
+	}
+	schedulingInfo := schedulingInfos[0]
+
+	lrps, err := c.lrpDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: processGuid})
+	if err != nil {
+		logger.Error("failed-fetching-actual-lrps", err)
+		return nil, err
+	}
+
+	cellSet, err := c.serviceClient.Cells(logger)
+	if err == models.ErrResourceNotFound {
+		cellSet = models.CellSet{}
+	} else if err != nil {
+		logger.Error("failed-listing-cells", err)
+		return nil, err
+	}
+
+	if c.cellCordons != nil {
+		err = c.cellCordons.FlagCells(ctx, logger, cellSet)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the instances retires let go are unavailable, as they are when retiring
+	availableLRPs := lrps
+	var reserved []string
+	if c.retirements != nil {
+		availableLRPs, reserved = c.retirements.withoutReserved(processGuid, lrps)
+	}
+
+	instances := schedulingInfo.Instances
+	budget := schedulingInfo.DisruptionBudget
+	available := models.AvailableInstances(availableLRPs, instances)
+	_, delayed := models.DrainMoves(lrps, instances, budget, func(cellID string) bool {
+		cell, ok := cellSet[cellID]
+		return ok && cell.Draining
+	})
+
+	return &models.DisruptionBudgetStatus{
+		ProcessGuid:            processGuid,
+		Budget:                 budget,
+		DesiredInstances:       instances,
+		AvailableInstances:     available,
+		AllowedDisruptions:     budget.AllowedDisruptions(instances, available),
+		DelayedDrainingIndices: delayed,
+		ReservedInstanceGuids:  reserved,
+	}, nil
+}
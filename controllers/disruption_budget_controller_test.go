@@ -0,0 +1,150 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DisruptionBudgetController", func() {
+	var (
+		fakeLRPDB        *dbfakes.FakeLRPDB
+		fakeCellCordonDB *dbfakes.FakeCellCordonDB
+		retirements      *controllers.RetirementReservations
+
+		schedulingInfo models.DesiredLRPSchedulingInfo
+		lrps           []*models.ActualLRP
+
+		controller *controllers.DisruptionBudgetController
+		status     *models.DisruptionBudgetStatus
+		err        error
+	)
+
+	BeforeEach(func() {
+		fakeLRPDB = new(dbfakes.FakeLRPDB)
+		fakeCellCordonDB = new(dbfakes.FakeCellCordonDB)
+
+		schedulingInfo = model_helpers.NewValidDesiredLRP("some-guid").DesiredLRPSchedulingInfo()
+		schedulingInfo.Instances = 3
+		schedulingInfo.DisruptionBudget = &models.DisruptionBudget{MinAvailable: 1}
+		fakeLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{&schedulingInfo}, nil)
+
+		lrps = nil
+		for i := int32(0); i < 3; i++ {
+			lrp := model_helpers.NewValidActualLRP("some-guid", i)
+			lrp.CellId = "draining-cell"
+			lrps = append(lrps, lrp)
+		}
+		lrps[2].State = models.ActualLRPStateCrashed
+		fakeLRPDB.ActualLRPsReturns(lrps, nil)
+
+		drainingCell := models.NewCellPresence("draining-cell", "1.1.1.1", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+		fakeServiceClient.CellsReturns(models.CellSet{"draining-cell": &drainingCell}, nil)
+		fakeCellCordonDB.CellCordonsReturns([]*models.CellCordon{{CellId: "draining-cell", Draining: true}}, nil)
+
+		cellCordons := controllers.NewCellCordonController(fakeCellCordonDB, fakeclock.NewFakeClock(time.Now()))
+		retirements = controllers.NewRetirementReservations(fakeclock.NewFakeClock(time.Now()), time.Minute)
+		controller = controllers.NewDisruptionBudgetController(fakeLRPDB, fakeServiceClient, cellCordons, retirements)
+	})
+
+	JustBeforeEach(func() {
+		status, err = controller.DisruptionBudgetStatus(ctx, logger, "some-guid", []string{"some-domain"})
+	})
+
+	It("fetches the desired LRP in the domains", func() {
+		Expect(err).NotTo(HaveOccurred())
+		_, _, filter := fakeLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+		Expect(filter).To(Equal(models.DesiredLRPFilter{ProcessGuids: []string{"some-guid"}, Domains: []string{"some-domain"}}))
+	})
+
+	It("reports the allowed disruptions and the delayed draining indices", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status).To(Equal(&models.DisruptionBudgetStatus{
+			ProcessGuid:            "some-guid",
+			Budget:                 &models.DisruptionBudget{MinAvailable: 1},
+			DesiredInstances:       3,
+			AvailableInstances:     2,
+			AllowedDisruptions:     1,
+			DelayedDrainingIndices: []int32{1},
+		}))
+	})
+
+	Context("when a retire holds a disruption", func() {
+		BeforeEach(func() {
+			cellPresence := models.NewCellPresence("draining-cell", "1.1.1.1", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+			fakeServiceClient.CellByIdReturns(&cellPresence, nil)
+			fakeLRPDB.ActualLRPsStub = func(_ context.Context, _ lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+				if filter.Index != nil {
+					return []*models.ActualLRP{lrps[*filter.Index]}, nil
+				}
+				return lrps, nil
+			}
+
+			for i, lrp := range lrps {
+				lrp.InstanceGuid = fmt.Sprintf("instance-guid-%d", i)
+			}
+
+			lifecycleController := controllers.NewActualLRPLifecycleController(
+				fakeLRPDB, nil, nil, fakeLRPDB, nil, nil,
+				fakeServiceClient,
+				fakeRepClientFactory,
+				new(eventfakes.FakeHub),
+				new(eventfakes.FakeHub),
+				nil,
+				retirements,
+			)
+			Expect(lifecycleController.RetireActualLRP(ctx, logger, &lrps[0].ActualLRPKey)).To(Succeed())
+		})
+
+		It("reports the reserved instance as unavailable", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.ReservedInstanceGuids).To(Equal([]string{lrps[0].InstanceGuid}))
+			Expect(status.AvailableInstances).To(BeEquivalentTo(1))
+			Expect(status.AllowedDisruptions).To(BeEquivalentTo(0))
+		})
+	})
+
+	Context("when the LRP has no budget", func() {
+		BeforeEach(func() {
+			schedulingInfo.DisruptionBudget = nil
+		})
+
+		It("allows every available instance to be disrupted", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Budget).To(BeNil())
+			Expect(status.AllowedDisruptions).To(BeEquivalentTo(2))
+			Expect(status.DelayedDrainingIndices).To(BeEmpty())
+		})
+	})
+
+	Context("when the LRP is not desired", func() {
+		BeforeEach(func() {
+			fakeLRPDB.DesiredLRPSchedulingInfosReturns(nil, nil)
+		})
+
+		It("returns a resource not found error", func() {
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Context("when fetching the actual LRPs fails", func() {
+		BeforeEach(func() {
+			fakeLRPDB.ActualLRPsReturns(nil, errors.New("boom"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+})
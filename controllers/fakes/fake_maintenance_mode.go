@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeMaintenanceMode struct {
+	EnabledStub        func(context.Context, lager.Logger) bool
+	enabledMutex       sync.RWMutex
+	enabledArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	enabledReturns struct {
+		result1 bool
+	}
+	enabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMaintenanceMode) Enabled(arg1 context.Context, arg2 lager.Logger) bool {
+	fake.enabledMutex.Lock()
+	ret, specificReturn := fake.enabledReturnsOnCall[len(fake.enabledArgsForCall)]
+	fake.enabledArgsForCall = append(fake.enabledArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.EnabledStub
+	fakeReturns := fake.enabledReturns
+	fake.recordInvocation("Enabled", []interface{}{arg1, arg2})
+	fake.enabledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeMaintenanceMode) EnabledCallCount() int {
+	fake.enabledMutex.RLock()
+	defer fake.enabledMutex.RUnlock()
+	return len(fake.enabledArgsForCall)
+}
+
+func (fake *FakeMaintenanceMode) EnabledCalls(stub func(context.Context, lager.Logger) bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = stub
+}
+
+func (fake *FakeMaintenanceMode) EnabledArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.enabledMutex.RLock()
+	defer fake.enabledMutex.RUnlock()
+	argsForCall := fake.enabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeMaintenanceMode) EnabledReturns(result1 bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = nil
+	fake.enabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeMaintenanceMode) EnabledReturnsOnCall(i int, result1 bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = nil
+	if fake.enabledReturnsOnCall == nil {
+		fake.enabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.enabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeMaintenanceMode) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.enabledMutex.RLock()
+	defer fake.enabledMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMaintenanceMode) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.MaintenanceMode = new(FakeMaintenanceMode)
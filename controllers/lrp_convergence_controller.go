@@ -33,6 +33,10 @@ type LRPConvergenceController struct {
 	retirer                Retirer
 	convergenceWorkersSize int
 	lrpStatMetronNotifier  metrics.LRPStatMetronNotifier
+	scheduler              *LRPConvergenceScheduler
+	safetyValve            *ConvergenceSafetyValve
+	cellCordons            *CellCordonController
+	settingsLock           sync.RWMutex
 }
 
 func NewLRPConvergenceController(
@@ -48,6 +52,9 @@ func NewLRPConvergenceController(
 	retirer Retirer,
 	convergenceWorkersSize int,
 	lrpStatMetronNotifier metrics.LRPStatMetronNotifier,
+	scheduler *LRPConvergenceScheduler,
+	safetyValve *ConvergenceSafetyValve,
+	cellCordons *CellCordonController,
 ) *LRPConvergenceController {
 	return &LRPConvergenceController{
 		logger:                 logger,
@@ -62,9 +69,20 @@ func NewLRPConvergenceController(
 		retirer:                retirer,
 		convergenceWorkersSize: convergenceWorkersSize,
 		lrpStatMetronNotifier:  lrpStatMetronNotifier,
+		scheduler:              scheduler,
+		safetyValve:            safetyValve,
+		cellCordons:            cellCordons,
 	}
 }
 
+// SetConvergenceWorkers changes the number of workers used by later
+// convergence runs.
+func (h *LRPConvergenceController) SetConvergenceWorkers(convergenceWorkersSize int) {
+	h.settingsLock.Lock()
+	defer h.settingsLock.Unlock()
+	h.convergenceWorkersSize = convergenceWorkersSize
+}
+
 func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context, logger lager.Logger) {
 	logger = h.logger.Session("converge-lrps")
 
@@ -85,7 +103,35 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context, logger lage
 	}
 	logger.Debug("succeeded-listing-cells")
 
-	convergenceResult := h.lrpDB.ConvergeLRPs(ctx, logger, cellSet)
+	if h.safetyValve != nil {
+		cellSet, err = h.safetyValve.CellSet(ctx, logger, LRPConvergenceSafetyValve, cellSet)
+		if err != nil {
+			// without the accepted cells, the LRPs on the cells missing from the
+			// cell set could be lost cells or a partial cell set
+			logger.Error("failed-applying-safety-valve", err)
+			return
+		}
+	}
+
+	if h.cellCordons != nil {
+		err = h.cellCordons.FlagCells(ctx, logger, cellSet)
+		if err != nil {
+			// without the cordons, the suspect LRPs of draining cells would be
+			// restored
+			logger.Error("failed-flagging-cordoned-cells", err)
+			return
+		}
+	}
+
+	// without a scheduler every pass converges every LRP
+	var convergenceResult db.ConvergenceResult
+	scope := db.LRPConvergenceScope{}
+	if h.scheduler != nil {
+		scope = h.scheduler.NextScope(cellSet)
+		convergenceResult = h.lrpDB.ConvergeLRPsInScope(ctx, logger, cellSet, scope)
+	} else {
+		convergenceResult = h.lrpDB.ConvergeLRPs(ctx, logger, cellSet)
+	}
 
 	events := convergenceResult.Events
 	for _, e := range events {
@@ -137,14 +183,20 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context, logger lage
 		claimed, unclaimed, running, crashed, crashingDesired := h.lrpDB.CountActualLRPsByState(ctx, logger)
 		desired := h.lrpDB.CountDesiredInstances(ctx, logger)
 
+		missing, extra, suspectCells := len(convergenceResult.MissingLRPKeys), len(convergenceResult.KeysToRetire), len(convergenceResult.MissingCellIds)
+		if h.scheduler != nil && !scope.Full() {
+			// the result of an incremental pass only covers its scope
+			missing, extra, suspectCells = h.scheduler.RecordResult(scope, convergenceResult)
+		}
+
 		h.lrpStatMetronNotifier.RecordLRPCounts(
 			unclaimed, claimed, running, crashed,
-			len(convergenceResult.MissingLRPKeys), len(convergenceResult.KeysToRetire),
+			missing, extra,
 			len(convergenceResult.SuspectRunningKeys), len(convergenceResult.SuspectClaimedKeys),
 			desired, crashingDesired,
 		)
 
-		h.lrpStatMetronNotifier.RecordCellCounts(len(cellSet), len(convergenceResult.MissingCellIds))
+		h.lrpStatMetronNotifier.RecordCellCounts(len(cellSet), suspectCells)
 	}()
 
 	for _, key := range convergenceResult.MissingLRPKeys {
@@ -197,6 +249,30 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context, logger lage
 		suspectKeyMap[*suspectKey] = 0
 	}
 
+	// marks the LRP suspect, and creates and auctions a replacement for it
+	replaceLRP := func(logger lager.Logger, key *models.ActualLRPKeyWithSchedulingInfo, reason string) {
+		before, after, err := h.lrpDB.ChangeActualLRPPresence(ctx, logger, key.Key, models.ActualLRP_Ordinary, models.ActualLRP_Suspect)
+		if err != nil {
+			logger.Error("cannot-change-lrp-presence", err, lager.Data{"key": key})
+			return
+		}
+		go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceChangedEvent(before, after))
+
+		unclaimed, err := h.lrpDB.CreateUnclaimedActualLRP(ctx, logger.Session("create-unclaimed-actual"), key.Key)
+		if err != nil {
+			logger.Error("cannot-unclaim-lrp", err)
+			return
+		}
+		go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(unclaimed))
+
+		startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(key.SchedulingInfo, int(key.Key.Index))
+		startRequestLock.Lock()
+		startRequests = append(startRequests, &startRequest)
+		startRequestLock.Unlock()
+		logger.Info("creating-start-request",
+			lager.Data{"reason": reason, "process_guid": key.Key.ProcessGuid, "index": key.Key.Index})
+	}
+
 	for _, key := range convergenceResult.KeysWithMissingCells {
 		dereferencedKey := *key
 		handleLRP := func() {
@@ -222,31 +298,19 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context, logger lage
 				return
 			}
 
-			before, after, err := h.lrpDB.ChangeActualLRPPresence(ctx, logger, dereferencedKey.Key, models.ActualLRP_Ordinary, models.ActualLRP_Suspect)
-			if err != nil {
-				logger.Error("cannot-change-lrp-presence", err, lager.Data{"key": dereferencedKey})
-				return
-			}
-			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceChangedEvent(before, after))
-
-			unclaimed, err := h.lrpDB.CreateUnclaimedActualLRP(ctx, logger.Session("create-unclaimed-actual"), dereferencedKey.Key)
-			if err != nil {
-				logger.Error("cannot-unclaim-lrp", err)
-				return
-			}
-			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(unclaimed))
-
-			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo, int(dereferencedKey.Key.Index))
-			startRequestLock.Lock()
-			startRequests = append(startRequests, &startRequest)
-			startRequestLock.Unlock()
-			logger.Info("creating-start-request",
-				lager.Data{"reason": "missing-cell", "process_guid": dereferencedKey.Key.ProcessGuid, "index": dereferencedKey.Key.Index})
+			replaceLRP(logger, &dereferencedKey, "missing-cell")
 		}
 
 		works = append(works, handleLRP)
 	}
 
+	for _, key := range convergenceResult.KeysOnDrainingCells {
+		dereferencedKey := *key
+		works = append(works, func() {
+			replaceLRP(logger.Session("keys-on-draining-cells"), &dereferencedKey, "draining-cell")
+		})
+	}
+
 	for _, key := range convergenceResult.SuspectKeysWithExistingCells {
 		dereferencedKey := *key
 		works = append(works, func() {
@@ -281,10 +345,14 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context, logger lage
 		})
 	}
 
+	h.settingsLock.RLock()
+	convergenceWorkersSize := h.convergenceWorkersSize
+	h.settingsLock.RUnlock()
+
 	var throttler *workpool.Throttler
-	throttler, err = workpool.NewThrottler(h.convergenceWorkersSize, works)
+	throttler, err = workpool.NewThrottler(convergenceWorkersSize, works)
 	if err != nil {
-		logger.Error("failed-constructing-throttler", err, lager.Data{"max_workers": h.convergenceWorkersSize, "num_works": len(works)})
+		logger.Error("failed-constructing-throttler", err, lager.Data{"max_workers": convergenceWorkersSize, "num_works": len(works)})
 		return
 	}
 
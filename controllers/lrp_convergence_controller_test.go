@@ -17,6 +17,7 @@ import (
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
 	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/diego-logging-client/testhelpers"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"code.cloudfoundry.org/rep/repfakes"
@@ -37,6 +38,9 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		retirer                   *fakes.FakeRetirer
 		fakeAuctioneerClient      *auctioneerfakes.FakeClient
 		fakeLRPStatMetronNotifier *mfakes.FakeLRPStatMetronNotifier
+		scheduler                 *controllers.LRPConvergenceScheduler
+		safetyValve               *controllers.ConvergenceSafetyValve
+		cellCordons               *controllers.CellCordonController
 
 		keysToRetire         []*models.ActualLRPKey
 		keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo
@@ -76,6 +80,9 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		actualHub = &eventfakes.FakeHub{}
 		actualLRPInstanceHub = &eventfakes.FakeHub{}
 		retirer = &fakes.FakeRetirer{}
+		scheduler = nil
+		safetyValve = nil
+		cellCordons = nil
 	})
 
 	JustBeforeEach(func() {
@@ -92,6 +99,9 @@ var _ = Describe("LRP Convergence Controllers", func() {
 			retirer,
 			2,
 			fakeLRPStatMetronNotifier,
+			scheduler,
+			safetyValve,
+			cellCordons,
 		)
 		controller.ConvergeLRPs(ctx, logger)
 	})
@@ -373,6 +383,89 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		})
 	})
 
+	Context("when convergence is incremental", func() {
+		BeforeEach(func() {
+			scheduler = controllers.NewLRPConvergenceScheduler(4)
+			scheduler.MarkDirty("some-guid")
+
+			key := models.NewActualLRPKey("some-guid", 1, "some-domain")
+			fakeLRPDB.ConvergeLRPsInScopeReturns(db.ConvergenceResult{
+				KeysToRetire: []*models.ActualLRPKey{&key},
+			})
+		})
+
+		It("converges the scope of the next pass", func() {
+			Expect(fakeLRPDB.ConvergeLRPsCallCount()).To(Equal(0))
+			Expect(fakeLRPDB.ConvergeLRPsInScopeCallCount()).To(Equal(1))
+			_, _, actualCellSet, scope := fakeLRPDB.ConvergeLRPsInScopeArgsForCall(0)
+			Expect(actualCellSet).To(BeEquivalentTo(cellSet))
+			Expect(scope).To(Equal(db.LRPConvergenceScope{
+				ProcessGuids: []string{"some-guid"},
+				Shard:        0,
+				ShardCount:   4,
+				AllCells:     true,
+			}))
+		})
+
+		It("acts on the result of the pass", func() {
+			Eventually(retirer.RetireActualLRPCallCount).Should(Equal(1))
+			_, _, key := retirer.RetireActualLRPArgsForCall(0)
+			Expect(key.ProcessGuid).To(Equal("some-guid"))
+		})
+
+		Context("when recording metrics", func() {
+			BeforeEach(func() {
+				inShard := "guid-b"
+				Expect(db.ProcessGuidShard(inShard, 4)).To(Equal(0))
+				Expect(db.ProcessGuidShard("some-guid", 4)).NotTo(Equal(0))
+
+				fakeLRPDB.ConvergeLRPsInScopeReturns(db.ConvergenceResult{
+					KeysToRetire:   []*models.ActualLRPKey{{ProcessGuid: "some-guid"}, {ProcessGuid: inShard}},
+					MissingCellIds: []string{"missing-cell"},
+				})
+			})
+
+			It("records the totals of the sweep rather than of the dirty process guids", func() {
+				Expect(fakeLRPStatMetronNotifier.RecordLRPCountsCallCount()).To(Equal(1))
+				_, _, _, _, missing, extra, _, _, _, _ := fakeLRPStatMetronNotifier.RecordLRPCountsArgsForCall(0)
+				Expect(missing).To(Equal(0))
+				Expect(extra).To(Equal(1))
+
+				Expect(fakeLRPStatMetronNotifier.RecordCellCountsCallCount()).To(Equal(1))
+				_, suspectCells := fakeLRPStatMetronNotifier.RecordCellCountsArgsForCall(0)
+				Expect(suspectCells).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the safety valve trips", func() {
+		BeforeEach(func() {
+			safetyValve = controllers.NewConvergenceSafetyValve(nil, fakeClock, new(testhelpers.FakeIngressClient), nil, 50, 0)
+			safetyValve.CellSet(ctx, logger, controllers.LRPConvergenceSafetyValve, cellSet)
+
+			fakeServiceClient.CellsReturns(models.CellSet{}, nil)
+		})
+
+		It("converges with the cells of the last accepted pass", func() {
+			Expect(fakeLRPDB.ConvergeLRPsCallCount()).To(Equal(1))
+			_, _, actualCellSet := fakeLRPDB.ConvergeLRPsArgsForCall(0)
+			Expect(actualCellSet).To(BeEquivalentTo(cellSet))
+		})
+	})
+
+	Context("when the safety valve cannot load the accepted cells", func() {
+		BeforeEach(func() {
+			fakeSafetyValveDB := new(dbfakes.FakeSafetyValveDB)
+			fakeSafetyValveDB.SafetyValveCellIdsReturns(nil, errors.New("boom"))
+			safetyValve = controllers.NewConvergenceSafetyValve(fakeSafetyValveDB, fakeClock, new(testhelpers.FakeIngressClient), nil, 50, 0)
+		})
+
+		It("skips the pass", func() {
+			Expect(fakeLRPDB.ConvergeLRPsCallCount()).To(Equal(0))
+			Eventually(logger).Should(gbytes.Say("failed-applying-safety-valve"))
+		})
+	})
+
 	Context("when fetching the cells fails", func() {
 		BeforeEach(func() {
 			fakeServiceClient.CellsReturns(nil, errors.New("kaboom"))
@@ -545,6 +638,91 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		})
 	})
 
+	Context("when cells are cordoned", func() {
+		var fakeCellCordonDB *dbfakes.FakeCellCordonDB
+
+		BeforeEach(func() {
+			fakeCellCordonDB = new(dbfakes.FakeCellCordonDB)
+			fakeCellCordonDB.CellCordonsReturns([]*models.CellCordon{{CellId: "cell-id", Draining: true}}, nil)
+			cellCordons = controllers.NewCellCordonController(fakeCellCordonDB, fakeClock)
+		})
+
+		It("converges with the draining cells flagged", func() {
+			Expect(fakeLRPDB.ConvergeLRPsCallCount()).To(Equal(1))
+			_, _, actualCellSet := fakeLRPDB.ConvergeLRPsArgsForCall(0)
+			Expect(actualCellSet["cell-id"].Cordoned).To(BeTrue())
+			Expect(actualCellSet["cell-id"].Draining).To(BeTrue())
+		})
+
+		Context("when there is an LRP on a draining cell", func() {
+			var (
+				before, after, drainingActualLRP *models.ActualLRP
+				unclaimed                        *models.ActualLRP
+			)
+
+			BeforeEach(func() {
+				drainingActualLRP = model_helpers.NewValidActualLRP("to-unclaim-1", 0)
+				fakeLRPDB.ConvergeLRPsReturns(db.ConvergenceResult{
+					KeysOnDrainingCells: []*models.ActualLRPKeyWithSchedulingInfo{
+						{Key: &drainingActualLRP.ActualLRPKey, SchedulingInfo: &desiredLRP1},
+					},
+				})
+
+				before = &models.ActualLRP{Presence: models.ActualLRP_Ordinary}
+				after = &models.ActualLRP{Presence: models.ActualLRP_Suspect}
+				fakeLRPDB.ChangeActualLRPPresenceReturns(before, after, nil)
+
+				unclaimed = &models.ActualLRP{State: models.ActualLRPStateUnclaimed}
+				fakeLRPDB.CreateUnclaimedActualLRPReturns(unclaimed, nil)
+			})
+
+			It("makes the LRP suspect", func() {
+				Expect(fakeLRPDB.ChangeActualLRPPresenceCallCount()).To(Equal(1))
+				_, _, key, from, to := fakeLRPDB.ChangeActualLRPPresenceArgsForCall(0)
+				Expect(key).To(Equal(&drainingActualLRP.ActualLRPKey))
+				Expect(from).To(Equal(models.ActualLRP_Ordinary))
+				Expect(to).To(Equal(models.ActualLRP_Suspect))
+			})
+
+			It("auctions a replacement", func() {
+				Expect(fakeLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(1))
+				_, _, lrpKey := fakeLRPDB.CreateUnclaimedActualLRPArgsForCall(0)
+				Expect(lrpKey).To(Equal(&drainingActualLRP.ActualLRPKey))
+
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+				unclaimedStartRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(&desiredLRP1, 0)
+				_, startAuctions := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+				Expect(startAuctions).To(ConsistOf(&unclaimedStartRequest))
+			})
+
+			It("emits instance change events", func() {
+				Eventually(actualLRPInstanceHub.EmitCallCount).Should(Equal(2))
+				events := []models.Event{
+					actualLRPInstanceHub.EmitArgsForCall(0),
+					actualLRPInstanceHub.EmitArgsForCall(1),
+				}
+				Expect(events).To(ConsistOf(
+					models.NewActualLRPInstanceChangedEvent(before, after),
+					models.NewActualLRPInstanceCreatedEvent(unclaimed),
+				))
+			})
+		})
+
+		Context("when fetching the cell cordons fails", func() {
+			BeforeEach(func() {
+				fakeCellCordonDB.CellCordonsReturns(nil, errors.New("boom"))
+			})
+
+			It("does not call ConvergeLRPs", func() {
+				Expect(fakeLRPDB.ConvergeLRPsCallCount()).To(Equal(0))
+			})
+
+			It("logs the error", func() {
+				Expect(logger).To(gbytes.Say("failed-flagging-cordoned-cells"))
+			})
+		})
+	})
+
 	Context("when there are suspect LRPs with existing cells", func() {
 		var (
 			suspectActualLRP             *models.ActualLRP
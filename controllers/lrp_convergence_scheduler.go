@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// MaxDirtyProcessGuidsPerPass bounds the changed process guids a single
+// incremental convergence pass checks. The others wait for later passes.
+const MaxDirtyProcessGuidsPerPass = 1000
+
+// LRPConvergenceScheduler splits LRP convergence into incremental passes.
+// Each pass checks the process guids changed since the previous pass, and one
+// shard of every process guid, so that a full sweep completes every shardCount
+// passes.
+type LRPConvergenceScheduler struct {
+	shardCount int
+
+	lock      sync.Mutex
+	dirty     map[string]struct{}
+	nextShard int
+	draining  map[string]bool
+
+	sweep     lrpConvergenceTotals
+	lastSweep *lrpConvergenceTotals
+}
+
+// lrpConvergenceTotals accumulates what the passes of a sweep found.
+type lrpConvergenceTotals struct {
+	missing      int
+	extra        int
+	suspectCells map[string]struct{}
+}
+
+func NewLRPConvergenceScheduler(shardCount int) *LRPConvergenceScheduler {
+	return &LRPConvergenceScheduler{
+		shardCount: shardCount,
+		dirty:      map[string]struct{}{},
+		sweep:      lrpConvergenceTotals{suspectCells: map[string]struct{}{}},
+	}
+}
+
+// MarkDirty makes the next pass check the process guid.
+func (s *LRPConvergenceScheduler) MarkDirty(processGuid string) {
+	if processGuid == "" {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.dirty[processGuid] = struct{}{}
+}
+
+// TrackHub returns a hub that marks the process guid of every LRP event
+// emitted through it dirty.
+func (s *LRPConvergenceScheduler) TrackHub(hub events.Hub) events.Hub {
+	return &dirtyTrackingHub{Hub: hub, scheduler: s}
+}
+
+// NextScope returns the scope of the next pass, and forgets the dirty process
+// guids it holds. The pass checks every cell when the cell set, or which of
+// its cells are draining, has changed since the previous pass.
+func (s *LRPConvergenceScheduler) NextScope(cellSet models.CellSet) db.LRPConvergenceScope {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	scope := db.LRPConvergenceScope{
+		Shard:      s.nextShard,
+		ShardCount: s.shardCount,
+		AllCells:   s.cellsChanged(cellSet),
+	}
+	s.nextShard = (s.nextShard + 1) % s.shardCount
+
+	for processGuid := range s.dirty {
+		if len(scope.ProcessGuids) == MaxDirtyProcessGuidsPerPass {
+			break
+		}
+		scope.ProcessGuids = append(scope.ProcessGuids, processGuid)
+		delete(s.dirty, processGuid)
+	}
+	sort.Strings(scope.ProcessGuids)
+
+	s.draining = make(map[string]bool, len(cellSet))
+	for cellID, cell := range cellSet {
+		s.draining[cellID] = cell.Draining
+	}
+
+	return scope
+}
+
+// RecordResult adds the result of the pass in the scope to the totals of the
+// sweep, and returns the missing and extra LRPs and the suspect cells of the
+// last completed sweep, or of the sweep so far before the first one
+// completes. Only the LRPs in the shard of the pass are counted, so that the
+// dirty process guids it also checked are not counted twice.
+func (s *LRPConvergenceScheduler) RecordResult(scope db.LRPConvergenceScope, result db.ConvergenceResult) (missing, extra, suspectCells int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	inShard := func(processGuid string) bool {
+		return db.ProcessGuidShard(processGuid, scope.ShardCount) == scope.Shard
+	}
+	for _, key := range result.MissingLRPKeys {
+		if inShard(key.Key.ProcessGuid) {
+			s.sweep.missing++
+		}
+	}
+	for _, key := range result.KeysToRetire {
+		if inShard(key.ProcessGuid) {
+			s.sweep.extra++
+		}
+	}
+	for _, cellID := range result.MissingCellIds {
+		s.sweep.suspectCells[cellID] = struct{}{}
+	}
+
+	totals := s.sweep
+	if scope.Shard == scope.ShardCount-1 {
+		s.lastSweep = &totals
+		s.sweep = lrpConvergenceTotals{suspectCells: map[string]struct{}{}}
+	}
+	if s.lastSweep != nil {
+		totals = *s.lastSweep
+	}
+	return totals.missing, totals.extra, len(totals.suspectCells)
+}
+
+func (s *LRPConvergenceScheduler) cellsChanged(cellSet models.CellSet) bool {
+	if s.draining == nil || len(s.draining) != len(cellSet) {
+		return true
+	}
+	for cellID, cell := range cellSet {
+		draining, ok := s.draining[cellID]
+		if !ok || draining != cell.Draining {
+			return true
+		}
+	}
+	return false
+}
+
+type dirtyTrackingHub struct {
+	events.Hub
+	scheduler *LRPConvergenceScheduler
+}
+
+func (h *dirtyTrackingHub) Emit(event models.Event) {
+	h.scheduler.MarkDirty(eventProcessGuid(event))
+	h.Hub.Emit(event)
+}
+
+func eventProcessGuid(event models.Event) string {
+	switch x := event.(type) {
+	case *models.DesiredLRPCreatedEvent:
+		return x.DesiredLrp.GetProcessGuid()
+	case *models.DesiredLRPChangedEvent:
+		return x.Before.GetProcessGuid()
+	case *models.DesiredLRPRemovedEvent:
+		return x.DesiredLrp.GetProcessGuid()
+	case *models.ActualLRPCreatedEvent:
+		return actualLRPGroupProcessGuid(x.ActualLrpGroup)
+	case *models.ActualLRPChangedEvent:
+		return actualLRPGroupProcessGuid(x.Before)
+	case *models.ActualLRPRemovedEvent:
+		return actualLRPGroupProcessGuid(x.ActualLrpGroup)
+	case *models.ActualLRPCrashedEvent:
+		return x.ActualLRPKey.ProcessGuid
+	case *models.ActualLRPInstanceCreatedEvent:
+		return actualLRPProcessGuid(x.ActualLrp)
+	case *models.ActualLRPInstanceChangedEvent:
+		return x.ActualLRPKey.ProcessGuid
+	case *models.ActualLRPInstanceRemovedEvent:
+		return actualLRPProcessGuid(x.ActualLrp)
+	}
+	return ""
+}
+
+func actualLRPGroupProcessGuid(group *models.ActualLRPGroup) string {
+	if group == nil {
+		return ""
+	}
+
+	lrp, _, err := group.Resolve()
+	if err != nil {
+		return ""
+	}
+	return lrp.ProcessGuid
+}
+
+func actualLRPProcessGuid(lrp *models.ActualLRP) string {
+	if lrp == nil {
+		return ""
+	}
+	return lrp.ProcessGuid
+}
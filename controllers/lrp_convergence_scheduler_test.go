@@ -0,0 +1,151 @@
+package controllers_test
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LRPConvergenceScheduler", func() {
+	var (
+		scheduler *controllers.LRPConvergenceScheduler
+		cellSet   models.CellSet
+	)
+
+	BeforeEach(func() {
+		scheduler = controllers.NewLRPConvergenceScheduler(3)
+
+		cellPresence := models.NewCellPresence("cell-id", "1.1.1.1", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+		cellSet = models.CellSet{"cell-id": &cellPresence}
+	})
+
+	It("cycles through the shards", func() {
+		shards := []int{}
+		for i := 0; i < 4; i++ {
+			scope := scheduler.NextScope(cellSet)
+			Expect(scope.ShardCount).To(Equal(3))
+			shards = append(shards, scope.Shard)
+		}
+		Expect(shards).To(Equal([]int{0, 1, 2, 0}))
+	})
+
+	It("checks the dirty process guids once", func() {
+		scheduler.MarkDirty("guid-b")
+		scheduler.MarkDirty("guid-a")
+		scheduler.MarkDirty("guid-b")
+
+		Expect(scheduler.NextScope(cellSet).ProcessGuids).To(Equal([]string{"guid-a", "guid-b"}))
+		Expect(scheduler.NextScope(cellSet).ProcessGuids).To(BeEmpty())
+	})
+
+	It("leaves dirty process guids beyond the limit for later passes", func() {
+		for i := 0; i < controllers.MaxDirtyProcessGuidsPerPass+1; i++ {
+			scheduler.MarkDirty(fmt.Sprintf("guid-%d", i))
+		}
+
+		Expect(scheduler.NextScope(cellSet).ProcessGuids).To(HaveLen(controllers.MaxDirtyProcessGuidsPerPass))
+		Expect(scheduler.NextScope(cellSet).ProcessGuids).To(HaveLen(1))
+	})
+
+	It("checks every cell when the cell set changes", func() {
+		Expect(scheduler.NextScope(cellSet).AllCells).To(BeTrue())
+		Expect(scheduler.NextScope(cellSet).AllCells).To(BeFalse())
+
+		otherCellPresence := models.NewCellPresence("other-cell-id", "1.1.1.2", "", "z1", models.CellCapacity{}, nil, nil, nil, nil)
+		otherCellSet := models.CellSet{"other-cell-id": &otherCellPresence}
+		Expect(scheduler.NextScope(otherCellSet).AllCells).To(BeTrue())
+		Expect(scheduler.NextScope(models.CellSet{}).AllCells).To(BeTrue())
+		Expect(scheduler.NextScope(models.CellSet{}).AllCells).To(BeFalse())
+	})
+
+	It("checks every cell when a cell starts or stops draining", func() {
+		Expect(scheduler.NextScope(cellSet).AllCells).To(BeTrue())
+
+		drainingCellPresence := cellSet["cell-id"].Copy()
+		drainingCellPresence.Cordoned = true
+		drainingCellPresence.Draining = true
+		drainingCellSet := models.CellSet{"cell-id": drainingCellPresence}
+		Expect(scheduler.NextScope(drainingCellSet).AllCells).To(BeTrue())
+		Expect(scheduler.NextScope(drainingCellSet).AllCells).To(BeFalse())
+		Expect(scheduler.NextScope(cellSet).AllCells).To(BeTrue())
+	})
+
+	Describe("RecordResult", func() {
+		var guidsByShard []string
+
+		BeforeEach(func() {
+			guidsByShard = make([]string, 3)
+			for i, found := 0, 0; found < 3; i++ {
+				guid := fmt.Sprintf("guid-%d", i)
+				shard := db.ProcessGuidShard(guid, 3)
+				if guidsByShard[shard] == "" {
+					guidsByShard[shard] = guid
+					found++
+				}
+			}
+		})
+
+		retire := func(processGuids ...string) []*models.ActualLRPKey {
+			keys := []*models.ActualLRPKey{}
+			for _, processGuid := range processGuids {
+				keys = append(keys, &models.ActualLRPKey{ProcessGuid: processGuid})
+			}
+			return keys
+		}
+
+		record := func(result db.ConvergenceResult) []int {
+			missing, extra, suspectCells := scheduler.RecordResult(scheduler.NextScope(cellSet), result)
+			return []int{missing, extra, suspectCells}
+		}
+
+		It("returns the totals of the last completed sweep", func() {
+			Expect(record(db.ConvergenceResult{
+				KeysToRetire:   retire(guidsByShard[0], guidsByShard[1]),
+				MissingCellIds: []string{"cell-a"},
+			})).To(Equal([]int{0, 1, 1}))
+
+			Expect(record(db.ConvergenceResult{
+				MissingLRPKeys: []*models.ActualLRPKeyWithSchedulingInfo{{Key: &models.ActualLRPKey{ProcessGuid: guidsByShard[1]}}},
+			})).To(Equal([]int{1, 1, 1}))
+
+			Expect(record(db.ConvergenceResult{
+				KeysToRetire:   retire(guidsByShard[2]),
+				MissingCellIds: []string{"cell-a", "cell-b"},
+			})).To(Equal([]int{1, 2, 2}))
+
+			Expect(record(db.ConvergenceResult{})).To(Equal([]int{1, 2, 2}))
+			Expect(record(db.ConvergenceResult{})).To(Equal([]int{1, 2, 2}))
+			Expect(record(db.ConvergenceResult{})).To(Equal([]int{0, 0, 0}))
+		})
+	})
+
+	Describe("TrackHub", func() {
+		var fakeHub *eventfakes.FakeHub
+
+		BeforeEach(func() {
+			fakeHub = new(eventfakes.FakeHub)
+		})
+
+		It("marks the process guids of the emitted LRP events dirty", func() {
+			hub := scheduler.TrackHub(fakeHub)
+
+			desiredLRP := model_helpers.NewValidDesiredLRP("desired-guid")
+			actualLRP := model_helpers.NewValidActualLRP("actual-guid", 0)
+			task := model_helpers.NewValidTask("task-guid")
+
+			hub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP))
+			hub.Emit(models.NewActualLRPInstanceCreatedEvent(actualLRP))
+			hub.Emit(models.NewActualLRPRemovedEvent(actualLRP.ToActualLRPGroup()))
+			hub.Emit(models.NewTaskCreatedEvent(task))
+
+			Expect(fakeHub.EmitCallCount()).To(Equal(4))
+			Expect(scheduler.NextScope(cellSet).ProcessGuids).To(Equal([]string{"actual-guid", "desired-guid"}))
+		})
+	})
+})
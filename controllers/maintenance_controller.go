@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	loggingclient "code.cloudfoundry.org/diego-logging-client"
+	"code.cloudfoundry.org/lager"
+)
+
+const MaintenanceModeMetric = "MaintenanceMode"
+
+// MaintenanceMode reports whether the BBS is in maintenance mode, for the
+// controllers that pause work in it.
+//
+//go:generate counterfeiter -o fakes/fake_maintenance_mode.go . MaintenanceMode
+type MaintenanceMode interface {
+	Enabled(ctx context.Context, logger lager.Logger) bool
+}
+
+// MaintenanceController keeps the maintenance mode stored in the
+// configurations table. It caches the mode for the request path, and
+// Refresh reloads it, so that a mode set through another BBS is picked up.
+type MaintenanceController struct {
+	db           db.MaintenanceDB
+	clock        clock.Clock
+	metronClient loggingclient.IngressClient
+
+	lock sync.RWMutex
+	mode *models.MaintenanceMode
+}
+
+func NewMaintenanceController(db db.MaintenanceDB, clock clock.Clock, metronClient loggingclient.IngressClient) *MaintenanceController {
+	return &MaintenanceController{
+		db:           db,
+		clock:        clock,
+		metronClient: metronClient,
+	}
+}
+
+// MaintenanceMode returns the cached mode, loading it on first use.
+func (c *MaintenanceController) MaintenanceMode(ctx context.Context, logger lager.Logger) (*models.MaintenanceMode, error) {
+	c.lock.RLock()
+	mode := c.mode
+	c.lock.RUnlock()
+
+	if mode != nil {
+		return mode, nil
+	}
+	return c.Refresh(ctx, logger)
+}
+
+// Enabled reports whether the BBS is in maintenance mode. When the mode
+// cannot be loaded it reports false, leaving the database errors to the
+// request.
+func (c *MaintenanceController) Enabled(ctx context.Context, logger lager.Logger) bool {
+	mode, err := c.MaintenanceMode(ctx, logger)
+	if err != nil {
+		return false
+	}
+	return mode.Enabled
+}
+
+// Refresh reloads the mode from the database and emits the maintenance mode
+// metric.
+func (c *MaintenanceController) Refresh(ctx context.Context, logger lager.Logger) (*models.MaintenanceMode, error) {
+	logger = logger.Session("refresh-maintenance-mode")
+
+	mode, err := c.db.MaintenanceMode(ctx, logger)
+	if err != nil {
+		logger.Error("failed-to-fetch-maintenance-mode", err)
+		return nil, err
+	}
+
+	c.update(logger, mode)
+	return mode, nil
+}
+
+func (c *MaintenanceController) SetMaintenanceMode(ctx context.Context, logger lager.Logger, enabled bool, reason string) (*models.MaintenanceMode, error) {
+	logger = logger.Session("set-maintenance-mode", lager.Data{"enabled": enabled, "reason": reason})
+
+	mode := &models.MaintenanceMode{
+		Enabled:   enabled,
+		Reason:    reason,
+		UpdatedAt: c.clock.Now().UnixNano(),
+	}
+
+	err := c.db.SetMaintenanceMode(ctx, logger, mode)
+	if err != nil {
+		logger.Error("failed-to-set-maintenance-mode", err)
+		return nil, err
+	}
+	logger.Info("set")
+
+	c.update(logger, mode)
+	return mode, nil
+}
+
+func (c *MaintenanceController) update(logger lager.Logger, mode *models.MaintenanceMode) {
+	c.lock.Lock()
+	c.mode = mode
+	c.lock.Unlock()
+
+	value := 0
+	if mode.Enabled {
+		value = 1
+	}
+	err := c.metronClient.SendMetric(MaintenanceModeMetric, value)
+	if err != nil {
+		logger.Error("failed-sending-metric", err)
+	}
+}
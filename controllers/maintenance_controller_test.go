@@ -0,0 +1,104 @@
+package controllers_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/diego-logging-client/testhelpers"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MaintenanceController", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeDB           *dbfakes.FakeMaintenanceDB
+		fakeClock        *fakeclock.FakeClock
+		fakeMetronClient *testhelpers.FakeIngressClient
+		controller       *controllers.MaintenanceController
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDB = new(dbfakes.FakeMaintenanceDB)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(100, 0))
+		fakeMetronClient = new(testhelpers.FakeIngressClient)
+		controller = controllers.NewMaintenanceController(fakeDB, fakeClock, fakeMetronClient)
+
+		fakeDB.MaintenanceModeReturns(&models.MaintenanceMode{Enabled: true, Reason: "database upgrade"}, nil)
+	})
+
+	Describe("MaintenanceMode", func() {
+		It("loads the mode once and caches it", func() {
+			mode, err := controller.MaintenanceMode(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mode.Reason).To(Equal("database upgrade"))
+
+			Expect(controller.Enabled(ctx, logger)).To(BeTrue())
+			Expect(fakeDB.MaintenanceModeCallCount()).To(Equal(1))
+		})
+
+		Context("when loading the mode fails", func() {
+			BeforeEach(func() {
+				fakeDB.MaintenanceModeReturns(nil, errors.New("boom"))
+			})
+
+			It("returns the error, and is not enabled", func() {
+				_, err := controller.MaintenanceMode(ctx, logger)
+				Expect(err).To(MatchError("boom"))
+				Expect(controller.Enabled(ctx, logger)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Refresh", func() {
+		It("reloads the mode and emits the metric", func() {
+			_, err := controller.Refresh(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeDB.MaintenanceModeReturns(&models.MaintenanceMode{}, nil)
+			_, err = controller.Refresh(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controller.Enabled(ctx, logger)).To(BeFalse())
+
+			Expect(fakeMetronClient.SendMetricCallCount()).To(Equal(2))
+			name, value, _ := fakeMetronClient.SendMetricArgsForCall(0)
+			Expect(name).To(Equal(controllers.MaintenanceModeMetric))
+			Expect(value).To(Equal(1))
+			_, value, _ = fakeMetronClient.SendMetricArgsForCall(1)
+			Expect(value).To(Equal(0))
+		})
+	})
+
+	Describe("SetMaintenanceMode", func() {
+		It("stores the mode and uses it from then on", func() {
+			mode, err := controller.SetMaintenanceMode(ctx, logger, true, "incident")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mode).To(Equal(&models.MaintenanceMode{Enabled: true, Reason: "incident", UpdatedAt: fakeClock.Now().UnixNano()}))
+
+			Expect(fakeDB.SetMaintenanceModeCallCount()).To(Equal(1))
+			_, _, storedMode := fakeDB.SetMaintenanceModeArgsForCall(0)
+			Expect(storedMode).To(Equal(mode))
+
+			Expect(controller.Enabled(ctx, logger)).To(BeTrue())
+			Expect(fakeDB.MaintenanceModeCallCount()).To(Equal(0))
+		})
+
+		Context("when storing the mode fails", func() {
+			BeforeEach(func() {
+				fakeDB.SetMaintenanceModeReturns(errors.New("boom"))
+			})
+
+			It("returns the error and keeps the previous mode", func() {
+				_, err := controller.SetMaintenanceMode(ctx, logger, false, "")
+				Expect(err).To(MatchError("boom"))
+				Expect(controller.Enabled(ctx, logger)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+)
+
+// RetirementReservations tracks, per process guid, the running instances a
+// retire let go that the database still reports as running. Each reservation
+// holds a disruption of the budget until the instance stops running, or until
+// it expires after the TTL, so that a stop that never lands does not hold the
+// disruption forever. A TTL of 0 never expires them.
+type RetirementReservations struct {
+	clock clock.Clock
+
+	mu      sync.Mutex
+	ttl     time.Duration
+	locks   map[string]*retirementLock
+	pending map[string]map[string]time.Time
+}
+
+type retirementLock struct {
+	sync.Mutex
+	refs int
+}
+
+func NewRetirementReservations(clock clock.Clock, ttl time.Duration) *RetirementReservations {
+	return &RetirementReservations{
+		clock:   clock,
+		ttl:     ttl,
+		locks:   map[string]*retirementLock{},
+		pending: map[string]map[string]time.Time{},
+	}
+}
+
+// SetTTL changes how long later reservations, and the ones already held, are
+// kept.
+func (r *RetirementReservations) SetTTL(ttl time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ttl = ttl
+}
+
+func (r *RetirementReservations) lock(processGuid string) func() {
+	r.mu.Lock()
+	l, ok := r.locks[processGuid]
+	if !ok {
+		l = &retirementLock{}
+		r.locks[processGuid] = l
+	}
+	l.refs++
+	r.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		r.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(r.locks, processGuid)
+		}
+		r.mu.Unlock()
+	}
+}
+
+// withoutReserved forgets the reservations that expired or whose instance is
+// no longer running, and drops the instances still reserved from lrps. It
+// also returns the instance guids still reserved.
+func (r *RetirementReservations) withoutReserved(processGuid string, lrps []*models.ActualLRP) ([]*models.ActualLRP, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pending := r.pending[processGuid]
+	if len(pending) == 0 {
+		return lrps, nil
+	}
+
+	now := r.clock.Now()
+	reserved := map[string]time.Time{}
+	kept := make([]*models.ActualLRP, 0, len(lrps))
+	for _, lrp := range lrps {
+		reservedAt, ok := pending[lrp.InstanceGuid]
+		expired := r.ttl > 0 && now.Sub(reservedAt) >= r.ttl
+		if ok && !expired && lrp.Presence == models.ActualLRP_Ordinary && lrp.State == models.ActualLRPStateRunning {
+			reserved[lrp.InstanceGuid] = reservedAt
+			continue
+		}
+		kept = append(kept, lrp)
+	}
+
+	if len(reserved) == 0 {
+		delete(r.pending, processGuid)
+		return kept, nil
+	}
+	r.pending[processGuid] = reserved
+
+	instanceGuids := make([]string, 0, len(reserved))
+	for instanceGuid := range reserved {
+		instanceGuids = append(instanceGuids, instanceGuid)
+	}
+	sort.Strings(instanceGuids)
+	return kept, instanceGuids
+}
+
+func (r *RetirementReservations) reserve(processGuid, instanceGuid string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pending[processGuid] == nil {
+		r.pending[processGuid] = map[string]time.Time{}
+	}
+	r.pending[processGuid][instanceGuid] = r.clock.Now()
+}
+
+func (r *RetirementReservations) release(processGuid, instanceGuid string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.pending[processGuid], instanceGuid)
+	if len(r.pending[processGuid]) == 0 {
+		delete(r.pending, processGuid)
+	}
+}
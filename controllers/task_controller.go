@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
@@ -24,6 +25,8 @@ type TaskController struct {
 	taskHub                events.Hub
 	taskStatMetronNotifier metrics.TaskStatMetronNotifier
 	maxRetries             int
+	safetyValve            *ConvergenceSafetyValve
+	settingsLock           sync.RWMutex
 }
 
 func NewTaskController(
@@ -35,6 +38,7 @@ func NewTaskController(
 	taskHub events.Hub,
 	taskStatMetronNotifier metrics.TaskStatMetronNotifier,
 	maxRetries int,
+	safetyValve *ConvergenceSafetyValve,
 ) *TaskController {
 	return &TaskController{
 		db:                     db,
@@ -45,13 +49,20 @@ func NewTaskController(
 		taskHub:                taskHub,
 		taskStatMetronNotifier: taskStatMetronNotifier,
 		maxRetries:             maxRetries,
+		safetyValve:            safetyValve,
 	}
 }
 
-func (c *TaskController) Tasks(ctx context.Context, logger lager.Logger, domain, cellID string) ([]*models.Task, error) {
+// SetMaxRetries changes how often a task may be rejected before it fails.
+func (c *TaskController) SetMaxRetries(maxRetries int) {
+	c.settingsLock.Lock()
+	defer c.settingsLock.Unlock()
+	c.maxRetries = maxRetries
+}
+
+func (c *TaskController) Tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
 	logger = logger.Session("tasks")
 
-	filter := models.TaskFilter{Domain: domain, CellID: cellID}
 	return c.db.Tasks(ctx, logger, filter)
 }
 
@@ -175,7 +186,11 @@ func (c *TaskController) RejectTask(ctx context.Context, logger lager.Logger, ta
 		logger.Error("failed-to-reject-task", rejectTaskErr)
 	}
 
-	if int(task.RejectionCount) >= c.maxRetries {
+	c.settingsLock.RLock()
+	maxRetries := c.maxRetries
+	c.settingsLock.RUnlock()
+
+	if int(task.RejectionCount) >= maxRetries {
 		return c.FailTask(ctx, logger, taskGUID, rejectionReason)
 	}
 
@@ -261,6 +276,14 @@ func (c *TaskController) ConvergeTasks(
 	}
 	logger.Debug("succeeded-listing-cells")
 
+	if c.safetyValve != nil {
+		cellSet, err = c.safetyValve.CellSet(ctx, logger, TaskConvergenceSafetyValve, cellSet)
+		if err != nil {
+			logger.Error("failed-applying-safety-valve", err)
+			return err
+		}
+	}
+
 	convergenceStartTime := time.Now()
 	taskConvergenceResult := c.db.ConvergeTasks(
 		ctx,
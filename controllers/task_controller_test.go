@@ -14,6 +14,8 @@ import (
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/taskworkpool/taskworkpoolfakes"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/diego-logging-client/testhelpers"
 	"code.cloudfoundry.org/lager/lagertest"
 	"code.cloudfoundry.org/rep"
 	. "github.com/onsi/ginkgo"
@@ -29,6 +31,7 @@ var _ = Describe("Task Controller", func() {
 		fakeTaskCompletionClient *taskworkpoolfakes.FakeTaskCompletionClient
 		taskHub                  *eventfakes.FakeHub
 		maxPlacementRetries      int
+		safetyValve              *controllers.ConvergenceSafetyValve
 
 		controller           *controllers.TaskController
 		fakeTaskStatNotifier *fakes.FakeTaskStatMetronNotifier
@@ -46,6 +49,7 @@ var _ = Describe("Task Controller", func() {
 
 		taskHub = &eventfakes.FakeHub{}
 		maxPlacementRetries = 0
+		safetyValve = nil
 	})
 
 	JustBeforeEach(func() {
@@ -58,26 +62,26 @@ var _ = Describe("Task Controller", func() {
 			taskHub,
 			fakeTaskStatNotifier,
 			maxPlacementRetries,
+			safetyValve,
 		)
 	})
 
 	Describe("Tasks", func() {
 		var (
-			domain, cellId string
-			task1          models.Task
-			task2          models.Task
-			actualTasks    []*models.Task
+			filter      models.TaskFilter
+			task1       models.Task
+			task2       models.Task
+			actualTasks []*models.Task
 		)
 
 		BeforeEach(func() {
 			task1 = models.Task{Domain: "domain-1"}
 			task2 = models.Task{CellId: "cell-id"}
-			domain = ""
-			cellId = ""
+			filter = models.TaskFilter{}
 		})
 
 		JustBeforeEach(func() {
-			actualTasks, err = controller.Tasks(ctx, logger, domain, cellId)
+			actualTasks, err = controller.Tasks(ctx, logger, filter)
 		})
 
 		Context("when reading tasks from DB succeeds", func() {
@@ -95,31 +99,43 @@ var _ = Describe("Task Controller", func() {
 
 			It("calls the DB with no filter", func() {
 				Expect(fakeTaskDB.TasksCallCount()).To(Equal(1))
-				_, _, filter := fakeTaskDB.TasksArgsForCall(0)
-				Expect(filter).To(Equal(models.TaskFilter{}))
+				_, _, dbFilter := fakeTaskDB.TasksArgsForCall(0)
+				Expect(dbFilter).To(Equal(models.TaskFilter{}))
 			})
 
 			Context("and filtering by domain", func() {
 				BeforeEach(func() {
-					domain = "domain-1"
+					filter.Domain = "domain-1"
 				})
 
 				It("calls the DB with a domain filter", func() {
 					Expect(fakeTaskDB.TasksCallCount()).To(Equal(1))
-					_, _, filter := fakeTaskDB.TasksArgsForCall(0)
-					Expect(filter.Domain).To(Equal(domain))
+					_, _, dbFilter := fakeTaskDB.TasksArgsForCall(0)
+					Expect(dbFilter.Domain).To(Equal("domain-1"))
+				})
+			})
+
+			Context("and filtering by a list of domains", func() {
+				BeforeEach(func() {
+					filter.Domains = []string{"domain-1", "domain-2"}
+				})
+
+				It("calls the DB with the domains filter", func() {
+					Expect(fakeTaskDB.TasksCallCount()).To(Equal(1))
+					_, _, dbFilter := fakeTaskDB.TasksArgsForCall(0)
+					Expect(dbFilter.Domains).To(Equal([]string{"domain-1", "domain-2"}))
 				})
 			})
 
 			Context("and filtering by cell id", func() {
 				BeforeEach(func() {
-					cellId = "cell-id"
+					filter.CellID = "cell-id"
 				})
 
 				It("calls the DB with a cell filter", func() {
 					Expect(fakeTaskDB.TasksCallCount()).To(Equal(1))
-					_, _, filter := fakeTaskDB.TasksArgsForCall(0)
-					Expect(filter.CellID).To(Equal(cellId))
+					_, _, dbFilter := fakeTaskDB.TasksArgsForCall(0)
+					Expect(dbFilter.CellID).To(Equal("cell-id"))
 				})
 			})
 		})
@@ -735,6 +751,23 @@ var _ = Describe("Task Controller", func() {
 		})
 	})
 
+	Describe("SetMaxRetries", func() {
+		BeforeEach(func() {
+			maxPlacementRetries = 0
+			fakeTaskDB.TaskByGuidReturns(&models.Task{}, nil)
+			fakeTaskDB.RejectTaskReturns(&models.Task{}, model_helpers.NewValidTask("hi-bob"), nil)
+		})
+
+		It("applies the new limit to later rejections", func() {
+			controller.SetMaxRetries(1)
+
+			err = controller.RejectTask(ctx, logger, "task-guid", "rejection")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeTaskDB.RejectTaskCallCount()).To(Equal(1))
+			Expect(fakeTaskDB.FailTaskCallCount()).To(Equal(0))
+		})
+	})
+
 	Describe("CompleteTask", func() {
 		var (
 			taskGuid      string
@@ -1033,6 +1066,35 @@ var _ = Describe("Task Controller", func() {
 				})
 			})
 
+			Context("when the safety valve trips", func() {
+				BeforeEach(func() {
+					safetyValve = controllers.NewConvergenceSafetyValve(nil, fakeclock.NewFakeClock(time.Now()), new(testhelpers.FakeIngressClient), nil, 50, 0)
+					safetyValve.CellSet(ctx, logger, controllers.TaskConvergenceSafetyValve, cellSet)
+
+					fakeServiceClient.CellsReturns(models.CellSet{}, nil)
+				})
+
+				It("converges with the cells of the last accepted pass", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeTaskDB.ConvergeTasksCallCount()).To(Equal(1))
+					_, _, actualCellSet, _, _, _ := fakeTaskDB.ConvergeTasksArgsForCall(0)
+					Expect(actualCellSet).To(BeEquivalentTo(cellSet))
+				})
+			})
+
+			Context("when the safety valve cannot load the accepted cells", func() {
+				BeforeEach(func() {
+					fakeSafetyValveDB := new(dbfakes.FakeSafetyValveDB)
+					fakeSafetyValveDB.SafetyValveCellIdsReturns(nil, errors.New("boom"))
+					safetyValve = controllers.NewConvergenceSafetyValve(fakeSafetyValveDB, fakeclock.NewFakeClock(time.Now()), new(testhelpers.FakeIngressClient), nil, 50, 0)
+				})
+
+				It("does not call ConvergeTasks", func() {
+					Expect(err).To(MatchError("boom"))
+					Expect(fakeTaskDB.ConvergeTasksCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when there are tasks to complete", func() {
 				const taskGuid1 = "to-complete-1"
 				const taskGuid2 = "to-complete-2"
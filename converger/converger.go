@@ -24,11 +24,17 @@ type TaskController interface {
 	ConvergeTasks(ctx context.Context, logger lager.Logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) error
 }
 
+//go:generate counterfeiter -o fake_controllers/fake_maintenance_controller.go . MaintenanceController
+type MaintenanceController interface {
+	Refresh(ctx context.Context, logger lager.Logger) (*models.MaintenanceMode, error)
+}
+
 type Converger struct {
 	id                          string
 	serviceClient               serviceclient.ServiceClient
 	lrpConvergenceController    LrpConvergenceController
 	taskController              TaskController
+	maintenanceController       MaintenanceController
 	logger                      lager.Logger
 	clock                       clock.Clock
 	convergeRepeatInterval      time.Duration
@@ -36,6 +42,7 @@ type Converger struct {
 	expirePendingTaskDuration   time.Duration
 	expireCompletedTaskDuration time.Duration
 	closeOnce                   *sync.Once
+	settingsLock                sync.RWMutex
 }
 
 func New(
@@ -43,6 +50,7 @@ func New(
 	clock clock.Clock,
 	lrpConvergenceController LrpConvergenceController,
 	taskController TaskController,
+	maintenanceController MaintenanceController,
 	serviceClient serviceclient.ServiceClient,
 	convergeRepeatInterval,
 	kickTaskDuration,
@@ -62,6 +70,7 @@ func New(
 		serviceClient:               serviceClient,
 		lrpConvergenceController:    lrpConvergenceController,
 		taskController:              taskController,
+		maintenanceController:       maintenanceController,
 		convergeRepeatInterval:      convergeRepeatInterval,
 		kickTaskDuration:            kickTaskDuration,
 		expirePendingTaskDuration:   expirePendingTaskDuration,
@@ -70,11 +79,34 @@ func New(
 	}
 }
 
+// SetIntervals changes the convergence intervals. They apply from the next
+// convergence run.
+func (c *Converger) SetIntervals(
+	convergeRepeatInterval,
+	kickTaskDuration,
+	expirePendingTaskDuration,
+	expireCompletedTaskDuration time.Duration,
+) {
+	c.settingsLock.Lock()
+	defer c.settingsLock.Unlock()
+
+	c.convergeRepeatInterval = convergeRepeatInterval
+	c.kickTaskDuration = kickTaskDuration
+	c.expirePendingTaskDuration = expirePendingTaskDuration
+	c.expireCompletedTaskDuration = expireCompletedTaskDuration
+}
+
+func (c *Converger) repeatInterval() time.Duration {
+	c.settingsLock.RLock()
+	defer c.settingsLock.RUnlock()
+	return c.convergeRepeatInterval
+}
+
 func (c *Converger) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	logger := c.logger.Session("converger-process")
 	logger.Info("started")
 
-	convergeTimer := c.clock.NewTimer(c.convergeRepeatInterval)
+	convergeTimer := c.clock.NewTimer(c.repeatInterval())
 	defer func() {
 		logger.Info("done")
 		convergeTimer.Stop()
@@ -117,13 +149,33 @@ func (c *Converger) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 		case <-convergeChan:
 		}
 
-		convergeTimer.Reset(c.convergeRepeatInterval)
+		convergeTimer.Reset(c.repeatInterval())
 	}
 }
 
 func (c *Converger) converge(convergeChan chan struct{}) {
 	logger := c.logger.Session("executing-convergence")
 
+	c.settingsLock.RLock()
+	kickTaskDuration := c.kickTaskDuration
+	expirePendingTaskDuration := c.expirePendingTaskDuration
+	expireCompletedTaskDuration := c.expireCompletedTaskDuration
+	c.settingsLock.RUnlock()
+
+	// convergence creates, retires and auctions work, so it pauses in
+	// maintenance mode, and when the mode cannot be read
+	mode, err := c.maintenanceController.Refresh(context.Background(), logger)
+	if err != nil || mode.Enabled {
+		if err != nil {
+			logger.Error("failed-to-refresh-maintenance-mode", err)
+		} else {
+			logger.Info("skipping-convergence-in-maintenance", lager.Data{"reason": mode.Reason})
+		}
+		convergeChan <- struct{}{}
+		convergeChan <- struct{}{}
+		return
+	}
+
 	go func() {
 		logger.Info("converge-tasks-started")
 		defer logger.Info("converge-tasks-done")
@@ -131,9 +183,9 @@ func (c *Converger) converge(convergeChan chan struct{}) {
 		err := c.taskController.ConvergeTasks(
 			context.Background(),
 			c.logger,
-			c.kickTaskDuration,
-			c.expirePendingTaskDuration,
-			c.expireCompletedTaskDuration,
+			kickTaskDuration,
+			expirePendingTaskDuration,
+			expireCompletedTaskDuration,
 		)
 		if err != nil {
 			logger.Error("failed-to-converge-tasks", err)
@@ -15,6 +15,7 @@ import (
 	"code.cloudfoundry.org/clock/fakeclock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 
 	"code.cloudfoundry.org/bbs/converger"
 	"code.cloudfoundry.org/bbs/converger/fake_controllers"
@@ -26,6 +27,7 @@ var _ = Describe("ConvergerProcess", func() {
 	var (
 		fakeLrpConvergenceController *fake_controllers.FakeLrpConvergenceController
 		fakeTaskController           *fake_controllers.FakeTaskController
+		fakeMaintenanceController    *fake_controllers.FakeMaintenanceController
 		fakeBBSServiceClient         *serviceclientfakes.FakeServiceClient
 		logger                       *lagertest.TestLogger
 		fakeClock                    *fakeclock.FakeClock
@@ -34,7 +36,8 @@ var _ = Describe("ConvergerProcess", func() {
 		expirePendingTaskDuration    time.Duration
 		expireCompletedTaskDuration  time.Duration
 
-		process ifrit.Process
+		convergerProcess *converger.Converger
+		process          ifrit.Process
 
 		waitEvents chan<- models.CellEvent
 		waitErrs   chan<- error
@@ -43,6 +46,8 @@ var _ = Describe("ConvergerProcess", func() {
 	BeforeEach(func() {
 		fakeLrpConvergenceController = new(fake_controllers.FakeLrpConvergenceController)
 		fakeTaskController = new(fake_controllers.FakeTaskController)
+		fakeMaintenanceController = new(fake_controllers.FakeMaintenanceController)
+		fakeMaintenanceController.RefreshReturns(&models.MaintenanceMode{}, nil)
 		fakeBBSServiceClient = new(serviceclientfakes.FakeServiceClient)
 		logger = lagertest.NewTestLogger("test")
 		fakeClock = fakeclock.NewFakeClock(time.Now())
@@ -63,19 +68,19 @@ var _ = Describe("ConvergerProcess", func() {
 	})
 
 	JustBeforeEach(func() {
-		process = ifrit.Invoke(
-			converger.New(
-				logger,
-				fakeClock,
-				fakeLrpConvergenceController,
-				fakeTaskController,
-				fakeBBSServiceClient,
-				convergeRepeatInterval,
-				kickTaskDuration,
-				expirePendingTaskDuration,
-				expireCompletedTaskDuration,
-			),
+		convergerProcess = converger.New(
+			logger,
+			fakeClock,
+			fakeLrpConvergenceController,
+			fakeTaskController,
+			fakeMaintenanceController,
+			fakeBBSServiceClient,
+			convergeRepeatInterval,
+			kickTaskDuration,
+			expirePendingTaskDuration,
+			expireCompletedTaskDuration,
 		)
+		process = ifrit.Invoke(convergerProcess)
 	})
 
 	AfterEach(func() {
@@ -107,6 +112,26 @@ var _ = Describe("ConvergerProcess", func() {
 		})
 	})
 
+	Describe("changing the intervals", func() {
+		It("uses the new intervals from the next convergence", func() {
+			convergerProcess.SetIntervals(5*time.Second, time.Second, time.Minute, time.Hour)
+
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeTaskController.ConvergeTasksCallCount).Should(Equal(1))
+
+			_, _, actualKickTaskDuration, actualExpirePendingTaskDuration, actualExpireCompletedTaskDuration := fakeTaskController.ConvergeTasksArgsForCall(0)
+			Expect(actualKickTaskDuration).To(Equal(time.Second))
+			Expect(actualExpirePendingTaskDuration).To(Equal(time.Minute))
+			Expect(actualExpireCompletedTaskDuration).To(Equal(time.Hour))
+
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Consistently(fakeTaskController.ConvergeTasksCallCount).Should(Equal(1))
+
+			fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+			Eventually(fakeTaskController.ConvergeTasksCallCount).Should(Equal(2))
+		})
+	})
+
 	Describe("converging when cells disappear", func() {
 		It("converges tasks and LRPs immediately", func() {
 			Consistently(fakeTaskController.ConvergeTasksCallCount).Should(Equal(0))
@@ -155,6 +180,39 @@ var _ = Describe("ConvergerProcess", func() {
 		})
 	})
 
+	Describe("converging in maintenance mode", func() {
+		BeforeEach(func() {
+			fakeMaintenanceController.RefreshReturns(&models.MaintenanceMode{Enabled: true, Reason: "database upgrade"}, nil)
+		})
+
+		It("skips convergence until maintenance mode ends", func() {
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeMaintenanceController.RefreshCallCount).Should(Equal(1))
+			Consistently(fakeTaskController.ConvergeTasksCallCount).Should(Equal(0))
+			Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(0))
+			Expect(logger).To(gbytes.Say("skipping-convergence-in-maintenance"))
+
+			fakeMaintenanceController.RefreshReturns(&models.MaintenanceMode{}, nil)
+
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeTaskController.ConvergeTasksCallCount).Should(Equal(1))
+			Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(1))
+		})
+
+		Context("when the maintenance mode cannot be read", func() {
+			BeforeEach(func() {
+				fakeMaintenanceController.RefreshReturns(nil, errors.New("boom"))
+			})
+
+			It("skips convergence", func() {
+				fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+				Eventually(fakeMaintenanceController.RefreshCallCount).Should(Equal(1))
+				Consistently(fakeTaskController.ConvergeTasksCallCount).Should(Equal(0))
+				Consistently(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(0))
+			})
+		})
+	})
+
 	Describe("converging when database is unresponsive", func() {
 		var (
 			finishChan chan struct{}
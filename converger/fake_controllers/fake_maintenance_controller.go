@@ -0,0 +1,121 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeMaintenanceController struct {
+	RefreshStub        func(context.Context, lager.Logger) (*models.MaintenanceMode, error)
+	refreshMutex       sync.RWMutex
+	refreshArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	refreshReturns struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	refreshReturnsOnCall map[int]struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMaintenanceController) Refresh(arg1 context.Context, arg2 lager.Logger) (*models.MaintenanceMode, error) {
+	fake.refreshMutex.Lock()
+	ret, specificReturn := fake.refreshReturnsOnCall[len(fake.refreshArgsForCall)]
+	fake.refreshArgsForCall = append(fake.refreshArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.RefreshStub
+	fakeReturns := fake.refreshReturns
+	fake.recordInvocation("Refresh", []interface{}{arg1, arg2})
+	fake.refreshMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeMaintenanceController) RefreshCallCount() int {
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	return len(fake.refreshArgsForCall)
+}
+
+func (fake *FakeMaintenanceController) RefreshCalls(stub func(context.Context, lager.Logger) (*models.MaintenanceMode, error)) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = stub
+}
+
+func (fake *FakeMaintenanceController) RefreshArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	argsForCall := fake.refreshArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeMaintenanceController) RefreshReturns(result1 *models.MaintenanceMode, result2 error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = nil
+	fake.refreshReturns = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMaintenanceController) RefreshReturnsOnCall(i int, result1 *models.MaintenanceMode, result2 error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = nil
+	if fake.refreshReturnsOnCall == nil {
+		fake.refreshReturnsOnCall = make(map[int]struct {
+			result1 *models.MaintenanceMode
+			result2 error
+		})
+	}
+	fake.refreshReturnsOnCall[i] = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMaintenanceController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMaintenanceController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.MaintenanceController = new(FakeMaintenanceController)
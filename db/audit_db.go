@@ -0,0 +1,15 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . AuditDB
+
+type AuditDB interface {
+	InsertAuditEntry(ctx context.Context, logger lager.Logger, entry *models.AuditEntry) error
+	AuditEntries(ctx context.Context, logger lager.Logger, filter models.AuditEntryFilter) ([]*models.AuditEntry, error)
+}
@@ -0,0 +1,16 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . CellCordonDB
+
+type CellCordonDB interface {
+	CellCordons(ctx context.Context, logger lager.Logger) ([]*models.CellCordon, error)
+	UpsertCellCordon(ctx context.Context, logger lager.Logger, cordon *models.CellCordon) error
+	RemoveCellCordon(ctx context.Context, logger lager.Logger, cellID string) error
+}
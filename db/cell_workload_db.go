@@ -0,0 +1,14 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . CellWorkloadDB
+
+type CellWorkloadDB interface {
+	CellWorkloads(ctx context.Context, logger lager.Logger) ([]*models.CellWorkload, error)
+}
@@ -0,0 +1,22 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// DataMigrationProgress is the checkpoint of a background data migration.
+// Checkpoint is opaque to the db and only interpreted by the migration.
+type DataMigrationProgress struct {
+	Checkpoint    string `json:"checkpoint"`
+	RowsProcessed int64  `json:"rows_processed"`
+	Done          bool   `json:"done"`
+}
+
+//go:generate counterfeiter . DataMigrationDB
+
+type DataMigrationDB interface {
+	DataMigrationProgress(ctx context.Context, logger lager.Logger, version int64) (*DataMigrationProgress, error)
+	SetDataMigrationProgress(ctx context.Context, logger lager.Logger, version int64, progress *DataMigrationProgress) error
+}
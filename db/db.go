@@ -3,10 +3,16 @@ package db
 //go:generate counterfeiter . DB
 
 type DB interface {
+	AuditDB
+	CellCordonDB
+	CellWorkloadDB
+	DataMigrationDB
 	DomainDB
 	EncryptionDB
 	EvacuationDB
 	LRPDB
+	MaintenanceDB
+	SafetyValveDB
 	TaskDB
 	VersionDB
 	SuspectDB
@@ -0,0 +1,201 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeAuditDB struct {
+	AuditEntriesStub        func(context.Context, lager.Logger, models.AuditEntryFilter) ([]*models.AuditEntry, error)
+	auditEntriesMutex       sync.RWMutex
+	auditEntriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditEntryFilter
+	}
+	auditEntriesReturns struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}
+	auditEntriesReturnsOnCall map[int]struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}
+	InsertAuditEntryStub        func(context.Context, lager.Logger, *models.AuditEntry) error
+	insertAuditEntryMutex       sync.RWMutex
+	insertAuditEntryArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.AuditEntry
+	}
+	insertAuditEntryReturns struct {
+		result1 error
+	}
+	insertAuditEntryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditDB) AuditEntries(arg1 context.Context, arg2 lager.Logger, arg3 models.AuditEntryFilter) ([]*models.AuditEntry, error) {
+	fake.auditEntriesMutex.Lock()
+	ret, specificReturn := fake.auditEntriesReturnsOnCall[len(fake.auditEntriesArgsForCall)]
+	fake.auditEntriesArgsForCall = append(fake.auditEntriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditEntryFilter
+	}{arg1, arg2, arg3})
+	stub := fake.AuditEntriesStub
+	fakeReturns := fake.auditEntriesReturns
+	fake.recordInvocation("AuditEntries", []interface{}{arg1, arg2, arg3})
+	fake.auditEntriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditDB) AuditEntriesCallCount() int {
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	return len(fake.auditEntriesArgsForCall)
+}
+
+func (fake *FakeAuditDB) AuditEntriesCalls(stub func(context.Context, lager.Logger, models.AuditEntryFilter) ([]*models.AuditEntry, error)) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = stub
+}
+
+func (fake *FakeAuditDB) AuditEntriesArgsForCall(i int) (context.Context, lager.Logger, models.AuditEntryFilter) {
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	argsForCall := fake.auditEntriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuditDB) AuditEntriesReturns(result1 []*models.AuditEntry, result2 error) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = nil
+	fake.auditEntriesReturns = struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditDB) AuditEntriesReturnsOnCall(i int, result1 []*models.AuditEntry, result2 error) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = nil
+	if fake.auditEntriesReturnsOnCall == nil {
+		fake.auditEntriesReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditEntry
+			result2 error
+		})
+	}
+	fake.auditEntriesReturnsOnCall[i] = struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditDB) InsertAuditEntry(arg1 context.Context, arg2 lager.Logger, arg3 *models.AuditEntry) error {
+	fake.insertAuditEntryMutex.Lock()
+	ret, specificReturn := fake.insertAuditEntryReturnsOnCall[len(fake.insertAuditEntryArgsForCall)]
+	fake.insertAuditEntryArgsForCall = append(fake.insertAuditEntryArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.AuditEntry
+	}{arg1, arg2, arg3})
+	stub := fake.InsertAuditEntryStub
+	fakeReturns := fake.insertAuditEntryReturns
+	fake.recordInvocation("InsertAuditEntry", []interface{}{arg1, arg2, arg3})
+	fake.insertAuditEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuditDB) InsertAuditEntryCallCount() int {
+	fake.insertAuditEntryMutex.RLock()
+	defer fake.insertAuditEntryMutex.RUnlock()
+	return len(fake.insertAuditEntryArgsForCall)
+}
+
+func (fake *FakeAuditDB) InsertAuditEntryCalls(stub func(context.Context, lager.Logger, *models.AuditEntry) error) {
+	fake.insertAuditEntryMutex.Lock()
+	defer fake.insertAuditEntryMutex.Unlock()
+	fake.InsertAuditEntryStub = stub
+}
+
+func (fake *FakeAuditDB) InsertAuditEntryArgsForCall(i int) (context.Context, lager.Logger, *models.AuditEntry) {
+	fake.insertAuditEntryMutex.RLock()
+	defer fake.insertAuditEntryMutex.RUnlock()
+	argsForCall := fake.insertAuditEntryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuditDB) InsertAuditEntryReturns(result1 error) {
+	fake.insertAuditEntryMutex.Lock()
+	defer fake.insertAuditEntryMutex.Unlock()
+	fake.InsertAuditEntryStub = nil
+	fake.insertAuditEntryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditDB) InsertAuditEntryReturnsOnCall(i int, result1 error) {
+	fake.insertAuditEntryMutex.Lock()
+	defer fake.insertAuditEntryMutex.Unlock()
+	fake.InsertAuditEntryStub = nil
+	if fake.insertAuditEntryReturnsOnCall == nil {
+		fake.insertAuditEntryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.insertAuditEntryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	fake.insertAuditEntryMutex.RLock()
+	defer fake.insertAuditEntryMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.AuditDB = new(FakeAuditDB)
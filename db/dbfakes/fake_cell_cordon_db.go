@@ -0,0 +1,277 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeCellCordonDB struct {
+	CellCordonsStub        func(context.Context, lager.Logger) ([]*models.CellCordon, error)
+	cellCordonsMutex       sync.RWMutex
+	cellCordonsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellCordonsReturns struct {
+		result1 []*models.CellCordon
+		result2 error
+	}
+	cellCordonsReturnsOnCall map[int]struct {
+		result1 []*models.CellCordon
+		result2 error
+	}
+	RemoveCellCordonStub        func(context.Context, lager.Logger, string) error
+	removeCellCordonMutex       sync.RWMutex
+	removeCellCordonArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	removeCellCordonReturns struct {
+		result1 error
+	}
+	removeCellCordonReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpsertCellCordonStub        func(context.Context, lager.Logger, *models.CellCordon) error
+	upsertCellCordonMutex       sync.RWMutex
+	upsertCellCordonArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.CellCordon
+	}
+	upsertCellCordonReturns struct {
+		result1 error
+	}
+	upsertCellCordonReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCellCordonDB) CellCordons(arg1 context.Context, arg2 lager.Logger) ([]*models.CellCordon, error) {
+	fake.cellCordonsMutex.Lock()
+	ret, specificReturn := fake.cellCordonsReturnsOnCall[len(fake.cellCordonsArgsForCall)]
+	fake.cellCordonsArgsForCall = append(fake.cellCordonsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellCordonsStub
+	fakeReturns := fake.cellCordonsReturns
+	fake.recordInvocation("CellCordons", []interface{}{arg1, arg2})
+	fake.cellCordonsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellCordonDB) CellCordonsCallCount() int {
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	return len(fake.cellCordonsArgsForCall)
+}
+
+func (fake *FakeCellCordonDB) CellCordonsCalls(stub func(context.Context, lager.Logger) ([]*models.CellCordon, error)) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = stub
+}
+
+func (fake *FakeCellCordonDB) CellCordonsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	argsForCall := fake.cellCordonsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCellCordonDB) CellCordonsReturns(result1 []*models.CellCordon, result2 error) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = nil
+	fake.cellCordonsReturns = struct {
+		result1 []*models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonDB) CellCordonsReturnsOnCall(i int, result1 []*models.CellCordon, result2 error) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = nil
+	if fake.cellCordonsReturnsOnCall == nil {
+		fake.cellCordonsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellCordon
+			result2 error
+		})
+	}
+	fake.cellCordonsReturnsOnCall[i] = struct {
+		result1 []*models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonDB) RemoveCellCordon(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeCellCordonMutex.Lock()
+	ret, specificReturn := fake.removeCellCordonReturnsOnCall[len(fake.removeCellCordonArgsForCall)]
+	fake.removeCellCordonArgsForCall = append(fake.removeCellCordonArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveCellCordonStub
+	fakeReturns := fake.removeCellCordonReturns
+	fake.recordInvocation("RemoveCellCordon", []interface{}{arg1, arg2, arg3})
+	fake.removeCellCordonMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellCordonDB) RemoveCellCordonCallCount() int {
+	fake.removeCellCordonMutex.RLock()
+	defer fake.removeCellCordonMutex.RUnlock()
+	return len(fake.removeCellCordonArgsForCall)
+}
+
+func (fake *FakeCellCordonDB) RemoveCellCordonCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeCellCordonMutex.Lock()
+	defer fake.removeCellCordonMutex.Unlock()
+	fake.RemoveCellCordonStub = stub
+}
+
+func (fake *FakeCellCordonDB) RemoveCellCordonArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeCellCordonMutex.RLock()
+	defer fake.removeCellCordonMutex.RUnlock()
+	argsForCall := fake.removeCellCordonArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellCordonDB) RemoveCellCordonReturns(result1 error) {
+	fake.removeCellCordonMutex.Lock()
+	defer fake.removeCellCordonMutex.Unlock()
+	fake.RemoveCellCordonStub = nil
+	fake.removeCellCordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) RemoveCellCordonReturnsOnCall(i int, result1 error) {
+	fake.removeCellCordonMutex.Lock()
+	defer fake.removeCellCordonMutex.Unlock()
+	fake.RemoveCellCordonStub = nil
+	if fake.removeCellCordonReturnsOnCall == nil {
+		fake.removeCellCordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeCellCordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) UpsertCellCordon(arg1 context.Context, arg2 lager.Logger, arg3 *models.CellCordon) error {
+	fake.upsertCellCordonMutex.Lock()
+	ret, specificReturn := fake.upsertCellCordonReturnsOnCall[len(fake.upsertCellCordonArgsForCall)]
+	fake.upsertCellCordonArgsForCall = append(fake.upsertCellCordonArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.CellCordon
+	}{arg1, arg2, arg3})
+	stub := fake.UpsertCellCordonStub
+	fakeReturns := fake.upsertCellCordonReturns
+	fake.recordInvocation("UpsertCellCordon", []interface{}{arg1, arg2, arg3})
+	fake.upsertCellCordonMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellCordonDB) UpsertCellCordonCallCount() int {
+	fake.upsertCellCordonMutex.RLock()
+	defer fake.upsertCellCordonMutex.RUnlock()
+	return len(fake.upsertCellCordonArgsForCall)
+}
+
+func (fake *FakeCellCordonDB) UpsertCellCordonCalls(stub func(context.Context, lager.Logger, *models.CellCordon) error) {
+	fake.upsertCellCordonMutex.Lock()
+	defer fake.upsertCellCordonMutex.Unlock()
+	fake.UpsertCellCordonStub = stub
+}
+
+func (fake *FakeCellCordonDB) UpsertCellCordonArgsForCall(i int) (context.Context, lager.Logger, *models.CellCordon) {
+	fake.upsertCellCordonMutex.RLock()
+	defer fake.upsertCellCordonMutex.RUnlock()
+	argsForCall := fake.upsertCellCordonArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellCordonDB) UpsertCellCordonReturns(result1 error) {
+	fake.upsertCellCordonMutex.Lock()
+	defer fake.upsertCellCordonMutex.Unlock()
+	fake.UpsertCellCordonStub = nil
+	fake.upsertCellCordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) UpsertCellCordonReturnsOnCall(i int, result1 error) {
+	fake.upsertCellCordonMutex.Lock()
+	defer fake.upsertCellCordonMutex.Unlock()
+	fake.UpsertCellCordonStub = nil
+	if fake.upsertCellCordonReturnsOnCall == nil {
+		fake.upsertCellCordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertCellCordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	fake.removeCellCordonMutex.RLock()
+	defer fake.removeCellCordonMutex.RUnlock()
+	fake.upsertCellCordonMutex.RLock()
+	defer fake.upsertCellCordonMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCellCordonDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CellCordonDB = new(FakeCellCordonDB)
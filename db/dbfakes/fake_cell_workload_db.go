@@ -0,0 +1,121 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeCellWorkloadDB struct {
+	CellWorkloadsStub        func(context.Context, lager.Logger) ([]*models.CellWorkload, error)
+	cellWorkloadsMutex       sync.RWMutex
+	cellWorkloadsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellWorkloadsReturns struct {
+		result1 []*models.CellWorkload
+		result2 error
+	}
+	cellWorkloadsReturnsOnCall map[int]struct {
+		result1 []*models.CellWorkload
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCellWorkloadDB) CellWorkloads(arg1 context.Context, arg2 lager.Logger) ([]*models.CellWorkload, error) {
+	fake.cellWorkloadsMutex.Lock()
+	ret, specificReturn := fake.cellWorkloadsReturnsOnCall[len(fake.cellWorkloadsArgsForCall)]
+	fake.cellWorkloadsArgsForCall = append(fake.cellWorkloadsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellWorkloadsStub
+	fakeReturns := fake.cellWorkloadsReturns
+	fake.recordInvocation("CellWorkloads", []interface{}{arg1, arg2})
+	fake.cellWorkloadsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellWorkloadDB) CellWorkloadsCallCount() int {
+	fake.cellWorkloadsMutex.RLock()
+	defer fake.cellWorkloadsMutex.RUnlock()
+	return len(fake.cellWorkloadsArgsForCall)
+}
+
+func (fake *FakeCellWorkloadDB) CellWorkloadsCalls(stub func(context.Context, lager.Logger) ([]*models.CellWorkload, error)) {
+	fake.cellWorkloadsMutex.Lock()
+	defer fake.cellWorkloadsMutex.Unlock()
+	fake.CellWorkloadsStub = stub
+}
+
+func (fake *FakeCellWorkloadDB) CellWorkloadsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellWorkloadsMutex.RLock()
+	defer fake.cellWorkloadsMutex.RUnlock()
+	argsForCall := fake.cellWorkloadsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCellWorkloadDB) CellWorkloadsReturns(result1 []*models.CellWorkload, result2 error) {
+	fake.cellWorkloadsMutex.Lock()
+	defer fake.cellWorkloadsMutex.Unlock()
+	fake.CellWorkloadsStub = nil
+	fake.cellWorkloadsReturns = struct {
+		result1 []*models.CellWorkload
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellWorkloadDB) CellWorkloadsReturnsOnCall(i int, result1 []*models.CellWorkload, result2 error) {
+	fake.cellWorkloadsMutex.Lock()
+	defer fake.cellWorkloadsMutex.Unlock()
+	fake.CellWorkloadsStub = nil
+	if fake.cellWorkloadsReturnsOnCall == nil {
+		fake.cellWorkloadsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellWorkload
+			result2 error
+		})
+	}
+	fake.cellWorkloadsReturnsOnCall[i] = struct {
+		result1 []*models.CellWorkload
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellWorkloadDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cellWorkloadsMutex.RLock()
+	defer fake.cellWorkloadsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCellWorkloadDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CellWorkloadDB = new(FakeCellWorkloadDB)
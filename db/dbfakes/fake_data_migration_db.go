@@ -0,0 +1,202 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeDataMigrationDB struct {
+	DataMigrationProgressStub        func(context.Context, lager.Logger, int64) (*db.DataMigrationProgress, error)
+	dataMigrationProgressMutex       sync.RWMutex
+	dataMigrationProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int64
+	}
+	dataMigrationProgressReturns struct {
+		result1 *db.DataMigrationProgress
+		result2 error
+	}
+	dataMigrationProgressReturnsOnCall map[int]struct {
+		result1 *db.DataMigrationProgress
+		result2 error
+	}
+	SetDataMigrationProgressStub        func(context.Context, lager.Logger, int64, *db.DataMigrationProgress) error
+	setDataMigrationProgressMutex       sync.RWMutex
+	setDataMigrationProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int64
+		arg4 *db.DataMigrationProgress
+	}
+	setDataMigrationProgressReturns struct {
+		result1 error
+	}
+	setDataMigrationProgressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDataMigrationDB) DataMigrationProgress(arg1 context.Context, arg2 lager.Logger, arg3 int64) (*db.DataMigrationProgress, error) {
+	fake.dataMigrationProgressMutex.Lock()
+	ret, specificReturn := fake.dataMigrationProgressReturnsOnCall[len(fake.dataMigrationProgressArgsForCall)]
+	fake.dataMigrationProgressArgsForCall = append(fake.dataMigrationProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int64
+	}{arg1, arg2, arg3})
+	stub := fake.DataMigrationProgressStub
+	fakeReturns := fake.dataMigrationProgressReturns
+	fake.recordInvocation("DataMigrationProgress", []interface{}{arg1, arg2, arg3})
+	fake.dataMigrationProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDataMigrationDB) DataMigrationProgressCallCount() int {
+	fake.dataMigrationProgressMutex.RLock()
+	defer fake.dataMigrationProgressMutex.RUnlock()
+	return len(fake.dataMigrationProgressArgsForCall)
+}
+
+func (fake *FakeDataMigrationDB) DataMigrationProgressCalls(stub func(context.Context, lager.Logger, int64) (*db.DataMigrationProgress, error)) {
+	fake.dataMigrationProgressMutex.Lock()
+	defer fake.dataMigrationProgressMutex.Unlock()
+	fake.DataMigrationProgressStub = stub
+}
+
+func (fake *FakeDataMigrationDB) DataMigrationProgressArgsForCall(i int) (context.Context, lager.Logger, int64) {
+	fake.dataMigrationProgressMutex.RLock()
+	defer fake.dataMigrationProgressMutex.RUnlock()
+	argsForCall := fake.dataMigrationProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDataMigrationDB) DataMigrationProgressReturns(result1 *db.DataMigrationProgress, result2 error) {
+	fake.dataMigrationProgressMutex.Lock()
+	defer fake.dataMigrationProgressMutex.Unlock()
+	fake.DataMigrationProgressStub = nil
+	fake.dataMigrationProgressReturns = struct {
+		result1 *db.DataMigrationProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataMigrationDB) DataMigrationProgressReturnsOnCall(i int, result1 *db.DataMigrationProgress, result2 error) {
+	fake.dataMigrationProgressMutex.Lock()
+	defer fake.dataMigrationProgressMutex.Unlock()
+	fake.DataMigrationProgressStub = nil
+	if fake.dataMigrationProgressReturnsOnCall == nil {
+		fake.dataMigrationProgressReturnsOnCall = make(map[int]struct {
+			result1 *db.DataMigrationProgress
+			result2 error
+		})
+	}
+	fake.dataMigrationProgressReturnsOnCall[i] = struct {
+		result1 *db.DataMigrationProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDataMigrationDB) SetDataMigrationProgress(arg1 context.Context, arg2 lager.Logger, arg3 int64, arg4 *db.DataMigrationProgress) error {
+	fake.setDataMigrationProgressMutex.Lock()
+	ret, specificReturn := fake.setDataMigrationProgressReturnsOnCall[len(fake.setDataMigrationProgressArgsForCall)]
+	fake.setDataMigrationProgressArgsForCall = append(fake.setDataMigrationProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int64
+		arg4 *db.DataMigrationProgress
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetDataMigrationProgressStub
+	fakeReturns := fake.setDataMigrationProgressReturns
+	fake.recordInvocation("SetDataMigrationProgress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setDataMigrationProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDataMigrationDB) SetDataMigrationProgressCallCount() int {
+	fake.setDataMigrationProgressMutex.RLock()
+	defer fake.setDataMigrationProgressMutex.RUnlock()
+	return len(fake.setDataMigrationProgressArgsForCall)
+}
+
+func (fake *FakeDataMigrationDB) SetDataMigrationProgressCalls(stub func(context.Context, lager.Logger, int64, *db.DataMigrationProgress) error) {
+	fake.setDataMigrationProgressMutex.Lock()
+	defer fake.setDataMigrationProgressMutex.Unlock()
+	fake.SetDataMigrationProgressStub = stub
+}
+
+func (fake *FakeDataMigrationDB) SetDataMigrationProgressArgsForCall(i int) (context.Context, lager.Logger, int64, *db.DataMigrationProgress) {
+	fake.setDataMigrationProgressMutex.RLock()
+	defer fake.setDataMigrationProgressMutex.RUnlock()
+	argsForCall := fake.setDataMigrationProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDataMigrationDB) SetDataMigrationProgressReturns(result1 error) {
+	fake.setDataMigrationProgressMutex.Lock()
+	defer fake.setDataMigrationProgressMutex.Unlock()
+	fake.SetDataMigrationProgressStub = nil
+	fake.setDataMigrationProgressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataMigrationDB) SetDataMigrationProgressReturnsOnCall(i int, result1 error) {
+	fake.setDataMigrationProgressMutex.Lock()
+	defer fake.setDataMigrationProgressMutex.Unlock()
+	fake.SetDataMigrationProgressStub = nil
+	if fake.setDataMigrationProgressReturnsOnCall == nil {
+		fake.setDataMigrationProgressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setDataMigrationProgressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDataMigrationDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.dataMigrationProgressMutex.RLock()
+	defer fake.dataMigrationProgressMutex.RUnlock()
+	fake.setDataMigrationProgressMutex.RLock()
+	defer fake.setDataMigrationProgressMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDataMigrationDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.DataMigrationDB = new(FakeDataMigrationDB)
@@ -27,6 +27,21 @@ type FakeDB struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	AuditEntriesStub        func(context.Context, lager.Logger, models.AuditEntryFilter) ([]*models.AuditEntry, error)
+	auditEntriesMutex       sync.RWMutex
+	auditEntriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditEntryFilter
+	}
+	auditEntriesReturns struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}
+	auditEntriesReturnsOnCall map[int]struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}
 	CancelTaskStub        func(context.Context, lager.Logger, string) (*models.Task, *models.Task, string, error)
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -46,6 +61,34 @@ type FakeDB struct {
 		result3 string
 		result4 error
 	}
+	CellCordonsStub        func(context.Context, lager.Logger) ([]*models.CellCordon, error)
+	cellCordonsMutex       sync.RWMutex
+	cellCordonsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellCordonsReturns struct {
+		result1 []*models.CellCordon
+		result2 error
+	}
+	cellCordonsReturnsOnCall map[int]struct {
+		result1 []*models.CellCordon
+		result2 error
+	}
+	CellWorkloadsStub        func(context.Context, lager.Logger) ([]*models.CellWorkload, error)
+	cellWorkloadsMutex       sync.RWMutex
+	cellWorkloadsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellWorkloadsReturns struct {
+		result1 []*models.CellWorkload
+		result2 error
+	}
+	cellWorkloadsReturnsOnCall map[int]struct {
+		result1 []*models.CellWorkload
+		result2 error
+	}
 	ChangeActualLRPPresenceStub        func(context.Context, lager.Logger, *models.ActualLRPKey, models.ActualLRP_Presence, models.ActualLRP_Presence) (*models.ActualLRP, *models.ActualLRP, error)
 	changeActualLRPPresenceMutex       sync.RWMutex
 	changeActualLRPPresenceArgsForCall []struct {
@@ -118,6 +161,20 @@ type FakeDB struct {
 	convergeLRPsReturnsOnCall map[int]struct {
 		result1 db.ConvergenceResult
 	}
+	ConvergeLRPsInScopeStub        func(context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) db.ConvergenceResult
+	convergeLRPsInScopeMutex       sync.RWMutex
+	convergeLRPsInScopeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 db.LRPConvergenceScope
+	}
+	convergeLRPsInScopeReturns struct {
+		result1 db.ConvergenceResult
+	}
+	convergeLRPsInScopeReturnsOnCall map[int]struct {
+		result1 db.ConvergenceResult
+	}
 	ConvergeTasksStub        func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergenceResult
 	convergeTasksMutex       sync.RWMutex
 	convergeTasksArgsForCall []struct {
@@ -202,6 +259,21 @@ type FakeDB struct {
 		result1 *models.ActualLRP
 		result2 error
 	}
+	DataMigrationProgressStub        func(context.Context, lager.Logger, int64) (*db.DataMigrationProgress, error)
+	dataMigrationProgressMutex       sync.RWMutex
+	dataMigrationProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int64
+	}
+	dataMigrationProgressReturns struct {
+		result1 *db.DataMigrationProgress
+		result2 error
+	}
+	dataMigrationProgressReturnsOnCall map[int]struct {
+		result1 *db.DataMigrationProgress
+		result2 error
+	}
 	DeleteTaskStub        func(context.Context, lager.Logger, string) (*models.Task, error)
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
@@ -292,6 +364,16 @@ type FakeDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	EncryptedTablesStub        func() []string
+	encryptedTablesMutex       sync.RWMutex
+	encryptedTablesArgsForCall []struct {
+	}
+	encryptedTablesReturns struct {
+		result1 []string
+	}
+	encryptedTablesReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	EncryptionKeyLabelStub        func(context.Context, lager.Logger) (string, error)
 	encryptionKeyLabelMutex       sync.RWMutex
 	encryptionKeyLabelArgsForCall []struct {
@@ -306,6 +388,21 @@ type FakeDB struct {
 		result1 string
 		result2 error
 	}
+	EncryptionKeyUsageStub        func(context.Context, lager.Logger, string) (map[string]int, error)
+	encryptionKeyUsageMutex       sync.RWMutex
+	encryptionKeyUsageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	encryptionKeyUsageReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	encryptionKeyUsageReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
 	EvacuateActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo) (*models.ActualLRP, error)
 	evacuateActualLRPMutex       sync.RWMutex
 	evacuateActualLRPArgsForCall []struct {
@@ -373,6 +470,33 @@ type FakeDB struct {
 		result1 []string
 		result2 error
 	}
+	InsertAuditEntryStub        func(context.Context, lager.Logger, *models.AuditEntry) error
+	insertAuditEntryMutex       sync.RWMutex
+	insertAuditEntryArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.AuditEntry
+	}
+	insertAuditEntryReturns struct {
+		result1 error
+	}
+	insertAuditEntryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	MaintenanceModeStub        func(context.Context, lager.Logger) (*models.MaintenanceMode, error)
+	maintenanceModeMutex       sync.RWMutex
+	maintenanceModeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	maintenanceModeReturns struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	maintenanceModeReturnsOnCall map[int]struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
 	PerformEncryptionStub        func(context.Context, lager.Logger) error
 	performEncryptionMutex       sync.RWMutex
 	performEncryptionArgsForCall []struct {
@@ -385,6 +509,63 @@ type FakeDB struct {
 	performEncryptionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	PlanLRPConvergenceStub        func(context.Context, lager.Logger, models.CellSet) db.LRPConvergencePlan
+	planLRPConvergenceMutex       sync.RWMutex
+	planLRPConvergenceArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+	}
+	planLRPConvergenceReturns struct {
+		result1 db.LRPConvergencePlan
+	}
+	planLRPConvergenceReturnsOnCall map[int]struct {
+		result1 db.LRPConvergencePlan
+	}
+	PlanLRPConvergenceInScopeStub        func(context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) db.LRPConvergencePlan
+	planLRPConvergenceInScopeMutex       sync.RWMutex
+	planLRPConvergenceInScopeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 db.LRPConvergenceScope
+	}
+	planLRPConvergenceInScopeReturns struct {
+		result1 db.LRPConvergencePlan
+	}
+	planLRPConvergenceInScopeReturnsOnCall map[int]struct {
+		result1 db.LRPConvergencePlan
+	}
+	PlanTaskConvergenceStub        func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergencePlan
+	planTaskConvergenceMutex       sync.RWMutex
+	planTaskConvergenceArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 time.Duration
+		arg5 time.Duration
+		arg6 time.Duration
+	}
+	planTaskConvergenceReturns struct {
+		result1 db.TaskConvergencePlan
+	}
+	planTaskConvergenceReturnsOnCall map[int]struct {
+		result1 db.TaskConvergencePlan
+	}
+	ReEncryptTableStub        func(context.Context, lager.Logger, string, db.EncryptionProgressFunc) error
+	reEncryptTableMutex       sync.RWMutex
+	reEncryptTableArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 db.EncryptionProgressFunc
+	}
+	reEncryptTableReturns struct {
+		result1 error
+	}
+	reEncryptTableReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RejectTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)
 	rejectTaskMutex       sync.RWMutex
 	rejectTaskArgsForCall []struct {
@@ -418,6 +599,19 @@ type FakeDB struct {
 	removeActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemoveCellCordonStub        func(context.Context, lager.Logger, string) error
+	removeCellCordonMutex       sync.RWMutex
+	removeCellCordonArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	removeCellCordonReturns struct {
+		result1 error
+	}
+	removeCellCordonReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveDesiredLRPStub        func(context.Context, lager.Logger, string) error
 	removeDesiredLRPMutex       sync.RWMutex
 	removeDesiredLRPArgsForCall []struct {
@@ -477,6 +671,49 @@ type FakeDB struct {
 		result2 *models.Task
 		result3 error
 	}
+	RotatedEncryptionKeyStub        func(context.Context, lager.Logger) (*db.RotatedEncryptionKey, error)
+	rotatedEncryptionKeyMutex       sync.RWMutex
+	rotatedEncryptionKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	rotatedEncryptionKeyReturns struct {
+		result1 *db.RotatedEncryptionKey
+		result2 error
+	}
+	rotatedEncryptionKeyReturnsOnCall map[int]struct {
+		result1 *db.RotatedEncryptionKey
+		result2 error
+	}
+	SafetyValveCellIdsStub        func(context.Context, lager.Logger, string) ([]string, error)
+	safetyValveCellIdsMutex       sync.RWMutex
+	safetyValveCellIdsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	safetyValveCellIdsReturns struct {
+		result1 []string
+		result2 error
+	}
+	safetyValveCellIdsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	SetDataMigrationProgressStub        func(context.Context, lager.Logger, int64, *db.DataMigrationProgress) error
+	setDataMigrationProgressMutex       sync.RWMutex
+	setDataMigrationProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int64
+		arg4 *db.DataMigrationProgress
+	}
+	setDataMigrationProgressReturns struct {
+		result1 error
+	}
+	setDataMigrationProgressReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetEncryptionKeyLabelStub        func(context.Context, lager.Logger, string) error
 	setEncryptionKeyLabelMutex       sync.RWMutex
 	setEncryptionKeyLabelArgsForCall []struct {
@@ -490,6 +727,46 @@ type FakeDB struct {
 	setEncryptionKeyLabelReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetMaintenanceModeStub        func(context.Context, lager.Logger, *models.MaintenanceMode) error
+	setMaintenanceModeMutex       sync.RWMutex
+	setMaintenanceModeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.MaintenanceMode
+	}
+	setMaintenanceModeReturns struct {
+		result1 error
+	}
+	setMaintenanceModeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetRotatedEncryptionKeyStub        func(context.Context, lager.Logger, *db.RotatedEncryptionKey) error
+	setRotatedEncryptionKeyMutex       sync.RWMutex
+	setRotatedEncryptionKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *db.RotatedEncryptionKey
+	}
+	setRotatedEncryptionKeyReturns struct {
+		result1 error
+	}
+	setRotatedEncryptionKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetSafetyValveCellIdsStub        func(context.Context, lager.Logger, string, []string) error
+	setSafetyValveCellIdsMutex       sync.RWMutex
+	setSafetyValveCellIdsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []string
+	}
+	setSafetyValveCellIdsReturns struct {
+		result1 error
+	}
+	setSafetyValveCellIdsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetVersionStub        func(context.Context, lager.Logger, *models.Version) error
 	setVersionMutex       sync.RWMutex
 	setVersionArgsForCall []struct {
@@ -605,6 +882,19 @@ type FakeDB struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
+	UpsertCellCordonStub        func(context.Context, lager.Logger, *models.CellCordon) error
+	upsertCellCordonMutex       sync.RWMutex
+	upsertCellCordonArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.CellCordon
+	}
+	upsertCellCordonReturns struct {
+		result1 error
+	}
+	upsertCellCordonReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpsertDomainStub        func(context.Context, lager.Logger, string, uint32) error
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
@@ -703,6 +993,72 @@ func (fake *FakeDB) ActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP,
 	}{result1, result2}
 }
 
+func (fake *FakeDB) AuditEntries(arg1 context.Context, arg2 lager.Logger, arg3 models.AuditEntryFilter) ([]*models.AuditEntry, error) {
+	fake.auditEntriesMutex.Lock()
+	ret, specificReturn := fake.auditEntriesReturnsOnCall[len(fake.auditEntriesArgsForCall)]
+	fake.auditEntriesArgsForCall = append(fake.auditEntriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditEntryFilter
+	}{arg1, arg2, arg3})
+	stub := fake.AuditEntriesStub
+	fakeReturns := fake.auditEntriesReturns
+	fake.recordInvocation("AuditEntries", []interface{}{arg1, arg2, arg3})
+	fake.auditEntriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) AuditEntriesCallCount() int {
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	return len(fake.auditEntriesArgsForCall)
+}
+
+func (fake *FakeDB) AuditEntriesCalls(stub func(context.Context, lager.Logger, models.AuditEntryFilter) ([]*models.AuditEntry, error)) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = stub
+}
+
+func (fake *FakeDB) AuditEntriesArgsForCall(i int) (context.Context, lager.Logger, models.AuditEntryFilter) {
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	argsForCall := fake.auditEntriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) AuditEntriesReturns(result1 []*models.AuditEntry, result2 error) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = nil
+	fake.auditEntriesReturns = struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) AuditEntriesReturnsOnCall(i int, result1 []*models.AuditEntry, result2 error) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = nil
+	if fake.auditEntriesReturnsOnCall == nil {
+		fake.auditEntriesReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditEntry
+			result2 error
+		})
+	}
+	fake.auditEntriesReturnsOnCall[i] = struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) CancelTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, *models.Task, string, error) {
 	fake.cancelTaskMutex.Lock()
 	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
@@ -775,6 +1131,136 @@ func (fake *FakeDB) CancelTaskReturnsOnCall(i int, result1 *models.Task, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeDB) CellCordons(arg1 context.Context, arg2 lager.Logger) ([]*models.CellCordon, error) {
+	fake.cellCordonsMutex.Lock()
+	ret, specificReturn := fake.cellCordonsReturnsOnCall[len(fake.cellCordonsArgsForCall)]
+	fake.cellCordonsArgsForCall = append(fake.cellCordonsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellCordonsStub
+	fakeReturns := fake.cellCordonsReturns
+	fake.recordInvocation("CellCordons", []interface{}{arg1, arg2})
+	fake.cellCordonsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) CellCordonsCallCount() int {
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	return len(fake.cellCordonsArgsForCall)
+}
+
+func (fake *FakeDB) CellCordonsCalls(stub func(context.Context, lager.Logger) ([]*models.CellCordon, error)) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = stub
+}
+
+func (fake *FakeDB) CellCordonsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	argsForCall := fake.cellCordonsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) CellCordonsReturns(result1 []*models.CellCordon, result2 error) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = nil
+	fake.cellCordonsReturns = struct {
+		result1 []*models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CellCordonsReturnsOnCall(i int, result1 []*models.CellCordon, result2 error) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = nil
+	if fake.cellCordonsReturnsOnCall == nil {
+		fake.cellCordonsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellCordon
+			result2 error
+		})
+	}
+	fake.cellCordonsReturnsOnCall[i] = struct {
+		result1 []*models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CellWorkloads(arg1 context.Context, arg2 lager.Logger) ([]*models.CellWorkload, error) {
+	fake.cellWorkloadsMutex.Lock()
+	ret, specificReturn := fake.cellWorkloadsReturnsOnCall[len(fake.cellWorkloadsArgsForCall)]
+	fake.cellWorkloadsArgsForCall = append(fake.cellWorkloadsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellWorkloadsStub
+	fakeReturns := fake.cellWorkloadsReturns
+	fake.recordInvocation("CellWorkloads", []interface{}{arg1, arg2})
+	fake.cellWorkloadsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) CellWorkloadsCallCount() int {
+	fake.cellWorkloadsMutex.RLock()
+	defer fake.cellWorkloadsMutex.RUnlock()
+	return len(fake.cellWorkloadsArgsForCall)
+}
+
+func (fake *FakeDB) CellWorkloadsCalls(stub func(context.Context, lager.Logger) ([]*models.CellWorkload, error)) {
+	fake.cellWorkloadsMutex.Lock()
+	defer fake.cellWorkloadsMutex.Unlock()
+	fake.CellWorkloadsStub = stub
+}
+
+func (fake *FakeDB) CellWorkloadsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellWorkloadsMutex.RLock()
+	defer fake.cellWorkloadsMutex.RUnlock()
+	argsForCall := fake.cellWorkloadsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) CellWorkloadsReturns(result1 []*models.CellWorkload, result2 error) {
+	fake.cellWorkloadsMutex.Lock()
+	defer fake.cellWorkloadsMutex.Unlock()
+	fake.CellWorkloadsStub = nil
+	fake.cellWorkloadsReturns = struct {
+		result1 []*models.CellWorkload
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CellWorkloadsReturnsOnCall(i int, result1 []*models.CellWorkload, result2 error) {
+	fake.cellWorkloadsMutex.Lock()
+	defer fake.cellWorkloadsMutex.Unlock()
+	fake.CellWorkloadsStub = nil
+	if fake.cellWorkloadsReturnsOnCall == nil {
+		fake.cellWorkloadsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellWorkload
+			result2 error
+		})
+	}
+	fake.cellWorkloadsReturnsOnCall[i] = struct {
+		result1 []*models.CellWorkload
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) ChangeActualLRPPresence(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 models.ActualLRP_Presence, arg5 models.ActualLRP_Presence) (*models.ActualLRP, *models.ActualLRP, error) {
 	fake.changeActualLRPPresenceMutex.Lock()
 	ret, specificReturn := fake.changeActualLRPPresenceReturnsOnCall[len(fake.changeActualLRPPresenceArgsForCall)]
@@ -1053,21 +1539,85 @@ func (fake *FakeDB) ConvergeLRPsReturnsOnCall(i int, result1 db.ConvergenceResul
 	}{result1}
 }
 
-func (fake *FakeDB) ConvergeTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 time.Duration, arg5 time.Duration, arg6 time.Duration) db.TaskConvergenceResult {
-	fake.convergeTasksMutex.Lock()
-	ret, specificReturn := fake.convergeTasksReturnsOnCall[len(fake.convergeTasksArgsForCall)]
-	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
+func (fake *FakeDB) ConvergeLRPsInScope(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 db.LRPConvergenceScope) db.ConvergenceResult {
+	fake.convergeLRPsInScopeMutex.Lock()
+	ret, specificReturn := fake.convergeLRPsInScopeReturnsOnCall[len(fake.convergeLRPsInScopeArgsForCall)]
+	fake.convergeLRPsInScopeArgsForCall = append(fake.convergeLRPsInScopeArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 models.CellSet
-		arg4 time.Duration
-		arg5 time.Duration
-		arg6 time.Duration
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
-	stub := fake.ConvergeTasksStub
-	fakeReturns := fake.convergeTasksReturns
-	fake.recordInvocation("ConvergeTasks", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
-	fake.convergeTasksMutex.Unlock()
+		arg4 db.LRPConvergenceScope
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ConvergeLRPsInScopeStub
+	fakeReturns := fake.convergeLRPsInScopeReturns
+	fake.recordInvocation("ConvergeLRPsInScope", []interface{}{arg1, arg2, arg3, arg4})
+	fake.convergeLRPsInScopeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) ConvergeLRPsInScopeCallCount() int {
+	fake.convergeLRPsInScopeMutex.RLock()
+	defer fake.convergeLRPsInScopeMutex.RUnlock()
+	return len(fake.convergeLRPsInScopeArgsForCall)
+}
+
+func (fake *FakeDB) ConvergeLRPsInScopeCalls(stub func(context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) db.ConvergenceResult) {
+	fake.convergeLRPsInScopeMutex.Lock()
+	defer fake.convergeLRPsInScopeMutex.Unlock()
+	fake.ConvergeLRPsInScopeStub = stub
+}
+
+func (fake *FakeDB) ConvergeLRPsInScopeArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) {
+	fake.convergeLRPsInScopeMutex.RLock()
+	defer fake.convergeLRPsInScopeMutex.RUnlock()
+	argsForCall := fake.convergeLRPsInScopeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) ConvergeLRPsInScopeReturns(result1 db.ConvergenceResult) {
+	fake.convergeLRPsInScopeMutex.Lock()
+	defer fake.convergeLRPsInScopeMutex.Unlock()
+	fake.ConvergeLRPsInScopeStub = nil
+	fake.convergeLRPsInScopeReturns = struct {
+		result1 db.ConvergenceResult
+	}{result1}
+}
+
+func (fake *FakeDB) ConvergeLRPsInScopeReturnsOnCall(i int, result1 db.ConvergenceResult) {
+	fake.convergeLRPsInScopeMutex.Lock()
+	defer fake.convergeLRPsInScopeMutex.Unlock()
+	fake.ConvergeLRPsInScopeStub = nil
+	if fake.convergeLRPsInScopeReturnsOnCall == nil {
+		fake.convergeLRPsInScopeReturnsOnCall = make(map[int]struct {
+			result1 db.ConvergenceResult
+		})
+	}
+	fake.convergeLRPsInScopeReturnsOnCall[i] = struct {
+		result1 db.ConvergenceResult
+	}{result1}
+}
+
+func (fake *FakeDB) ConvergeTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 time.Duration, arg5 time.Duration, arg6 time.Duration) db.TaskConvergenceResult {
+	fake.convergeTasksMutex.Lock()
+	ret, specificReturn := fake.convergeTasksReturnsOnCall[len(fake.convergeTasksArgsForCall)]
+	fake.convergeTasksArgsForCall = append(fake.convergeTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 time.Duration
+		arg5 time.Duration
+		arg6 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ConvergeTasksStub
+	fakeReturns := fake.convergeTasksReturns
+	fake.recordInvocation("ConvergeTasks", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.convergeTasksMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
@@ -1395,6 +1945,72 @@ func (fake *FakeDB) CreateUnclaimedActualLRPReturnsOnCall(i int, result1 *models
 	}{result1, result2}
 }
 
+func (fake *FakeDB) DataMigrationProgress(arg1 context.Context, arg2 lager.Logger, arg3 int64) (*db.DataMigrationProgress, error) {
+	fake.dataMigrationProgressMutex.Lock()
+	ret, specificReturn := fake.dataMigrationProgressReturnsOnCall[len(fake.dataMigrationProgressArgsForCall)]
+	fake.dataMigrationProgressArgsForCall = append(fake.dataMigrationProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int64
+	}{arg1, arg2, arg3})
+	stub := fake.DataMigrationProgressStub
+	fakeReturns := fake.dataMigrationProgressReturns
+	fake.recordInvocation("DataMigrationProgress", []interface{}{arg1, arg2, arg3})
+	fake.dataMigrationProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DataMigrationProgressCallCount() int {
+	fake.dataMigrationProgressMutex.RLock()
+	defer fake.dataMigrationProgressMutex.RUnlock()
+	return len(fake.dataMigrationProgressArgsForCall)
+}
+
+func (fake *FakeDB) DataMigrationProgressCalls(stub func(context.Context, lager.Logger, int64) (*db.DataMigrationProgress, error)) {
+	fake.dataMigrationProgressMutex.Lock()
+	defer fake.dataMigrationProgressMutex.Unlock()
+	fake.DataMigrationProgressStub = stub
+}
+
+func (fake *FakeDB) DataMigrationProgressArgsForCall(i int) (context.Context, lager.Logger, int64) {
+	fake.dataMigrationProgressMutex.RLock()
+	defer fake.dataMigrationProgressMutex.RUnlock()
+	argsForCall := fake.dataMigrationProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DataMigrationProgressReturns(result1 *db.DataMigrationProgress, result2 error) {
+	fake.dataMigrationProgressMutex.Lock()
+	defer fake.dataMigrationProgressMutex.Unlock()
+	fake.DataMigrationProgressStub = nil
+	fake.dataMigrationProgressReturns = struct {
+		result1 *db.DataMigrationProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DataMigrationProgressReturnsOnCall(i int, result1 *db.DataMigrationProgress, result2 error) {
+	fake.dataMigrationProgressMutex.Lock()
+	defer fake.dataMigrationProgressMutex.Unlock()
+	fake.DataMigrationProgressStub = nil
+	if fake.dataMigrationProgressReturnsOnCall == nil {
+		fake.dataMigrationProgressReturnsOnCall = make(map[int]struct {
+			result1 *db.DataMigrationProgress
+			result2 error
+		})
+	}
+	fake.dataMigrationProgressReturnsOnCall[i] = struct {
+		result1 *db.DataMigrationProgress
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) DeleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, error) {
 	fake.deleteTaskMutex.Lock()
 	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
@@ -1790,6 +2406,59 @@ func (fake *FakeDB) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP
 	}{result1, result2}
 }
 
+func (fake *FakeDB) EncryptedTables() []string {
+	fake.encryptedTablesMutex.Lock()
+	ret, specificReturn := fake.encryptedTablesReturnsOnCall[len(fake.encryptedTablesArgsForCall)]
+	fake.encryptedTablesArgsForCall = append(fake.encryptedTablesArgsForCall, struct {
+	}{})
+	stub := fake.EncryptedTablesStub
+	fakeReturns := fake.encryptedTablesReturns
+	fake.recordInvocation("EncryptedTables", []interface{}{})
+	fake.encryptedTablesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) EncryptedTablesCallCount() int {
+	fake.encryptedTablesMutex.RLock()
+	defer fake.encryptedTablesMutex.RUnlock()
+	return len(fake.encryptedTablesArgsForCall)
+}
+
+func (fake *FakeDB) EncryptedTablesCalls(stub func() []string) {
+	fake.encryptedTablesMutex.Lock()
+	defer fake.encryptedTablesMutex.Unlock()
+	fake.EncryptedTablesStub = stub
+}
+
+func (fake *FakeDB) EncryptedTablesReturns(result1 []string) {
+	fake.encryptedTablesMutex.Lock()
+	defer fake.encryptedTablesMutex.Unlock()
+	fake.EncryptedTablesStub = nil
+	fake.encryptedTablesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeDB) EncryptedTablesReturnsOnCall(i int, result1 []string) {
+	fake.encryptedTablesMutex.Lock()
+	defer fake.encryptedTablesMutex.Unlock()
+	fake.EncryptedTablesStub = nil
+	if fake.encryptedTablesReturnsOnCall == nil {
+		fake.encryptedTablesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.encryptedTablesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeDB) EncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger) (string, error) {
 	fake.encryptionKeyLabelMutex.Lock()
 	ret, specificReturn := fake.encryptionKeyLabelReturnsOnCall[len(fake.encryptionKeyLabelArgsForCall)]
@@ -1855,6 +2524,72 @@ func (fake *FakeDB) EncryptionKeyLabelReturnsOnCall(i int, result1 string, resul
 	}{result1, result2}
 }
 
+func (fake *FakeDB) EncryptionKeyUsage(arg1 context.Context, arg2 lager.Logger, arg3 string) (map[string]int, error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	ret, specificReturn := fake.encryptionKeyUsageReturnsOnCall[len(fake.encryptionKeyUsageArgsForCall)]
+	fake.encryptionKeyUsageArgsForCall = append(fake.encryptionKeyUsageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.EncryptionKeyUsageStub
+	fakeReturns := fake.encryptionKeyUsageReturns
+	fake.recordInvocation("EncryptionKeyUsage", []interface{}{arg1, arg2, arg3})
+	fake.encryptionKeyUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) EncryptionKeyUsageCallCount() int {
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
+	return len(fake.encryptionKeyUsageArgsForCall)
+}
+
+func (fake *FakeDB) EncryptionKeyUsageCalls(stub func(context.Context, lager.Logger, string) (map[string]int, error)) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = stub
+}
+
+func (fake *FakeDB) EncryptionKeyUsageArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
+	argsForCall := fake.encryptionKeyUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) EncryptionKeyUsageReturns(result1 map[string]int, result2 error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = nil
+	fake.encryptionKeyUsageReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EncryptionKeyUsageReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = nil
+	if fake.encryptionKeyUsageReturnsOnCall == nil {
+		fake.encryptionKeyUsageReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.encryptionKeyUsageReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) EvacuateActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 *models.ActualLRPNetInfo) (*models.ActualLRP, error) {
 	fake.evacuateActualLRPMutex.Lock()
 	ret, specificReturn := fake.evacuateActualLRPReturnsOnCall[len(fake.evacuateActualLRPArgsForCall)]
@@ -2128,6 +2863,134 @@ func (fake *FakeDB) FreshDomainsReturnsOnCall(i int, result1 []string, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeDB) InsertAuditEntry(arg1 context.Context, arg2 lager.Logger, arg3 *models.AuditEntry) error {
+	fake.insertAuditEntryMutex.Lock()
+	ret, specificReturn := fake.insertAuditEntryReturnsOnCall[len(fake.insertAuditEntryArgsForCall)]
+	fake.insertAuditEntryArgsForCall = append(fake.insertAuditEntryArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.AuditEntry
+	}{arg1, arg2, arg3})
+	stub := fake.InsertAuditEntryStub
+	fakeReturns := fake.insertAuditEntryReturns
+	fake.recordInvocation("InsertAuditEntry", []interface{}{arg1, arg2, arg3})
+	fake.insertAuditEntryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) InsertAuditEntryCallCount() int {
+	fake.insertAuditEntryMutex.RLock()
+	defer fake.insertAuditEntryMutex.RUnlock()
+	return len(fake.insertAuditEntryArgsForCall)
+}
+
+func (fake *FakeDB) InsertAuditEntryCalls(stub func(context.Context, lager.Logger, *models.AuditEntry) error) {
+	fake.insertAuditEntryMutex.Lock()
+	defer fake.insertAuditEntryMutex.Unlock()
+	fake.InsertAuditEntryStub = stub
+}
+
+func (fake *FakeDB) InsertAuditEntryArgsForCall(i int) (context.Context, lager.Logger, *models.AuditEntry) {
+	fake.insertAuditEntryMutex.RLock()
+	defer fake.insertAuditEntryMutex.RUnlock()
+	argsForCall := fake.insertAuditEntryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) InsertAuditEntryReturns(result1 error) {
+	fake.insertAuditEntryMutex.Lock()
+	defer fake.insertAuditEntryMutex.Unlock()
+	fake.InsertAuditEntryStub = nil
+	fake.insertAuditEntryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) InsertAuditEntryReturnsOnCall(i int, result1 error) {
+	fake.insertAuditEntryMutex.Lock()
+	defer fake.insertAuditEntryMutex.Unlock()
+	fake.InsertAuditEntryStub = nil
+	if fake.insertAuditEntryReturnsOnCall == nil {
+		fake.insertAuditEntryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.insertAuditEntryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) MaintenanceMode(arg1 context.Context, arg2 lager.Logger) (*models.MaintenanceMode, error) {
+	fake.maintenanceModeMutex.Lock()
+	ret, specificReturn := fake.maintenanceModeReturnsOnCall[len(fake.maintenanceModeArgsForCall)]
+	fake.maintenanceModeArgsForCall = append(fake.maintenanceModeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.MaintenanceModeStub
+	fakeReturns := fake.maintenanceModeReturns
+	fake.recordInvocation("MaintenanceMode", []interface{}{arg1, arg2})
+	fake.maintenanceModeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) MaintenanceModeCallCount() int {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	return len(fake.maintenanceModeArgsForCall)
+}
+
+func (fake *FakeDB) MaintenanceModeCalls(stub func(context.Context, lager.Logger) (*models.MaintenanceMode, error)) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = stub
+}
+
+func (fake *FakeDB) MaintenanceModeArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	argsForCall := fake.maintenanceModeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) MaintenanceModeReturns(result1 *models.MaintenanceMode, result2 error) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = nil
+	fake.maintenanceModeReturns = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) MaintenanceModeReturnsOnCall(i int, result1 *models.MaintenanceMode, result2 error) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = nil
+	if fake.maintenanceModeReturnsOnCall == nil {
+		fake.maintenanceModeReturnsOnCall = make(map[int]struct {
+			result1 *models.MaintenanceMode
+			result2 error
+		})
+	}
+	fake.maintenanceModeReturnsOnCall[i] = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) PerformEncryption(arg1 context.Context, arg2 lager.Logger) error {
 	fake.performEncryptionMutex.Lock()
 	ret, specificReturn := fake.performEncryptionReturnsOnCall[len(fake.performEncryptionArgsForCall)]
@@ -2190,90 +3053,347 @@ func (fake *FakeDB) PerformEncryptionReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeDB) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
-	fake.rejectTaskMutex.Lock()
-	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
-	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+func (fake *FakeDB) PlanLRPConvergence(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet) db.LRPConvergencePlan {
+	fake.planLRPConvergenceMutex.Lock()
+	ret, specificReturn := fake.planLRPConvergenceReturnsOnCall[len(fake.planLRPConvergenceArgsForCall)]
+	fake.planLRPConvergenceArgsForCall = append(fake.planLRPConvergenceArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.RejectTaskStub
-	fakeReturns := fake.rejectTaskReturns
-	fake.recordInvocation("RejectTask", []interface{}{arg1, arg2, arg3, arg4})
-	fake.rejectTaskMutex.Unlock()
+		arg3 models.CellSet
+	}{arg1, arg2, arg3})
+	stub := fake.PlanLRPConvergenceStub
+	fakeReturns := fake.planLRPConvergenceReturns
+	fake.recordInvocation("PlanLRPConvergence", []interface{}{arg1, arg2, arg3})
+	fake.planLRPConvergenceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1
 }
 
-func (fake *FakeDB) RejectTaskCallCount() int {
-	fake.rejectTaskMutex.RLock()
-	defer fake.rejectTaskMutex.RUnlock()
-	return len(fake.rejectTaskArgsForCall)
+func (fake *FakeDB) PlanLRPConvergenceCallCount() int {
+	fake.planLRPConvergenceMutex.RLock()
+	defer fake.planLRPConvergenceMutex.RUnlock()
+	return len(fake.planLRPConvergenceArgsForCall)
 }
 
-func (fake *FakeDB) RejectTaskCalls(stub func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)) {
-	fake.rejectTaskMutex.Lock()
-	defer fake.rejectTaskMutex.Unlock()
-	fake.RejectTaskStub = stub
+func (fake *FakeDB) PlanLRPConvergenceCalls(stub func(context.Context, lager.Logger, models.CellSet) db.LRPConvergencePlan) {
+	fake.planLRPConvergenceMutex.Lock()
+	defer fake.planLRPConvergenceMutex.Unlock()
+	fake.PlanLRPConvergenceStub = stub
 }
 
-func (fake *FakeDB) RejectTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
-	fake.rejectTaskMutex.RLock()
-	defer fake.rejectTaskMutex.RUnlock()
-	argsForCall := fake.rejectTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeDB) PlanLRPConvergenceArgsForCall(i int) (context.Context, lager.Logger, models.CellSet) {
+	fake.planLRPConvergenceMutex.RLock()
+	defer fake.planLRPConvergenceMutex.RUnlock()
+	argsForCall := fake.planLRPConvergenceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) RejectTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.rejectTaskMutex.Lock()
-	defer fake.rejectTaskMutex.Unlock()
-	fake.RejectTaskStub = nil
-	fake.rejectTaskReturns = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeDB) PlanLRPConvergenceReturns(result1 db.LRPConvergencePlan) {
+	fake.planLRPConvergenceMutex.Lock()
+	defer fake.planLRPConvergenceMutex.Unlock()
+	fake.PlanLRPConvergenceStub = nil
+	fake.planLRPConvergenceReturns = struct {
+		result1 db.LRPConvergencePlan
+	}{result1}
 }
 
-func (fake *FakeDB) RejectTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.rejectTaskMutex.Lock()
-	defer fake.rejectTaskMutex.Unlock()
-	fake.RejectTaskStub = nil
-	if fake.rejectTaskReturnsOnCall == nil {
-		fake.rejectTaskReturnsOnCall = make(map[int]struct {
-			result1 *models.Task
-			result2 *models.Task
-			result3 error
+func (fake *FakeDB) PlanLRPConvergenceReturnsOnCall(i int, result1 db.LRPConvergencePlan) {
+	fake.planLRPConvergenceMutex.Lock()
+	defer fake.planLRPConvergenceMutex.Unlock()
+	fake.PlanLRPConvergenceStub = nil
+	if fake.planLRPConvergenceReturnsOnCall == nil {
+		fake.planLRPConvergenceReturnsOnCall = make(map[int]struct {
+			result1 db.LRPConvergencePlan
 		})
 	}
-	fake.rejectTaskReturnsOnCall[i] = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+	fake.planLRPConvergenceReturnsOnCall[i] = struct {
+		result1 db.LRPConvergencePlan
+	}{result1}
 }
 
-func (fake *FakeDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
-	fake.removeActualLRPMutex.Lock()
-	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
-	fake.removeActualLRPArgsForCall = append(fake.removeActualLRPArgsForCall, struct {
+func (fake *FakeDB) PlanLRPConvergenceInScope(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 db.LRPConvergenceScope) db.LRPConvergencePlan {
+	fake.planLRPConvergenceInScopeMutex.Lock()
+	ret, specificReturn := fake.planLRPConvergenceInScopeReturnsOnCall[len(fake.planLRPConvergenceInScopeArgsForCall)]
+	fake.planLRPConvergenceInScopeArgsForCall = append(fake.planLRPConvergenceInScopeArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 int32
-		arg5 *models.ActualLRPInstanceKey
-	}{arg1, arg2, arg3, arg4, arg5})
-	stub := fake.RemoveActualLRPStub
-	fakeReturns := fake.removeActualLRPReturns
-	fake.recordInvocation("RemoveActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5})
-	fake.removeActualLRPMutex.Unlock()
+		arg3 models.CellSet
+		arg4 db.LRPConvergenceScope
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PlanLRPConvergenceInScopeStub
+	fakeReturns := fake.planLRPConvergenceInScopeReturns
+	fake.recordInvocation("PlanLRPConvergenceInScope", []interface{}{arg1, arg2, arg3, arg4})
+	fake.planLRPConvergenceInScopeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) PlanLRPConvergenceInScopeCallCount() int {
+	fake.planLRPConvergenceInScopeMutex.RLock()
+	defer fake.planLRPConvergenceInScopeMutex.RUnlock()
+	return len(fake.planLRPConvergenceInScopeArgsForCall)
+}
+
+func (fake *FakeDB) PlanLRPConvergenceInScopeCalls(stub func(context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) db.LRPConvergencePlan) {
+	fake.planLRPConvergenceInScopeMutex.Lock()
+	defer fake.planLRPConvergenceInScopeMutex.Unlock()
+	fake.PlanLRPConvergenceInScopeStub = stub
+}
+
+func (fake *FakeDB) PlanLRPConvergenceInScopeArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) {
+	fake.planLRPConvergenceInScopeMutex.RLock()
+	defer fake.planLRPConvergenceInScopeMutex.RUnlock()
+	argsForCall := fake.planLRPConvergenceInScopeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) PlanLRPConvergenceInScopeReturns(result1 db.LRPConvergencePlan) {
+	fake.planLRPConvergenceInScopeMutex.Lock()
+	defer fake.planLRPConvergenceInScopeMutex.Unlock()
+	fake.PlanLRPConvergenceInScopeStub = nil
+	fake.planLRPConvergenceInScopeReturns = struct {
+		result1 db.LRPConvergencePlan
+	}{result1}
+}
+
+func (fake *FakeDB) PlanLRPConvergenceInScopeReturnsOnCall(i int, result1 db.LRPConvergencePlan) {
+	fake.planLRPConvergenceInScopeMutex.Lock()
+	defer fake.planLRPConvergenceInScopeMutex.Unlock()
+	fake.PlanLRPConvergenceInScopeStub = nil
+	if fake.planLRPConvergenceInScopeReturnsOnCall == nil {
+		fake.planLRPConvergenceInScopeReturnsOnCall = make(map[int]struct {
+			result1 db.LRPConvergencePlan
+		})
+	}
+	fake.planLRPConvergenceInScopeReturnsOnCall[i] = struct {
+		result1 db.LRPConvergencePlan
+	}{result1}
+}
+
+func (fake *FakeDB) PlanTaskConvergence(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 time.Duration, arg5 time.Duration, arg6 time.Duration) db.TaskConvergencePlan {
+	fake.planTaskConvergenceMutex.Lock()
+	ret, specificReturn := fake.planTaskConvergenceReturnsOnCall[len(fake.planTaskConvergenceArgsForCall)]
+	fake.planTaskConvergenceArgsForCall = append(fake.planTaskConvergenceArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 time.Duration
+		arg5 time.Duration
+		arg6 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.PlanTaskConvergenceStub
+	fakeReturns := fake.planTaskConvergenceReturns
+	fake.recordInvocation("PlanTaskConvergence", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.planTaskConvergenceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) PlanTaskConvergenceCallCount() int {
+	fake.planTaskConvergenceMutex.RLock()
+	defer fake.planTaskConvergenceMutex.RUnlock()
+	return len(fake.planTaskConvergenceArgsForCall)
+}
+
+func (fake *FakeDB) PlanTaskConvergenceCalls(stub func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergencePlan) {
+	fake.planTaskConvergenceMutex.Lock()
+	defer fake.planTaskConvergenceMutex.Unlock()
+	fake.PlanTaskConvergenceStub = stub
+}
+
+func (fake *FakeDB) PlanTaskConvergenceArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) {
+	fake.planTaskConvergenceMutex.RLock()
+	defer fake.planTaskConvergenceMutex.RUnlock()
+	argsForCall := fake.planTaskConvergenceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeDB) PlanTaskConvergenceReturns(result1 db.TaskConvergencePlan) {
+	fake.planTaskConvergenceMutex.Lock()
+	defer fake.planTaskConvergenceMutex.Unlock()
+	fake.PlanTaskConvergenceStub = nil
+	fake.planTaskConvergenceReturns = struct {
+		result1 db.TaskConvergencePlan
+	}{result1}
+}
+
+func (fake *FakeDB) PlanTaskConvergenceReturnsOnCall(i int, result1 db.TaskConvergencePlan) {
+	fake.planTaskConvergenceMutex.Lock()
+	defer fake.planTaskConvergenceMutex.Unlock()
+	fake.PlanTaskConvergenceStub = nil
+	if fake.planTaskConvergenceReturnsOnCall == nil {
+		fake.planTaskConvergenceReturnsOnCall = make(map[int]struct {
+			result1 db.TaskConvergencePlan
+		})
+	}
+	fake.planTaskConvergenceReturnsOnCall[i] = struct {
+		result1 db.TaskConvergencePlan
+	}{result1}
+}
+
+func (fake *FakeDB) ReEncryptTable(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 db.EncryptionProgressFunc) error {
+	fake.reEncryptTableMutex.Lock()
+	ret, specificReturn := fake.reEncryptTableReturnsOnCall[len(fake.reEncryptTableArgsForCall)]
+	fake.reEncryptTableArgsForCall = append(fake.reEncryptTableArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 db.EncryptionProgressFunc
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ReEncryptTableStub
+	fakeReturns := fake.reEncryptTableReturns
+	fake.recordInvocation("ReEncryptTable", []interface{}{arg1, arg2, arg3, arg4})
+	fake.reEncryptTableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) ReEncryptTableCallCount() int {
+	fake.reEncryptTableMutex.RLock()
+	defer fake.reEncryptTableMutex.RUnlock()
+	return len(fake.reEncryptTableArgsForCall)
+}
+
+func (fake *FakeDB) ReEncryptTableCalls(stub func(context.Context, lager.Logger, string, db.EncryptionProgressFunc) error) {
+	fake.reEncryptTableMutex.Lock()
+	defer fake.reEncryptTableMutex.Unlock()
+	fake.ReEncryptTableStub = stub
+}
+
+func (fake *FakeDB) ReEncryptTableArgsForCall(i int) (context.Context, lager.Logger, string, db.EncryptionProgressFunc) {
+	fake.reEncryptTableMutex.RLock()
+	defer fake.reEncryptTableMutex.RUnlock()
+	argsForCall := fake.reEncryptTableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) ReEncryptTableReturns(result1 error) {
+	fake.reEncryptTableMutex.Lock()
+	defer fake.reEncryptTableMutex.Unlock()
+	fake.ReEncryptTableStub = nil
+	fake.reEncryptTableReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) ReEncryptTableReturnsOnCall(i int, result1 error) {
+	fake.reEncryptTableMutex.Lock()
+	defer fake.reEncryptTableMutex.Unlock()
+	fake.ReEncryptTableStub = nil
+	if fake.reEncryptTableReturnsOnCall == nil {
+		fake.reEncryptTableReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reEncryptTableReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
+	fake.rejectTaskMutex.Lock()
+	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
+	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RejectTaskStub
+	fakeReturns := fake.rejectTaskReturns
+	fake.recordInvocation("RejectTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rejectTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) RejectTaskCallCount() int {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return len(fake.rejectTaskArgsForCall)
+}
+
+func (fake *FakeDB) RejectTaskCalls(stub func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = stub
+}
+
+func (fake *FakeDB) RejectTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	argsForCall := fake.rejectTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) RejectTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = nil
+	fake.rejectTaskReturns = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) RejectTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = nil
+	if fake.rejectTaskReturnsOnCall == nil {
+		fake.rejectTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
+			result2 *models.Task
+			result3 error
+		})
+	}
+	fake.rejectTaskReturnsOnCall[i] = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
+	fake.removeActualLRPMutex.Lock()
+	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
+	fake.removeActualLRPArgsForCall = append(fake.removeActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+		arg5 *models.ActualLRPInstanceKey
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RemoveActualLRPStub
+	fakeReturns := fake.removeActualLRPReturns
+	fake.recordInvocation("RemoveActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.removeActualLRPMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
@@ -2325,6 +3445,69 @@ func (fake *FakeDB) RemoveActualLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) RemoveCellCordon(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeCellCordonMutex.Lock()
+	ret, specificReturn := fake.removeCellCordonReturnsOnCall[len(fake.removeCellCordonArgsForCall)]
+	fake.removeCellCordonArgsForCall = append(fake.removeCellCordonArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveCellCordonStub
+	fakeReturns := fake.removeCellCordonReturns
+	fake.recordInvocation("RemoveCellCordon", []interface{}{arg1, arg2, arg3})
+	fake.removeCellCordonMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) RemoveCellCordonCallCount() int {
+	fake.removeCellCordonMutex.RLock()
+	defer fake.removeCellCordonMutex.RUnlock()
+	return len(fake.removeCellCordonArgsForCall)
+}
+
+func (fake *FakeDB) RemoveCellCordonCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeCellCordonMutex.Lock()
+	defer fake.removeCellCordonMutex.Unlock()
+	fake.RemoveCellCordonStub = stub
+}
+
+func (fake *FakeDB) RemoveCellCordonArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeCellCordonMutex.RLock()
+	defer fake.removeCellCordonMutex.RUnlock()
+	argsForCall := fake.removeCellCordonArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) RemoveCellCordonReturns(result1 error) {
+	fake.removeCellCordonMutex.Lock()
+	defer fake.removeCellCordonMutex.Unlock()
+	fake.RemoveCellCordonStub = nil
+	fake.removeCellCordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveCellCordonReturnsOnCall(i int, result1 error) {
+	fake.removeCellCordonMutex.Lock()
+	defer fake.removeCellCordonMutex.Unlock()
+	fake.RemoveCellCordonStub = nil
+	if fake.removeCellCordonReturnsOnCall == nil {
+		fake.removeCellCordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeCellCordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) RemoveDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
 	fake.removeDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.removeDesiredLRPReturnsOnCall[len(fake.removeDesiredLRPArgsForCall)]
@@ -2587,6 +3770,201 @@ func (fake *FakeDB) ResolvingTaskReturnsOnCall(i int, result1 *models.Task, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeDB) RotatedEncryptionKey(arg1 context.Context, arg2 lager.Logger) (*db.RotatedEncryptionKey, error) {
+	fake.rotatedEncryptionKeyMutex.Lock()
+	ret, specificReturn := fake.rotatedEncryptionKeyReturnsOnCall[len(fake.rotatedEncryptionKeyArgsForCall)]
+	fake.rotatedEncryptionKeyArgsForCall = append(fake.rotatedEncryptionKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.RotatedEncryptionKeyStub
+	fakeReturns := fake.rotatedEncryptionKeyReturns
+	fake.recordInvocation("RotatedEncryptionKey", []interface{}{arg1, arg2})
+	fake.rotatedEncryptionKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) RotatedEncryptionKeyCallCount() int {
+	fake.rotatedEncryptionKeyMutex.RLock()
+	defer fake.rotatedEncryptionKeyMutex.RUnlock()
+	return len(fake.rotatedEncryptionKeyArgsForCall)
+}
+
+func (fake *FakeDB) RotatedEncryptionKeyCalls(stub func(context.Context, lager.Logger) (*db.RotatedEncryptionKey, error)) {
+	fake.rotatedEncryptionKeyMutex.Lock()
+	defer fake.rotatedEncryptionKeyMutex.Unlock()
+	fake.RotatedEncryptionKeyStub = stub
+}
+
+func (fake *FakeDB) RotatedEncryptionKeyArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.rotatedEncryptionKeyMutex.RLock()
+	defer fake.rotatedEncryptionKeyMutex.RUnlock()
+	argsForCall := fake.rotatedEncryptionKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) RotatedEncryptionKeyReturns(result1 *db.RotatedEncryptionKey, result2 error) {
+	fake.rotatedEncryptionKeyMutex.Lock()
+	defer fake.rotatedEncryptionKeyMutex.Unlock()
+	fake.RotatedEncryptionKeyStub = nil
+	fake.rotatedEncryptionKeyReturns = struct {
+		result1 *db.RotatedEncryptionKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RotatedEncryptionKeyReturnsOnCall(i int, result1 *db.RotatedEncryptionKey, result2 error) {
+	fake.rotatedEncryptionKeyMutex.Lock()
+	defer fake.rotatedEncryptionKeyMutex.Unlock()
+	fake.RotatedEncryptionKeyStub = nil
+	if fake.rotatedEncryptionKeyReturnsOnCall == nil {
+		fake.rotatedEncryptionKeyReturnsOnCall = make(map[int]struct {
+			result1 *db.RotatedEncryptionKey
+			result2 error
+		})
+	}
+	fake.rotatedEncryptionKeyReturnsOnCall[i] = struct {
+		result1 *db.RotatedEncryptionKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SafetyValveCellIds(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]string, error) {
+	fake.safetyValveCellIdsMutex.Lock()
+	ret, specificReturn := fake.safetyValveCellIdsReturnsOnCall[len(fake.safetyValveCellIdsArgsForCall)]
+	fake.safetyValveCellIdsArgsForCall = append(fake.safetyValveCellIdsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SafetyValveCellIdsStub
+	fakeReturns := fake.safetyValveCellIdsReturns
+	fake.recordInvocation("SafetyValveCellIds", []interface{}{arg1, arg2, arg3})
+	fake.safetyValveCellIdsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) SafetyValveCellIdsCallCount() int {
+	fake.safetyValveCellIdsMutex.RLock()
+	defer fake.safetyValveCellIdsMutex.RUnlock()
+	return len(fake.safetyValveCellIdsArgsForCall)
+}
+
+func (fake *FakeDB) SafetyValveCellIdsCalls(stub func(context.Context, lager.Logger, string) ([]string, error)) {
+	fake.safetyValveCellIdsMutex.Lock()
+	defer fake.safetyValveCellIdsMutex.Unlock()
+	fake.SafetyValveCellIdsStub = stub
+}
+
+func (fake *FakeDB) SafetyValveCellIdsArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.safetyValveCellIdsMutex.RLock()
+	defer fake.safetyValveCellIdsMutex.RUnlock()
+	argsForCall := fake.safetyValveCellIdsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) SafetyValveCellIdsReturns(result1 []string, result2 error) {
+	fake.safetyValveCellIdsMutex.Lock()
+	defer fake.safetyValveCellIdsMutex.Unlock()
+	fake.SafetyValveCellIdsStub = nil
+	fake.safetyValveCellIdsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SafetyValveCellIdsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.safetyValveCellIdsMutex.Lock()
+	defer fake.safetyValveCellIdsMutex.Unlock()
+	fake.SafetyValveCellIdsStub = nil
+	if fake.safetyValveCellIdsReturnsOnCall == nil {
+		fake.safetyValveCellIdsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.safetyValveCellIdsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SetDataMigrationProgress(arg1 context.Context, arg2 lager.Logger, arg3 int64, arg4 *db.DataMigrationProgress) error {
+	fake.setDataMigrationProgressMutex.Lock()
+	ret, specificReturn := fake.setDataMigrationProgressReturnsOnCall[len(fake.setDataMigrationProgressArgsForCall)]
+	fake.setDataMigrationProgressArgsForCall = append(fake.setDataMigrationProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int64
+		arg4 *db.DataMigrationProgress
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetDataMigrationProgressStub
+	fakeReturns := fake.setDataMigrationProgressReturns
+	fake.recordInvocation("SetDataMigrationProgress", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setDataMigrationProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) SetDataMigrationProgressCallCount() int {
+	fake.setDataMigrationProgressMutex.RLock()
+	defer fake.setDataMigrationProgressMutex.RUnlock()
+	return len(fake.setDataMigrationProgressArgsForCall)
+}
+
+func (fake *FakeDB) SetDataMigrationProgressCalls(stub func(context.Context, lager.Logger, int64, *db.DataMigrationProgress) error) {
+	fake.setDataMigrationProgressMutex.Lock()
+	defer fake.setDataMigrationProgressMutex.Unlock()
+	fake.SetDataMigrationProgressStub = stub
+}
+
+func (fake *FakeDB) SetDataMigrationProgressArgsForCall(i int) (context.Context, lager.Logger, int64, *db.DataMigrationProgress) {
+	fake.setDataMigrationProgressMutex.RLock()
+	defer fake.setDataMigrationProgressMutex.RUnlock()
+	argsForCall := fake.setDataMigrationProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) SetDataMigrationProgressReturns(result1 error) {
+	fake.setDataMigrationProgressMutex.Lock()
+	defer fake.setDataMigrationProgressMutex.Unlock()
+	fake.SetDataMigrationProgressStub = nil
+	fake.setDataMigrationProgressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) SetDataMigrationProgressReturnsOnCall(i int, result1 error) {
+	fake.setDataMigrationProgressMutex.Lock()
+	defer fake.setDataMigrationProgressMutex.Unlock()
+	fake.SetDataMigrationProgressStub = nil
+	if fake.setDataMigrationProgressReturnsOnCall == nil {
+		fake.setDataMigrationProgressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setDataMigrationProgressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) SetEncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
 	fake.setEncryptionKeyLabelMutex.Lock()
 	ret, specificReturn := fake.setEncryptionKeyLabelReturnsOnCall[len(fake.setEncryptionKeyLabelArgsForCall)]
@@ -2650,6 +4028,201 @@ func (fake *FakeDB) SetEncryptionKeyLabelReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) SetMaintenanceMode(arg1 context.Context, arg2 lager.Logger, arg3 *models.MaintenanceMode) error {
+	fake.setMaintenanceModeMutex.Lock()
+	ret, specificReturn := fake.setMaintenanceModeReturnsOnCall[len(fake.setMaintenanceModeArgsForCall)]
+	fake.setMaintenanceModeArgsForCall = append(fake.setMaintenanceModeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.MaintenanceMode
+	}{arg1, arg2, arg3})
+	stub := fake.SetMaintenanceModeStub
+	fakeReturns := fake.setMaintenanceModeReturns
+	fake.recordInvocation("SetMaintenanceMode", []interface{}{arg1, arg2, arg3})
+	fake.setMaintenanceModeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) SetMaintenanceModeCallCount() int {
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	return len(fake.setMaintenanceModeArgsForCall)
+}
+
+func (fake *FakeDB) SetMaintenanceModeCalls(stub func(context.Context, lager.Logger, *models.MaintenanceMode) error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = stub
+}
+
+func (fake *FakeDB) SetMaintenanceModeArgsForCall(i int) (context.Context, lager.Logger, *models.MaintenanceMode) {
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	argsForCall := fake.setMaintenanceModeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) SetMaintenanceModeReturns(result1 error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = nil
+	fake.setMaintenanceModeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) SetMaintenanceModeReturnsOnCall(i int, result1 error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = nil
+	if fake.setMaintenanceModeReturnsOnCall == nil {
+		fake.setMaintenanceModeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setMaintenanceModeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) SetRotatedEncryptionKey(arg1 context.Context, arg2 lager.Logger, arg3 *db.RotatedEncryptionKey) error {
+	fake.setRotatedEncryptionKeyMutex.Lock()
+	ret, specificReturn := fake.setRotatedEncryptionKeyReturnsOnCall[len(fake.setRotatedEncryptionKeyArgsForCall)]
+	fake.setRotatedEncryptionKeyArgsForCall = append(fake.setRotatedEncryptionKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *db.RotatedEncryptionKey
+	}{arg1, arg2, arg3})
+	stub := fake.SetRotatedEncryptionKeyStub
+	fakeReturns := fake.setRotatedEncryptionKeyReturns
+	fake.recordInvocation("SetRotatedEncryptionKey", []interface{}{arg1, arg2, arg3})
+	fake.setRotatedEncryptionKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) SetRotatedEncryptionKeyCallCount() int {
+	fake.setRotatedEncryptionKeyMutex.RLock()
+	defer fake.setRotatedEncryptionKeyMutex.RUnlock()
+	return len(fake.setRotatedEncryptionKeyArgsForCall)
+}
+
+func (fake *FakeDB) SetRotatedEncryptionKeyCalls(stub func(context.Context, lager.Logger, *db.RotatedEncryptionKey) error) {
+	fake.setRotatedEncryptionKeyMutex.Lock()
+	defer fake.setRotatedEncryptionKeyMutex.Unlock()
+	fake.SetRotatedEncryptionKeyStub = stub
+}
+
+func (fake *FakeDB) SetRotatedEncryptionKeyArgsForCall(i int) (context.Context, lager.Logger, *db.RotatedEncryptionKey) {
+	fake.setRotatedEncryptionKeyMutex.RLock()
+	defer fake.setRotatedEncryptionKeyMutex.RUnlock()
+	argsForCall := fake.setRotatedEncryptionKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) SetRotatedEncryptionKeyReturns(result1 error) {
+	fake.setRotatedEncryptionKeyMutex.Lock()
+	defer fake.setRotatedEncryptionKeyMutex.Unlock()
+	fake.SetRotatedEncryptionKeyStub = nil
+	fake.setRotatedEncryptionKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) SetRotatedEncryptionKeyReturnsOnCall(i int, result1 error) {
+	fake.setRotatedEncryptionKeyMutex.Lock()
+	defer fake.setRotatedEncryptionKeyMutex.Unlock()
+	fake.SetRotatedEncryptionKeyStub = nil
+	if fake.setRotatedEncryptionKeyReturnsOnCall == nil {
+		fake.setRotatedEncryptionKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRotatedEncryptionKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) SetSafetyValveCellIds(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 []string) error {
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.setSafetyValveCellIdsMutex.Lock()
+	ret, specificReturn := fake.setSafetyValveCellIdsReturnsOnCall[len(fake.setSafetyValveCellIdsArgsForCall)]
+	fake.setSafetyValveCellIdsArgsForCall = append(fake.setSafetyValveCellIdsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []string
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.SetSafetyValveCellIdsStub
+	fakeReturns := fake.setSafetyValveCellIdsReturns
+	fake.recordInvocation("SetSafetyValveCellIds", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.setSafetyValveCellIdsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) SetSafetyValveCellIdsCallCount() int {
+	fake.setSafetyValveCellIdsMutex.RLock()
+	defer fake.setSafetyValveCellIdsMutex.RUnlock()
+	return len(fake.setSafetyValveCellIdsArgsForCall)
+}
+
+func (fake *FakeDB) SetSafetyValveCellIdsCalls(stub func(context.Context, lager.Logger, string, []string) error) {
+	fake.setSafetyValveCellIdsMutex.Lock()
+	defer fake.setSafetyValveCellIdsMutex.Unlock()
+	fake.SetSafetyValveCellIdsStub = stub
+}
+
+func (fake *FakeDB) SetSafetyValveCellIdsArgsForCall(i int) (context.Context, lager.Logger, string, []string) {
+	fake.setSafetyValveCellIdsMutex.RLock()
+	defer fake.setSafetyValveCellIdsMutex.RUnlock()
+	argsForCall := fake.setSafetyValveCellIdsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) SetSafetyValveCellIdsReturns(result1 error) {
+	fake.setSafetyValveCellIdsMutex.Lock()
+	defer fake.setSafetyValveCellIdsMutex.Unlock()
+	fake.SetSafetyValveCellIdsStub = nil
+	fake.setSafetyValveCellIdsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) SetSafetyValveCellIdsReturnsOnCall(i int, result1 error) {
+	fake.setSafetyValveCellIdsMutex.Lock()
+	defer fake.setSafetyValveCellIdsMutex.Unlock()
+	fake.SetSafetyValveCellIdsStub = nil
+	if fake.setSafetyValveCellIdsReturnsOnCall == nil {
+		fake.setSafetyValveCellIdsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setSafetyValveCellIdsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) SetVersion(arg1 context.Context, arg2 lager.Logger, arg3 *models.Version) error {
 	fake.setVersionMutex.Lock()
 	ret, specificReturn := fake.setVersionReturnsOnCall[len(fake.setVersionArgsForCall)]
@@ -3125,6 +4698,69 @@ func (fake *FakeDB) UpdateDesiredLRPReturnsOnCall(i int, result1 *models.Desired
 	}{result1, result2}
 }
 
+func (fake *FakeDB) UpsertCellCordon(arg1 context.Context, arg2 lager.Logger, arg3 *models.CellCordon) error {
+	fake.upsertCellCordonMutex.Lock()
+	ret, specificReturn := fake.upsertCellCordonReturnsOnCall[len(fake.upsertCellCordonArgsForCall)]
+	fake.upsertCellCordonArgsForCall = append(fake.upsertCellCordonArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.CellCordon
+	}{arg1, arg2, arg3})
+	stub := fake.UpsertCellCordonStub
+	fakeReturns := fake.upsertCellCordonReturns
+	fake.recordInvocation("UpsertCellCordon", []interface{}{arg1, arg2, arg3})
+	fake.upsertCellCordonMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) UpsertCellCordonCallCount() int {
+	fake.upsertCellCordonMutex.RLock()
+	defer fake.upsertCellCordonMutex.RUnlock()
+	return len(fake.upsertCellCordonArgsForCall)
+}
+
+func (fake *FakeDB) UpsertCellCordonCalls(stub func(context.Context, lager.Logger, *models.CellCordon) error) {
+	fake.upsertCellCordonMutex.Lock()
+	defer fake.upsertCellCordonMutex.Unlock()
+	fake.UpsertCellCordonStub = stub
+}
+
+func (fake *FakeDB) UpsertCellCordonArgsForCall(i int) (context.Context, lager.Logger, *models.CellCordon) {
+	fake.upsertCellCordonMutex.RLock()
+	defer fake.upsertCellCordonMutex.RUnlock()
+	argsForCall := fake.upsertCellCordonArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) UpsertCellCordonReturns(result1 error) {
+	fake.upsertCellCordonMutex.Lock()
+	defer fake.upsertCellCordonMutex.Unlock()
+	fake.UpsertCellCordonStub = nil
+	fake.upsertCellCordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) UpsertCellCordonReturnsOnCall(i int, result1 error) {
+	fake.upsertCellCordonMutex.Lock()
+	defer fake.upsertCellCordonMutex.Unlock()
+	fake.UpsertCellCordonStub = nil
+	if fake.upsertCellCordonReturnsOnCall == nil {
+		fake.upsertCellCordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertCellCordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint32) error {
 	fake.upsertDomainMutex.Lock()
 	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
@@ -3259,8 +4895,14 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	fake.cellWorkloadsMutex.RLock()
+	defer fake.cellWorkloadsMutex.RUnlock()
 	fake.changeActualLRPPresenceMutex.RLock()
 	defer fake.changeActualLRPPresenceMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
@@ -3269,6 +4911,8 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.completeTaskMutex.RUnlock()
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
+	fake.convergeLRPsInScopeMutex.RLock()
+	defer fake.convergeLRPsInScopeMutex.RUnlock()
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
 	fake.countActualLRPsByStateMutex.RLock()
@@ -3279,6 +4923,8 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.crashActualLRPMutex.RUnlock()
 	fake.createUnclaimedActualLRPMutex.RLock()
 	defer fake.createUnclaimedActualLRPMutex.RUnlock()
+	fake.dataMigrationProgressMutex.RLock()
+	defer fake.dataMigrationProgressMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
@@ -3291,8 +4937,12 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.encryptedTablesMutex.RLock()
+	defer fake.encryptedTablesMutex.RUnlock()
 	fake.encryptionKeyLabelMutex.RLock()
 	defer fake.encryptionKeyLabelMutex.RUnlock()
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
 	fake.evacuateActualLRPMutex.RLock()
 	defer fake.evacuateActualLRPMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
@@ -3301,12 +4951,26 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.failTaskMutex.RUnlock()
 	fake.freshDomainsMutex.RLock()
 	defer fake.freshDomainsMutex.RUnlock()
+	fake.insertAuditEntryMutex.RLock()
+	defer fake.insertAuditEntryMutex.RUnlock()
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
 	fake.performEncryptionMutex.RLock()
 	defer fake.performEncryptionMutex.RUnlock()
+	fake.planLRPConvergenceMutex.RLock()
+	defer fake.planLRPConvergenceMutex.RUnlock()
+	fake.planLRPConvergenceInScopeMutex.RLock()
+	defer fake.planLRPConvergenceInScopeMutex.RUnlock()
+	fake.planTaskConvergenceMutex.RLock()
+	defer fake.planTaskConvergenceMutex.RUnlock()
+	fake.reEncryptTableMutex.RLock()
+	defer fake.reEncryptTableMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
 	defer fake.rejectTaskMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
+	fake.removeCellCordonMutex.RLock()
+	defer fake.removeCellCordonMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
 	fake.removeEvacuatingActualLRPMutex.RLock()
@@ -3315,8 +4979,20 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.removeSuspectActualLRPMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
+	fake.rotatedEncryptionKeyMutex.RLock()
+	defer fake.rotatedEncryptionKeyMutex.RUnlock()
+	fake.safetyValveCellIdsMutex.RLock()
+	defer fake.safetyValveCellIdsMutex.RUnlock()
+	fake.setDataMigrationProgressMutex.RLock()
+	defer fake.setDataMigrationProgressMutex.RUnlock()
 	fake.setEncryptionKeyLabelMutex.RLock()
 	defer fake.setEncryptionKeyLabelMutex.RUnlock()
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	fake.setRotatedEncryptionKeyMutex.RLock()
+	defer fake.setRotatedEncryptionKeyMutex.RUnlock()
+	fake.setSafetyValveCellIdsMutex.RLock()
+	defer fake.setSafetyValveCellIdsMutex.RUnlock()
 	fake.setVersionMutex.RLock()
 	defer fake.setVersionMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
@@ -3331,6 +5007,8 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.unclaimActualLRPMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.upsertCellCordonMutex.RLock()
+	defer fake.upsertCellCordonMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
 	fake.versionMutex.RLock()
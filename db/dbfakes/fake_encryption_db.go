@@ -10,6 +10,16 @@ import (
 )
 
 type FakeEncryptionDB struct {
+	EncryptedTablesStub        func() []string
+	encryptedTablesMutex       sync.RWMutex
+	encryptedTablesArgsForCall []struct {
+	}
+	encryptedTablesReturns struct {
+		result1 []string
+	}
+	encryptedTablesReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	EncryptionKeyLabelStub        func(context.Context, lager.Logger) (string, error)
 	encryptionKeyLabelMutex       sync.RWMutex
 	encryptionKeyLabelArgsForCall []struct {
@@ -24,6 +34,21 @@ type FakeEncryptionDB struct {
 		result1 string
 		result2 error
 	}
+	EncryptionKeyUsageStub        func(context.Context, lager.Logger, string) (map[string]int, error)
+	encryptionKeyUsageMutex       sync.RWMutex
+	encryptionKeyUsageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	encryptionKeyUsageReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	encryptionKeyUsageReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
 	PerformEncryptionStub        func(context.Context, lager.Logger) error
 	performEncryptionMutex       sync.RWMutex
 	performEncryptionArgsForCall []struct {
@@ -36,6 +61,34 @@ type FakeEncryptionDB struct {
 	performEncryptionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReEncryptTableStub        func(context.Context, lager.Logger, string, db.EncryptionProgressFunc) error
+	reEncryptTableMutex       sync.RWMutex
+	reEncryptTableArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 db.EncryptionProgressFunc
+	}
+	reEncryptTableReturns struct {
+		result1 error
+	}
+	reEncryptTableReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RotatedEncryptionKeyStub        func(context.Context, lager.Logger) (*db.RotatedEncryptionKey, error)
+	rotatedEncryptionKeyMutex       sync.RWMutex
+	rotatedEncryptionKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	rotatedEncryptionKeyReturns struct {
+		result1 *db.RotatedEncryptionKey
+		result2 error
+	}
+	rotatedEncryptionKeyReturnsOnCall map[int]struct {
+		result1 *db.RotatedEncryptionKey
+		result2 error
+	}
 	SetEncryptionKeyLabelStub        func(context.Context, lager.Logger, string) error
 	setEncryptionKeyLabelMutex       sync.RWMutex
 	setEncryptionKeyLabelArgsForCall []struct {
@@ -49,10 +102,76 @@ type FakeEncryptionDB struct {
 	setEncryptionKeyLabelReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetRotatedEncryptionKeyStub        func(context.Context, lager.Logger, *db.RotatedEncryptionKey) error
+	setRotatedEncryptionKeyMutex       sync.RWMutex
+	setRotatedEncryptionKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *db.RotatedEncryptionKey
+	}
+	setRotatedEncryptionKeyReturns struct {
+		result1 error
+	}
+	setRotatedEncryptionKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeEncryptionDB) EncryptedTables() []string {
+	fake.encryptedTablesMutex.Lock()
+	ret, specificReturn := fake.encryptedTablesReturnsOnCall[len(fake.encryptedTablesArgsForCall)]
+	fake.encryptedTablesArgsForCall = append(fake.encryptedTablesArgsForCall, struct {
+	}{})
+	stub := fake.EncryptedTablesStub
+	fakeReturns := fake.encryptedTablesReturns
+	fake.recordInvocation("EncryptedTables", []interface{}{})
+	fake.encryptedTablesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEncryptionDB) EncryptedTablesCallCount() int {
+	fake.encryptedTablesMutex.RLock()
+	defer fake.encryptedTablesMutex.RUnlock()
+	return len(fake.encryptedTablesArgsForCall)
+}
+
+func (fake *FakeEncryptionDB) EncryptedTablesCalls(stub func() []string) {
+	fake.encryptedTablesMutex.Lock()
+	defer fake.encryptedTablesMutex.Unlock()
+	fake.EncryptedTablesStub = stub
+}
+
+func (fake *FakeEncryptionDB) EncryptedTablesReturns(result1 []string) {
+	fake.encryptedTablesMutex.Lock()
+	defer fake.encryptedTablesMutex.Unlock()
+	fake.EncryptedTablesStub = nil
+	fake.encryptedTablesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeEncryptionDB) EncryptedTablesReturnsOnCall(i int, result1 []string) {
+	fake.encryptedTablesMutex.Lock()
+	defer fake.encryptedTablesMutex.Unlock()
+	fake.EncryptedTablesStub = nil
+	if fake.encryptedTablesReturnsOnCall == nil {
+		fake.encryptedTablesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.encryptedTablesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeEncryptionDB) EncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger) (string, error) {
 	fake.encryptionKeyLabelMutex.Lock()
 	ret, specificReturn := fake.encryptionKeyLabelReturnsOnCall[len(fake.encryptionKeyLabelArgsForCall)]
@@ -118,6 +237,72 @@ func (fake *FakeEncryptionDB) EncryptionKeyLabelReturnsOnCall(i int, result1 str
 	}{result1, result2}
 }
 
+func (fake *FakeEncryptionDB) EncryptionKeyUsage(arg1 context.Context, arg2 lager.Logger, arg3 string) (map[string]int, error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	ret, specificReturn := fake.encryptionKeyUsageReturnsOnCall[len(fake.encryptionKeyUsageArgsForCall)]
+	fake.encryptionKeyUsageArgsForCall = append(fake.encryptionKeyUsageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.EncryptionKeyUsageStub
+	fakeReturns := fake.encryptionKeyUsageReturns
+	fake.recordInvocation("EncryptionKeyUsage", []interface{}{arg1, arg2, arg3})
+	fake.encryptionKeyUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEncryptionDB) EncryptionKeyUsageCallCount() int {
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
+	return len(fake.encryptionKeyUsageArgsForCall)
+}
+
+func (fake *FakeEncryptionDB) EncryptionKeyUsageCalls(stub func(context.Context, lager.Logger, string) (map[string]int, error)) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = stub
+}
+
+func (fake *FakeEncryptionDB) EncryptionKeyUsageArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
+	argsForCall := fake.encryptionKeyUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEncryptionDB) EncryptionKeyUsageReturns(result1 map[string]int, result2 error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = nil
+	fake.encryptionKeyUsageReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionDB) EncryptionKeyUsageReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = nil
+	if fake.encryptionKeyUsageReturnsOnCall == nil {
+		fake.encryptionKeyUsageReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.encryptionKeyUsageReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeEncryptionDB) PerformEncryption(arg1 context.Context, arg2 lager.Logger) error {
 	fake.performEncryptionMutex.Lock()
 	ret, specificReturn := fake.performEncryptionReturnsOnCall[len(fake.performEncryptionArgsForCall)]
@@ -180,6 +365,135 @@ func (fake *FakeEncryptionDB) PerformEncryptionReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+func (fake *FakeEncryptionDB) ReEncryptTable(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 db.EncryptionProgressFunc) error {
+	fake.reEncryptTableMutex.Lock()
+	ret, specificReturn := fake.reEncryptTableReturnsOnCall[len(fake.reEncryptTableArgsForCall)]
+	fake.reEncryptTableArgsForCall = append(fake.reEncryptTableArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 db.EncryptionProgressFunc
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ReEncryptTableStub
+	fakeReturns := fake.reEncryptTableReturns
+	fake.recordInvocation("ReEncryptTable", []interface{}{arg1, arg2, arg3, arg4})
+	fake.reEncryptTableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEncryptionDB) ReEncryptTableCallCount() int {
+	fake.reEncryptTableMutex.RLock()
+	defer fake.reEncryptTableMutex.RUnlock()
+	return len(fake.reEncryptTableArgsForCall)
+}
+
+func (fake *FakeEncryptionDB) ReEncryptTableCalls(stub func(context.Context, lager.Logger, string, db.EncryptionProgressFunc) error) {
+	fake.reEncryptTableMutex.Lock()
+	defer fake.reEncryptTableMutex.Unlock()
+	fake.ReEncryptTableStub = stub
+}
+
+func (fake *FakeEncryptionDB) ReEncryptTableArgsForCall(i int) (context.Context, lager.Logger, string, db.EncryptionProgressFunc) {
+	fake.reEncryptTableMutex.RLock()
+	defer fake.reEncryptTableMutex.RUnlock()
+	argsForCall := fake.reEncryptTableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEncryptionDB) ReEncryptTableReturns(result1 error) {
+	fake.reEncryptTableMutex.Lock()
+	defer fake.reEncryptTableMutex.Unlock()
+	fake.ReEncryptTableStub = nil
+	fake.reEncryptTableReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEncryptionDB) ReEncryptTableReturnsOnCall(i int, result1 error) {
+	fake.reEncryptTableMutex.Lock()
+	defer fake.reEncryptTableMutex.Unlock()
+	fake.ReEncryptTableStub = nil
+	if fake.reEncryptTableReturnsOnCall == nil {
+		fake.reEncryptTableReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reEncryptTableReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEncryptionDB) RotatedEncryptionKey(arg1 context.Context, arg2 lager.Logger) (*db.RotatedEncryptionKey, error) {
+	fake.rotatedEncryptionKeyMutex.Lock()
+	ret, specificReturn := fake.rotatedEncryptionKeyReturnsOnCall[len(fake.rotatedEncryptionKeyArgsForCall)]
+	fake.rotatedEncryptionKeyArgsForCall = append(fake.rotatedEncryptionKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.RotatedEncryptionKeyStub
+	fakeReturns := fake.rotatedEncryptionKeyReturns
+	fake.recordInvocation("RotatedEncryptionKey", []interface{}{arg1, arg2})
+	fake.rotatedEncryptionKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEncryptionDB) RotatedEncryptionKeyCallCount() int {
+	fake.rotatedEncryptionKeyMutex.RLock()
+	defer fake.rotatedEncryptionKeyMutex.RUnlock()
+	return len(fake.rotatedEncryptionKeyArgsForCall)
+}
+
+func (fake *FakeEncryptionDB) RotatedEncryptionKeyCalls(stub func(context.Context, lager.Logger) (*db.RotatedEncryptionKey, error)) {
+	fake.rotatedEncryptionKeyMutex.Lock()
+	defer fake.rotatedEncryptionKeyMutex.Unlock()
+	fake.RotatedEncryptionKeyStub = stub
+}
+
+func (fake *FakeEncryptionDB) RotatedEncryptionKeyArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.rotatedEncryptionKeyMutex.RLock()
+	defer fake.rotatedEncryptionKeyMutex.RUnlock()
+	argsForCall := fake.rotatedEncryptionKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEncryptionDB) RotatedEncryptionKeyReturns(result1 *db.RotatedEncryptionKey, result2 error) {
+	fake.rotatedEncryptionKeyMutex.Lock()
+	defer fake.rotatedEncryptionKeyMutex.Unlock()
+	fake.RotatedEncryptionKeyStub = nil
+	fake.rotatedEncryptionKeyReturns = struct {
+		result1 *db.RotatedEncryptionKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionDB) RotatedEncryptionKeyReturnsOnCall(i int, result1 *db.RotatedEncryptionKey, result2 error) {
+	fake.rotatedEncryptionKeyMutex.Lock()
+	defer fake.rotatedEncryptionKeyMutex.Unlock()
+	fake.RotatedEncryptionKeyStub = nil
+	if fake.rotatedEncryptionKeyReturnsOnCall == nil {
+		fake.rotatedEncryptionKeyReturnsOnCall = make(map[int]struct {
+			result1 *db.RotatedEncryptionKey
+			result2 error
+		})
+	}
+	fake.rotatedEncryptionKeyReturnsOnCall[i] = struct {
+		result1 *db.RotatedEncryptionKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeEncryptionDB) SetEncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
 	fake.setEncryptionKeyLabelMutex.Lock()
 	ret, specificReturn := fake.setEncryptionKeyLabelReturnsOnCall[len(fake.setEncryptionKeyLabelArgsForCall)]
@@ -243,15 +557,88 @@ func (fake *FakeEncryptionDB) SetEncryptionKeyLabelReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeEncryptionDB) SetRotatedEncryptionKey(arg1 context.Context, arg2 lager.Logger, arg3 *db.RotatedEncryptionKey) error {
+	fake.setRotatedEncryptionKeyMutex.Lock()
+	ret, specificReturn := fake.setRotatedEncryptionKeyReturnsOnCall[len(fake.setRotatedEncryptionKeyArgsForCall)]
+	fake.setRotatedEncryptionKeyArgsForCall = append(fake.setRotatedEncryptionKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *db.RotatedEncryptionKey
+	}{arg1, arg2, arg3})
+	stub := fake.SetRotatedEncryptionKeyStub
+	fakeReturns := fake.setRotatedEncryptionKeyReturns
+	fake.recordInvocation("SetRotatedEncryptionKey", []interface{}{arg1, arg2, arg3})
+	fake.setRotatedEncryptionKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEncryptionDB) SetRotatedEncryptionKeyCallCount() int {
+	fake.setRotatedEncryptionKeyMutex.RLock()
+	defer fake.setRotatedEncryptionKeyMutex.RUnlock()
+	return len(fake.setRotatedEncryptionKeyArgsForCall)
+}
+
+func (fake *FakeEncryptionDB) SetRotatedEncryptionKeyCalls(stub func(context.Context, lager.Logger, *db.RotatedEncryptionKey) error) {
+	fake.setRotatedEncryptionKeyMutex.Lock()
+	defer fake.setRotatedEncryptionKeyMutex.Unlock()
+	fake.SetRotatedEncryptionKeyStub = stub
+}
+
+func (fake *FakeEncryptionDB) SetRotatedEncryptionKeyArgsForCall(i int) (context.Context, lager.Logger, *db.RotatedEncryptionKey) {
+	fake.setRotatedEncryptionKeyMutex.RLock()
+	defer fake.setRotatedEncryptionKeyMutex.RUnlock()
+	argsForCall := fake.setRotatedEncryptionKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEncryptionDB) SetRotatedEncryptionKeyReturns(result1 error) {
+	fake.setRotatedEncryptionKeyMutex.Lock()
+	defer fake.setRotatedEncryptionKeyMutex.Unlock()
+	fake.SetRotatedEncryptionKeyStub = nil
+	fake.setRotatedEncryptionKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEncryptionDB) SetRotatedEncryptionKeyReturnsOnCall(i int, result1 error) {
+	fake.setRotatedEncryptionKeyMutex.Lock()
+	defer fake.setRotatedEncryptionKeyMutex.Unlock()
+	fake.SetRotatedEncryptionKeyStub = nil
+	if fake.setRotatedEncryptionKeyReturnsOnCall == nil {
+		fake.setRotatedEncryptionKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRotatedEncryptionKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeEncryptionDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.encryptedTablesMutex.RLock()
+	defer fake.encryptedTablesMutex.RUnlock()
 	fake.encryptionKeyLabelMutex.RLock()
 	defer fake.encryptionKeyLabelMutex.RUnlock()
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
 	fake.performEncryptionMutex.RLock()
 	defer fake.performEncryptionMutex.RUnlock()
+	fake.reEncryptTableMutex.RLock()
+	defer fake.reEncryptTableMutex.RUnlock()
+	fake.rotatedEncryptionKeyMutex.RLock()
+	defer fake.rotatedEncryptionKeyMutex.RUnlock()
 	fake.setEncryptionKeyLabelMutex.RLock()
 	defer fake.setEncryptionKeyLabelMutex.RUnlock()
+	fake.setRotatedEncryptionKeyMutex.RLock()
+	defer fake.setRotatedEncryptionKeyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -77,6 +77,20 @@ type FakeLRPDB struct {
 	convergeLRPsReturnsOnCall map[int]struct {
 		result1 db.ConvergenceResult
 	}
+	ConvergeLRPsInScopeStub        func(context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) db.ConvergenceResult
+	convergeLRPsInScopeMutex       sync.RWMutex
+	convergeLRPsInScopeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 db.LRPConvergenceScope
+	}
+	convergeLRPsInScopeReturns struct {
+		result1 db.ConvergenceResult
+	}
+	convergeLRPsInScopeReturnsOnCall map[int]struct {
+		result1 db.ConvergenceResult
+	}
 	CountActualLRPsByStateStub        func(context.Context, lager.Logger) (int, int, int, int, int)
 	countActualLRPsByStateMutex       sync.RWMutex
 	countActualLRPsByStateArgsForCall []struct {
@@ -221,6 +235,33 @@ type FakeLRPDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	PlanLRPConvergenceStub        func(context.Context, lager.Logger, models.CellSet) db.LRPConvergencePlan
+	planLRPConvergenceMutex       sync.RWMutex
+	planLRPConvergenceArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+	}
+	planLRPConvergenceReturns struct {
+		result1 db.LRPConvergencePlan
+	}
+	planLRPConvergenceReturnsOnCall map[int]struct {
+		result1 db.LRPConvergencePlan
+	}
+	PlanLRPConvergenceInScopeStub        func(context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) db.LRPConvergencePlan
+	planLRPConvergenceInScopeMutex       sync.RWMutex
+	planLRPConvergenceInScopeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 db.LRPConvergenceScope
+	}
+	planLRPConvergenceInScopeReturns struct {
+		result1 db.LRPConvergencePlan
+	}
+	planLRPConvergenceInScopeReturnsOnCall map[int]struct {
+		result1 db.LRPConvergencePlan
+	}
 	RemoveActualLRPStub        func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error
 	removeActualLRPMutex       sync.RWMutex
 	removeActualLRPArgsForCall []struct {
@@ -576,6 +617,70 @@ func (fake *FakeLRPDB) ConvergeLRPsReturnsOnCall(i int, result1 db.ConvergenceRe
 	}{result1}
 }
 
+func (fake *FakeLRPDB) ConvergeLRPsInScope(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 db.LRPConvergenceScope) db.ConvergenceResult {
+	fake.convergeLRPsInScopeMutex.Lock()
+	ret, specificReturn := fake.convergeLRPsInScopeReturnsOnCall[len(fake.convergeLRPsInScopeArgsForCall)]
+	fake.convergeLRPsInScopeArgsForCall = append(fake.convergeLRPsInScopeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 db.LRPConvergenceScope
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ConvergeLRPsInScopeStub
+	fakeReturns := fake.convergeLRPsInScopeReturns
+	fake.recordInvocation("ConvergeLRPsInScope", []interface{}{arg1, arg2, arg3, arg4})
+	fake.convergeLRPsInScopeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLRPDB) ConvergeLRPsInScopeCallCount() int {
+	fake.convergeLRPsInScopeMutex.RLock()
+	defer fake.convergeLRPsInScopeMutex.RUnlock()
+	return len(fake.convergeLRPsInScopeArgsForCall)
+}
+
+func (fake *FakeLRPDB) ConvergeLRPsInScopeCalls(stub func(context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) db.ConvergenceResult) {
+	fake.convergeLRPsInScopeMutex.Lock()
+	defer fake.convergeLRPsInScopeMutex.Unlock()
+	fake.ConvergeLRPsInScopeStub = stub
+}
+
+func (fake *FakeLRPDB) ConvergeLRPsInScopeArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) {
+	fake.convergeLRPsInScopeMutex.RLock()
+	defer fake.convergeLRPsInScopeMutex.RUnlock()
+	argsForCall := fake.convergeLRPsInScopeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLRPDB) ConvergeLRPsInScopeReturns(result1 db.ConvergenceResult) {
+	fake.convergeLRPsInScopeMutex.Lock()
+	defer fake.convergeLRPsInScopeMutex.Unlock()
+	fake.ConvergeLRPsInScopeStub = nil
+	fake.convergeLRPsInScopeReturns = struct {
+		result1 db.ConvergenceResult
+	}{result1}
+}
+
+func (fake *FakeLRPDB) ConvergeLRPsInScopeReturnsOnCall(i int, result1 db.ConvergenceResult) {
+	fake.convergeLRPsInScopeMutex.Lock()
+	defer fake.convergeLRPsInScopeMutex.Unlock()
+	fake.ConvergeLRPsInScopeStub = nil
+	if fake.convergeLRPsInScopeReturnsOnCall == nil {
+		fake.convergeLRPsInScopeReturnsOnCall = make(map[int]struct {
+			result1 db.ConvergenceResult
+		})
+	}
+	fake.convergeLRPsInScopeReturnsOnCall[i] = struct {
+		result1 db.ConvergenceResult
+	}{result1}
+}
+
 func (fake *FakeLRPDB) CountActualLRPsByState(arg1 context.Context, arg2 lager.Logger) (int, int, int, int, int) {
 	fake.countActualLRPsByStateMutex.Lock()
 	ret, specificReturn := fake.countActualLRPsByStateReturnsOnCall[len(fake.countActualLRPsByStateArgsForCall)]
@@ -1183,6 +1288,133 @@ func (fake *FakeLRPDB) FailActualLRPReturnsOnCall(i int, result1 *models.ActualL
 	}{result1, result2, result3}
 }
 
+func (fake *FakeLRPDB) PlanLRPConvergence(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet) db.LRPConvergencePlan {
+	fake.planLRPConvergenceMutex.Lock()
+	ret, specificReturn := fake.planLRPConvergenceReturnsOnCall[len(fake.planLRPConvergenceArgsForCall)]
+	fake.planLRPConvergenceArgsForCall = append(fake.planLRPConvergenceArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+	}{arg1, arg2, arg3})
+	stub := fake.PlanLRPConvergenceStub
+	fakeReturns := fake.planLRPConvergenceReturns
+	fake.recordInvocation("PlanLRPConvergence", []interface{}{arg1, arg2, arg3})
+	fake.planLRPConvergenceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceCallCount() int {
+	fake.planLRPConvergenceMutex.RLock()
+	defer fake.planLRPConvergenceMutex.RUnlock()
+	return len(fake.planLRPConvergenceArgsForCall)
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceCalls(stub func(context.Context, lager.Logger, models.CellSet) db.LRPConvergencePlan) {
+	fake.planLRPConvergenceMutex.Lock()
+	defer fake.planLRPConvergenceMutex.Unlock()
+	fake.PlanLRPConvergenceStub = stub
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceArgsForCall(i int) (context.Context, lager.Logger, models.CellSet) {
+	fake.planLRPConvergenceMutex.RLock()
+	defer fake.planLRPConvergenceMutex.RUnlock()
+	argsForCall := fake.planLRPConvergenceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceReturns(result1 db.LRPConvergencePlan) {
+	fake.planLRPConvergenceMutex.Lock()
+	defer fake.planLRPConvergenceMutex.Unlock()
+	fake.PlanLRPConvergenceStub = nil
+	fake.planLRPConvergenceReturns = struct {
+		result1 db.LRPConvergencePlan
+	}{result1}
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceReturnsOnCall(i int, result1 db.LRPConvergencePlan) {
+	fake.planLRPConvergenceMutex.Lock()
+	defer fake.planLRPConvergenceMutex.Unlock()
+	fake.PlanLRPConvergenceStub = nil
+	if fake.planLRPConvergenceReturnsOnCall == nil {
+		fake.planLRPConvergenceReturnsOnCall = make(map[int]struct {
+			result1 db.LRPConvergencePlan
+		})
+	}
+	fake.planLRPConvergenceReturnsOnCall[i] = struct {
+		result1 db.LRPConvergencePlan
+	}{result1}
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceInScope(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 db.LRPConvergenceScope) db.LRPConvergencePlan {
+	fake.planLRPConvergenceInScopeMutex.Lock()
+	ret, specificReturn := fake.planLRPConvergenceInScopeReturnsOnCall[len(fake.planLRPConvergenceInScopeArgsForCall)]
+	fake.planLRPConvergenceInScopeArgsForCall = append(fake.planLRPConvergenceInScopeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 db.LRPConvergenceScope
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PlanLRPConvergenceInScopeStub
+	fakeReturns := fake.planLRPConvergenceInScopeReturns
+	fake.recordInvocation("PlanLRPConvergenceInScope", []interface{}{arg1, arg2, arg3, arg4})
+	fake.planLRPConvergenceInScopeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceInScopeCallCount() int {
+	fake.planLRPConvergenceInScopeMutex.RLock()
+	defer fake.planLRPConvergenceInScopeMutex.RUnlock()
+	return len(fake.planLRPConvergenceInScopeArgsForCall)
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceInScopeCalls(stub func(context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) db.LRPConvergencePlan) {
+	fake.planLRPConvergenceInScopeMutex.Lock()
+	defer fake.planLRPConvergenceInScopeMutex.Unlock()
+	fake.PlanLRPConvergenceInScopeStub = stub
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceInScopeArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, db.LRPConvergenceScope) {
+	fake.planLRPConvergenceInScopeMutex.RLock()
+	defer fake.planLRPConvergenceInScopeMutex.RUnlock()
+	argsForCall := fake.planLRPConvergenceInScopeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceInScopeReturns(result1 db.LRPConvergencePlan) {
+	fake.planLRPConvergenceInScopeMutex.Lock()
+	defer fake.planLRPConvergenceInScopeMutex.Unlock()
+	fake.PlanLRPConvergenceInScopeStub = nil
+	fake.planLRPConvergenceInScopeReturns = struct {
+		result1 db.LRPConvergencePlan
+	}{result1}
+}
+
+func (fake *FakeLRPDB) PlanLRPConvergenceInScopeReturnsOnCall(i int, result1 db.LRPConvergencePlan) {
+	fake.planLRPConvergenceInScopeMutex.Lock()
+	defer fake.planLRPConvergenceInScopeMutex.Unlock()
+	fake.PlanLRPConvergenceInScopeStub = nil
+	if fake.planLRPConvergenceInScopeReturnsOnCall == nil {
+		fake.planLRPConvergenceInScopeReturnsOnCall = make(map[int]struct {
+			result1 db.LRPConvergencePlan
+		})
+	}
+	fake.planLRPConvergenceInScopeReturnsOnCall[i] = struct {
+		result1 db.LRPConvergencePlan
+	}{result1}
+}
+
 func (fake *FakeLRPDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
 	fake.removeActualLRPMutex.Lock()
 	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
@@ -1529,6 +1761,8 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
+	fake.convergeLRPsInScopeMutex.RLock()
+	defer fake.convergeLRPsInScopeMutex.RUnlock()
 	fake.countActualLRPsByStateMutex.RLock()
 	defer fake.countActualLRPsByStateMutex.RUnlock()
 	fake.countDesiredInstancesMutex.RLock()
@@ -1547,6 +1781,10 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPsMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
+	fake.planLRPConvergenceMutex.RLock()
+	defer fake.planLRPConvergenceMutex.RUnlock()
+	fake.planLRPConvergenceInScopeMutex.RLock()
+	defer fake.planLRPConvergenceInScopeMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
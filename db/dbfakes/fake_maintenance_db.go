@@ -0,0 +1,199 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeMaintenanceDB struct {
+	MaintenanceModeStub        func(context.Context, lager.Logger) (*models.MaintenanceMode, error)
+	maintenanceModeMutex       sync.RWMutex
+	maintenanceModeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	maintenanceModeReturns struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	maintenanceModeReturnsOnCall map[int]struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	SetMaintenanceModeStub        func(context.Context, lager.Logger, *models.MaintenanceMode) error
+	setMaintenanceModeMutex       sync.RWMutex
+	setMaintenanceModeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.MaintenanceMode
+	}
+	setMaintenanceModeReturns struct {
+		result1 error
+	}
+	setMaintenanceModeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMaintenanceDB) MaintenanceMode(arg1 context.Context, arg2 lager.Logger) (*models.MaintenanceMode, error) {
+	fake.maintenanceModeMutex.Lock()
+	ret, specificReturn := fake.maintenanceModeReturnsOnCall[len(fake.maintenanceModeArgsForCall)]
+	fake.maintenanceModeArgsForCall = append(fake.maintenanceModeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.MaintenanceModeStub
+	fakeReturns := fake.maintenanceModeReturns
+	fake.recordInvocation("MaintenanceMode", []interface{}{arg1, arg2})
+	fake.maintenanceModeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeMaintenanceDB) MaintenanceModeCallCount() int {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	return len(fake.maintenanceModeArgsForCall)
+}
+
+func (fake *FakeMaintenanceDB) MaintenanceModeCalls(stub func(context.Context, lager.Logger) (*models.MaintenanceMode, error)) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = stub
+}
+
+func (fake *FakeMaintenanceDB) MaintenanceModeArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	argsForCall := fake.maintenanceModeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeMaintenanceDB) MaintenanceModeReturns(result1 *models.MaintenanceMode, result2 error) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = nil
+	fake.maintenanceModeReturns = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMaintenanceDB) MaintenanceModeReturnsOnCall(i int, result1 *models.MaintenanceMode, result2 error) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = nil
+	if fake.maintenanceModeReturnsOnCall == nil {
+		fake.maintenanceModeReturnsOnCall = make(map[int]struct {
+			result1 *models.MaintenanceMode
+			result2 error
+		})
+	}
+	fake.maintenanceModeReturnsOnCall[i] = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMaintenanceDB) SetMaintenanceMode(arg1 context.Context, arg2 lager.Logger, arg3 *models.MaintenanceMode) error {
+	fake.setMaintenanceModeMutex.Lock()
+	ret, specificReturn := fake.setMaintenanceModeReturnsOnCall[len(fake.setMaintenanceModeArgsForCall)]
+	fake.setMaintenanceModeArgsForCall = append(fake.setMaintenanceModeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.MaintenanceMode
+	}{arg1, arg2, arg3})
+	stub := fake.SetMaintenanceModeStub
+	fakeReturns := fake.setMaintenanceModeReturns
+	fake.recordInvocation("SetMaintenanceMode", []interface{}{arg1, arg2, arg3})
+	fake.setMaintenanceModeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeMaintenanceDB) SetMaintenanceModeCallCount() int {
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	return len(fake.setMaintenanceModeArgsForCall)
+}
+
+func (fake *FakeMaintenanceDB) SetMaintenanceModeCalls(stub func(context.Context, lager.Logger, *models.MaintenanceMode) error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = stub
+}
+
+func (fake *FakeMaintenanceDB) SetMaintenanceModeArgsForCall(i int) (context.Context, lager.Logger, *models.MaintenanceMode) {
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	argsForCall := fake.setMaintenanceModeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeMaintenanceDB) SetMaintenanceModeReturns(result1 error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = nil
+	fake.setMaintenanceModeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeMaintenanceDB) SetMaintenanceModeReturnsOnCall(i int, result1 error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = nil
+	if fake.setMaintenanceModeReturnsOnCall == nil {
+		fake.setMaintenanceModeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setMaintenanceModeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeMaintenanceDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMaintenanceDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.MaintenanceDB = new(FakeMaintenanceDB)
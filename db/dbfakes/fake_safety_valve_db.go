@@ -0,0 +1,207 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeSafetyValveDB struct {
+	SafetyValveCellIdsStub        func(context.Context, lager.Logger, string) ([]string, error)
+	safetyValveCellIdsMutex       sync.RWMutex
+	safetyValveCellIdsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	safetyValveCellIdsReturns struct {
+		result1 []string
+		result2 error
+	}
+	safetyValveCellIdsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	SetSafetyValveCellIdsStub        func(context.Context, lager.Logger, string, []string) error
+	setSafetyValveCellIdsMutex       sync.RWMutex
+	setSafetyValveCellIdsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []string
+	}
+	setSafetyValveCellIdsReturns struct {
+		result1 error
+	}
+	setSafetyValveCellIdsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSafetyValveDB) SafetyValveCellIds(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]string, error) {
+	fake.safetyValveCellIdsMutex.Lock()
+	ret, specificReturn := fake.safetyValveCellIdsReturnsOnCall[len(fake.safetyValveCellIdsArgsForCall)]
+	fake.safetyValveCellIdsArgsForCall = append(fake.safetyValveCellIdsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SafetyValveCellIdsStub
+	fakeReturns := fake.safetyValveCellIdsReturns
+	fake.recordInvocation("SafetyValveCellIds", []interface{}{arg1, arg2, arg3})
+	fake.safetyValveCellIdsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSafetyValveDB) SafetyValveCellIdsCallCount() int {
+	fake.safetyValveCellIdsMutex.RLock()
+	defer fake.safetyValveCellIdsMutex.RUnlock()
+	return len(fake.safetyValveCellIdsArgsForCall)
+}
+
+func (fake *FakeSafetyValveDB) SafetyValveCellIdsCalls(stub func(context.Context, lager.Logger, string) ([]string, error)) {
+	fake.safetyValveCellIdsMutex.Lock()
+	defer fake.safetyValveCellIdsMutex.Unlock()
+	fake.SafetyValveCellIdsStub = stub
+}
+
+func (fake *FakeSafetyValveDB) SafetyValveCellIdsArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.safetyValveCellIdsMutex.RLock()
+	defer fake.safetyValveCellIdsMutex.RUnlock()
+	argsForCall := fake.safetyValveCellIdsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSafetyValveDB) SafetyValveCellIdsReturns(result1 []string, result2 error) {
+	fake.safetyValveCellIdsMutex.Lock()
+	defer fake.safetyValveCellIdsMutex.Unlock()
+	fake.SafetyValveCellIdsStub = nil
+	fake.safetyValveCellIdsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSafetyValveDB) SafetyValveCellIdsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.safetyValveCellIdsMutex.Lock()
+	defer fake.safetyValveCellIdsMutex.Unlock()
+	fake.SafetyValveCellIdsStub = nil
+	if fake.safetyValveCellIdsReturnsOnCall == nil {
+		fake.safetyValveCellIdsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.safetyValveCellIdsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSafetyValveDB) SetSafetyValveCellIds(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 []string) error {
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.setSafetyValveCellIdsMutex.Lock()
+	ret, specificReturn := fake.setSafetyValveCellIdsReturnsOnCall[len(fake.setSafetyValveCellIdsArgsForCall)]
+	fake.setSafetyValveCellIdsArgsForCall = append(fake.setSafetyValveCellIdsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []string
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.SetSafetyValveCellIdsStub
+	fakeReturns := fake.setSafetyValveCellIdsReturns
+	fake.recordInvocation("SetSafetyValveCellIds", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.setSafetyValveCellIdsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSafetyValveDB) SetSafetyValveCellIdsCallCount() int {
+	fake.setSafetyValveCellIdsMutex.RLock()
+	defer fake.setSafetyValveCellIdsMutex.RUnlock()
+	return len(fake.setSafetyValveCellIdsArgsForCall)
+}
+
+func (fake *FakeSafetyValveDB) SetSafetyValveCellIdsCalls(stub func(context.Context, lager.Logger, string, []string) error) {
+	fake.setSafetyValveCellIdsMutex.Lock()
+	defer fake.setSafetyValveCellIdsMutex.Unlock()
+	fake.SetSafetyValveCellIdsStub = stub
+}
+
+func (fake *FakeSafetyValveDB) SetSafetyValveCellIdsArgsForCall(i int) (context.Context, lager.Logger, string, []string) {
+	fake.setSafetyValveCellIdsMutex.RLock()
+	defer fake.setSafetyValveCellIdsMutex.RUnlock()
+	argsForCall := fake.setSafetyValveCellIdsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSafetyValveDB) SetSafetyValveCellIdsReturns(result1 error) {
+	fake.setSafetyValveCellIdsMutex.Lock()
+	defer fake.setSafetyValveCellIdsMutex.Unlock()
+	fake.SetSafetyValveCellIdsStub = nil
+	fake.setSafetyValveCellIdsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSafetyValveDB) SetSafetyValveCellIdsReturnsOnCall(i int, result1 error) {
+	fake.setSafetyValveCellIdsMutex.Lock()
+	defer fake.setSafetyValveCellIdsMutex.Unlock()
+	fake.SetSafetyValveCellIdsStub = nil
+	if fake.setSafetyValveCellIdsReturnsOnCall == nil {
+		fake.setSafetyValveCellIdsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setSafetyValveCellIdsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSafetyValveDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.safetyValveCellIdsMutex.RLock()
+	defer fake.safetyValveCellIdsMutex.RUnlock()
+	fake.setSafetyValveCellIdsMutex.RLock()
+	defer fake.setSafetyValveCellIdsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSafetyValveDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.SafetyValveDB = new(FakeSafetyValveDB)
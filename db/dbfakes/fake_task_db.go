@@ -118,6 +118,22 @@ type FakeTaskDB struct {
 		result2 *models.Task
 		result3 error
 	}
+	PlanTaskConvergenceStub        func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergencePlan
+	planTaskConvergenceMutex       sync.RWMutex
+	planTaskConvergenceArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 time.Duration
+		arg5 time.Duration
+		arg6 time.Duration
+	}
+	planTaskConvergenceReturns struct {
+		result1 db.TaskConvergencePlan
+	}
+	planTaskConvergenceReturnsOnCall map[int]struct {
+		result1 db.TaskConvergencePlan
+	}
 	RejectTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)
 	rejectTaskMutex       sync.RWMutex
 	rejectTaskArgsForCall []struct {
@@ -622,6 +638,72 @@ func (fake *FakeTaskDB) FailTaskReturnsOnCall(i int, result1 *models.Task, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTaskDB) PlanTaskConvergence(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet, arg4 time.Duration, arg5 time.Duration, arg6 time.Duration) db.TaskConvergencePlan {
+	fake.planTaskConvergenceMutex.Lock()
+	ret, specificReturn := fake.planTaskConvergenceReturnsOnCall[len(fake.planTaskConvergenceArgsForCall)]
+	fake.planTaskConvergenceArgsForCall = append(fake.planTaskConvergenceArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+		arg4 time.Duration
+		arg5 time.Duration
+		arg6 time.Duration
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.PlanTaskConvergenceStub
+	fakeReturns := fake.planTaskConvergenceReturns
+	fake.recordInvocation("PlanTaskConvergence", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.planTaskConvergenceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDB) PlanTaskConvergenceCallCount() int {
+	fake.planTaskConvergenceMutex.RLock()
+	defer fake.planTaskConvergenceMutex.RUnlock()
+	return len(fake.planTaskConvergenceArgsForCall)
+}
+
+func (fake *FakeTaskDB) PlanTaskConvergenceCalls(stub func(context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) db.TaskConvergencePlan) {
+	fake.planTaskConvergenceMutex.Lock()
+	defer fake.planTaskConvergenceMutex.Unlock()
+	fake.PlanTaskConvergenceStub = stub
+}
+
+func (fake *FakeTaskDB) PlanTaskConvergenceArgsForCall(i int) (context.Context, lager.Logger, models.CellSet, time.Duration, time.Duration, time.Duration) {
+	fake.planTaskConvergenceMutex.RLock()
+	defer fake.planTaskConvergenceMutex.RUnlock()
+	argsForCall := fake.planTaskConvergenceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeTaskDB) PlanTaskConvergenceReturns(result1 db.TaskConvergencePlan) {
+	fake.planTaskConvergenceMutex.Lock()
+	defer fake.planTaskConvergenceMutex.Unlock()
+	fake.PlanTaskConvergenceStub = nil
+	fake.planTaskConvergenceReturns = struct {
+		result1 db.TaskConvergencePlan
+	}{result1}
+}
+
+func (fake *FakeTaskDB) PlanTaskConvergenceReturnsOnCall(i int, result1 db.TaskConvergencePlan) {
+	fake.planTaskConvergenceMutex.Lock()
+	defer fake.planTaskConvergenceMutex.Unlock()
+	fake.PlanTaskConvergenceStub = nil
+	if fake.planTaskConvergenceReturnsOnCall == nil {
+		fake.planTaskConvergenceReturnsOnCall = make(map[int]struct {
+			result1 db.TaskConvergencePlan
+		})
+	}
+	fake.planTaskConvergenceReturnsOnCall[i] = struct {
+		result1 db.TaskConvergencePlan
+	}{result1}
+}
+
 func (fake *FakeTaskDB) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
 	fake.rejectTaskMutex.Lock()
 	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
@@ -981,6 +1063,8 @@ func (fake *FakeTaskDB) Invocations() map[string][][]interface{} {
 	defer fake.desireTaskMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.planTaskConvergenceMutex.RLock()
+	defer fake.planTaskConvergenceMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
 	defer fake.rejectTaskMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
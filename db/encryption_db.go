@@ -6,10 +6,27 @@ import (
 	"code.cloudfoundry.org/lager"
 )
 
+// EncryptionProgressFunc is called while a table is being re-encrypted with the
+// number of rows processed so far and the number of rows in the table.
+type EncryptionProgressFunc func(processed, total int)
+
+// RotatedEncryptionKey records that the active encryption key was rotated
+// through the API, and which active key label was configured at the time.
+type RotatedEncryptionKey struct {
+	Label           string `json:"label"`
+	ConfiguredLabel string `json:"configured_label"`
+}
+
 //go:generate counterfeiter . EncryptionDB
 
 type EncryptionDB interface {
 	EncryptionKeyLabel(ctx context.Context, logger lager.Logger) (string, error)
 	SetEncryptionKeyLabel(ctx context.Context, logger lager.Logger, encryptionKeyLabel string) error
+	RotatedEncryptionKey(ctx context.Context, logger lager.Logger) (*RotatedEncryptionKey, error)
+	SetRotatedEncryptionKey(ctx context.Context, logger lager.Logger, rotated *RotatedEncryptionKey) error
 	PerformEncryption(ctx context.Context, logger lager.Logger) error
+
+	EncryptedTables() []string
+	ReEncryptTable(ctx context.Context, logger lager.Logger, table string, progress EncryptionProgressFunc) error
+	EncryptionKeyUsage(ctx context.Context, logger lager.Logger, encryptionKeyLabel string) (map[string]int, error)
 }
@@ -2,6 +2,8 @@ package db
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/binary"
 
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
@@ -18,14 +20,72 @@ type ConvergenceResult struct {
 	SuspectClaimedKeys           []*models.ActualLRPKey
 	KeysToRetire                 []*models.ActualLRPKey
 	KeysWithMissingCells         []*models.ActualLRPKeyWithSchedulingInfo
+	KeysOnDrainingCells          []*models.ActualLRPKeyWithSchedulingInfo
+	KeysDelayedByBudget          []*models.ActualLRPKey
 	MissingCellIds               []string
 	Events                       []models.Event
 	InstanceEvents               []models.Event
 }
 
+// LRPConvergencePlan holds what ConvergeLRPs would do. Its Events are empty,
+// the evacuating LRPs it would remove are listed instead.
+type LRPConvergencePlan struct {
+	ConvergenceResult
+	ExpiredDomains         []string
+	EvacuatingKeysToRemove []*models.ActualLRPKey
+}
+
+// LRPConvergenceScope limits a convergence pass to some process guids: the
+// ProcessGuids, and those in shard Shard of ShardCount. A scope without
+// ProcessGuids and with a ShardCount of 0 or 1 covers every process guid.
+//
+// Crashed and stale unclaimed LRPs wait on time rather than on a change, and
+// LRPs on draining cells wait on the cell cordons, so they are checked
+// whatever the scope. LRPs on missing cells, and suspect LRPs whose cell is
+// back, wait on the cell set instead and are checked outside of the scope when
+// AllCells is set.
+type LRPConvergenceScope struct {
+	ProcessGuids []string
+	Shard        int
+	ShardCount   int
+	AllCells     bool
+}
+
+func (s LRPConvergenceScope) Full() bool {
+	return s.ShardCount <= 1 && len(s.ProcessGuids) == 0
+}
+
+// ProcessGuidHashes is the number of process guid hashes. A shard of the
+// process guids is a range of them.
+const ProcessGuidHashes = 1 << 28
+
+// ProcessGuidHash returns the first 28 bits of the MD5 sum of the process
+// guid. It is stored with the LRPs so that a shard selects an indexed range.
+func ProcessGuidHash(processGuid string) int {
+	sum := md5.Sum([]byte(processGuid))
+	return int(binary.BigEndian.Uint32(sum[:4]) >> 4)
+}
+
+// ProcessGuidHashRange returns the range [start, end) of the hashes in the
+// shard.
+func ProcessGuidHashRange(shard, shardCount int) (start, end int) {
+	start = (shard*ProcessGuidHashes + shardCount - 1) / shardCount
+	end = ((shard+1)*ProcessGuidHashes + shardCount - 1) / shardCount
+	return start, end
+}
+
+// ProcessGuidShard returns the shard of the process guid, the one whose hash
+// range holds its hash.
+func ProcessGuidShard(processGuid string, shardCount int) int {
+	return ProcessGuidHash(processGuid) * shardCount / ProcessGuidHashes
+}
+
 type LRPDB interface {
 	ActualLRPDB
 	DesiredLRPDB
 
 	ConvergeLRPs(ctx context.Context, logger lager.Logger, cellSet models.CellSet) ConvergenceResult
+	ConvergeLRPsInScope(ctx context.Context, logger lager.Logger, cellSet models.CellSet, scope LRPConvergenceScope) ConvergenceResult
+	PlanLRPConvergence(ctx context.Context, logger lager.Logger, cellSet models.CellSet) LRPConvergencePlan
+	PlanLRPConvergenceInScope(ctx context.Context, logger lager.Logger, cellSet models.CellSet, scope LRPConvergenceScope) LRPConvergencePlan
 }
@@ -0,0 +1,15 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . MaintenanceDB
+
+type MaintenanceDB interface {
+	MaintenanceMode(ctx context.Context, logger lager.Logger) (*models.MaintenanceMode, error)
+	SetMaintenanceMode(ctx context.Context, logger lager.Logger, mode *models.MaintenanceMode) error
+}
@@ -65,14 +65,42 @@ func (e *InitSQL) Up(logger lager.Logger) error {
 	return nil
 }
 
-func dropTables(db *sql.DB) error {
-	tableNames := []string{
-		"domains",
-		"tasks",
-		"desired_lrps",
-		"actual_lrps",
+func (e *InitSQL) Down(logger lager.Logger) error {
+	logger = logger.Session("init-sql")
+	logger.Info("dropping-tables")
+
+	return execStatements(logger, e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *InitSQL) UpSQL() []string {
+	statements := e.DownSQL()
+	for _, query := range []string{createDomainSQL, createDesiredLRPsSQL, createActualLRPsSQL, createTasksSQL} {
+		statements = append(statements, helpers.RebindForFlavor(query, e.dbFlavor))
 	}
-	for _, tableName := range tableNames {
+	statements = append(statements, createDomainsIndices...)
+	statements = append(statements, createDesiredLRPsIndices...)
+	statements = append(statements, createActualLRPsIndices...)
+	statements = append(statements, createTasksIndices...)
+	return statements
+}
+
+func (e *InitSQL) DownSQL() []string {
+	statements := []string{}
+	for _, tableName := range initTableNames {
+		statements = append(statements, "DROP TABLE IF EXISTS "+tableName)
+	}
+	return statements
+}
+
+var initTableNames = []string{
+	"domains",
+	"tasks",
+	"desired_lrps",
+	"actual_lrps",
+}
+
+func dropTables(db *sql.DB) error {
+	for _, tableName := range initTableNames {
 		_, err := db.Exec("DROP TABLE IF EXISTS " + tableName)
 		if err != nil {
 			return err
@@ -135,4 +135,16 @@ var _ = Describe("Init SQL Migration", func() {
 			})
 		})
 	})
+
+	Describe("Down", func() {
+		BeforeEach(func() {
+			migration.SetRawSQLDB(rawSQLDB)
+			migration.SetClock(fakeClock)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("drops the tables created by Up", func() {
+			testReversibility(rawSQLDB, migration, logger)
+		})
+	})
 })
@@ -49,32 +49,33 @@ func (e *IncreaseRunInfoColumnSize) Up(logger lager.Logger) error {
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	return alterTables(logger, e.rawSQLDB, e.dbFlavor)
+	return execStatements(logger, e.rawSQLDB, e.dbFlavor, increaseRunInfoColumnSQL(e.dbFlavor))
 }
 
-func alterTables(logger lager.Logger, db *sql.DB, flavor string) error {
+// Down does nothing, the larger columns are still compatible with the
+// previous schema.
+func (e *IncreaseRunInfoColumnSize) Down(logger lager.Logger) error {
+	return nil
+}
+
+func (e *IncreaseRunInfoColumnSize) UpSQL() []string {
+	return increaseRunInfoColumnSQL(e.dbFlavor)
+}
+
+func (e *IncreaseRunInfoColumnSize) DownSQL() []string {
+	return []string{}
+}
+
+func increaseRunInfoColumnSQL(flavor string) []string {
 	if flavor != "mysql" {
-		return nil
+		return []string{}
 	}
 
-	var alterTablesSQL = []string{
+	return []string{
 		alterDesiredLRPsSQL,
 		alterActualLRPsSQL,
 		alterTasksSQL,
 	}
-
-	logger.Info("altering-tables")
-	for _, query := range alterTablesSQL {
-		logger.Info("altering the table", lager.Data{"query": query})
-		_, err := db.Exec(query)
-		if err != nil {
-			logger.Error("failed-altering-tables", err)
-			return err
-		}
-		logger.Info("altered the table", lager.Data{"query": query})
-	}
-
-	return nil
 }
 
 const alterDesiredLRPsSQL = `ALTER TABLE desired_lrps
@@ -66,7 +66,7 @@ var _ = Describe("Increase Run Info Column Migration", func() {
 		})
 
 		It("should change the size of all text columns ", func() {
-			Expect(migration.Up(logger)).To(Succeed())
+			Expect(migrateUp(migration, logger)).To(Succeed())
 			value := strings.Repeat("x", 65536*2)
 			query := helpers.RebindForFlavor("insert into desired_lrps(annotation, routes, volume_placement, run_info) values('', '', '', ?)", flavor)
 			_, err := rawSQLDB.Exec(query, value)
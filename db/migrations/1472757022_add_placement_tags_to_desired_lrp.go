@@ -56,5 +56,17 @@ func (e *AddPlacementTagsToDesiredLRPs) Up(logger lager.Logger) error {
 	return nil
 }
 
+func (e *AddPlacementTagsToDesiredLRPs) Down(logger lager.Logger) error {
+	return execStatements(logger.Session("remove-placement-tags"), e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *AddPlacementTagsToDesiredLRPs) UpSQL() []string {
+	return []string{alterDesiredLRPAddPlacementTagSQL}
+}
+
+func (e *AddPlacementTagsToDesiredLRPs) DownSQL() []string {
+	return []string{"ALTER TABLE desired_lrps DROP COLUMN placement_tags;"}
+}
+
 const alterDesiredLRPAddPlacementTagSQL = `ALTER TABLE desired_lrps
 	ADD COLUMN placement_tags TEXT;`
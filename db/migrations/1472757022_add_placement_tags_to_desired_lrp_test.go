@@ -48,7 +48,7 @@ var _ = Describe("Add Placement Tags to Desired LRPs", func() {
 				m.SetRawSQLDB(rawSQLDB)
 				m.SetDBFlavor(flavor)
 				m.SetClock(fakeClock)
-				err := m.Up(logger)
+				err := migrateUp(m, logger)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -86,6 +86,10 @@ var _ = Describe("Add Placement Tags to Desired LRPs", func() {
 			Expect(fetchedJSONData).To(BeEquivalentTo(jsonData))
 		})
 
+		It("is reversible", func() {
+			testReversibility(rawSQLDB, mig, logger)
+		})
+
 		It("is idempotent", func() {
 			testIdempotency(rawSQLDB, mig, logger)
 		})
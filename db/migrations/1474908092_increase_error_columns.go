@@ -45,35 +45,31 @@ func (e *IncreaseErrorColumnsSize) SetClock(c clock.Clock)    { e.clock = c }
 func (e *IncreaseErrorColumnsSize) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
 
 func (e *IncreaseErrorColumnsSize) Up(logger lager.Logger) error {
-	logger = logger.Session("increase-run-info-column")
+	logger = logger.Session("increase-error-columns")
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	return e.alterTables(logger, e.rawSQLDB, e.dbFlavor)
+	return execStatements(logger, e.rawSQLDB, e.dbFlavor, e.UpSQL())
 }
 
-func (e *IncreaseErrorColumnsSize) alterTables(logger lager.Logger, db *sql.DB, flavor string) error {
-	var alterActualLRPsSQL string
+// Down does nothing, the larger columns are still compatible with the
+// previous schema.
+func (e *IncreaseErrorColumnsSize) Down(logger lager.Logger) error {
+	return nil
+}
 
+func (e *IncreaseErrorColumnsSize) UpSQL() []string {
 	if e.dbFlavor == "mysql" {
-		alterActualLRPsSQL = `ALTER TABLE actual_lrps
+		return []string{`ALTER TABLE actual_lrps
 	MODIFY crash_reason VARCHAR(1024) NOT NULL DEFAULT '',
-	MODIFY placement_error VARCHAR(1024) NOT NULL DEFAULT ''`
-
-	} else {
-		alterActualLRPsSQL = `ALTER TABLE actual_lrps
-	ALTER crash_reason TYPE VARCHAR(1024),
-	ALTER placement_error TYPE VARCHAR(1024)`
+	MODIFY placement_error VARCHAR(1024) NOT NULL DEFAULT ''`}
 	}
 
-	logger.Info("altering-tables")
-	logger.Info("altering the table", lager.Data{"query": alterActualLRPsSQL})
-	_, err := db.Exec(alterActualLRPsSQL)
-	if err != nil {
-		logger.Error("failed-altering-tables", err)
-		return err
-	}
-	logger.Info("altered the table", lager.Data{"query": alterActualLRPsSQL})
+	return []string{`ALTER TABLE actual_lrps
+	ALTER crash_reason TYPE VARCHAR(1024),
+	ALTER placement_error TYPE VARCHAR(1024)`}
+}
 
-	return nil
+func (e *IncreaseErrorColumnsSize) DownSQL() []string {
+	return []string{}
 }
@@ -47,49 +47,146 @@ func (e *EncryptRoutes) SetRawSQLDB(db *sql.DB) {
 func (e *EncryptRoutes) SetClock(c clock.Clock)    { e.clock = c }
 func (e *EncryptRoutes) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
 
+// Up does nothing. The routes are encrypted in the background by
+// MigrateBatch, and readers accept routes that are still in plain text.
 func (e *EncryptRoutes) Up(logger lager.Logger) error {
-	logger = logger.Session("encrypt-route-column")
-	logger.Info("starting")
-	defer logger.Info("completed")
+	return nil
+}
 
-	query := fmt.Sprintf("SELECT process_guid, routes FROM desired_lrps")
+// MigrateBatch encrypts the routes of the next batchSize desired LRPs after
+// the process guid in checkpoint. Routes that are already encrypted are left
+// alone, so a batch that ran without its checkpoint being saved can be run
+// again.
+func (e *EncryptRoutes) MigrateBatch(logger lager.Logger, checkpoint string, batchSize int) (string, int, bool, error) {
+	logger = logger.Session("encrypt-route-column", lager.Data{"checkpoint": checkpoint})
 
-	rows, err := e.rawSQLDB.Query(query)
+	tx, err := e.rawSQLDB.Begin()
 	if err != nil {
-		logger.Error("failed-query", err)
-		return err
+		logger.Error("failed-starting-transaction", err)
+		return checkpoint, 0, false, err
 	}
-	defer rows.Close()
+	defer tx.Rollback()
 
-	var processGuid string
-	var routeData []byte
+	query := fmt.Sprintf(selectRoutesBatchSQL, batchSize)
+	rows, err := tx.Query(helpers.RebindForFlavor(query, e.dbFlavor), checkpoint)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return checkpoint, 0, false, err
+	}
 
+	count := 0
+	next := checkpoint
+	plaintext := map[string][]byte{}
 	for rows.Next() {
+		var processGuid string
+		var routeData []byte
+
 		err := rows.Scan(&processGuid, &routeData)
 		if err != nil {
 			logger.Error("failed-reading-row", err)
-			continue
+			rows.Close()
+			return checkpoint, 0, false, err
 		}
+		count++
+		next = processGuid
+
+		if !format.IsEncoded(routeData) {
+			plaintext[processGuid] = routeData
+		}
+	}
+	rows.Close()
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return checkpoint, 0, false, rows.Err()
+	}
+
+	for processGuid, routeData := range plaintext {
 		encodedData, err := e.encoder.Encode(routeData)
 		if err != nil {
-			logger.Error("failed-encrypting-routes", err)
-			return models.ErrBadRequest
+			logger.Error("failed-encrypting-routes", err, lager.Data{"process_guid": processGuid})
+			return checkpoint, 0, false, models.ErrBadRequest
 		}
 
-		bindings := make([]interface{}, 0, 3)
-		updateQuery := fmt.Sprintf("UPDATE desired_lrps SET routes = ? WHERE process_guid = ?")
-		bindings = append(bindings, encodedData)
-		bindings = append(bindings, processGuid)
-		_, err = e.rawSQLDB.Exec(helpers.RebindForFlavor(updateQuery, e.dbFlavor), bindings...)
+		_, err = tx.Exec(helpers.RebindForFlavor(updateRoutesSQL, e.dbFlavor), encodedData, processGuid)
 		if err != nil {
-			logger.Error("failed-updating-desired-lrp-record", err)
-			return models.ErrBadRequest
+			logger.Error("failed-updating-desired-lrp-record", err, lager.Data{"process_guid": processGuid})
+			return checkpoint, 0, false, err
 		}
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		logger.Error("failed-committing-transaction", err)
+		return checkpoint, 0, false, err
+	}
+
+	return next, count, count < batchSize, nil
+}
+
+// Down decrypts the routes. Routes the background migration had not yet
+// reached are still in plain text and are left alone.
+func (e *EncryptRoutes) Down(logger lager.Logger) error {
+	logger = logger.Session("decrypt-route-column")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	rows, err := e.rawSQLDB.Query("SELECT process_guid, routes FROM desired_lrps")
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+
+	decrypted := map[string][]byte{}
+	for rows.Next() {
+		var processGuid string
+		var routeData []byte
+
+		err := rows.Scan(&processGuid, &routeData)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+		if !format.IsEncoded(routeData) {
+			continue
+		}
+		decodedData, err := e.encoder.Decode(routeData)
+		if err != nil {
+			logger.Error("failed-decrypting-routes", err, lager.Data{"process_guid": processGuid})
+			rows.Close()
+			return err
+		}
+		decrypted[processGuid] = decodedData
+	}
+	rows.Close()
+
 	if rows.Err() != nil {
 		logger.Error("failed-fetching-row", rows.Err())
 		return rows.Err()
 	}
+
+	for processGuid, routeData := range decrypted {
+		_, err = e.rawSQLDB.Exec(helpers.RebindForFlavor(updateRoutesSQL, e.dbFlavor), routeData, processGuid)
+		if err != nil {
+			logger.Error("failed-updating-desired-lrp-record", err)
+			return err
+		}
+	}
 	return nil
 }
+
+func (e *EncryptRoutes) UpSQL() []string {
+	return []string{"-- for every desired lrp, with the encrypted routes\n" + updateRoutesSQL}
+}
+
+func (e *EncryptRoutes) DownSQL() []string {
+	return []string{"-- for every desired lrp, with the decrypted routes\n" + updateRoutesSQL}
+}
+
+const updateRoutesSQL = "UPDATE desired_lrps SET routes = ? WHERE process_guid = ?"
+
+const selectRoutesBatchSQL = `SELECT process_guid, routes FROM desired_lrps
+	WHERE process_guid > ?
+	ORDER BY process_guid
+	LIMIT %d
+	FOR UPDATE`
@@ -70,6 +70,18 @@ func (e *AddMaxPidsToDesiredLRPs) Up(logger lager.Logger) error {
 	return nil
 }
 
+func (e *AddMaxPidsToDesiredLRPs) Down(logger lager.Logger) error {
+	return execStatements(logger.Session("remove-max-pids"), e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *AddMaxPidsToDesiredLRPs) UpSQL() []string {
+	return []string{alterDesiredLRPAddMaxPidsSQL}
+}
+
+func (e *AddMaxPidsToDesiredLRPs) DownSQL() []string {
+	return []string{"ALTER TABLE desired_lrps DROP COLUMN max_pids;"}
+}
+
 const postgresColumnNotExistErr = `"max_pids" does not exist`
 const mysqlColumnNotExistErr = `Unknown column 'max_pids'`
 const checkMaxPidsExistenceSQL = `SELECT count(max_pids) FROM desired_lrps`
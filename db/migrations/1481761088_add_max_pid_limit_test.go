@@ -47,7 +47,7 @@ var _ = Describe("Add Maximum Process limit to Desired LRPs", func() {
 				m.SetRawSQLDB(rawSQLDB)
 				m.SetDBFlavor(flavor)
 				m.SetClock(fakeClock)
-				err := m.Up(logger)
+				err := migrateUp(m, logger)
 				Expect(err).NotTo(HaveOccurred())
 			}
 
@@ -99,6 +99,10 @@ var _ = Describe("Add Maximum Process limit to Desired LRPs", func() {
 			Expect(maxPids).To(Equal(0))
 		})
 
+		It("is reversible", func() {
+			testReversibility(rawSQLDB, mig, logger)
+		})
+
 		It("is idempotent", func() {
 			testIdempotency(rawSQLDB, mig, logger)
 		})
@@ -49,29 +49,25 @@ func (e *IncreaseRootFSColumnsSize) Up(logger lager.Logger) error {
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	return e.alterTables(logger, e.rawSQLDB, e.dbFlavor)
+	return execStatements(logger, e.rawSQLDB, e.dbFlavor, e.UpSQL())
 }
 
-func (e *IncreaseRootFSColumnsSize) alterTables(logger lager.Logger, db *sql.DB, flavor string) error {
-	var alterActualLRPsSQL string
+// Down does nothing, the larger column is still compatible with the previous
+// schema.
+func (e *IncreaseRootFSColumnsSize) Down(logger lager.Logger) error {
+	return nil
+}
 
+func (e *IncreaseRootFSColumnsSize) UpSQL() []string {
 	if e.dbFlavor == "mysql" {
-		alterActualLRPsSQL = `ALTER TABLE desired_lrps
-	MODIFY rootfs VARCHAR(1024) NOT NULL DEFAULT ''`
-
-	} else {
-		alterActualLRPsSQL = `ALTER TABLE desired_lrps
-	ALTER rootfs TYPE VARCHAR(1024)`
+		return []string{`ALTER TABLE desired_lrps
+	MODIFY rootfs VARCHAR(1024) NOT NULL DEFAULT ''`}
 	}
 
-	logger.Info("altering-tables")
-	logger.Info("altering the table", lager.Data{"query": alterActualLRPsSQL})
-	_, err := db.Exec(alterActualLRPsSQL)
-	if err != nil {
-		logger.Error("failed-altering-tables", err)
-		return err
-	}
-	logger.Info("altered the table", lager.Data{"query": alterActualLRPsSQL})
+	return []string{`ALTER TABLE desired_lrps
+	ALTER rootfs TYPE VARCHAR(1024)`}
+}
 
-	return nil
+func (e *IncreaseRootFSColumnsSize) DownSQL() []string {
+	return []string{}
 }
@@ -53,7 +53,7 @@ var _ = Describe("Increase rootfs Column Migration", func() {
 		})
 
 		It("changes the size of the rootfs column", func() {
-			Expect(migration.Up(logger)).To(Succeed())
+			Expect(migrateUp(migration, logger)).To(Succeed())
 			value := strings.Repeat("x", 1024)
 			query := helpers.RebindForFlavor("insert into desired_lrps(rootfs) values(?)", flavor)
 			_, err := rawSQLDB.Exec(query, value)
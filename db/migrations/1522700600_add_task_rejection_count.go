@@ -46,11 +46,24 @@ func (e *AddTaskRejectionCount) Up(logger lager.Logger) error {
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	const stmt = "ALTER TABLE tasks ADD COLUMN rejection_count INTEGER NOT NULL DEFAULT 0;"
-	_, err := e.rawSQLDB.Exec(stmt)
+	_, err := e.rawSQLDB.Exec(addTaskRejectionCountSQL)
 	if err != nil {
 		logger.Error("failed-altering-table", err)
 		return err
 	}
 	return nil
 }
+
+func (e *AddTaskRejectionCount) Down(logger lager.Logger) error {
+	return execStatements(logger.Session("remove-task-rejection-count"), e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *AddTaskRejectionCount) UpSQL() []string {
+	return []string{addTaskRejectionCountSQL}
+}
+
+func (e *AddTaskRejectionCount) DownSQL() []string {
+	return []string{"ALTER TABLE tasks DROP COLUMN rejection_count;"}
+}
+
+const addTaskRejectionCountSQL = "ALTER TABLE tasks ADD COLUMN rejection_count INTEGER NOT NULL DEFAULT 0;"
@@ -71,6 +71,10 @@ var _ = Describe("AddTaskRejectionCount", func() {
 			Expect(rejectionCount).To(Equal(0))
 		})
 
+		It("is reversible", func() {
+			testReversibility(rawSQLDB, mig, logger)
+		})
+
 		It("is idempotent", func() {
 			testIdempotency(rawSQLDB, mig, logger)
 		})
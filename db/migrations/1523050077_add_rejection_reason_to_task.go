@@ -46,11 +46,24 @@ func (e *AddRejectionReasonToTask) Up(logger lager.Logger) error {
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	const query = "ALTER TABLE tasks ADD COLUMN rejection_reason VARCHAR(255) NOT NULL DEFAULT '';"
-	_, err := e.rawSQLDB.Exec(query)
+	_, err := e.rawSQLDB.Exec(addRejectionReasonToTaskSQL)
 	if err != nil {
 		logger.Error("failed-altering-table", err)
 		return err
 	}
 	return nil
 }
+
+func (e *AddRejectionReasonToTask) Down(logger lager.Logger) error {
+	return execStatements(logger.Session("remove-task-rejection-reason"), e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *AddRejectionReasonToTask) UpSQL() []string {
+	return []string{addRejectionReasonToTaskSQL}
+}
+
+func (e *AddRejectionReasonToTask) DownSQL() []string {
+	return []string{"ALTER TABLE tasks DROP COLUMN rejection_reason;"}
+}
+
+const addRejectionReasonToTaskSQL = "ALTER TABLE tasks ADD COLUMN rejection_reason VARCHAR(255) NOT NULL DEFAULT '';"
@@ -67,6 +67,10 @@ var _ = Describe("AddRejectionReasonToTask", func() {
 			Expect(rejectionReason).To(Equal(""))
 		})
 
+		It("is reversible", func() {
+			testReversibility(rawSQLDB, migration, logger)
+		})
+
 		It("is idempotent", func() {
 			testIdempotency(rawSQLDB, migration, logger)
 		})
@@ -46,31 +46,27 @@ func (e *IncreaseTaskErrorColumns) Up(logger lager.Logger) error {
 	logger.Info("starting")
 	defer logger.Info("completed")
 
-	return e.alterTables(logger, e.rawSQLDB, e.dbFlavor)
+	return execStatements(logger, e.rawSQLDB, e.dbFlavor, e.UpSQL())
 }
 
-func (e *IncreaseTaskErrorColumns) alterTables(logger lager.Logger, db *sql.DB, flavor string) error {
-	var alterTaskTableSQL string
+// Down does nothing, the larger columns are still compatible with the
+// previous schema.
+func (e *IncreaseTaskErrorColumns) Down(logger lager.Logger) error {
+	return nil
+}
 
+func (e *IncreaseTaskErrorColumns) UpSQL() []string {
 	if e.dbFlavor == "mysql" {
-		alterTaskTableSQL = `ALTER TABLE tasks
+		return []string{`ALTER TABLE tasks
 	MODIFY rejection_reason VARCHAR(1024) NOT NULL DEFAULT '',
-	MODIFY failure_reason VARCHAR(1024) NOT NULL DEFAULT ''`
-
-	} else {
-		alterTaskTableSQL = `ALTER TABLE tasks
-	ALTER rejection_reason TYPE VARCHAR(1024),
-	ALTER failure_reason TYPE VARCHAR(1024)`
+	MODIFY failure_reason VARCHAR(1024) NOT NULL DEFAULT ''`}
 	}
 
-	logger.Info("altering-tables")
-	logger.Info("altering the table", lager.Data{"query": alterTaskTableSQL})
-	_, err := db.Exec(alterTaskTableSQL)
-	if err != nil {
-		logger.Error("failed-altering-tables", err)
-		return err
-	}
-	logger.Info("altered the table", lager.Data{"query": alterTaskTableSQL})
+	return []string{`ALTER TABLE tasks
+	ALTER rejection_reason TYPE VARCHAR(1024),
+	ALTER failure_reason TYPE VARCHAR(1024)`}
+}
 
-	return nil
+func (e *IncreaseTaskErrorColumns) DownSQL() []string {
+	return []string{}
 }
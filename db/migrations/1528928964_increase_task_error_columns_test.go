@@ -52,7 +52,7 @@ var _ = Describe("IncreaseTaskErrorColumns", func() {
 		testTableAndColumn := func(table, column string) {
 			title := fmt.Sprintf("should change the size of %s column ", column)
 			It(title, func() {
-				Expect(migration.Up(logger)).To(Succeed())
+				Expect(migrateUp(migration, logger)).To(Succeed())
 				value := strings.Repeat("x", 1024)
 				insertQuery := fmt.Sprintf("insert into %s(%s) values(?)", table, column)
 				query := helpers.RebindForFlavor(insertQuery, flavor)
@@ -71,7 +71,7 @@ var _ = Describe("IncreaseTaskErrorColumns", func() {
 		testTableAndColumn("tasks", "rejection_reason")
 
 		It("does not remove non null constraint", func() {
-			Expect(migration.Up(logger)).To(Succeed())
+			Expect(migrateUp(migration, logger)).To(Succeed())
 			query := helpers.RebindForFlavor("insert into tasks(failure_reason) values(?)", flavor)
 			_, err := rawSQLDB.Exec(query, nil)
 			Expect(err).To(MatchError(ContainSubstring("null")))
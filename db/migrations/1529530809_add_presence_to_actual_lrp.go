@@ -52,7 +52,15 @@ func (e *AddPresenceToActualLrp) Up(logger lager.Logger) error {
 	return e.alterTable(logger)
 }
 
-func (e *AddPresenceToActualLrp) alterTable(logger lager.Logger) error {
+func (e *AddPresenceToActualLrp) Down(logger lager.Logger) error {
+	logger = logger.Session("remove-presence")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	return execStatements(logger, e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *AddPresenceToActualLrp) UpSQL() []string {
 	alterTablesSQL := []string{
 		"ALTER TABLE actual_lrps ADD COLUMN presence INT NOT NULL DEFAULT 0;",
 	}
@@ -69,6 +77,48 @@ func (e *AddPresenceToActualLrp) alterTable(logger lager.Logger) error {
 		)
 	}
 
+	return alterTablesSQL
+}
+
+// DownSQL keeps the suspect instance of an index, as it is the one that may
+// still be running, and turns it back into an ordinary instance since the
+// previous schema has no notion of suspect instances.
+func (e *AddPresenceToActualLrp) DownSQL() []string {
+	var alterTablesSQL []string
+
+	if e.dbFlavor == "mysql" {
+		alterTablesSQL = append(alterTablesSQL, fmt.Sprintf(
+			"DELETE a FROM actual_lrps a INNER JOIN actual_lrps s ON a.process_guid = s.process_guid AND a.instance_index = s.instance_index WHERE a.presence = %d AND s.presence = %d;",
+			models.ActualLRP_Ordinary, models.ActualLRP_Suspect,
+		))
+	} else {
+		alterTablesSQL = append(alterTablesSQL, fmt.Sprintf(
+			"DELETE FROM actual_lrps a USING actual_lrps s WHERE a.process_guid = s.process_guid AND a.instance_index = s.instance_index AND a.presence = %d AND s.presence = %d;",
+			models.ActualLRP_Ordinary, models.ActualLRP_Suspect,
+		))
+	}
+
+	alterTablesSQL = append(alterTablesSQL,
+		fmt.Sprintf("UPDATE actual_lrps SET presence = %d WHERE presence = %d;", models.ActualLRP_Ordinary, models.ActualLRP_Suspect),
+		fmt.Sprintf("UPDATE actual_lrps SET evacuating = (presence = %d);", models.ActualLRP_Evacuating),
+	)
+
+	if e.dbFlavor == "mysql" {
+		alterTablesSQL = append(alterTablesSQL,
+			"ALTER TABLE actual_lrps DROP primary key, ADD PRIMARY KEY (process_guid, instance_index, evacuating);",
+		)
+	} else {
+		alterTablesSQL = append(alterTablesSQL,
+			"ALTER TABLE actual_lrps DROP CONSTRAINT actual_lrps_pkey, ADD PRIMARY KEY (process_guid, instance_index, evacuating);",
+		)
+	}
+
+	return append(alterTablesSQL, "ALTER TABLE actual_lrps DROP COLUMN presence;")
+}
+
+func (e *AddPresenceToActualLrp) alterTable(logger lager.Logger) error {
+	alterTablesSQL := e.UpSQL()
+
 	logger.Info("altering-table")
 	for _, query := range alterTablesSQL {
 		logger.Info("altering the table", lager.Data{"query": query})
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/migration"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -143,4 +144,71 @@ var _ = Describe("AddPresenceToActualLrp", func() {
 			})
 		})
 	})
+
+	Describe("Down", func() {
+		insertActualLRP := func(index int, state string, presence models.ActualLRP_Presence) {
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO actual_lrps
+						(process_guid, instance_index, domain, state, net_info,
+						modification_tag_epoch, modification_tag_index, presence)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", index, "cfapps", state, "", "epoch", 0, presence,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			initialMigration := migrations.NewInitSQL()
+			initialMigration.SetRawSQLDB(rawSQLDB)
+			initialMigration.SetDBFlavor(flavor)
+			initialMigration.SetClock(fakeClock)
+			Expect(initialMigration.Up(logger)).To(Succeed())
+
+			migration.SetRawSQLDB(rawSQLDB)
+			migration.SetDBFlavor(flavor)
+			Expect(migration.Up(logger)).To(Succeed())
+
+			insertActualLRP(0, "UNCLAIMED", models.ActualLRP_Ordinary)
+			insertActualLRP(0, "RUNNING", models.ActualLRP_Suspect)
+			insertActualLRP(1, "RUNNING", models.ActualLRP_Ordinary)
+			insertActualLRP(1, "RUNNING", models.ActualLRP_Evacuating)
+		})
+
+		It("keeps the suspect instance and restores the evacuating column", func() {
+			downMigration, ok := migration.(interface {
+				Down(logger lager.Logger) error
+			})
+			Expect(ok).To(BeTrue())
+			Expect(downMigration.Down(logger)).To(Succeed())
+
+			rows, err := rawSQLDB.Query("SELECT instance_index, state, evacuating FROM actual_lrps ORDER BY instance_index, evacuating")
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+
+			type row struct {
+				index      int
+				state      string
+				evacuating bool
+			}
+			var result []row
+			for rows.Next() {
+				var r row
+				Expect(rows.Scan(&r.index, &r.state, &r.evacuating)).To(Succeed())
+				result = append(result, r)
+			}
+			Expect(rows.Err()).NotTo(HaveOccurred())
+
+			Expect(result).To(Equal([]row{
+				{0, "RUNNING", false},
+				{1, "RUNNING", false},
+				{1, "RUNNING", true},
+			}))
+
+			_, err = rawSQLDB.Exec("SELECT presence FROM actual_lrps")
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	appendMigration(NewCreateAuditEntries())
+}
+
+type CreateAuditEntries struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	rawSQLDB   *sql.DB
+	dbFlavor   string
+}
+
+func NewCreateAuditEntries() migration.Migration {
+	return new(CreateAuditEntries)
+}
+
+func (e *CreateAuditEntries) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateAuditEntries) Version() int64 {
+	return 1539993600
+}
+
+func (e *CreateAuditEntries) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateAuditEntries) SetRawSQLDB(db *sql.DB)    { e.rawSQLDB = db }
+func (e *CreateAuditEntries) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateAuditEntries) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateAuditEntries) Up(logger lager.Logger) error {
+	return execStatements(logger.Session("create-audit-entries"), e.rawSQLDB, e.dbFlavor, e.UpSQL())
+}
+
+func (e *CreateAuditEntries) Down(logger lager.Logger) error {
+	return execStatements(logger.Session("drop-audit-entries"), e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *CreateAuditEntries) UpSQL() []string {
+	return []string{
+		helpers.RebindForFlavor(createAuditEntriesSQL, e.dbFlavor),
+		"CREATE INDEX audit_entries_created_at_idx ON audit_entries (created_at);",
+		"CREATE INDEX audit_entries_target_guid_idx ON audit_entries (target_guid);",
+	}
+}
+
+func (e *CreateAuditEntries) DownSQL() []string {
+	return []string{"DROP TABLE IF EXISTS audit_entries;"}
+}
+
+const createAuditEntriesSQL = `CREATE TABLE audit_entries(
+	guid VARCHAR(255) PRIMARY KEY,
+	created_at BIGINT NOT NULL DEFAULT 0,
+	route VARCHAR(255) NOT NULL DEFAULT '',
+	identity VARCHAR(1024) NOT NULL DEFAULT '',
+	remote_addr VARCHAR(255) NOT NULL DEFAULT '',
+	target_guid VARCHAR(255) NOT NULL DEFAULT '',
+	request MEDIUMTEXT,
+	error_type VARCHAR(255) NOT NULL DEFAULT '',
+	modification_tag_before_epoch VARCHAR(255) NOT NULL DEFAULT '',
+	modification_tag_before_index INT NOT NULL DEFAULT 0,
+	modification_tag_after_epoch VARCHAR(255) NOT NULL DEFAULT '',
+	modification_tag_after_index INT NOT NULL DEFAULT 0
+);`
@@ -0,0 +1,68 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateAuditEntries", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE audit_entries;")
+
+		migration = migrations.NewCreateAuditEntries()
+		migration.SetRawSQLDB(rawSQLDB)
+		migration.SetDBFlavor(flavor)
+		migration.SetClock(fakeClock)
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1539993600))
+		})
+	})
+
+	Describe("Up", func() {
+		It("creates the audit_entries table", func() {
+			Expect(migration.Up(logger)).To(Succeed())
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO audit_entries
+						(guid, created_at, route, identity, target_guid, request)
+						VALUES (?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", 1, "RemoveDesiredLRP", "CN=scheduler", "process-guid", "{}",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var errorType string
+			query := helpers.RebindForFlavor("SELECT error_type FROM audit_entries WHERE guid = ?", flavor)
+			row := rawSQLDB.QueryRow(query, "guid")
+			Expect(row.Scan(&errorType)).To(Succeed())
+			Expect(errorType).To(BeEmpty())
+		})
+	})
+
+	Describe("Down", func() {
+		It("drops the audit_entries table", func() {
+			testReversibility(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	appendMigration(NewCreateCellCordons())
+}
+
+type CreateCellCordons struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	rawSQLDB   *sql.DB
+	dbFlavor   string
+}
+
+func NewCreateCellCordons() migration.Migration {
+	return new(CreateCellCordons)
+}
+
+func (e *CreateCellCordons) String() string {
+	return migrationString(e)
+}
+
+func (e *CreateCellCordons) Version() int64 {
+	return 1541030400
+}
+
+func (e *CreateCellCordons) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *CreateCellCordons) SetRawSQLDB(db *sql.DB)    { e.rawSQLDB = db }
+func (e *CreateCellCordons) SetClock(c clock.Clock)    { e.clock = c }
+func (e *CreateCellCordons) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *CreateCellCordons) Up(logger lager.Logger) error {
+	return execStatements(logger.Session("create-cell-cordons"), e.rawSQLDB, e.dbFlavor, e.UpSQL())
+}
+
+func (e *CreateCellCordons) Down(logger lager.Logger) error {
+	return execStatements(logger.Session("drop-cell-cordons"), e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *CreateCellCordons) UpSQL() []string {
+	return []string{helpers.RebindForFlavor(createCellCordonsSQL, e.dbFlavor)}
+}
+
+func (e *CreateCellCordons) DownSQL() []string {
+	return []string{"DROP TABLE IF EXISTS cell_cordons;"}
+}
+
+const createCellCordonsSQL = `CREATE TABLE cell_cordons(
+	cell_id VARCHAR(255) PRIMARY KEY,
+	draining BOOL NOT NULL DEFAULT false,
+	reason VARCHAR(1024) NOT NULL DEFAULT '',
+	updated_at BIGINT NOT NULL DEFAULT 0
+);`
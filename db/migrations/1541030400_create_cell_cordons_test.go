@@ -0,0 +1,66 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateCellCordons", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE cell_cordons;")
+
+		migration = migrations.NewCreateCellCordons()
+		migration.SetRawSQLDB(rawSQLDB)
+		migration.SetDBFlavor(flavor)
+		migration.SetClock(fakeClock)
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1541030400))
+		})
+	})
+
+	Describe("Up", func() {
+		It("creates the cell_cordons table", func() {
+			Expect(migration.Up(logger)).To(Succeed())
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					"INSERT INTO cell_cordons (cell_id, reason, updated_at) VALUES (?, ?, ?)",
+					flavor,
+				),
+				"cell-1", "kernel upgrade", 1,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var draining bool
+			query := helpers.RebindForFlavor("SELECT draining FROM cell_cordons WHERE cell_id = ?", flavor)
+			row := rawSQLDB.QueryRow(query, "cell-1")
+			Expect(row.Scan(&draining)).To(Succeed())
+			Expect(draining).To(BeFalse())
+		})
+	})
+
+	Describe("Down", func() {
+		It("drops the cell_cordons table", func() {
+			testReversibility(rawSQLDB, migration, logger)
+		})
+	})
+})
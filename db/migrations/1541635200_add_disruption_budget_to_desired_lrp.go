@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	appendMigration(NewAddDisruptionBudgetToDesiredLRPs())
+}
+
+type AddDisruptionBudgetToDesiredLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	rawSQLDB   *sql.DB
+	dbFlavor   string
+}
+
+func NewAddDisruptionBudgetToDesiredLRPs() migration.Migration {
+	return &AddDisruptionBudgetToDesiredLRPs{}
+}
+
+func (e *AddDisruptionBudgetToDesiredLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddDisruptionBudgetToDesiredLRPs) Version() int64 {
+	return 1541635200
+}
+
+func (e *AddDisruptionBudgetToDesiredLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddDisruptionBudgetToDesiredLRPs) SetRawSQLDB(db *sql.DB) {
+	e.rawSQLDB = db
+}
+
+func (e *AddDisruptionBudgetToDesiredLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddDisruptionBudgetToDesiredLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddDisruptionBudgetToDesiredLRPs) Up(logger lager.Logger) error {
+	logger.Info("altering the table", lager.Data{"query": alterDesiredLRPAddDisruptionBudgetSQL})
+	_, err := e.rawSQLDB.Exec(alterDesiredLRPAddDisruptionBudgetSQL)
+	if err != nil {
+		logger.Error("failed-altering-tables", err)
+		return err
+	}
+	logger.Info("altered the table", lager.Data{"query": alterDesiredLRPAddDisruptionBudgetSQL})
+
+	return nil
+}
+
+func (e *AddDisruptionBudgetToDesiredLRPs) Down(logger lager.Logger) error {
+	return execStatements(logger.Session("remove-disruption-budget"), e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+func (e *AddDisruptionBudgetToDesiredLRPs) UpSQL() []string {
+	return []string{alterDesiredLRPAddDisruptionBudgetSQL}
+}
+
+func (e *AddDisruptionBudgetToDesiredLRPs) DownSQL() []string {
+	return []string{"ALTER TABLE desired_lrps DROP COLUMN disruption_budget;"}
+}
+
+const alterDesiredLRPAddDisruptionBudgetSQL = `ALTER TABLE desired_lrps
+	ADD COLUMN disruption_budget TEXT;`
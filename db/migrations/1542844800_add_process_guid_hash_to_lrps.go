@@ -0,0 +1,91 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+func init() {
+	appendMigration(NewAddProcessGuidHashToLRPs())
+}
+
+// AddProcessGuidHashToLRPs stores the hash that incremental LRP convergence
+// shards process guids by, so that a shard selects an indexed range of it.
+type AddProcessGuidHashToLRPs struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	rawSQLDB   *sql.DB
+	dbFlavor   string
+}
+
+func NewAddProcessGuidHashToLRPs() migration.Migration {
+	return &AddProcessGuidHashToLRPs{}
+}
+
+func (e *AddProcessGuidHashToLRPs) String() string {
+	return migrationString(e)
+}
+
+func (e *AddProcessGuidHashToLRPs) Version() int64 {
+	return 1542844800
+}
+
+func (e *AddProcessGuidHashToLRPs) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddProcessGuidHashToLRPs) SetRawSQLDB(db *sql.DB)    { e.rawSQLDB = db }
+func (e *AddProcessGuidHashToLRPs) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddProcessGuidHashToLRPs) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddProcessGuidHashToLRPs) Up(logger lager.Logger) error {
+	logger = logger.Session("add-process-guid-hash")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	return execStatements(logger, e.rawSQLDB, e.dbFlavor, e.UpSQL())
+}
+
+func (e *AddProcessGuidHashToLRPs) Down(logger lager.Logger) error {
+	logger = logger.Session("remove-process-guid-hash")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	return execStatements(logger, e.rawSQLDB, e.dbFlavor, e.DownSQL())
+}
+
+// UpSQL fills in the hash of the existing LRPs. It is the first 28 bits of
+// the MD5 sum of the process guid, as db.ProcessGuidHash computes it for new
+// ones.
+func (e *AddProcessGuidHashToLRPs) UpSQL() []string {
+	var hash string
+	if e.dbFlavor == helpers.MySQL {
+		hash = "CAST(CONV(SUBSTRING(MD5(process_guid), 1, 7), 16, 10) AS UNSIGNED)"
+	} else {
+		hash = "('x' || SUBSTR(MD5(process_guid), 1, 7))::bit(28)::int"
+	}
+
+	statements := []string{}
+	for _, table := range []string{"desired_lrps", "actual_lrps"} {
+		statements = append(statements,
+			fmt.Sprintf("ALTER TABLE %s ADD COLUMN process_guid_hash INT NOT NULL DEFAULT 0;", table),
+			fmt.Sprintf("UPDATE %s SET process_guid_hash = %s;", table, hash),
+			fmt.Sprintf("CREATE INDEX %s_process_guid_hash_idx ON %s (process_guid_hash);", table, table),
+		)
+	}
+	return statements
+}
+
+func (e *AddProcessGuidHashToLRPs) DownSQL() []string {
+	return []string{
+		"ALTER TABLE desired_lrps DROP COLUMN process_guid_hash;",
+		"ALTER TABLE actual_lrps DROP COLUMN process_guid_hash;",
+	}
+}
@@ -0,0 +1,105 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Add Process Guid Hash to LRPs", func() {
+	var (
+		mig migration.Migration
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+		mig = migrations.NewAddProcessGuidHashToLRPs()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1542844800))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigrations := []migration.Migration{
+				migrations.NewInitSQL(),
+				migrations.NewIncreaseRunInfoColumnSize(),
+			}
+
+			for _, m := range initialMigrations {
+				m.SetRawSQLDB(rawSQLDB)
+				m.SetDBFlavor(flavor)
+				m.SetClock(fakeClock)
+				err := migrateUp(m, logger)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			mig.SetRawSQLDB(rawSQLDB)
+			mig.SetDBFlavor(flavor)
+		})
+
+		It("fills in the process guid hash of the existing lrps", func() {
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO desired_lrps
+						  (process_guid, domain, log_guid, instances, memory_mb,
+							  disk_mb, rootfs, routes, volume_placement, modification_tag_epoch, run_info)
+						  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "domain",
+				"log guid", 2, 1, 1, "rootfs", "routes", "volumes yo", "1", "run info",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO actual_lrps
+						(process_guid, instance_index, domain, state, net_info,
+						modification_tag_epoch, modification_tag_index)
+					VALUES (?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", 10, "cfapps", "RUNNING", "", "epoch", 0,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mig.Up(logger)).To(Succeed())
+
+			var hash int
+			row := rawSQLDB.QueryRow("SELECT process_guid_hash FROM desired_lrps LIMIT 1")
+			Expect(row.Scan(&hash)).To(Succeed())
+			Expect(hash).To(Equal(db.ProcessGuidHash("guid")))
+
+			row = rawSQLDB.QueryRow("SELECT process_guid_hash FROM actual_lrps LIMIT 1")
+			Expect(row.Scan(&hash)).To(Succeed())
+			Expect(hash).To(Equal(db.ProcessGuidHash("guid")))
+		})
+
+		It("is reversible", func() {
+			testReversibility(rawSQLDB, mig, logger)
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
@@ -1,13 +1,16 @@
 package migrations
 
 import (
+	"database/sql"
 	"path/filepath"
 	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
 
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/lager"
 )
 
 var migrationsRegistry = migration.Migrations{}
@@ -24,6 +27,18 @@ func migrationString(m migration.Migration) string {
 	return strings.Split(filepath.Base(filename), ".")[0]
 }
 
+func execStatements(logger lager.Logger, db *sql.DB, flavor string, statements []string) error {
+	for _, query := range statements {
+		logger.Info("executing", lager.Data{"query": query})
+		_, err := db.Exec(helpers.RebindForFlavor(query, flavor))
+		if err != nil {
+			logger.Error("failed-executing", err, lager.Data{"query": query})
+			return err
+		}
+	}
+	return nil
+}
+
 func AllMigrations() migration.Migrations {
 	migs := make(migration.Migrations, len(migrationsRegistry))
 	for i, mig := range migrationsRegistry {
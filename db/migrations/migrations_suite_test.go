@@ -121,6 +121,48 @@ func getAllSchemas(db *sql.DB) ([]string, map[string][]*sql.ColumnType) {
 	return tableNames, allSchemas
 }
 
+// migrateUp runs the migration and, for a data migration, every one of its
+// batches, as the manager does before it reports the migration complete.
+func migrateUp(mig migration.Migration, logger lager.Logger) error {
+	err := mig.Up(logger)
+	if err != nil {
+		return err
+	}
+
+	dataMigration, ok := mig.(migration.DataMigration)
+	if !ok {
+		return nil
+	}
+
+	checkpoint := ""
+	for {
+		next, _, done, err := dataMigration.MigrateBatch(logger, checkpoint, 2)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		checkpoint = next
+	}
+}
+
+func migrateBatch(mig migration.Migration, logger lager.Logger, checkpoint string, batchSize int) (string, int, bool, error) {
+	return mig.(migration.DataMigration).MigrateBatch(logger, checkpoint, batchSize)
+}
+
+func testReversibility(db *sql.DB, mig migration.Migration, logger lager.Logger) {
+	tableNamesBefore, allSchemasBefore := getAllSchemas(db)
+
+	Expect(migrateUp(mig, logger)).To(Succeed())
+	Expect(mig.(migration.DownMigration).Down(logger)).To(Succeed())
+
+	tableNamesAfter, allSchemasAfter := getAllSchemas(db)
+
+	Expect(tableNamesAfter).To(Equal(tableNamesBefore))
+	Expect(allSchemasAfter).To(Equal(allSchemasBefore))
+}
+
 func dumpTableData(db *sql.DB, name string) [][][]byte {
 	rows, err := db.Query("SELECT * FROM " + name)
 	Expect(err).NotTo(HaveOccurred())
@@ -147,7 +189,7 @@ func dumpTableData(db *sql.DB, name string) [][][]byte {
 }
 
 func testIdempotency(db *sql.DB, mig migration.Migration, logger lager.Logger) {
-	Expect(mig.Up(logger)).To(Succeed())
+	Expect(migrateUp(mig, logger)).To(Succeed())
 
 	tableNamesBefore, allSchemasBefore := getAllSchemas(db)
 
@@ -158,7 +200,7 @@ func testIdempotency(db *sql.DB, mig migration.Migration, logger lager.Logger) {
 
 	// some migrations will not apply a second time, but we still want
 	// to make sure the data was not changed.
-	mig.Up(logger)
+	migrateUp(mig, logger)
 
 	tableNamesAfter, allSchemasAfter := getAllSchemas(db)
 
@@ -2,6 +2,7 @@ package migrations_test
 
 import (
 	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/migration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -14,4 +15,13 @@ var _ = Describe("Migrations", func() {
 			allMigrations[mig.Version()] = true
 		}
 	})
+
+	It("can revert and describe every migration", func() {
+		for _, mig := range migrations.AllMigrations() {
+			_, ok := mig.(migration.DownMigration)
+			Expect(ok).To(BeTrue(), mig.String()+" does not implement Down")
+			_, ok = mig.(migration.PlannableMigration)
+			Expect(ok).To(BeTrue(), mig.String()+" does not describe its SQL")
+		}
+	})
 })
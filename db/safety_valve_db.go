@@ -0,0 +1,14 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . SafetyValveDB
+
+type SafetyValveDB interface {
+	SafetyValveCellIds(ctx context.Context, logger lager.Logger, convergence string) ([]string, error)
+	SetSafetyValveCellIds(ctx context.Context, logger lager.Logger, convergence string, cellIds []string) error
+}
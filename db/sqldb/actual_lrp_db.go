@@ -77,6 +77,14 @@ func (db *SQLDB) ActualLRPs(ctx context.Context, logger lager.Logger, filter mod
 		values = append(values, filter.Domain)
 	}
 
+	if len(filter.Domains) > 0 {
+		wheres = append(wheres, whereClauseForDomains(filter.Domains))
+
+		for _, domain := range filter.Domains {
+			values = append(values, domain)
+		}
+	}
+
 	if filter.CellID != "" {
 		wheres = append(wheres, "cell_id = ?")
 		values = append(values, filter.CellID)
@@ -121,6 +129,7 @@ func (db *SQLDB) CreateUnclaimedActualLRP(ctx context.Context, logger lager.Logg
 		_, err := db.insert(ctx, logger, tx, actualLRPsTable,
 			helpers.SQLAttributes{
 				"process_guid":           key.ProcessGuid,
+				"process_guid_hash":      processGuidHash(key.ProcessGuid),
 				"instance_index":         key.Index,
 				"domain":                 key.Domain,
 				"state":                  models.ActualLRPStateUnclaimed,
@@ -537,6 +546,7 @@ func (db *SQLDB) createRunningActualLRP(ctx context.Context, logger lager.Logger
 	_, err = db.insert(ctx, logger, tx, actualLRPsTable,
 		helpers.SQLAttributes{
 			"process_guid":           actualLRP.ActualLRPKey.ProcessGuid,
+			"process_guid_hash":      processGuidHash(actualLRP.ActualLRPKey.ProcessGuid),
 			"instance_index":         actualLRP.ActualLRPKey.Index,
 			"domain":                 actualLRP.ActualLRPKey.Domain,
 			"instance_guid":          actualLRP.ActualLRPInstanceKey.InstanceGuid,
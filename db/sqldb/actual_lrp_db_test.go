@@ -367,6 +367,15 @@ var _ = Describe("ActualLRPDB", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(actualLRPs).To(ConsistOf(allActualLRPs[1], allActualLRPs[3], allActualLRPs[4]))
 			})
+
+			It("returns the actual lrps in any of the listed domains", func() {
+				filter := models.ActualLRPFilter{
+					Domains: []string{"domain2", "domain3"},
+				}
+				actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualLRPs).To(ConsistOf(allActualLRPs[1], allActualLRPs[3], allActualLRPs[4]))
+			})
 		})
 
 		Context("when filtering on cell", func() {
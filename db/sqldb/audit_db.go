@@ -0,0 +1,142 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// DefaultAuditEntriesLimit is the number of audit entries returned when the
+// filter does not set a limit.
+const DefaultAuditEntriesLimit = 100
+
+func (db *SQLDB) InsertAuditEntry(ctx context.Context, logger lager.Logger, entry *models.AuditEntry) error {
+	logger = logger.Session("db-insert-audit-entry", lager.Data{"route": entry.Route, "target_guid": entry.TargetGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	guid, err := db.guidProvider.NextGUID()
+	if err != nil {
+		logger.Error("failed-to-generate-guid", err)
+		return models.ErrGUIDGeneration
+	}
+
+	before := entry.ModificationTagBefore
+	if before == nil {
+		before = &models.ModificationTag{}
+	}
+	after := entry.ModificationTagAfter
+	if after == nil {
+		after = &models.ModificationTag{}
+	}
+
+	_, err = db.insert(ctx, logger, db.db, auditEntriesTable,
+		helpers.SQLAttributes{
+			"guid":                          guid,
+			"created_at":                    entry.Timestamp,
+			"route":                         entry.Route,
+			"identity":                      entry.Identity,
+			"remote_addr":                   entry.RemoteAddr,
+			"target_guid":                   entry.TargetGuid,
+			"request":                       entry.Request,
+			"error_type":                    entry.ErrorType,
+			"modification_tag_before_epoch": before.Epoch,
+			"modification_tag_before_index": before.Index,
+			"modification_tag_after_epoch":  after.Epoch,
+			"modification_tag_after_index":  after.Index,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-audit-entry", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+// AuditEntries returns the most recent audit entries matching the filter,
+// newest first.
+func (db *SQLDB) AuditEntries(ctx context.Context, logger lager.Logger, filter models.AuditEntryFilter) ([]*models.AuditEntry, error) {
+	logger = logger.Session("db-audit-entries", lager.Data{"filter": filter})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := []string{"created_at >= ?"}
+	values := []interface{}{filter.Since}
+
+	if filter.Route != "" {
+		wheres = append(wheres, "route = ?")
+		values = append(values, filter.Route)
+	}
+
+	if filter.Identity != "" {
+		wheres = append(wheres, "identity = ?")
+		values = append(values, filter.Identity)
+	}
+
+	if filter.TargetGuid != "" {
+		wheres = append(wheres, "target_guid = ?")
+		values = append(values, filter.TargetGuid)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultAuditEntriesLimit
+	}
+
+	query := fmt.Sprintf("%s ORDER BY created_at DESC LIMIT %d", strings.Join(wheres, " AND "), limit)
+	rows, err := db.all(ctx, logger, db.db, auditEntriesTable, auditEntryColumns, helpers.NoLockRow, query, values...)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	entries := []*models.AuditEntry{}
+	for rows.Next() {
+		entry := &models.AuditEntry{
+			ModificationTagBefore: &models.ModificationTag{},
+			ModificationTagAfter:  &models.ModificationTag{},
+		}
+		var request *string
+		err = rows.Scan(
+			&entry.Timestamp,
+			&entry.Route,
+			&entry.Identity,
+			&entry.RemoteAddr,
+			&entry.TargetGuid,
+			&request,
+			&entry.ErrorType,
+			&entry.ModificationTagBefore.Epoch,
+			&entry.ModificationTagBefore.Index,
+			&entry.ModificationTagAfter.Epoch,
+			&entry.ModificationTagAfter.Index,
+		)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		if request != nil {
+			entry.Request = *request
+		}
+		if entry.ModificationTagBefore.Epoch == "" {
+			entry.ModificationTagBefore = nil
+		}
+		if entry.ModificationTagAfter.Epoch == "" {
+			entry.ModificationTagAfter = nil
+		}
+		entries = append(entries, entry)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return entries, nil
+}
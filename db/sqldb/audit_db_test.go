@@ -0,0 +1,123 @@
+package sqldb_test
+
+import (
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditDB", func() {
+	var guids int
+
+	BeforeEach(func() {
+		guids = 0
+		fakeGUIDProvider.NextGUIDStub = func() (string, error) {
+			guids++
+			return fmt.Sprintf("audit-guid-%d", guids), nil
+		}
+	})
+
+	AfterEach(func() {
+		fakeGUIDProvider.NextGUIDStub = nil
+	})
+
+	Describe("InsertAuditEntry", func() {
+		It("stores the entry", func() {
+			entry := &models.AuditEntry{
+				Timestamp:             10,
+				Route:                 "UpdateDesiredLRP",
+				Identity:              "CN=scheduler",
+				RemoteAddr:            "10.0.0.1:1234",
+				TargetGuid:            "process-guid",
+				Request:               `{"process_guid":"process-guid"}`,
+				ErrorType:             "ResourceNotFound",
+				ModificationTagBefore: &models.ModificationTag{Epoch: "epoch", Index: 1},
+				ModificationTagAfter:  &models.ModificationTag{Epoch: "epoch", Index: 2},
+			}
+			Expect(sqlDB.InsertAuditEntry(ctx, logger, entry)).To(Succeed())
+
+			entries, err := sqlDB.AuditEntries(ctx, logger, models.AuditEntryFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(Equal([]*models.AuditEntry{entry}))
+		})
+
+		It("stores entries without modification tags", func() {
+			entry := &models.AuditEntry{Timestamp: 10, Route: "CancelTask", TargetGuid: "task-guid"}
+			Expect(sqlDB.InsertAuditEntry(ctx, logger, entry)).To(Succeed())
+
+			entries, err := sqlDB.AuditEntries(ctx, logger, models.AuditEntryFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(Equal([]*models.AuditEntry{entry}))
+		})
+
+		Context("when generating a guid fails", func() {
+			BeforeEach(func() {
+				fakeGUIDProvider.NextGUIDStub = func() (string, error) {
+					return "", errors.New("no guid")
+				}
+			})
+
+			It("returns ErrGUIDGeneration", func() {
+				err := sqlDB.InsertAuditEntry(ctx, logger, &models.AuditEntry{Route: "CancelTask"})
+				Expect(err).To(Equal(models.ErrGUIDGeneration))
+			})
+		})
+	})
+
+	Describe("AuditEntries", func() {
+		BeforeEach(func() {
+			for i, route := range []string{"DesireTask", "CancelTask", "DeleteTask", "CancelTask"} {
+				entry := &models.AuditEntry{
+					Timestamp:  int64(i + 1),
+					Route:      route,
+					Identity:   fmt.Sprintf("CN=client-%d", i%2),
+					TargetGuid: fmt.Sprintf("task-guid-%d", i/2),
+				}
+				Expect(sqlDB.InsertAuditEntry(ctx, logger, entry)).To(Succeed())
+			}
+		})
+
+		timestamps := func(entries []*models.AuditEntry) []int64 {
+			result := []int64{}
+			for _, entry := range entries {
+				result = append(result, entry.Timestamp)
+			}
+			return result
+		}
+
+		It("returns the newest entries first", func() {
+			entries, err := sqlDB.AuditEntries(ctx, logger, models.AuditEntryFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timestamps(entries)).To(Equal([]int64{4, 3, 2, 1}))
+		})
+
+		It("filters by route, identity and target guid", func() {
+			entries, err := sqlDB.AuditEntries(ctx, logger, models.AuditEntryFilter{Route: "CancelTask"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timestamps(entries)).To(Equal([]int64{4, 2}))
+
+			entries, err = sqlDB.AuditEntries(ctx, logger, models.AuditEntryFilter{Identity: "CN=client-0"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timestamps(entries)).To(Equal([]int64{3, 1}))
+
+			entries, err = sqlDB.AuditEntries(ctx, logger, models.AuditEntryFilter{TargetGuid: "task-guid-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timestamps(entries)).To(Equal([]int64{4, 3}))
+		})
+
+		It("only returns entries since the given time", func() {
+			entries, err := sqlDB.AuditEntries(ctx, logger, models.AuditEntryFilter{Since: 3})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timestamps(entries)).To(Equal([]int64{4, 3}))
+		})
+
+		It("limits the number of entries", func() {
+			entries, err := sqlDB.AuditEntries(ctx, logger, models.AuditEntryFilter{Limit: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timestamps(entries)).To(Equal([]int64{4}))
+		})
+	})
+})
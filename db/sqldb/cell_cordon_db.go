@@ -0,0 +1,91 @@
+package sqldb
+
+import (
+	"context"
+	"sort"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+func (db *SQLDB) CellCordons(ctx context.Context, logger lager.Logger) ([]*models.CellCordon, error) {
+	logger = logger.Session("db-cell-cordons")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(ctx, logger, db.db, cellCordonsTable, cellCordonColumns, helpers.NoLockRow, "")
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	cordons := []*models.CellCordon{}
+	for rows.Next() {
+		cordon := &models.CellCordon{}
+		err = rows.Scan(&cordon.CellId, &cordon.Draining, &cordon.Reason, &cordon.UpdatedAt)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+		cordons = append(cordons, cordon)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	sort.Slice(cordons, func(i, j int) bool { return cordons[i].CellId < cordons[j].CellId })
+	return cordons, nil
+}
+
+func (db *SQLDB) UpsertCellCordon(ctx context.Context, logger lager.Logger, cordon *models.CellCordon) error {
+	logger = logger.Session("db-upsert-cell-cordon", lager.Data{"cordon": cordon})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.upsert(ctx, logger, tx, cellCordonsTable,
+			helpers.SQLAttributes{
+				"cell_id":    cordon.CellId,
+				"draining":   cordon.Draining,
+				"reason":     cordon.Reason,
+				"updated_at": cordon.UpdatedAt,
+			},
+			"cell_id = ?", cordon.CellId,
+		)
+		if err != nil {
+			logger.Error("failed-upserting-cell-cordon", err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// RemoveCellCordon returns ErrResourceNotFound when the cell is not cordoned.
+func (db *SQLDB) RemoveCellCordon(ctx context.Context, logger lager.Logger, cellID string) error {
+	logger = logger.Session("db-remove-cell-cordon", lager.Data{"cell_id": cellID})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	result, err := db.delete(ctx, logger, db.db, cellCordonsTable, "cell_id = ?", cellID)
+	if err != nil {
+		logger.Error("failed-deleting-cell-cordon", err)
+		return db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-getting-rows-affected", err)
+		return db.convertSQLError(err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	return nil
+}
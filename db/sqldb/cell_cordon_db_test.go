@@ -0,0 +1,72 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CellCordonDB", func() {
+	Describe("CellCordons", func() {
+		Context("when no cell is cordoned", func() {
+			It("returns no cordons", func() {
+				cordons, err := sqlDB.CellCordons(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cordons).To(BeEmpty())
+			})
+		})
+
+		Context("when cells are cordoned", func() {
+			BeforeEach(func() {
+				err := sqlDB.UpsertCellCordon(ctx, logger, &models.CellCordon{CellId: "cell-2", Draining: true, Reason: "decommission", UpdatedAt: 5678})
+				Expect(err).NotTo(HaveOccurred())
+				err = sqlDB.UpsertCellCordon(ctx, logger, &models.CellCordon{CellId: "cell-1", Reason: "kernel upgrade", UpdatedAt: 1234})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns their cordons ordered by cell id", func() {
+				cordons, err := sqlDB.CellCordons(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cordons).To(Equal([]*models.CellCordon{
+					{CellId: "cell-1", Reason: "kernel upgrade", UpdatedAt: 1234},
+					{CellId: "cell-2", Draining: true, Reason: "decommission", UpdatedAt: 5678},
+				}))
+			})
+
+			It("replaces the cordon of a cell when upserted again", func() {
+				err := sqlDB.UpsertCellCordon(ctx, logger, &models.CellCordon{CellId: "cell-1", Draining: true, UpdatedAt: 9999})
+				Expect(err).NotTo(HaveOccurred())
+
+				cordons, err := sqlDB.CellCordons(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cordons).To(HaveLen(2))
+				Expect(cordons[0]).To(Equal(&models.CellCordon{CellId: "cell-1", Draining: true, UpdatedAt: 9999}))
+			})
+		})
+	})
+
+	Describe("RemoveCellCordon", func() {
+		Context("when the cell is cordoned", func() {
+			BeforeEach(func() {
+				err := sqlDB.UpsertCellCordon(ctx, logger, &models.CellCordon{CellId: "cell-1"})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("removes the cordon", func() {
+				err := sqlDB.RemoveCellCordon(ctx, logger, "cell-1")
+				Expect(err).NotTo(HaveOccurred())
+
+				cordons, err := sqlDB.CellCordons(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cordons).To(BeEmpty())
+			})
+		})
+
+		Context("when the cell is not cordoned", func() {
+			It("returns ErrResourceNotFound", func() {
+				err := sqlDB.RemoveCellCordon(ctx, logger, "cell-1")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+	})
+})
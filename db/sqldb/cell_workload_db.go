@@ -0,0 +1,160 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// CellWorkloads returns, for every cell that has claimed or running LRP
+// instances or tasks, the counts of that work and the resources it reserves.
+// An instance reserves the memory and disk of its DesiredLRP whatever its
+// presence, and so does a running task; completed tasks reserve nothing.
+func (db *SQLDB) CellWorkloads(ctx context.Context, logger lager.Logger) ([]*models.CellWorkload, error) {
+	logger = logger.Session("db-cell-workloads")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	workloads := map[string]*models.CellWorkload{}
+	workloadFor := func(cellID string) *models.CellWorkload {
+		workload, ok := workloads[cellID]
+		if !ok {
+			workload = &models.CellWorkload{
+				CellId:     cellID,
+				ActualLrps: &models.CellActualLRPCounts{},
+				Tasks:      &models.CellTaskCounts{},
+				Reserved:   &models.CellCapacity{},
+			}
+			workloads[cellID] = workload
+		}
+		return workload
+	}
+
+	err := db.addActualLRPWorkloads(ctx, logger, workloadFor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.addTaskWorkloads(ctx, logger, workloadFor)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.CellWorkload, 0, len(workloads))
+	for _, workload := range workloads {
+		result = append(result, workload)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CellId < result[j].CellId })
+	return result, nil
+}
+
+func (db *SQLDB) addActualLRPWorkloads(ctx context.Context, logger lager.Logger, workloadFor func(string) *models.CellWorkload) error {
+	query := fmt.Sprintf(`
+		SELECT actual_lrps.cell_id, actual_lrps.presence, actual_lrps.state, COUNT(*),
+			COALESCE(SUM(desired_lrps.memory_mb), 0), COALESCE(SUM(desired_lrps.disk_mb), 0)
+			FROM %s
+			LEFT JOIN %s ON actual_lrps.process_guid = desired_lrps.process_guid
+			WHERE actual_lrps.cell_id <> '' AND actual_lrps.state IN (?, ?)
+			GROUP BY actual_lrps.cell_id, actual_lrps.presence, actual_lrps.state
+		`,
+		actualLRPsTable,
+		desiredLRPsTable,
+	)
+
+	rows, err := db.db.QueryContext(ctx, db.helper.Rebind(query), models.ActualLRPStateClaimed, models.ActualLRPStateRunning)
+	if err != nil {
+		logger.Error("failed-query-actual-lrps", err)
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cellID, state string
+		var presence models.ActualLRP_Presence
+		var count, memoryMB, diskMB int64
+		err = rows.Scan(&cellID, &presence, &state, &count, &memoryMB, &diskMB)
+		if err != nil {
+			logger.Error("failed-scanning-actual-lrp-row", err)
+			return db.convertSQLError(err)
+		}
+
+		workload := workloadFor(cellID)
+		switch state {
+		case models.ActualLRPStateClaimed:
+			workload.ActualLrps.Claimed += int32(count)
+		case models.ActualLRPStateRunning:
+			workload.ActualLrps.Running += int32(count)
+		}
+		switch presence {
+		case models.ActualLRP_Suspect:
+			workload.ActualLrps.Suspect += int32(count)
+		case models.ActualLRP_Evacuating:
+			workload.ActualLrps.Evacuating += int32(count)
+		}
+		workload.Reserved.MemoryMb += int32(memoryMB)
+		workload.Reserved.DiskMb += int32(diskMB)
+		workload.Reserved.Containers += int32(count)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-actual-lrp-row", rows.Err())
+		return db.convertSQLError(rows.Err())
+	}
+	return nil
+}
+
+func (db *SQLDB) addTaskWorkloads(ctx context.Context, logger lager.Logger, workloadFor func(string) *models.CellWorkload) error {
+	rows, err := db.all(ctx, logger, db.db, tasksTable,
+		helpers.ColumnList{"cell_id", "state", "task_definition"}, helpers.NoLockRow,
+		"cell_id <> '' AND state IN (?, ?, ?)",
+		models.Task_Running, models.Task_Completed, models.Task_Resolving,
+	)
+	if err != nil {
+		logger.Error("failed-query-tasks", err)
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cellID string
+		var state models.Task_State
+		var taskDefData []byte
+		err = rows.Scan(&cellID, &state, &taskDefData)
+		if err != nil {
+			logger.Error("failed-scanning-task-row", err)
+			return db.convertSQLError(err)
+		}
+
+		workload := workloadFor(cellID)
+		switch state {
+		case models.Task_Completed:
+			workload.Tasks.Completed++
+			continue
+		case models.Task_Resolving:
+			workload.Tasks.Resolving++
+			continue
+		}
+
+		workload.Tasks.Running++
+		var taskDef models.TaskDefinition
+		err = db.deserializeModel(logger, taskDefData, &taskDef)
+		if err != nil {
+			// count the task without its resources; fetching tasks deletes undecodable ones
+			logger.Error("failed-deserializing-task-definition", err, lager.Data{"cell_id": cellID})
+			continue
+		}
+		workload.Reserved.MemoryMb += taskDef.MemoryMb
+		workload.Reserved.DiskMb += taskDef.DiskMb
+		workload.Reserved.Containers++
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-task-row", rows.Err())
+		return db.convertSQLError(rows.Err())
+	}
+	return nil
+}
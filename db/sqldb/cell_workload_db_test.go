@@ -0,0 +1,107 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CellWorkloadDB", func() {
+	Describe("CellWorkloads", func() {
+		Context("when no work is placed", func() {
+			It("returns no workloads", func() {
+				workloads, err := sqlDB.CellWorkloads(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(workloads).To(BeEmpty())
+			})
+		})
+
+		Context("when LRP instances and tasks are placed on cells", func() {
+			BeforeEach(func() {
+				desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+				desiredLRP.MemoryMb = 256
+				desiredLRP.DiskMb = 512
+				err := sqlDB.DesireLRP(ctx, logger, desiredLRP)
+				Expect(err).NotTo(HaveOccurred())
+
+				netInfo := &models.ActualLRPNetInfo{Address: "0.0.0.0", Ports: []*models.PortMapping{}, InstanceAddress: "1.1.1.1"}
+				cell1Key := models.NewActualLRPInstanceKey("ig-1", "cell-1")
+				cell2Key := models.NewActualLRPInstanceKey("ig-2", "cell-2")
+
+				runningKey := models.NewActualLRPKey("some-guid", 0, desiredLRP.Domain)
+				_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &runningKey)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.StartActualLRP(ctx, logger, &runningKey, &cell1Key, netInfo)
+				Expect(err).NotTo(HaveOccurred())
+
+				claimedKey := models.NewActualLRPKey("some-guid", 1, desiredLRP.Domain)
+				_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &claimedKey)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.ClaimActualLRP(ctx, logger, "some-guid", 1, &cell1Key)
+				Expect(err).NotTo(HaveOccurred())
+
+				suspectKey := models.NewActualLRPKey("some-guid", 2, desiredLRP.Domain)
+				_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &suspectKey)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.StartActualLRP(ctx, logger, &suspectKey, &cell2Key, netInfo)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.ChangeActualLRPPresence(ctx, logger, &suspectKey, models.ActualLRP_Ordinary, models.ActualLRP_Suspect)
+				Expect(err).NotTo(HaveOccurred())
+
+				evacuatingKey := models.NewActualLRPKey("some-guid", 3, desiredLRP.Domain)
+				_, err = sqlDB.EvacuateActualLRP(ctx, logger, &evacuatingKey, &cell2Key, netInfo)
+				Expect(err).NotTo(HaveOccurred())
+
+				undesiredKey := models.NewActualLRPKey("undesired-guid", 0, "some-domain")
+				_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &undesiredKey)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.StartActualLRP(ctx, logger, &undesiredKey, &cell2Key, netInfo)
+				Expect(err).NotTo(HaveOccurred())
+
+				unclaimedKey := models.NewActualLRPKey("some-guid", 4, desiredLRP.Domain)
+				_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &unclaimedKey)
+				Expect(err).NotTo(HaveOccurred())
+
+				taskDef := model_helpers.NewValidTaskDefinition()
+				taskDef.MemoryMb = 128
+				taskDef.DiskMb = 64
+				for _, guid := range []string{"pending-task", "running-task", "completed-task", "resolving-task"} {
+					_, err = sqlDB.DesireTask(ctx, logger, taskDef, guid, "some-domain")
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "running-task", "cell-1")
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, guid := range []string{"completed-task", "resolving-task"} {
+					_, _, _, err = sqlDB.StartTask(ctx, logger, guid, "cell-2")
+					Expect(err).NotTo(HaveOccurred())
+					_, _, err = sqlDB.CompleteTask(ctx, logger, guid, "cell-2", false, "", "result")
+					Expect(err).NotTo(HaveOccurred())
+				}
+				_, _, err = sqlDB.ResolvingTask(ctx, logger, "resolving-task")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("counts the work on each cell and the resources it reserves", func() {
+				workloads, err := sqlDB.CellWorkloads(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(workloads).To(Equal([]*models.CellWorkload{
+					{
+						CellId:     "cell-1",
+						ActualLrps: &models.CellActualLRPCounts{Claimed: 1, Running: 1},
+						Tasks:      &models.CellTaskCounts{Running: 1},
+						Reserved:   &models.CellCapacity{MemoryMb: 640, DiskMb: 1088, Containers: 3},
+					},
+					{
+						CellId:     "cell-2",
+						ActualLrps: &models.CellActualLRPCounts{Running: 3, Suspect: 1, Evacuating: 1},
+						Tasks:      &models.CellTaskCounts{Completed: 1, Resolving: 1},
+						Reserved:   &models.CellCapacity{MemoryMb: 512, DiskMb: 1024, Containers: 3},
+					},
+				}))
+			})
+		})
+	})
+})
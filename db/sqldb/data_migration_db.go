@@ -0,0 +1,51 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+const dataMigrationIDPrefix = "data_migration_"
+
+func dataMigrationID(version int64) string {
+	return fmt.Sprintf("%s%d", dataMigrationIDPrefix, version)
+}
+
+func (sqldb *SQLDB) SetDataMigrationProgress(ctx context.Context, logger lager.Logger, version int64, progress *db.DataMigrationProgress) error {
+	logger = logger.Session("db-set-data-migration-progress", lager.Data{"version": version, "progress": progress})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	progressJSON, err := json.Marshal(progress)
+	if err != nil {
+		logger.Error("failed-marshalling-progress", err)
+		return err
+	}
+
+	return sqldb.setConfigurationValue(ctx, logger, dataMigrationID(version), string(progressJSON))
+}
+
+func (sqldb *SQLDB) DataMigrationProgress(ctx context.Context, logger lager.Logger, version int64) (*db.DataMigrationProgress, error) {
+	logger = logger.Session("db-data-migration-progress", lager.Data{"version": version})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	progressJSON, err := sqldb.getConfigurationValue(ctx, logger, dataMigrationID(version))
+	if err != nil {
+		return nil, err
+	}
+
+	var progress db.DataMigrationProgress
+	err = json.Unmarshal([]byte(progressJSON), &progress)
+	if err != nil {
+		logger.Error("failed-to-deserialize-progress", err)
+		return nil, models.ErrDeserialize
+	}
+
+	return &progress, nil
+}
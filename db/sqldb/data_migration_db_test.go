@@ -0,0 +1,54 @@
+package sqldb_test
+
+import (
+	dbpkg "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DataMigrationDB", func() {
+	Describe("DataMigrationProgress", func() {
+		Context("when no progress has been recorded", func() {
+			It("returns a ErrResourceNotFound", func() {
+				_, err := sqlDB.DataMigrationProgress(ctx, logger, 42)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when the stored progress is invalid", func() {
+			BeforeEach(func() {
+				queryStr := "INSERT INTO configurations (id, value) VALUES (?, ?)"
+				_, err := db.ExecContext(ctx, helpers.RebindForFlavor(queryStr, dbDriverName), "data_migration_42", "{{")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a deserialization error", func() {
+				_, err := sqlDB.DataMigrationProgress(ctx, logger, 42)
+				Expect(err).To(Equal(models.ErrDeserialize))
+			})
+		})
+	})
+
+	Describe("SetDataMigrationProgress", func() {
+		It("stores the progress per migration version", func() {
+			progress := &dbpkg.DataMigrationProgress{Checkpoint: "some-guid", RowsProcessed: 1000}
+			Expect(sqlDB.SetDataMigrationProgress(ctx, logger, 42, progress)).To(Succeed())
+			Expect(sqlDB.SetDataMigrationProgress(ctx, logger, 43, &dbpkg.DataMigrationProgress{Done: true})).To(Succeed())
+
+			actual, err := sqlDB.DataMigrationProgress(ctx, logger, 42)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(progress))
+		})
+
+		It("overwrites earlier progress", func() {
+			Expect(sqlDB.SetDataMigrationProgress(ctx, logger, 42, &dbpkg.DataMigrationProgress{Checkpoint: "a"})).To(Succeed())
+			Expect(sqlDB.SetDataMigrationProgress(ctx, logger, 42, &dbpkg.DataMigrationProgress{Checkpoint: "b", Done: true})).To(Succeed())
+
+			actual, err := sqlDB.DataMigrationProgress(ctx, logger, 42)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(&dbpkg.DataMigrationProgress{Checkpoint: "b", Done: true}))
+		})
+	})
+})
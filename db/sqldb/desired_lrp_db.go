@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -55,11 +56,18 @@ func (db *SQLDB) DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP
 			return err
 		}
 
+		disruptionBudgetData, err := encodeDisruptionBudget(desiredLRP.DisruptionBudget)
+		if err != nil {
+			logger.Error("failed-to-serialize-model", err)
+			return err
+		}
+
 		desiredLRP.ModificationTag = &models.ModificationTag{Epoch: guid, Index: 0}
 
 		_, err = db.insert(ctx, logger, tx, desiredLRPsTable,
 			helpers.SQLAttributes{
 				"process_guid":           desiredLRP.ProcessGuid,
+				"process_guid_hash":      processGuidHash(desiredLRP.ProcessGuid),
 				"domain":                 desiredLRP.Domain,
 				"log_guid":               desiredLRP.LogGuid,
 				"annotation":             desiredLRP.Annotation,
@@ -74,6 +82,7 @@ func (db *SQLDB) DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP
 				"routes":                 routesData,
 				"run_info":               runInfoData,
 				"placement_tags":         placementTagData,
+				"disruption_budget":      disruptionBudgetData,
 			},
 		)
 		if err != nil {
@@ -118,6 +127,14 @@ func (db *SQLDB) DesiredLRPs(ctx context.Context, logger lager.Logger, filter mo
 		values = append(values, filter.Domain)
 	}
 
+	if len(filter.Domains) > 0 {
+		wheres = append(wheres, whereClauseForDomains(filter.Domains))
+
+		for _, domain := range filter.Domains {
+			values = append(values, domain)
+		}
+	}
+
 	if len(filter.ProcessGuids) > 0 {
 		wheres = append(wheres, whereClauseForProcessGuids(filter.ProcessGuids))
 
@@ -164,6 +181,14 @@ func (db *SQLDB) DesiredLRPSchedulingInfos(ctx context.Context, logger lager.Log
 		values = append(values, filter.Domain)
 	}
 
+	if len(filter.Domains) > 0 {
+		wheres = append(wheres, whereClauseForDomains(filter.Domains))
+
+		for _, domain := range filter.Domains {
+			values = append(values, domain)
+		}
+	}
+
 	if len(filter.ProcessGuids) > 0 {
 		wheres = append(wheres, whereClauseForProcessGuids(filter.ProcessGuids))
 
@@ -242,6 +267,15 @@ func (db *SQLDB) UpdateDesiredLRP(ctx context.Context, logger lager.Logger, proc
 			updateAttributes["routes"] = encodedData
 		}
 
+		if update.DisruptionBudget != nil {
+			disruptionBudgetData, err := encodeDisruptionBudget(update.DisruptionBudget)
+			if err != nil {
+				logger.Error("failed-to-serialize-model", err)
+				return err
+			}
+			updateAttributes["disruption_budget"] = disruptionBudgetData
+		}
+
 		_, err = db.update(ctx, logger, tx, desiredLRPsTable, updateAttributes, `process_guid = ?`, processGuid)
 		if err != nil {
 			logger.Error("failed-executing-query", err)
@@ -268,6 +302,23 @@ func (db *SQLDB) encodeRouteData(logger lager.Logger, routes *models.Routes) ([]
 	return encodedData, nil
 }
 
+// decodeRouteData returns routes the encrypt_routes data migration has not
+// reached yet as they are.
+func (db *SQLDB) decodeRouteData(routeData []byte) ([]byte, error) {
+	if !format.IsEncoded(routeData) {
+		return routeData, nil
+	}
+	return db.encoder.Decode(routeData)
+}
+
+// encodeDisruptionBudget stores a budget that is not set as NULL.
+func encodeDisruptionBudget(budget *models.DisruptionBudget) (interface{}, error) {
+	if !budget.IsSet() {
+		return nil, nil
+	}
+	return json.Marshal(budget)
+}
+
 func (db *SQLDB) RemoveDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string) error {
 	logger = logger.Session("db-remove-desired-lrp", lager.Data{"process_guid": processGuid})
 	logger.Info("starting")
@@ -293,7 +344,7 @@ func (db *SQLDB) RemoveDesiredLRP(ctx context.Context, logger lager.Logger, proc
 // "rows" needs to have the columns defined in the schedulingInfoColumns constant
 func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scanner helpers.RowScanner, dest ...interface{}) (*models.DesiredLRPSchedulingInfo, error) {
 	schedulingInfo := &models.DesiredLRPSchedulingInfo{}
-	var routeData, volumePlacementData, placementTagData []byte
+	var routeData, volumePlacementData, placementTagData, disruptionBudgetData []byte
 	values := []interface{}{
 		&schedulingInfo.ProcessGuid,
 		&schedulingInfo.Domain,
@@ -309,6 +360,7 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 		&schedulingInfo.ModificationTag.Epoch,
 		&schedulingInfo.ModificationTag.Index,
 		&placementTagData,
+		&disruptionBudgetData,
 	}
 	values = append(values, dest...)
 
@@ -323,7 +375,7 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 	}
 
 	var routes models.Routes
-	encodedData, err := db.encoder.Decode(routeData)
+	encodedData, err := db.decodeRouteData(routeData)
 	if err != nil {
 		logger.Error("failed-decrypting-routes", err)
 		return nil, err
@@ -349,6 +401,14 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 			return nil, err
 		}
 	}
+	if disruptionBudgetData != nil {
+		schedulingInfo.DisruptionBudget = &models.DisruptionBudget{}
+		err = json.Unmarshal(disruptionBudgetData, schedulingInfo.DisruptionBudget)
+		if err != nil {
+			logger.Error("failed-parsing-disruption-budget", err)
+			return nil, err
+		}
+	}
 
 	return schedulingInfo, nil
 }
@@ -446,6 +506,18 @@ func whereClauseForProcessGuids(filter []string) string {
 	return where + ")"
 }
 
+func whereClauseForDomains(filter []string) string {
+	var questionMarks []string
+
+	where := "domain IN ("
+	for range filter {
+		questionMarks = append(questionMarks, "?")
+	}
+
+	where += strings.Join(questionMarks, ", ")
+	return where + ")"
+}
+
 func dedupSlice(ints []uint32) []uint32 {
 	if ints == nil {
 		// this is really here to make some tests happy, otherwise we replace the
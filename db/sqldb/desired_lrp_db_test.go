@@ -28,6 +28,17 @@ var _ = Describe("DesiredLRPDB", func() {
 			Expect(desiredLRP).To(Equal(expectedDesiredLRP))
 		})
 
+		It("saves the disruption budget", func() {
+			expectedDesiredLRP.DisruptionBudget = &models.DisruptionBudget{MinAvailable: 2}
+			err := sqlDB.DesireLRP(ctx, logger, expectedDesiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			schedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{"the-guid"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedulingInfos).To(HaveLen(1))
+			Expect(schedulingInfos[0].DisruptionBudget).To(Equal(&models.DisruptionBudget{MinAvailable: 2}))
+		})
+
 		Context("when the process_guid is already taken", func() {
 			BeforeEach(func() {
 				err := sqlDB.DesireLRP(ctx, logger, expectedDesiredLRP)
@@ -125,6 +136,26 @@ var _ = Describe("DesiredLRPDB", func() {
 				Expect(desiredLRP).To(BeNil())
 			})
 		})
+
+		Context("when the routes have not been encrypted yet", func() {
+			BeforeEach(func() {
+				routeData, err := json.Marshal(expectedDesiredLRP.Routes)
+				Expect(err).NotTo(HaveOccurred())
+
+				queryStr := `UPDATE desired_lrps SET routes = ? WHERE process_guid = ?`
+				if test_helpers.UsePostgres() {
+					queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+				}
+				_, err = db.ExecContext(ctx, queryStr, routeData, expectedDesiredLRP.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the routes", func() {
+				desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, expectedDesiredLRP.ProcessGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRP.Routes).To(Equal(expectedDesiredLRP.Routes))
+			})
+		})
 	})
 
 	Describe("DesiredLRPs", func() {
@@ -207,6 +238,14 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when filtering by a list of domains", func() {
+			It("returns the desired lrps in any of the domains", func() {
+				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{Domains: []string{"domain-1", "domain-3"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(ConsistOf(expectedDesiredLRPs[0], expectedDesiredLRPs[2]))
+			})
+		})
+
 		Context("when filtering by process guids", func() {
 			It("returns the filtered desired lrps", func() {
 				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{"d-1", "d-3"}})
@@ -297,6 +336,14 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when filtering by a list of domains", func() {
+			It("returns the scheduling infos in any of the domains", func() {
+				desiredLRPSchedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(ctx, logger, models.DesiredLRPFilter{Domains: []string{"domain-1", "domain-3"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPSchedulingInfos).To(ConsistOf(expectedDesiredLRPSchedulingInfos[0], expectedDesiredLRPSchedulingInfos[2]))
+			})
+		})
+
 		Context("when filtering by process guids", func() {
 			It("returns the filtered schedulig infos", func() {
 				filter := models.DesiredLRPFilter{ProcessGuids: []string{"d-1", "d-3"}}
@@ -387,6 +434,24 @@ var _ = Describe("DesiredLRPDB", func() {
 			Expect(desiredLRP).To(BeEquivalentTo(expectedDesiredLRP))
 		})
 
+		It("sets and clears the disruption budget", func() {
+			update = &models.DesiredLRPUpdate{DisruptionBudget: &models.DisruptionBudget{MaxUnavailable: 1}}
+			_, err := sqlDB.UpdateDesiredLRP(ctx, logger, expectedDesiredLRP.ProcessGuid, update)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, expectedDesiredLRP.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRP.DisruptionBudget).To(Equal(&models.DisruptionBudget{MaxUnavailable: 1}))
+
+			update = &models.DesiredLRPUpdate{DisruptionBudget: &models.DisruptionBudget{}}
+			_, err = sqlDB.UpdateDesiredLRP(ctx, logger, expectedDesiredLRP.ProcessGuid, update)
+			Expect(err).NotTo(HaveOccurred())
+
+			desiredLRP, err = sqlDB.DesiredLRPByProcessGuid(ctx, logger, expectedDesiredLRP.ProcessGuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRP.DisruptionBudget).To(BeNil())
+		})
+
 		It("updates only the modification tag if update is empty", func() {
 			update = &models.DesiredLRPUpdate{}
 			_, err := sqlDB.UpdateDesiredLRP(ctx, logger, expectedDesiredLRP.ProcessGuid, update)
@@ -2,14 +2,19 @@ package sqldb
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
 
 const EncryptionKeyID = "encryption_key_label"
+const RotatedEncryptionKeyID = "rotated_encryption_key"
 
 func (db *SQLDB) SetEncryptionKeyLabel(ctx context.Context, logger lager.Logger, label string) error {
 	logger = logger.Session("db-set-encrption-key-label", lager.Data{"label": label})
@@ -27,26 +32,69 @@ func (db *SQLDB) EncryptionKeyLabel(ctx context.Context, logger lager.Logger) (s
 	return db.getConfigurationValue(ctx, logger, EncryptionKeyID)
 }
 
-func (db *SQLDB) PerformEncryption(ctx context.Context, logger lager.Logger) error {
-	errCh := make(chan error)
+func (sqldb *SQLDB) SetRotatedEncryptionKey(ctx context.Context, logger lager.Logger, rotated *db.RotatedEncryptionKey) error {
+	logger = logger.Session("db-set-rotated-encryption-key", lager.Data{"rotated": rotated})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rotatedJSON, err := json.Marshal(rotated)
+	if err != nil {
+		logger.Error("failed-marshalling-rotated-encryption-key", err)
+		return err
+	}
+
+	return sqldb.setConfigurationValue(ctx, logger, RotatedEncryptionKeyID, string(rotatedJSON))
+}
+
+// RotatedEncryptionKey returns the last rotation of the active encryption key,
+// or ErrResourceNotFound when the key was never rotated.
+func (sqldb *SQLDB) RotatedEncryptionKey(ctx context.Context, logger lager.Logger) (*db.RotatedEncryptionKey, error) {
+	logger = logger.Session("db-rotated-encryption-key")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rotatedJSON, err := sqldb.getConfigurationValue(ctx, logger, RotatedEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
 
-	funcs := []func(){
-		func() {
-			errCh <- db.reEncrypt(ctx, logger, tasksTable, "guid", true, "task_definition")
-		},
-		func() {
-			errCh <- db.reEncrypt(ctx, logger, desiredLRPsTable, "process_guid", true, "run_info", "volume_placement", "routes")
-		},
-		func() {
-			errCh <- db.reEncrypt(ctx, logger, actualLRPsTable, "process_guid", false, "net_info")
-		},
+	var rotated db.RotatedEncryptionKey
+	err = json.Unmarshal([]byte(rotatedJSON), &rotated)
+	if err != nil {
+		logger.Error("failed-to-deserialize-rotated-encryption-key", err)
+		return nil, models.ErrDeserialize
 	}
 
-	for _, f := range funcs {
-		go f()
+	return &rotated, nil
+}
+
+type encryptedTable struct {
+	primaryKey     string
+	encryptIfEmpty bool
+	blobColumns    []string
+}
+
+var encryptedTables = map[string]encryptedTable{
+	tasksTable:       {primaryKey: "guid", encryptIfEmpty: true, blobColumns: []string{"task_definition"}},
+	desiredLRPsTable: {primaryKey: "process_guid", encryptIfEmpty: true, blobColumns: []string{"run_info", "volume_placement", "routes"}},
+	actualLRPsTable:  {primaryKey: "process_guid", encryptIfEmpty: false, blobColumns: []string{"net_info"}},
+}
+
+func (db *SQLDB) EncryptedTables() []string {
+	return []string{tasksTable, desiredLRPsTable, actualLRPsTable}
+}
+
+func (db *SQLDB) PerformEncryption(ctx context.Context, logger lager.Logger) error {
+	tables := db.EncryptedTables()
+	errCh := make(chan error)
+
+	for _, table := range tables {
+		go func(table string) {
+			errCh <- db.ReEncryptTable(ctx, logger, table, nil)
+		}(table)
 	}
 
-	for range funcs {
+	for range tables {
 		err := <-errCh
 		if err != nil {
 			return err
@@ -55,7 +103,72 @@ func (db *SQLDB) PerformEncryption(ctx context.Context, logger lager.Logger) err
 	return nil
 }
 
-func (db *SQLDB) reEncrypt(ctx context.Context, logger lager.Logger, tableName, primaryKey string, encryptIfEmpty bool, blobColumns ...string) error {
+func (sqldb *SQLDB) ReEncryptTable(ctx context.Context, logger lager.Logger, tableName string, progress db.EncryptionProgressFunc) error {
+	table, ok := encryptedTables[tableName]
+	if !ok {
+		return fmt.Errorf("table %q is not encrypted", tableName)
+	}
+
+	return sqldb.reEncrypt(ctx, logger, tableName, table.primaryKey, table.encryptIfEmpty, progress, table.blobColumns...)
+}
+
+// EncryptionKeyUsage returns, per table, the number of rows that still
+// contain data encrypted with the given key.
+func (db *SQLDB) EncryptionKeyUsage(ctx context.Context, logger lager.Logger, label string) (map[string]int, error) {
+	logger = logger.Session("db-encryption-key-usage", lager.Data{"label": label})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	usage := map[string]int{}
+	for _, tableName := range db.EncryptedTables() {
+		table := encryptedTables[tableName]
+		count, err := db.countRowsWithKeyLabel(ctx, logger, tableName, table.blobColumns, label)
+		if err != nil {
+			return nil, err
+		}
+		usage[tableName] = count
+	}
+	return usage, nil
+}
+
+func (db *SQLDB) countRowsWithKeyLabel(ctx context.Context, logger lager.Logger, tableName string, blobColumns []string, label string) (int, error) {
+	rows, err := db.db.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s", strings.Join(blobColumns, ", "), tableName))
+	if err != nil {
+		logger.Error("failed-query", err, lager.Data{"table_name": tableName})
+		return 0, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		blobs := make([]interface{}, len(blobColumns))
+		for i := range blobColumns {
+			var blob []byte
+			blobs[i] = &blob
+		}
+
+		err := rows.Scan(blobs...)
+		if err != nil {
+			logger.Error("failed-to-scan-blob", err, lager.Data{"table_name": tableName})
+			continue
+		}
+
+		for _, blob := range blobs {
+			blobLabel, err := format.KeyLabel(*blob.(*[]byte))
+			if err == nil && blobLabel == label {
+				count++
+				break
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, db.convertSQLError(err)
+	}
+	return count, nil
+}
+
+func (db *SQLDB) reEncrypt(ctx context.Context, logger lager.Logger, tableName, primaryKey string, encryptIfEmpty bool, progress func(processed, total int), blobColumns ...string) error {
 	logger = logger.WithData(
 		lager.Data{"table_name": tableName, "primary_key": primaryKey, "blob_columns": blobColumns},
 	)
@@ -77,7 +190,7 @@ func (db *SQLDB) reEncrypt(ctx context.Context, logger lager.Logger, tableName,
 	}
 
 	where := fmt.Sprintf("%s = ?", primaryKey)
-	for _, guid := range guids {
+	for i, guid := range guids {
 		err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
 			blobs := make([]interface{}, len(blobColumns))
 
@@ -105,19 +218,23 @@ func (db *SQLDB) reEncrypt(ctx context.Context, logger lager.Logger, tableName,
 					return nil
 				}
 
-				encoder := format.NewEncoder(db.cryptor)
-				payload, err := encoder.Decode(blob)
+				columnName := blobColumns[columnIdx]
+				var payload []byte
+				if columnName == "routes" {
+					payload, err = db.decodeRouteData(blob)
+				} else {
+					payload, err = db.encoder.Decode(blob)
+				}
 				if err != nil {
 					logger.Error("failed-to-decode-blob", err)
 					return nil
 				}
-				encryptedPayload, err := encoder.Encode(payload)
+				encryptedPayload, err := db.encoder.Encode(payload)
 				if err != nil {
 					logger.Error("failed-to-encode-blob", err)
 					return err
 				}
 
-				columnName := blobColumns[columnIdx]
 				updatedColumnValues[columnName] = encryptedPayload
 			}
 			_, err = db.update(ctx, logger, tx, tableName,
@@ -134,6 +251,10 @@ func (db *SQLDB) reEncrypt(ctx context.Context, logger lager.Logger, tableName,
 		if err != nil {
 			return err
 		}
+
+		if progress != nil {
+			progress(i+1, len(guids))
+		}
 	}
 	return nil
 }
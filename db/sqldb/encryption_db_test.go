@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	dbpkg "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/sqldb"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/format"
@@ -107,6 +108,27 @@ var _ = Describe("Encryption", func() {
 		})
 	})
 
+	Describe("RotatedEncryptionKey", func() {
+		Context("when a rotation was stored", func() {
+			It("retrieves the last rotation", func() {
+				Expect(sqlDB.SetRotatedEncryptionKey(ctx, logger, &dbpkg.RotatedEncryptionKey{Label: "first", ConfiguredLabel: "configured"})).To(Succeed())
+				Expect(sqlDB.SetRotatedEncryptionKey(ctx, logger, &dbpkg.RotatedEncryptionKey{Label: "second", ConfiguredLabel: "configured"})).To(Succeed())
+
+				rotated, err := sqlDB.RotatedEncryptionKey(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rotated).To(Equal(&dbpkg.RotatedEncryptionKey{Label: "second", ConfiguredLabel: "configured"}))
+			})
+		})
+
+		Context("when the key was never rotated", func() {
+			It("returns a ErrResourceNotFound", func() {
+				rotated, err := sqlDB.RotatedEncryptionKey(ctx, logger)
+				Expect(err).To(MatchError(models.ErrResourceNotFound))
+				Expect(rotated).To(BeNil())
+			})
+		})
+	})
+
 	makeCryptor := func(activeLabel string, decryptionLabels ...string) encryption.Cryptor {
 		activeKey, err := encryption.NewKey(activeLabel, fmt.Sprintf("%s-passphrase", activeLabel))
 		Expect(err).NotTo(HaveOccurred())
@@ -173,7 +195,7 @@ var _ = Describe("Encryption", func() {
 			Expect(err).NotTo(HaveOccurred())
 			cryptor = makeCryptor("new", "old")
 
-			sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+			sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, format.NoCompression, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 			err = sqlDB.PerformEncryption(ctx, logger)
 			Expect(err).NotTo(HaveOccurred())
 
@@ -240,7 +262,7 @@ var _ = Describe("Encryption", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				cryptor = makeCryptor("new", "old")
-				sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+				sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, format.NoCompression, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 				err = sqlDB.PerformEncryption(ctx, logger)
 				Expect(err).NotTo(HaveOccurred())
 			})
@@ -312,9 +334,67 @@ var _ = Describe("Encryption", func() {
 
 			cryptor = makeCryptor("new", "old")
 
-			sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+			sqlDB := sqldb.NewSQLDB(db, 5, 5, cryptor, format.NoCompression, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 			err = sqlDB.PerformEncryption(ctx, logger)
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+	Describe("ReEncryptTable", func() {
+		BeforeEach(func() {
+			encoder := format.NewEncoder(makeCryptor("old"))
+			queryStr := "INSERT INTO tasks (guid, domain, task_definition) VALUES (?, ?, ?)"
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			for _, guid := range []string{"task-1", "task-2"} {
+				encoded, err := encoder.Encode([]byte(guid))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = db.ExecContext(ctx, queryStr, guid, "fake-domain", encoded)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("re-encrypts the table and reports its progress", func() {
+			sqlDB := sqldb.NewSQLDB(db, 5, 5, makeCryptor("new", "old"), format.NoCompression, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+
+			progress := [][2]int{}
+			err := sqlDB.ReEncryptTable(ctx, logger, "tasks", func(processed, total int) {
+				progress = append(progress, [2]int{processed, total})
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress).To(Equal([][2]int{{1, 2}, {2, 2}}))
+
+			usage, err := sqlDB.EncryptionKeyUsage(ctx, logger, "old")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(map[string]int{"tasks": 0, "desired_lrps": 0, "actual_lrps": 0}))
+
+			usage, err = sqlDB.EncryptionKeyUsage(ctx, logger, "new")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(map[string]int{"tasks": 2, "desired_lrps": 0, "actual_lrps": 0}))
+		})
+
+		It("fails for tables that are not encrypted", func() {
+			err := sqlDB.ReEncryptTable(ctx, logger, "domains", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("EncryptionKeyUsage", func() {
+		It("counts the rows still encrypted with the key", func() {
+			encoder := format.NewEncoder(makeCryptor("old"))
+			encoded, err := encoder.Encode([]byte("some text"))
+			Expect(err).NotTo(HaveOccurred())
+
+			queryStr := "INSERT INTO tasks (guid, domain, task_definition) VALUES (?, ?, ?)"
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			_, err = db.ExecContext(ctx, queryStr, "some-guid", "fake-domain", encoded)
+			Expect(err).NotTo(HaveOccurred())
+
+			usage, err := sqlDB.EncryptionKeyUsage(ctx, logger, "old")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(HaveKeyWithValue("tasks", 1))
+		})
+	})
 })
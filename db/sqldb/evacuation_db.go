@@ -154,6 +154,7 @@ func (db *SQLDB) createEvacuatingActualLRP(
 
 	sqlAttributes := helpers.SQLAttributes{
 		"process_guid":           actualLRP.ProcessGuid,
+		"process_guid_hash":      processGuidHash(actualLRP.ProcessGuid),
 		"instance_index":         actualLRP.Index,
 		"presence":               models.ActualLRP_Evacuating,
 		"domain":                 actualLRP.Domain,
@@ -15,6 +15,12 @@ import (
 )
 
 func (sqldb *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellSet models.CellSet) db.ConvergenceResult {
+	return sqldb.ConvergeLRPsInScope(ctx, logger, cellSet, db.LRPConvergenceScope{})
+}
+
+// ConvergeLRPsInScope is ConvergeLRPs, limited to the process guids in the
+// scope.
+func (sqldb *SQLDB) ConvergeLRPsInScope(ctx context.Context, logger lager.Logger, cellSet models.CellSet, scope db.LRPConvergenceScope) db.ConvergenceResult {
 	logger = logger.Session("db-converge-lrps")
 	logger.Info("starting")
 	defer logger.Info("complete")
@@ -22,14 +28,64 @@ func (sqldb *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellS
 	now := sqldb.clock.Now()
 	sqldb.pruneDomains(ctx, logger, now)
 	events, instanceEvents := sqldb.pruneEvacuatingActualLRPs(ctx, logger, cellSet)
-	domainSet, err := sqldb.domainSet(ctx, logger)
+
+	result, err := sqldb.planLRPConvergence(ctx, logger, cellSet, scope, now)
 	if err != nil {
 		return db.ConvergenceResult{}
 	}
 
-	converge := newConvergence(sqldb)
+	result.Events = events
+	result.InstanceEvents = instanceEvents
+	return result
+}
+
+// PlanLRPConvergence returns what ConvergeLRPs would do, without pruning
+// domains or evacuating LRPs.
+func (sqldb *SQLDB) PlanLRPConvergence(ctx context.Context, logger lager.Logger, cellSet models.CellSet) db.LRPConvergencePlan {
+	return sqldb.PlanLRPConvergenceInScope(ctx, logger, cellSet, db.LRPConvergenceScope{})
+}
+
+// PlanLRPConvergenceInScope is PlanLRPConvergence, limited to the process
+// guids in the scope.
+func (sqldb *SQLDB) PlanLRPConvergenceInScope(ctx context.Context, logger lager.Logger, cellSet models.CellSet, scope db.LRPConvergenceScope) db.LRPConvergencePlan {
+	logger = logger.Session("db-plan-lrp-convergence")
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	now := sqldb.clock.Now()
+	plan := db.LRPConvergencePlan{
+		ExpiredDomains:         sqldb.expiredDomains(ctx, logger, now),
+		EvacuatingKeysToRemove: sqldb.evacuatingActualLRPKeysToPrune(ctx, logger, cellSet),
+	}
+
+	result, err := sqldb.planLRPConvergence(ctx, logger, cellSet, scope, now)
+	if err != nil {
+		return plan
+	}
+
+	plan.ConvergenceResult = result
+	return plan
+}
+
+func (sqldb *SQLDB) planLRPConvergence(ctx context.Context, logger lager.Logger, cellSet models.CellSet, scope db.LRPConvergenceScope, now time.Time) (db.ConvergenceResult, error) {
+	domainSet, err := sqldb.domainSet(ctx, logger)
+	if err != nil {
+		return db.ConvergenceResult{}, err
+	}
+
+	if !scope.Full() {
+		logger.Info("converging-scope", lager.Data{
+			"shard":              scope.Shard,
+			"shard_count":        scope.ShardCount,
+			"process_guid_count": len(scope.ProcessGuids),
+			"all_cells":          scope.AllCells,
+		})
+	}
+
+	converge := newConvergence(sqldb, scope)
 	converge.staleUnclaimedActualLRPs(ctx, logger, now)
 	converge.actualLRPsWithMissingCells(ctx, logger, cellSet)
+	converge.actualLRPsOnDrainingCells(ctx, logger, cellSet)
 	converge.lrpInstanceCounts(ctx, logger, domainSet)
 	converge.orphanedActualLRPs(ctx, logger)
 	converge.orphanedSuspectActualLRPs(ctx, logger)
@@ -45,21 +101,25 @@ func (sqldb *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellS
 		KeysToRetire:                 converge.keysToRetire,
 		SuspectLRPKeysToRetire:       converge.suspectKeysToRetire,
 		KeysWithMissingCells:         converge.ordinaryKeysWithMissingCells,
+		KeysOnDrainingCells:          converge.keysOnDrainingCells,
+		KeysDelayedByBudget:          converge.keysDelayedByBudget,
 		MissingCellIds:               converge.missingCellIds,
-		Events:                       events,
-		InstanceEvents:               instanceEvents,
 		SuspectKeysWithExistingCells: converge.suspectKeysWithExistingCells,
 		SuspectRunningKeys:           converge.suspectRunningKeys,
 		SuspectClaimedKeys:           converge.suspectClaimedKeys,
-	}
+	}, nil
 }
 
 type convergence struct {
 	*SQLDB
 
+	scope db.LRPConvergenceScope
+
 	ordinaryKeysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo
 	missingCellIds               []string
 	suspectKeysWithExistingCells []*models.ActualLRPKey
+	keysOnDrainingCells          []*models.ActualLRPKeyWithSchedulingInfo
+	keysDelayedByBudget          []*models.ActualLRPKey
 
 	suspectKeysToRetire []*models.ActualLRPKey
 
@@ -73,12 +133,53 @@ type convergence struct {
 	unstartedLRPKeys []*models.ActualLRPKeyWithSchedulingInfo
 }
 
-func newConvergence(db *SQLDB) *convergence {
+// processGuidHash is db.ProcessGuidHash, for the methods whose receiver
+// shadows the db package.
+func processGuidHash(processGuid string) int {
+	return db.ProcessGuidHash(processGuid)
+}
+
+func newConvergence(db *SQLDB, scope db.LRPConvergenceScope) *convergence {
 	return &convergence{
 		SQLDB: db,
+		scope: scope,
 	}
 }
 
+// processGuidsInScope returns the condition selecting the rows of the table
+// whose process guid is in the scope, or no condition when the scope is full.
+// Shards select a range of the indexed process_guid_hash column.
+func (c *convergence) processGuidsInScope(table string) (string, []interface{}) {
+	if c.scope.Full() {
+		return "", nil
+	}
+
+	conditions := []string{}
+	bindings := []interface{}{}
+	if len(c.scope.ProcessGuids) > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s.process_guid IN (%s)", table, helpers.QuestionMarks(len(c.scope.ProcessGuids))))
+		for _, processGuid := range c.scope.ProcessGuids {
+			bindings = append(bindings, processGuid)
+		}
+	}
+	if c.scope.ShardCount > 1 {
+		start, end := db.ProcessGuidHashRange(c.scope.Shard, c.scope.ShardCount)
+		conditions = append(conditions, fmt.Sprintf("(%s.process_guid_hash >= ? AND %s.process_guid_hash < ?)", table, table))
+		bindings = append(bindings, start, end)
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", bindings
+}
+
+// cellsInScope is processGuidsInScope for the queries that compare LRPs with
+// the cell set.
+func (c *convergence) cellsInScope(table string) (string, []interface{}) {
+	if c.scope.AllCells {
+		return "", nil
+	}
+	return c.processGuidsInScope(table)
+}
+
 // Adds stale UNCLAIMED Actual LRPs to the list of start requests.
 func (c *convergence) staleUnclaimedActualLRPs(ctx context.Context, logger lager.Logger, now time.Time) {
 	logger = logger.Session("stale-unclaimed-actual-lrps")
@@ -181,7 +282,8 @@ func scanActualLRPs(logger lager.Logger, rows *sql.Rows) []*models.ActualLRPKey
 func (c *convergence) orphanedActualLRPs(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("orphaned-actual-lrps")
 
-	rows, err := c.selectOrphanedActualLRPs(ctx, logger, c.db)
+	scope, scopeBindings := c.processGuidsInScope(actualLRPsTable)
+	rows, err := c.selectOrphanedActualLRPs(ctx, logger, c.db, scope, scopeBindings)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
@@ -193,7 +295,8 @@ func (c *convergence) orphanedActualLRPs(ctx context.Context, logger lager.Logge
 func (c *convergence) extraSuspectActualLRPs(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("extra-suspect-lrps")
 
-	rows, err := c.selectExtraSuspectActualLRPs(ctx, logger, c.db)
+	scope, scopeBindings := c.processGuidsInScope(actualLRPsTable)
+	rows, err := c.selectExtraSuspectActualLRPs(ctx, logger, c.db, scope, scopeBindings)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
@@ -205,7 +308,8 @@ func (c *convergence) extraSuspectActualLRPs(ctx context.Context, logger lager.L
 func (c *convergence) orphanedSuspectActualLRPs(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("orphaned-suspect-lrps")
 
-	rows, err := c.selectOrphanedSuspectActualLRPs(ctx, logger, c.db)
+	scope, scopeBindings := c.processGuidsInScope(actualLRPsTable)
+	rows, err := c.selectOrphanedSuspectActualLRPs(ctx, logger, c.db, scope, scopeBindings)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
@@ -243,7 +347,8 @@ func (c *convergence) suspectClaimedActualLRPs(ctx context.Context, logger lager
 func (c *convergence) lrpInstanceCounts(ctx context.Context, logger lager.Logger, domainSet map[string]struct{}) {
 	logger = logger.Session("lrp-instance-counts")
 
-	rows, err := c.selectLRPInstanceCounts(ctx, logger, c.db)
+	scope, scopeBindings := c.processGuidsInScope(desiredLRPsTable)
+	rows, err := c.selectLRPInstanceCounts(ctx, logger, c.db, scope, scopeBindings)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
@@ -320,11 +425,20 @@ func (c *convergence) lrpInstanceCounts(ctx context.Context, logger lager.Logger
 func (c *convergence) suspectActualLRPsWithExistingCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) {
 	logger = logger.Session("suspect-lrps-with-existing-cells")
 
-	if len(cellSet) == 0 {
+	// the suspect LRPs on draining cells wait for their replacements
+	existingCellSet := models.CellSet{}
+	for cellID, cell := range cellSet {
+		if !cell.Draining {
+			existingCellSet[cellID] = cell
+		}
+	}
+
+	if len(existingCellSet) == 0 {
 		return
 	}
 
-	rows, err := c.selectSuspectLRPsWithExistingCells(ctx, logger, c.db, cellSet)
+	scope, scopeBindings := c.cellsInScope(actualLRPsTable)
+	rows, err := c.selectSuspectLRPsWithExistingCells(ctx, logger, c.db, existingCellSet, scope, scopeBindings)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
@@ -340,7 +454,8 @@ func (c *convergence) actualLRPsWithMissingCells(ctx context.Context, logger lag
 
 	var ordinaryKeysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo
 
-	rows, err := c.selectLRPsWithMissingCells(ctx, logger, c.db, cellSet)
+	scope, scopeBindings := c.cellsInScope(actualLRPsTable)
+	rows, err := c.selectLRPsWithMissingCells(ctx, logger, c.db, cellSet, scope, scopeBindings)
 	if err != nil {
 		logger.Error("failed-query", err)
 		return
@@ -380,6 +495,133 @@ func (c *convergence) actualLRPsWithMissingCells(ctx context.Context, logger lag
 	c.ordinaryKeysWithMissingCells = ordinaryKeysWithMissingCells
 }
 
+// Adds the running ordinary Actual LRPs on draining cells to the LRPs to
+// replace. An LRP without a disruption budget moves one instance at a time:
+// it waits while it has a suspect instance. An LRP with a budget moves as many
+// instances as the budget allows, the others are delayed.
+func (c *convergence) actualLRPsOnDrainingCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) {
+	logger = logger.Session("actual-lrps-on-draining-cells")
+
+	drainingCellIDs := []string{}
+	for cellID, cell := range cellSet {
+		if cell.Draining {
+			drainingCellIDs = append(drainingCellIDs, cellID)
+		}
+	}
+
+	if len(drainingCellIDs) == 0 {
+		return
+	}
+
+	rows, err := c.selectLRPsOnDrainingCells(ctx, logger, c.db, drainingCellIDs)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return
+	}
+
+	schedulingInfos := map[string]*models.DesiredLRPSchedulingInfo{}
+	processGuids := []string{}
+	for rows.Next() {
+		var index int32
+		schedulingInfo, err := c.fetchDesiredLRPSchedulingInfoAndMore(logger, rows, &index)
+		if err != nil {
+			continue
+		}
+		if _, ok := schedulingInfos[schedulingInfo.ProcessGuid]; ok {
+			continue
+		}
+		schedulingInfos[schedulingInfo.ProcessGuid] = schedulingInfo
+		processGuids = append(processGuids, schedulingInfo.ProcessGuid)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+	}
+
+	if len(processGuids) == 0 {
+		return
+	}
+
+	lrps, err := c.actualLRPStatesForProcessGuids(ctx, logger, processGuids)
+	if err != nil {
+		return
+	}
+
+	draining := func(cellID string) bool {
+		cell, ok := cellSet[cellID]
+		return ok && cell.Draining
+	}
+
+	for _, guid := range processGuids {
+		schedulingInfo := schedulingInfos[guid]
+		moves, delayed := models.DrainMoves(lrps[guid], schedulingInfo.Instances, schedulingInfo.DisruptionBudget, draining)
+
+		for _, index := range moves {
+			c.keysOnDrainingCells = append(c.keysOnDrainingCells, &models.ActualLRPKeyWithSchedulingInfo{
+				Key: &models.ActualLRPKey{
+					ProcessGuid: schedulingInfo.ProcessGuid,
+					Domain:      schedulingInfo.Domain,
+					Index:       index,
+				},
+				SchedulingInfo: schedulingInfo,
+			})
+		}
+
+		for _, index := range delayed {
+			c.keysDelayedByBudget = append(c.keysDelayedByBudget, &models.ActualLRPKey{
+				ProcessGuid: schedulingInfo.ProcessGuid,
+				Domain:      schedulingInfo.Domain,
+				Index:       index,
+			})
+		}
+	}
+
+	if len(c.keysOnDrainingCells) > 0 {
+		logger.Info("replacing-lrps-on-draining-cells", lager.Data{"cell_ids": drainingCellIDs, "count": len(c.keysOnDrainingCells)})
+	}
+
+	if len(c.keysDelayedByBudget) > 0 {
+		logger.Info("delayed-by-disruption-budget", lager.Data{"count": len(c.keysDelayedByBudget)})
+	}
+}
+
+// actualLRPStatesForProcessGuids returns the presence, state and cell of the
+// Actual LRPs of the process guids, by process guid.
+func (c *convergence) actualLRPStatesForProcessGuids(ctx context.Context, logger lager.Logger, processGuids []string) (map[string][]*models.ActualLRP, error) {
+	values := make([]interface{}, 0, len(processGuids))
+	for _, guid := range processGuids {
+		values = append(values, guid)
+	}
+
+	rows, err := c.all(ctx, logger, c.db, actualLRPsTable,
+		helpers.ColumnList{"process_guid", "instance_index", "presence", "state", "cell_id"}, helpers.NoLockRow,
+		whereClauseForProcessGuids(processGuids), values...,
+	)
+	if err != nil {
+		logger.Error("failed-query-actual-lrps", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	lrps := map[string][]*models.ActualLRP{}
+	for rows.Next() {
+		lrp := &models.ActualLRP{}
+		err := rows.Scan(&lrp.ProcessGuid, &lrp.Index, &lrp.Presence, &lrp.State, &lrp.CellId)
+		if err != nil {
+			logger.Error("failed-scanning-actual-lrp", err)
+			continue
+		}
+		lrps[lrp.ProcessGuid] = append(lrps[lrp.ProcessGuid], lrp)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, rows.Err()
+	}
+
+	return lrps, nil
+}
+
 func (db *SQLDB) pruneDomains(ctx context.Context, logger lager.Logger, now time.Time) {
 	logger = logger.Session("prune-domains")
 
@@ -409,9 +651,27 @@ func (db *SQLDB) pruneDomains(ctx context.Context, logger lager.Logger, now time
 	}
 }
 
-func (db *SQLDB) pruneEvacuatingActualLRPs(ctx context.Context, logger lager.Logger, cellSet models.CellSet) ([]models.Event, []models.Event) {
-	logger = logger.Session("prune-evacuating-actual-lrps")
+func (db *SQLDB) expiredDomains(ctx context.Context, logger lager.Logger, now time.Time) []string {
+	logger = logger.Session("expired-domains")
 
+	domains, err := db.domains(ctx, logger, db.db, time.Time{})
+	if err != nil {
+		logger.Error("failed-listing-domains", err)
+		return nil
+	}
+
+	var expired []string
+	for _, d := range domains {
+		if !d.expiresAt.After(now) {
+			expired = append(expired, d.name)
+		}
+	}
+	return expired
+}
+
+// evacuatingActualLRPsWithMissingCells selects the evacuating LRPs whose cell
+// is not in the cell set.
+func evacuatingActualLRPsWithMissingCells(cellSet models.CellSet) (string, []interface{}) {
 	wheres := []string{"presence = ?"}
 	bindings := []interface{}{models.ActualLRP_Evacuating}
 
@@ -423,12 +683,20 @@ func (db *SQLDB) pruneEvacuatingActualLRPs(ctx context.Context, logger lager.Log
 		}
 	}
 
-	lrpsToDelete, err := db.getActualLRPs(ctx, logger, strings.Join(wheres, " AND "), bindings...)
+	return strings.Join(wheres, " AND "), bindings
+}
+
+func (db *SQLDB) pruneEvacuatingActualLRPs(ctx context.Context, logger lager.Logger, cellSet models.CellSet) ([]models.Event, []models.Event) {
+	logger = logger.Session("prune-evacuating-actual-lrps")
+
+	wheres, bindings := evacuatingActualLRPsWithMissingCells(cellSet)
+
+	lrpsToDelete, err := db.getActualLRPs(ctx, logger, wheres, bindings...)
 	if err != nil {
 		logger.Error("failed-fetching-evacuating-lrps-with-missing-cells", err)
 	}
 
-	_, err = db.delete(ctx, logger, db.db, actualLRPsTable, strings.Join(wheres, " AND "), bindings...)
+	_, err = db.delete(ctx, logger, db.db, actualLRPsTable, wheres, bindings...)
 	if err != nil {
 		logger.Error("failed-query", err)
 	}
@@ -442,6 +710,24 @@ func (db *SQLDB) pruneEvacuatingActualLRPs(ctx context.Context, logger lager.Log
 	return events, instanceEvents
 }
 
+func (db *SQLDB) evacuatingActualLRPKeysToPrune(ctx context.Context, logger lager.Logger, cellSet models.CellSet) []*models.ActualLRPKey {
+	logger = logger.Session("evacuating-actual-lrps-to-prune")
+
+	wheres, bindings := evacuatingActualLRPsWithMissingCells(cellSet)
+
+	rows, err := db.all(ctx, logger, db.db, actualLRPsTable,
+		helpers.ColumnList{"process_guid", "instance_index", "domain"}, helpers.NoLockRow,
+		wheres, bindings...,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil
+	}
+	defer rows.Close()
+
+	return scanActualLRPs(logger, rows)
+}
+
 func (db *SQLDB) domainSet(ctx context.Context, logger lager.Logger) (map[string]struct{}, error) {
 	logger.Debug("listing-domains")
 	domains, err := db.FreshDomains(ctx, logger)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	dbpkg "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/test_helpers"
@@ -1380,4 +1381,201 @@ var _ = Describe("LRPConvergence", func() {
 			})
 		})
 	})
+
+	Describe("PlanLRPConvergence", func() {
+		var (
+			processGuid, domain string
+			expiredDomain       = "expired-domain"
+			plan                dbpkg.LRPConvergencePlan
+		)
+
+		BeforeEach(func() {
+			domain = "some-domain"
+			processGuid = "desired-with-evacuating-actual"
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = domain
+			desiredLRP.Instances = 1
+			err := sqlDB.DesireLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain})
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.ClaimActualLRP(ctx, logger, processGuid, 0, &models.ActualLRPInstanceKey{InstanceGuid: "ig-1", CellId: "missing-cell"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.ExecContext(ctx, fmt.Sprintf(`UPDATE actual_lrps SET presence = %d`, models.ActualLRP_Evacuating))
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(-10 * time.Second)
+			Expect(sqlDB.UpsertDomain(ctx, logger, expiredDomain, 5)).To(Succeed())
+			fakeClock.Increment(10 * time.Second)
+		})
+
+		JustBeforeEach(func() {
+			plan = sqlDB.PlanLRPConvergence(ctx, logger, cellSet)
+		})
+
+		It("plans pruning the expired domains", func() {
+			Expect(plan.ExpiredDomains).To(ConsistOf(expiredDomain))
+
+			var count int
+			row := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM domains WHERE domain = 'expired-domain'")
+			Expect(row.Scan(&count)).To(Succeed())
+			Expect(count).To(Equal(1))
+		})
+
+		It("plans removing the evacuating LRPs on missing cells", func() {
+			Expect(plan.EvacuatingKeysToRemove).To(ConsistOf(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain}))
+
+			lrps, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: processGuid})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lrps).To(HaveLen(1))
+		})
+
+		It("plans creating the instances the evacuating LRPs leave missing", func() {
+			Expect(plan.MissingLRPKeys).To(HaveLen(1))
+			Expect(plan.MissingLRPKeys[0].Key).To(Equal(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain}))
+		})
+	})
+
+	Describe("ConvergeLRPsInScope", func() {
+		var (
+			domain                      string
+			inShardGuid, outOfShardGuid string
+			shard                       int
+			scope                       dbpkg.LRPConvergenceScope
+			result                      dbpkg.ConvergenceResult
+		)
+
+		BeforeEach(func() {
+			domain = "some-domain"
+			inShardGuid = "process-guid-0"
+			shard = dbpkg.ProcessGuidShard(inShardGuid, 2)
+			for i := 1; ; i++ {
+				outOfShardGuid = fmt.Sprintf("process-guid-%d", i)
+				if dbpkg.ProcessGuidShard(outOfShardGuid, 2) != shard {
+					break
+				}
+			}
+
+			Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+			for _, processGuid := range []string{inShardGuid, outOfShardGuid} {
+				desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+				desiredLRP.Domain = domain
+				desiredLRP.Instances = 2
+				Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+				_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain})
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.ClaimActualLRP(ctx, logger, processGuid, 0, &models.ActualLRPInstanceKey{InstanceGuid: processGuid + "-ig", CellId: "missing-cell"})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			scope = dbpkg.LRPConvergenceScope{Shard: shard, ShardCount: 2}
+		})
+
+		JustBeforeEach(func() {
+			result = sqlDB.ConvergeLRPsInScope(ctx, logger, cellSet, scope)
+		})
+
+		missingProcessGuids := func(keys []*models.ActualLRPKeyWithSchedulingInfo) []string {
+			guids := []string{}
+			for _, key := range keys {
+				guids = append(guids, key.Key.ProcessGuid)
+			}
+			return guids
+		}
+
+		It("only converges the process guids in the shard", func() {
+			Expect(missingProcessGuids(result.MissingLRPKeys)).To(ConsistOf(inShardGuid))
+			Expect(missingProcessGuids(result.KeysWithMissingCells)).To(ConsistOf(inShardGuid))
+		})
+
+		Context("when the scope has process guids", func() {
+			BeforeEach(func() {
+				scope.ProcessGuids = []string{outOfShardGuid}
+			})
+
+			It("also converges them", func() {
+				Expect(missingProcessGuids(result.MissingLRPKeys)).To(ConsistOf(inShardGuid, outOfShardGuid))
+			})
+		})
+
+		Context("when the scope checks every cell", func() {
+			BeforeEach(func() {
+				scope.AllCells = true
+			})
+
+			It("returns the LRPs on missing cells in every shard", func() {
+				Expect(missingProcessGuids(result.MissingLRPKeys)).To(ConsistOf(inShardGuid))
+				Expect(missingProcessGuids(result.KeysWithMissingCells)).To(ConsistOf(inShardGuid, outOfShardGuid))
+			})
+		})
+
+		Context("when the scope only has process guids", func() {
+			BeforeEach(func() {
+				scope = dbpkg.LRPConvergenceScope{ProcessGuids: []string{outOfShardGuid}}
+			})
+
+			It("only converges them", func() {
+				Expect(missingProcessGuids(result.MissingLRPKeys)).To(ConsistOf(outOfShardGuid))
+				Expect(missingProcessGuids(result.KeysWithMissingCells)).To(ConsistOf(outOfShardGuid))
+			})
+		})
+
+		Context("when planning", func() {
+			It("only plans the process guids in the scope", func() {
+				plan := sqlDB.PlanLRPConvergenceInScope(ctx, logger, cellSet, dbpkg.LRPConvergenceScope{ProcessGuids: []string{inShardGuid}})
+				Expect(missingProcessGuids(plan.MissingLRPKeys)).To(ConsistOf(inShardGuid))
+			})
+		})
+	})
+
+	Describe("actual LRPs on draining cells", func() {
+		var (
+			processGuid string
+			desiredLRP  *models.DesiredLRP
+			result      dbpkg.ConvergenceResult
+		)
+
+		BeforeEach(func() {
+			processGuid = "desired-on-draining-cell"
+			desiredLRP = model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = "some-domain"
+			desiredLRP.Instances = 3
+		})
+
+		JustBeforeEach(func() {
+			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+			Expect(sqlDB.UpsertDomain(ctx, logger, "some-domain", 5)).To(Succeed())
+
+			actualLRPNetInfo := models.NewActualLRPNetInfo("some-address", "container-address", models.ActualLRPNetInfo_PreferredAddressUnknown, models.NewPortMapping(2222, 4444))
+			for i := 0; i < 3; i++ {
+				lrpKey := models.NewActualLRPKey(processGuid, int32(i), "some-domain")
+				_, _, err := sqlDB.StartActualLRP(ctx, logger, &lrpKey, &models.ActualLRPInstanceKey{InstanceGuid: fmt.Sprintf("ig-%d", i), CellId: "draining-cell"}, &actualLRPNetInfo)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			drainingCell := &models.CellPresence{CellId: "draining-cell", Cordoned: true, Draining: true}
+			cellSet = models.NewCellSetFromList([]*models.CellPresence{{CellId: "existing-cell"}, drainingCell})
+			result = sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+		})
+
+		It("replaces one instance at a time", func() {
+			Expect(result.KeysOnDrainingCells).To(ConsistOf(actualLRPKeyWithSchedulingInfo(desiredLRP, 0)))
+			Expect(result.KeysDelayedByBudget).To(BeEmpty())
+		})
+
+		Context("when the LRP has a disruption budget", func() {
+			BeforeEach(func() {
+				desiredLRP.DisruptionBudget = &models.DisruptionBudget{MaxUnavailable: 2}
+			})
+
+			It("replaces the instances the budget allows and delays the others", func() {
+				Expect(result.KeysOnDrainingCells).To(ConsistOf(
+					actualLRPKeyWithSchedulingInfo(desiredLRP, 0),
+					actualLRPKeyWithSchedulingInfo(desiredLRP, 1),
+				))
+				Expect(result.KeysDelayedByBudget).To(ConsistOf(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 2, Domain: "some-domain"}))
+			})
+		})
+	})
 })
@@ -0,0 +1,49 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+const MaintenanceModeID = "maintenance_mode"
+
+func (db *SQLDB) SetMaintenanceMode(ctx context.Context, logger lager.Logger, mode *models.MaintenanceMode) error {
+	logger = logger.Session("db-set-maintenance-mode", lager.Data{"maintenance_mode": mode})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	modeJSON, err := json.Marshal(mode)
+	if err != nil {
+		logger.Error("failed-marshalling-maintenance-mode", err)
+		return err
+	}
+
+	return db.setConfigurationValue(ctx, logger, MaintenanceModeID, string(modeJSON))
+}
+
+// MaintenanceMode returns the stored maintenance mode, which is disabled when
+// it has never been set.
+func (db *SQLDB) MaintenanceMode(ctx context.Context, logger lager.Logger) (*models.MaintenanceMode, error) {
+	logger = logger.Session("db-maintenance-mode")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	modeJSON, err := db.getConfigurationValue(ctx, logger, MaintenanceModeID)
+	if err == models.ErrResourceNotFound {
+		return &models.MaintenanceMode{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var mode models.MaintenanceMode
+	err = json.Unmarshal([]byte(modeJSON), &mode)
+	if err != nil {
+		logger.Error("failed-to-deserialize-maintenance-mode", err)
+		return nil, models.ErrDeserialize
+	}
+
+	return &mode, nil
+}
@@ -0,0 +1,62 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MaintenanceDB", func() {
+	Describe("MaintenanceMode", func() {
+		Context("when the maintenance mode was never set", func() {
+			It("returns a disabled maintenance mode", func() {
+				mode, err := sqlDB.MaintenanceMode(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mode).To(Equal(&models.MaintenanceMode{}))
+			})
+		})
+
+		Context("when the maintenance mode is set", func() {
+			var expectedMode *models.MaintenanceMode
+
+			BeforeEach(func() {
+				expectedMode = &models.MaintenanceMode{Enabled: true, Reason: "database upgrade", UpdatedAt: 1234}
+				err := sqlDB.SetMaintenanceMode(ctx, logger, expectedMode)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns it", func() {
+				mode, err := sqlDB.MaintenanceMode(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mode).To(Equal(expectedMode))
+			})
+
+			It("replaces it when set again", func() {
+				err := sqlDB.SetMaintenanceMode(ctx, logger, &models.MaintenanceMode{UpdatedAt: 5678})
+				Expect(err).NotTo(HaveOccurred())
+
+				mode, err := sqlDB.MaintenanceMode(ctx, logger)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mode).To(Equal(&models.MaintenanceMode{UpdatedAt: 5678}))
+			})
+		})
+
+		Context("when the stored maintenance mode is not valid json", func() {
+			BeforeEach(func() {
+				err := sqlDB.SetMaintenanceMode(ctx, logger, &models.MaintenanceMode{})
+				Expect(err).NotTo(HaveOccurred())
+
+				queryStr := "UPDATE configurations SET value = '{{' WHERE id = ?"
+				_, err = db.ExecContext(ctx, helpers.RebindForFlavor(queryStr, dbDriverName), sqldb.MaintenanceModeID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an ErrDeserialize", func() {
+				_, err := sqlDB.MaintenanceMode(ctx, logger)
+				Expect(err).To(MatchError(models.ErrDeserialize))
+			})
+		})
+	})
+})
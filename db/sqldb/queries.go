@@ -13,10 +13,12 @@ import (
 )
 
 const (
-	tasksTable       = "tasks"
-	desiredLRPsTable = "desired_lrps"
-	actualLRPsTable  = "actual_lrps"
-	domainsTable     = "domains"
+	tasksTable        = "tasks"
+	desiredLRPsTable  = "desired_lrps"
+	actualLRPsTable   = "actual_lrps"
+	domainsTable      = "domains"
+	auditEntriesTable = "audit_entries"
+	cellCordonsTable  = "cell_cordons"
 )
 
 var (
@@ -35,6 +37,7 @@ var (
 		desiredLRPsTable + ".modification_tag_epoch",
 		desiredLRPsTable + ".modification_tag_index",
 		desiredLRPsTable + ".placement_tags",
+		desiredLRPsTable + ".disruption_budget",
 	}
 
 	desiredLRPColumns = append(schedulingInfoColumns,
@@ -78,6 +81,27 @@ var (
 		domainsTable + ".domain",
 		domainsTable + ".expire_time",
 	}
+
+	auditEntryColumns = helpers.ColumnList{
+		auditEntriesTable + ".created_at",
+		auditEntriesTable + ".route",
+		auditEntriesTable + ".identity",
+		auditEntriesTable + ".remote_addr",
+		auditEntriesTable + ".target_guid",
+		auditEntriesTable + ".request",
+		auditEntriesTable + ".error_type",
+		auditEntriesTable + ".modification_tag_before_epoch",
+		auditEntriesTable + ".modification_tag_before_index",
+		auditEntriesTable + ".modification_tag_after_epoch",
+		auditEntriesTable + ".modification_tag_after_index",
+	}
+
+	cellCordonColumns = helpers.ColumnList{
+		cellCordonsTable + ".cell_id",
+		cellCordonsTable + ".draining",
+		cellCordonsTable + ".reason",
+		cellCordonsTable + ".updated_at",
+	}
 )
 
 func (db *SQLDB) CreateConfigurationsTable(ctx context.Context, logger lager.Logger) error {
@@ -95,7 +119,7 @@ func (db *SQLDB) CreateConfigurationsTable(ctx context.Context, logger lager.Log
 	return nil
 }
 
-func (db *SQLDB) selectLRPInstanceCounts(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
+func (db *SQLDB) selectLRPInstanceCounts(ctx context.Context, logger lager.Logger, q helpers.Queryable, scope string, scopeBindings []interface{}) (*sql.Rows, error) {
 	var query string
 	columns := schedulingInfoColumns
 	columns = append(columns, "COUNT(actual_lrps.instance_index) AS actual_instances")
@@ -110,41 +134,47 @@ func (db *SQLDB) selectLRPInstanceCounts(ctx context.Context, logger lager.Logge
 		panic("database flavor not implemented: " + db.flavor)
 	}
 
+	where := ""
+	if scope != "" {
+		where = "WHERE " + scope
+	}
+
 	query = fmt.Sprintf(`
 		SELECT %s
 			FROM desired_lrps
 			LEFT OUTER JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid AND actual_lrps.presence = %d
+			%s
 			GROUP BY desired_lrps.process_guid
 			HAVING COUNT(actual_lrps.instance_index) <> desired_lrps.instances
 		`,
-		strings.Join(columns, ", "), models.ActualLRP_Ordinary,
+		strings.Join(columns, ", "), models.ActualLRP_Ordinary, where,
 	)
 
-	return q.QueryContext(ctx, query)
+	return q.QueryContext(ctx, db.helper.Rebind(query), scopeBindings...)
 }
 
-func (db *SQLDB) selectOrphanedActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
+func (db *SQLDB) selectOrphanedActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable, scope string, scopeBindings []interface{}) (*sql.Rows, error) {
 	query := fmt.Sprintf(`
     SELECT actual_lrps.process_guid, actual_lrps.instance_index, actual_lrps.domain
       FROM actual_lrps
       JOIN domains ON actual_lrps.domain = domains.domain
       LEFT JOIN desired_lrps ON actual_lrps.process_guid = desired_lrps.process_guid
-      WHERE actual_lrps.presence = %d AND desired_lrps.process_guid IS NULL
-		`, models.ActualLRP_Ordinary)
+      WHERE actual_lrps.presence = %d AND desired_lrps.process_guid IS NULL%s
+		`, models.ActualLRP_Ordinary, andScope(scope))
 
-	return q.QueryContext(ctx, query)
+	return q.QueryContext(ctx, db.helper.Rebind(query), scopeBindings...)
 }
 
-func (db *SQLDB) selectOrphanedSuspectActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
+func (db *SQLDB) selectOrphanedSuspectActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable, scope string, scopeBindings []interface{}) (*sql.Rows, error) {
 	query := fmt.Sprintf(`
     SELECT actual_lrps.process_guid, actual_lrps.instance_index, actual_lrps.domain
       FROM actual_lrps
       JOIN domains ON actual_lrps.domain = domains.domain
       LEFT JOIN desired_lrps ON actual_lrps.process_guid = desired_lrps.process_guid
-      WHERE actual_lrps.presence = %d AND desired_lrps.process_guid IS NULL
-		`, models.ActualLRP_Suspect)
+      WHERE actual_lrps.presence = %d AND desired_lrps.process_guid IS NULL%s
+		`, models.ActualLRP_Suspect, andScope(scope))
 
-	return q.QueryContext(ctx, query)
+	return q.QueryContext(ctx, db.helper.Rebind(query), scopeBindings...)
 }
 
 func (db *SQLDB) selectSuspectRunningActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
@@ -163,16 +193,17 @@ func (db *SQLDB) selectSuspectClaimedActualLRPs(ctx context.Context, logger lage
 	return q.QueryContext(ctx, query, models.ActualLRP_Suspect, models.ActualLRPStateClaimed)
 }
 
-func (db *SQLDB) selectExtraSuspectActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
-	query := db.helper.Rebind(`SELECT process_guid, instance_index, domain
+func (db *SQLDB) selectExtraSuspectActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable, scope string, scopeBindings []interface{}) (*sql.Rows, error) {
+	query := db.helper.Rebind(fmt.Sprintf(`SELECT process_guid, instance_index, domain
       FROM actual_lrps
-      WHERE actual_lrps.presence IN (?, ?) AND actual_lrps.state = ?
+      WHERE actual_lrps.presence IN (?, ?) AND actual_lrps.state = ?%s
 			GROUP BY process_guid, instance_index, domain
-			HAVING count(*) >= 2`)
-	return q.QueryContext(ctx, query, models.ActualLRP_Ordinary, models.ActualLRP_Suspect, models.ActualLRPStateRunning)
+			HAVING count(*) >= 2`, andScope(scope)))
+	bindings := append([]interface{}{models.ActualLRP_Ordinary, models.ActualLRP_Suspect, models.ActualLRPStateRunning}, scopeBindings...)
+	return q.QueryContext(ctx, query, bindings...)
 }
 
-func (db *SQLDB) selectSuspectLRPsWithExistingCells(ctx context.Context, logger lager.Logger, q helpers.Queryable, cellSet models.CellSet) (*sql.Rows, error) {
+func (db *SQLDB) selectSuspectLRPsWithExistingCells(ctx context.Context, logger lager.Logger, q helpers.Queryable, cellSet models.CellSet, scope string, scopeBindings []interface{}) (*sql.Rows, error) {
 	wheres := []string{fmt.Sprintf("actual_lrps.presence = %d", models.ActualLRP_Suspect)}
 	bindings := make([]interface{}, 0, len(cellSet))
 
@@ -183,6 +214,11 @@ func (db *SQLDB) selectSuspectLRPsWithExistingCells(ctx context.Context, logger
 		}
 	}
 
+	if scope != "" {
+		wheres = append(wheres, scope)
+		bindings = append(bindings, scopeBindings...)
+	}
+
 	query := fmt.Sprintf(`
 		SELECT process_guid, instance_index, domain
 			FROM actual_lrps
@@ -194,7 +230,7 @@ func (db *SQLDB) selectSuspectLRPsWithExistingCells(ctx context.Context, logger
 	return q.QueryContext(ctx, db.helper.Rebind(query), bindings...)
 }
 
-func (db *SQLDB) selectLRPsWithMissingCells(ctx context.Context, logger lager.Logger, q helpers.Queryable, cellSet models.CellSet) (*sql.Rows, error) {
+func (db *SQLDB) selectLRPsWithMissingCells(ctx context.Context, logger lager.Logger, q helpers.Queryable, cellSet models.CellSet, scope string, scopeBindings []interface{}) (*sql.Rows, error) {
 	wheres := []string{
 		"(actual_lrps.state = ? OR actual_lrps.state = ?)",
 	}
@@ -211,6 +247,11 @@ func (db *SQLDB) selectLRPsWithMissingCells(ctx context.Context, logger lager.Lo
 		}
 	}
 
+	if scope != "" {
+		wheres = append(wheres, scope)
+		bindings = append(bindings, scopeBindings...)
+	}
+
 	query := fmt.Sprintf(`
 		SELECT %s
 			FROM desired_lrps
@@ -224,6 +265,36 @@ func (db *SQLDB) selectLRPsWithMissingCells(ctx context.Context, logger lager.Lo
 	return q.QueryContext(ctx, db.helper.Rebind(query), bindings...)
 }
 
+func (db *SQLDB) selectLRPsOnDrainingCells(ctx context.Context, logger lager.Logger, q helpers.Queryable, cellIDs []string) (*sql.Rows, error) {
+	bindings := []interface{}{models.ActualLRPStateRunning}
+	for _, cellID := range cellIDs {
+		bindings = append(bindings, cellID)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+			FROM desired_lrps
+			JOIN actual_lrps ON desired_lrps.process_guid = actual_lrps.process_guid
+			WHERE actual_lrps.presence = %d AND actual_lrps.state = ? AND actual_lrps.cell_id IN (%s)
+			AND actual_lrps.instance_index < desired_lrps.instances
+			ORDER BY actual_lrps.process_guid, actual_lrps.instance_index
+		`,
+		strings.Join(append(schedulingInfoColumns, "actual_lrps.instance_index"), ", "),
+		models.ActualLRP_Ordinary,
+		helpers.QuestionMarks(len(cellIDs)),
+	)
+
+	return q.QueryContext(ctx, db.helper.Rebind(query), bindings...)
+}
+
+// andScope appends a convergence scope condition to a WHERE clause.
+func andScope(scope string) string {
+	if scope == "" {
+		return ""
+	}
+	return " AND " + scope
+}
+
 func (db *SQLDB) selectCrashedLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable) (*sql.Rows, error) {
 	query := fmt.Sprintf(`
 		SELECT %s
@@ -0,0 +1,53 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+const safetyValveCellIdsIDPrefix = "safety_valve_cell_ids_"
+
+// SetSafetyValveCellIds stores the cells of the last pass the safety valve of
+// the convergence accepted.
+func (db *SQLDB) SetSafetyValveCellIds(ctx context.Context, logger lager.Logger, convergence string, cellIds []string) error {
+	logger = logger.Session("db-set-safety-valve-cell-ids", lager.Data{"convergence": convergence, "cell_count": len(cellIds)})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	if cellIds == nil {
+		cellIds = []string{}
+	}
+
+	cellIdsJSON, err := json.Marshal(cellIds)
+	if err != nil {
+		logger.Error("failed-marshalling-cell-ids", err)
+		return err
+	}
+
+	return db.setConfigurationValue(ctx, logger, safetyValveCellIdsIDPrefix+convergence, string(cellIdsJSON))
+}
+
+// SafetyValveCellIds returns the stored cells of the safety valve of the
+// convergence, or ErrResourceNotFound when none were ever stored.
+func (db *SQLDB) SafetyValveCellIds(ctx context.Context, logger lager.Logger, convergence string) ([]string, error) {
+	logger = logger.Session("db-safety-valve-cell-ids", lager.Data{"convergence": convergence})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	cellIdsJSON, err := db.getConfigurationValue(ctx, logger, safetyValveCellIdsIDPrefix+convergence)
+	if err != nil {
+		return nil, err
+	}
+
+	var cellIds []string
+	err = json.Unmarshal([]byte(cellIdsJSON), &cellIds)
+	if err != nil {
+		logger.Error("failed-to-deserialize-cell-ids", err)
+		return nil, models.ErrDeserialize
+	}
+
+	return cellIds, nil
+}
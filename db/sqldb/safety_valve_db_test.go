@@ -0,0 +1,45 @@
+package sqldb_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SafetyValveDB", func() {
+	Describe("SafetyValveCellIds", func() {
+		Context("when the cells were never stored", func() {
+			It("returns a resource not found error", func() {
+				_, err := sqlDB.SafetyValveCellIds(ctx, logger, "lrps")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when the cells are stored", func() {
+			BeforeEach(func() {
+				err := sqlDB.SetSafetyValveCellIds(ctx, logger, "lrps", []string{"cell-1", "cell-2"})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns them", func() {
+				cellIds, err := sqlDB.SafetyValveCellIds(ctx, logger, "lrps")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cellIds).To(Equal([]string{"cell-1", "cell-2"}))
+			})
+
+			It("keeps them apart from the cells of the other convergence", func() {
+				_, err := sqlDB.SafetyValveCellIds(ctx, logger, "tasks")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+
+			It("replaces them when stored again", func() {
+				err := sqlDB.SetSafetyValveCellIds(ctx, logger, "lrps", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				cellIds, err := sqlDB.SafetyValveCellIds(ctx, logger, "lrps")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cellIds).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -32,6 +32,7 @@ func NewSQLDB(
 	convergenceWorkersSize int,
 	updateWorkersSize int,
 	cryptor encryption.Cryptor,
+	compression format.Compression,
 	guidProvider guidprovider.GUIDProvider,
 	clock clock.Clock,
 	flavor string,
@@ -44,9 +45,9 @@ func NewSQLDB(
 		updateWorkersSize:      updateWorkersSize,
 		clock:                  clock,
 		guidProvider:           guidProvider,
-		serializer:             format.NewSerializer(cryptor),
+		serializer:             format.NewSerializerWithCompression(cryptor, compression),
 		cryptor:                cryptor,
-		encoder:                format.NewEncoder(cryptor),
+		encoder:                format.NewEncoderWithCompression(cryptor, compression),
 		flavor:                 flavor,
 		helper:                 helper,
 		metronClient:           metronClient,
@@ -100,7 +100,7 @@ var _ = BeforeSuite(func() {
 	db = helpers.NewMonitoredDB(rawDB, monitor.New())
 	ctx = context.Background()
 
-	sqlDB = sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+	sqlDB = sqldb.NewSQLDB(db, 5, 5, cryptor, format.NoCompression, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 	err = sqlDB.CreateConfigurationsTable(ctx, logger)
 	if err != nil {
 		logger.Fatal("sql-failed-create-configurations-table", err)
@@ -115,7 +115,7 @@ var _ = BeforeEach(func() {
 
 	fakeMetronClient = new(mfakes.FakeIngressClient)
 	migrationMetronClient := new(mfakes.FakeIngressClient)
-	sqlDB = sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+	sqlDB = sqldb.NewSQLDB(db, 5, 5, cryptor, format.NoCompression, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 
 	migrationsDone := make(chan struct{})
 
@@ -174,6 +174,8 @@ var truncateTablesSQL = []string{
 	"TRUNCATE TABLE desired_lrps",
 	"TRUNCATE TABLE actual_lrps",
 	"TRUNCATE TABLE configurations",
+	"TRUNCATE TABLE audit_entries",
+	"TRUNCATE TABLE cell_cordons",
 }
 
 func randStr(strSize int) string {
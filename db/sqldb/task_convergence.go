@@ -3,7 +3,6 @@ package sqldb
 import (
 	"context"
 	"fmt"
-	"math"
 	"strings"
 	"time"
 
@@ -24,212 +23,248 @@ func (sqldb *SQLDB) ConvergeTasks(ctx context.Context, logger lager.Logger, cell
 	logger.Info("starting")
 	defer logger.Info("complete")
 
+	now := sqldb.clock.Now()
+	plan := sqldb.planTaskConvergence(ctx, logger, now, cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+
 	convergenceResult := db.TaskConvergenceResult{}
 
-	// failedEvents is a list of tasks that have transitioned from the pending to the completed state (but expired and failed)
-	// failedFetches are tasks that failed to deserialize (invalid task def)
-	// rowsAffected are the number of pending tasks that have expired
-	failedEvents, failedFetches, rowsAffected := sqldb.failExpiredPendingTasks(ctx, logger, expirePendingTaskDuration)
+	sqldb.deleteInvalidTasks(ctx, logger, sqldb.db, plan.InvalidTaskGuids...)
+	convergenceResult.Metrics.TasksPruned += uint64(len(plan.InvalidTaskGuids))
+
+	// expired pending tasks transition from the pending to the completed state, and fail
+	failedEvents, rowsAffected := sqldb.failTasks(ctx, logger.Session("fail-expired-pending-tasks"), now,
+		expiredPendingTasks(now, expirePendingTaskDuration), plan.ExpiredPendingTasks, expiredFailureReason)
 	convergenceResult.Events = append(convergenceResult.Events, failedEvents...)
-	convergenceResult.Metrics.TasksPruned += failedFetches
 	convergenceResult.Metrics.TasksKicked += uint64(rowsAffected)
 
-	// tasksToAuction is a list of tasks in the pending state that have not expired and are being auctioned
-	tasksToAuction, failedFetches := sqldb.getTaskStartRequestsForKickablePendingTasks(ctx, logger, expirePendingTaskDuration)
-	convergenceResult.TasksToAuction = tasksToAuction
-	convergenceResult.Metrics.TasksPruned += failedFetches
-	convergenceResult.Metrics.TasksKicked += uint64(len(tasksToAuction))
+	// pending tasks that have not expired are auctioned again
+	convergenceResult.TasksToAuction = []*auctioneer.TaskStartRequest{}
+	for _, task := range plan.PendingTasksToKick {
+		taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
+		convergenceResult.TasksToAuction = append(convergenceResult.TasksToAuction, &taskStartRequest)
+	}
+	convergenceResult.Metrics.TasksKicked += uint64(len(convergenceResult.TasksToAuction))
 
-	// failedEvents is a list of tasks that have transitioned from the running to completed state (but cell dissappeared and failed)
-	// rowsAffected is the number of running tasks that have lost their cells
-	failedEvents, failedFetches, rowsAffected = sqldb.failTasksWithDisappearedCells(ctx, logger, cellSet)
+	// running tasks whose cell disappeared transition to the completed state, and fail
+	failedEvents, rowsAffected = sqldb.failTasks(ctx, logger.Session("fail-tasks-with-disappeared-cells"), now,
+		tasksWithDisappearedCells(cellSet), plan.TasksWithMissingCells, cellDisappearedFailureReason)
 	convergenceResult.Events = append(convergenceResult.Events, failedEvents...)
-	convergenceResult.Metrics.TasksPruned += failedFetches
 	convergenceResult.Metrics.TasksKicked += uint64(rowsAffected)
 
 	// do this first so that we now have "Completed" tasks before cleaning up
 	// or re-sending the completion callback
-	// demotedEvents is a list of tasks transitioning from resolving back to completed state (bc they exceeded kickTasksDuration)
-	demotedEvents, failedFetches := sqldb.demoteKickableResolvingTasks(ctx, logger, kickTasksDuration)
+	demotedEvents := sqldb.demoteResolvingTasks(ctx, logger, kickableResolvingTasks(now, kickTasksDuration), plan.ResolvingTasksToDemote)
 	convergenceResult.Events = append(convergenceResult.Events, demotedEvents...)
-	convergenceResult.Metrics.TasksPruned += failedFetches
 
-	// removedEvents is a list of tasks in the completed stated that have been deleted bc the time since they initially changed to completed exceeded expireCompleteTaskDuration
-	removedEvents, rowsAffected := sqldb.deleteExpiredCompletedTasks(ctx, logger, expireCompletedTaskDuration)
+	removedEvents, rowsAffected := sqldb.deleteCompletedTasks(ctx, logger, expiredCompletedTasks(now, expireCompletedTaskDuration), plan.ExpiredCompletedTasks)
 	convergenceResult.Events = append(convergenceResult.Events, removedEvents...)
 	convergenceResult.Metrics.TasksPruned += uint64(rowsAffected)
 
-	// tasksToComplete is a list of tasks in the complete state that have exceeded kickTasksDuration
-	tasksToComplete, failedFetches := sqldb.getKickableCompleteTasksForCompletion(ctx, logger, kickTasksDuration)
-	convergenceResult.TasksToComplete = tasksToComplete
-	convergenceResult.Metrics.TasksPruned += failedFetches
-	convergenceResult.Metrics.TasksKicked += uint64(len(tasksToComplete))
+	// completed tasks that exceeded kickTasksDuration have their completion callback sent again
+	convergenceResult.TasksToComplete = plan.CompletedTasksToKick
+	convergenceResult.Metrics.TasksKicked += uint64(len(plan.CompletedTasksToKick))
 
 	convergenceResult.Metrics.TasksPending, convergenceResult.Metrics.TasksRunning, convergenceResult.Metrics.TasksCompleted, convergenceResult.Metrics.TasksResolving = sqldb.getTaskCountByState(ctx, logger)
 
 	return convergenceResult
 }
 
-func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logger, expirePendingTaskDuration time.Duration) ([]models.Event, uint64, int64) {
-	logger = logger.Session("fail-expired-pending-tasks")
+// PlanTaskConvergence returns the tasks ConvergeTasks would change, without
+// changing them.
+func (sqldb *SQLDB) PlanTaskConvergence(ctx context.Context, logger lager.Logger, cellSet models.CellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) db.TaskConvergencePlan {
+	logger = logger.Session("db-plan-task-convergence")
+	logger.Info("starting")
+	defer logger.Info("complete")
 
-	now := db.clock.Now()
+	return sqldb.planTaskConvergence(ctx, logger, sqldb.clock.Now(), cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+}
 
-	rows, err := db.all(ctx, logger, db.db, tasksTable,
-		taskColumns, helpers.NoLockRow,
-		"state = ? AND created_at < ?", models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano())
-	if err != nil {
-		logger.Error("failed-query", err)
-		return nil, 0, 0
-	}
-	defer rows.Close()
+func (sqldb *SQLDB) planTaskConvergence(ctx context.Context, logger lager.Logger, now time.Time, cellSet models.CellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) db.TaskConvergencePlan {
+	plan := db.TaskConvergencePlan{}
 
-	tasks, validTaskGuids, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
-	if err != nil {
-		logger.Error("failed-fetching-some-tasks", err)
+	invalidTaskGuids := map[string]struct{}{}
+	selectTasks := func(logger lager.Logger, query taskQuery) []*models.Task {
+		tasks, invalidGuids := sqldb.selectTasks(ctx, logger, query)
+		for _, guid := range invalidGuids {
+			if _, ok := invalidTaskGuids[guid]; !ok {
+				invalidTaskGuids[guid] = struct{}{}
+				plan.InvalidTaskGuids = append(plan.InvalidTaskGuids, guid)
+			}
+		}
+		return tasks
 	}
 
-	wheres := []string{"state = ?", "created_at < ?"}
-	bindings := []interface{}{models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano()}
+	plan.ExpiredPendingTasks = selectTasks(logger.Session("expired-pending-tasks"), expiredPendingTasks(now, expirePendingTaskDuration))
+	plan.PendingTasksToKick = selectTasks(logger.Session("kickable-pending-tasks"), kickablePendingTasks(now, expirePendingTaskDuration))
+	plan.TasksWithMissingCells = selectTasks(logger.Session("tasks-with-disappeared-cells"), tasksWithDisappearedCells(cellSet))
+	plan.ResolvingTasksToDemote = selectTasks(logger.Session("kickable-resolving-tasks"), kickableResolvingTasks(now, kickTasksDuration))
 
-	if len(validTaskGuids) == 0 {
-		return nil, uint64(invalidTasksCount), 0
+	// resolving tasks are demoted to completed before completed tasks are
+	// deleted or kicked, so they are deleted or kicked as well
+	demotedTasks := make([]*models.Task, 0, len(plan.ResolvingTasksToDemote))
+	for _, task := range plan.ResolvingTasksToDemote {
+		demotedTask := *task
+		demotedTask.State = models.Task_Completed
+		demotedTasks = append(demotedTasks, &demotedTask)
 	}
 
-	wheres = append(wheres, fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(validTaskGuids))))
-	for _, guid := range validTaskGuids {
-		bindings = append(bindings, guid)
+	expiredBefore := now.Add(-expireCompletedTaskDuration).UnixNano()
+	plan.ExpiredCompletedTasks = selectTasks(logger.Session("expired-completed-tasks"), expiredCompletedTasks(now, expireCompletedTaskDuration))
+	for _, task := range demotedTasks {
+		if task.FirstCompletedAt < expiredBefore {
+			plan.ExpiredCompletedTasks = append(plan.ExpiredCompletedTasks, task)
+		}
 	}
 
-	result, err := db.update(ctx, logger, db.db, tasksTable,
-		helpers.SQLAttributes{
-			"failed":             true,
-			"failure_reason":     expiredFailureReason,
-			"result":             "",
-			"state":              models.Task_Completed,
-			"first_completed_at": now.UnixNano(),
-			"updated_at":         now.UnixNano(),
-		},
-		strings.Join(wheres, " AND "), bindings...)
-	if err != nil {
-		logger.Error("failed-query", err)
-		return nil, uint64(invalidTasksCount), 0
+	expiredTaskGuids := map[string]struct{}{}
+	for _, task := range plan.ExpiredCompletedTasks {
+		expiredTaskGuids[task.TaskGuid] = struct{}{}
 	}
 
-	var events []models.Event
-	for _, task := range tasks {
-		afterTask := *task
-		afterTask.Failed = true
-		afterTask.FailureReason = expiredFailureReason
-		afterTask.Result = ""
-		afterTask.State = models.Task_Completed
-		afterTask.FirstCompletedAt = now.UnixNano()
-		afterTask.UpdatedAt = now.UnixNano()
-
-		events = append(events, models.NewTaskChangedEvent(task, &afterTask))
+	kickableTasks := selectTasks(logger.Session("kickable-completed-tasks"), kickableCompletedTasks(now, kickTasksDuration))
+	plan.CompletedTasksToKick = []*models.Task{}
+	for _, task := range append(kickableTasks, demotedTasks...) {
+		if _, ok := expiredTaskGuids[task.TaskGuid]; !ok {
+			plan.CompletedTasksToKick = append(plan.CompletedTasksToKick, task)
+		}
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		logger.Error("failed-rows-affected", err)
-		return events, uint64(invalidTasksCount), 0
-	}
-	return events, uint64(invalidTasksCount), rowsAffected
+	return plan
 }
 
-func (db *SQLDB) getTaskStartRequestsForKickablePendingTasks(ctx context.Context, logger lager.Logger, expirePendingTaskDuration time.Duration) ([]*auctioneer.TaskStartRequest, uint64) {
-	logger = logger.Session("get-task-start-requests-for-kickable-pending-tasks")
-
-	rows, err := db.all(ctx, logger, db.db, tasksTable,
-		taskColumns, helpers.NoLockRow,
-		"state = ? AND created_at > ?",
-		models.Task_Pending, db.clock.Now().Add(-expirePendingTaskDuration).UnixNano(),
-	)
+// taskQuery selects the tasks a step of task convergence applies to.
+type taskQuery struct {
+	wheres   []string
+	bindings []interface{}
+}
 
-	if err != nil {
-		logger.Error("failed-query", err)
-		return []*auctioneer.TaskStartRequest{}, math.MaxUint64
+func expiredPendingTasks(now time.Time, expirePendingTaskDuration time.Duration) taskQuery {
+	return taskQuery{
+		wheres:   []string{"state = ?", "created_at < ?"},
+		bindings: []interface{}{models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano()},
 	}
+}
 
-	defer rows.Close()
-
-	tasksToAuction := []*auctioneer.TaskStartRequest{}
-	tasks, _, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
-	if err != nil {
-		logger.Error("failed-fetching-some-tasks", err)
+func kickablePendingTasks(now time.Time, expirePendingTaskDuration time.Duration) taskQuery {
+	return taskQuery{
+		wheres:   []string{"state = ?", "created_at > ?"},
+		bindings: []interface{}{models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano()},
 	}
+}
 
-	for _, task := range tasks {
-		taskStartRequest := auctioneer.NewTaskStartRequestFromModel(task.TaskGuid, task.Domain, task.TaskDefinition)
-		tasksToAuction = append(tasksToAuction, &taskStartRequest)
+func tasksWithDisappearedCells(cellSet models.CellSet) taskQuery {
+	query := taskQuery{
+		wheres:   []string{"state = ?"},
+		bindings: []interface{}{models.Task_Running},
 	}
 
-	return tasksToAuction, uint64(invalidTasksCount)
+	if len(cellSet) != 0 {
+		query.wheres = append(query.wheres, fmt.Sprintf("cell_id NOT IN (%s)", helpers.QuestionMarks(len(cellSet))))
+		for cellID := range cellSet {
+			query.bindings = append(query.bindings, cellID)
+		}
+	}
+	return query
 }
 
-func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) ([]models.Event, uint64, int64) {
-	logger = logger.Session("fail-tasks-with-disappeared-cells")
+func kickableResolvingTasks(now time.Time, kickTasksDuration time.Duration) taskQuery {
+	return taskQuery{
+		wheres:   []string{"state = ?", "updated_at < ?"},
+		bindings: []interface{}{models.Task_Resolving, now.Add(-kickTasksDuration).UnixNano()},
+	}
+}
 
-	values := make([]interface{}, 0, 1+len(cellSet))
-	values = append(values, models.Task_Running)
+func expiredCompletedTasks(now time.Time, expireCompletedTaskDuration time.Duration) taskQuery {
+	return taskQuery{
+		wheres:   []string{"state = ?", "first_completed_at < ?"},
+		bindings: []interface{}{models.Task_Completed, now.Add(-expireCompletedTaskDuration).UnixNano()},
+	}
+}
 
-	for k := range cellSet {
-		values = append(values, k)
+func kickableCompletedTasks(now time.Time, kickTasksDuration time.Duration) taskQuery {
+	return taskQuery{
+		wheres:   []string{"state = ?", "updated_at < ?"},
+		bindings: []interface{}{models.Task_Completed, now.Add(-kickTasksDuration).UnixNano()},
 	}
+}
 
-	wheres := "state = ?"
-	if len(cellSet) != 0 {
-		wheres += fmt.Sprintf(" AND cell_id NOT IN (%s)", helpers.QuestionMarks(len(cellSet)))
+// forTasks restricts the query to the given tasks, so that tasks changed
+// since they were selected are left alone.
+func (q taskQuery) forTasks(tasks []*models.Task) (string, []interface{}) {
+	wheres := append([]string{}, q.wheres...)
+	bindings := append([]interface{}{}, q.bindings...)
+
+	wheres = append(wheres, fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(tasks))))
+	for _, task := range tasks {
+		bindings = append(bindings, task.TaskGuid)
 	}
-	now := db.clock.Now().UnixNano()
+	return strings.Join(wheres, " AND "), bindings
+}
 
-	rows, err := db.all(ctx, logger, db.db, tasksTable, taskColumns, helpers.NoLockRow, wheres, values...)
+// selectTasks returns the tasks matching the query, and the guids of those
+// that cannot be deserialized. It does not delete them.
+func (db *SQLDB) selectTasks(ctx context.Context, logger lager.Logger, query taskQuery) ([]*models.Task, []string) {
+	rows, err := db.all(ctx, logger, db.db, tasksTable,
+		taskColumns, helpers.NoLockRow,
+		strings.Join(query.wheres, " AND "), query.bindings...,
+	)
 	if err != nil {
 		logger.Error("failed-query", err)
-		return nil, 0, 0
+		return []*models.Task{}, nil
 	}
 	defer rows.Close()
 
-	tasks, validTaskGuids, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
-	if err != nil {
-		logger.Error("failed-fetching-tasks", err)
+	tasks := []*models.Task{}
+	invalidGuids := []string{}
+	for rows.Next() {
+		task, guid, err := db.fetchTaskInternal(logger, rows)
+		if err == models.ErrDeserialize {
+			invalidGuids = append(invalidGuids, guid)
+			continue
+		} else if err != nil {
+			continue
+		}
+		tasks = append(tasks, task)
 	}
 
-	if len(validTaskGuids) == 0 {
-		return nil, uint64(invalidTasksCount), 0
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
 	}
 
-	wheres += fmt.Sprintf(" AND guid IN (%s)", helpers.QuestionMarks(len(validTaskGuids)))
+	return tasks, invalidGuids
+}
 
-	for _, guid := range validTaskGuids {
-		values = append(values, guid)
+func (db *SQLDB) failTasks(ctx context.Context, logger lager.Logger, now time.Time, query taskQuery, tasks []*models.Task, failureReason string) ([]models.Event, int64) {
+	if len(tasks) == 0 {
+		return nil, 0
 	}
 
+	wheres, bindings := query.forTasks(tasks)
 	result, err := db.update(ctx, logger, db.db, tasksTable,
 		helpers.SQLAttributes{
 			"failed":             true,
-			"failure_reason":     cellDisappearedFailureReason,
+			"failure_reason":     failureReason,
 			"result":             "",
 			"state":              models.Task_Completed,
-			"first_completed_at": now,
-			"updated_at":         now,
+			"first_completed_at": now.UnixNano(),
+			"updated_at":         now.UnixNano(),
 		},
-		wheres, values...,
+		wheres, bindings...,
 	)
 	if err != nil {
 		logger.Error("failed-updating-tasks", err)
-		return nil, uint64(invalidTasksCount), 0
+		return nil, 0
 	}
 
 	var events []models.Event
 	for _, task := range tasks {
 		afterTask := *task
 		afterTask.Failed = true
-		afterTask.FailureReason = cellDisappearedFailureReason
+		afterTask.FailureReason = failureReason
 		afterTask.Result = ""
 		afterTask.State = models.Task_Completed
-		afterTask.FirstCompletedAt = now
-		afterTask.UpdatedAt = now
+		afterTask.FirstCompletedAt = now.UnixNano()
+		afterTask.UpdatedAt = now.UnixNano()
 
 		events = append(events, models.NewTaskChangedEvent(task, &afterTask))
 	}
@@ -237,46 +272,22 @@ func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		logger.Error("failed-rows-affected", err)
-		return events, uint64(invalidTasksCount), 0
+		return events, 0
 	}
-
-	return events, uint64(invalidTasksCount), rowsAffected
+	return events, rowsAffected
 }
 
-func (db *SQLDB) demoteKickableResolvingTasks(ctx context.Context, logger lager.Logger, kickTasksDuration time.Duration) ([]models.Event, uint64) {
+func (db *SQLDB) demoteResolvingTasks(ctx context.Context, logger lager.Logger, query taskQuery, tasks []*models.Task) []models.Event {
 	logger = logger.Session("demote-kickable-resolving-tasks")
 
-	rows, err := db.all(ctx, logger, db.db, tasksTable,
-		taskColumns, helpers.NoLockRow,
-		"state = ? AND updated_at < ?", models.Task_Resolving, db.clock.Now().Add(-kickTasksDuration).UnixNano(),
-	)
-	if err != nil {
-		logger.Error("failed-query", err)
-		return nil, 0
-	}
-	defer rows.Close()
-
-	tasks, validTaskGuids, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
-	if err != nil {
-		logger.Error("failed-fetching-tasks", err)
+	if len(tasks) == 0 {
+		return nil
 	}
 
-	wheres := []string{"state = ?", "updated_at < ?"}
-	bindings := []interface{}{models.Task_Resolving, db.clock.Now().Add(-kickTasksDuration).UnixNano()}
-
-	if len(validTaskGuids) == 0 {
-		return nil, uint64(invalidTasksCount)
-	}
-
-	wheres = append(wheres, fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(validTaskGuids))))
-
-	for _, guid := range validTaskGuids {
-		bindings = append(bindings, guid)
-	}
-
-	_, err = db.update(ctx, logger, db.db, tasksTable,
+	wheres, bindings := query.forTasks(tasks)
+	_, err := db.update(ctx, logger, db.db, tasksTable,
 		helpers.SQLAttributes{"state": models.Task_Completed},
-		strings.Join(wheres, " AND "), bindings...,
+		wheres, bindings...,
 	)
 	if err != nil {
 		logger.Error("failed-updating-tasks", err)
@@ -289,43 +300,21 @@ func (db *SQLDB) demoteKickableResolvingTasks(ctx context.Context, logger lager.
 		events = append(events, models.NewTaskChangedEvent(task, &afterTask))
 	}
 
-	return events, uint64(invalidTasksCount)
+	return events
 }
 
-func (db *SQLDB) deleteExpiredCompletedTasks(ctx context.Context, logger lager.Logger, expireCompletedTaskDuration time.Duration) ([]models.Event, int64) {
+func (db *SQLDB) deleteCompletedTasks(ctx context.Context, logger lager.Logger, query taskQuery, tasks []*models.Task) ([]models.Event, int64) {
 	logger = logger.Session("delete-expired-completed-tasks")
-	wheres := "state = ? AND first_completed_at < ?"
-	values := []interface{}{models.Task_Completed, db.clock.Now().Add(-expireCompletedTaskDuration).UnixNano()}
 
-	rows, err := db.all(ctx, logger, db.db, tasksTable,
-		taskColumns, helpers.NoLockRow,
-		wheres, values...,
-	)
-	if err != nil {
-		logger.Error("failed-query", err)
+	if len(tasks) == 0 {
 		return nil, 0
 	}
-	defer rows.Close()
-
-	tasks, validTaskGuids, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
-	if err != nil {
-		logger.Error("failed-fetching-tasks", err, lager.Data{"invalidTasksCound": int64(invalidTasksCount)})
-	}
 
-	if len(validTaskGuids) == 0 {
-		return nil, int64(invalidTasksCount)
-	}
-
-	wheres += fmt.Sprintf(" AND guid IN (%s)", helpers.QuestionMarks(len(validTaskGuids)))
-
-	for _, guid := range validTaskGuids {
-		values = append(values, guid)
-	}
-
-	result, err := db.delete(ctx, logger, db.db, tasksTable, wheres, values...)
+	wheres, bindings := query.forTasks(tasks)
+	result, err := db.delete(ctx, logger, db.db, tasksTable, wheres, bindings...)
 	if err != nil {
 		logger.Error("failed-query", err)
-		return nil, int64(invalidTasksCount)
+		return nil, 0
 	}
 
 	var events []models.Event
@@ -336,38 +325,12 @@ func (db *SQLDB) deleteExpiredCompletedTasks(ctx context.Context, logger lager.L
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		logger.Error("failed-rows-affected", err)
-		return events, int64(invalidTasksCount)
+		return events, 0
 	}
-	rowsAffected += int64(invalidTasksCount)
 
 	return events, rowsAffected
 }
 
-func (db *SQLDB) getKickableCompleteTasksForCompletion(ctx context.Context, logger lager.Logger, kickTasksDuration time.Duration) ([]*models.Task, uint64) {
-	logger = logger.Session("get-kickable-complete-tasks-for-completion")
-
-	rows, err := db.all(ctx, logger, db.db, tasksTable,
-		taskColumns, helpers.NoLockRow,
-		"state = ? AND updated_at < ?",
-		models.Task_Completed, db.clock.Now().Add(-kickTasksDuration).UnixNano(),
-	)
-
-	if err != nil {
-		logger.Error("failed-query", err)
-		return []*models.Task{}, math.MaxUint64
-	}
-
-	defer rows.Close()
-
-	tasksToComplete, _, failedFetches, err := db.fetchTasks(ctx, logger, rows, db.db, false)
-
-	if err != nil {
-		logger.Error("failed-fetching-some-tasks", err)
-	}
-
-	return tasksToComplete, uint64(failedFetches)
-}
-
 func (db *SQLDB) getTaskCountByState(ctx context.Context, logger lager.Logger) (pendingCount, runningCount, completedCount, resolvingCount int) {
 	var query string
 	switch db.flavor {
@@ -416,4 +416,78 @@ var _ = Describe("Convergence of Tasks", func() {
 			})
 		})
 	})
+
+	Describe("PlanTaskConvergence", func() {
+		var (
+			domain  string
+			cellSet models.CellSet
+			taskDef *models.TaskDefinition
+
+			plan dbpkg.TaskConvergencePlan
+		)
+
+		BeforeEach(func() {
+			domain = "my-domain"
+			cellSet = models.NewCellSetFromList([]*models.CellPresence{
+				{CellId: "existing-cell"},
+			})
+			taskDef = model_helpers.NewValidTaskDefinition()
+
+			var err error
+			fakeClock.IncrementBySeconds(-expirePendingTaskDurationInSeconds)
+			_, err = sqlDB.DesireTask(ctx, logger, taskDef, "pending-expired-task", domain)
+			Expect(err).NotTo(HaveOccurred())
+			fakeClock.IncrementBySeconds(expirePendingTaskDurationInSeconds)
+
+			fakeClock.IncrementBySeconds(-kickTasksDurationInSeconds)
+			_, err = sqlDB.DesireTask(ctx, logger, taskDef, "pending-kickable-task", domain)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.DesireTask(ctx, logger, taskDef, "pending-kickable-invalid-task", domain)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.ExecContext(ctx, "UPDATE tasks SET task_definition = 'garbage' WHERE guid = 'pending-kickable-invalid-task'")
+			Expect(err).NotTo(HaveOccurred())
+			fakeClock.IncrementBySeconds(kickTasksDurationInSeconds)
+
+			_, err = sqlDB.DesireTask(ctx, logger, taskDef, "running-task-no-cell", domain)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.StartTask(ctx, logger, "running-task-no-cell", "non-existant-cell")
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.IncrementBySeconds(1)
+		})
+
+		JustBeforeEach(func() {
+			plan = sqlDB.PlanTaskConvergence(ctx, logger, cellSet, kickTasksDuration, expirePendingTaskDuration, expireCompletedTaskDuration)
+		})
+
+		It("plans the tasks convergence would change", func() {
+			Expect(taskGuidsOf(plan.ExpiredPendingTasks)).To(ConsistOf("pending-expired-task"))
+			Expect(taskGuidsOf(plan.PendingTasksToKick)).To(ConsistOf("pending-kickable-task"))
+			Expect(taskGuidsOf(plan.TasksWithMissingCells)).To(ConsistOf("running-task-no-cell"))
+			Expect(plan.InvalidTaskGuids).To(ConsistOf("pending-kickable-invalid-task"))
+		})
+
+		It("does not change any tasks", func() {
+			task, err := sqlDB.TaskByGuid(ctx, logger, "pending-expired-task")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.State).To(Equal(models.Task_Pending))
+
+			task, err = sqlDB.TaskByGuid(ctx, logger, "running-task-no-cell")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.State).To(Equal(models.Task_Running))
+
+			var count int
+			row := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE guid = 'pending-kickable-invalid-task'")
+			Expect(row.Scan(&count)).To(Succeed())
+			Expect(count).To(Equal(1))
+		})
+	})
 })
+
+func taskGuidsOf(tasks []*models.Task) []string {
+	guids := []string{}
+	for _, task := range tasks {
+		guids = append(guids, task.TaskGuid)
+	}
+	return guids
+}
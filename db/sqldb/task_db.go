@@ -67,6 +67,14 @@ func (db *SQLDB) Tasks(ctx context.Context, logger lager.Logger, filter models.T
 		values = append(values, filter.Domain)
 	}
 
+	if len(filter.Domains) > 0 {
+		wheres = append(wheres, whereClauseForDomains(filter.Domains))
+
+		for _, domain := range filter.Domains {
+			values = append(values, domain)
+		}
+	}
+
 	if filter.CellID != "" {
 		wheres = append(wheres, "cell_id = ?")
 		values = append(values, filter.CellID)
@@ -162,6 +162,12 @@ var _ = Describe("TaskDB", func() {
 				Expect(tasks[0]).To(Equal(expectedTasks[0]))
 			})
 
+			It("can filter by a list of domains", func() {
+				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{Domains: []string{"domain-1", "domain-3"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(ConsistOf(expectedTasks[0]))
+			})
+
 			It("can filter by cell id", func() {
 				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{CellID: "cell-2"})
 				Expect(err).NotTo(HaveOccurred())
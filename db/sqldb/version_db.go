@@ -2,8 +2,10 @@ package sqldb
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -24,14 +26,32 @@ func (db *SQLDB) SetVersion(ctx context.Context, logger lager.Logger, version *m
 	return db.setConfigurationValue(ctx, logger, VersionID, string(versionJSON))
 }
 
+// Version reads the stored version in a read-only transaction, so that a
+// migration dry run can plan without writing. A missing configurations table
+// has no version.
 func (db *SQLDB) Version(ctx context.Context, logger lager.Logger) (*models.Version, error) {
 	logger = logger.Session("db-version")
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
-	versionJSON, err := db.getConfigurationValue(ctx, logger, VersionID)
+	tx, err := db.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
-		return nil, err
+		logger.Error("failed-starting-transaction", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer tx.Rollback()
+
+	var versionJSON string
+	err = db.one(ctx, logger, tx, "configurations",
+		helpers.ColumnList{"value"}, helpers.NoLockRow,
+		"id = ?", VersionID,
+	).Scan(&versionJSON)
+	if db.helper.ConvertSQLError(err) == helpers.ErrUnrecoverableError {
+		logger.Info("configurations-table-missing")
+		return nil, models.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, db.convertSQLError(err)
 	}
 
 	var version models.Version
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/bbs/db/sqldb"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/test_helpers"
 	. "github.com/onsi/ginkgo"
@@ -109,7 +110,7 @@ var _ = Describe("Version", func() {
 				db, err = helpers.Connect(logger, dbDriverName, dbBaseConnectionString+"invalid-db", "", false)
 				Expect(err).NotTo(HaveOccurred())
 				helperDB := helpers.NewMonitoredDB(db, monitor.New())
-				sqlDB = sqldb.NewSQLDB(helperDB, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
+				sqlDB = sqldb.NewSQLDB(helperDB, 5, 5, cryptor, format.NoCompression, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient)
 			})
 
 			AfterEach(func() {
@@ -122,6 +123,26 @@ var _ = Describe("Version", func() {
 			})
 		})
 
+		Context("when the configurations table does not exist", func() {
+			BeforeEach(func() {
+				_, err := db.ExecContext(ctx, "DROP TABLE configurations")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(sqlDB.CreateConfigurationsTable(ctx, logger)).To(Succeed())
+			})
+
+			It("returns a ErrResourceNotFound without creating it", func() {
+				version, err := sqlDB.Version(ctx, logger)
+				Expect(err).To(MatchError(models.ErrResourceNotFound))
+				Expect(version).To(BeNil())
+
+				_, err = db.ExecContext(ctx, "SELECT 1 FROM configurations")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		Context("when the version key does not exist", func() {
 			BeforeEach(func() {
 				_, err := db.ExecContext(ctx, "DELETE FROM configurations")
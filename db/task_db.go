@@ -19,6 +19,17 @@ type TaskConvergenceResult struct {
 	Metrics TaskMetrics
 }
 
+// TaskConvergencePlan holds the tasks ConvergeTasks would change.
+type TaskConvergencePlan struct {
+	ExpiredPendingTasks    []*models.Task
+	PendingTasksToKick     []*models.Task
+	TasksWithMissingCells  []*models.Task
+	ResolvingTasksToDemote []*models.Task
+	ExpiredCompletedTasks  []*models.Task
+	CompletedTasksToKick   []*models.Task
+	InvalidTaskGuids       []string
+}
+
 type TaskMetrics struct {
 	TasksPending   int
 	TasksRunning   int
@@ -43,4 +54,5 @@ type TaskDB interface {
 	DeleteTask(ctx context.Context, logger lager.Logger, taskGuid string) (task *models.Task, err error)
 
 	ConvergeTasks(ctx context.Context, logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) TaskConvergenceResult
+	PlanTaskConvergence(ctx context.Context, logger lager.Logger, cellSet models.CellSet, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) TaskConvergencePlan
 }
@@ -12,6 +12,8 @@ type Encrypted struct {
 	Nonce      []byte
 	KeyLabel   string
 	CipherText []byte
+	// WrappedKey is the wrapped data key of an EnvelopeKey, if one was used
+	WrappedKey []byte
 }
 
 type Encryptor interface {
@@ -56,7 +58,11 @@ func (c *cryptor) Encrypt(plaintext []byte) (Encrypted, error) {
 	}
 
 	ciphertext := aead.Seal(nil, nonce, plaintext, nil)
-	return Encrypted{KeyLabel: key.Label(), Nonce: nonce, CipherText: ciphertext}, nil
+	encrypted := Encrypted{KeyLabel: key.Label(), Nonce: nonce, CipherText: ciphertext}
+	if envelopeKey, ok := key.(EnvelopeKey); ok {
+		encrypted.WrappedKey = envelopeKey.WrappedKey()
+	}
+	return encrypted, nil
 }
 
 func (d *cryptor) Decrypt(encrypted Encrypted) ([]byte, error) {
@@ -65,6 +71,23 @@ func (d *cryptor) Decrypt(encrypted Encrypted) ([]byte, error) {
 		return nil, fmt.Errorf("Key with label %q was not found", encrypted.KeyLabel)
 	}
 
+	if _, ok := key.(EnvelopeKey); ok && len(encrypted.WrappedKey) == 0 {
+		return nil, fmt.Errorf("Payload encrypted with envelope key %q has no wrapped data key", encrypted.KeyLabel)
+	}
+
+	if len(encrypted.WrappedKey) > 0 {
+		envelopeKey, ok := key.(EnvelopeKey)
+		if !ok {
+			return nil, fmt.Errorf("Key with label %q is not an envelope key", encrypted.KeyLabel)
+		}
+
+		var err error
+		key, err = envelopeKey.DataKey(encrypted.WrappedKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	aead, err := cipher.NewGCM(key.Block())
 	if err != nil {
 		return nil, fmt.Errorf("Unable to create GCM-wrapped cipher: %q", err)
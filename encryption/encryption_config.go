@@ -1,22 +1,98 @@
 package encryption
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	PassphraseProvider   = "passphrase"
+	KeystoreProvider     = "keystore"
+	VaultTransitProvider = "vault-transit"
+
+	DefaultVaultTransitMount = "transit"
+
+	vaultTransitTimeout = 10 * time.Second
+)
 
 type EncryptionConfig struct {
-	ActiveKeyLabel string            `json:"active_key_label"`
-	EncryptionKeys map[string]string `json:"encryption_keys"`
+	ActiveKeyLabel string                       `json:"active_key_label"`
+	EncryptionKeys map[string]string            `json:"encryption_keys"`
+	EnvelopeKeys   map[string]EnvelopeKeyConfig `json:"envelope_encryption_keys,omitempty"`
 }
 
-func (ef *EncryptionConfig) Parse() (Key, []Key, error) {
-	if len(ef.EncryptionKeys) == 0 {
-		return nil, nil, errors.New("Must have at least one encryption key set")
+// EnvelopeKeyConfig selects the KeyProvider wrapping the data keys of an
+// envelope encryption key and holds the settings of that provider.
+type EnvelopeKeyConfig struct {
+	Provider string `json:"provider"`
+
+	// passphrase
+	Passphrase string `json:"passphrase,omitempty"`
+
+	// keystore
+	KeystorePath string `json:"keystore_path,omitempty"`
+
+	// keystore and vault-transit, defaults to the key label
+	KeyID string `json:"key_id,omitempty"`
+
+	// vault-transit
+	VaultAddress string `json:"vault_address,omitempty"`
+	VaultToken   string `json:"vault_token,omitempty"`
+	VaultMount   string `json:"vault_mount,omitempty"`
+}
+
+// Validate checks the labels of the keys and the settings of the envelope key
+// providers without building any keys, so it neither reads keystores nor
+// contacts Vault.
+func (ef *EncryptionConfig) Validate() error {
+	if len(ef.EncryptionKeys) == 0 && len(ef.EnvelopeKeys) == 0 {
+		return errors.New("Must have at least one encryption key set")
 	}
 
 	if len(ef.ActiveKeyLabel) == 0 {
-		return nil, nil, errors.New("Must select an active encryption key")
+		return errors.New("Must select an active encryption key")
 	}
 
-	var encryptionKey Key
+	for label := range ef.EncryptionKeys {
+		if err := validateLabel(label); err != nil {
+			return err
+		}
+	}
+
+	for label, config := range ef.EnvelopeKeys {
+		if err := validateLabel(label); err != nil {
+			return err
+		}
+
+		if _, ok := ef.EncryptionKeys[label]; ok {
+			return fmt.Errorf("Multiple keys with the same label: %q", label)
+		}
+
+		if err := config.validate(label); err != nil {
+			return err
+		}
+	}
+
+	_, isKey := ef.EncryptionKeys[ef.ActiveKeyLabel]
+	_, isEnvelopeKey := ef.EnvelopeKeys[ef.ActiveKeyLabel]
+	if !isKey && !isEnvelopeKey {
+		return errors.New("The selected active key must be listed on the encryption keys flag")
+	}
+
+	return nil
+}
+
+// Parse returns the active key and all the keys. The envelope keys do not
+// call their provider yet: NewKeyManager creates the data key of the active
+// one, and the others only unwrap data keys when a payload needs them.
+func (ef *EncryptionConfig) Parse() (Key, []Key, error) {
+	err := ef.Validate()
+	if err != nil {
+		return nil, nil, err
+	}
 
 	labelsToKeys := map[string]Key{}
 
@@ -28,9 +104,17 @@ func (ef *EncryptionConfig) Parse() (Key, []Key, error) {
 		labelsToKeys[label] = key
 	}
 
-	encryptionKey, ok := labelsToKeys[ef.ActiveKeyLabel]
-	if !ok {
-		return nil, nil, errors.New("The selected active key must be listed on the encryption keys flag")
+	for label, config := range ef.EnvelopeKeys {
+		provider, err := config.provider(label)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key, err := NewDeferredEnvelopeKey(label, provider)
+		if err != nil {
+			return nil, nil, err
+		}
+		labelsToKeys[label] = key
 	}
 
 	keys := []Key{}
@@ -38,5 +122,51 @@ func (ef *EncryptionConfig) Parse() (Key, []Key, error) {
 		keys = append(keys, v)
 	}
 
-	return encryptionKey, keys, nil
+	return labelsToKeys[ef.ActiveKeyLabel], keys, nil
+}
+
+func (c EnvelopeKeyConfig) validate(label string) error {
+	switch c.Provider {
+	case PassphraseProvider:
+		return nil
+	case KeystoreProvider:
+		if c.KeystorePath == "" {
+			return fmt.Errorf("Envelope key %q requires a keystore_path", label)
+		}
+		return nil
+	case VaultTransitProvider:
+		if c.VaultAddress == "" {
+			return fmt.Errorf("Envelope key %q requires a vault_address", label)
+		}
+		address, err := url.Parse(c.VaultAddress)
+		if err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+			return fmt.Errorf("Envelope key %q has an invalid vault_address %q", label, c.VaultAddress)
+		}
+		return nil
+	default:
+		return fmt.Errorf("Envelope key %q has unknown provider %q", label, c.Provider)
+	}
+}
+
+func (c EnvelopeKeyConfig) provider(label string) (KeyProvider, error) {
+	keyID := c.KeyID
+	if keyID == "" {
+		keyID = label
+	}
+
+	switch c.Provider {
+	case PassphraseProvider:
+		return NewPassphraseProvider(c.Passphrase)
+	case KeystoreProvider:
+		return NewKeystoreProvider(c.KeystorePath, keyID)
+	case VaultTransitProvider:
+		mount := c.VaultMount
+		if mount == "" {
+			mount = DefaultVaultTransitMount
+		}
+		client := &http.Client{Timeout: vaultTransitTimeout}
+		return NewVaultTransitProvider(client, c.VaultAddress, c.VaultToken, mount, keyID), nil
+	default:
+		return nil, fmt.Errorf("Envelope key %q has unknown provider %q", label, c.Provider)
+	}
 }
@@ -73,4 +73,96 @@ var _ = Describe("Encryption Flags", func() {
 			Expect(keyLabels).To(ContainElement("old-label"))
 		})
 	})
+	Describe("envelope encryption keys", func() {
+		It("creates envelope keys for the configured providers", func() {
+			encryptionConfig.ActiveKeyLabel = "envelope"
+			encryptionConfig.EncryptionKeys["label"] = "key"
+			encryptionConfig.EnvelopeKeys = map[string]encryption.EnvelopeKeyConfig{
+				"envelope": {Provider: encryption.PassphraseProvider, Passphrase: "kek"},
+			}
+
+			key, keys, err := encryptionConfig.Parse()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key.Label()).To(Equal("envelope"))
+			_, isEnvelopeKey := key.(encryption.EnvelopeKey)
+			Expect(isEnvelopeKey).To(BeTrue())
+			Expect(keys).To(HaveLen(2))
+		})
+
+		It("does not contact the providers", func() {
+			encryptionConfig.ActiveKeyLabel = "label"
+			encryptionConfig.EncryptionKeys["label"] = "key"
+			encryptionConfig.EnvelopeKeys = map[string]encryption.EnvelopeKeyConfig{
+				"retired": {Provider: encryption.VaultTransitProvider, VaultAddress: "http://127.0.0.1:1"},
+			}
+
+			key, keys, err := encryptionConfig.Parse()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = encryption.NewKeyManager(key, keys)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not require passphrase keys", func() {
+			encryptionConfig.ActiveKeyLabel = "envelope"
+			encryptionConfig.EnvelopeKeys = map[string]encryption.EnvelopeKeyConfig{
+				"envelope": {Provider: encryption.PassphraseProvider, Passphrase: "kek"},
+			}
+
+			_, _, err := encryptionConfig.Parse()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("fails when a label is used twice", func() {
+			encryptionConfig.ActiveKeyLabel = "label"
+			encryptionConfig.EncryptionKeys["label"] = "key"
+			encryptionConfig.EnvelopeKeys = map[string]encryption.EnvelopeKeyConfig{
+				"label": {Provider: encryption.PassphraseProvider, Passphrase: "kek"},
+			}
+
+			_, _, err := encryptionConfig.Parse()
+			Expect(err).To(MatchError(`Multiple keys with the same label: "label"`))
+		})
+
+		It("fails for unknown providers", func() {
+			encryptionConfig.ActiveKeyLabel = "envelope"
+			encryptionConfig.EnvelopeKeys = map[string]encryption.EnvelopeKeyConfig{
+				"envelope": {Provider: "hsm"},
+			}
+
+			_, _, err := encryptionConfig.Parse()
+			Expect(err).To(MatchError(`Envelope key "envelope" has unknown provider "hsm"`))
+		})
+
+		It("fails when the keystore provider has no keystore", func() {
+			encryptionConfig.ActiveKeyLabel = "envelope"
+			encryptionConfig.EnvelopeKeys = map[string]encryption.EnvelopeKeyConfig{
+				"envelope": {Provider: encryption.KeystoreProvider},
+			}
+
+			_, _, err := encryptionConfig.Parse()
+			Expect(err).To(MatchError(`Envelope key "envelope" requires a keystore_path`))
+		})
+
+		It("fails when the vault address is not a URL", func() {
+			encryptionConfig.ActiveKeyLabel = "envelope"
+			encryptionConfig.EnvelopeKeys = map[string]encryption.EnvelopeKeyConfig{
+				"envelope": {Provider: encryption.VaultTransitProvider, VaultAddress: "vault:8200"},
+			}
+
+			Expect(encryptionConfig.Validate()).To(MatchError(`Envelope key "envelope" has an invalid vault_address "vault:8200"`))
+		})
+
+		It("validates without reading keystores", func() {
+			encryptionConfig.ActiveKeyLabel = "envelope"
+			encryptionConfig.EnvelopeKeys = map[string]encryption.EnvelopeKeyConfig{
+				"envelope": {Provider: encryption.KeystoreProvider, KeystorePath: "/does/not/exist"},
+			}
+
+			Expect(encryptionConfig.Validate()).To(Succeed())
+
+			_, _, err := encryptionConfig.Parse()
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,205 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package encryptionfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/encryption"
+)
+
+type FakeKeyProvider struct {
+	UnwrapKeyStub        func([]byte) ([]byte, error)
+	unwrapKeyMutex       sync.RWMutex
+	unwrapKeyArgsForCall []struct {
+		arg1 []byte
+	}
+	unwrapKeyReturns struct {
+		result1 []byte
+		result2 error
+	}
+	unwrapKeyReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	WrapKeyStub        func([]byte) ([]byte, error)
+	wrapKeyMutex       sync.RWMutex
+	wrapKeyArgsForCall []struct {
+		arg1 []byte
+	}
+	wrapKeyReturns struct {
+		result1 []byte
+		result2 error
+	}
+	wrapKeyReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeKeyProvider) UnwrapKey(arg1 []byte) ([]byte, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.unwrapKeyMutex.Lock()
+	ret, specificReturn := fake.unwrapKeyReturnsOnCall[len(fake.unwrapKeyArgsForCall)]
+	fake.unwrapKeyArgsForCall = append(fake.unwrapKeyArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.UnwrapKeyStub
+	fakeReturns := fake.unwrapKeyReturns
+	fake.recordInvocation("UnwrapKey", []interface{}{arg1Copy})
+	fake.unwrapKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyCallCount() int {
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	return len(fake.unwrapKeyArgsForCall)
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyCalls(stub func([]byte) ([]byte, error)) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = stub
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyArgsForCall(i int) []byte {
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	argsForCall := fake.unwrapKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyReturns(result1 []byte, result2 error) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = nil
+	fake.unwrapKeyReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = nil
+	if fake.unwrapKeyReturnsOnCall == nil {
+		fake.unwrapKeyReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.unwrapKeyReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyProvider) WrapKey(arg1 []byte) ([]byte, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.wrapKeyMutex.Lock()
+	ret, specificReturn := fake.wrapKeyReturnsOnCall[len(fake.wrapKeyArgsForCall)]
+	fake.wrapKeyArgsForCall = append(fake.wrapKeyArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.WrapKeyStub
+	fakeReturns := fake.wrapKeyReturns
+	fake.recordInvocation("WrapKey", []interface{}{arg1Copy})
+	fake.wrapKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKeyProvider) WrapKeyCallCount() int {
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	return len(fake.wrapKeyArgsForCall)
+}
+
+func (fake *FakeKeyProvider) WrapKeyCalls(stub func([]byte) ([]byte, error)) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = stub
+}
+
+func (fake *FakeKeyProvider) WrapKeyArgsForCall(i int) []byte {
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	argsForCall := fake.wrapKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKeyProvider) WrapKeyReturns(result1 []byte, result2 error) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = nil
+	fake.wrapKeyReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyProvider) WrapKeyReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = nil
+	if fake.wrapKeyReturnsOnCall == nil {
+		fake.wrapKeyReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.wrapKeyReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeKeyProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ encryption.KeyProvider = new(FakeKeyProvider)
@@ -0,0 +1,181 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+const DataKeySize = 32
+
+//go:generate counterfeiter . KeyProvider
+
+// KeyProvider wraps and unwraps data keys with a key-encryption key that
+// never leaves the provider.
+type KeyProvider interface {
+	WrapKey(dataKey []byte) ([]byte, error)
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+}
+
+// EnvelopeKey is a Key whose block is a data key wrapped by a KeyProvider.
+// Payloads encrypted with it carry the wrapped data key so that any data key
+// wrapped by the same provider can be recovered for decryption.
+type EnvelopeKey interface {
+	Key
+	WrappedKey() []byte
+	DataKey(wrappedKey []byte) (Key, error)
+	GenerateDataKey() error
+}
+
+type envelopeKey struct {
+	key
+	wrappedKey []byte
+	provider   KeyProvider
+	prng       io.Reader
+
+	dataKeysLock sync.Mutex
+	dataKeys     map[string]Key
+}
+
+// NewEnvelopeKey generates a random data key and wraps it with the provider.
+func NewEnvelopeKey(label string, provider KeyProvider) (EnvelopeKey, error) {
+	return newEnvelopeKey(label, provider, rand.Reader)
+}
+
+func newEnvelopeKey(label string, provider KeyProvider, prng io.Reader) (EnvelopeKey, error) {
+	k, err := newDeferredEnvelopeKey(label, provider, prng)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.GenerateDataKey()
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// NewDeferredEnvelopeKey returns an envelope key without a data key of its
+// own, so that the provider is only called once it unwraps the data key of a
+// payload, or once GenerateDataKey is called before it encrypts.
+func NewDeferredEnvelopeKey(label string, provider KeyProvider) (EnvelopeKey, error) {
+	return newDeferredEnvelopeKey(label, provider, rand.Reader)
+}
+
+func newDeferredEnvelopeKey(label string, provider KeyProvider, prng io.Reader) (*envelopeKey, error) {
+	err := validateLabel(label)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envelopeKey{
+		key:      key{label: label},
+		provider: provider,
+		prng:     prng,
+		dataKeys: map[string]Key{},
+	}, nil
+}
+
+// GenerateDataKey generates a random data key and wraps it with the provider,
+// unless the key already has one.
+func (k *envelopeKey) GenerateDataKey() error {
+	k.dataKeysLock.Lock()
+	defer k.dataKeysLock.Unlock()
+
+	if k.wrappedKey != nil {
+		return nil
+	}
+
+	dataKey := make([]byte, DataKeySize)
+	_, err := io.ReadFull(k.prng, dataKey)
+	if err != nil {
+		return fmt.Errorf("Unable to generate data key: %q", err)
+	}
+
+	wrappedKey, err := k.provider.WrapKey(dataKey)
+	if err != nil {
+		return fmt.Errorf("Unable to wrap data key for %q: %s", k.label, err)
+	}
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return err
+	}
+
+	k.block = block
+	k.wrappedKey = wrappedKey
+	k.dataKeys[string(wrappedKey)] = &key{label: k.label, block: block}
+	return nil
+}
+
+func (k *envelopeKey) WrappedKey() []byte {
+	return k.wrappedKey
+}
+
+// DataKey unwraps the given data key with the provider. Unwrapped keys are
+// cached, as every payload written by the same BBS carries the same one.
+func (k *envelopeKey) DataKey(wrappedKey []byte) (Key, error) {
+	k.dataKeysLock.Lock()
+	defer k.dataKeysLock.Unlock()
+
+	if dataKey, ok := k.dataKeys[string(wrappedKey)]; ok {
+		return dataKey, nil
+	}
+
+	dataKey, err := k.provider.UnwrapKey(wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to unwrap data key for %q: %s", k.label, err)
+	}
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	unwrapped := &key{label: k.label, block: block}
+	k.dataKeys[string(wrappedKey)] = unwrapped
+	return unwrapped, nil
+}
+
+// aesKeyWrapper wraps data keys locally with AES-GCM.
+type aesKeyWrapper struct {
+	aead cipher.AEAD
+	prng io.Reader
+}
+
+func newAESKeyWrapper(keyEncryptionKey []byte) (*aesKeyWrapper, error) {
+	block, err := aes.NewCipher(keyEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create GCM-wrapped cipher: %q", err)
+	}
+
+	return &aesKeyWrapper{aead: aead, prng: rand.Reader}, nil
+}
+
+func (w *aesKeyWrapper) WrapKey(dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, w.aead.NonceSize())
+	_, err := io.ReadFull(w.prng, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to generate random nonce: %q", err)
+	}
+
+	return w.aead.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func (w *aesKeyWrapper) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < w.aead.NonceSize() {
+		return nil, errors.New("Wrapped key is truncated")
+	}
+
+	nonceSize := w.aead.NonceSize()
+	return w.aead.Open(nil, wrappedKey[:nonceSize], wrappedKey[nonceSize:], nil)
+}
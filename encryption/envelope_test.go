@@ -0,0 +1,268 @@
+package encryption_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Envelope encryption", func() {
+	Describe("EnvelopeKey", func() {
+		var provider *encryptionfakes.FakeKeyProvider
+
+		BeforeEach(func() {
+			localProvider, err := encryption.NewPassphraseProvider("kek")
+			Expect(err).NotTo(HaveOccurred())
+
+			provider = &encryptionfakes.FakeKeyProvider{}
+			provider.WrapKeyStub = localProvider.WrapKey
+			provider.UnwrapKeyStub = localProvider.UnwrapKey
+		})
+
+		It("wraps a freshly generated data key with the provider", func() {
+			key, err := encryption.NewEnvelopeKey("label", provider)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(key.Label()).To(Equal("label"))
+			Expect(provider.WrapKeyCallCount()).To(Equal(1))
+			Expect(provider.WrapKeyArgsForCall(0)).To(HaveLen(encryption.DataKeySize))
+			Expect(key.WrappedKey()).NotTo(BeEmpty())
+		})
+
+		It("unwraps data keys of other envelope keys with the same provider once", func() {
+			writer, err := encryption.NewEnvelopeKey("label", provider)
+			Expect(err).NotTo(HaveOccurred())
+			reader, err := encryption.NewEnvelopeKey("label", provider)
+			Expect(err).NotTo(HaveOccurred())
+
+			dataKey, err := reader.DataKey(writer.WrappedKey())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataKey.Block()).To(Equal(writer.Block()))
+
+			_, err = reader.DataKey(writer.WrappedKey())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(provider.UnwrapKeyCallCount()).To(Equal(1))
+		})
+
+		It("does not unwrap its own data key", func() {
+			key, err := encryption.NewEnvelopeKey("label", provider)
+			Expect(err).NotTo(HaveOccurred())
+
+			dataKey, err := key.DataKey(key.WrappedKey())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataKey.Block()).To(Equal(key.Block()))
+			Expect(provider.UnwrapKeyCallCount()).To(Equal(0))
+		})
+
+		It("fails when the provider cannot wrap the data key", func() {
+			provider.WrapKeyReturns(nil, errors.New("kms unavailable"))
+			_, err := encryption.NewEnvelopeKey("label", provider)
+			Expect(err).To(MatchError(`Unable to wrap data key for "label": kms unavailable`))
+		})
+
+		It("requires a label", func() {
+			_, err := encryption.NewEnvelopeKey("", provider)
+			Expect(err).To(MatchError("A key label is required"))
+		})
+
+		Describe("deferred envelope keys", func() {
+			It("do not call the provider until they unwrap a data key", func() {
+				writer, err := encryption.NewEnvelopeKey("label", provider)
+				Expect(err).NotTo(HaveOccurred())
+
+				reader, err := encryption.NewDeferredEnvelopeKey("label", provider)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(provider.WrapKeyCallCount()).To(Equal(1))
+				Expect(reader.WrappedKey()).To(BeNil())
+
+				dataKey, err := reader.DataKey(writer.WrappedKey())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dataKey.Block()).To(Equal(writer.Block()))
+				Expect(provider.WrapKeyCallCount()).To(Equal(1))
+			})
+
+			It("generate their data key once", func() {
+				key, err := encryption.NewDeferredEnvelopeKey("label", provider)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(key.GenerateDataKey()).To(Succeed())
+				wrappedKey := key.WrappedKey()
+				Expect(wrappedKey).NotTo(BeEmpty())
+
+				Expect(key.GenerateDataKey()).To(Succeed())
+				Expect(key.WrappedKey()).To(Equal(wrappedKey))
+				Expect(provider.WrapKeyCallCount()).To(Equal(1))
+			})
+
+			It("refuse payloads without a wrapped data key", func() {
+				active, err := encryption.NewKey("active", "phrase")
+				Expect(err).NotTo(HaveOccurred())
+				key, err := encryption.NewDeferredEnvelopeKey("label", provider)
+				Expect(err).NotTo(HaveOccurred())
+				keyManager, err := encryption.NewKeyManager(active, []encryption.Key{key})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = encryption.NewCryptor(keyManager, rand.Reader).Decrypt(encryption.Encrypted{KeyLabel: "label"})
+				Expect(err).To(MatchError(`Payload encrypted with envelope key "label" has no wrapped data key`))
+			})
+		})
+
+		It("round trips through a cryptor", func() {
+			key, err := encryption.NewEnvelopeKey("label", provider)
+			Expect(err).NotTo(HaveOccurred())
+			keyManager, err := encryption.NewKeyManager(key, nil)
+			Expect(err).NotTo(HaveOccurred())
+			cryptor := encryption.NewCryptor(keyManager, rand.Reader)
+
+			encrypted, err := cryptor.Encrypt([]byte("plaintext"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encrypted.WrappedKey).To(Equal(key.WrappedKey()))
+
+			otherKey, err := encryption.NewEnvelopeKey("label", provider)
+			Expect(err).NotTo(HaveOccurred())
+			otherKeyManager, err := encryption.NewKeyManager(otherKey, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			plaintext, err := encryption.NewCryptor(otherKeyManager, rand.Reader).Decrypt(encrypted)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("plaintext")))
+		})
+
+		It("refuses wrapped keys for plain keys", func() {
+			plainKey, err := encryption.NewKey("label", "phrase")
+			Expect(err).NotTo(HaveOccurred())
+			keyManager, err := encryption.NewKeyManager(plainKey, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = encryption.NewCryptor(keyManager, rand.Reader).Decrypt(encryption.Encrypted{KeyLabel: "label", WrappedKey: []byte("wrapped")})
+			Expect(err).To(MatchError(`Key with label "label" is not an envelope key`))
+		})
+	})
+
+	Describe("PassphraseProvider", func() {
+		It("only unwraps keys it wrapped itself", func() {
+			provider, err := encryption.NewPassphraseProvider("phrase")
+			Expect(err).NotTo(HaveOccurred())
+			otherProvider, err := encryption.NewPassphraseProvider("other phrase")
+			Expect(err).NotTo(HaveOccurred())
+
+			wrapped, err := provider.WrapKey([]byte("data key"))
+			Expect(err).NotTo(HaveOccurred())
+
+			dataKey, err := provider.UnwrapKey(wrapped)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataKey).To(Equal([]byte("data key")))
+
+			_, err = otherProvider.UnwrapKey(wrapped)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("KeystoreProvider", func() {
+		var dir, keystorePath string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "keystore")
+			Expect(err).NotTo(HaveOccurred())
+
+			kek := make([]byte, encryption.DataKeySize)
+			_, err = rand.Read(kek)
+			Expect(err).NotTo(HaveOccurred())
+
+			keystorePath = filepath.Join(dir, "keystore.json")
+			keystore := `{"kek-1": "` + base64.StdEncoding.EncodeToString(kek) + `", "short": "c2hvcnQ="}`
+			Expect(ioutil.WriteFile(keystorePath, []byte(keystore), 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("wraps and unwraps with the key with the given id", func() {
+			provider, err := encryption.NewKeystoreProvider(keystorePath, "kek-1")
+			Expect(err).NotTo(HaveOccurred())
+
+			wrapped, err := provider.WrapKey([]byte("data key"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wrapped).NotTo(ContainSubstring("data key"))
+
+			dataKey, err := provider.UnwrapKey(wrapped)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataKey).To(Equal([]byte("data key")))
+		})
+
+		It("fails for unknown key ids", func() {
+			_, err := encryption.NewKeystoreProvider(keystorePath, "kek-2")
+			Expect(err).To(MatchError(ContainSubstring(`Key "kek-2" was not found`)))
+		})
+
+		It("fails for keys of the wrong size", func() {
+			_, err := encryption.NewKeystoreProvider(keystorePath, "short")
+			Expect(err).To(MatchError(ContainSubstring("must be 32 bytes")))
+		})
+
+		It("fails when the keystore does not exist", func() {
+			_, err := encryption.NewKeystoreProvider(keystorePath+".missing", "kek-1")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("VaultTransitProvider", func() {
+		var (
+			server   *ghttp.Server
+			provider encryption.KeyProvider
+		)
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			provider = encryption.NewVaultTransitProvider(http.DefaultClient, server.URL()+"/", "some-token", "/transit/", "bbs-kek")
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("wraps data keys with the transit encrypt endpoint", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/transit/encrypt/bbs-kek"),
+				ghttp.VerifyHeaderKV("X-Vault-Token", "some-token"),
+				ghttp.VerifyJSON(`{"plaintext": "ZGF0YSBrZXk="}`),
+				ghttp.RespondWith(http.StatusOK, `{"data": {"ciphertext": "vault:v1:abc"}}`),
+			))
+
+			wrapped, err := provider.WrapKey([]byte("data key"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wrapped).To(Equal([]byte("vault:v1:abc")))
+		})
+
+		It("unwraps data keys with the transit decrypt endpoint", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/transit/decrypt/bbs-kek"),
+				ghttp.VerifyJSON(`{"ciphertext": "vault:v1:abc"}`),
+				ghttp.RespondWith(http.StatusOK, `{"data": {"plaintext": "ZGF0YSBrZXk="}}`),
+			))
+
+			dataKey, err := provider.UnwrapKey([]byte("vault:v1:abc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dataKey).To(Equal([]byte("data key")))
+		})
+
+		It("returns the errors reported by vault", func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, `{"errors": ["permission denied"]}`))
+
+			_, err := provider.WrapKey([]byte("data key"))
+			Expect(err).To(MatchError("Vault transit encrypt failed with status 403: permission denied"))
+		})
+	})
+})
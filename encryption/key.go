@@ -20,12 +20,9 @@ type key struct {
 }
 
 func NewKey(label, phrase string) (Key, error) {
-	if label == "" {
-		return nil, errors.New("A key label is required")
-	}
-
-	if len(label) > 127 {
-		return nil, errors.New("Key label is longer than 127 bytes")
+	err := validateLabel(label)
+	if err != nil {
+		return nil, err
 	}
 
 	hash := sha256.Sum256([]byte(phrase))
@@ -40,6 +37,18 @@ func NewKey(label, phrase string) (Key, error) {
 	}, nil
 }
 
+func validateLabel(label string) error {
+	if label == "" {
+		return errors.New("A key label is required")
+	}
+
+	if len(label) > 127 {
+		return errors.New("Key label is longer than 127 bytes")
+	}
+
+	return nil
+}
+
 func (k *key) Label() string {
 	return k.label
 }
@@ -1,8 +1,12 @@
 package encryption
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 type keyManager struct {
+	lock           sync.RWMutex
 	encryptionKey  Key
 	decryptionKeys map[string]Key
 }
@@ -10,9 +14,15 @@ type keyManager struct {
 type KeyManager interface {
 	EncryptionKey() Key
 	DecryptionKey(label string) Key
+	SetEncryptionKey(label string) error
 }
 
 func NewKeyManager(encryptionKey Key, decryptionKeys []Key) (KeyManager, error) {
+	err := generateDataKey(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
 	decryptionKeyMap := map[string]Key{
 		encryptionKey.Label(): encryptionKey,
 	}
@@ -31,9 +41,41 @@ func NewKeyManager(encryptionKey Key, decryptionKeys []Key) (KeyManager, error)
 }
 
 func (m *keyManager) EncryptionKey() Key {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
 	return m.encryptionKey
 }
 
 func (m *keyManager) DecryptionKey(label string) Key {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
 	return m.decryptionKeys[label]
 }
+
+// SetEncryptionKey makes the known key with the given label the key used for
+// all subsequent encryptions. Existing data is not re-encrypted.
+func (m *keyManager) SetEncryptionKey(label string) error {
+	key := m.DecryptionKey(label)
+	if key == nil {
+		return fmt.Errorf("Key with label %q was not found", label)
+	}
+
+	err := generateDataKey(key)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.encryptionKey = key
+	return nil
+}
+
+// generateDataKey makes sure an envelope key has a data key to encrypt with,
+// as the envelope keys only used for decryption do not create one.
+func generateDataKey(key Key) error {
+	if envelopeKey, ok := key.(EnvelopeKey); ok {
+		return envelopeKey.GenerateDataKey()
+	}
+	return nil
+}
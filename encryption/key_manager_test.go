@@ -1,7 +1,10 @@
 package encryption_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -63,6 +66,83 @@ var _ = Describe("KeyManager", func() {
 		})
 	})
 
+	Describe("SetEncryptionKey", func() {
+		var otherKey encryption.Key
+
+		BeforeEach(func() {
+			var err error
+			otherKey, err = encryption.NewKey("other label", "other pass phrase")
+			Expect(err).NotTo(HaveOccurred())
+			decryptionKeys = []encryption.Key{otherKey}
+		})
+
+		It("switches the encryption key to a known key", func() {
+			Expect(manager.SetEncryptionKey("other label")).To(Succeed())
+			Expect(manager.EncryptionKey()).To(Equal(otherKey))
+			Expect(manager.DecryptionKey(encryptionKey.Label())).To(Equal(encryptionKey))
+		})
+
+		It("refuses to switch to an unknown key", func() {
+			Expect(manager.SetEncryptionKey("unknown")).To(MatchError(`Key with label "unknown" was not found`))
+			Expect(manager.EncryptionKey()).To(Equal(encryptionKey))
+		})
+
+		Context("when the key is a deferred envelope key", func() {
+			var provider *encryptionfakes.FakeKeyProvider
+
+			BeforeEach(func() {
+				provider = &encryptionfakes.FakeKeyProvider{}
+				provider.WrapKeyReturns([]byte("wrapped"), nil)
+
+				var err error
+				otherKey, err = encryption.NewDeferredEnvelopeKey("other label", provider)
+				Expect(err).NotTo(HaveOccurred())
+				decryptionKeys = []encryption.Key{otherKey}
+			})
+
+			It("only generates its data key when it becomes the encryption key", func() {
+				Expect(provider.WrapKeyCallCount()).To(Equal(0))
+				Expect(manager.SetEncryptionKey("other label")).To(Succeed())
+				Expect(provider.WrapKeyCallCount()).To(Equal(1))
+				Expect(otherKey.(encryption.EnvelopeKey).WrappedKey()).To(Equal([]byte("wrapped")))
+			})
+
+			It("keeps the encryption key when the data key cannot be wrapped", func() {
+				provider.WrapKeyReturns(nil, errors.New("kms unavailable"))
+				Expect(manager.SetEncryptionKey("other label")).To(MatchError(`Unable to wrap data key for "other label": kms unavailable`))
+				Expect(manager.EncryptionKey()).To(Equal(encryptionKey))
+			})
+		})
+	})
+
+	Context("when the encryption key is a deferred envelope key", func() {
+		var provider *encryptionfakes.FakeKeyProvider
+
+		BeforeEach(func() {
+			provider = &encryptionfakes.FakeKeyProvider{}
+			provider.WrapKeyReturns([]byte("wrapped"), nil)
+
+			var err error
+			encryptionKey, err = encryption.NewDeferredEnvelopeKey("envelope label", provider)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("generates its data key", func() {
+			Expect(cerr).NotTo(HaveOccurred())
+			Expect(provider.WrapKeyCallCount()).To(Equal(1))
+		})
+
+		Context("when the data key cannot be wrapped", func() {
+			BeforeEach(func() {
+				provider.WrapKeyReturns(nil, errors.New("kms unavailable"))
+			})
+
+			It("returns the error", func() {
+				Expect(cerr).To(MatchError(`Unable to wrap data key for "envelope label": kms unavailable`))
+			})
+		})
+	})
+
 	Context("when the encryption key and a decryption key have the same label but different blocks", func() {
 		BeforeEach(func() {
 			decryptKey, err := encryption.NewKey("key label", "a different pass phrase")
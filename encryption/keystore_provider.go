@@ -0,0 +1,40 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// NewKeystoreProvider returns a KeyProvider backed by a software keystore.
+// The keystore is a JSON file mapping key IDs to base64-encoded 256 bit
+// key-encryption keys; only the key with the given ID is loaded.
+func NewKeystoreProvider(path, keyID string) (KeyProvider, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keystore := map[string]string{}
+	err = json.Unmarshal(data, &keystore)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse keystore %s: %s", path, err)
+	}
+
+	encodedKey, ok := keystore[keyID]
+	if !ok {
+		return nil, fmt.Errorf("Key %q was not found in keystore %s", keyID, path)
+	}
+
+	keyEncryptionKey, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("Key %q in keystore %s is not valid base64: %s", keyID, path, err)
+	}
+
+	if len(keyEncryptionKey) != DataKeySize {
+		return nil, fmt.Errorf("Key %q in keystore %s must be %d bytes", keyID, path, DataKeySize)
+	}
+
+	return newAESKeyWrapper(keyEncryptionKey)
+}
@@ -0,0 +1,10 @@
+package encryption
+
+import "crypto/sha256"
+
+// NewPassphraseProvider returns a KeyProvider whose key-encryption key is
+// derived from a passphrase in the same way as the keys returned by NewKey.
+func NewPassphraseProvider(phrase string) (KeyProvider, error) {
+	hash := sha256.Sum256([]byte(phrase))
+	return newAESKeyWrapper(hash[:])
+}
@@ -0,0 +1,96 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type vaultTransitProvider struct {
+	client  *http.Client
+	address string
+	token   string
+	mount   string
+	keyName string
+}
+
+type vaultTransitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultTransitResponse struct {
+	Data   vaultTransitRequest `json:"data"`
+	Errors []string            `json:"errors"`
+}
+
+// NewVaultTransitProvider returns a KeyProvider that wraps data keys with the
+// named key of a Vault transit secrets engine mounted at mount.
+func NewVaultTransitProvider(client *http.Client, address, token, mount, keyName string) KeyProvider {
+	return &vaultTransitProvider{
+		client:  client,
+		address: strings.TrimSuffix(address, "/"),
+		token:   token,
+		mount:   strings.Trim(mount, "/"),
+		keyName: keyName,
+	}
+}
+
+func (p *vaultTransitProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	response, err := p.do("encrypt", vaultTransitRequest{Plaintext: base64.StdEncoding.EncodeToString(dataKey)})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Data.Ciphertext == "" {
+		return nil, errors.New("Vault transit returned no ciphertext")
+	}
+	return []byte(response.Data.Ciphertext), nil
+}
+
+func (p *vaultTransitProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	response, err := p.do("decrypt", vaultTransitRequest{Ciphertext: string(wrappedKey)})
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(response.Data.Plaintext)
+}
+
+func (p *vaultTransitProvider) do(operation string, request vaultTransitRequest) (*vaultTransitResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", p.address, p.mount, operation, p.keyName)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := &vaultTransitResponse{}
+	err = json.NewDecoder(resp.Body).Decode(response)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Vault transit %s failed with status %d: %s", operation, resp.StatusCode, strings.Join(response.Errors, "; "))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse Vault transit response: %s", err)
+	}
+
+	return response, nil
+}
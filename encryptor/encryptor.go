@@ -3,7 +3,10 @@ package encryptor
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/encryption"
@@ -15,8 +18,13 @@ import (
 
 const (
 	encryptionDuration = "EncryptionDuration"
+
+	MaxTableAttempts   = 3
+	TableRetryInterval = 5 * time.Second
 )
 
+var ErrEncryptionInProgress = models.NewError(models.Error_ResourceConflict, "re-encryption is already in progress")
+
 type Encryptor struct {
 	logger       lager.Logger
 	db           db.EncryptionDB
@@ -24,6 +32,12 @@ type Encryptor struct {
 	cryptor      encryption.Cryptor
 	clock        clock.Clock
 	metronClient loggingclient.IngressClient
+
+	configuredLabel string
+	encryptions     chan struct{}
+
+	statusLock sync.Mutex
+	status     models.EncryptionStatus
 }
 
 func New(
@@ -33,22 +47,30 @@ func New(
 	cryptor encryption.Cryptor,
 	clock clock.Clock,
 	metronClient loggingclient.IngressClient,
-) Encryptor {
-	return Encryptor{
+) *Encryptor {
+	return &Encryptor{
 		logger:       logger,
 		db:           db,
 		keyManager:   keyManager,
 		cryptor:      cryptor,
 		clock:        clock,
 		metronClient: metronClient,
+
+		configuredLabel: keyManager.EncryptionKey().Label(),
+		encryptions:     make(chan struct{}, 1),
 	}
 }
 
-func (m Encryptor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+func (m *Encryptor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	logger := m.logger.Session("encryptor")
 	logger.Info("starting")
 	defer logger.Info("exited")
 
+	err := m.restoreRotation(logger)
+	if err != nil {
+		return err
+	}
+
 	currentEncryptionKey, err := m.db.EncryptionKeyLabel(context.Background(), logger)
 	if err != nil {
 		if models.ConvertError(err) != models.ErrResourceNotFound {
@@ -63,31 +85,237 @@ func (m Encryptor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 		}
 	}
 
-	close(ready)
+	m.statusLock.Lock()
+	m.status.StoredKeyLabel = currentEncryptionKey
+	m.statusLock.Unlock()
 
 	if currentEncryptionKey != m.keyManager.EncryptionKey().Label() {
-		logger := logger.WithData(lager.Data{
-			"desired-key-label":  m.keyManager.EncryptionKey().Label(),
-			"existing-key-label": currentEncryptionKey,
-		})
-
-		encryptionStart := m.clock.Now()
-		logger.Info("encryption-started")
-		err := m.db.PerformEncryption(context.Background(), logger)
-		if err != nil {
-			logger.Error("encryption-failed", err)
-		} else {
-			m.db.SetEncryptionKeyLabel(context.Background(), logger, m.keyManager.EncryptionKey().Label())
+		m.startEncryption()
+	}
+
+	close(ready)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	for {
+		select {
+		case <-m.encryptions:
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				m.encrypt(ctx, logger)
+			}()
+		case <-signals:
+			cancel()
+			wg.Wait()
+			return nil
 		}
+	}
+}
 
-		totalTime := m.clock.Since(encryptionStart)
-		logger.Info("encryption-finished", lager.Data{"total_time": totalTime})
-		err = m.metronClient.SendDuration(encryptionDuration, totalTime)
-		if err != nil {
-			logger.Error("failed-to-send-encryption-duration-metrics", err)
+// Rotate switches the active encryption key to the known key with the given
+// label and re-encrypts all existing records with it in the background. The
+// rotation is stored, so that a restarted BBS keeps the rotated key.
+func (m *Encryptor) Rotate(logger lager.Logger, label string) error {
+	logger = logger.Session("rotate-encryption-key", lager.Data{"label": label})
+
+	m.statusLock.Lock()
+	defer m.statusLock.Unlock()
+
+	if m.status.State == models.EncryptionRunning {
+		logger.Info("encryption-already-in-progress")
+		return ErrEncryptionInProgress
+	}
+
+	if m.keyManager.DecryptionKey(label) == nil {
+		err := fmt.Errorf("Key with label %q was not found", label)
+		logger.Error("failed-to-set-encryption-key", err)
+		return models.NewError(models.Error_InvalidRequest, err.Error())
+	}
+
+	previousLabel := m.keyManager.EncryptionKey().Label()
+	err := m.keyManager.SetEncryptionKey(label)
+	if err != nil {
+		logger.Error("failed-to-set-encryption-key", err)
+		return err
+	}
+
+	err = m.db.SetRotatedEncryptionKey(context.Background(), logger, &db.RotatedEncryptionKey{
+		Label:           label,
+		ConfiguredLabel: m.configuredLabel,
+	})
+	if err != nil {
+		logger.Error("failed-to-store-rotated-encryption-key", err)
+		m.keyManager.SetEncryptionKey(previousLabel)
+		return err
+	}
+	logger.Info("encryption-key-rotated")
+
+	m.resetStatus()
+	m.encryptions <- struct{}{}
+	return nil
+}
+
+// restoreRotation makes the key of the last rotation the active key again,
+// unless the configured active key changed since that rotation.
+func (m *Encryptor) restoreRotation(logger lager.Logger) error {
+	rotated, err := m.db.RotatedEncryptionKey(context.Background(), logger)
+	if err != nil {
+		if models.ConvertError(err) == models.ErrResourceNotFound {
+			return nil
 		}
+		logger.Error("failed-to-fetch-rotated-encryption-key", err)
+		return err
 	}
 
-	<-signals
+	if rotated.ConfiguredLabel != m.configuredLabel {
+		logger.Info("rotation-superseded-by-configuration", lager.Data{"rotated_label": rotated.Label, "configured_label": m.configuredLabel})
+		return nil
+	}
+
+	err = m.keyManager.SetEncryptionKey(rotated.Label)
+	if err != nil {
+		err = errors.New("Rotated encryption key (" + rotated.Label + ") is not among the known keys")
+		logger.Error("unknown-rotated-encryption-key", err)
+		return err
+	}
+	logger.Info("restored-rotated-encryption-key", lager.Data{"label": rotated.Label})
+
 	return nil
 }
+
+// Status returns a snapshot of the progress of the current or most recent
+// re-encryption.
+func (m *Encryptor) Status() *models.EncryptionStatus {
+	m.statusLock.Lock()
+	defer m.statusLock.Unlock()
+
+	status := m.status
+	status.ActiveKeyLabel = m.keyManager.EncryptionKey().Label()
+	status.Tables = make([]*models.EncryptionTableProgress, len(m.status.Tables))
+	for i, table := range m.status.Tables {
+		tableCopy := *table
+		status.Tables[i] = &tableCopy
+	}
+	return &status
+}
+
+func (m *Encryptor) startEncryption() {
+	m.statusLock.Lock()
+	defer m.statusLock.Unlock()
+
+	m.resetStatus()
+	m.encryptions <- struct{}{}
+}
+
+// resetStatus must be called with the status lock held.
+func (m *Encryptor) resetStatus() {
+	m.status.State = models.EncryptionRunning
+	m.status.StartedAt = m.clock.Now().UnixNano()
+	m.status.CompletedAt = 0
+	m.status.Tables = []*models.EncryptionTableProgress{}
+	for _, table := range m.db.EncryptedTables() {
+		m.status.Tables = append(m.status.Tables, &models.EncryptionTableProgress{Table: table})
+	}
+}
+
+func (m *Encryptor) encrypt(ctx context.Context, logger lager.Logger) {
+	label := m.keyManager.EncryptionKey().Label()
+	logger = logger.WithData(lager.Data{"desired-key-label": label})
+
+	encryptionStart := m.clock.Now()
+	logger.Info("encryption-started")
+
+	err := m.encryptTables(ctx, logger)
+	if err != nil {
+		logger.Error("encryption-failed", err)
+	} else {
+		err = m.db.SetEncryptionKeyLabel(ctx, logger, label)
+		if err != nil {
+			logger.Error("failed-to-set-encryption-key-label", err)
+		}
+	}
+
+	m.statusLock.Lock()
+	m.status.CompletedAt = m.clock.Now().UnixNano()
+	if err != nil {
+		m.status.State = models.EncryptionFailed
+	} else {
+		m.status.State = models.EncryptionCompleted
+		m.status.StoredKeyLabel = label
+	}
+	m.statusLock.Unlock()
+
+	totalTime := m.clock.Since(encryptionStart)
+	logger.Info("encryption-finished", lager.Data{"total_time": totalTime})
+	err = m.metronClient.SendDuration(encryptionDuration, totalTime)
+	if err != nil {
+		logger.Error("failed-to-send-encryption-duration-metrics", err)
+	}
+}
+
+func (m *Encryptor) encryptTables(ctx context.Context, logger lager.Logger) error {
+	m.statusLock.Lock()
+	tables := m.status.Tables
+	m.statusLock.Unlock()
+
+	errCh := make(chan error, len(tables))
+	for _, table := range tables {
+		go func(table *models.EncryptionTableProgress) {
+			errCh <- m.encryptTable(ctx, logger, table)
+		}(table)
+	}
+
+	var err error
+	for range tables {
+		tableErr := <-errCh
+		if tableErr != nil {
+			err = tableErr
+		}
+	}
+	return err
+}
+
+func (m *Encryptor) encryptTable(ctx context.Context, logger lager.Logger, table *models.EncryptionTableProgress) error {
+	logger = logger.Session("encrypt-table", lager.Data{"table": table.Table})
+
+	progress := func(processed, total int) {
+		m.statusLock.Lock()
+		table.Processed = int32(processed)
+		table.Total = int32(total)
+		m.statusLock.Unlock()
+	}
+
+	for {
+		m.statusLock.Lock()
+		table.Attempts++
+		attempt := table.Attempts
+		m.statusLock.Unlock()
+
+		err := m.db.ReEncryptTable(ctx, logger, table.Table, progress)
+
+		m.statusLock.Lock()
+		if err == nil {
+			table.Done = true
+			table.LastError = ""
+		} else {
+			table.LastError = err.Error()
+		}
+		m.statusLock.Unlock()
+
+		if err == nil {
+			return nil
+		}
+
+		logger.Error("failed-to-encrypt-table", err, lager.Data{"attempt": attempt})
+		if attempt >= MaxTableAttempts {
+			return err
+		}
+
+		select {
+		case <-m.clock.After(TableRetryInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
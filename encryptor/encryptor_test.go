@@ -1,15 +1,19 @@
 package encryptor_test
 
 import (
+	"context"
 	"crypto/rand"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/encryptor"
 	"code.cloudfoundry.org/bbs/models"
-	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/clock/fakeclock"
 	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -26,7 +30,8 @@ var _ = Describe("Encryptor", func() {
 		cryptor    encryption.Cryptor
 		keyManager encryption.KeyManager
 
-		fakeDB *dbfakes.FakeEncryptionDB
+		fakeDB    *dbfakes.FakeEncryptionDB
+		fakeClock *fakeclock.FakeClock
 
 		fakeMetronClient *mfakes.FakeIngressClient
 	)
@@ -34,6 +39,14 @@ var _ = Describe("Encryptor", func() {
 	BeforeEach(func() {
 		fakeMetronClient = new(mfakes.FakeIngressClient)
 		fakeDB = new(dbfakes.FakeEncryptionDB)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		fakeDB.EncryptedTablesReturns([]string{"tasks", "desired_lrps"})
+		fakeDB.ReEncryptTableStub = func(ctx context.Context, logger lager.Logger, table string, progress db.EncryptionProgressFunc) error {
+			progress(1, 2)
+			fakeClock.Increment(time.Second)
+			progress(2, 2)
+			return nil
+		}
 
 		logger = lagertest.NewTestLogger("test")
 
@@ -45,10 +58,11 @@ var _ = Describe("Encryptor", func() {
 		cryptor = encryption.NewCryptor(keyManager, rand.Reader)
 
 		fakeDB.EncryptionKeyLabelReturns("", models.ErrResourceNotFound)
+		fakeDB.RotatedEncryptionKeyReturns(nil, models.ErrResourceNotFound)
 	})
 
 	JustBeforeEach(func() {
-		runner = encryptor.New(logger, fakeDB, keyManager, cryptor, clock.NewClock(), fakeMetronClient)
+		runner = encryptor.New(logger, fakeDB, keyManager, cryptor, fakeClock, fakeMetronClient)
 		encryptorProcess = ifrit.Background(runner)
 	})
 
@@ -74,7 +88,7 @@ var _ = Describe("Encryptor", func() {
 		It("encrypts all the existing records", func() {
 			Eventually(encryptorProcess.Ready()).Should(BeClosed())
 			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
-			Expect(fakeDB.PerformEncryptionCallCount()).To(Equal(1))
+			Expect(fakeDB.ReEncryptTableCallCount()).To(Equal(2))
 		})
 
 		It("writes the current encryption key", func() {
@@ -86,11 +100,27 @@ var _ = Describe("Encryptor", func() {
 
 	Context("when encrypting fails", func() {
 		BeforeEach(func() {
-			fakeDB.PerformEncryptionReturns(errors.New("something is broken"))
+			fakeDB.ReEncryptTableStub = func(ctx context.Context, logger lager.Logger, table string, progress db.EncryptionProgressFunc) error {
+				if table == "tasks" {
+					return errors.New("something is broken")
+				}
+				return nil
+			}
 		})
 
-		It("does not fail and logs the error", func() {
+		JustBeforeEach(func() {
 			Eventually(encryptorProcess.Ready()).Should(BeClosed())
+			for i := 1; i < encryptor.MaxTableAttempts; i++ {
+				fakeClock.WaitForWatcherAndIncrement(encryptor.TableRetryInterval)
+			}
+		})
+
+		It("retries the failing table", func() {
+			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
+			Expect(fakeDB.ReEncryptTableCallCount()).To(Equal(encryptor.MaxTableAttempts + 1))
+		})
+
+		It("does not fail and logs the error", func() {
 			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
 
 			Expect(logger.LogMessages()).To(ContainElement("test.encryptor.encryption-failed"))
@@ -99,6 +129,16 @@ var _ = Describe("Encryptor", func() {
 		It("does not change the key in the db", func() {
 			Consistently(fakeDB.SetEncryptionKeyLabelCallCount).Should(Equal(0))
 		})
+
+		It("reports the failure in its status", func() {
+			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
+			status := runner.(*encryptor.Encryptor).Status()
+			Expect(status.State).To(Equal(models.EncryptionFailed))
+			Expect(status.Tables).To(ConsistOf(
+				&models.EncryptionTableProgress{Table: "tasks", Attempts: encryptor.MaxTableAttempts, LastError: "something is broken"},
+				&models.EncryptionTableProgress{Table: "desired_lrps", Attempts: 1, Done: true},
+			))
+		})
 	})
 
 	Context("when fetching the current encryption key fails", func() {
@@ -151,7 +191,7 @@ var _ = Describe("Encryptor", func() {
 			Eventually(encryptorProcess.Ready()).Should(BeClosed())
 			Eventually(logger.LogMessages).Should(ContainElement("test.encryptor.encryption-finished"))
 
-			Expect(fakeDB.PerformEncryptionCallCount()).To(Equal(1))
+			Expect(fakeDB.ReEncryptTableCallCount()).To(Equal(2))
 		})
 
 		It("writes the current encryption key", func() {
@@ -160,4 +200,147 @@ var _ = Describe("Encryptor", func() {
 			Expect(newLabel).To(Equal("label"))
 		})
 	})
+
+	Context("when the active key was rotated before", func() {
+		BeforeEach(func() {
+			fakeDB.EncryptionKeyLabelReturns("old-key", nil)
+			fakeDB.RotatedEncryptionKeyReturns(&db.RotatedEncryptionKey{Label: "old-key", ConfiguredLabel: "label"}, nil)
+		})
+
+		It("keeps the rotated key active and does not re-encrypt", func() {
+			Eventually(encryptorProcess.Ready()).Should(BeClosed())
+			Expect(keyManager.EncryptionKey().Label()).To(Equal("old-key"))
+			Consistently(fakeDB.ReEncryptTableCallCount).Should(Equal(0))
+		})
+
+		Context("when the configured active key changed since the rotation", func() {
+			BeforeEach(func() {
+				fakeDB.RotatedEncryptionKeyReturns(&db.RotatedEncryptionKey{Label: "old-key", ConfiguredLabel: "previous-label"}, nil)
+			})
+
+			It("uses the configured key", func() {
+				Eventually(encryptorProcess.Ready()).Should(BeClosed())
+				Expect(keyManager.EncryptionKey().Label()).To(Equal("label"))
+				Eventually(fakeDB.SetEncryptionKeyLabelCallCount).Should(Equal(1))
+			})
+		})
+
+		Context("when the rotated key is not known to the encryptor", func() {
+			BeforeEach(func() {
+				fakeDB.RotatedEncryptionKeyReturns(&db.RotatedEncryptionKey{Label: "some-unknown-key", ConfiguredLabel: "label"}, nil)
+			})
+
+			It("shuts down without signalling ready", func() {
+				var err error
+				Eventually(encryptorProcess.Wait()).Should(Receive(&err))
+				Expect(err).To(MatchError("Rotated encryption key (some-unknown-key) is not among the known keys"))
+				Expect(encryptorProcess.Ready()).ToNot(BeClosed())
+			})
+		})
+
+		Context("when fetching the rotation fails", func() {
+			BeforeEach(func() {
+				fakeDB.RotatedEncryptionKeyReturns(nil, errors.New("can't fetch"))
+			})
+
+			It("fails early", func() {
+				var err error
+				Eventually(encryptorProcess.Wait()).Should(Receive(&err))
+				Expect(err).To(MatchError("can't fetch"))
+				Expect(encryptorProcess.Ready()).ToNot(BeClosed())
+			})
+		})
+	})
+
+	Describe("Status", func() {
+		It("reports the per-table progress and the completion", func() {
+			Eventually(fakeDB.SetEncryptionKeyLabelCallCount).Should(Equal(1))
+			Eventually(func() models.EncryptionStatus_State {
+				return runner.(*encryptor.Encryptor).Status().State
+			}).Should(Equal(models.EncryptionCompleted))
+
+			status := runner.(*encryptor.Encryptor).Status()
+			Expect(status.ActiveKeyLabel).To(Equal("label"))
+			Expect(status.StoredKeyLabel).To(Equal("label"))
+			Expect(status.CompletedAt).NotTo(BeZero())
+			Expect(status.Tables).To(ConsistOf(
+				&models.EncryptionTableProgress{Table: "tasks", Total: 2, Processed: 2, Attempts: 1, Done: true},
+				&models.EncryptionTableProgress{Table: "desired_lrps", Total: 2, Processed: 2, Attempts: 1, Done: true},
+			))
+		})
+	})
+
+	Describe("Rotate", func() {
+		var encryptorRunner *encryptor.Encryptor
+
+		BeforeEach(func() {
+			fakeDB.EncryptionKeyLabelReturns("label", nil)
+		})
+
+		JustBeforeEach(func() {
+			encryptorRunner = runner.(*encryptor.Encryptor)
+			Eventually(encryptorProcess.Ready()).Should(BeClosed())
+		})
+
+		It("switches the active key and re-encrypts all records in the background", func() {
+			Expect(encryptorRunner.Rotate(logger, "old-key")).To(Succeed())
+			Expect(keyManager.EncryptionKey().Label()).To(Equal("old-key"))
+
+			Eventually(fakeDB.SetEncryptionKeyLabelCallCount).Should(Equal(1))
+			_, _, newLabel := fakeDB.SetEncryptionKeyLabelArgsForCall(0)
+			Expect(newLabel).To(Equal("old-key"))
+			Expect(fakeDB.ReEncryptTableCallCount()).To(Equal(2))
+		})
+
+		It("stores the rotation with the configured key", func() {
+			Expect(encryptorRunner.Rotate(logger, "old-key")).To(Succeed())
+
+			Expect(fakeDB.SetRotatedEncryptionKeyCallCount()).To(Equal(1))
+			_, _, rotated := fakeDB.SetRotatedEncryptionKeyArgsForCall(0)
+			Expect(rotated).To(Equal(&db.RotatedEncryptionKey{Label: "old-key", ConfiguredLabel: "label"}))
+		})
+
+		It("rejects unknown keys", func() {
+			err := encryptorRunner.Rotate(logger, "unknown-key")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidRequest))
+			Expect(keyManager.EncryptionKey().Label()).To(Equal("label"))
+			Expect(fakeDB.SetRotatedEncryptionKeyCallCount()).To(Equal(0))
+			Consistently(fakeDB.ReEncryptTableCallCount).Should(Equal(0))
+		})
+
+		Context("when storing the rotation fails", func() {
+			BeforeEach(func() {
+				fakeDB.SetRotatedEncryptionKeyReturns(errors.New("can't store"))
+			})
+
+			It("keeps the active key", func() {
+				err := encryptorRunner.Rotate(logger, "old-key")
+				Expect(err).To(MatchError("can't store"))
+				Expect(keyManager.EncryptionKey().Label()).To(Equal("label"))
+				Consistently(fakeDB.ReEncryptTableCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when a re-encryption is in progress", func() {
+			var unblock chan struct{}
+
+			BeforeEach(func() {
+				unblock = make(chan struct{})
+				fakeDB.ReEncryptTableStub = func(ctx context.Context, logger lager.Logger, table string, progress db.EncryptionProgressFunc) error {
+					<-unblock
+					return nil
+				}
+			})
+
+			AfterEach(func() {
+				close(unblock)
+			})
+
+			It("rejects another rotation", func() {
+				Expect(encryptorRunner.Rotate(logger, "old-key")).To(Succeed())
+				Expect(encryptorRunner.Rotate(logger, "label")).To(Equal(encryptor.ErrEncryptionInProgress))
+				Expect(encryptorRunner.Status().State).To(Equal(models.EncryptionRunning))
+			})
+		})
+	})
 })
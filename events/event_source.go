@@ -2,6 +2,7 @@ package events
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -69,6 +70,21 @@ func NewEventFromModelEvent(eventID int, event models.Event) (sse.Event, error)
 	}, nil
 }
 
+// NewJSONEventFromModelEvent encodes the event as JSON rather than base64
+// encoded protobuf, for clients that ask for JSON.
+func NewJSONEventFromModelEvent(eventID int, event models.Event) (sse.Event, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return sse.Event{}, err
+	}
+
+	return sse.Event{
+		ID:   strconv.Itoa(eventID),
+		Name: string(event.EventType()),
+		Data: payload,
+	}, nil
+}
+
 //go:generate counterfeiter -o eventfakes/fake_event_source.go . EventSource
 
 // EventSource provides sequential access to a stream of events.
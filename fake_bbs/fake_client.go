@@ -174,6 +174,20 @@ type FakeClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DisruptionBudgetStatusStub        func(lager.Logger, string) (*models.DisruptionBudgetStatus, error)
+	disruptionBudgetStatusMutex       sync.RWMutex
+	disruptionBudgetStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	disruptionBudgetStatusReturns struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}
+	disruptionBudgetStatusReturnsOnCall map[int]struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}
 	DomainsStub        func(lager.Logger) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -1170,6 +1184,71 @@ func (fake *FakeClient) DesiredLRPsReturnsOnCall(i int, result1 []*models.Desire
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DisruptionBudgetStatus(arg1 lager.Logger, arg2 string) (*models.DisruptionBudgetStatus, error) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	ret, specificReturn := fake.disruptionBudgetStatusReturnsOnCall[len(fake.disruptionBudgetStatusArgsForCall)]
+	fake.disruptionBudgetStatusArgsForCall = append(fake.disruptionBudgetStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DisruptionBudgetStatusStub
+	fakeReturns := fake.disruptionBudgetStatusReturns
+	fake.recordInvocation("DisruptionBudgetStatus", []interface{}{arg1, arg2})
+	fake.disruptionBudgetStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DisruptionBudgetStatusCallCount() int {
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
+	return len(fake.disruptionBudgetStatusArgsForCall)
+}
+
+func (fake *FakeClient) DisruptionBudgetStatusCalls(stub func(lager.Logger, string) (*models.DisruptionBudgetStatus, error)) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = stub
+}
+
+func (fake *FakeClient) DisruptionBudgetStatusArgsForCall(i int) (lager.Logger, string) {
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
+	argsForCall := fake.disruptionBudgetStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) DisruptionBudgetStatusReturns(result1 *models.DisruptionBudgetStatus, result2 error) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = nil
+	fake.disruptionBudgetStatusReturns = struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DisruptionBudgetStatusReturnsOnCall(i int, result1 *models.DisruptionBudgetStatus, result2 error) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = nil
+	if fake.disruptionBudgetStatusReturnsOnCall == nil {
+		fake.disruptionBudgetStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.DisruptionBudgetStatus
+			result2 error
+		})
+	}
+	fake.disruptionBudgetStatusReturnsOnCall[i] = struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Domains(arg1 lager.Logger) ([]string, error) {
 	fake.domainsMutex.Lock()
 	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
@@ -2280,6 +2359,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
 	fake.pingMutex.RLock()
@@ -69,6 +69,20 @@ type FakeInternalClient struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	AuditEntriesStub        func(lager.Logger, *models.AuditEntriesRequest) ([]*models.AuditEntry, error)
+	auditEntriesMutex       sync.RWMutex
+	auditEntriesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 *models.AuditEntriesRequest
+	}
+	auditEntriesReturns struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}
+	auditEntriesReturnsOnCall map[int]struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}
 	CancelTaskStub        func(lager.Logger, string) error
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -81,6 +95,34 @@ type FakeInternalClient struct {
 	cancelTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CellCordonsStub        func(lager.Logger) ([]*models.CellCordon, error)
+	cellCordonsMutex       sync.RWMutex
+	cellCordonsArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	cellCordonsReturns struct {
+		result1 []*models.CellCordon
+		result2 error
+	}
+	cellCordonsReturnsOnCall map[int]struct {
+		result1 []*models.CellCordon
+		result2 error
+	}
+	CellSummariesStub        func(lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error)
+	cellSummariesMutex       sync.RWMutex
+	cellSummariesArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	cellSummariesReturns struct {
+		result1 []*models.CellSummary
+		result2 []*models.ZoneSummary
+		result3 error
+	}
+	cellSummariesReturnsOnCall map[int]struct {
+		result1 []*models.CellSummary
+		result2 []*models.ZoneSummary
+		result3 error
+	}
 	CellsStub        func(lager.Logger) ([]*models.CellPresence, error)
 	cellsMutex       sync.RWMutex
 	cellsArgsForCall []struct {
@@ -123,6 +165,48 @@ type FakeInternalClient struct {
 	completeTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ConvergencePlanStub        func(lager.Logger, int32) (*models.ConvergencePlan, error)
+	convergencePlanMutex       sync.RWMutex
+	convergencePlanArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 int32
+	}
+	convergencePlanReturns struct {
+		result1 *models.ConvergencePlan
+		result2 error
+	}
+	convergencePlanReturnsOnCall map[int]struct {
+		result1 *models.ConvergencePlan
+		result2 error
+	}
+	ConvergenceSafetyValveStub        func(lager.Logger) (*models.ConvergenceSafetyValveStatus, error)
+	convergenceSafetyValveMutex       sync.RWMutex
+	convergenceSafetyValveArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	convergenceSafetyValveReturns struct {
+		result1 *models.ConvergenceSafetyValveStatus
+		result2 error
+	}
+	convergenceSafetyValveReturnsOnCall map[int]struct {
+		result1 *models.ConvergenceSafetyValveStatus
+		result2 error
+	}
+	CordonCellStub        func(lager.Logger, string, string) (*models.CellCordon, error)
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 *models.CellCordon
+		result2 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 *models.CellCordon
+		result2 error
+	}
 	CrashActualLRPStub        func(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) error
 	crashActualLRPMutex       sync.RWMutex
 	crashActualLRPArgsForCall []struct {
@@ -217,6 +301,20 @@ type FakeInternalClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DisruptionBudgetStatusStub        func(lager.Logger, string) (*models.DisruptionBudgetStatus, error)
+	disruptionBudgetStatusMutex       sync.RWMutex
+	disruptionBudgetStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	disruptionBudgetStatusReturns struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}
+	disruptionBudgetStatusReturnsOnCall map[int]struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}
 	DomainsStub        func(lager.Logger) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -230,6 +328,48 @@ type FakeInternalClient struct {
 		result1 []string
 		result2 error
 	}
+	DrainCellStub        func(lager.Logger, string, string) (*models.CellCordon, error)
+	drainCellMutex       sync.RWMutex
+	drainCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	drainCellReturns struct {
+		result1 *models.CellCordon
+		result2 error
+	}
+	drainCellReturnsOnCall map[int]struct {
+		result1 *models.CellCordon
+		result2 error
+	}
+	EncryptionKeyUsageStub        func(lager.Logger, string) (map[string]int32, error)
+	encryptionKeyUsageMutex       sync.RWMutex
+	encryptionKeyUsageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	encryptionKeyUsageReturns struct {
+		result1 map[string]int32
+		result2 error
+	}
+	encryptionKeyUsageReturnsOnCall map[int]struct {
+		result1 map[string]int32
+		result2 error
+	}
+	EncryptionStatusStub        func(lager.Logger) (*models.EncryptionStatus, error)
+	encryptionStatusMutex       sync.RWMutex
+	encryptionStatusArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	encryptionStatusReturns struct {
+		result1 *models.EncryptionStatus
+		result2 error
+	}
+	encryptionStatusReturnsOnCall map[int]struct {
+		result1 *models.EncryptionStatus
+		result2 error
+	}
 	EvacuateClaimedActualLRPStub        func(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)
 	evacuateClaimedActualLRPMutex       sync.RWMutex
 	evacuateClaimedActualLRPArgsForCall []struct {
@@ -292,6 +432,21 @@ type FakeInternalClient struct {
 		result1 bool
 		result2 error
 	}
+	ExplainActualLRPStub        func(lager.Logger, string, int32) (*models.ActualLRPExplanation, error)
+	explainActualLRPMutex       sync.RWMutex
+	explainActualLRPArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 int32
+	}
+	explainActualLRPReturns struct {
+		result1 *models.ActualLRPExplanation
+		result2 error
+	}
+	explainActualLRPReturnsOnCall map[int]struct {
+		result1 *models.ActualLRPExplanation
+		result2 error
+	}
 	FailActualLRPStub        func(lager.Logger, *models.ActualLRPKey, string) error
 	failActualLRPMutex       sync.RWMutex
 	failActualLRPArgsForCall []struct {
@@ -318,6 +473,32 @@ type FakeInternalClient struct {
 	failTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MaintenanceModeStub        func(lager.Logger) (*models.MaintenanceMode, error)
+	maintenanceModeMutex       sync.RWMutex
+	maintenanceModeArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	maintenanceModeReturns struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	maintenanceModeReturnsOnCall map[int]struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	OverrideConvergenceSafetyValveStub        func(lager.Logger) (*models.ConvergenceSafetyValveStatus, error)
+	overrideConvergenceSafetyValveMutex       sync.RWMutex
+	overrideConvergenceSafetyValveArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	overrideConvergenceSafetyValveReturns struct {
+		result1 *models.ConvergenceSafetyValveStatus
+		result2 error
+	}
+	overrideConvergenceSafetyValveReturnsOnCall map[int]struct {
+		result1 *models.ConvergenceSafetyValveStatus
+		result2 error
+	}
 	PingStub        func(lager.Logger) bool
 	pingMutex       sync.RWMutex
 	pingArgsForCall []struct {
@@ -342,6 +523,19 @@ type FakeInternalClient struct {
 	rejectTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReloadConfigStub        func(lager.Logger) (*models.ConfigReloadReport, error)
+	reloadConfigMutex       sync.RWMutex
+	reloadConfigArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	reloadConfigReturns struct {
+		result1 *models.ConfigReloadReport
+		result2 error
+	}
+	reloadConfigReturnsOnCall map[int]struct {
+		result1 *models.ConfigReloadReport
+		result2 error
+	}
 	RemoveActualLRPStub        func(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error
 	removeActualLRPMutex       sync.RWMutex
 	removeActualLRPArgsForCall []struct {
@@ -404,6 +598,33 @@ type FakeInternalClient struct {
 	retireActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RotateEncryptionKeyStub        func(lager.Logger, string) error
+	rotateEncryptionKeyMutex       sync.RWMutex
+	rotateEncryptionKeyArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	rotateEncryptionKeyReturns struct {
+		result1 error
+	}
+	rotateEncryptionKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetMaintenanceModeStub        func(lager.Logger, bool, string) (*models.MaintenanceMode, error)
+	setMaintenanceModeMutex       sync.RWMutex
+	setMaintenanceModeArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 bool
+		arg3 string
+	}
+	setMaintenanceModeReturns struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	setMaintenanceModeReturnsOnCall map[int]struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
 	StartActualLRPStub        func(lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo) error
 	startActualLRPMutex       sync.RWMutex
 	startActualLRPArgsForCall []struct {
@@ -569,6 +790,18 @@ type FakeInternalClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	UncordonCellStub        func(lager.Logger, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(lager.Logger, string, *models.DesiredLRPUpdate) error
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -860,6 +1093,71 @@ func (fake *FakeInternalClient) ActualLRPsReturnsOnCall(i int, result1 []*models
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) AuditEntries(arg1 lager.Logger, arg2 *models.AuditEntriesRequest) ([]*models.AuditEntry, error) {
+	fake.auditEntriesMutex.Lock()
+	ret, specificReturn := fake.auditEntriesReturnsOnCall[len(fake.auditEntriesArgsForCall)]
+	fake.auditEntriesArgsForCall = append(fake.auditEntriesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 *models.AuditEntriesRequest
+	}{arg1, arg2})
+	stub := fake.AuditEntriesStub
+	fakeReturns := fake.auditEntriesReturns
+	fake.recordInvocation("AuditEntries", []interface{}{arg1, arg2})
+	fake.auditEntriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) AuditEntriesCallCount() int {
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	return len(fake.auditEntriesArgsForCall)
+}
+
+func (fake *FakeInternalClient) AuditEntriesCalls(stub func(lager.Logger, *models.AuditEntriesRequest) ([]*models.AuditEntry, error)) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = stub
+}
+
+func (fake *FakeInternalClient) AuditEntriesArgsForCall(i int) (lager.Logger, *models.AuditEntriesRequest) {
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
+	argsForCall := fake.auditEntriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) AuditEntriesReturns(result1 []*models.AuditEntry, result2 error) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = nil
+	fake.auditEntriesReturns = struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) AuditEntriesReturnsOnCall(i int, result1 []*models.AuditEntry, result2 error) {
+	fake.auditEntriesMutex.Lock()
+	defer fake.auditEntriesMutex.Unlock()
+	fake.AuditEntriesStub = nil
+	if fake.auditEntriesReturnsOnCall == nil {
+		fake.auditEntriesReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditEntry
+			result2 error
+		})
+	}
+	fake.auditEntriesReturnsOnCall[i] = struct {
+		result1 []*models.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) CancelTask(arg1 lager.Logger, arg2 string) error {
 	fake.cancelTaskMutex.Lock()
 	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
@@ -922,6 +1220,137 @@ func (fake *FakeInternalClient) CancelTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) CellCordons(arg1 lager.Logger) ([]*models.CellCordon, error) {
+	fake.cellCordonsMutex.Lock()
+	ret, specificReturn := fake.cellCordonsReturnsOnCall[len(fake.cellCordonsArgsForCall)]
+	fake.cellCordonsArgsForCall = append(fake.cellCordonsArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.CellCordonsStub
+	fakeReturns := fake.cellCordonsReturns
+	fake.recordInvocation("CellCordons", []interface{}{arg1})
+	fake.cellCordonsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) CellCordonsCallCount() int {
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	return len(fake.cellCordonsArgsForCall)
+}
+
+func (fake *FakeInternalClient) CellCordonsCalls(stub func(lager.Logger) ([]*models.CellCordon, error)) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = stub
+}
+
+func (fake *FakeInternalClient) CellCordonsArgsForCall(i int) lager.Logger {
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	argsForCall := fake.cellCordonsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInternalClient) CellCordonsReturns(result1 []*models.CellCordon, result2 error) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = nil
+	fake.cellCordonsReturns = struct {
+		result1 []*models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CellCordonsReturnsOnCall(i int, result1 []*models.CellCordon, result2 error) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = nil
+	if fake.cellCordonsReturnsOnCall == nil {
+		fake.cellCordonsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellCordon
+			result2 error
+		})
+	}
+	fake.cellCordonsReturnsOnCall[i] = struct {
+		result1 []*models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CellSummaries(arg1 lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error) {
+	fake.cellSummariesMutex.Lock()
+	ret, specificReturn := fake.cellSummariesReturnsOnCall[len(fake.cellSummariesArgsForCall)]
+	fake.cellSummariesArgsForCall = append(fake.cellSummariesArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.CellSummariesStub
+	fakeReturns := fake.cellSummariesReturns
+	fake.recordInvocation("CellSummaries", []interface{}{arg1})
+	fake.cellSummariesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalClient) CellSummariesCallCount() int {
+	fake.cellSummariesMutex.RLock()
+	defer fake.cellSummariesMutex.RUnlock()
+	return len(fake.cellSummariesArgsForCall)
+}
+
+func (fake *FakeInternalClient) CellSummariesCalls(stub func(lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error)) {
+	fake.cellSummariesMutex.Lock()
+	defer fake.cellSummariesMutex.Unlock()
+	fake.CellSummariesStub = stub
+}
+
+func (fake *FakeInternalClient) CellSummariesArgsForCall(i int) lager.Logger {
+	fake.cellSummariesMutex.RLock()
+	defer fake.cellSummariesMutex.RUnlock()
+	argsForCall := fake.cellSummariesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInternalClient) CellSummariesReturns(result1 []*models.CellSummary, result2 []*models.ZoneSummary, result3 error) {
+	fake.cellSummariesMutex.Lock()
+	defer fake.cellSummariesMutex.Unlock()
+	fake.CellSummariesStub = nil
+	fake.cellSummariesReturns = struct {
+		result1 []*models.CellSummary
+		result2 []*models.ZoneSummary
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) CellSummariesReturnsOnCall(i int, result1 []*models.CellSummary, result2 []*models.ZoneSummary, result3 error) {
+	fake.cellSummariesMutex.Lock()
+	defer fake.cellSummariesMutex.Unlock()
+	fake.CellSummariesStub = nil
+	if fake.cellSummariesReturnsOnCall == nil {
+		fake.cellSummariesReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellSummary
+			result2 []*models.ZoneSummary
+			result3 error
+		})
+	}
+	fake.cellSummariesReturnsOnCall[i] = struct {
+		result1 []*models.CellSummary
+		result2 []*models.ZoneSummary
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) Cells(arg1 lager.Logger) ([]*models.CellPresence, error) {
 	fake.cellsMutex.Lock()
 	ret, specificReturn := fake.cellsReturnsOnCall[len(fake.cellsArgsForCall)]
@@ -1115,6 +1544,201 @@ func (fake *FakeInternalClient) CompleteTaskReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeInternalClient) ConvergencePlan(arg1 lager.Logger, arg2 int32) (*models.ConvergencePlan, error) {
+	fake.convergencePlanMutex.Lock()
+	ret, specificReturn := fake.convergencePlanReturnsOnCall[len(fake.convergencePlanArgsForCall)]
+	fake.convergencePlanArgsForCall = append(fake.convergencePlanArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 int32
+	}{arg1, arg2})
+	stub := fake.ConvergencePlanStub
+	fakeReturns := fake.convergencePlanReturns
+	fake.recordInvocation("ConvergencePlan", []interface{}{arg1, arg2})
+	fake.convergencePlanMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ConvergencePlanCallCount() int {
+	fake.convergencePlanMutex.RLock()
+	defer fake.convergencePlanMutex.RUnlock()
+	return len(fake.convergencePlanArgsForCall)
+}
+
+func (fake *FakeInternalClient) ConvergencePlanCalls(stub func(lager.Logger, int32) (*models.ConvergencePlan, error)) {
+	fake.convergencePlanMutex.Lock()
+	defer fake.convergencePlanMutex.Unlock()
+	fake.ConvergencePlanStub = stub
+}
+
+func (fake *FakeInternalClient) ConvergencePlanArgsForCall(i int) (lager.Logger, int32) {
+	fake.convergencePlanMutex.RLock()
+	defer fake.convergencePlanMutex.RUnlock()
+	argsForCall := fake.convergencePlanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) ConvergencePlanReturns(result1 *models.ConvergencePlan, result2 error) {
+	fake.convergencePlanMutex.Lock()
+	defer fake.convergencePlanMutex.Unlock()
+	fake.ConvergencePlanStub = nil
+	fake.convergencePlanReturns = struct {
+		result1 *models.ConvergencePlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ConvergencePlanReturnsOnCall(i int, result1 *models.ConvergencePlan, result2 error) {
+	fake.convergencePlanMutex.Lock()
+	defer fake.convergencePlanMutex.Unlock()
+	fake.ConvergencePlanStub = nil
+	if fake.convergencePlanReturnsOnCall == nil {
+		fake.convergencePlanReturnsOnCall = make(map[int]struct {
+			result1 *models.ConvergencePlan
+			result2 error
+		})
+	}
+	fake.convergencePlanReturnsOnCall[i] = struct {
+		result1 *models.ConvergencePlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ConvergenceSafetyValve(arg1 lager.Logger) (*models.ConvergenceSafetyValveStatus, error) {
+	fake.convergenceSafetyValveMutex.Lock()
+	ret, specificReturn := fake.convergenceSafetyValveReturnsOnCall[len(fake.convergenceSafetyValveArgsForCall)]
+	fake.convergenceSafetyValveArgsForCall = append(fake.convergenceSafetyValveArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.ConvergenceSafetyValveStub
+	fakeReturns := fake.convergenceSafetyValveReturns
+	fake.recordInvocation("ConvergenceSafetyValve", []interface{}{arg1})
+	fake.convergenceSafetyValveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ConvergenceSafetyValveCallCount() int {
+	fake.convergenceSafetyValveMutex.RLock()
+	defer fake.convergenceSafetyValveMutex.RUnlock()
+	return len(fake.convergenceSafetyValveArgsForCall)
+}
+
+func (fake *FakeInternalClient) ConvergenceSafetyValveCalls(stub func(lager.Logger) (*models.ConvergenceSafetyValveStatus, error)) {
+	fake.convergenceSafetyValveMutex.Lock()
+	defer fake.convergenceSafetyValveMutex.Unlock()
+	fake.ConvergenceSafetyValveStub = stub
+}
+
+func (fake *FakeInternalClient) ConvergenceSafetyValveArgsForCall(i int) lager.Logger {
+	fake.convergenceSafetyValveMutex.RLock()
+	defer fake.convergenceSafetyValveMutex.RUnlock()
+	argsForCall := fake.convergenceSafetyValveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInternalClient) ConvergenceSafetyValveReturns(result1 *models.ConvergenceSafetyValveStatus, result2 error) {
+	fake.convergenceSafetyValveMutex.Lock()
+	defer fake.convergenceSafetyValveMutex.Unlock()
+	fake.ConvergenceSafetyValveStub = nil
+	fake.convergenceSafetyValveReturns = struct {
+		result1 *models.ConvergenceSafetyValveStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ConvergenceSafetyValveReturnsOnCall(i int, result1 *models.ConvergenceSafetyValveStatus, result2 error) {
+	fake.convergenceSafetyValveMutex.Lock()
+	defer fake.convergenceSafetyValveMutex.Unlock()
+	fake.ConvergenceSafetyValveStub = nil
+	if fake.convergenceSafetyValveReturnsOnCall == nil {
+		fake.convergenceSafetyValveReturnsOnCall = make(map[int]struct {
+			result1 *models.ConvergenceSafetyValveStatus
+			result2 error
+		})
+	}
+	fake.convergenceSafetyValveReturnsOnCall[i] = struct {
+		result1 *models.ConvergenceSafetyValveStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CordonCell(arg1 lager.Logger, arg2 string, arg3 string) (*models.CellCordon, error) {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) CordonCellCalls(stub func(lager.Logger, string, string) (*models.CellCordon, error)) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeInternalClient) CordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) CordonCellReturns(result1 *models.CellCordon, result2 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 *models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CordonCellReturnsOnCall(i int, result1 *models.CellCordon, result2 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 *models.CellCordon
+			result2 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 *models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) CrashActualLRP(arg1 lager.Logger, arg2 *models.ActualLRPKey, arg3 *models.ActualLRPInstanceKey, arg4 string) error {
 	fake.crashActualLRPMutex.Lock()
 	ret, specificReturn := fake.crashActualLRPReturnsOnCall[len(fake.crashActualLRPArgsForCall)]
@@ -1546,32 +2170,292 @@ func (fake *FakeInternalClient) DesiredLRPsReturns(result1 []*models.DesiredLRP,
 	}{result1, result2}
 }
 
-func (fake *FakeInternalClient) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
-	fake.desiredLRPsMutex.Lock()
-	defer fake.desiredLRPsMutex.Unlock()
-	fake.DesiredLRPsStub = nil
-	if fake.desiredLRPsReturnsOnCall == nil {
-		fake.desiredLRPsReturnsOnCall = make(map[int]struct {
-			result1 []*models.DesiredLRP
+func (fake *FakeInternalClient) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	if fake.desiredLRPsReturnsOnCall == nil {
+		fake.desiredLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DisruptionBudgetStatus(arg1 lager.Logger, arg2 string) (*models.DisruptionBudgetStatus, error) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	ret, specificReturn := fake.disruptionBudgetStatusReturnsOnCall[len(fake.disruptionBudgetStatusArgsForCall)]
+	fake.disruptionBudgetStatusArgsForCall = append(fake.disruptionBudgetStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DisruptionBudgetStatusStub
+	fakeReturns := fake.disruptionBudgetStatusReturns
+	fake.recordInvocation("DisruptionBudgetStatus", []interface{}{arg1, arg2})
+	fake.disruptionBudgetStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DisruptionBudgetStatusCallCount() int {
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
+	return len(fake.disruptionBudgetStatusArgsForCall)
+}
+
+func (fake *FakeInternalClient) DisruptionBudgetStatusCalls(stub func(lager.Logger, string) (*models.DisruptionBudgetStatus, error)) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = stub
+}
+
+func (fake *FakeInternalClient) DisruptionBudgetStatusArgsForCall(i int) (lager.Logger, string) {
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
+	argsForCall := fake.disruptionBudgetStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) DisruptionBudgetStatusReturns(result1 *models.DisruptionBudgetStatus, result2 error) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = nil
+	fake.disruptionBudgetStatusReturns = struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DisruptionBudgetStatusReturnsOnCall(i int, result1 *models.DisruptionBudgetStatus, result2 error) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = nil
+	if fake.disruptionBudgetStatusReturnsOnCall == nil {
+		fake.disruptionBudgetStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.DisruptionBudgetStatus
+			result2 error
+		})
+	}
+	fake.disruptionBudgetStatusReturnsOnCall[i] = struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) Domains(arg1 lager.Logger) ([]string, error) {
+	fake.domainsMutex.Lock()
+	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
+	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.DomainsStub
+	fakeReturns := fake.domainsReturns
+	fake.recordInvocation("Domains", []interface{}{arg1})
+	fake.domainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DomainsCallCount() int {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	return len(fake.domainsArgsForCall)
+}
+
+func (fake *FakeInternalClient) DomainsCalls(stub func(lager.Logger) ([]string, error)) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = stub
+}
+
+func (fake *FakeInternalClient) DomainsArgsForCall(i int) lager.Logger {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	argsForCall := fake.domainsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInternalClient) DomainsReturns(result1 []string, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	fake.domainsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DomainsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	if fake.domainsReturnsOnCall == nil {
+		fake.domainsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.domainsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DrainCell(arg1 lager.Logger, arg2 string, arg3 string) (*models.CellCordon, error) {
+	fake.drainCellMutex.Lock()
+	ret, specificReturn := fake.drainCellReturnsOnCall[len(fake.drainCellArgsForCall)]
+	fake.drainCellArgsForCall = append(fake.drainCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DrainCellStub
+	fakeReturns := fake.drainCellReturns
+	fake.recordInvocation("DrainCell", []interface{}{arg1, arg2, arg3})
+	fake.drainCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DrainCellCallCount() int {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	return len(fake.drainCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) DrainCellCalls(stub func(lager.Logger, string, string) (*models.CellCordon, error)) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = stub
+}
+
+func (fake *FakeInternalClient) DrainCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	argsForCall := fake.drainCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DrainCellReturns(result1 *models.CellCordon, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	fake.drainCellReturns = struct {
+		result1 *models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DrainCellReturnsOnCall(i int, result1 *models.CellCordon, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	if fake.drainCellReturnsOnCall == nil {
+		fake.drainCellReturnsOnCall = make(map[int]struct {
+			result1 *models.CellCordon
+			result2 error
+		})
+	}
+	fake.drainCellReturnsOnCall[i] = struct {
+		result1 *models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) EncryptionKeyUsage(arg1 lager.Logger, arg2 string) (map[string]int32, error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	ret, specificReturn := fake.encryptionKeyUsageReturnsOnCall[len(fake.encryptionKeyUsageArgsForCall)]
+	fake.encryptionKeyUsageArgsForCall = append(fake.encryptionKeyUsageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.EncryptionKeyUsageStub
+	fakeReturns := fake.encryptionKeyUsageReturns
+	fake.recordInvocation("EncryptionKeyUsage", []interface{}{arg1, arg2})
+	fake.encryptionKeyUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) EncryptionKeyUsageCallCount() int {
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
+	return len(fake.encryptionKeyUsageArgsForCall)
+}
+
+func (fake *FakeInternalClient) EncryptionKeyUsageCalls(stub func(lager.Logger, string) (map[string]int32, error)) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = stub
+}
+
+func (fake *FakeInternalClient) EncryptionKeyUsageArgsForCall(i int) (lager.Logger, string) {
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
+	argsForCall := fake.encryptionKeyUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) EncryptionKeyUsageReturns(result1 map[string]int32, result2 error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = nil
+	fake.encryptionKeyUsageReturns = struct {
+		result1 map[string]int32
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) EncryptionKeyUsageReturnsOnCall(i int, result1 map[string]int32, result2 error) {
+	fake.encryptionKeyUsageMutex.Lock()
+	defer fake.encryptionKeyUsageMutex.Unlock()
+	fake.EncryptionKeyUsageStub = nil
+	if fake.encryptionKeyUsageReturnsOnCall == nil {
+		fake.encryptionKeyUsageReturnsOnCall = make(map[int]struct {
+			result1 map[string]int32
 			result2 error
 		})
 	}
-	fake.desiredLRPsReturnsOnCall[i] = struct {
-		result1 []*models.DesiredLRP
+	fake.encryptionKeyUsageReturnsOnCall[i] = struct {
+		result1 map[string]int32
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeInternalClient) Domains(arg1 lager.Logger) ([]string, error) {
-	fake.domainsMutex.Lock()
-	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
-	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
+func (fake *FakeInternalClient) EncryptionStatus(arg1 lager.Logger) (*models.EncryptionStatus, error) {
+	fake.encryptionStatusMutex.Lock()
+	ret, specificReturn := fake.encryptionStatusReturnsOnCall[len(fake.encryptionStatusArgsForCall)]
+	fake.encryptionStatusArgsForCall = append(fake.encryptionStatusArgsForCall, struct {
 		arg1 lager.Logger
 	}{arg1})
-	stub := fake.DomainsStub
-	fakeReturns := fake.domainsReturns
-	fake.recordInvocation("Domains", []interface{}{arg1})
-	fake.domainsMutex.Unlock()
+	stub := fake.EncryptionStatusStub
+	fakeReturns := fake.encryptionStatusReturns
+	fake.recordInvocation("EncryptionStatus", []interface{}{arg1})
+	fake.encryptionStatusMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -1581,47 +2465,47 @@ func (fake *FakeInternalClient) Domains(arg1 lager.Logger) ([]string, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeInternalClient) DomainsCallCount() int {
-	fake.domainsMutex.RLock()
-	defer fake.domainsMutex.RUnlock()
-	return len(fake.domainsArgsForCall)
+func (fake *FakeInternalClient) EncryptionStatusCallCount() int {
+	fake.encryptionStatusMutex.RLock()
+	defer fake.encryptionStatusMutex.RUnlock()
+	return len(fake.encryptionStatusArgsForCall)
 }
 
-func (fake *FakeInternalClient) DomainsCalls(stub func(lager.Logger) ([]string, error)) {
-	fake.domainsMutex.Lock()
-	defer fake.domainsMutex.Unlock()
-	fake.DomainsStub = stub
+func (fake *FakeInternalClient) EncryptionStatusCalls(stub func(lager.Logger) (*models.EncryptionStatus, error)) {
+	fake.encryptionStatusMutex.Lock()
+	defer fake.encryptionStatusMutex.Unlock()
+	fake.EncryptionStatusStub = stub
 }
 
-func (fake *FakeInternalClient) DomainsArgsForCall(i int) lager.Logger {
-	fake.domainsMutex.RLock()
-	defer fake.domainsMutex.RUnlock()
-	argsForCall := fake.domainsArgsForCall[i]
+func (fake *FakeInternalClient) EncryptionStatusArgsForCall(i int) lager.Logger {
+	fake.encryptionStatusMutex.RLock()
+	defer fake.encryptionStatusMutex.RUnlock()
+	argsForCall := fake.encryptionStatusArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeInternalClient) DomainsReturns(result1 []string, result2 error) {
-	fake.domainsMutex.Lock()
-	defer fake.domainsMutex.Unlock()
-	fake.DomainsStub = nil
-	fake.domainsReturns = struct {
-		result1 []string
+func (fake *FakeInternalClient) EncryptionStatusReturns(result1 *models.EncryptionStatus, result2 error) {
+	fake.encryptionStatusMutex.Lock()
+	defer fake.encryptionStatusMutex.Unlock()
+	fake.EncryptionStatusStub = nil
+	fake.encryptionStatusReturns = struct {
+		result1 *models.EncryptionStatus
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeInternalClient) DomainsReturnsOnCall(i int, result1 []string, result2 error) {
-	fake.domainsMutex.Lock()
-	defer fake.domainsMutex.Unlock()
-	fake.DomainsStub = nil
-	if fake.domainsReturnsOnCall == nil {
-		fake.domainsReturnsOnCall = make(map[int]struct {
-			result1 []string
+func (fake *FakeInternalClient) EncryptionStatusReturnsOnCall(i int, result1 *models.EncryptionStatus, result2 error) {
+	fake.encryptionStatusMutex.Lock()
+	defer fake.encryptionStatusMutex.Unlock()
+	fake.EncryptionStatusStub = nil
+	if fake.encryptionStatusReturnsOnCall == nil {
+		fake.encryptionStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.EncryptionStatus
 			result2 error
 		})
 	}
-	fake.domainsReturnsOnCall[i] = struct {
-		result1 []string
+	fake.encryptionStatusReturnsOnCall[i] = struct {
+		result1 *models.EncryptionStatus
 		result2 error
 	}{result1, result2}
 }
@@ -1892,6 +2776,72 @@ func (fake *FakeInternalClient) EvacuateStoppedActualLRPReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) ExplainActualLRP(arg1 lager.Logger, arg2 string, arg3 int32) (*models.ActualLRPExplanation, error) {
+	fake.explainActualLRPMutex.Lock()
+	ret, specificReturn := fake.explainActualLRPReturnsOnCall[len(fake.explainActualLRPArgsForCall)]
+	fake.explainActualLRPArgsForCall = append(fake.explainActualLRPArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 int32
+	}{arg1, arg2, arg3})
+	stub := fake.ExplainActualLRPStub
+	fakeReturns := fake.explainActualLRPReturns
+	fake.recordInvocation("ExplainActualLRP", []interface{}{arg1, arg2, arg3})
+	fake.explainActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ExplainActualLRPCallCount() int {
+	fake.explainActualLRPMutex.RLock()
+	defer fake.explainActualLRPMutex.RUnlock()
+	return len(fake.explainActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalClient) ExplainActualLRPCalls(stub func(lager.Logger, string, int32) (*models.ActualLRPExplanation, error)) {
+	fake.explainActualLRPMutex.Lock()
+	defer fake.explainActualLRPMutex.Unlock()
+	fake.ExplainActualLRPStub = stub
+}
+
+func (fake *FakeInternalClient) ExplainActualLRPArgsForCall(i int) (lager.Logger, string, int32) {
+	fake.explainActualLRPMutex.RLock()
+	defer fake.explainActualLRPMutex.RUnlock()
+	argsForCall := fake.explainActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ExplainActualLRPReturns(result1 *models.ActualLRPExplanation, result2 error) {
+	fake.explainActualLRPMutex.Lock()
+	defer fake.explainActualLRPMutex.Unlock()
+	fake.ExplainActualLRPStub = nil
+	fake.explainActualLRPReturns = struct {
+		result1 *models.ActualLRPExplanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ExplainActualLRPReturnsOnCall(i int, result1 *models.ActualLRPExplanation, result2 error) {
+	fake.explainActualLRPMutex.Lock()
+	defer fake.explainActualLRPMutex.Unlock()
+	fake.ExplainActualLRPStub = nil
+	if fake.explainActualLRPReturnsOnCall == nil {
+		fake.explainActualLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.ActualLRPExplanation
+			result2 error
+		})
+	}
+	fake.explainActualLRPReturnsOnCall[i] = struct {
+		result1 *models.ActualLRPExplanation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) FailActualLRP(arg1 lager.Logger, arg2 *models.ActualLRPKey, arg3 string) error {
 	fake.failActualLRPMutex.Lock()
 	ret, specificReturn := fake.failActualLRPReturnsOnCall[len(fake.failActualLRPArgsForCall)]
@@ -2018,6 +2968,134 @@ func (fake *FakeInternalClient) FailTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) MaintenanceMode(arg1 lager.Logger) (*models.MaintenanceMode, error) {
+	fake.maintenanceModeMutex.Lock()
+	ret, specificReturn := fake.maintenanceModeReturnsOnCall[len(fake.maintenanceModeArgsForCall)]
+	fake.maintenanceModeArgsForCall = append(fake.maintenanceModeArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.MaintenanceModeStub
+	fakeReturns := fake.maintenanceModeReturns
+	fake.recordInvocation("MaintenanceMode", []interface{}{arg1})
+	fake.maintenanceModeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) MaintenanceModeCallCount() int {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	return len(fake.maintenanceModeArgsForCall)
+}
+
+func (fake *FakeInternalClient) MaintenanceModeCalls(stub func(lager.Logger) (*models.MaintenanceMode, error)) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = stub
+}
+
+func (fake *FakeInternalClient) MaintenanceModeArgsForCall(i int) lager.Logger {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	argsForCall := fake.maintenanceModeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInternalClient) MaintenanceModeReturns(result1 *models.MaintenanceMode, result2 error) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = nil
+	fake.maintenanceModeReturns = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) MaintenanceModeReturnsOnCall(i int, result1 *models.MaintenanceMode, result2 error) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = nil
+	if fake.maintenanceModeReturnsOnCall == nil {
+		fake.maintenanceModeReturnsOnCall = make(map[int]struct {
+			result1 *models.MaintenanceMode
+			result2 error
+		})
+	}
+	fake.maintenanceModeReturnsOnCall[i] = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) OverrideConvergenceSafetyValve(arg1 lager.Logger) (*models.ConvergenceSafetyValveStatus, error) {
+	fake.overrideConvergenceSafetyValveMutex.Lock()
+	ret, specificReturn := fake.overrideConvergenceSafetyValveReturnsOnCall[len(fake.overrideConvergenceSafetyValveArgsForCall)]
+	fake.overrideConvergenceSafetyValveArgsForCall = append(fake.overrideConvergenceSafetyValveArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.OverrideConvergenceSafetyValveStub
+	fakeReturns := fake.overrideConvergenceSafetyValveReturns
+	fake.recordInvocation("OverrideConvergenceSafetyValve", []interface{}{arg1})
+	fake.overrideConvergenceSafetyValveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) OverrideConvergenceSafetyValveCallCount() int {
+	fake.overrideConvergenceSafetyValveMutex.RLock()
+	defer fake.overrideConvergenceSafetyValveMutex.RUnlock()
+	return len(fake.overrideConvergenceSafetyValveArgsForCall)
+}
+
+func (fake *FakeInternalClient) OverrideConvergenceSafetyValveCalls(stub func(lager.Logger) (*models.ConvergenceSafetyValveStatus, error)) {
+	fake.overrideConvergenceSafetyValveMutex.Lock()
+	defer fake.overrideConvergenceSafetyValveMutex.Unlock()
+	fake.OverrideConvergenceSafetyValveStub = stub
+}
+
+func (fake *FakeInternalClient) OverrideConvergenceSafetyValveArgsForCall(i int) lager.Logger {
+	fake.overrideConvergenceSafetyValveMutex.RLock()
+	defer fake.overrideConvergenceSafetyValveMutex.RUnlock()
+	argsForCall := fake.overrideConvergenceSafetyValveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInternalClient) OverrideConvergenceSafetyValveReturns(result1 *models.ConvergenceSafetyValveStatus, result2 error) {
+	fake.overrideConvergenceSafetyValveMutex.Lock()
+	defer fake.overrideConvergenceSafetyValveMutex.Unlock()
+	fake.OverrideConvergenceSafetyValveStub = nil
+	fake.overrideConvergenceSafetyValveReturns = struct {
+		result1 *models.ConvergenceSafetyValveStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) OverrideConvergenceSafetyValveReturnsOnCall(i int, result1 *models.ConvergenceSafetyValveStatus, result2 error) {
+	fake.overrideConvergenceSafetyValveMutex.Lock()
+	defer fake.overrideConvergenceSafetyValveMutex.Unlock()
+	fake.OverrideConvergenceSafetyValveStub = nil
+	if fake.overrideConvergenceSafetyValveReturnsOnCall == nil {
+		fake.overrideConvergenceSafetyValveReturnsOnCall = make(map[int]struct {
+			result1 *models.ConvergenceSafetyValveStatus
+			result2 error
+		})
+	}
+	fake.overrideConvergenceSafetyValveReturnsOnCall[i] = struct {
+		result1 *models.ConvergenceSafetyValveStatus
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Ping(arg1 lager.Logger) bool {
 	fake.pingMutex.Lock()
 	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
@@ -2142,6 +3220,70 @@ func (fake *FakeInternalClient) RejectTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) ReloadConfig(arg1 lager.Logger) (*models.ConfigReloadReport, error) {
+	fake.reloadConfigMutex.Lock()
+	ret, specificReturn := fake.reloadConfigReturnsOnCall[len(fake.reloadConfigArgsForCall)]
+	fake.reloadConfigArgsForCall = append(fake.reloadConfigArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.ReloadConfigStub
+	fakeReturns := fake.reloadConfigReturns
+	fake.recordInvocation("ReloadConfig", []interface{}{arg1})
+	fake.reloadConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ReloadConfigCallCount() int {
+	fake.reloadConfigMutex.RLock()
+	defer fake.reloadConfigMutex.RUnlock()
+	return len(fake.reloadConfigArgsForCall)
+}
+
+func (fake *FakeInternalClient) ReloadConfigCalls(stub func(lager.Logger) (*models.ConfigReloadReport, error)) {
+	fake.reloadConfigMutex.Lock()
+	defer fake.reloadConfigMutex.Unlock()
+	fake.ReloadConfigStub = stub
+}
+
+func (fake *FakeInternalClient) ReloadConfigArgsForCall(i int) lager.Logger {
+	fake.reloadConfigMutex.RLock()
+	defer fake.reloadConfigMutex.RUnlock()
+	argsForCall := fake.reloadConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInternalClient) ReloadConfigReturns(result1 *models.ConfigReloadReport, result2 error) {
+	fake.reloadConfigMutex.Lock()
+	defer fake.reloadConfigMutex.Unlock()
+	fake.ReloadConfigStub = nil
+	fake.reloadConfigReturns = struct {
+		result1 *models.ConfigReloadReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ReloadConfigReturnsOnCall(i int, result1 *models.ConfigReloadReport, result2 error) {
+	fake.reloadConfigMutex.Lock()
+	defer fake.reloadConfigMutex.Unlock()
+	fake.ReloadConfigStub = nil
+	if fake.reloadConfigReturnsOnCall == nil {
+		fake.reloadConfigReturnsOnCall = make(map[int]struct {
+			result1 *models.ConfigReloadReport
+			result2 error
+		})
+	}
+	fake.reloadConfigReturnsOnCall[i] = struct {
+		result1 *models.ConfigReloadReport
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) RemoveActualLRP(arg1 lager.Logger, arg2 *models.ActualLRPKey, arg3 *models.ActualLRPInstanceKey) error {
 	fake.removeActualLRPMutex.Lock()
 	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
@@ -2454,6 +3596,134 @@ func (fake *FakeInternalClient) RetireActualLRPReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+func (fake *FakeInternalClient) RotateEncryptionKey(arg1 lager.Logger, arg2 string) error {
+	fake.rotateEncryptionKeyMutex.Lock()
+	ret, specificReturn := fake.rotateEncryptionKeyReturnsOnCall[len(fake.rotateEncryptionKeyArgsForCall)]
+	fake.rotateEncryptionKeyArgsForCall = append(fake.rotateEncryptionKeyArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RotateEncryptionKeyStub
+	fakeReturns := fake.rotateEncryptionKeyReturns
+	fake.recordInvocation("RotateEncryptionKey", []interface{}{arg1, arg2})
+	fake.rotateEncryptionKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) RotateEncryptionKeyCallCount() int {
+	fake.rotateEncryptionKeyMutex.RLock()
+	defer fake.rotateEncryptionKeyMutex.RUnlock()
+	return len(fake.rotateEncryptionKeyArgsForCall)
+}
+
+func (fake *FakeInternalClient) RotateEncryptionKeyCalls(stub func(lager.Logger, string) error) {
+	fake.rotateEncryptionKeyMutex.Lock()
+	defer fake.rotateEncryptionKeyMutex.Unlock()
+	fake.RotateEncryptionKeyStub = stub
+}
+
+func (fake *FakeInternalClient) RotateEncryptionKeyArgsForCall(i int) (lager.Logger, string) {
+	fake.rotateEncryptionKeyMutex.RLock()
+	defer fake.rotateEncryptionKeyMutex.RUnlock()
+	argsForCall := fake.rotateEncryptionKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) RotateEncryptionKeyReturns(result1 error) {
+	fake.rotateEncryptionKeyMutex.Lock()
+	defer fake.rotateEncryptionKeyMutex.Unlock()
+	fake.RotateEncryptionKeyStub = nil
+	fake.rotateEncryptionKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RotateEncryptionKeyReturnsOnCall(i int, result1 error) {
+	fake.rotateEncryptionKeyMutex.Lock()
+	defer fake.rotateEncryptionKeyMutex.Unlock()
+	fake.RotateEncryptionKeyStub = nil
+	if fake.rotateEncryptionKeyReturnsOnCall == nil {
+		fake.rotateEncryptionKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rotateEncryptionKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) SetMaintenanceMode(arg1 lager.Logger, arg2 bool, arg3 string) (*models.MaintenanceMode, error) {
+	fake.setMaintenanceModeMutex.Lock()
+	ret, specificReturn := fake.setMaintenanceModeReturnsOnCall[len(fake.setMaintenanceModeArgsForCall)]
+	fake.setMaintenanceModeArgsForCall = append(fake.setMaintenanceModeArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 bool
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SetMaintenanceModeStub
+	fakeReturns := fake.setMaintenanceModeReturns
+	fake.recordInvocation("SetMaintenanceMode", []interface{}{arg1, arg2, arg3})
+	fake.setMaintenanceModeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) SetMaintenanceModeCallCount() int {
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	return len(fake.setMaintenanceModeArgsForCall)
+}
+
+func (fake *FakeInternalClient) SetMaintenanceModeCalls(stub func(lager.Logger, bool, string) (*models.MaintenanceMode, error)) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = stub
+}
+
+func (fake *FakeInternalClient) SetMaintenanceModeArgsForCall(i int) (lager.Logger, bool, string) {
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	argsForCall := fake.setMaintenanceModeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) SetMaintenanceModeReturns(result1 *models.MaintenanceMode, result2 error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = nil
+	fake.setMaintenanceModeReturns = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SetMaintenanceModeReturnsOnCall(i int, result1 *models.MaintenanceMode, result2 error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = nil
+	if fake.setMaintenanceModeReturnsOnCall == nil {
+		fake.setMaintenanceModeReturnsOnCall = make(map[int]struct {
+			result1 *models.MaintenanceMode
+			result2 error
+		})
+	}
+	fake.setMaintenanceModeReturnsOnCall[i] = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) StartActualLRP(arg1 lager.Logger, arg2 *models.ActualLRPKey, arg3 *models.ActualLRPInstanceKey, arg4 *models.ActualLRPNetInfo) error {
 	fake.startActualLRPMutex.Lock()
 	ret, specificReturn := fake.startActualLRPReturnsOnCall[len(fake.startActualLRPArgsForCall)]
@@ -3230,6 +4500,68 @@ func (fake *FakeInternalClient) TasksWithFilterReturnsOnCall(i int, result1 []*m
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) UncordonCell(arg1 lager.Logger, arg2 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) UncordonCellCalls(stub func(lager.Logger, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeInternalClient) UncordonCellArgsForCall(i int) (lager.Logger, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) UpdateDesiredLRP(arg1 lager.Logger, arg2 string, arg3 *models.DesiredLRPUpdate) error {
 	fake.updateDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
@@ -3367,14 +4699,26 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
+	fake.auditEntriesMutex.RLock()
+	defer fake.auditEntriesMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	fake.cellSummariesMutex.RLock()
+	defer fake.cellSummariesMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
+	fake.convergencePlanMutex.RLock()
+	defer fake.convergencePlanMutex.RUnlock()
+	fake.convergenceSafetyValveMutex.RLock()
+	defer fake.convergenceSafetyValveMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
 	fake.crashActualLRPMutex.RLock()
 	defer fake.crashActualLRPMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
@@ -3389,8 +4733,16 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	fake.encryptionKeyUsageMutex.RLock()
+	defer fake.encryptionKeyUsageMutex.RUnlock()
+	fake.encryptionStatusMutex.RLock()
+	defer fake.encryptionStatusMutex.RUnlock()
 	fake.evacuateClaimedActualLRPMutex.RLock()
 	defer fake.evacuateClaimedActualLRPMutex.RUnlock()
 	fake.evacuateCrashedActualLRPMutex.RLock()
@@ -3399,14 +4751,22 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.evacuateRunningActualLRPMutex.RUnlock()
 	fake.evacuateStoppedActualLRPMutex.RLock()
 	defer fake.evacuateStoppedActualLRPMutex.RUnlock()
+	fake.explainActualLRPMutex.RLock()
+	defer fake.explainActualLRPMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	fake.overrideConvergenceSafetyValveMutex.RLock()
+	defer fake.overrideConvergenceSafetyValveMutex.RUnlock()
 	fake.pingMutex.RLock()
 	defer fake.pingMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
 	defer fake.rejectTaskMutex.RUnlock()
+	fake.reloadConfigMutex.RLock()
+	defer fake.reloadConfigMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
@@ -3417,6 +4777,10 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.resolvingTaskMutex.RUnlock()
 	fake.retireActualLRPMutex.RLock()
 	defer fake.retireActualLRPMutex.RUnlock()
+	fake.rotateEncryptionKeyMutex.RLock()
+	defer fake.rotateEncryptionKeyMutex.RUnlock()
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
 	fake.startTaskMutex.RLock()
@@ -3441,6 +4805,8 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.tasksByDomainMutex.RUnlock()
 	fake.tasksWithFilterMutex.RLock()
 	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
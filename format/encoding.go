@@ -1,8 +1,12 @@
 package format
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"code.cloudfoundry.org/bbs/encryption"
 )
@@ -10,13 +14,48 @@ import (
 type Encoding [EncodingOffset]byte
 
 var (
-	BASE64_ENCRYPTED Encoding = [2]byte{'0', '2'}
+	BASE64_ENCRYPTED               Encoding = [2]byte{'0', '2'}
+	BASE64_ENVELOPE_ENCRYPTED      Encoding = [2]byte{'0', '3'}
+	BASE64_GZIP_ENCRYPTED          Encoding = [2]byte{'0', '4'}
+	BASE64_GZIP_ENVELOPE_ENCRYPTED Encoding = [2]byte{'0', '5'}
 )
 
 const EncodingOffset int = 2
 
+const maxWrappedKeyLength = 1<<16 - 1
+
+type encodingProperties struct {
+	envelope bool
+	gzip     bool
+}
+
+var encodings = map[Encoding]encodingProperties{
+	BASE64_ENCRYPTED:               {},
+	BASE64_ENVELOPE_ENCRYPTED:      {envelope: true},
+	BASE64_GZIP_ENCRYPTED:          {gzip: true},
+	BASE64_GZIP_ENVELOPE_ENCRYPTED: {envelope: true, gzip: true},
+}
+
+// Compression selects how payloads are compressed before they are encrypted.
+type Compression string
+
+const (
+	NoCompression   Compression = ""
+	GzipCompression Compression = "gzip"
+)
+
+func (c Compression) Validate() error {
+	switch c {
+	case NoCompression, GzipCompression:
+		return nil
+	default:
+		return fmt.Errorf("Unknown compression: %q", string(c))
+	}
+}
+
 type encoder struct {
-	cryptor encryption.Cryptor
+	cryptor     encryption.Cryptor
+	compression Compression
 }
 
 type Encoder interface {
@@ -25,62 +64,179 @@ type Encoder interface {
 }
 
 func NewEncoder(cryptor encryption.Cryptor) Encoder {
-	return &encoder{cryptor: cryptor}
+	return NewEncoderWithCompression(cryptor, NoCompression)
+}
+
+// NewEncoderWithCompression returns an Encoder that compresses payloads
+// before encrypting them. Payloads that do not get smaller are stored
+// uncompressed. Decoding supports every encoding regardless of compression.
+func NewEncoderWithCompression(cryptor encryption.Cryptor, compression Compression) Encoder {
+	return &encoder{cryptor: cryptor, compression: compression}
 }
 
 func (e *encoder) Encode(payload []byte) ([]byte, error) {
-	encrypted, err := e.encrypt(payload)
+	compressed := false
+	if e.compression == GzipCompression {
+		gzipped, err := gzipPayload(payload)
+		if err != nil {
+			return nil, err
+		}
+		if len(gzipped) < len(payload) {
+			payload = gzipped
+			compressed = true
+		}
+	}
+
+	encoding, encrypted, err := e.encrypt(payload)
 	if err != nil {
 		return nil, err
 	}
+
+	if compressed {
+		if encoding == BASE64_ENVELOPE_ENCRYPTED {
+			encoding = BASE64_GZIP_ENVELOPE_ENCRYPTED
+		} else {
+			encoding = BASE64_GZIP_ENCRYPTED
+		}
+	}
+
 	encoded := encodeBase64(encrypted)
-	return append(BASE64_ENCRYPTED[:], encoded...), nil
+	return append(encoding[:], encoded...), nil
 }
 
 func (e *encoder) Decode(payload []byte) ([]byte, error) {
-	encoding := encodingFromPayload(payload)
-	switch encoding {
-	case BASE64_ENCRYPTED:
-		encrypted, err := decodeBase64(payload[EncodingOffset:])
-		if err != nil {
-			return nil, err
-		}
-		return e.decrypt(encrypted)
-	default:
-		return nil, fmt.Errorf("Unknown encoding: %v", encoding)
+	encrypted, properties, err := parsePayload(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := e.cryptor.Decrypt(encrypted)
+	if err != nil {
+		return nil, err
 	}
+
+	if properties.gzip {
+		return gunzipPayload(decrypted)
+	}
+	return decrypted, nil
 }
 
-func (e *encoder) encrypt(cleartext []byte) ([]byte, error) {
-	encrypted, err := e.cryptor.Encrypt(cleartext)
+func gzipPayload(payload []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write(payload)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
 	if err != nil {
 		return nil, err
 	}
+	return buf.Bytes(), nil
+}
+
+func gunzipPayload(payload []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to decompress payload: %s", err)
+	}
+	defer reader.Close()
+
+	decompressed, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to decompress payload: %s", err)
+	}
+	return decompressed, nil
+}
+
+func (e *encoder) encrypt(cleartext []byte) (Encoding, []byte, error) {
+	encrypted, err := e.cryptor.Encrypt(cleartext)
+	if err != nil {
+		return Encoding{}, nil, err
+	}
 
+	encoding := BASE64_ENCRYPTED
 	payload := []byte{}
 	payload = append(payload, byte(len(encrypted.KeyLabel)))
 	payload = append(payload, []byte(encrypted.KeyLabel)...)
+	if len(encrypted.WrappedKey) > 0 {
+		if len(encrypted.WrappedKey) > maxWrappedKeyLength {
+			return Encoding{}, nil, fmt.Errorf("Wrapped key is longer than %d bytes", maxWrappedKeyLength)
+		}
+		encoding = BASE64_ENVELOPE_ENCRYPTED
+		payload = append(payload, byte(len(encrypted.WrappedKey)>>8), byte(len(encrypted.WrappedKey)))
+		payload = append(payload, encrypted.WrappedKey...)
+	}
 	payload = append(payload, encrypted.Nonce...)
 	payload = append(payload, encrypted.CipherText...)
 
-	return payload, nil
+	return encoding, payload, nil
+}
+
+// KeyLabel returns the label of the key an encoded payload was encrypted with,
+// without decrypting it.
+func KeyLabel(payload []byte) (string, error) {
+	encrypted, _, err := parsePayload(payload)
+	if err != nil {
+		return "", err
+	}
+	return encrypted.KeyLabel, nil
+}
+
+func parsePayload(payload []byte) (encryption.Encrypted, encodingProperties, error) {
+	if len(payload) < EncodingOffset {
+		return encryption.Encrypted{}, encodingProperties{}, fmt.Errorf("Payload too short to contain an encoding: %d bytes", len(payload))
+	}
+
+	encoding := encodingFromPayload(payload)
+	properties, ok := encodings[encoding]
+	if !ok {
+		return encryption.Encrypted{}, encodingProperties{}, fmt.Errorf("Unknown encoding: %v", encoding)
+	}
+
+	encryptedData, err := decodeBase64(payload[EncodingOffset:])
+	if err != nil {
+		return encryption.Encrypted{}, encodingProperties{}, err
+	}
+
+	encrypted, err := parseEncrypted(encryptedData, properties.envelope)
+	return encrypted, properties, err
 }
 
-func (e *encoder) decrypt(encryptedData []byte) ([]byte, error) {
-	labelLength := encryptedData[0]
+func parseEncrypted(encryptedData []byte, envelope bool) (encryption.Encrypted, error) {
+	if len(encryptedData) < 1 {
+		return encryption.Encrypted{}, errors.New("Encrypted payload is empty")
+	}
+
+	labelLength := int(encryptedData[0])
 	encryptedData = encryptedData[1:]
+	if len(encryptedData) < labelLength {
+		return encryption.Encrypted{}, errors.New("Encrypted payload is truncated")
+	}
 
-	label := string(encryptedData[:labelLength])
+	encrypted := encryption.Encrypted{KeyLabel: string(encryptedData[:labelLength])}
 	encryptedData = encryptedData[labelLength:]
 
-	nonce := encryptedData[:encryption.NonceSize]
-	ciphertext := encryptedData[encryption.NonceSize:]
+	if envelope {
+		if len(encryptedData) < 2 {
+			return encryption.Encrypted{}, errors.New("Encrypted payload is truncated")
+		}
+		wrappedKeyLength := int(encryptedData[0])<<8 | int(encryptedData[1])
+		encryptedData = encryptedData[2:]
+		if len(encryptedData) < wrappedKeyLength {
+			return encryption.Encrypted{}, errors.New("Encrypted payload is truncated")
+		}
+		encrypted.WrappedKey = encryptedData[:wrappedKeyLength]
+		encryptedData = encryptedData[wrappedKeyLength:]
+	}
+
+	if len(encryptedData) < encryption.NonceSize {
+		return encryption.Encrypted{}, errors.New("Encrypted payload is truncated")
+	}
 
-	return e.cryptor.Decrypt(encryption.Encrypted{
-		KeyLabel:   label,
-		Nonce:      nonce,
-		CipherText: ciphertext,
-	})
+	encrypted.Nonce = encryptedData[:encryption.NonceSize]
+	encrypted.CipherText = encryptedData[encryption.NonceSize:]
+	return encrypted, nil
 }
 
 func encodeBase64(unencodedPayload []byte) []byte {
@@ -97,6 +253,17 @@ func decodeBase64(encodedPayload []byte) ([]byte, error) {
 	return decodedPayload[:n], err
 }
 
+// IsEncoded reports whether the payload starts with one of the encodings
+// Decode understands, as opposed to plain data written before it was
+// encrypted.
+func IsEncoded(payload []byte) bool {
+	if len(payload) < EncodingOffset {
+		return false
+	}
+	_, ok := encodings[encodingFromPayload(payload)]
+	return ok
+}
+
 func encodingFromPayload(payload []byte) Encoding {
 	return Encoding{payload[0], payload[1]}
 }
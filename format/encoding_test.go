@@ -1,6 +1,7 @@
 package format_test
 
 import (
+	"bytes"
 	"encoding/base64"
 	"errors"
 	"io"
@@ -86,6 +87,60 @@ var _ = Describe("Encoding", func() {
 		})
 	})
 
+	Describe("BASE64_ENVELOPE_ENCRYPTED", func() {
+		var envelopeKey encryption.EnvelopeKey
+
+		BeforeEach(func() {
+			provider, err := encryption.NewPassphraseProvider("key encryption key")
+			Expect(err).NotTo(HaveOccurred())
+			envelopeKey, err = encryption.NewEnvelopeKey("envelope-label", provider)
+			Expect(err).NotTo(HaveOccurred())
+
+			keyManager, err := encryption.NewKeyManager(envelopeKey, nil)
+			Expect(err).NotTo(HaveOccurred())
+			cryptor = encryption.NewCryptor(keyManager, prng)
+		})
+
+		It("records the key label and the wrapped data key in the header", func() {
+			payload := []byte("some-payload")
+			encoded, err := encoder.Encode(payload)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(encoded[0:2]).To(Equal(format.BASE64_ENVELOPE_ENCRYPTED[:]))
+			decoded, err := base64.StdEncoding.DecodeString(string(encoded[2:]))
+			Expect(err).NotTo(HaveOccurred())
+
+			labelLength := int(decoded[0])
+			Expect(string(decoded[1 : 1+labelLength])).To(Equal("envelope-label"))
+			decoded = decoded[1+labelLength:]
+
+			wrappedKeyLength := int(decoded[0])<<8 | int(decoded[1])
+			Expect(decoded[2 : 2+wrappedKeyLength]).To(Equal(envelopeKey.WrappedKey()))
+
+			label, err := format.KeyLabel(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(label).To(Equal("envelope-label"))
+		})
+
+		It("decodes the payload", func() {
+			payload := []byte("some-payload")
+			encoded, err := encoder.Encode(payload)
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := encoder.Decode(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(payload))
+		})
+
+		It("fails when the wrapped key is truncated", func() {
+			encoded := []byte{1, 'l', 0, 40, 'x'}
+			payload := append(format.BASE64_ENVELOPE_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString(encoded))...)
+
+			_, err := encoder.Decode(payload)
+			Expect(err).To(MatchError("Encrypted payload is truncated"))
+		})
+	})
+
 	Describe("Decode", func() {
 		Describe("BASE64_ENCRYPTED", func() {
 			It("returns the decrypted payload without an encoding type prefix", func() {
@@ -106,6 +161,27 @@ var _ = Describe("Encoding", func() {
 			})
 		})
 
+		Describe("truncated payloads", func() {
+			It("fails when the payload is too short to contain an encoding", func() {
+				_, err := encoder.Decode([]byte("0"))
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("fails when the encrypted payload is empty", func() {
+				_, err := encoder.Decode(format.BASE64_ENCRYPTED[:])
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("fails when the encrypted payload is missing its nonce", func() {
+				encoded := []byte{5}
+				encoded = append(encoded, []byte("label")...)
+				payload := append(format.BASE64_ENCRYPTED[:], []byte(base64.StdEncoding.EncodeToString(encoded))...)
+
+				_, err := encoder.Decode(payload)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
 		Describe("unkown encoding", func() {
 			It("fails with an unknown encoding error", func() {
 				payload := []byte("99some-payload")
@@ -115,6 +191,133 @@ var _ = Describe("Encoding", func() {
 			})
 		})
 	})
+
+	Describe("IsEncoded", func() {
+		It("recognises encoded payloads", func() {
+			encoded, err := encoder.Encode([]byte("some-payload"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(format.IsEncoded(encoded)).To(BeTrue())
+		})
+
+		It("does not recognise plain payloads", func() {
+			Expect(format.IsEncoded([]byte(`{"cf-router":[]}`))).To(BeFalse())
+			Expect(format.IsEncoded([]byte("9"))).To(BeFalse())
+			Expect(format.IsEncoded(nil)).To(BeFalse())
+		})
+	})
+
+	Describe("gzip compression", func() {
+		var compressible []byte
+
+		BeforeEach(func() {
+			compressible = bytes.Repeat([]byte("some-compressible-payload"), 100)
+		})
+
+		JustBeforeEach(func() {
+			encoder = format.NewEncoderWithCompression(cryptor, format.GzipCompression)
+		})
+
+		It("compresses the payload before encrypting it", func() {
+			encoded, err := encoder.Encode(compressible)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded[0:2]).To(Equal(format.BASE64_GZIP_ENCRYPTED[:]))
+
+			uncompressed, err := format.NewEncoder(cryptor).Encode(compressible)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(encoded)).To(BeNumerically("<", len(uncompressed)))
+
+			decoded, err := encoder.Decode(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(compressible))
+
+			label, err := format.KeyLabel(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(label).To(Equal("label"))
+		})
+
+		It("stores payloads that do not get smaller uncompressed", func() {
+			encoded, err := encoder.Encode([]byte("tiny"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded[0:2]).To(Equal(format.BASE64_ENCRYPTED[:]))
+
+			decoded, err := encoder.Decode(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal([]byte("tiny")))
+		})
+
+		It("decodes payloads written without compression", func() {
+			encoded, err := format.NewEncoder(cryptor).Encode(compressible)
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := encoder.Decode(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(compressible))
+		})
+
+		It("decodes compressed payloads with an encoder that does not compress", func() {
+			encoded, err := encoder.Encode(compressible)
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := format.NewEncoder(cryptor).Decode(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(compressible))
+		})
+
+		Context("with an envelope key", func() {
+			BeforeEach(func() {
+				provider, err := encryption.NewPassphraseProvider("key encryption key")
+				Expect(err).NotTo(HaveOccurred())
+				envelopeKey, err := encryption.NewEnvelopeKey("envelope-label", provider)
+				Expect(err).NotTo(HaveOccurred())
+
+				keyManager, err := encryption.NewKeyManager(envelopeKey, nil)
+				Expect(err).NotTo(HaveOccurred())
+				cryptor = encryption.NewCryptor(keyManager, prng)
+			})
+
+			It("uses the compressed envelope encoding", func() {
+				encoded, err := encoder.Encode(compressible)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded[0:2]).To(Equal(format.BASE64_GZIP_ENVELOPE_ENCRYPTED[:]))
+
+				decoded, err := encoder.Decode(encoded)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decoded).To(Equal(compressible))
+			})
+		})
+	})
+
+	Describe("Compression", func() {
+		It("accepts the known compressions", func() {
+			Expect(format.NoCompression.Validate()).To(Succeed())
+			Expect(format.GzipCompression.Validate()).To(Succeed())
+		})
+
+		It("rejects unknown compressions", func() {
+			Expect(format.Compression("lz4").Validate()).To(MatchError(`Unknown compression: "lz4"`))
+		})
+	})
+
+	Describe("KeyLabel", func() {
+		It("returns the label of the key used to encrypt the payload", func() {
+			encoded, err := encoder.Encode([]byte("payload"))
+			Expect(err).NotTo(HaveOccurred())
+
+			label, err := format.KeyLabel(encoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(label).To(Equal("label"))
+		})
+
+		It("fails on truncated payloads", func() {
+			_, err := format.KeyLabel(format.BASE64_ENCRYPTED[:])
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails on unknown encodings", func() {
+			_, err := format.KeyLabel([]byte("99some-payload"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 type zeroReader struct{}
@@ -1,6 +1,8 @@
 package format
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/gogo/protobuf/proto"
 )
@@ -14,6 +16,9 @@ const (
 const EnvelopeOffset int = 2
 
 func UnmarshalEnvelope(logger lager.Logger, unencodedPayload []byte, model Model) error {
+	if len(unencodedPayload) < EnvelopeOffset {
+		return fmt.Errorf("Payload too short to contain an envelope: %d bytes", len(unencodedPayload))
+	}
 	return UnmarshalProto(logger, unencodedPayload[EnvelopeOffset:], model)
 }
 
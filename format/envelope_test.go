@@ -52,5 +52,11 @@ var _ = Describe("Envelope", func() {
 			err := format.UnmarshalEnvelope(logger, payload, model)
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("returns an error when the payload is shorter than the envelope header", func() {
+			model := model_helpers.NewValidTask("foo")
+			err := format.UnmarshalEnvelope(logger, []byte{byte(format.PROTO)}, model)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
@@ -15,8 +15,12 @@ type Serializer interface {
 }
 
 func NewSerializer(cryptor encryption.Cryptor) Serializer {
+	return NewSerializerWithCompression(cryptor, NoCompression)
+}
+
+func NewSerializerWithCompression(cryptor encryption.Cryptor, compression Compression) Serializer {
 	return &serializer{
-		encoder: NewEncoder(cryptor),
+		encoder: NewEncoderWithCompression(cryptor, compression),
 	}
 }
 
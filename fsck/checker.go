@@ -0,0 +1,457 @@
+package fsck
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+const (
+	tasksTable       = "tasks"
+	desiredLRPsTable = "desired_lrps"
+	actualLRPsTable  = "actual_lrps"
+	domainsTable     = "domains"
+)
+
+var (
+	domainColumns = helpers.ColumnList{"domain"}
+
+	desiredLRPColumns = helpers.ColumnList{
+		"process_guid",
+		"domain",
+		"log_guid",
+		"annotation",
+		"instances",
+		"memory_mb",
+		"disk_mb",
+		"max_pids",
+		"rootfs",
+		"routes",
+		"volume_placement",
+		"modification_tag_epoch",
+		"modification_tag_index",
+		"placement_tags",
+		"disruption_budget",
+		"run_info",
+	}
+
+	actualLRPColumns = helpers.ColumnList{
+		"process_guid",
+		"instance_index",
+		"presence",
+		"domain",
+		"state",
+		"instance_guid",
+		"cell_id",
+		"placement_error",
+		"since",
+		"net_info",
+		"modification_tag_epoch",
+		"modification_tag_index",
+		"crash_count",
+		"crash_reason",
+	}
+
+	taskColumns = helpers.ColumnList{
+		"guid",
+		"domain",
+		"updated_at",
+		"created_at",
+		"first_completed_at",
+		"state",
+		"cell_id",
+		"result",
+		"failed",
+		"failure_reason",
+		"task_definition",
+		"rejection_count",
+		"rejection_reason",
+	}
+)
+
+type desiredLRPSummary struct {
+	domain    string
+	instances int32
+}
+
+type indexKey struct {
+	processGuid string
+	index       int32
+}
+
+// Checker scans the BBS tables offline and reports rows that the BBS would
+// either fail to read or that violate the invariants it relies on. The
+// cryptor must be able to decrypt every key label in use, so it should be
+// built with all configured encryption keys.
+type Checker struct {
+	db         helpers.QueryableDB
+	helper     helpers.SQLHelper
+	encoder    format.Encoder
+	serializer format.Serializer
+}
+
+func NewChecker(db helpers.QueryableDB, flavor string, cryptor encryption.Cryptor) *Checker {
+	return &Checker{
+		db:         db,
+		helper:     helpers.NewSQLHelper(flavor),
+		encoder:    format.NewEncoder(cryptor),
+		serializer: format.NewSerializer(cryptor),
+	}
+}
+
+func (c *Checker) Check(ctx context.Context, logger lager.Logger) (*Report, error) {
+	logger = logger.Session("fsck")
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	report := newReport()
+
+	domains, err := c.scanDomains(ctx, logger, report)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredLRPs, err := c.checkDesiredLRPs(ctx, logger, report, domains)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkActualLRPs(ctx, logger, report, domains, desiredLRPs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkTasks(ctx, logger, report, domains)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("checked", lager.Data{"scanned": report.Scanned, "problems": len(report.Problems), "warnings": len(report.Warnings)})
+	return report, nil
+}
+
+// Repair applies the given repair plan in a single transaction.
+func (c *Checker) Repair(ctx context.Context, logger lager.Logger, repairs []Repair) error {
+	logger = logger.Session("fsck-repair", lager.Data{"statements": len(repairs)})
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	return c.helper.Transact(ctx, logger, c.db, func(logger lager.Logger, tx helpers.Tx) error {
+		for _, repair := range repairs {
+			_, err := tx.ExecContext(ctx, c.helper.Rebind(repair.Query), repair.Args...)
+			if err != nil {
+				logger.Error("failed-applying-repair", err, lager.Data{"description": repair.Description})
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (c *Checker) scanDomains(ctx context.Context, logger lager.Logger, report *Report) (map[string]struct{}, error) {
+	rows, err := c.helper.All(ctx, logger, c.db, domainsTable, domainColumns, helpers.NoLockRow, "")
+	if err != nil {
+		logger.Error("failed-query-domains", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	domains := map[string]struct{}{}
+	for rows.Next() {
+		var domain string
+		err := rows.Scan(&domain)
+		if err != nil {
+			logger.Error("failed-scanning-domain", err)
+			return nil, err
+		}
+		domains[domain] = struct{}{}
+		report.Scanned[domainsTable]++
+	}
+
+	return domains, rows.Err()
+}
+
+func (c *Checker) checkDesiredLRPs(ctx context.Context, logger lager.Logger, report *Report, domains map[string]struct{}) (map[string]desiredLRPSummary, error) {
+	rows, err := c.helper.All(ctx, logger, c.db, desiredLRPsTable, desiredLRPColumns, helpers.NoLockRow, "")
+	if err != nil {
+		logger.Error("failed-query-desired-lrps", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	summaries := map[string]desiredLRPSummary{}
+	for rows.Next() {
+		var schedulingInfo models.DesiredLRPSchedulingInfo
+		var routeData, volumePlacementData, runInfoData []byte
+		var placementTagData, disruptionBudgetData sql.NullString
+
+		err := rows.Scan(
+			&schedulingInfo.ProcessGuid,
+			&schedulingInfo.Domain,
+			&schedulingInfo.LogGuid,
+			&schedulingInfo.Annotation,
+			&schedulingInfo.Instances,
+			&schedulingInfo.MemoryMb,
+			&schedulingInfo.DiskMb,
+			&schedulingInfo.MaxPids,
+			&schedulingInfo.RootFs,
+			&routeData,
+			&volumePlacementData,
+			&schedulingInfo.ModificationTag.Epoch,
+			&schedulingInfo.ModificationTag.Index,
+			&placementTagData,
+			&disruptionBudgetData,
+			&runInfoData,
+		)
+		if err != nil {
+			logger.Error("failed-scanning-desired-lrp", err)
+			return nil, err
+		}
+		report.Scanned[desiredLRPsTable]++
+
+		guid := schedulingInfo.ProcessGuid
+		summaries[guid] = desiredLRPSummary{
+			domain:    schedulingInfo.Domain,
+			instances: schedulingInfo.Instances,
+		}
+		c.checkDomain(report, desiredLRPsTable, guid, schedulingInfo.Domain, domains)
+
+		var runInfo models.DesiredLRPRunInfo
+		var volumePlacement models.VolumePlacement
+		decodeErr := c.decodeRoutes(routeData, &schedulingInfo.Routes)
+		if decodeErr == nil {
+			decodeErr = c.serializer.Unmarshal(logger, volumePlacementData, &volumePlacement)
+		}
+		if decodeErr == nil {
+			decodeErr = c.serializer.Unmarshal(logger, runInfoData, &runInfo)
+		}
+		if decodeErr == nil && placementTagData.Valid {
+			decodeErr = json.Unmarshal([]byte(placementTagData.String), &schedulingInfo.PlacementTags)
+		}
+		if decodeErr == nil && disruptionBudgetData.Valid {
+			schedulingInfo.DisruptionBudget = &models.DisruptionBudget{}
+			decodeErr = json.Unmarshal([]byte(disruptionBudgetData.String), schedulingInfo.DisruptionBudget)
+		}
+		if decodeErr != nil {
+			report.addProblem(desiredLRPsTable, guid, CheckUndecodable, decodeErr.Error())
+			report.addRepair(
+				fmt.Sprintf("delete undecodable desired lrp %s", guid),
+				"DELETE FROM desired_lrps WHERE process_guid = ?", guid,
+			)
+			continue
+		}
+		schedulingInfo.VolumePlacement = &volumePlacement
+
+		desiredLRP := models.NewDesiredLRP(schedulingInfo, runInfo)
+		if err := desiredLRP.Validate(); err != nil {
+			report.addProblem(desiredLRPsTable, guid, CheckInvalid, err.Error())
+		}
+	}
+
+	return summaries, rows.Err()
+}
+
+func (c *Checker) checkActualLRPs(ctx context.Context, logger lager.Logger, report *Report, domains map[string]struct{}, desiredLRPs map[string]desiredLRPSummary) error {
+	rows, err := c.helper.All(ctx, logger, c.db, actualLRPsTable, actualLRPColumns, helpers.NoLockRow, "")
+	if err != nil {
+		logger.Error("failed-query-actual-lrps", err)
+		return err
+	}
+	defer rows.Close()
+
+	presences := map[indexKey][]models.ActualLRP_Presence{}
+	for rows.Next() {
+		var actualLRP models.ActualLRP
+		var netInfoData []byte
+
+		err := rows.Scan(
+			&actualLRP.ProcessGuid,
+			&actualLRP.Index,
+			&actualLRP.Presence,
+			&actualLRP.Domain,
+			&actualLRP.State,
+			&actualLRP.InstanceGuid,
+			&actualLRP.CellId,
+			&actualLRP.PlacementError,
+			&actualLRP.Since,
+			&netInfoData,
+			&actualLRP.ModificationTag.Epoch,
+			&actualLRP.ModificationTag.Index,
+			&actualLRP.CrashCount,
+			&actualLRP.CrashReason,
+		)
+		if err != nil {
+			logger.Error("failed-scanning-actual-lrp", err)
+			return err
+		}
+		report.Scanned[actualLRPsTable]++
+
+		key := fmt.Sprintf("%s/%d/%s", actualLRP.ProcessGuid, actualLRP.Index, actualLRP.Presence.String())
+		pk := []interface{}{actualLRP.ProcessGuid, actualLRP.Index, actualLRP.Presence}
+		deleteQuery := "DELETE FROM actual_lrps WHERE process_guid = ? AND instance_index = ? AND presence = ?"
+
+		ik := indexKey{actualLRP.ProcessGuid, actualLRP.Index}
+		presences[ik] = append(presences[ik], actualLRP.Presence)
+
+		c.checkDomain(report, actualLRPsTable, key, actualLRP.Domain, domains)
+
+		if len(netInfoData) > 0 {
+			err = c.serializer.Unmarshal(logger, netInfoData, &actualLRP.ActualLRPNetInfo)
+			if err != nil {
+				report.addProblem(actualLRPsTable, key, CheckUndecodable, err.Error())
+				report.addRepair(fmt.Sprintf("delete undecodable actual lrp %s", key), deleteQuery, pk...)
+				continue
+			}
+		}
+
+		if err := actualLRP.Validate(); err != nil {
+			report.addProblem(actualLRPsTable, key, CheckInvalid, err.Error())
+		}
+		if _, ok := models.ActualLRP_Presence_name[int32(actualLRP.Presence)]; !ok {
+			report.addProblem(actualLRPsTable, key, CheckInvalid, fmt.Sprintf("unknown presence %d", actualLRP.Presence))
+		}
+
+		// only instances that are not placed on a cell can be removed without
+		// leaving a workload behind; the rest are left to convergence
+		removable := actualLRP.State == models.ActualLRPStateUnclaimed || actualLRP.State == models.ActualLRPStateCrashed
+
+		desired, ok := desiredLRPs[actualLRP.ProcessGuid]
+		if !ok {
+			report.addProblem(actualLRPsTable, key, CheckOrphaned, "no desired lrp with this process guid")
+			if removable {
+				report.addRepair(fmt.Sprintf("delete orphaned actual lrp %s", key), deleteQuery, pk...)
+			}
+			continue
+		}
+
+		if actualLRP.Index < 0 || actualLRP.Index >= desired.instances {
+			report.addProblem(actualLRPsTable, key, CheckIndexOutOfRange,
+				fmt.Sprintf("index %d is outside of the %d desired instances", actualLRP.Index, desired.instances))
+			if removable {
+				report.addRepair(fmt.Sprintf("delete out of range actual lrp %s", key), deleteQuery, pk...)
+			}
+		}
+
+		if actualLRP.Domain != desired.domain {
+			report.addProblem(actualLRPsTable, key, CheckDomainMismatch,
+				fmt.Sprintf("domain %q does not match desired lrp domain %q", actualLRP.Domain, desired.domain))
+			report.addRepair(
+				fmt.Sprintf("set domain of actual lrp %s to %s", key, desired.domain),
+				"UPDATE actual_lrps SET domain = ? WHERE process_guid = ? AND instance_index = ? AND presence = ?",
+				append([]interface{}{desired.domain}, pk...)...,
+			)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// the primary key already guarantees one row per presence, but an index
+	// may only ever have an evacuating or a suspect instance, never both
+	for ik, ps := range presences {
+		var evacuating, suspect bool
+		for _, p := range ps {
+			switch p {
+			case models.ActualLRP_Evacuating:
+				evacuating = true
+			case models.ActualLRP_Suspect:
+				suspect = true
+			}
+		}
+		if evacuating && suspect {
+			report.addProblem(actualLRPsTable, fmt.Sprintf("%s/%d", ik.processGuid, ik.index), CheckConflictingPresence,
+				"index has both an evacuating and a suspect instance")
+		}
+	}
+
+	return nil
+}
+
+func (c *Checker) checkTasks(ctx context.Context, logger lager.Logger, report *Report, domains map[string]struct{}) error {
+	rows, err := c.helper.All(ctx, logger, c.db, tasksTable, taskColumns, helpers.NoLockRow, "")
+	if err != nil {
+		logger.Error("failed-query-tasks", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var task models.Task
+		var result sql.NullString
+		var taskDefData []byte
+
+		err := rows.Scan(
+			&task.TaskGuid,
+			&task.Domain,
+			&task.UpdatedAt,
+			&task.CreatedAt,
+			&task.FirstCompletedAt,
+			&task.State,
+			&task.CellId,
+			&result,
+			&task.Failed,
+			&task.FailureReason,
+			&taskDefData,
+			&task.RejectionCount,
+			&task.RejectionReason,
+		)
+		if err != nil {
+			logger.Error("failed-scanning-task", err)
+			return err
+		}
+		report.Scanned[tasksTable]++
+		task.Result = result.String
+
+		c.checkDomain(report, tasksTable, task.TaskGuid, task.Domain, domains)
+
+		var taskDef models.TaskDefinition
+		err = c.serializer.Unmarshal(logger, taskDefData, &taskDef)
+		if err != nil {
+			report.addProblem(tasksTable, task.TaskGuid, CheckUndecodable, err.Error())
+			report.addRepair(
+				fmt.Sprintf("delete undecodable task %s", task.TaskGuid),
+				"DELETE FROM tasks WHERE guid = ?", task.TaskGuid,
+			)
+			continue
+		}
+		task.TaskDefinition = &taskDef
+
+		if err := task.Validate(); err != nil {
+			report.addProblem(tasksTable, task.TaskGuid, CheckInvalid, err.Error())
+		}
+	}
+
+	return rows.Err()
+}
+
+// checkDomain only warns: domains expire and are pruned as a matter of course,
+// and the records of an expired domain are left for their owner to converge.
+func (c *Checker) checkDomain(report *Report, table, key, domain string, domains map[string]struct{}) {
+	if domain == "" {
+		return
+	}
+	if _, ok := domains[domain]; !ok {
+		report.addWarning(table, key, CheckMissingDomain, fmt.Sprintf("domain %q does not exist", domain))
+	}
+}
+
+// decodeRoutes accepts routes the encrypt_routes data migration has not
+// reached yet, which are still in plain text.
+func (c *Checker) decodeRoutes(routeData []byte, routes *models.Routes) error {
+	decoded := routeData
+	if format.IsEncoded(routeData) {
+		var err error
+		decoded, err = c.encoder.Decode(routeData)
+		if err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(decoded, routes)
+}
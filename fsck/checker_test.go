@@ -0,0 +1,205 @@
+package fsck_test
+
+import (
+	"crypto/rand"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/fsck"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		checker    *fsck.Checker
+		desiredLRP *models.DesiredLRP
+		task       *models.Task
+		report     *fsck.Report
+	)
+
+	BeforeEach(func() {
+		checker = fsck.NewChecker(db, dbFlavor, cryptor)
+
+		Expect(sqlDB.UpsertDomain(ctx, logger, "some-domain", 100)).To(Succeed())
+
+		desiredLRP = model_helpers.NewValidDesiredLRP("the-guid")
+		desiredLRP.Instances = 2
+		Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+		for i := int32(0); i < 2; i++ {
+			_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: "the-guid", Index: i, Domain: "some-domain"})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		task = model_helpers.NewValidTask("the-task-guid")
+		_, err := sqlDB.DesireTask(ctx, logger, task.TaskDefinition, task.TaskGuid, "some-domain")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		report, err = checker.Check(ctx, logger)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	problem := func(table, key, check string) types.GomegaMatcher {
+		return MatchFields(IgnoreExtras, Fields{
+			"Table": Equal(table),
+			"Key":   Equal(key),
+			"Check": Equal(check),
+		})
+	}
+
+	Context("when the database is consistent", func() {
+		It("reports no problems", func() {
+			Expect(report.Clean()).To(BeTrue())
+			Expect(report.Repairs).To(BeEmpty())
+		})
+
+		It("reports the number of scanned rows per table", func() {
+			Expect(report.Scanned).To(Equal(map[string]int{
+				"domains":      1,
+				"desired_lrps": 1,
+				"actual_lrps":  2,
+				"tasks":        1,
+			}))
+		})
+	})
+
+	Context("when a blob cannot be decoded", func() {
+		BeforeEach(func() {
+			execSQL("UPDATE desired_lrps SET run_info = ? WHERE process_guid = ?", "{{", "the-guid")
+			execSQL("UPDATE tasks SET task_definition = ? WHERE guid = ?", "{{", "the-task-guid")
+		})
+
+		It("reports the rows and plans to delete them", func() {
+			Expect(report.Problems).To(ConsistOf(
+				problem("desired_lrps", "the-guid", fsck.CheckUndecodable),
+				problem("tasks", "the-task-guid", fsck.CheckUndecodable),
+			))
+			Expect(report.Repairs).To(HaveLen(2))
+
+			Expect(checker.Repair(ctx, logger, report.Repairs)).To(Succeed())
+
+			_, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, "the-guid")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+			_, err = sqlDB.TaskByGuid(ctx, logger, "the-task-guid")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Context("when the blob was encrypted with an unknown key", func() {
+		BeforeEach(func() {
+			otherKey, err := encryption.NewKey("other-label", "other-passphrase")
+			Expect(err).NotTo(HaveOccurred())
+			otherKeyManager, err := encryption.NewKeyManager(otherKey, nil)
+			Expect(err).NotTo(HaveOccurred())
+			otherSerializer := format.NewSerializer(encryption.NewCryptor(otherKeyManager, rand.Reader))
+
+			runInfo := desiredLRP.DesiredLRPRunInfo(fakeClock.Now())
+			runInfoData, err := otherSerializer.Marshal(logger, &runInfo)
+			Expect(err).NotTo(HaveOccurred())
+			execSQL("UPDATE desired_lrps SET run_info = ? WHERE process_guid = ?", runInfoData, "the-guid")
+		})
+
+		It("reports the row as undecodable", func() {
+			Expect(report.Problems).To(ConsistOf(problem("desired_lrps", "the-guid", fsck.CheckUndecodable)))
+		})
+	})
+
+	Context("when an actual lrp has no desired lrp", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: "orphan", Index: 0, Domain: "some-domain"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("reports it and plans to delete it", func() {
+			Expect(report.Problems).To(ConsistOf(problem("actual_lrps", "orphan/0/Ordinary", fsck.CheckOrphaned)))
+			Expect(report.Repairs).To(HaveLen(1))
+
+			Expect(checker.Repair(ctx, logger, report.Repairs)).To(Succeed())
+			actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: "orphan"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPs).To(BeEmpty())
+		})
+	})
+
+	Context("when an actual lrp index is beyond the desired instances", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: "the-guid", Index: 2, Domain: "some-domain"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("reports it", func() {
+			Expect(report.Problems).To(ConsistOf(problem("actual_lrps", "the-guid/2/Ordinary", fsck.CheckIndexOutOfRange)))
+			Expect(report.Repairs).To(HaveLen(1))
+		})
+	})
+
+	Context("when an actual lrp domain differs from its desired lrp", func() {
+		BeforeEach(func() {
+			execSQL("UPDATE desired_lrps SET domain = ? WHERE process_guid = ?", "other-domain", "the-guid")
+			Expect(sqlDB.UpsertDomain(ctx, logger, "other-domain", 100)).To(Succeed())
+		})
+
+		It("plans to align the actual lrp domains", func() {
+			Expect(report.Problems).To(ConsistOf(
+				problem("actual_lrps", "the-guid/0/Ordinary", fsck.CheckDomainMismatch),
+				problem("actual_lrps", "the-guid/1/Ordinary", fsck.CheckDomainMismatch),
+			))
+
+			Expect(checker.Repair(ctx, logger, report.Repairs)).To(Succeed())
+			actualLRPs, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: "the-guid"})
+			Expect(err).NotTo(HaveOccurred())
+			for _, actualLRP := range actualLRPs {
+				Expect(actualLRP.Domain).To(Equal("other-domain"))
+			}
+		})
+	})
+
+	Context("when a record refers to a domain that does not exist", func() {
+		BeforeEach(func() {
+			execSQL("DELETE FROM domains")
+		})
+
+		It("warns about every record in that domain without failing the check", func() {
+			Expect(report.Clean()).To(BeTrue())
+			Expect(report.Warnings).To(ConsistOf(
+				problem("desired_lrps", "the-guid", fsck.CheckMissingDomain),
+				problem("actual_lrps", "the-guid/0/Ordinary", fsck.CheckMissingDomain),
+				problem("actual_lrps", "the-guid/1/Ordinary", fsck.CheckMissingDomain),
+				problem("tasks", "the-task-guid", fsck.CheckMissingDomain),
+			))
+			Expect(report.Repairs).To(BeEmpty())
+		})
+	})
+
+	Context("when an index has both an evacuating and a suspect instance", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: "the-guid", Index: 2, Domain: "some-domain"})
+			Expect(err).NotTo(HaveOccurred())
+			execSQL("UPDATE actual_lrps SET presence = ? WHERE process_guid = ? AND instance_index = ?", models.ActualLRP_Evacuating, "the-guid", 0)
+			execSQL("UPDATE actual_lrps SET presence = ?, instance_index = ? WHERE process_guid = ? AND instance_index = ?", models.ActualLRP_Suspect, 0, "the-guid", 2)
+		})
+
+		It("reports the conflicting presences", func() {
+			Expect(report.Problems).To(ContainElement(problem("actual_lrps", "the-guid/0", fsck.CheckConflictingPresence)))
+		})
+	})
+
+	Context("when a task fails validation", func() {
+		BeforeEach(func() {
+			execSQL("UPDATE tasks SET domain = ? WHERE guid = ?", "", "the-task-guid")
+		})
+
+		It("reports it without planning a repair", func() {
+			Expect(report.Problems).To(ConsistOf(problem("tasks", "the-task-guid", fsck.CheckInvalid)))
+			Expect(report.Repairs).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1 @@
+package fsck // import "code.cloudfoundry.org/bbs/fsck"
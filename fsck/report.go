@@ -0,0 +1,107 @@
+package fsck
+
+import (
+	"fmt"
+	"io"
+)
+
+const (
+	CheckUndecodable         = "undecodable"
+	CheckInvalid             = "invalid"
+	CheckMissingDomain       = "missing-domain"
+	CheckOrphaned            = "orphaned"
+	CheckIndexOutOfRange     = "index-out-of-range"
+	CheckDomainMismatch      = "domain-mismatch"
+	CheckConflictingPresence = "conflicting-presence"
+)
+
+// Problem describes a single inconsistency found in a row of the BBS database.
+type Problem struct {
+	Table   string `json:"table"`
+	Key     string `json:"key"`
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+// Repair is a single SQL statement that resolves one or more problems. Queries
+// use '?' placeholders and are rebound for the database flavor when applied.
+type Repair struct {
+	Description string        `json:"description"`
+	Query       string        `json:"query"`
+	Args        []interface{} `json:"args"`
+}
+
+// Warnings are findings that healthy deployments also have, such as records
+// whose domain expired, so they do not make a report unclean.
+type Report struct {
+	Scanned  map[string]int `json:"scanned"`
+	Problems []Problem      `json:"problems"`
+	Warnings []Problem      `json:"warnings"`
+	Repairs  []Repair       `json:"repairs"`
+}
+
+func newReport() *Report {
+	return &Report{
+		Scanned:  map[string]int{},
+		Problems: []Problem{},
+		Warnings: []Problem{},
+		Repairs:  []Repair{},
+	}
+}
+
+func (r *Report) Clean() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *Report) addProblem(table, key, check, message string) {
+	r.Problems = append(r.Problems, Problem{
+		Table:   table,
+		Key:     key,
+		Check:   check,
+		Message: message,
+	})
+}
+
+func (r *Report) addWarning(table, key, check, message string) {
+	r.Warnings = append(r.Warnings, Problem{
+		Table:   table,
+		Key:     key,
+		Check:   check,
+		Message: message,
+	})
+}
+
+func (r *Report) addRepair(description, query string, args ...interface{}) {
+	r.Repairs = append(r.Repairs, Repair{
+		Description: description,
+		Query:       query,
+		Args:        args,
+	})
+}
+
+func (r *Report) WriteText(w io.Writer, includeRepairs bool) {
+	for _, table := range []string{domainsTable, desiredLRPsTable, actualLRPsTable, tasksTable} {
+		fmt.Fprintf(w, "scanned %d rows in %s\n", r.Scanned[table], table)
+	}
+
+	fmt.Fprintf(w, "found %d problems\n", len(r.Problems))
+	for _, p := range r.Problems {
+		fmt.Fprintf(w, "  [%s] %s %s: %s\n", p.Check, p.Table, p.Key, p.Message)
+	}
+
+	if len(r.Warnings) > 0 {
+		fmt.Fprintf(w, "found %d warnings\n", len(r.Warnings))
+		for _, p := range r.Warnings {
+			fmt.Fprintf(w, "  [%s] %s %s: %s\n", p.Check, p.Table, p.Key, p.Message)
+		}
+	}
+
+	if !includeRepairs {
+		return
+	}
+
+	fmt.Fprintf(w, "repair plan has %d statements\n", len(r.Repairs))
+	for _, repair := range r.Repairs {
+		fmt.Fprintf(w, "  -- %s\n  %s; %v\n", repair.Description, repair.Query, repair.Args)
+	}
+}
@@ -0,0 +1,169 @@
+package grpcserver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+	"github.com/vito/go-sse/sse"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type eventStream interface {
+	Send(*models.StreamedEvent) error
+	grpc.ServerStream
+}
+
+// DEPRECATED
+func (s *Server) SubscribeToEvents(request *models.EventsByCellId, stream models.BBS_SubscribeToEventsServer) error {
+	return s.streamEvents(stream, bbs.LRPGroupEventStreamRoute_r1, request)
+}
+
+func (s *Server) SubscribeToInstanceEvents(request *models.EventsByCellId, stream models.BBS_SubscribeToInstanceEventsServer) error {
+	return s.streamEvents(stream, bbs.LRPInstanceEventStreamRoute_r1, request)
+}
+
+func (s *Server) SubscribeToTaskEvents(request *models.EmptyRequest, stream models.BBS_SubscribeToTaskEventsServer) error {
+	return s.streamEvents(stream, bbs.TaskEventStreamRoute_r1, request)
+}
+
+// streamEvents serves the event stream route and reads the server-sent events
+// it writes to the hijacked connection back into events for the gRPC stream.
+func (s *Server) streamEvents(stream eventStream, route string, request proto.Message) error {
+	logger := s.logger.Session("stream-events", lager.Data{"route": route})
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	req, err := s.newRequest(ctx, route, request)
+	if err != nil {
+		return err
+	}
+
+	writer := newResponseWriter(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.handler.ServeHTTP(writer, req)
+	}()
+
+	select {
+	case <-writer.hijacked:
+	case <-done:
+		if writer.status() == http.StatusTooManyRequests {
+			stream.SetTrailer(retryAfterTrailer(writer.Header()))
+		}
+		return statusError(writer.status(), writer.body.Bytes())
+	}
+
+	defer func() {
+		cancel()
+		writer.clientConn.Close()
+		<-done
+	}()
+
+	chunks := httputil.NewChunkedReader(writer.clientConn)
+	eventSource := events.NewEventSource(sse.NewReadCloser(readCloser{chunks, writer.clientConn}))
+
+	for {
+		event, err := eventSource.Next()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			logger.Error("failed-to-get-next-event", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		streamedEvent, err := models.NewStreamedEvent(event)
+		if err != nil {
+			logger.Error("failed-to-convert-event", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		err = stream.Send(streamedEvent)
+		if err != nil {
+			logger.Debug("failed-to-send-event", lager.Data{"error": err.Error()})
+			return err
+		}
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// responseWriter records the response of the HTTP handler. Event stream
+// handlers hijack it and get one end of an in-memory connection, and are
+// told the client went away when the context of the call is done.
+type responseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+
+	closeNotify chan bool
+	hijacked    chan struct{}
+	clientConn  net.Conn
+}
+
+func newResponseWriter(ctx context.Context) *responseWriter {
+	w := &responseWriter{
+		header:      http.Header{},
+		closeNotify: make(chan bool),
+		hijacked:    make(chan struct{}),
+	}
+
+	go func() {
+		<-ctx.Done()
+		close(w.closeNotify)
+	}()
+
+	return w
+}
+
+func (w *responseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+// status is the status code the handler wrote, which is 200 when it only
+// wrote a body or wrote nothing at all.
+func (w *responseWriter) status() int {
+	if w.statusCode == 0 {
+		return http.StatusOK
+	}
+	return w.statusCode
+}
+
+func (w *responseWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(data)
+}
+
+func (w *responseWriter) CloseNotify() <-chan bool {
+	return w.closeNotify
+}
+
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	serverConn, clientConn := net.Pipe()
+	w.clientConn = clientConn
+	close(w.hijacked)
+	return serverConn, bufio.NewReadWriter(bufio.NewReader(serverConn), bufio.NewWriter(serverConn)), nil
+}
@@ -0,0 +1,13 @@
+package grpcserver_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestGRPCServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "gRPC Server Suite")
+}
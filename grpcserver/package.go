@@ -0,0 +1 @@
+package grpcserver // import "code.cloudfoundry.org/bbs/grpcserver"
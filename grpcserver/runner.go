@@ -0,0 +1,70 @@
+package grpcserver
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/tedsuo/ifrit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type runner struct {
+	logger        lager.Logger
+	listenAddress string
+	tlsConfig     *tls.Config
+	server        models.BBSServer
+}
+
+// NewRunner serves the BBS gRPC service on the listen address, using TLS
+// when tlsConfig is not nil.
+func NewRunner(logger lager.Logger, listenAddress string, tlsConfig *tls.Config, server models.BBSServer) ifrit.Runner {
+	return &runner{
+		logger:        logger,
+		listenAddress: listenAddress,
+		tlsConfig:     tlsConfig,
+		server:        server,
+	}
+}
+
+func (r *runner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger.Session("grpc-server", lager.Data{"listen-address": r.listenAddress})
+
+	logger.Info("started")
+	defer logger.Info("complete")
+
+	listener, err := net.Listen("tcp", r.listenAddress)
+	if err != nil {
+		logger.Error("failed-to-listen", err)
+		return err
+	}
+
+	options := []grpc.ServerOption{}
+	if r.tlsConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(r.tlsConfig)))
+	}
+	server := grpc.NewServer(options...)
+	models.RegisterBBSServer(server, r.server)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	close(ready)
+
+	select {
+	case sig := <-signals:
+		logger.Info("signalled", lager.Data{"signal": sig})
+	case err = <-errCh:
+		logger.Error("failed-to-serve", err)
+	}
+
+	// event streams only end when their clients go away, so stop without
+	// waiting for them
+	server.Stop()
+	return err
+}
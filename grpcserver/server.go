@@ -0,0 +1,388 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tedsuo/rata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterTrailer carries the number of seconds a rate limited client
+// should wait before retrying, as the Retry-After header does over HTTP.
+const RetryAfterTrailer = "retry-after"
+
+// Server implements the BBS gRPC service by passing every call to the handler
+// of the HTTP API, so that both share authorization, rate limiting, auditing,
+// metrics and logging.
+type Server struct {
+	logger           lager.Logger
+	handler          http.Handler
+	requestGenerator *rata.RequestGenerator
+}
+
+func NewServer(logger lager.Logger, handler http.Handler) *Server {
+	return &Server{
+		logger:           logger.Session("grpc"),
+		handler:          handler,
+		requestGenerator: rata.NewRequestGenerator("", bbs.Routes),
+	}
+}
+
+func (s *Server) call(ctx context.Context, route string, request, response proto.Message) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	req, err := s.newRequest(ctx, route, request)
+	if err != nil {
+		return err
+	}
+
+	writer := newResponseWriter(ctx)
+	s.handler.ServeHTTP(writer, req)
+
+	if writer.status() != http.StatusOK {
+		if writer.status() == http.StatusTooManyRequests {
+			grpc.SetTrailer(ctx, retryAfterTrailer(writer.Header()))
+		}
+		return statusError(writer.status(), writer.body.Bytes())
+	}
+
+	err = proto.Unmarshal(writer.body.Bytes(), response)
+	if err != nil {
+		s.logger.Error("failed-to-unmarshal-response", err, lager.Data{"route": route})
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// newRequest builds the HTTP request for the route, carrying the address and
+// TLS state of the gRPC client so that the middleware can identify it.
+func (s *Server) newRequest(ctx context.Context, route string, request proto.Message) (*http.Request, error) {
+	body, err := proto.Marshal(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	req, err := s.requestGenerator.CreateRequest(route, nil, bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error("failed-to-create-request", err, lager.Data{"route": route})
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", bbs.ProtoContentType)
+
+	if p, ok := peer.FromContext(ctx); ok {
+		req.RemoteAddr = p.Addr.String()
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state := tlsInfo.State
+			req.TLS = &state
+		}
+	}
+
+	return req, nil
+}
+
+// statusError converts a response the middleware rejected into a gRPC status,
+// using the message of the error in its body when there is one.
+func statusError(statusCode int, body []byte) error {
+	code := codes.Internal
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusTooManyRequests:
+		code = codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		code = codes.Unavailable
+	case http.StatusNotFound:
+		code = codes.Unimplemented
+	}
+
+	message := http.StatusText(statusCode)
+	response := &models.UpsertDomainResponse{}
+	if proto.Unmarshal(body, response) == nil && response.Error != nil {
+		message = response.Error.Message
+	}
+
+	return status.Error(code, message)
+}
+
+func retryAfterTrailer(header http.Header) metadata.MD {
+	return metadata.Pairs(RetryAfterTrailer, header.Get(middleware.RetryAfterHeader))
+}
+
+func (s *Server) Ping(ctx context.Context, request *models.EmptyRequest) (*models.PingResponse, error) {
+	response := &models.PingResponse{}
+	return response, s.call(ctx, bbs.PingRoute_r0, request, response)
+}
+
+func (s *Server) Domains(ctx context.Context, request *models.EmptyRequest) (*models.DomainsResponse, error) {
+	response := &models.DomainsResponse{}
+	return response, s.call(ctx, bbs.DomainsRoute_r0, request, response)
+}
+
+func (s *Server) UpsertDomain(ctx context.Context, request *models.UpsertDomainRequest) (*models.UpsertDomainResponse, error) {
+	response := &models.UpsertDomainResponse{}
+	return response, s.call(ctx, bbs.UpsertDomainRoute_r0, request, response)
+}
+
+func (s *Server) ActualLRPs(ctx context.Context, request *models.ActualLRPsRequest) (*models.ActualLRPsResponse, error) {
+	response := &models.ActualLRPsResponse{}
+	return response, s.call(ctx, bbs.ActualLRPsRoute_r0, request, response)
+}
+
+func (s *Server) ExplainActualLRP(ctx context.Context, request *models.ExplainActualLRPRequest) (*models.ExplainActualLRPResponse, error) {
+	response := &models.ExplainActualLRPResponse{}
+	return response, s.call(ctx, bbs.ExplainActualLRPRoute_r0, request, response)
+}
+
+// DEPRECATED
+func (s *Server) ActualLRPGroups(ctx context.Context, request *models.ActualLRPGroupsRequest) (*models.ActualLRPGroupsResponse, error) {
+	response := &models.ActualLRPGroupsResponse{}
+	return response, s.call(ctx, bbs.ActualLRPGroupsRoute_r0, request, response)
+}
+
+// DEPRECATED
+func (s *Server) ActualLRPGroupsByProcessGuid(ctx context.Context, request *models.ActualLRPGroupsByProcessGuidRequest) (*models.ActualLRPGroupsResponse, error) {
+	response := &models.ActualLRPGroupsResponse{}
+	return response, s.call(ctx, bbs.ActualLRPGroupsByProcessGuidRoute_r0, request, response)
+}
+
+// DEPRECATED
+func (s *Server) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, request *models.ActualLRPGroupByProcessGuidAndIndexRequest) (*models.ActualLRPGroupResponse, error) {
+	response := &models.ActualLRPGroupResponse{}
+	return response, s.call(ctx, bbs.ActualLRPGroupByProcessGuidAndIndexRoute_r0, request, response)
+}
+
+func (s *Server) ClaimActualLRP(ctx context.Context, request *models.ClaimActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.ClaimActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) StartActualLRP(ctx context.Context, request *models.StartActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.StartActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) CrashActualLRP(ctx context.Context, request *models.CrashActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.CrashActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) FailActualLRP(ctx context.Context, request *models.FailActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.FailActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) RemoveActualLRP(ctx context.Context, request *models.RemoveActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.RemoveActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) RetireActualLRP(ctx context.Context, request *models.RetireActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.RetireActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) RemoveEvacuatingActualLRP(ctx context.Context, request *models.RemoveEvacuatingActualLRPRequest) (*models.RemoveEvacuatingActualLRPResponse, error) {
+	response := &models.RemoveEvacuatingActualLRPResponse{}
+	return response, s.call(ctx, bbs.RemoveEvacuatingActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) EvacuateClaimedActualLRP(ctx context.Context, request *models.EvacuateClaimedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	return response, s.call(ctx, bbs.EvacuateClaimedActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) EvacuateCrashedActualLRP(ctx context.Context, request *models.EvacuateCrashedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	return response, s.call(ctx, bbs.EvacuateCrashedActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) EvacuateStoppedActualLRP(ctx context.Context, request *models.EvacuateStoppedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	return response, s.call(ctx, bbs.EvacuateStoppedActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) EvacuateRunningActualLRP(ctx context.Context, request *models.EvacuateRunningActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	return response, s.call(ctx, bbs.EvacuateRunningActualLRPRoute_r0, request, response)
+}
+
+func (s *Server) DesiredLRPs(ctx context.Context, request *models.DesiredLRPsRequest) (*models.DesiredLRPsResponse, error) {
+	response := &models.DesiredLRPsResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPsRoute_r3, request, response)
+}
+
+func (s *Server) DesiredLRPSchedulingInfos(ctx context.Context, request *models.DesiredLRPsRequest) (*models.DesiredLRPSchedulingInfosResponse, error) {
+	response := &models.DesiredLRPSchedulingInfosResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPSchedulingInfosRoute_r0, request, response)
+}
+
+func (s *Server) DesiredLRPByProcessGuid(ctx context.Context, request *models.DesiredLRPByProcessGuidRequest) (*models.DesiredLRPResponse, error) {
+	response := &models.DesiredLRPResponse{}
+	return response, s.call(ctx, bbs.DesiredLRPByProcessGuidRoute_r3, request, response)
+}
+
+func (s *Server) DesireDesiredLRP(ctx context.Context, request *models.DesireLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.DesireDesiredLRPRoute_r2, request, response)
+}
+
+func (s *Server) UpdateDesiredLRP(ctx context.Context, request *models.UpdateDesiredLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.UpdateDesiredLRPRoute_r0, request, response)
+}
+
+func (s *Server) DisruptionBudgetStatus(ctx context.Context, request *models.DisruptionBudgetStatusRequest) (*models.DisruptionBudgetStatusResponse, error) {
+	response := &models.DisruptionBudgetStatusResponse{}
+	return response, s.call(ctx, bbs.DisruptionBudgetStatusRoute_r0, request, response)
+}
+
+func (s *Server) RemoveDesiredLRP(ctx context.Context, request *models.RemoveDesiredLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	return response, s.call(ctx, bbs.RemoveDesiredLRPRoute_r0, request, response)
+}
+
+func (s *Server) Tasks(ctx context.Context, request *models.TasksRequest) (*models.TasksResponse, error) {
+	response := &models.TasksResponse{}
+	return response, s.call(ctx, bbs.TasksRoute_r3, request, response)
+}
+
+func (s *Server) TaskByGuid(ctx context.Context, request *models.TaskByGuidRequest) (*models.TaskResponse, error) {
+	response := &models.TaskResponse{}
+	return response, s.call(ctx, bbs.TaskByGuidRoute_r3, request, response)
+}
+
+func (s *Server) DesireTask(ctx context.Context, request *models.DesireTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.DesireTaskRoute_r2, request, response)
+}
+
+func (s *Server) StartTask(ctx context.Context, request *models.StartTaskRequest) (*models.StartTaskResponse, error) {
+	response := &models.StartTaskResponse{}
+	return response, s.call(ctx, bbs.StartTaskRoute_r0, request, response)
+}
+
+func (s *Server) CancelTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.CancelTaskRoute_r0, request, response)
+}
+
+// DEPRECATED
+func (s *Server) FailTask(ctx context.Context, request *models.FailTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.FailTaskRoute_r0, request, response)
+}
+
+func (s *Server) RejectTask(ctx context.Context, request *models.RejectTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.RejectTaskRoute_r0, request, response)
+}
+
+func (s *Server) CompleteTask(ctx context.Context, request *models.CompleteTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.CompleteTaskRoute_r0, request, response)
+}
+
+func (s *Server) ResolvingTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.ResolvingTaskRoute_r0, request, response)
+}
+
+func (s *Server) DeleteTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	return response, s.call(ctx, bbs.DeleteTaskRoute_r0, request, response)
+}
+
+func (s *Server) Cells(ctx context.Context, request *models.EmptyRequest) (*models.CellsResponse, error) {
+	response := &models.CellsResponse{}
+	return response, s.call(ctx, bbs.CellsRoute_r0, request, response)
+}
+
+func (s *Server) CellSummaries(ctx context.Context, request *models.EmptyRequest) (*models.CellSummariesResponse, error) {
+	response := &models.CellSummariesResponse{}
+	return response, s.call(ctx, bbs.CellSummariesRoute_r0, request, response)
+}
+
+func (s *Server) CellCordons(ctx context.Context, request *models.EmptyRequest) (*models.CellCordonsResponse, error) {
+	response := &models.CellCordonsResponse{}
+	return response, s.call(ctx, bbs.CellCordonsRoute_r0, request, response)
+}
+
+func (s *Server) CordonCell(ctx context.Context, request *models.CordonCellRequest) (*models.CellCordonResponse, error) {
+	response := &models.CellCordonResponse{}
+	return response, s.call(ctx, bbs.CordonCellRoute_r0, request, response)
+}
+
+func (s *Server) DrainCell(ctx context.Context, request *models.DrainCellRequest) (*models.CellCordonResponse, error) {
+	response := &models.CellCordonResponse{}
+	return response, s.call(ctx, bbs.DrainCellRoute_r0, request, response)
+}
+
+func (s *Server) UncordonCell(ctx context.Context, request *models.UncordonCellRequest) (*models.CellCordonResponse, error) {
+	response := &models.CellCordonResponse{}
+	return response, s.call(ctx, bbs.UncordonCellRoute_r0, request, response)
+}
+
+func (s *Server) RotateEncryptionKey(ctx context.Context, request *models.RotateEncryptionKeyRequest) (*models.RotateEncryptionKeyResponse, error) {
+	response := &models.RotateEncryptionKeyResponse{}
+	return response, s.call(ctx, bbs.RotateEncryptionKeyRoute_r0, request, response)
+}
+
+func (s *Server) EncryptionStatus(ctx context.Context, request *models.EmptyRequest) (*models.EncryptionStatusResponse, error) {
+	response := &models.EncryptionStatusResponse{}
+	return response, s.call(ctx, bbs.EncryptionStatusRoute_r0, request, response)
+}
+
+func (s *Server) EncryptionKeyUsage(ctx context.Context, request *models.EncryptionKeyUsageRequest) (*models.EncryptionKeyUsageResponse, error) {
+	response := &models.EncryptionKeyUsageResponse{}
+	return response, s.call(ctx, bbs.EncryptionKeyUsageRoute_r0, request, response)
+}
+
+func (s *Server) AuditEntries(ctx context.Context, request *models.AuditEntriesRequest) (*models.AuditEntriesResponse, error) {
+	response := &models.AuditEntriesResponse{}
+	return response, s.call(ctx, bbs.AuditEntriesRoute_r0, request, response)
+}
+
+func (s *Server) ReloadConfig(ctx context.Context, request *models.EmptyRequest) (*models.ReloadConfigResponse, error) {
+	response := &models.ReloadConfigResponse{}
+	return response, s.call(ctx, bbs.ReloadConfigRoute_r0, request, response)
+}
+
+func (s *Server) ConvergencePlan(ctx context.Context, request *models.ConvergencePlanRequest) (*models.ConvergencePlanResponse, error) {
+	response := &models.ConvergencePlanResponse{}
+	return response, s.call(ctx, bbs.ConvergencePlanRoute_r0, request, response)
+}
+
+func (s *Server) ConvergenceSafetyValve(ctx context.Context, request *models.EmptyRequest) (*models.ConvergenceSafetyValveResponse, error) {
+	response := &models.ConvergenceSafetyValveResponse{}
+	return response, s.call(ctx, bbs.ConvergenceSafetyValveRoute_r0, request, response)
+}
+
+func (s *Server) OverrideConvergenceSafetyValve(ctx context.Context, request *models.EmptyRequest) (*models.ConvergenceSafetyValveResponse, error) {
+	response := &models.ConvergenceSafetyValveResponse{}
+	return response, s.call(ctx, bbs.OverrideConvergenceSafetyValveRoute_r0, request, response)
+}
+
+func (s *Server) MaintenanceMode(ctx context.Context, request *models.EmptyRequest) (*models.MaintenanceModeResponse, error) {
+	response := &models.MaintenanceModeResponse{}
+	return response, s.call(ctx, bbs.MaintenanceModeRoute_r0, request, response)
+}
+
+func (s *Server) SetMaintenanceMode(ctx context.Context, request *models.SetMaintenanceModeRequest) (*models.MaintenanceModeResponse, error) {
+	response := &models.MaintenanceModeResponse{}
+	return response, s.call(ctx, bbs.SetMaintenanceModeRoute_r0, request, response)
+}
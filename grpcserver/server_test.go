@@ -0,0 +1,226 @@
+package grpcserver_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync/atomic"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/grpcserver"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+	"github.com/tedsuo/rata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		logger       *lagertest.TestLogger
+		routeHandler http.HandlerFunc
+		taskHub      events.Hub
+		subscribers  *int32
+		process      ifrit.Process
+		conn         *grpc.ClientConn
+		client       models.BBSClient
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		taskHub = events.NewHub(logger)
+		count := new(int32)
+		subscribers = count
+		taskHub.RegisterCallback(func(size int) { atomic.StoreInt32(count, int32(size)) })
+		routeHandler = func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		}
+
+		taskEventHandler := handlers.NewTaskEventHandler(taskHub)
+		delegate := func(w http.ResponseWriter, r *http.Request) { routeHandler(w, r) }
+		routes := rata.Handlers{}
+		for _, route := range bbs.Routes {
+			routes[route.Name] = http.HandlerFunc(delegate)
+		}
+		routes[bbs.TaskEventStreamRoute_r1] = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			taskEventHandler.Subscribe_r1(logger, w, r)
+		})
+		handler, err := rata.NewRouter(bbs.Routes, routes)
+		Expect(err).NotTo(HaveOccurred())
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		address := listener.Addr().String()
+		Expect(listener.Close()).To(Succeed())
+
+		server := grpcserver.NewServer(logger, handler)
+		process = ginkgomon.Invoke(grpcserver.NewRunner(logger, address, nil, server))
+
+		conn, err = grpc.Dial(address, grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		client = models.NewBBSClient(conn)
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		ginkgomon.Interrupt(process)
+		Expect(taskHub.Close()).To(Succeed())
+	})
+
+	subscriberCount := func() int32 {
+		return atomic.LoadInt32(subscribers)
+	}
+
+	writeResponse := func(w http.ResponseWriter, statusCode int, response proto.Message) {
+		body, err := proto.Marshal(response)
+		Expect(err).NotTo(HaveOccurred())
+		w.Header().Set("Content-Type", bbs.ProtoContentType)
+		w.WriteHeader(statusCode)
+		w.Write(body)
+	}
+
+	Describe("unary calls", func() {
+		It("serves the call with the handler of the matching route", func() {
+			var request *http.Request
+			routeHandler = func(w http.ResponseWriter, r *http.Request) {
+				request = r
+				writeResponse(w, http.StatusOK, &models.PingResponse{Available: true})
+			}
+
+			response, err := client.Ping(context.Background(), &models.EmptyRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Available).To(BeTrue())
+
+			Expect(request.Method).To(Equal("POST"))
+			Expect(request.URL.Path).To(Equal("/v1/ping"))
+			Expect(request.Header.Get("Content-Type")).To(Equal(bbs.ProtoContentType))
+			Expect(request.RemoteAddr).To(HavePrefix("127.0.0.1:"))
+		})
+
+		It("passes the request in the body", func() {
+			var body []byte
+			routeHandler = func(w http.ResponseWriter, r *http.Request) {
+				body, _ = ioutil.ReadAll(r.Body)
+				writeResponse(w, http.StatusOK, &models.TaskResponse{})
+			}
+
+			_, err := client.TaskByGuid(context.Background(), &models.TaskByGuidRequest{TaskGuid: "some-guid"})
+			Expect(err).NotTo(HaveOccurred())
+
+			request := &models.TaskByGuidRequest{}
+			Expect(proto.Unmarshal(body, request)).To(Succeed())
+			Expect(request.TaskGuid).To(Equal("some-guid"))
+		})
+
+		It("returns errors of the BBS in the response", func() {
+			routeHandler = func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusOK, &models.TaskResponse{Error: models.ErrResourceNotFound})
+			}
+
+			response, err := client.TaskByGuid(context.Background(), &models.TaskByGuidRequest{TaskGuid: "some-guid"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("returns PermissionDenied when the middleware rejects the client", func() {
+			routeHandler = func(w http.ResponseWriter, r *http.Request) {
+				writeResponse(w, http.StatusForbidden, &models.UpsertDomainResponse{
+					Error: models.NewError(models.Error_Forbidden, "not allowed"),
+				})
+			}
+
+			_, err := client.Domains(context.Background(), &models.EmptyRequest{})
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(status.Convert(err).Message()).To(Equal("not allowed"))
+		})
+
+		It("returns ResourceExhausted and when to retry when the client is rate limited", func() {
+			routeHandler = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(middleware.RetryAfterHeader, "3")
+				writeResponse(w, http.StatusTooManyRequests, &models.UpsertDomainResponse{Error: models.ErrTooManyRequests})
+			}
+
+			var trailer metadata.MD
+			_, err := client.Domains(context.Background(), &models.EmptyRequest{}, grpc.Trailer(&trailer))
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+			Expect(trailer.Get(grpcserver.RetryAfterTrailer)).To(ConsistOf("3"))
+		})
+
+		It("returns Unavailable while the BBS is starting", func() {
+			routeHandler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+
+			_, err := client.Domains(context.Background(), &models.EmptyRequest{})
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+	})
+
+	Describe("event streams", func() {
+		It("streams the events of the hub", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stream, err := client.SubscribeToTaskEvents(ctx, &models.EmptyRequest{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(subscriberCount).Should(BeEquivalentTo(1))
+
+			task := &models.Task{TaskGuid: "some-guid", TaskDefinition: &models.TaskDefinition{}}
+			taskHub.Emit(models.NewTaskCreatedEvent(task))
+
+			streamedEvent, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(streamedEvent.GetTaskCreated().Task.TaskGuid).To(Equal("some-guid"))
+			Expect(streamedEvent.ModelEvent()).To(BeAssignableToTypeOf(&models.TaskCreatedEvent{}))
+		})
+
+		It("unsubscribes from the hub when the client goes away", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+
+			_, err := client.SubscribeToTaskEvents(ctx, &models.EmptyRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(subscriberCount).Should(BeEquivalentTo(1))
+
+			cancel()
+			Eventually(subscriberCount).Should(BeEquivalentTo(0))
+		})
+
+		It("ends the stream when the hub closes", func() {
+			stream, err := client.SubscribeToTaskEvents(context.Background(), &models.EmptyRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(subscriberCount).Should(BeEquivalentTo(1))
+
+			Expect(taskHub.Close()).To(Succeed())
+			taskHub = events.NewHub(logger)
+
+			_, err = stream.Recv()
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).NotTo(Equal(codes.Internal))
+		})
+
+		It("returns the status of a stream the middleware rejects", func() {
+			routeHandler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			}
+
+			stream, err := client.SubscribeToInstanceEvents(context.Background(), &models.EventsByCellId{})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		})
+	})
+})
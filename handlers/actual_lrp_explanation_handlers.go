@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_actual_lrp_explainer.go . ActualLRPExplainer
+type ActualLRPExplainer interface {
+	ExplainActualLRP(ctx context.Context, logger lager.Logger, processGuid string, index int32, domains []string) (*models.ActualLRPExplanation, error)
+}
+
+type ActualLRPExplanationHandler struct {
+	explainer ActualLRPExplainer
+	exitChan  chan<- struct{}
+}
+
+func NewActualLRPExplanationHandler(explainer ActualLRPExplainer, exitChan chan<- struct{}) *ActualLRPExplanationHandler {
+	return &ActualLRPExplanationHandler{
+		explainer: explainer,
+		exitChan:  exitChan,
+	}
+}
+
+func (h *ActualLRPExplanationHandler) ExplainActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("explain-actual-lrp")
+
+	request := &models.ExplainActualLRPRequest{}
+	response := &models.ExplainActualLRPResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		response.Explanation, err = h.explainer.ExplainActualLRP(req.Context(), logger, request.ProcessGuid, request.Index, allowedDomains(req))
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
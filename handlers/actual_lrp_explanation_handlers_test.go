@@ -0,0 +1,126 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ActualLRP Explanation Handlers", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeExplainer     *fake_controllers.FakeActualLRPExplainer
+		responseRecorder  *httptest.ResponseRecorder
+		handler           *handlers.ActualLRPExplanationHandler
+		requestBody       interface{}
+		request           *http.Request
+		exitCh            chan struct{}
+		actualExplanation *models.ActualLRPExplanation
+	)
+
+	BeforeEach(func() {
+		fakeExplainer = new(fake_controllers.FakeActualLRPExplainer)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewActualLRPExplanationHandler(fakeExplainer, exitCh)
+
+		requestBody = &models.ExplainActualLRPRequest{ProcessGuid: "some-guid", Index: 1}
+		actualExplanation = &models.ActualLRPExplanation{
+			ProcessGuid:       "some-guid",
+			Index:             1,
+			Desired:           true,
+			ConvergenceAction: models.ConvergenceActionCreateMissing,
+		}
+		fakeExplainer.ExplainActualLRPReturns(actualExplanation, nil)
+	})
+
+	JustBeforeEach(func() {
+		if request == nil {
+			request = newTestRequest(requestBody)
+		}
+		handler.ExplainActualLRP(logger, responseRecorder, request)
+	})
+
+	AfterEach(func() {
+		request = nil
+	})
+
+	It("returns the explanation", func() {
+		Expect(fakeExplainer.ExplainActualLRPCallCount()).To(Equal(1))
+		_, _, processGuid, index, domains := fakeExplainer.ExplainActualLRPArgsForCall(0)
+		Expect(processGuid).To(Equal("some-guid"))
+		Expect(index).To(BeEquivalentTo(1))
+		Expect(domains).To(BeNil())
+
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		response := &models.ExplainActualLRPResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Error).To(BeNil())
+		Expect(response.Explanation).To(Equal(actualExplanation))
+	})
+
+	Context("when the client is restricted to domains", func() {
+		BeforeEach(func() {
+			request = newTestRequest(requestBody)
+			request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+		})
+
+		It("only explains the allowed domains", func() {
+			Expect(fakeExplainer.ExplainActualLRPCallCount()).To(Equal(1))
+			_, _, _, _, domains := fakeExplainer.ExplainActualLRPArgsForCall(0)
+			Expect(domains).To(Equal([]string{"domain-1"}))
+		})
+	})
+
+	Context("when the request is invalid", func() {
+		BeforeEach(func() {
+			requestBody = &models.ExplainActualLRPRequest{Index: -1}
+		})
+
+		It("responds with a bad request error", func() {
+			Expect(fakeExplainer.ExplainActualLRPCallCount()).To(Equal(0))
+			response := &models.ExplainActualLRPResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+	})
+
+	Context("when the LRP does not exist", func() {
+		BeforeEach(func() {
+			fakeExplainer.ExplainActualLRPReturns(nil, models.ErrResourceNotFound)
+		})
+
+		It("responds with a resource not found error", func() {
+			response := &models.ExplainActualLRPResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			Expect(response.Explanation).To(BeNil())
+		})
+	})
+
+	Context("when explaining fails", func() {
+		BeforeEach(func() {
+			fakeExplainer.ExplainActualLRPReturns(nil, errors.New("boom"))
+		})
+
+		It("responds with the error", func() {
+			response := &models.ExplainActualLRPResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error.Type).To(Equal(models.Error_UnknownError))
+			Expect(response.Error.Message).To(Equal("boom"))
+		})
+	})
+})
@@ -36,13 +36,13 @@ func (h *ActualLRPHandler) ActualLRPs(logger lager.Logger, w http.ResponseWriter
 			i := request.GetIndex()
 			index = &i
 		}
-		filter := models.ActualLRPFilter{Domain: request.Domain, CellID: request.CellId, Index: index, ProcessGuid: request.ProcessGuid}
+		filter := models.ActualLRPFilter{Domain: request.Domain, Domains: allowedDomains(req), CellID: request.CellId, Index: index, ProcessGuid: request.ProcessGuid}
 		response.ActualLrps, err = h.db.ActualLRPs(req.Context(), logger, filter)
 	}
 
 	response.Error = models.ConvertError(err)
 
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -54,7 +54,7 @@ func (h *ActualLRPHandler) ActualLRPGroups(logger lager.Logger, w http.ResponseW
 	request := &models.ActualLRPGroupsRequest{}
 	response := &models.ActualLRPGroupsResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -62,7 +62,7 @@ func (h *ActualLRPHandler) ActualLRPGroups(logger lager.Logger, w http.ResponseW
 		return
 	}
 
-	filter := models.ActualLRPFilter{Domain: request.Domain, CellID: request.CellId}
+	filter := models.ActualLRPFilter{Domain: request.Domain, Domains: allowedDomains(req), CellID: request.CellId}
 	lrps, err := h.db.ActualLRPs(req.Context(), logger, filter)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -79,14 +79,14 @@ func (h *ActualLRPHandler) ActualLRPGroupsByProcessGuid(logger lager.Logger, w h
 	request := &models.ActualLRPGroupsByProcessGuidRequest{}
 	response := &models.ActualLRPGroupsResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
-	filter := models.ActualLRPFilter{ProcessGuid: request.ProcessGuid}
+	filter := models.ActualLRPFilter{ProcessGuid: request.ProcessGuid, Domains: allowedDomains(req)}
 	lrps, err := h.db.ActualLRPs(req.Context(), logger, filter)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -103,14 +103,14 @@ func (h *ActualLRPHandler) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logg
 	request := &models.ActualLRPGroupByProcessGuidAndIndexRequest{}
 	response := &models.ActualLRPGroupResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
 		response.Error = models.ConvertError(err)
 		return
 	}
-	filter := models.ActualLRPFilter{ProcessGuid: request.ProcessGuid, Index: &request.Index}
+	filter := models.ActualLRPFilter{ProcessGuid: request.ProcessGuid, Index: &request.Index, Domains: allowedDomains(req)}
 	lrps, err := h.db.ActualLRPs(req.Context(), logger, filter)
 
 	if err == nil && len(lrps) == 0 {
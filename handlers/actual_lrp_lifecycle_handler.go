@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -19,17 +20,20 @@ type ActualLRPLifecycleController interface {
 }
 
 type ActualLRPLifecycleHandler struct {
-	controller ActualLRPLifecycleController
-	exitChan   chan<- struct{}
+	controller  ActualLRPLifecycleController
+	actualLRPDB db.ActualLRPDB
+	exitChan    chan<- struct{}
 }
 
 func NewActualLRPLifecycleHandler(
 	controller ActualLRPLifecycleController,
+	actualLRPDB db.ActualLRPDB,
 	exitChan chan<- struct{},
 ) *ActualLRPLifecycleHandler {
 	return &ActualLRPLifecycleHandler{
-		controller: controller,
-		exitChan:   exitChan,
+		controller:  controller,
+		actualLRPDB: actualLRPDB,
+		exitChan:    exitChan,
 	}
 }
 
@@ -42,7 +46,7 @@ func (h *ActualLRPLifecycleHandler) ClaimActualLRP(logger lager.Logger, w http.R
 	request := &models.ClaimActualLRPRequest{}
 	response := &models.ActualLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -50,6 +54,12 @@ func (h *ActualLRPLifecycleHandler) ClaimActualLRP(logger lager.Logger, w http.R
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ProcessGuid, request.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.ClaimActualLRP(req.Context(), logger, request.ProcessGuid, request.Index, request.ActualLrpInstanceKey)
 	response.Error = models.ConvertError(err)
 }
@@ -63,7 +73,7 @@ func (h *ActualLRPLifecycleHandler) StartActualLRP(logger lager.Logger, w http.R
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -71,6 +81,12 @@ func (h *ActualLRPLifecycleHandler) StartActualLRP(logger lager.Logger, w http.R
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.StartActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ActualLrpNetInfo)
 	response.Error = models.ConvertError(err)
 }
@@ -83,7 +99,7 @@ func (h *ActualLRPLifecycleHandler) CrashActualLRP(logger lager.Logger, w http.R
 	request := &models.CrashActualLRPRequest{}
 	response := &models.ActualLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -94,6 +110,12 @@ func (h *ActualLRPLifecycleHandler) CrashActualLRP(logger lager.Logger, w http.R
 	actualLRPKey := request.ActualLrpKey
 	actualLRPInstanceKey := request.ActualLrpInstanceKey
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, actualLRPKey.ProcessGuid, actualLRPKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.CrashActualLRP(req.Context(), logger, actualLRPKey, actualLRPInstanceKey, request.ErrorMessage)
 	response.Error = models.ConvertError(err)
 }
@@ -108,7 +130,7 @@ func (h *ActualLRPLifecycleHandler) FailActualLRP(logger lager.Logger, w http.Re
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -116,6 +138,12 @@ func (h *ActualLRPLifecycleHandler) FailActualLRP(logger lager.Logger, w http.Re
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.FailActualLRP(req.Context(), logger, request.ActualLrpKey, request.ErrorMessage)
 	response.Error = models.ConvertError(err)
 }
@@ -130,7 +158,7 @@ func (h *ActualLRPLifecycleHandler) RemoveActualLRP(logger lager.Logger, w http.
 	response := &models.ActualLRPLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -138,6 +166,12 @@ func (h *ActualLRPLifecycleHandler) RemoveActualLRP(logger lager.Logger, w http.
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ProcessGuid, request.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.RemoveActualLRP(req.Context(), logger, request.ProcessGuid, request.Index, request.ActualLrpInstanceKey)
 	response.Error = models.ConvertError(err)
 }
@@ -151,7 +185,7 @@ func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.
 
 	var err error
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -159,6 +193,12 @@ func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.RetireActualLRP(req.Context(), logger, request.ActualLrpKey)
 	response.Error = models.ConvertError(err)
 }
@@ -5,8 +5,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
 	"code.cloudfoundry.org/lager/lagertest"
@@ -22,6 +24,7 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 		responseRecorder *httptest.ResponseRecorder
 		handler          *handlers.ActualLRPLifecycleHandler
 		fakeController   *fake_controllers.FakeActualLRPLifecycleController
+		fakeActualLRPDB  *dbfakes.FakeActualLRPDB
 		exitCh           chan struct{}
 	)
 
@@ -36,7 +39,8 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 
 		exitCh = make(chan struct{}, 1)
 		fakeController = &fake_controllers.FakeActualLRPLifecycleController{}
-		handler = handlers.NewActualLRPLifecycleHandler(fakeController, exitCh)
+		fakeActualLRPDB = &dbfakes.FakeActualLRPDB{}
+		handler = handlers.NewActualLRPLifecycleHandler(fakeController, fakeActualLRPDB, exitCh)
 	})
 
 	Describe("ClaimActualLRP", func() {
@@ -60,8 +64,17 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 			}
 		})
 
+		var domains []string
+
+		BeforeEach(func() {
+			domains = nil
+		})
+
 		JustBeforeEach(func() {
 			request := newTestRequest(requestBody)
+			if domains != nil {
+				request = request.WithContext(middleware.WithAllowedDomains(request.Context(), domains))
+			}
 			handler.ClaimActualLRP(logger, responseRecorder, request)
 		})
 
@@ -73,6 +86,48 @@ var _ = Describe("ActualLRP Lifecycle Handlers", func() {
 			Expect(actualInstanceKey).To(Equal(&instanceKey))
 		})
 
+		It("does not look up the actual lrp", func() {
+			Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(0))
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(func() {
+				domains = []string{"domain-1"}
+			})
+
+			Context("when the actual lrp is in an allowed domain", func() {
+				BeforeEach(func() {
+					fakeActualLRPDB.ActualLRPsReturns([]*models.ActualLRP{{}}, nil)
+				})
+
+				It("looks up the actual lrp within the domains", func() {
+					Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeActualLRPDB.ActualLRPsArgsForCall(0)
+					Expect(filter.ProcessGuid).To(Equal(processGuid))
+					Expect(*filter.Index).To(Equal(index))
+					Expect(filter.Domains).To(Equal(domains))
+				})
+
+				It("calls the controller", func() {
+					Expect(fakeController.ClaimActualLRPCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the actual lrp is in another domain", func() {
+				BeforeEach(func() {
+					fakeActualLRPDB.ActualLRPsReturns([]*models.ActualLRP{}, nil)
+				})
+
+				It("responds with resource not found", func() {
+					Expect(fakeController.ClaimActualLRPCallCount()).To(Equal(0))
+					response := &models.ActualLRPLifecycleResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+				})
+			})
+		})
+
 		Context("when the controller call succeeds", func() {
 			BeforeEach(func() {
 				fakeController.ClaimActualLRPReturns(nil)
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/gogo/protobuf/proto"
+)
+
+type modificationTagFetcher func(ctx context.Context, logger lager.Logger, lrpDB db.LRPDB, targetGuid string, request proto.Message) *models.ModificationTag
+
+type auditedRoute struct {
+	newRequest      func() proto.Message
+	targetGuid      func(request proto.Message) string
+	modificationTag modificationTagFetcher
+	// fields are the identifying fields of the request kept in the summary
+	fields []string
+}
+
+// auditedRoutes are the mutating routes recorded in the audit log.
+var auditedRoutes = map[string]auditedRoute{
+	bbs.UpsertDomainRoute_r0: {
+		newRequest: func() proto.Message { return &models.UpsertDomainRequest{} },
+		targetGuid: func(request proto.Message) string { return request.(*models.UpsertDomainRequest).Domain },
+		fields:     []string{"domain", "ttl"},
+	},
+	bbs.DesireDesiredLRPRoute_r2: {
+		newRequest: func() proto.Message { return &models.DesireLRPRequest{} },
+		targetGuid: func(request proto.Message) string {
+			return request.(*models.DesireLRPRequest).GetDesiredLrp().GetProcessGuid()
+		},
+		modificationTag: desiredLRPModificationTag,
+		fields:          []string{"desired_lrp.process_guid", "desired_lrp.domain", "desired_lrp.instances"},
+	},
+	bbs.UpdateDesiredLRPRoute_r0: {
+		newRequest:      func() proto.Message { return &models.UpdateDesiredLRPRequest{} },
+		targetGuid:      func(request proto.Message) string { return request.(*models.UpdateDesiredLRPRequest).ProcessGuid },
+		modificationTag: desiredLRPModificationTag,
+		fields:          []string{"process_guid", "update.instances"},
+	},
+	bbs.RemoveDesiredLRPRoute_r0: {
+		newRequest:      func() proto.Message { return &models.RemoveDesiredLRPRequest{} },
+		targetGuid:      func(request proto.Message) string { return request.(*models.RemoveDesiredLRPRequest).ProcessGuid },
+		modificationTag: desiredLRPModificationTag,
+		fields:          []string{"process_guid"},
+	},
+	bbs.RetireActualLRPRoute_r0: {
+		newRequest: func() proto.Message { return &models.RetireActualLRPRequest{} },
+		targetGuid: func(request proto.Message) string {
+			return request.(*models.RetireActualLRPRequest).GetActualLrpKey().GetProcessGuid()
+		},
+		modificationTag: actualLRPModificationTag,
+		fields:          []string{"actual_lrp_key.process_guid", "actual_lrp_key.index", "actual_lrp_key.domain"},
+	},
+	bbs.DesireTaskRoute_r2: {
+		newRequest: func() proto.Message { return &models.DesireTaskRequest{} },
+		targetGuid: func(request proto.Message) string { return request.(*models.DesireTaskRequest).TaskGuid },
+		fields:     []string{"task_guid", "domain"},
+	},
+	bbs.CancelTaskRoute_r0: {
+		newRequest: func() proto.Message { return &models.TaskGuidRequest{} },
+		targetGuid: func(request proto.Message) string { return request.(*models.TaskGuidRequest).TaskGuid },
+		fields:     []string{"task_guid"},
+	},
+	bbs.DeleteTaskRoute_r0: {
+		newRequest: func() proto.Message { return &models.TaskGuidRequest{} },
+		targetGuid: func(request proto.Message) string { return request.(*models.TaskGuidRequest).TaskGuid },
+		fields:     []string{"task_guid"},
+	},
+	bbs.OverrideConvergenceSafetyValveRoute_r0: {
+		newRequest: func() proto.Message { return &models.EmptyRequest{} },
+		targetGuid: func(request proto.Message) string { return "" },
+	},
+	bbs.SetMaintenanceModeRoute_r0: {
+		newRequest: func() proto.Message { return &models.SetMaintenanceModeRequest{} },
+		targetGuid: func(request proto.Message) string { return "" },
+		fields:     []string{"enabled", "reason"},
+	},
+	bbs.RotateEncryptionKeyRoute_r0: {
+		newRequest: func() proto.Message { return &models.RotateEncryptionKeyRequest{} },
+		targetGuid: func(request proto.Message) string { return request.(*models.RotateEncryptionKeyRequest).KeyLabel },
+		fields:     []string{"key_label"},
+	},
+	bbs.ReloadConfigRoute_r0: {
+		newRequest: func() proto.Message { return &models.EmptyRequest{} },
+		targetGuid: func(request proto.Message) string { return "" },
+	},
+	bbs.CordonCellRoute_r0: {
+		newRequest: func() proto.Message { return &models.CordonCellRequest{} },
+		targetGuid: func(request proto.Message) string { return request.(*models.CordonCellRequest).CellId },
+		fields:     []string{"cell_id", "reason"},
+	},
+	bbs.DrainCellRoute_r0: {
+		newRequest: func() proto.Message { return &models.DrainCellRequest{} },
+		targetGuid: func(request proto.Message) string { return request.(*models.DrainCellRequest).CellId },
+		fields:     []string{"cell_id", "reason"},
+	},
+	bbs.UncordonCellRoute_r0: {
+		newRequest: func() proto.Message { return &models.UncordonCellRequest{} },
+		targetGuid: func(request proto.Message) string { return request.(*models.UncordonCellRequest).CellId },
+		fields:     []string{"cell_id"},
+	},
+}
+
+// Audit records an entry in the sink for every request to one of the audited
+// routes, and passes requests to other routes straight to the handler.
+func Audit(clock clock.Clock, sink audit.Sink, lrpDB db.LRPDB, routeName string, handler middleware.LoggableHandlerFunc) middleware.LoggableHandlerFunc {
+	route, ok := auditedRoutes[routeName]
+	if !ok {
+		return handler
+	}
+
+	return func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+		auditLogger := logger.Session("audit", lager.Data{"route": routeName})
+
+		entry := &models.AuditEntry{
+			Timestamp:  clock.Now().UnixNano(),
+			Route:      routeName,
+			Identity:   clientIdentity(r),
+			RemoteAddr: r.RemoteAddr,
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			auditLogger.Error("failed-to-read-body", err)
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		request := route.newRequest()
+		parsed := middleware.UnmarshalRequest(r, body, request) == nil
+		if parsed {
+			entry.TargetGuid = route.targetGuid(request)
+			entry.Request = audit.SummarizeRequest(request, route.fields)
+		}
+
+		fetchTag := parsed && route.modificationTag != nil && entry.TargetGuid != ""
+		if fetchTag {
+			entry.ModificationTagBefore = route.modificationTag(r.Context(), auditLogger, lrpDB, entry.TargetGuid, request)
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w}
+		handler(logger, recorder, r)
+		entry.ErrorType = responseErrorType(recorder.Header().Get("Content-Type"), recorder.body.Bytes())
+
+		if fetchTag {
+			entry.ModificationTagAfter = route.modificationTag(r.Context(), auditLogger, lrpDB, entry.TargetGuid, request)
+		}
+
+		err = sink.Record(logger, entry)
+		if err != nil {
+			auditLogger.Error("failed-to-record-audit-entry", err)
+		}
+	}
+}
+
+func clientIdentity(r *http.Request) string {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return ""
+	}
+	return r.TLS.PeerCertificates[0].Subject.String()
+}
+
+// responseErrorType decodes the error of a response. Every BBS response
+// carries its error in field 1, or in the "error" field of JSON responses, so
+// any response message can decode it.
+func responseErrorType(contentType string, body []byte) string {
+	response := &models.UpsertDomainResponse{}
+	err := middleware.UnmarshalResponse(contentType, body, response)
+	if err != nil || response.Error == nil {
+		return ""
+	}
+	return response.Error.Type.String()
+}
+
+func desiredLRPModificationTag(ctx context.Context, logger lager.Logger, lrpDB db.LRPDB, processGuid string, request proto.Message) *models.ModificationTag {
+	filter := models.DesiredLRPFilter{ProcessGuids: []string{processGuid}}
+	schedulingInfos, err := lrpDB.DesiredLRPSchedulingInfos(ctx, logger.Session("fetch-modification-tag"), filter)
+	if err != nil || len(schedulingInfos) == 0 {
+		return nil
+	}
+
+	tag := schedulingInfos[0].ModificationTag
+	return &tag
+}
+
+func actualLRPModificationTag(ctx context.Context, logger lager.Logger, lrpDB db.LRPDB, processGuid string, request proto.Message) *models.ModificationTag {
+	index := request.(*models.RetireActualLRPRequest).GetActualLrpKey().GetIndex()
+
+	filter := models.ActualLRPFilter{ProcessGuid: processGuid, Index: &index}
+	lrps, err := lrpDB.ActualLRPs(ctx, logger.Session("fetch-modification-tag"), filter)
+	if err != nil || len(lrps) == 0 {
+		return nil
+	}
+
+	tag := lrps[0].ModificationTag
+	return &tag
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type AuditHandler struct {
+	db       db.AuditDB
+	exitChan chan<- struct{}
+}
+
+func NewAuditHandler(db db.AuditDB, exitChan chan<- struct{}) *AuditHandler {
+	return &AuditHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *AuditHandler) AuditEntries(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("audit-entries")
+
+	request := &models.AuditEntriesRequest{}
+	response := &models.AuditEntriesResponse{}
+
+	// entries of every domain are recorded together
+	if allowedDomains(req) != nil {
+		err = models.ErrForbidden
+	} else {
+		err = parseRequest(logger, req, request)
+	}
+
+	if err == nil {
+		filter := models.AuditEntryFilter{
+			Route:      request.Route,
+			Identity:   request.Identity,
+			TargetGuid: request.TargetGuid,
+			Since:      request.Since,
+			Limit:      int(request.Limit),
+		}
+		response.Entries, err = h.db.AuditEntries(req.Context(), logger, filter)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
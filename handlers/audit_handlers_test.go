@@ -0,0 +1,133 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeAuditDB      *dbfakes.FakeAuditDB
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.AuditHandler
+		requestBody      interface{}
+		exitCh           chan struct{}
+	)
+
+	BeforeEach(func() {
+		fakeAuditDB = new(dbfakes.FakeAuditDB)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewAuditHandler(fakeAuditDB, exitCh)
+	})
+
+	Describe("AuditEntries", func() {
+		var entries []*models.AuditEntry
+
+		BeforeEach(func() {
+			requestBody = &models.AuditEntriesRequest{
+				Route:      "UpsertDomain",
+				Identity:   "CN=cc-uploader",
+				TargetGuid: "some-domain",
+				Since:      1000,
+				Limit:      5,
+			}
+			entries = []*models.AuditEntry{
+				{Route: "UpsertDomain", TargetGuid: "some-domain"},
+			}
+			fakeAuditDB.AuditEntriesReturns(entries, nil)
+		})
+
+		var domains []string
+
+		BeforeEach(func() {
+			domains = nil
+		})
+
+		JustBeforeEach(func() {
+			request := newTestRequest(requestBody)
+			if domains != nil {
+				request = request.WithContext(middleware.WithAllowedDomains(request.Context(), domains))
+			}
+			handler.AuditEntries(logger, responseRecorder, request)
+		})
+
+		It("returns the matching entries", func() {
+			Expect(fakeAuditDB.AuditEntriesCallCount()).To(Equal(1))
+			_, _, filter := fakeAuditDB.AuditEntriesArgsForCall(0)
+			Expect(filter).To(Equal(models.AuditEntryFilter{
+				Route:      "UpsertDomain",
+				Identity:   "CN=cc-uploader",
+				TargetGuid: "some-domain",
+				Since:      1000,
+				Limit:      5,
+			}))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var response models.AuditEntriesResponse
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Entries).To(Equal(entries))
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.AuditEntriesRequest{Limit: -1}
+			})
+
+			It("responds with an error", func() {
+				Expect(fakeAuditDB.AuditEntriesCallCount()).To(Equal(0))
+
+				var response models.AuditEntriesResponse
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(func() {
+				domains = []string{"domain-1"}
+			})
+
+			It("is forbidden", func() {
+				Expect(fakeAuditDB.AuditEntriesCallCount()).To(Equal(0))
+
+				var response models.AuditEntriesResponse
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error).To(Equal(models.ErrForbidden))
+			})
+		})
+
+		Context("when the DB fails", func() {
+			BeforeEach(func() {
+				fakeAuditDB.AuditEntriesReturns(nil, models.ErrUnknownError)
+			})
+
+			It("responds with the error", func() {
+				var response models.AuditEntriesResponse
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+			})
+		})
+
+		Context("when the DB error is unrecoverable", func() {
+			BeforeEach(func() {
+				fakeAuditDB.AuditEntriesReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("exits", func() {
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+})
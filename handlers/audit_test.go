@@ -0,0 +1,233 @@
+package handlers_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/audit/auditfakes"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Audit", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeClock        *fakeclock.FakeClock
+		fakeSink         *auditfakes.FakeSink
+		fakeLRPDB        *dbfakes.FakeLRPDB
+		responseRecorder *httptest.ResponseRecorder
+		routeName        string
+		request          *http.Request
+		innerBody        []byte
+		innerResponse    proto.Message
+		innerCalled      bool
+		inner            middleware.LoggableHandlerFunc
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		fakeSink = new(auditfakes.FakeSink)
+		fakeLRPDB = new(dbfakes.FakeLRPDB)
+		responseRecorder = httptest.NewRecorder()
+		routeName = bbs.UpdateDesiredLRPRoute_r0
+		innerCalled = false
+		innerResponse = &models.DesiredLRPLifecycleResponse{}
+
+		inner = func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+			innerCalled = true
+			var err error
+			innerBody, err = ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			writeProto(w, innerResponse)
+		}
+
+		request = newTestRequest(&models.UpdateDesiredLRPRequest{
+			ProcessGuid: "some-guid",
+			Update:      &models.DesiredLRPUpdate{},
+		})
+		request.RemoteAddr = "10.0.0.1:1234"
+		request.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{
+				{Subject: pkix.Name{CommonName: "cc-uploader"}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		handlers.Audit(fakeClock, fakeSink, fakeLRPDB, routeName, inner)(logger, responseRecorder, request)
+	})
+
+	It("passes the request through to the handler", func() {
+		Expect(innerCalled).To(BeTrue())
+		Expect(innerBody).NotTo(BeEmpty())
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+	})
+
+	It("records an entry describing the request", func() {
+		Expect(fakeSink.RecordCallCount()).To(Equal(1))
+		_, entry := fakeSink.RecordArgsForCall(0)
+		Expect(entry.Timestamp).To(Equal(fakeClock.Now().UnixNano()))
+		Expect(entry.Route).To(Equal(bbs.UpdateDesiredLRPRoute_r0))
+		Expect(entry.Identity).To(Equal("CN=cc-uploader"))
+		Expect(entry.RemoteAddr).To(Equal("10.0.0.1:1234"))
+		Expect(entry.TargetGuid).To(Equal("some-guid"))
+		Expect(entry.Request).To(MatchJSON(`{"process_guid":"some-guid"}`))
+		Expect(entry.ErrorType).To(BeEmpty())
+	})
+
+	Context("when the desired LRP exists", func() {
+		BeforeEach(func() {
+			fakeLRPDB.DesiredLRPSchedulingInfosReturnsOnCall(0, []*models.DesiredLRPSchedulingInfo{
+				{ModificationTag: models.ModificationTag{Epoch: "abc", Index: 1}},
+			}, nil)
+			fakeLRPDB.DesiredLRPSchedulingInfosReturnsOnCall(1, []*models.DesiredLRPSchedulingInfo{
+				{ModificationTag: models.ModificationTag{Epoch: "abc", Index: 2}},
+			}, nil)
+		})
+
+		It("records the modification tag before and after the request", func() {
+			Expect(fakeLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(2))
+			_, _, filter := fakeLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+			Expect(filter.ProcessGuids).To(ConsistOf("some-guid"))
+
+			_, entry := fakeSink.RecordArgsForCall(0)
+			Expect(entry.ModificationTagBefore).To(Equal(&models.ModificationTag{Epoch: "abc", Index: 1}))
+			Expect(entry.ModificationTagAfter).To(Equal(&models.ModificationTag{Epoch: "abc", Index: 2}))
+		})
+	})
+
+	Context("when the desired LRP does not exist", func() {
+		It("records no modification tags", func() {
+			_, entry := fakeSink.RecordArgsForCall(0)
+			Expect(entry.ModificationTagBefore).To(BeNil())
+			Expect(entry.ModificationTagAfter).To(BeNil())
+		})
+	})
+
+	Context("when the request fails", func() {
+		BeforeEach(func() {
+			innerResponse = &models.DesiredLRPLifecycleResponse{Error: models.ErrResourceNotFound}
+		})
+
+		It("records the error type", func() {
+			_, entry := fakeSink.RecordArgsForCall(0)
+			Expect(entry.ErrorType).To(Equal(models.Error_ResourceNotFound.String()))
+		})
+	})
+
+	Context("when the request contains secrets", func() {
+		BeforeEach(func() {
+			routeName = bbs.DesireDesiredLRPRoute_r2
+			request = newTestRequest(&models.DesireLRPRequest{
+				DesiredLrp: &models.DesiredLRP{
+					ProcessGuid:          "some-guid",
+					Domain:               "some-domain",
+					Instances:            2,
+					ImageUsername:        "user",
+					ImagePassword:        "hunter2",
+					EnvironmentVariables: []*models.EnvironmentVariable{{Name: "VCAP_SERVICES", Value: "credentials"}},
+				},
+			})
+		})
+
+		It("only records the identifying fields", func() {
+			_, entry := fakeSink.RecordArgsForCall(0)
+			Expect(entry.TargetGuid).To(Equal("some-guid"))
+			Expect(entry.Request).To(MatchJSON(`{"desired_lrp":{"process_guid":"some-guid","domain":"some-domain","instances":2}}`))
+		})
+	})
+
+	Context("when retiring an actual LRP", func() {
+		BeforeEach(func() {
+			routeName = bbs.RetireActualLRPRoute_r0
+			key := models.NewActualLRPKey("some-guid", 2, "some-domain")
+			request = newTestRequest(&models.RetireActualLRPRequest{ActualLrpKey: &key})
+			fakeLRPDB.ActualLRPsReturns([]*models.ActualLRP{
+				{ModificationTag: models.ModificationTag{Epoch: "xyz", Index: 3}},
+			}, nil)
+		})
+
+		It("fetches the modification tag of the instance", func() {
+			_, _, filter := fakeLRPDB.ActualLRPsArgsForCall(0)
+			Expect(filter.ProcessGuid).To(Equal("some-guid"))
+			Expect(*filter.Index).To(BeEquivalentTo(2))
+
+			_, entry := fakeSink.RecordArgsForCall(0)
+			Expect(entry.ModificationTagBefore).To(Equal(&models.ModificationTag{Epoch: "xyz", Index: 3}))
+		})
+	})
+
+	Context("when rotating the encryption key", func() {
+		BeforeEach(func() {
+			routeName = bbs.RotateEncryptionKeyRoute_r0
+			request = newTestRequest(&models.RotateEncryptionKeyRequest{KeyLabel: "new-key"})
+			innerResponse = &models.RotateEncryptionKeyResponse{}
+		})
+
+		It("records the key label as the target", func() {
+			Expect(fakeSink.RecordCallCount()).To(Equal(1))
+			_, entry := fakeSink.RecordArgsForCall(0)
+			Expect(entry.Route).To(Equal(bbs.RotateEncryptionKeyRoute_r0))
+			Expect(entry.TargetGuid).To(Equal("new-key"))
+		})
+	})
+
+	Context("when reloading the configuration", func() {
+		BeforeEach(func() {
+			routeName = bbs.ReloadConfigRoute_r0
+			request = newTestRequest(&models.EmptyRequest{})
+			innerResponse = &models.ReloadConfigResponse{}
+		})
+
+		It("records an entry", func() {
+			Expect(fakeSink.RecordCallCount()).To(Equal(1))
+			_, entry := fakeSink.RecordArgsForCall(0)
+			Expect(entry.Route).To(Equal(bbs.ReloadConfigRoute_r0))
+		})
+	})
+
+	Context("when the sink fails", func() {
+		BeforeEach(func() {
+			fakeSink.RecordReturns(errors.New("disk full"))
+		})
+
+		It("logs the failure and still responds", func() {
+			Expect(logger).To(gbytes.Say("failed-to-record-audit-entry"))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	Context("when the route is not audited", func() {
+		BeforeEach(func() {
+			routeName = bbs.DesiredLRPsRoute_r2
+		})
+
+		It("does not record an entry", func() {
+			Expect(innerCalled).To(BeTrue())
+			Expect(fakeSink.RecordCallCount()).To(Equal(0))
+		})
+	})
+})
+
+func writeProto(w http.ResponseWriter, message proto.Message) {
+	payload, err := proto.Marshal(message)
+	Expect(err).NotTo(HaveOccurred())
+	w.Write(payload)
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_cell_cordon_controller.go . CellCordonController
+type CellCordonController interface {
+	CellCordons(ctx context.Context, logger lager.Logger) ([]*models.CellCordon, error)
+	CordonCell(ctx context.Context, logger lager.Logger, cellID, reason string) (*models.CellCordon, error)
+	DrainCell(ctx context.Context, logger lager.Logger, cellID, reason string) (*models.CellCordon, error)
+	UncordonCell(ctx context.Context, logger lager.Logger, cellID string) error
+	FlagCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) error
+}
+
+type CellCordonHandler struct {
+	controller CellCordonController
+	exitChan   chan<- struct{}
+}
+
+func NewCellCordonHandler(controller CellCordonController, exitChan chan<- struct{}) *CellCordonHandler {
+	return &CellCordonHandler{
+		controller: controller,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *CellCordonHandler) CellCordons(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("cell-cordons")
+
+	response := &models.CellCordonsResponse{}
+
+	// cells are shared by every domain
+	if allowedDomains(req) != nil {
+		err = models.ErrForbidden
+	} else {
+		response.Cordons, err = h.controller.CellCordons(req.Context(), logger)
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *CellCordonHandler) CordonCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("cordon-cell")
+
+	request := &models.CordonCellRequest{}
+	response := &models.CellCordonResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		if allowedDomains(req) != nil {
+			err = models.ErrForbidden
+		} else {
+			response.Cordon, err = h.controller.CordonCell(req.Context(), logger, request.CellId, request.Reason)
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *CellCordonHandler) DrainCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("drain-cell")
+
+	request := &models.DrainCellRequest{}
+	response := &models.CellCordonResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		if allowedDomains(req) != nil {
+			err = models.ErrForbidden
+		} else {
+			response.Cordon, err = h.controller.DrainCell(req.Context(), logger, request.CellId, request.Reason)
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *CellCordonHandler) UncordonCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("uncordon-cell")
+
+	request := &models.UncordonCellRequest{}
+	response := &models.CellCordonResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		if allowedDomains(req) != nil {
+			err = models.ErrForbidden
+		} else {
+			err = h.controller.UncordonCell(req.Context(), logger, request.CellId)
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
@@ -0,0 +1,231 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cell Cordon Handlers", func() {
+	var (
+		logger               *lagertest.TestLogger
+		fakeCordonController *fake_controllers.FakeCellCordonController
+		responseRecorder     *httptest.ResponseRecorder
+		handler              *handlers.CellCordonHandler
+		exitCh               chan struct{}
+		cordon               *models.CellCordon
+		requestBody          interface{}
+		request              *http.Request
+	)
+
+	BeforeEach(func() {
+		fakeCordonController = new(fake_controllers.FakeCellCordonController)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewCellCordonHandler(fakeCordonController, exitCh)
+
+		cordon = &models.CellCordon{CellId: "cell-1", Draining: true, Reason: "kernel upgrade", UpdatedAt: 1234}
+		fakeCordonController.CellCordonsReturns([]*models.CellCordon{cordon}, nil)
+		fakeCordonController.CordonCellReturns(cordon, nil)
+		fakeCordonController.DrainCellReturns(cordon, nil)
+
+		requestBody = ""
+		request = nil
+	})
+
+	restrictToDomains := func() {
+		request = newTestRequest(requestBody)
+		request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+	}
+
+	Describe("CellCordons", func() {
+		JustBeforeEach(func() {
+			if request == nil {
+				request = newTestRequest(requestBody)
+			}
+			handler.CellCordons(logger, responseRecorder, request)
+		})
+
+		It("returns the cordons", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.CellCordonsResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Cordons).To(ConsistOf(cordon))
+		})
+
+		Context("when fetching the cordons fails", func() {
+			BeforeEach(func() {
+				fakeCordonController.CellCordonsReturns(nil, errors.New("boom"))
+			})
+
+			It("responds with the error", func() {
+				response := &models.CellCordonsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Message).To(Equal("boom"))
+			})
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(restrictToDomains)
+
+			It("responds with a forbidden error", func() {
+				Expect(fakeCordonController.CellCordonsCallCount()).To(Equal(0))
+				response := &models.CellCordonsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("CordonCell", func() {
+		BeforeEach(func() {
+			requestBody = &models.CordonCellRequest{CellId: "cell-1", Reason: "kernel upgrade"}
+		})
+
+		JustBeforeEach(func() {
+			if request == nil {
+				request = newTestRequest(requestBody)
+			}
+			handler.CordonCell(logger, responseRecorder, request)
+		})
+
+		It("cordons the cell", func() {
+			Expect(fakeCordonController.CordonCellCallCount()).To(Equal(1))
+			_, _, cellID, reason := fakeCordonController.CordonCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+			Expect(reason).To(Equal("kernel upgrade"))
+
+			response := &models.CellCordonResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Cordon).To(Equal(cordon))
+		})
+
+		Context("when the cell id is missing", func() {
+			BeforeEach(func() {
+				requestBody = &models.CordonCellRequest{}
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(fakeCordonController.CordonCellCallCount()).To(Equal(0))
+				response := &models.CellCordonResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(restrictToDomains)
+
+			It("responds with a forbidden error", func() {
+				Expect(fakeCordonController.CordonCellCallCount()).To(Equal(0))
+				response := &models.CellCordonResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("DrainCell", func() {
+		BeforeEach(func() {
+			requestBody = &models.DrainCellRequest{CellId: "cell-1", Reason: "kernel upgrade"}
+		})
+
+		JustBeforeEach(func() {
+			if request == nil {
+				request = newTestRequest(requestBody)
+			}
+			handler.DrainCell(logger, responseRecorder, request)
+		})
+
+		It("drains the cell", func() {
+			Expect(fakeCordonController.DrainCellCallCount()).To(Equal(1))
+			_, _, cellID, reason := fakeCordonController.DrainCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+			Expect(reason).To(Equal("kernel upgrade"))
+
+			response := &models.CellCordonResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Cordon).To(Equal(cordon))
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(restrictToDomains)
+
+			It("responds with a forbidden error", func() {
+				Expect(fakeCordonController.DrainCellCallCount()).To(Equal(0))
+				response := &models.CellCordonResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("UncordonCell", func() {
+		BeforeEach(func() {
+			requestBody = &models.UncordonCellRequest{CellId: "cell-1"}
+		})
+
+		JustBeforeEach(func() {
+			if request == nil {
+				request = newTestRequest(requestBody)
+			}
+			handler.UncordonCell(logger, responseRecorder, request)
+		})
+
+		It("uncordons the cell", func() {
+			Expect(fakeCordonController.UncordonCellCallCount()).To(Equal(1))
+			_, _, cellID := fakeCordonController.UncordonCellArgsForCall(0)
+			Expect(cellID).To(Equal("cell-1"))
+
+			response := &models.CellCordonResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when the cell is not cordoned", func() {
+			BeforeEach(func() {
+				fakeCordonController.UncordonCellReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with a resource not found error", func() {
+				response := &models.CellCordonResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(restrictToDomains)
+
+			It("responds with a forbidden error", func() {
+				Expect(fakeCordonController.UncordonCellCallCount()).To(Equal(0))
+				response := &models.CellCordonResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrForbidden))
+			})
+		})
+	})
+})
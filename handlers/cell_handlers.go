@@ -10,12 +10,14 @@ import (
 
 type CellHandler struct {
 	serviceClient serviceclient.ServiceClient
+	cellCordons   CellCordonController
 	exitChan      chan<- struct{}
 }
 
-func NewCellHandler(serviceClient serviceclient.ServiceClient, exitChan chan<- struct{}) *CellHandler {
+func NewCellHandler(serviceClient serviceclient.ServiceClient, cellCordons CellCordonController, exitChan chan<- struct{}) *CellHandler {
 	return &CellHandler{
 		serviceClient: serviceClient,
+		cellCordons:   cellCordons,
 		exitChan:      exitChan,
 	}
 }
@@ -25,12 +27,19 @@ func (h *CellHandler) Cells(logger lager.Logger, w http.ResponseWriter, req *htt
 	logger = logger.Session("cells")
 	response := &models.CellsResponse{}
 	cellSet, err := h.serviceClient.Cells(logger)
+	if err == nil {
+		// the cells are still worth listing without their cordons
+		flagErr := h.cellCordons.FlagCells(req.Context(), logger, cellSet)
+		if flagErr != nil {
+			logger.Error("failed-flagging-cordoned-cells", flagErr)
+		}
+	}
 	cells := []*models.CellPresence{}
 	for _, cp := range cellSet {
 		cells = append(cells, cp)
 	}
 	response.Cells = cells
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
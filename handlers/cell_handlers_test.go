@@ -1,12 +1,16 @@
 package handlers_test
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -19,6 +23,7 @@ var _ = Describe("Cell Handlers", func() {
 		responseRecorder  *httptest.ResponseRecorder
 		handler           *handlers.CellHandler
 		fakeServiceClient *serviceclientfakes.FakeServiceClient
+		fakeCellCordons   *fake_controllers.FakeCellCordonController
 		exitCh            chan struct{}
 		cells             []*models.CellPresence
 		cellSet           models.CellSet
@@ -29,7 +34,8 @@ var _ = Describe("Cell Handlers", func() {
 		logger = lagertest.NewTestLogger("test")
 		responseRecorder = httptest.NewRecorder()
 		exitCh = make(chan struct{}, 1)
-		handler = handlers.NewCellHandler(fakeServiceClient, exitCh)
+		fakeCellCordons = new(fake_controllers.FakeCellCordonController)
+		handler = handlers.NewCellHandler(fakeServiceClient, fakeCellCordons, exitCh)
 		cells = []*models.CellPresence{
 			{
 				CellId:     "cell-1",
@@ -86,6 +92,47 @@ var _ = Describe("Cell Handlers", func() {
 				Expect(response.Error).To(BeNil())
 				Expect(response.Cells).To(ConsistOf(cells))
 			})
+
+			Context("when a cell is cordoned", func() {
+				BeforeEach(func() {
+					fakeCellCordons.FlagCellsStub = func(_ context.Context, _ lager.Logger, cellSet models.CellSet) error {
+						cellSet["cell-2"].Cordoned = true
+						cellSet["cell-2"].Draining = true
+						return nil
+					}
+				})
+
+				It("flags the cell", func() {
+					Expect(fakeCellCordons.FlagCellsCallCount()).To(Equal(1))
+
+					response := &models.CellsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(response.Cells).To(HaveLen(2))
+					for _, cell := range response.Cells {
+						Expect(cell.Cordoned).To(Equal(cell.CellId == "cell-2"))
+						Expect(cell.Draining).To(Equal(cell.CellId == "cell-2"))
+					}
+				})
+			})
+
+			Context("when fetching the cell cordons fails", func() {
+				BeforeEach(func() {
+					fakeCellCordons.FlagCellsReturns(errors.New("boom"))
+				})
+
+				It("returns the cells without their cordons", func() {
+					response := &models.CellsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Error).To(BeNil())
+					Expect(response.Cells).To(HaveLen(2))
+					for _, cell := range response.Cells {
+						Expect(cell.Cordoned).To(BeFalse())
+					}
+				})
+			})
 		})
 
 		Context("when the serviceClient returns no cells", func() {
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_cell_summary_controller.go . CellSummaryController
+type CellSummaryController interface {
+	CellSummaries(ctx context.Context, logger lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error)
+}
+
+type CellSummaryHandler struct {
+	controller CellSummaryController
+	exitChan   chan<- struct{}
+}
+
+func NewCellSummaryHandler(controller CellSummaryController, exitChan chan<- struct{}) *CellSummaryHandler {
+	return &CellSummaryHandler{
+		controller: controller,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *CellSummaryHandler) CellSummaries(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("cell-summaries")
+
+	response := &models.CellSummariesResponse{}
+
+	// cells are shared by every domain
+	if allowedDomains(req) != nil {
+		err = models.ErrForbidden
+	} else {
+		response.Cells, response.Zones, err = h.controller.CellSummaries(req.Context(), logger)
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
@@ -0,0 +1,98 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cell Summary Handlers", func() {
+	var (
+		logger                *lagertest.TestLogger
+		fakeSummaryController *fake_controllers.FakeCellSummaryController
+		responseRecorder      *httptest.ResponseRecorder
+		handler               *handlers.CellSummaryHandler
+		exitCh                chan struct{}
+		request               *http.Request
+		cell                  *models.CellSummary
+		zone                  *models.ZoneSummary
+	)
+
+	BeforeEach(func() {
+		fakeSummaryController = new(fake_controllers.FakeCellSummaryController)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewCellSummaryHandler(fakeSummaryController, exitCh)
+
+		cell = &models.CellSummary{
+			CellId:     "cell-1",
+			Zone:       "z1",
+			Capacity:   &models.CellCapacity{MemoryMb: 1024, DiskMb: 2048, Containers: 10},
+			Reserved:   &models.CellCapacity{MemoryMb: 256, DiskMb: 512, Containers: 2},
+			ActualLrps: &models.CellActualLRPCounts{Running: 1},
+			Tasks:      &models.CellTaskCounts{Running: 1},
+		}
+		zone = &models.ZoneSummary{
+			Zone:       "z1",
+			CellCount:  1,
+			Capacity:   cell.Capacity,
+			Reserved:   cell.Reserved,
+			ActualLrps: cell.ActualLrps,
+			Tasks:      cell.Tasks,
+		}
+		fakeSummaryController.CellSummariesReturns([]*models.CellSummary{cell}, []*models.ZoneSummary{zone}, nil)
+
+		request = newTestRequest("")
+	})
+
+	JustBeforeEach(func() {
+		handler.CellSummaries(logger, responseRecorder, request)
+	})
+
+	It("returns the cell and zone summaries", func() {
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		response := &models.CellSummariesResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Error).To(BeNil())
+		Expect(response.Cells).To(Equal([]*models.CellSummary{cell}))
+		Expect(response.Zones).To(Equal([]*models.ZoneSummary{zone}))
+	})
+
+	Context("when fetching the summaries fails", func() {
+		BeforeEach(func() {
+			fakeSummaryController.CellSummariesReturns(nil, nil, errors.New("boom"))
+		})
+
+		It("responds with the error", func() {
+			response := &models.CellSummariesResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error.Message).To(Equal("boom"))
+			Expect(response.Cells).To(BeNil())
+		})
+	})
+
+	Context("when the client is restricted to domains", func() {
+		BeforeEach(func() {
+			request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+		})
+
+		It("is forbidden", func() {
+			Expect(fakeSummaryController.CellSummariesCallCount()).To(Equal(0))
+			response := &models.CellSummariesResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrForbidden))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_config_reloader.go . ConfigReloader
+type ConfigReloader interface {
+	Reload(logger lager.Logger) (*models.ConfigReloadReport, error)
+}
+
+// Settings are the handler settings that can change while the BBS runs.
+type Settings struct {
+	UpdateWorkers  int
+	MaxTaskRetries int
+}
+
+type ConfigHandler struct {
+	reloader ConfigReloader
+}
+
+func NewConfigHandler(reloader ConfigReloader) *ConfigHandler {
+	return &ConfigHandler{
+		reloader: reloader,
+	}
+}
+
+func (h *ConfigHandler) ReloadConfig(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("reload-config")
+
+	response := &models.ReloadConfigResponse{}
+	response.Report, err = h.reloader.Reload(logger)
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
+}
@@ -0,0 +1,74 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config Handlers", func() {
+	var (
+		logger             *lagertest.TestLogger
+		fakeConfigReloader *fake_controllers.FakeConfigReloader
+		responseRecorder   *httptest.ResponseRecorder
+		handler            *handlers.ConfigHandler
+		report             *models.ConfigReloadReport
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeConfigReloader = new(fake_controllers.FakeConfigReloader)
+		responseRecorder = httptest.NewRecorder()
+		handler = handlers.NewConfigHandler(fakeConfigReloader)
+	})
+
+	Describe("ReloadConfig", func() {
+		JustBeforeEach(func() {
+			handler.ReloadConfig(logger, responseRecorder, newTestRequest(""))
+		})
+
+		Context("when the reload succeeds", func() {
+			BeforeEach(func() {
+				report = &models.ConfigReloadReport{
+					Applied: []*models.ConfigChange{
+						{Field: "max_task_retries", OldValue: "3", NewValue: "5"},
+					},
+				}
+				fakeConfigReloader.ReloadReturns(report, nil)
+			})
+
+			It("responds with the report", func() {
+				Expect(fakeConfigReloader.ReloadCallCount()).To(Equal(1))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				var response models.ReloadConfigResponse
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error).To(BeNil())
+				Expect(response.Report).To(Equal(report))
+			})
+		})
+
+		Context("when fields are rejected", func() {
+			BeforeEach(func() {
+				report = &models.ConfigReloadReport{
+					Rejected: []*models.ConfigChange{{Field: "listen_address"}},
+				}
+				fakeConfigReloader.ReloadReturns(report, models.NewError(models.Error_InvalidRequest, "listen_address cannot change without a restart"))
+			})
+
+			It("responds with the error and the report", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				var response models.ReloadConfigResponse
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(response.Report).To(Equal(report))
+			})
+		})
+	})
+})
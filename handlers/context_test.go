@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor"
 	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
+	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/guidprovider"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/migration"
@@ -67,6 +68,7 @@ var _ = Describe("Context", func() {
 			convergenceWorkers,
 			updateWorkers,
 			fakeCryptor,
+			format.NoCompression,
 			guidprovider.DefaultGuidProvider,
 			fakeClock,
 			sqlRunner.DriverName(),
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_convergence_planner.go . ConvergencePlanner
+type ConvergencePlanner interface {
+	PlanConvergence(ctx context.Context, logger lager.Logger, sampleSize int) (*models.ConvergencePlan, error)
+}
+
+type ConvergencePlanHandler struct {
+	planner  ConvergencePlanner
+	exitChan chan<- struct{}
+}
+
+func NewConvergencePlanHandler(planner ConvergencePlanner, exitChan chan<- struct{}) *ConvergencePlanHandler {
+	return &ConvergencePlanHandler{
+		planner:  planner,
+		exitChan: exitChan,
+	}
+}
+
+func (h *ConvergencePlanHandler) ConvergencePlan(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("convergence-plan")
+
+	request := &models.ConvergencePlanRequest{}
+	response := &models.ConvergencePlanResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		// convergence spans every domain
+		if allowedDomains(req) != nil {
+			err = models.ErrForbidden
+		} else {
+			response.Plan, err = h.planner.PlanConvergence(req.Context(), logger, int(request.SampleSize))
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
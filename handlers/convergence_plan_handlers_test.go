@@ -0,0 +1,118 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Convergence Plan Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakePlanner      *fake_controllers.FakeConvergencePlanner
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ConvergencePlanHandler
+		requestBody      interface{}
+		request          *http.Request
+		exitCh           chan struct{}
+		plan             *models.ConvergencePlan
+	)
+
+	BeforeEach(func() {
+		fakePlanner = new(fake_controllers.FakeConvergencePlanner)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewConvergencePlanHandler(fakePlanner, exitCh)
+
+		requestBody = &models.ConvergencePlanRequest{SampleSize: 5}
+		plan = &models.ConvergencePlan{
+			CellCount:      2,
+			MissingCellIds: []string{"missing-cell"},
+			LRPs: &models.LRPConvergencePlan{
+				InstancesToRetire: &models.ActualLRPKeySample{Count: 1},
+			},
+			Tasks: &models.TaskConvergencePlan{
+				PendingTasksToKick: &models.TaskGuidSample{Count: 1, TaskGuids: []string{"task-guid"}},
+			},
+		}
+		fakePlanner.PlanConvergenceReturns(plan, nil)
+	})
+
+	JustBeforeEach(func() {
+		if request == nil {
+			request = newTestRequest(requestBody)
+		}
+		handler.ConvergencePlan(logger, responseRecorder, request)
+	})
+
+	AfterEach(func() {
+		request = nil
+	})
+
+	It("returns the plan", func() {
+		Expect(fakePlanner.PlanConvergenceCallCount()).To(Equal(1))
+		_, _, sampleSize := fakePlanner.PlanConvergenceArgsForCall(0)
+		Expect(sampleSize).To(Equal(5))
+
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		response := &models.ConvergencePlanResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Error).To(BeNil())
+		Expect(response.Plan).To(Equal(plan))
+	})
+
+	Context("when the client is restricted to domains", func() {
+		BeforeEach(func() {
+			request = newTestRequest(requestBody)
+			request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+		})
+
+		It("responds with a forbidden error", func() {
+			Expect(fakePlanner.PlanConvergenceCallCount()).To(Equal(0))
+			response := &models.ConvergencePlanResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrForbidden))
+			Expect(response.Plan).To(BeNil())
+		})
+	})
+
+	Context("when the request is invalid", func() {
+		BeforeEach(func() {
+			requestBody = &models.ConvergencePlanRequest{SampleSize: -1}
+		})
+
+		It("responds with a bad request error", func() {
+			Expect(fakePlanner.PlanConvergenceCallCount()).To(Equal(0))
+			response := &models.ConvergencePlanResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+	})
+
+	Context("when planning fails", func() {
+		BeforeEach(func() {
+			fakePlanner.PlanConvergenceReturns(nil, errors.New("boom"))
+		})
+
+		It("responds with the error", func() {
+			response := &models.ConvergencePlanResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error.Type).To(Equal(models.Error_UnknownError))
+			Expect(response.Error.Message).To(Equal("boom"))
+			Expect(response.Plan).To(BeNil())
+		})
+	})
+})
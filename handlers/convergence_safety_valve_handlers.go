@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_convergence_safety_valve.go . ConvergenceSafetyValve
+type ConvergenceSafetyValve interface {
+	Status() *models.ConvergenceSafetyValveStatus
+	Override(logger lager.Logger) *models.ConvergenceSafetyValveStatus
+}
+
+type ConvergenceSafetyValveHandler struct {
+	safetyValve ConvergenceSafetyValve
+}
+
+func NewConvergenceSafetyValveHandler(safetyValve ConvergenceSafetyValve) *ConvergenceSafetyValveHandler {
+	return &ConvergenceSafetyValveHandler{
+		safetyValve: safetyValve,
+	}
+}
+
+func (h *ConvergenceSafetyValveHandler) ConvergenceSafetyValve(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("convergence-safety-valve")
+
+	response := &models.ConvergenceSafetyValveResponse{}
+
+	// convergence spans every domain
+	if allowedDomains(req) != nil {
+		response.Error = models.ErrForbidden
+	} else {
+		response.Status = h.safetyValve.Status()
+	}
+
+	writeResponse(w, req, response)
+}
+
+func (h *ConvergenceSafetyValveHandler) OverrideConvergenceSafetyValve(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("override-convergence-safety-valve")
+
+	response := &models.ConvergenceSafetyValveResponse{}
+
+	if allowedDomains(req) != nil {
+		response.Error = models.ErrForbidden
+	} else {
+		response.Status = h.safetyValve.Override(logger)
+	}
+
+	writeResponse(w, req, response)
+}
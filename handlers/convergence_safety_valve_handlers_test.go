@@ -0,0 +1,104 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Convergence Safety Valve Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeSafetyValve  *fake_controllers.FakeConvergenceSafetyValve
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ConvergenceSafetyValveHandler
+		request          *http.Request
+		status           *models.ConvergenceSafetyValveStatus
+	)
+
+	BeforeEach(func() {
+		fakeSafetyValve = new(fake_controllers.FakeConvergenceSafetyValve)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		handler = handlers.NewConvergenceSafetyValveHandler(fakeSafetyValve)
+
+		request = newTestRequest("")
+		status = &models.ConvergenceSafetyValveStatus{
+			MaxMissingCellPercent: 50,
+			Valves: []*models.ConvergenceSafetyValveState{
+				{Convergence: "lrps", Tripped: true, MissingCellIds: []string{"cell-1"}, MissingCellPercent: 100, TrippedPasses: 1},
+			},
+		}
+		fakeSafetyValve.StatusReturns(status)
+		fakeSafetyValve.OverrideReturns(status)
+	})
+
+	Describe("ConvergenceSafetyValve", func() {
+		JustBeforeEach(func() {
+			handler.ConvergenceSafetyValve(logger, responseRecorder, request)
+		})
+
+		It("returns the status of the valves", func() {
+			Expect(fakeSafetyValve.StatusCallCount()).To(Equal(1))
+			Expect(fakeSafetyValve.OverrideCallCount()).To(Equal(0))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.ConvergenceSafetyValveResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Status).To(Equal(status))
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(func() {
+				request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+			})
+
+			It("responds with a forbidden error", func() {
+				Expect(fakeSafetyValve.StatusCallCount()).To(Equal(0))
+				response := &models.ConvergenceSafetyValveResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("OverrideConvergenceSafetyValve", func() {
+		JustBeforeEach(func() {
+			handler.OverrideConvergenceSafetyValve(logger, responseRecorder, request)
+		})
+
+		It("overrides the valves and returns their status", func() {
+			Expect(fakeSafetyValve.OverrideCallCount()).To(Equal(1))
+
+			response := &models.ConvergenceSafetyValveResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Status).To(Equal(status))
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(func() {
+				request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+			})
+
+			It("responds with a forbidden error", func() {
+				Expect(fakeSafetyValve.OverrideCallCount()).To(Equal(0))
+				response := &models.ConvergenceSafetyValveResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrForbidden))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"sync"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs/db"
@@ -27,6 +28,7 @@ type DesiredLRPHandler struct {
 	serviceClient        serviceclient.ServiceClient
 	updateWorkersCount   int
 	exitChan             chan<- struct{}
+	settingsLock         sync.RWMutex
 }
 
 func NewDesiredLRPHandler(
@@ -55,6 +57,14 @@ func NewDesiredLRPHandler(
 	}
 }
 
+// SetUpdateWorkers changes the number of workers that create actual LRPs for
+// later updates.
+func (h *DesiredLRPHandler) SetUpdateWorkers(updateWorkersCount int) {
+	h.settingsLock.Lock()
+	defer h.settingsLock.Unlock()
+	h.updateWorkersCount = updateWorkersCount
+}
+
 func (h *DesiredLRPHandler) commonDesiredLRPs(logger lager.Logger, targetVersion format.Version, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("desired-lrps")
@@ -64,7 +74,7 @@ func (h *DesiredLRPHandler) commonDesiredLRPs(logger lager.Logger, targetVersion
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.DesiredLRPFilter{Domain: request.Domain, ProcessGuids: request.ProcessGuids}
+		filter := models.DesiredLRPFilter{Domain: request.Domain, Domains: allowedDomains(req), ProcessGuids: request.ProcessGuids}
 
 		var desiredLRPs []*models.DesiredLRP
 		desiredLRPs, err = h.desiredLRPDB.DesiredLRPs(req.Context(), logger, filter)
@@ -79,7 +89,7 @@ func (h *DesiredLRPHandler) commonDesiredLRPs(logger lager.Logger, targetVersion
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 
 }
@@ -103,6 +113,9 @@ func (h *DesiredLRPHandler) commonDesiredLRPByProcessGuid(logger lager.Logger, t
 	if err == nil {
 		var desiredLRP *models.DesiredLRP
 		desiredLRP, err = h.desiredLRPDB.DesiredLRPByProcessGuid(req.Context(), logger, request.ProcessGuid)
+		if desiredLRP != nil && !domainAllowed(req, desiredLRP.Domain) {
+			desiredLRP, err = nil, models.ErrResourceNotFound
+		}
 		if desiredLRP != nil {
 			desiredLRP = desiredLRP.VersionDownTo(targetVersion).PopulateMetricsGuid()
 		}
@@ -110,7 +123,7 @@ func (h *DesiredLRPHandler) commonDesiredLRPByProcessGuid(logger lager.Logger, t
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 
 }
@@ -136,13 +149,14 @@ func (h *DesiredLRPHandler) DesiredLRPSchedulingInfos(logger lager.Logger, w htt
 	if err == nil {
 		filter := models.DesiredLRPFilter{
 			Domain:       request.Domain,
+			Domains:      allowedDomains(req),
 			ProcessGuids: request.ProcessGuids,
 		}
 		response.DesiredLrpSchedulingInfos, err = h.desiredLRPDB.DesiredLRPSchedulingInfos(req.Context(), logger, filter)
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -152,7 +166,7 @@ func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.Respons
 	request := &models.DesireLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -160,6 +174,11 @@ func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
+	if !domainAllowed(req, request.DesiredLrp.Domain) {
+		response.Error = models.ErrForbidden
+		return
+	}
+
 	err = h.desiredLRPDB.DesireLRP(req.Context(), logger, request.DesiredLrp)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -186,7 +205,7 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 	request := &models.UpdateDesiredLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -197,6 +216,12 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 
 	logger = logger.WithData(lager.Data{"guid": request.ProcessGuid})
 
+	err = h.checkDomain(logger, req, request.ProcessGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	logger.Debug("updating-desired-lrp")
 	beforeDesiredLRP, err := h.desiredLRPDB.UpdateDesiredLRP(req.Context(), logger, request.ProcessGuid, request.Update)
 	if err != nil {
@@ -241,7 +266,7 @@ func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.Respons
 	request := &models.RemoveDesiredLRPRequest{}
 	response := &models.DesiredLRPLifecycleResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -256,6 +281,11 @@ func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.Respons
 		return
 	}
 
+	if desiredLRP != nil && !domainAllowed(req, desiredLRP.Domain) {
+		response.Error = models.ErrResourceNotFound
+		return
+	}
+
 	err = h.desiredLRPDB.RemoveDesiredLRP(req.Context(), logger.Session("remove-desired"), request.ProcessGuid)
 	if err != nil {
 		response.Error = models.ConvertError(err)
@@ -267,6 +297,26 @@ func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.Respons
 	h.stopInstancesFrom(req.Context(), logger, request.ProcessGuid, 0)
 }
 
+// checkDomain hides desired LRPs outside of the domains the client is
+// restricted to.
+func (h *DesiredLRPHandler) checkDomain(logger lager.Logger, req *http.Request, processGuid string) error {
+	domains := allowedDomains(req)
+	if domains == nil {
+		return nil
+	}
+
+	filter := models.DesiredLRPFilter{Domains: domains, ProcessGuids: []string{processGuid}}
+	schedulingInfos, err := h.desiredLRPDB.DesiredLRPSchedulingInfos(req.Context(), logger, filter)
+	if err != nil {
+		return err
+	}
+
+	if len(schedulingInfos) == 0 {
+		return models.ErrResourceNotFound
+	}
+	return nil
+}
+
 func (h *DesiredLRPHandler) startInstanceRange(ctx context.Context, logger lager.Logger, lower, upper int32, schedulingInfo *models.DesiredLRPSchedulingInfo) {
 	logger = logger.Session("start-instance-range", lager.Data{"lower": lower, "upper": upper})
 	logger.Info("starting")
@@ -316,7 +366,9 @@ func (h *DesiredLRPHandler) createUnclaimedActualLRPs(ctx context.Context, logge
 		}
 	}
 
+	h.settingsLock.RLock()
 	throttlerSize := h.updateWorkersCount
+	h.settingsLock.RUnlock()
 	throttler, err := workpool.NewThrottler(throttlerSize, works)
 	if err != nil {
 		logger.Error("failed-constructing-throttler", err, lager.Data{"max_workers": throttlerSize, "num_works": len(works)})
@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	. "code.cloudfoundry.org/bbs/test_helpers"
@@ -1429,4 +1430,73 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			})
 		})
 	})
+
+	Describe("when the client is restricted to domains", func() {
+		restrictedRequest := func(body interface{}) *http.Request {
+			request := newTestRequest(body)
+			return request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+		}
+
+		It("only lists desired lrps in the allowed domains", func() {
+			handler.DesiredLRPs(logger, responseRecorder, restrictedRequest(&models.DesiredLRPsRequest{}))
+
+			Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
+			_, _, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+			Expect(filter.Domains).To(Equal([]string{"domain-1"}))
+		})
+
+		It("hides desired lrps in other domains", func() {
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(&models.DesiredLRP{ProcessGuid: "some-guid", Domain: "domain-2"}, nil)
+			handler.DesiredLRPByProcessGuid(logger, responseRecorder, restrictedRequest(&models.DesiredLRPByProcessGuidRequest{ProcessGuid: "some-guid"}))
+
+			response := models.DesiredLRPResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			Expect(response.DesiredLrp).To(BeNil())
+		})
+
+		It("forbids desiring lrps in other domains", func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+			desiredLRP.Domain = "domain-2"
+			handler.DesireDesiredLRP(logger, responseRecorder, restrictedRequest(&models.DesireLRPRequest{DesiredLrp: desiredLRP}))
+
+			Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(0))
+			response := models.DesiredLRPLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrForbidden))
+		})
+
+		It("does not update desired lrps in other domains", func() {
+			fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, nil)
+			update := &models.DesiredLRPUpdate{}
+			update.SetInstances(3)
+			handler.UpdateDesiredLRP(logger, responseRecorder, restrictedRequest(&models.UpdateDesiredLRPRequest{
+				ProcessGuid: "some-guid",
+				Update:      update,
+			}))
+
+			_, _, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+			Expect(filter.ProcessGuids).To(Equal([]string{"some-guid"}))
+			Expect(filter.Domains).To(Equal([]string{"domain-1"}))
+			Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(0))
+
+			response := models.DesiredLRPLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("does not remove desired lrps in other domains", func() {
+			fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(&models.DesiredLRP{ProcessGuid: "some-guid", Domain: "domain-2"}, nil)
+			handler.RemoveDesiredLRP(logger, responseRecorder, restrictedRequest(&models.RemoveDesiredLRPRequest{ProcessGuid: "some-guid"}))
+
+			Expect(fakeDesiredLRPDB.RemoveDesiredLRPCallCount()).To(Equal(0))
+			response := models.DesiredLRPLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+		})
+	})
 })
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_disruption_budget_controller.go . DisruptionBudgetController
+type DisruptionBudgetController interface {
+	DisruptionBudgetStatus(ctx context.Context, logger lager.Logger, processGuid string, domains []string) (*models.DisruptionBudgetStatus, error)
+}
+
+type DisruptionBudgetHandler struct {
+	controller DisruptionBudgetController
+	exitChan   chan<- struct{}
+}
+
+func NewDisruptionBudgetHandler(controller DisruptionBudgetController, exitChan chan<- struct{}) *DisruptionBudgetHandler {
+	return &DisruptionBudgetHandler{
+		controller: controller,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *DisruptionBudgetHandler) DisruptionBudgetStatus(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("disruption-budget-status")
+
+	request := &models.DisruptionBudgetStatusRequest{}
+	response := &models.DisruptionBudgetStatusResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		response.Status, err = h.controller.DisruptionBudgetStatus(req.Context(), logger, request.ProcessGuid, allowedDomains(req))
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
@@ -0,0 +1,112 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Disruption Budget Handlers", func() {
+	var (
+		logger               *lagertest.TestLogger
+		fakeBudgetController *fake_controllers.FakeDisruptionBudgetController
+		responseRecorder     *httptest.ResponseRecorder
+		handler              *handlers.DisruptionBudgetHandler
+		requestBody          interface{}
+		request              *http.Request
+		exitCh               chan struct{}
+		status               *models.DisruptionBudgetStatus
+	)
+
+	BeforeEach(func() {
+		fakeBudgetController = new(fake_controllers.FakeDisruptionBudgetController)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewDisruptionBudgetHandler(fakeBudgetController, exitCh)
+
+		requestBody = &models.DisruptionBudgetStatusRequest{ProcessGuid: "some-guid"}
+		status = &models.DisruptionBudgetStatus{
+			ProcessGuid:        "some-guid",
+			Budget:             &models.DisruptionBudget{MinAvailable: 2},
+			DesiredInstances:   3,
+			AvailableInstances: 3,
+			AllowedDisruptions: 1,
+		}
+		fakeBudgetController.DisruptionBudgetStatusReturns(status, nil)
+	})
+
+	JustBeforeEach(func() {
+		if request == nil {
+			request = newTestRequest(requestBody)
+		}
+		handler.DisruptionBudgetStatus(logger, responseRecorder, request)
+	})
+
+	AfterEach(func() {
+		request = nil
+	})
+
+	It("returns the status", func() {
+		Expect(fakeBudgetController.DisruptionBudgetStatusCallCount()).To(Equal(1))
+		_, _, processGuid, domains := fakeBudgetController.DisruptionBudgetStatusArgsForCall(0)
+		Expect(processGuid).To(Equal("some-guid"))
+		Expect(domains).To(BeNil())
+
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		response := &models.DisruptionBudgetStatusResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Error).To(BeNil())
+		Expect(response.Status).To(Equal(status))
+	})
+
+	Context("when the client is restricted to domains", func() {
+		BeforeEach(func() {
+			request = newTestRequest(requestBody)
+			request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+		})
+
+		It("only looks in the allowed domains", func() {
+			Expect(fakeBudgetController.DisruptionBudgetStatusCallCount()).To(Equal(1))
+			_, _, _, domains := fakeBudgetController.DisruptionBudgetStatusArgsForCall(0)
+			Expect(domains).To(Equal([]string{"domain-1"}))
+		})
+	})
+
+	Context("when the request is invalid", func() {
+		BeforeEach(func() {
+			requestBody = &models.DisruptionBudgetStatusRequest{}
+		})
+
+		It("responds with a bad request error", func() {
+			Expect(fakeBudgetController.DisruptionBudgetStatusCallCount()).To(Equal(0))
+			response := &models.DisruptionBudgetStatusResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+	})
+
+	Context("when fetching the status fails", func() {
+		BeforeEach(func() {
+			fakeBudgetController.DisruptionBudgetStatusReturns(nil, errors.New("boom"))
+		})
+
+		It("responds with the error", func() {
+			response := &models.DisruptionBudgetStatusResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error.Message).To(Equal("boom"))
+			Expect(response.Status).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// allowedDomains returns the domains the client of the request is restricted
+// to, or nil when it may access every domain.
+func allowedDomains(req *http.Request) []string {
+	return middleware.AllowedDomains(req.Context())
+}
+
+func domainAllowed(req *http.Request, domain string) bool {
+	domains := allowedDomains(req)
+	if domains == nil {
+		return true
+	}
+
+	for _, allowed := range domains {
+		if allowed == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// filterByDomains skips events about records outside of the domains.
+func filterByDomains(domains []string, fetchEvent EventFetcher) EventFetcher {
+	allowed := map[string]bool{}
+	for _, domain := range domains {
+		allowed[domain] = true
+	}
+
+	return func() (models.Event, error) {
+		for {
+			event, err := fetchEvent()
+			if err != nil {
+				return event, err
+			}
+
+			if allowed[eventDomain(event)] {
+				return event, nil
+			}
+		}
+	}
+}
+
+func eventDomain(bbsEvent models.Event) string {
+	switch x := bbsEvent.(type) {
+	case *models.DesiredLRPCreatedEvent:
+		return x.DesiredLrp.GetDomain()
+	case *models.DesiredLRPChangedEvent:
+		return x.After.GetDomain()
+	case *models.DesiredLRPRemovedEvent:
+		return x.DesiredLrp.GetDomain()
+	case *models.ActualLRPCreatedEvent:
+		return actualLRPGroupDomain(x.ActualLrpGroup)
+	case *models.ActualLRPChangedEvent:
+		return actualLRPGroupDomain(x.After)
+	case *models.ActualLRPRemovedEvent:
+		return actualLRPGroupDomain(x.ActualLrpGroup)
+	case *models.ActualLRPCrashedEvent:
+		return x.ActualLRPKey.Domain
+	case *models.ActualLRPInstanceCreatedEvent:
+		return actualLRPDomain(x.ActualLrp)
+	case *models.ActualLRPInstanceChangedEvent:
+		return x.ActualLRPKey.Domain
+	case *models.ActualLRPInstanceRemovedEvent:
+		return actualLRPDomain(x.ActualLrp)
+	case *models.TaskCreatedEvent:
+		return x.Task.GetDomain()
+	case *models.TaskChangedEvent:
+		return x.After.GetDomain()
+	case *models.TaskRemovedEvent:
+		return x.Task.GetDomain()
+	}
+	return ""
+}
+
+func actualLRPGroupDomain(group *models.ActualLRPGroup) string {
+	if group == nil {
+		return ""
+	}
+
+	lrp, _, err := group.Resolve()
+	if err != nil {
+		return ""
+	}
+	return lrp.Domain
+}
+
+func actualLRPDomain(lrp *models.ActualLRP) string {
+	if lrp == nil {
+		return ""
+	}
+	return lrp.Domain
+}
+
+// checkActualLRPDomain reports actual LRPs outside of the domains the client
+// is restricted to as not found.
+func checkActualLRPDomain(logger lager.Logger, actualLRPDB db.ActualLRPDB, req *http.Request, processGuid string, index int32) error {
+	domains := allowedDomains(req)
+	if domains == nil {
+		return nil
+	}
+
+	filter := models.ActualLRPFilter{ProcessGuid: processGuid, Index: &index, Domains: domains}
+	lrps, err := actualLRPDB.ActualLRPs(req.Context(), logger, filter)
+	if err != nil {
+		return err
+	}
+	if len(lrps) == 0 {
+		return models.ErrResourceNotFound
+	}
+	return nil
+}
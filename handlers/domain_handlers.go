@@ -31,8 +31,17 @@ func (h *DomainHandler) Domains(logger lager.Logger, w http.ResponseWriter, req
 	logger = logger.Session("domains")
 	response := &models.DomainsResponse{}
 	response.Domains, err = h.db.FreshDomains(req.Context(), logger)
+	if allowedDomains(req) != nil {
+		domains := []string{}
+		for _, domain := range response.Domains {
+			if domainAllowed(req, domain) {
+				domains = append(domains, domain)
+			}
+		}
+		response.Domains = domains
+	}
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
@@ -44,11 +53,14 @@ func (h *DomainHandler) Upsert(logger lager.Logger, w http.ResponseWriter, req *
 	response := &models.UpsertDomainResponse{}
 
 	err = parseRequest(logger, req, request)
+	if err == nil && !domainAllowed(req, request.Domain) {
+		err = models.ErrForbidden
+	}
 	if err == nil {
 		err = h.db.UpsertDomain(req.Context(), logger, request.Domain, request.Ttl)
 	}
 
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
+	writeResponse(w, req, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
@@ -33,13 +34,15 @@ var _ = Describe("Domain Handlers", func() {
 
 	Describe("Upsert", func() {
 		var (
-			domain string
-			ttl    uint32
+			domain      string
+			ttl         uint32
+			contentType string
 		)
 
 		BeforeEach(func() {
 			domain = "domain-to-add"
 			ttl = 12345
+			contentType = "application/x-protobuf"
 
 			requestBody = &models.UpsertDomainRequest{
 				Domain: domain,
@@ -49,6 +52,7 @@ var _ = Describe("Domain Handlers", func() {
 
 		JustBeforeEach(func() {
 			request := newTestRequest(requestBody)
+			request.Header.Set("Content-Type", contentType)
 			handler.Upsert(logger, responseRecorder, request)
 		})
 
@@ -77,6 +81,36 @@ var _ = Describe("Domain Handlers", func() {
 			})
 		})
 
+		Context("when the request is JSON", func() {
+			BeforeEach(func() {
+				contentType = "application/json"
+				requestBody = `{"domain": "json-domain", "ttl": 60}`
+			})
+
+			It("decodes the request", func() {
+				Expect(fakeDomainDB.UpsertDomainCallCount()).To(Equal(1))
+				_, _, domainUpserted, ttlUpserted := fakeDomainDB.UpsertDomainArgsForCall(0)
+				Expect(domainUpserted).To(Equal("json-domain"))
+				Expect(ttlUpserted).To(BeEquivalentTo(60))
+			})
+
+			It("responds with JSON", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(responseRecorder.Header().Get("Content-Type")).To(Equal("application/json"))
+				Expect(responseRecorder.Body.String()).To(MatchJSON(`{}`))
+			})
+
+			Context("when the DB errors out", func() {
+				BeforeEach(func() {
+					fakeDomainDB.UpsertDomainReturns(models.ErrUnknownError)
+				})
+
+				It("responds with the error as JSON", func() {
+					Expect(responseRecorder.Body.String()).To(MatchJSON(`{"error": {"type": "UnknownError", "message": "the request failed for an unknown reason"}}`))
+				})
+			})
+		})
+
 		Context("when the request is invalid", func() {
 			BeforeEach(func() {
 				requestBody = &models.UpsertDomainRequest{}
@@ -217,4 +251,31 @@ var _ = Describe("Domain Handlers", func() {
 			})
 		})
 	})
+
+	Describe("when the client is restricted to domains", func() {
+		restrictedRequest := func(body interface{}) *http.Request {
+			request := newTestRequest(body)
+			return request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+		}
+
+		It("only lists the allowed domains", func() {
+			fakeDomainDB.FreshDomainsReturns([]string{"domain-1", "domain-2"}, nil)
+			handler.Domains(logger, responseRecorder, restrictedRequest(""))
+
+			var domainsResponse models.DomainsResponse
+			err := domainsResponse.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(domainsResponse.Domains).To(Equal([]string{"domain-1"}))
+		})
+
+		It("forbids upserting other domains", func() {
+			handler.Upsert(logger, responseRecorder, restrictedRequest(&models.UpsertDomainRequest{Domain: "domain-2", Ttl: 10}))
+
+			Expect(fakeDomainDB.UpsertDomainCallCount()).To(Equal(0))
+			var upsertDomainResponse models.UpsertDomainResponse
+			err := upsertDomainResponse.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(upsertDomainResponse.Error).To(Equal(models.ErrForbidden))
+		})
+	})
 })
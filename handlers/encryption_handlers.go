@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_encryption_controller.go . EncryptionController
+type EncryptionController interface {
+	Rotate(logger lager.Logger, keyLabel string) error
+	Status() *models.EncryptionStatus
+}
+
+type EncryptionHandler struct {
+	controller EncryptionController
+	db         db.EncryptionDB
+	exitChan   chan<- struct{}
+}
+
+func NewEncryptionHandler(controller EncryptionController, db db.EncryptionDB, exitChan chan<- struct{}) *EncryptionHandler {
+	return &EncryptionHandler{
+		controller: controller,
+		db:         db,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *EncryptionHandler) RotateEncryptionKey(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("rotate-encryption-key")
+
+	request := &models.RotateEncryptionKeyRequest{}
+	response := &models.RotateEncryptionKeyResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		err = h.controller.Rotate(logger, request.KeyLabel)
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
+}
+
+func (h *EncryptionHandler) EncryptionStatus(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	response := &models.EncryptionStatusResponse{}
+	response.Status = h.controller.Status()
+	writeResponse(w, req, response)
+}
+
+func (h *EncryptionHandler) EncryptionKeyUsage(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("encryption-key-usage")
+
+	request := &models.EncryptionKeyUsageRequest{}
+	response := &models.EncryptionKeyUsageResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		var usage map[string]int
+		usage, err = h.db.EncryptionKeyUsage(req.Context(), logger, request.KeyLabel)
+		if err == nil {
+			response.RowsByTable = map[string]int32{}
+			for table, rows := range usage {
+				response.RowsByTable[table] = int32(rows)
+			}
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
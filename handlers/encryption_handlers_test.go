@@ -0,0 +1,157 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Encryption Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeController   *fake_controllers.FakeEncryptionController
+		fakeEncryptionDB *dbfakes.FakeEncryptionDB
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.EncryptionHandler
+		requestBody      interface{}
+		exitCh           chan struct{}
+	)
+
+	BeforeEach(func() {
+		fakeController = new(fake_controllers.FakeEncryptionController)
+		fakeEncryptionDB = new(dbfakes.FakeEncryptionDB)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewEncryptionHandler(fakeController, fakeEncryptionDB, exitCh)
+	})
+
+	Describe("RotateEncryptionKey", func() {
+		BeforeEach(func() {
+			requestBody = &models.RotateEncryptionKeyRequest{KeyLabel: "new-key"}
+		})
+
+		JustBeforeEach(func() {
+			handler.RotateEncryptionKey(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("rotates to the requested key", func() {
+			Expect(fakeController.RotateCallCount()).To(Equal(1))
+			_, label := fakeController.RotateArgsForCall(0)
+			Expect(label).To(Equal("new-key"))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var response models.RotateEncryptionKeyResponse
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.RotateEncryptionKeyRequest{}
+			})
+
+			It("responds with an error", func() {
+				Expect(fakeController.RotateCallCount()).To(Equal(0))
+
+				var response models.RotateEncryptionKeyResponse
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the rotation is rejected", func() {
+			BeforeEach(func() {
+				fakeController.RotateReturns(models.ErrResourceConflict)
+			})
+
+			It("responds with the error", func() {
+				var response models.RotateEncryptionKeyResponse
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error).To(Equal(models.ErrResourceConflict))
+			})
+		})
+	})
+
+	Describe("EncryptionStatus", func() {
+		var status *models.EncryptionStatus
+
+		BeforeEach(func() {
+			status = &models.EncryptionStatus{
+				State:          models.EncryptionRunning,
+				ActiveKeyLabel: "new-key",
+				StoredKeyLabel: "old-key",
+				Tables: []*models.EncryptionTableProgress{
+					{Table: "tasks", Total: 10, Processed: 4, Attempts: 1},
+				},
+			}
+			fakeController.StatusReturns(status)
+		})
+
+		JustBeforeEach(func() {
+			handler.EncryptionStatus(logger, responseRecorder, newTestRequest(""))
+		})
+
+		It("responds with the status", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			var response models.EncryptionStatusResponse
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Status).To(Equal(status))
+		})
+	})
+
+	Describe("EncryptionKeyUsage", func() {
+		BeforeEach(func() {
+			requestBody = &models.EncryptionKeyUsageRequest{KeyLabel: "old-key"}
+			fakeEncryptionDB.EncryptionKeyUsageReturns(map[string]int{"tasks": 3, "actual_lrps": 0}, nil)
+		})
+
+		JustBeforeEach(func() {
+			handler.EncryptionKeyUsage(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("responds with the number of rows per table using the key", func() {
+			Expect(fakeEncryptionDB.EncryptionKeyUsageCallCount()).To(Equal(1))
+			_, _, label := fakeEncryptionDB.EncryptionKeyUsageArgsForCall(0)
+			Expect(label).To(Equal("old-key"))
+
+			var response models.EncryptionKeyUsageResponse
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.RowsByTable).To(Equal(map[string]int32{"tasks": 3, "actual_lrps": 0}))
+		})
+
+		Context("when the DB fails", func() {
+			BeforeEach(func() {
+				fakeEncryptionDB.EncryptionKeyUsageReturns(nil, errors.New("boom"))
+			})
+
+			It("responds with an error", func() {
+				var response models.EncryptionKeyUsageResponse
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error.Type).To(Equal(models.Error_UnknownError))
+			})
+		})
+
+		Context("when the DB returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				fakeEncryptionDB.EncryptionKeyUsageReturns(nil, models.NewUnrecoverableError(nil))
+			})
+
+			It("logs and writes to the exit channel", func() {
+				Eventually(logger).Should(gbytes.Say("unrecoverable-error"))
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 	"github.com/gogo/protobuf/proto"
@@ -19,17 +20,20 @@ type EvacuationController interface {
 }
 
 type EvacuationHandler struct {
-	controller EvacuationController
-	exitChan   chan<- struct{}
+	controller  EvacuationController
+	actualLRPDB db.ActualLRPDB
+	exitChan    chan<- struct{}
 }
 
 func NewEvacuationHandler(
 	controller EvacuationController,
+	actualLRPDB db.ActualLRPDB,
 	exitChan chan<- struct{},
 ) *EvacuationHandler {
 	return &EvacuationHandler{
-		controller: controller,
-		exitChan:   exitChan,
+		controller:  controller,
+		actualLRPDB: actualLRPDB,
+		exitChan:    exitChan,
 	}
 }
 
@@ -49,7 +53,7 @@ func (h *EvacuationHandler) RemoveEvacuatingActualLRP(logger lager.Logger, w htt
 	response := &models.RemoveEvacuatingActualLRPResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -57,6 +61,12 @@ func (h *EvacuationHandler) RemoveEvacuatingActualLRP(logger lager.Logger, w htt
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.RemoveEvacuatingActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
 	response.Error = models.ConvertError(err)
 }
@@ -69,7 +79,7 @@ func (h *EvacuationHandler) EvacuateClaimedActualLRP(logger lager.Logger, w http
 	request := &models.EvacuateClaimedActualLRPRequest{}
 	response := &models.EvacuationResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -79,6 +89,13 @@ func (h *EvacuationHandler) EvacuateClaimedActualLRP(logger lager.Logger, w http
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		response.KeepContainer = true
+		return
+	}
+
 	keepContainer, err := h.controller.EvacuateClaimedActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
 	response.Error = models.ConvertError(err)
 	response.KeepContainer = keepContainer
@@ -92,7 +109,7 @@ func (h *EvacuationHandler) EvacuateCrashedActualLRP(logger lager.Logger, w http
 	request := &models.EvacuateCrashedActualLRPRequest{}
 	response := &models.EvacuationResponse{}
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -101,6 +118,12 @@ func (h *EvacuationHandler) EvacuateCrashedActualLRP(logger lager.Logger, w http
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.EvacuateCrashedActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ErrorMessage)
 	response.Error = models.ConvertError(err)
 }
@@ -113,7 +136,7 @@ func (h *EvacuationHandler) EvacuateRunningActualLRP(logger lager.Logger, w http
 	response := &models.EvacuationResponse{}
 	response.KeepContainer = true
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	request := &models.EvacuateRunningActualLRPRequest{}
 	err := parseRequest(logger, req, request)
@@ -122,6 +145,12 @@ func (h *EvacuationHandler) EvacuateRunningActualLRP(logger lager.Logger, w http
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	keepContainer, err := h.controller.EvacuateRunningActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ActualLrpNetInfo)
 	response.Error = models.ConvertError(err)
 	response.KeepContainer = keepContainer
@@ -134,7 +163,7 @@ func (h *EvacuationHandler) EvacuateStoppedActualLRP(logger lager.Logger, w http
 	response := &models.EvacuationResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer writeResponse(w, response)
+	defer writeResponse(w, req, response)
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -143,6 +172,12 @@ func (h *EvacuationHandler) EvacuateStoppedActualLRP(logger lager.Logger, w http
 		return
 	}
 
+	err = checkActualLRPDomain(logger, h.actualLRPDB, req, request.ActualLrpKey.ProcessGuid, request.ActualLrpKey.Index)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.EvacuateStoppedActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
 	response.Error = models.ConvertError(err)
 }
@@ -4,8 +4,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/lager"
@@ -22,9 +24,10 @@ var _ = Describe("Evacuation Handlers", func() {
 		handler          *handlers.EvacuationHandler
 		exitCh           chan struct{}
 
-		key         models.ActualLRPKey
-		instanceKey models.ActualLRPInstanceKey
-		controller  *fake_controllers.FakeEvacuationController
+		key             models.ActualLRPKey
+		instanceKey     models.ActualLRPInstanceKey
+		controller      *fake_controllers.FakeEvacuationController
+		fakeActualLRPDB *dbfakes.FakeActualLRPDB
 	)
 
 	BeforeEach(func() {
@@ -34,7 +37,8 @@ var _ = Describe("Evacuation Handlers", func() {
 		exitCh = make(chan struct{}, 1)
 
 		controller = &fake_controllers.FakeEvacuationController{}
-		handler = handlers.NewEvacuationHandler(controller, exitCh)
+		fakeActualLRPDB = &dbfakes.FakeActualLRPDB{}
+		handler = handlers.NewEvacuationHandler(controller, fakeActualLRPDB, exitCh)
 
 		key = models.ActualLRPKey{
 			ProcessGuid: "some-guid",
@@ -148,6 +152,35 @@ var _ = Describe("Evacuation Handlers", func() {
 			actual      *models.ActualLRP
 		)
 
+		Context("when the client is restricted to the domain of another actual lrp", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.ActualLRPsReturns([]*models.ActualLRP{}, nil)
+
+				actual = model_helpers.NewValidActualLRP("process-guid", 1)
+				requestBody = &models.EvacuateClaimedActualLRPRequest{
+					ActualLrpKey:         &actual.ActualLRPKey,
+					ActualLrpInstanceKey: &actual.ActualLRPInstanceKey,
+				}
+				request = newTestRequest(requestBody)
+				request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"other-domain"}))
+				handler.EvacuateClaimedActualLRP(logger, responseRecorder, request)
+			})
+
+			It("responds with resource not found and keeps the container", func() {
+				Expect(controller.EvacuateClaimedActualLRPCallCount()).To(Equal(0))
+
+				_, _, filter := fakeActualLRPDB.ActualLRPsArgsForCall(0)
+				Expect(filter.ProcessGuid).To(Equal("process-guid"))
+				Expect(filter.Domains).To(Equal([]string{"other-domain"}))
+
+				var response models.EvacuationResponse
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+				Expect(response.KeepContainer).To(BeTrue())
+			})
+		})
+
 		Context("when request is valid", func() {
 			JustBeforeEach(func() {
 				actual = model_helpers.NewValidActualLRP("process-guid", 1)
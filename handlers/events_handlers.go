@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -51,7 +52,12 @@ func NewLRPInstanceEventHandler(desiredHub, lrpInstanceHub events.Hub) *LRPInsta
 	}
 }
 
-func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventChan <-chan models.Event, errorChan <-chan error) {
+func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, req *http.Request, eventChan <-chan models.Event, errorChan <-chan error) {
+	newSSEEvent := events.NewEventFromModelEvent
+	if middleware.WantsJSON(req) {
+		newSSEEvent = events.NewJSONEventFromModelEvent
+	}
+
 	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
 	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
 	w.Header().Add("Connection", "keep-alive")
@@ -91,7 +97,7 @@ func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventCha
 			return
 		}
 
-		sseEvent, err := events.NewEventFromModelEvent(eventID, event)
+		sseEvent, err := newSSEEvent(eventID, event)
 		if err != nil {
 			logger.Error("failed-to-marshal-event", err)
 			return
@@ -69,10 +69,15 @@ func (h *LRPGroupEventsHandler) commonSubscribe(logger lager.Logger, w http.Resp
 		return event, err
 	}
 
+	if domains := allowedDomains(req); domains != nil {
+		desiredEventsFetcher = filterByDomains(domains, desiredEventsFetcher)
+		actualEventsFetcher = filterByDomains(domains, actualEventsFetcher)
+	}
+
 	go streamSource(eventChan, errorChan, closeChan, desiredEventsFetcher)
 	go streamSource(eventChan, errorChan, closeChan, actualEventsFetcher)
 
-	streamEventsToResponse(logger, w, eventChan, errorChan)
+	streamEventsToResponse(logger, w, req, eventChan, errorChan)
 }
 
 func (h *LRPGroupEventsHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -142,10 +147,15 @@ func (h *LRPInstanceEventHandler) commonSubscribe(logger lager.Logger, w http.Re
 		return event, err
 	}
 
+	if domains := allowedDomains(req); domains != nil {
+		desiredEventsFetcher = filterByDomains(domains, desiredEventsFetcher)
+		lrpInstanceEventFetcher = filterByDomains(domains, lrpInstanceEventFetcher)
+	}
+
 	go streamSource(eventChan, errorChan, closeChan, desiredEventsFetcher)
 	go streamSource(eventChan, errorChan, closeChan, lrpInstanceEventFetcher)
 
-	streamEventsToResponse(logger, w, eventChan, errorChan)
+	streamEventsToResponse(logger, w, req, eventChan, errorChan)
 }
 
 func (h *LRPInstanceEventHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -182,9 +192,13 @@ func (h *TaskEventHandler) commonSubscribe(logger lager.Logger, w http.ResponseW
 		return event, err
 	}
 
+	if domains := allowedDomains(req); domains != nil {
+		taskEventsFetcher = filterByDomains(domains, taskEventsFetcher)
+	}
+
 	go streamSource(eventChan, errorChan, closeChan, taskEventsFetcher)
 
-	streamEventsToResponse(logger, w, eventChan, errorChan)
+	streamEventsToResponse(logger, w, req, eventChan, errorChan)
 }
 
 func (h *TaskEventHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -16,6 +17,7 @@ import (
 	"code.cloudfoundry.org/bbs/test_helpers"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/gogo/protobuf/proto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/vito/go-sse/sse"
@@ -829,6 +831,39 @@ var _ = Describe("Event Handlers", func() {
 		})
 
 		Describe("Subscribe to Task Events", func() {
+			Context("when the client accepts JSON", func() {
+				It("streams JSON encoded events", func() {
+					server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						handler.Subscribe_r1(logger, w, r)
+					}))
+					defer server.Close()
+
+					request, err := http.NewRequest("POST", server.URL, nil)
+					Expect(err).NotTo(HaveOccurred())
+					request.Header.Set("Accept", "application/json")
+					response, err := http.DefaultClient.Do(request)
+					Expect(err).NotTo(HaveOccurred())
+					reader := sse.NewReadCloser(response.Body)
+
+					event := models.NewTaskCreatedEvent(model_helpers.NewValidTask("guid"))
+					taskHub.Emit(event)
+
+					sseEvent, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(sseEvent.Name).To(Equal(models.EventTypeTaskCreated))
+
+					jsonEvent := &models.TaskCreatedEvent{}
+					Expect(json.Unmarshal(sseEvent.Data, jsonEvent)).To(Succeed())
+
+					protoEvent := &models.TaskCreatedEvent{}
+					protoPayload, err := proto.Marshal(event)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(proto.Unmarshal(protoPayload, protoEvent)).To(Succeed())
+
+					Expect(jsonEvent).To(Equal(protoEvent))
+				})
+			})
+
 			Context("downgrading task definitions down to v3", func() {
 				var (
 					server          *httptest.Server
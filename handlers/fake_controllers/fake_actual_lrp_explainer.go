@@ -0,0 +1,132 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeActualLRPExplainer struct {
+	ExplainActualLRPStub        func(context.Context, lager.Logger, string, int32, []string) (*models.ActualLRPExplanation, error)
+	explainActualLRPMutex       sync.RWMutex
+	explainActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+		arg5 []string
+	}
+	explainActualLRPReturns struct {
+		result1 *models.ActualLRPExplanation
+		result2 error
+	}
+	explainActualLRPReturnsOnCall map[int]struct {
+		result1 *models.ActualLRPExplanation
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeActualLRPExplainer) ExplainActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 []string) (*models.ActualLRPExplanation, error) {
+	var arg5Copy []string
+	if arg5 != nil {
+		arg5Copy = make([]string, len(arg5))
+		copy(arg5Copy, arg5)
+	}
+	fake.explainActualLRPMutex.Lock()
+	ret, specificReturn := fake.explainActualLRPReturnsOnCall[len(fake.explainActualLRPArgsForCall)]
+	fake.explainActualLRPArgsForCall = append(fake.explainActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+		arg5 []string
+	}{arg1, arg2, arg3, arg4, arg5Copy})
+	stub := fake.ExplainActualLRPStub
+	fakeReturns := fake.explainActualLRPReturns
+	fake.recordInvocation("ExplainActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5Copy})
+	fake.explainActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActualLRPExplainer) ExplainActualLRPCallCount() int {
+	fake.explainActualLRPMutex.RLock()
+	defer fake.explainActualLRPMutex.RUnlock()
+	return len(fake.explainActualLRPArgsForCall)
+}
+
+func (fake *FakeActualLRPExplainer) ExplainActualLRPCalls(stub func(context.Context, lager.Logger, string, int32, []string) (*models.ActualLRPExplanation, error)) {
+	fake.explainActualLRPMutex.Lock()
+	defer fake.explainActualLRPMutex.Unlock()
+	fake.ExplainActualLRPStub = stub
+}
+
+func (fake *FakeActualLRPExplainer) ExplainActualLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32, []string) {
+	fake.explainActualLRPMutex.RLock()
+	defer fake.explainActualLRPMutex.RUnlock()
+	argsForCall := fake.explainActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeActualLRPExplainer) ExplainActualLRPReturns(result1 *models.ActualLRPExplanation, result2 error) {
+	fake.explainActualLRPMutex.Lock()
+	defer fake.explainActualLRPMutex.Unlock()
+	fake.ExplainActualLRPStub = nil
+	fake.explainActualLRPReturns = struct {
+		result1 *models.ActualLRPExplanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPExplainer) ExplainActualLRPReturnsOnCall(i int, result1 *models.ActualLRPExplanation, result2 error) {
+	fake.explainActualLRPMutex.Lock()
+	defer fake.explainActualLRPMutex.Unlock()
+	fake.ExplainActualLRPStub = nil
+	if fake.explainActualLRPReturnsOnCall == nil {
+		fake.explainActualLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.ActualLRPExplanation
+			result2 error
+		})
+	}
+	fake.explainActualLRPReturnsOnCall[i] = struct {
+		result1 *models.ActualLRPExplanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPExplainer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.explainActualLRPMutex.RLock()
+	defer fake.explainActualLRPMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeActualLRPExplainer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.ActualLRPExplainer = new(FakeActualLRPExplainer)
@@ -0,0 +1,447 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeCellCordonController struct {
+	CellCordonsStub        func(context.Context, lager.Logger) ([]*models.CellCordon, error)
+	cellCordonsMutex       sync.RWMutex
+	cellCordonsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellCordonsReturns struct {
+		result1 []*models.CellCordon
+		result2 error
+	}
+	cellCordonsReturnsOnCall map[int]struct {
+		result1 []*models.CellCordon
+		result2 error
+	}
+	CordonCellStub        func(context.Context, lager.Logger, string, string) (*models.CellCordon, error)
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}
+	cordonCellReturns struct {
+		result1 *models.CellCordon
+		result2 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 *models.CellCordon
+		result2 error
+	}
+	DrainCellStub        func(context.Context, lager.Logger, string, string) (*models.CellCordon, error)
+	drainCellMutex       sync.RWMutex
+	drainCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}
+	drainCellReturns struct {
+		result1 *models.CellCordon
+		result2 error
+	}
+	drainCellReturnsOnCall map[int]struct {
+		result1 *models.CellCordon
+		result2 error
+	}
+	FlagCellsStub        func(context.Context, lager.Logger, models.CellSet) error
+	flagCellsMutex       sync.RWMutex
+	flagCellsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+	}
+	flagCellsReturns struct {
+		result1 error
+	}
+	flagCellsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UncordonCellStub        func(context.Context, lager.Logger, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCellCordonController) CellCordons(arg1 context.Context, arg2 lager.Logger) ([]*models.CellCordon, error) {
+	fake.cellCordonsMutex.Lock()
+	ret, specificReturn := fake.cellCordonsReturnsOnCall[len(fake.cellCordonsArgsForCall)]
+	fake.cellCordonsArgsForCall = append(fake.cellCordonsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellCordonsStub
+	fakeReturns := fake.cellCordonsReturns
+	fake.recordInvocation("CellCordons", []interface{}{arg1, arg2})
+	fake.cellCordonsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellCordonController) CellCordonsCallCount() int {
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	return len(fake.cellCordonsArgsForCall)
+}
+
+func (fake *FakeCellCordonController) CellCordonsCalls(stub func(context.Context, lager.Logger) ([]*models.CellCordon, error)) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = stub
+}
+
+func (fake *FakeCellCordonController) CellCordonsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	argsForCall := fake.cellCordonsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCellCordonController) CellCordonsReturns(result1 []*models.CellCordon, result2 error) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = nil
+	fake.cellCordonsReturns = struct {
+		result1 []*models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonController) CellCordonsReturnsOnCall(i int, result1 []*models.CellCordon, result2 error) {
+	fake.cellCordonsMutex.Lock()
+	defer fake.cellCordonsMutex.Unlock()
+	fake.CellCordonsStub = nil
+	if fake.cellCordonsReturnsOnCall == nil {
+		fake.cellCordonsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellCordon
+			result2 error
+		})
+	}
+	fake.cellCordonsReturnsOnCall[i] = struct {
+		result1 []*models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonController) CordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.CellCordon, error) {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3, arg4})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellCordonController) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeCellCordonController) CordonCellCalls(stub func(context.Context, lager.Logger, string, string) (*models.CellCordon, error)) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeCellCordonController) CordonCellArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCellCordonController) CordonCellReturns(result1 *models.CellCordon, result2 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 *models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonController) CordonCellReturnsOnCall(i int, result1 *models.CellCordon, result2 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 *models.CellCordon
+			result2 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 *models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonController) DrainCell(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.CellCordon, error) {
+	fake.drainCellMutex.Lock()
+	ret, specificReturn := fake.drainCellReturnsOnCall[len(fake.drainCellArgsForCall)]
+	fake.drainCellArgsForCall = append(fake.drainCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DrainCellStub
+	fakeReturns := fake.drainCellReturns
+	fake.recordInvocation("DrainCell", []interface{}{arg1, arg2, arg3, arg4})
+	fake.drainCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellCordonController) DrainCellCallCount() int {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	return len(fake.drainCellArgsForCall)
+}
+
+func (fake *FakeCellCordonController) DrainCellCalls(stub func(context.Context, lager.Logger, string, string) (*models.CellCordon, error)) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = stub
+}
+
+func (fake *FakeCellCordonController) DrainCellArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	argsForCall := fake.drainCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCellCordonController) DrainCellReturns(result1 *models.CellCordon, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	fake.drainCellReturns = struct {
+		result1 *models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonController) DrainCellReturnsOnCall(i int, result1 *models.CellCordon, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	if fake.drainCellReturnsOnCall == nil {
+		fake.drainCellReturnsOnCall = make(map[int]struct {
+			result1 *models.CellCordon
+			result2 error
+		})
+	}
+	fake.drainCellReturnsOnCall[i] = struct {
+		result1 *models.CellCordon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellCordonController) FlagCells(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet) error {
+	fake.flagCellsMutex.Lock()
+	ret, specificReturn := fake.flagCellsReturnsOnCall[len(fake.flagCellsArgsForCall)]
+	fake.flagCellsArgsForCall = append(fake.flagCellsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+	}{arg1, arg2, arg3})
+	stub := fake.FlagCellsStub
+	fakeReturns := fake.flagCellsReturns
+	fake.recordInvocation("FlagCells", []interface{}{arg1, arg2, arg3})
+	fake.flagCellsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellCordonController) FlagCellsCallCount() int {
+	fake.flagCellsMutex.RLock()
+	defer fake.flagCellsMutex.RUnlock()
+	return len(fake.flagCellsArgsForCall)
+}
+
+func (fake *FakeCellCordonController) FlagCellsCalls(stub func(context.Context, lager.Logger, models.CellSet) error) {
+	fake.flagCellsMutex.Lock()
+	defer fake.flagCellsMutex.Unlock()
+	fake.FlagCellsStub = stub
+}
+
+func (fake *FakeCellCordonController) FlagCellsArgsForCall(i int) (context.Context, lager.Logger, models.CellSet) {
+	fake.flagCellsMutex.RLock()
+	defer fake.flagCellsMutex.RUnlock()
+	argsForCall := fake.flagCellsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellCordonController) FlagCellsReturns(result1 error) {
+	fake.flagCellsMutex.Lock()
+	defer fake.flagCellsMutex.Unlock()
+	fake.FlagCellsStub = nil
+	fake.flagCellsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonController) FlagCellsReturnsOnCall(i int, result1 error) {
+	fake.flagCellsMutex.Lock()
+	defer fake.flagCellsMutex.Unlock()
+	fake.FlagCellsStub = nil
+	if fake.flagCellsReturnsOnCall == nil {
+		fake.flagCellsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.flagCellsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonController) UncordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellCordonController) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeCellCordonController) UncordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeCellCordonController) UncordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellCordonController) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonController) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellCordonController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cellCordonsMutex.RLock()
+	defer fake.cellCordonsMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	fake.flagCellsMutex.RLock()
+	defer fake.flagCellsMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCellCordonController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.CellCordonController = new(FakeCellCordonController)
@@ -0,0 +1,126 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeCellSummaryController struct {
+	CellSummariesStub        func(context.Context, lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error)
+	cellSummariesMutex       sync.RWMutex
+	cellSummariesArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellSummariesReturns struct {
+		result1 []*models.CellSummary
+		result2 []*models.ZoneSummary
+		result3 error
+	}
+	cellSummariesReturnsOnCall map[int]struct {
+		result1 []*models.CellSummary
+		result2 []*models.ZoneSummary
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCellSummaryController) CellSummaries(arg1 context.Context, arg2 lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error) {
+	fake.cellSummariesMutex.Lock()
+	ret, specificReturn := fake.cellSummariesReturnsOnCall[len(fake.cellSummariesArgsForCall)]
+	fake.cellSummariesArgsForCall = append(fake.cellSummariesArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellSummariesStub
+	fakeReturns := fake.cellSummariesReturns
+	fake.recordInvocation("CellSummaries", []interface{}{arg1, arg2})
+	fake.cellSummariesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCellSummaryController) CellSummariesCallCount() int {
+	fake.cellSummariesMutex.RLock()
+	defer fake.cellSummariesMutex.RUnlock()
+	return len(fake.cellSummariesArgsForCall)
+}
+
+func (fake *FakeCellSummaryController) CellSummariesCalls(stub func(context.Context, lager.Logger) ([]*models.CellSummary, []*models.ZoneSummary, error)) {
+	fake.cellSummariesMutex.Lock()
+	defer fake.cellSummariesMutex.Unlock()
+	fake.CellSummariesStub = stub
+}
+
+func (fake *FakeCellSummaryController) CellSummariesArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellSummariesMutex.RLock()
+	defer fake.cellSummariesMutex.RUnlock()
+	argsForCall := fake.cellSummariesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCellSummaryController) CellSummariesReturns(result1 []*models.CellSummary, result2 []*models.ZoneSummary, result3 error) {
+	fake.cellSummariesMutex.Lock()
+	defer fake.cellSummariesMutex.Unlock()
+	fake.CellSummariesStub = nil
+	fake.cellSummariesReturns = struct {
+		result1 []*models.CellSummary
+		result2 []*models.ZoneSummary
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCellSummaryController) CellSummariesReturnsOnCall(i int, result1 []*models.CellSummary, result2 []*models.ZoneSummary, result3 error) {
+	fake.cellSummariesMutex.Lock()
+	defer fake.cellSummariesMutex.Unlock()
+	fake.CellSummariesStub = nil
+	if fake.cellSummariesReturnsOnCall == nil {
+		fake.cellSummariesReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellSummary
+			result2 []*models.ZoneSummary
+			result3 error
+		})
+	}
+	fake.cellSummariesReturnsOnCall[i] = struct {
+		result1 []*models.CellSummary
+		result2 []*models.ZoneSummary
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCellSummaryController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cellSummariesMutex.RLock()
+	defer fake.cellSummariesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCellSummaryController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.CellSummaryController = new(FakeCellSummaryController)
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeConfigReloader struct {
+	ReloadStub        func(lager.Logger) (*models.ConfigReloadReport, error)
+	reloadMutex       sync.RWMutex
+	reloadArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	reloadReturns struct {
+		result1 *models.ConfigReloadReport
+		result2 error
+	}
+	reloadReturnsOnCall map[int]struct {
+		result1 *models.ConfigReloadReport
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConfigReloader) Reload(arg1 lager.Logger) (*models.ConfigReloadReport, error) {
+	fake.reloadMutex.Lock()
+	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
+	fake.reloadArgsForCall = append(fake.reloadArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.ReloadStub
+	fakeReturns := fake.reloadReturns
+	fake.recordInvocation("Reload", []interface{}{arg1})
+	fake.reloadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfigReloader) ReloadCallCount() int {
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	return len(fake.reloadArgsForCall)
+}
+
+func (fake *FakeConfigReloader) ReloadCalls(stub func(lager.Logger) (*models.ConfigReloadReport, error)) {
+	fake.reloadMutex.Lock()
+	defer fake.reloadMutex.Unlock()
+	fake.ReloadStub = stub
+}
+
+func (fake *FakeConfigReloader) ReloadArgsForCall(i int) lager.Logger {
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	argsForCall := fake.reloadArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfigReloader) ReloadReturns(result1 *models.ConfigReloadReport, result2 error) {
+	fake.reloadMutex.Lock()
+	defer fake.reloadMutex.Unlock()
+	fake.ReloadStub = nil
+	fake.reloadReturns = struct {
+		result1 *models.ConfigReloadReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfigReloader) ReloadReturnsOnCall(i int, result1 *models.ConfigReloadReport, result2 error) {
+	fake.reloadMutex.Lock()
+	defer fake.reloadMutex.Unlock()
+	fake.ReloadStub = nil
+	if fake.reloadReturnsOnCall == nil {
+		fake.reloadReturnsOnCall = make(map[int]struct {
+			result1 *models.ConfigReloadReport
+			result2 error
+		})
+	}
+	fake.reloadReturnsOnCall[i] = struct {
+		result1 *models.ConfigReloadReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfigReloader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeConfigReloader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.ConfigReloader = new(FakeConfigReloader)
@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeConvergencePlanner struct {
+	PlanConvergenceStub        func(context.Context, lager.Logger, int) (*models.ConvergencePlan, error)
+	planConvergenceMutex       sync.RWMutex
+	planConvergenceArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}
+	planConvergenceReturns struct {
+		result1 *models.ConvergencePlan
+		result2 error
+	}
+	planConvergenceReturnsOnCall map[int]struct {
+		result1 *models.ConvergencePlan
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConvergencePlanner) PlanConvergence(arg1 context.Context, arg2 lager.Logger, arg3 int) (*models.ConvergencePlan, error) {
+	fake.planConvergenceMutex.Lock()
+	ret, specificReturn := fake.planConvergenceReturnsOnCall[len(fake.planConvergenceArgsForCall)]
+	fake.planConvergenceArgsForCall = append(fake.planConvergenceArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.PlanConvergenceStub
+	fakeReturns := fake.planConvergenceReturns
+	fake.recordInvocation("PlanConvergence", []interface{}{arg1, arg2, arg3})
+	fake.planConvergenceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConvergencePlanner) PlanConvergenceCallCount() int {
+	fake.planConvergenceMutex.RLock()
+	defer fake.planConvergenceMutex.RUnlock()
+	return len(fake.planConvergenceArgsForCall)
+}
+
+func (fake *FakeConvergencePlanner) PlanConvergenceCalls(stub func(context.Context, lager.Logger, int) (*models.ConvergencePlan, error)) {
+	fake.planConvergenceMutex.Lock()
+	defer fake.planConvergenceMutex.Unlock()
+	fake.PlanConvergenceStub = stub
+}
+
+func (fake *FakeConvergencePlanner) PlanConvergenceArgsForCall(i int) (context.Context, lager.Logger, int) {
+	fake.planConvergenceMutex.RLock()
+	defer fake.planConvergenceMutex.RUnlock()
+	argsForCall := fake.planConvergenceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeConvergencePlanner) PlanConvergenceReturns(result1 *models.ConvergencePlan, result2 error) {
+	fake.planConvergenceMutex.Lock()
+	defer fake.planConvergenceMutex.Unlock()
+	fake.PlanConvergenceStub = nil
+	fake.planConvergenceReturns = struct {
+		result1 *models.ConvergencePlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConvergencePlanner) PlanConvergenceReturnsOnCall(i int, result1 *models.ConvergencePlan, result2 error) {
+	fake.planConvergenceMutex.Lock()
+	defer fake.planConvergenceMutex.Unlock()
+	fake.PlanConvergenceStub = nil
+	if fake.planConvergenceReturnsOnCall == nil {
+		fake.planConvergenceReturnsOnCall = make(map[int]struct {
+			result1 *models.ConvergencePlan
+			result2 error
+		})
+	}
+	fake.planConvergenceReturnsOnCall[i] = struct {
+		result1 *models.ConvergencePlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConvergencePlanner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.planConvergenceMutex.RLock()
+	defer fake.planConvergenceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeConvergencePlanner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.ConvergencePlanner = new(FakeConvergencePlanner)
@@ -0,0 +1,178 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeConvergenceSafetyValve struct {
+	OverrideStub        func(lager.Logger) *models.ConvergenceSafetyValveStatus
+	overrideMutex       sync.RWMutex
+	overrideArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	overrideReturns struct {
+		result1 *models.ConvergenceSafetyValveStatus
+	}
+	overrideReturnsOnCall map[int]struct {
+		result1 *models.ConvergenceSafetyValveStatus
+	}
+	StatusStub        func() *models.ConvergenceSafetyValveStatus
+	statusMutex       sync.RWMutex
+	statusArgsForCall []struct {
+	}
+	statusReturns struct {
+		result1 *models.ConvergenceSafetyValveStatus
+	}
+	statusReturnsOnCall map[int]struct {
+		result1 *models.ConvergenceSafetyValveStatus
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConvergenceSafetyValve) Override(arg1 lager.Logger) *models.ConvergenceSafetyValveStatus {
+	fake.overrideMutex.Lock()
+	ret, specificReturn := fake.overrideReturnsOnCall[len(fake.overrideArgsForCall)]
+	fake.overrideArgsForCall = append(fake.overrideArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.OverrideStub
+	fakeReturns := fake.overrideReturns
+	fake.recordInvocation("Override", []interface{}{arg1})
+	fake.overrideMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConvergenceSafetyValve) OverrideCallCount() int {
+	fake.overrideMutex.RLock()
+	defer fake.overrideMutex.RUnlock()
+	return len(fake.overrideArgsForCall)
+}
+
+func (fake *FakeConvergenceSafetyValve) OverrideCalls(stub func(lager.Logger) *models.ConvergenceSafetyValveStatus) {
+	fake.overrideMutex.Lock()
+	defer fake.overrideMutex.Unlock()
+	fake.OverrideStub = stub
+}
+
+func (fake *FakeConvergenceSafetyValve) OverrideArgsForCall(i int) lager.Logger {
+	fake.overrideMutex.RLock()
+	defer fake.overrideMutex.RUnlock()
+	argsForCall := fake.overrideArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConvergenceSafetyValve) OverrideReturns(result1 *models.ConvergenceSafetyValveStatus) {
+	fake.overrideMutex.Lock()
+	defer fake.overrideMutex.Unlock()
+	fake.OverrideStub = nil
+	fake.overrideReturns = struct {
+		result1 *models.ConvergenceSafetyValveStatus
+	}{result1}
+}
+
+func (fake *FakeConvergenceSafetyValve) OverrideReturnsOnCall(i int, result1 *models.ConvergenceSafetyValveStatus) {
+	fake.overrideMutex.Lock()
+	defer fake.overrideMutex.Unlock()
+	fake.OverrideStub = nil
+	if fake.overrideReturnsOnCall == nil {
+		fake.overrideReturnsOnCall = make(map[int]struct {
+			result1 *models.ConvergenceSafetyValveStatus
+		})
+	}
+	fake.overrideReturnsOnCall[i] = struct {
+		result1 *models.ConvergenceSafetyValveStatus
+	}{result1}
+}
+
+func (fake *FakeConvergenceSafetyValve) Status() *models.ConvergenceSafetyValveStatus {
+	fake.statusMutex.Lock()
+	ret, specificReturn := fake.statusReturnsOnCall[len(fake.statusArgsForCall)]
+	fake.statusArgsForCall = append(fake.statusArgsForCall, struct {
+	}{})
+	stub := fake.StatusStub
+	fakeReturns := fake.statusReturns
+	fake.recordInvocation("Status", []interface{}{})
+	fake.statusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConvergenceSafetyValve) StatusCallCount() int {
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	return len(fake.statusArgsForCall)
+}
+
+func (fake *FakeConvergenceSafetyValve) StatusCalls(stub func() *models.ConvergenceSafetyValveStatus) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = stub
+}
+
+func (fake *FakeConvergenceSafetyValve) StatusReturns(result1 *models.ConvergenceSafetyValveStatus) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = nil
+	fake.statusReturns = struct {
+		result1 *models.ConvergenceSafetyValveStatus
+	}{result1}
+}
+
+func (fake *FakeConvergenceSafetyValve) StatusReturnsOnCall(i int, result1 *models.ConvergenceSafetyValveStatus) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = nil
+	if fake.statusReturnsOnCall == nil {
+		fake.statusReturnsOnCall = make(map[int]struct {
+			result1 *models.ConvergenceSafetyValveStatus
+		})
+	}
+	fake.statusReturnsOnCall[i] = struct {
+		result1 *models.ConvergenceSafetyValveStatus
+	}{result1}
+}
+
+func (fake *FakeConvergenceSafetyValve) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.overrideMutex.RLock()
+	defer fake.overrideMutex.RUnlock()
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeConvergenceSafetyValve) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.ConvergenceSafetyValve = new(FakeConvergenceSafetyValve)
@@ -0,0 +1,130 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeDisruptionBudgetController struct {
+	DisruptionBudgetStatusStub        func(context.Context, lager.Logger, string, []string) (*models.DisruptionBudgetStatus, error)
+	disruptionBudgetStatusMutex       sync.RWMutex
+	disruptionBudgetStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []string
+	}
+	disruptionBudgetStatusReturns struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}
+	disruptionBudgetStatusReturnsOnCall map[int]struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDisruptionBudgetController) DisruptionBudgetStatus(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 []string) (*models.DisruptionBudgetStatus, error) {
+	var arg4Copy []string
+	if arg4 != nil {
+		arg4Copy = make([]string, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.disruptionBudgetStatusMutex.Lock()
+	ret, specificReturn := fake.disruptionBudgetStatusReturnsOnCall[len(fake.disruptionBudgetStatusArgsForCall)]
+	fake.disruptionBudgetStatusArgsForCall = append(fake.disruptionBudgetStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []string
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.DisruptionBudgetStatusStub
+	fakeReturns := fake.disruptionBudgetStatusReturns
+	fake.recordInvocation("DisruptionBudgetStatus", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.disruptionBudgetStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDisruptionBudgetController) DisruptionBudgetStatusCallCount() int {
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
+	return len(fake.disruptionBudgetStatusArgsForCall)
+}
+
+func (fake *FakeDisruptionBudgetController) DisruptionBudgetStatusCalls(stub func(context.Context, lager.Logger, string, []string) (*models.DisruptionBudgetStatus, error)) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = stub
+}
+
+func (fake *FakeDisruptionBudgetController) DisruptionBudgetStatusArgsForCall(i int) (context.Context, lager.Logger, string, []string) {
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
+	argsForCall := fake.disruptionBudgetStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDisruptionBudgetController) DisruptionBudgetStatusReturns(result1 *models.DisruptionBudgetStatus, result2 error) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = nil
+	fake.disruptionBudgetStatusReturns = struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDisruptionBudgetController) DisruptionBudgetStatusReturnsOnCall(i int, result1 *models.DisruptionBudgetStatus, result2 error) {
+	fake.disruptionBudgetStatusMutex.Lock()
+	defer fake.disruptionBudgetStatusMutex.Unlock()
+	fake.DisruptionBudgetStatusStub = nil
+	if fake.disruptionBudgetStatusReturnsOnCall == nil {
+		fake.disruptionBudgetStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.DisruptionBudgetStatus
+			result2 error
+		})
+	}
+	fake.disruptionBudgetStatusReturnsOnCall[i] = struct {
+		result1 *models.DisruptionBudgetStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDisruptionBudgetController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.disruptionBudgetStatusMutex.RLock()
+	defer fake.disruptionBudgetStatusMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDisruptionBudgetController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.DisruptionBudgetController = new(FakeDisruptionBudgetController)
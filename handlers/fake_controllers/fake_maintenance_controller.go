@@ -0,0 +1,282 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakeMaintenanceController struct {
+	EnabledStub        func(context.Context, lager.Logger) bool
+	enabledMutex       sync.RWMutex
+	enabledArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	enabledReturns struct {
+		result1 bool
+	}
+	enabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	MaintenanceModeStub        func(context.Context, lager.Logger) (*models.MaintenanceMode, error)
+	maintenanceModeMutex       sync.RWMutex
+	maintenanceModeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	maintenanceModeReturns struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	maintenanceModeReturnsOnCall map[int]struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	SetMaintenanceModeStub        func(context.Context, lager.Logger, bool, string) (*models.MaintenanceMode, error)
+	setMaintenanceModeMutex       sync.RWMutex
+	setMaintenanceModeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+		arg4 string
+	}
+	setMaintenanceModeReturns struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	setMaintenanceModeReturnsOnCall map[int]struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMaintenanceController) Enabled(arg1 context.Context, arg2 lager.Logger) bool {
+	fake.enabledMutex.Lock()
+	ret, specificReturn := fake.enabledReturnsOnCall[len(fake.enabledArgsForCall)]
+	fake.enabledArgsForCall = append(fake.enabledArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.EnabledStub
+	fakeReturns := fake.enabledReturns
+	fake.recordInvocation("Enabled", []interface{}{arg1, arg2})
+	fake.enabledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeMaintenanceController) EnabledCallCount() int {
+	fake.enabledMutex.RLock()
+	defer fake.enabledMutex.RUnlock()
+	return len(fake.enabledArgsForCall)
+}
+
+func (fake *FakeMaintenanceController) EnabledCalls(stub func(context.Context, lager.Logger) bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = stub
+}
+
+func (fake *FakeMaintenanceController) EnabledArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.enabledMutex.RLock()
+	defer fake.enabledMutex.RUnlock()
+	argsForCall := fake.enabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeMaintenanceController) EnabledReturns(result1 bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = nil
+	fake.enabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeMaintenanceController) EnabledReturnsOnCall(i int, result1 bool) {
+	fake.enabledMutex.Lock()
+	defer fake.enabledMutex.Unlock()
+	fake.EnabledStub = nil
+	if fake.enabledReturnsOnCall == nil {
+		fake.enabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.enabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeMaintenanceController) MaintenanceMode(arg1 context.Context, arg2 lager.Logger) (*models.MaintenanceMode, error) {
+	fake.maintenanceModeMutex.Lock()
+	ret, specificReturn := fake.maintenanceModeReturnsOnCall[len(fake.maintenanceModeArgsForCall)]
+	fake.maintenanceModeArgsForCall = append(fake.maintenanceModeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.MaintenanceModeStub
+	fakeReturns := fake.maintenanceModeReturns
+	fake.recordInvocation("MaintenanceMode", []interface{}{arg1, arg2})
+	fake.maintenanceModeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeMaintenanceController) MaintenanceModeCallCount() int {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	return len(fake.maintenanceModeArgsForCall)
+}
+
+func (fake *FakeMaintenanceController) MaintenanceModeCalls(stub func(context.Context, lager.Logger) (*models.MaintenanceMode, error)) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = stub
+}
+
+func (fake *FakeMaintenanceController) MaintenanceModeArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	argsForCall := fake.maintenanceModeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeMaintenanceController) MaintenanceModeReturns(result1 *models.MaintenanceMode, result2 error) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = nil
+	fake.maintenanceModeReturns = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMaintenanceController) MaintenanceModeReturnsOnCall(i int, result1 *models.MaintenanceMode, result2 error) {
+	fake.maintenanceModeMutex.Lock()
+	defer fake.maintenanceModeMutex.Unlock()
+	fake.MaintenanceModeStub = nil
+	if fake.maintenanceModeReturnsOnCall == nil {
+		fake.maintenanceModeReturnsOnCall = make(map[int]struct {
+			result1 *models.MaintenanceMode
+			result2 error
+		})
+	}
+	fake.maintenanceModeReturnsOnCall[i] = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMaintenanceController) SetMaintenanceMode(arg1 context.Context, arg2 lager.Logger, arg3 bool, arg4 string) (*models.MaintenanceMode, error) {
+	fake.setMaintenanceModeMutex.Lock()
+	ret, specificReturn := fake.setMaintenanceModeReturnsOnCall[len(fake.setMaintenanceModeArgsForCall)]
+	fake.setMaintenanceModeArgsForCall = append(fake.setMaintenanceModeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetMaintenanceModeStub
+	fakeReturns := fake.setMaintenanceModeReturns
+	fake.recordInvocation("SetMaintenanceMode", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setMaintenanceModeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeMaintenanceController) SetMaintenanceModeCallCount() int {
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	return len(fake.setMaintenanceModeArgsForCall)
+}
+
+func (fake *FakeMaintenanceController) SetMaintenanceModeCalls(stub func(context.Context, lager.Logger, bool, string) (*models.MaintenanceMode, error)) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = stub
+}
+
+func (fake *FakeMaintenanceController) SetMaintenanceModeArgsForCall(i int) (context.Context, lager.Logger, bool, string) {
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	argsForCall := fake.setMaintenanceModeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeMaintenanceController) SetMaintenanceModeReturns(result1 *models.MaintenanceMode, result2 error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = nil
+	fake.setMaintenanceModeReturns = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMaintenanceController) SetMaintenanceModeReturnsOnCall(i int, result1 *models.MaintenanceMode, result2 error) {
+	fake.setMaintenanceModeMutex.Lock()
+	defer fake.setMaintenanceModeMutex.Unlock()
+	fake.SetMaintenanceModeStub = nil
+	if fake.setMaintenanceModeReturnsOnCall == nil {
+		fake.setMaintenanceModeReturnsOnCall = make(map[int]struct {
+			result1 *models.MaintenanceMode
+			result2 error
+		})
+	}
+	fake.setMaintenanceModeReturnsOnCall[i] = struct {
+		result1 *models.MaintenanceMode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMaintenanceController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.enabledMutex.RLock()
+	defer fake.enabledMutex.RUnlock()
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	fake.setMaintenanceModeMutex.RLock()
+	defer fake.setMaintenanceModeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMaintenanceController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.MaintenanceController = new(FakeMaintenanceController)
@@ -157,13 +157,12 @@ type FakeTaskController struct {
 		result1 *models.Task
 		result2 error
 	}
-	TasksStub        func(context.Context, lager.Logger, string, string) ([]*models.Task, error)
+	TasksStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 string
+		arg3 models.TaskFilter
 	}
 	tasksReturns struct {
 		result1 []*models.Task
@@ -824,21 +823,20 @@ func (fake *FakeTaskController) TaskByGuidReturnsOnCall(i int, result1 *models.T
 	}{result1, result2}
 }
 
-func (fake *FakeTaskController) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) ([]*models.Task, error) {
+func (fake *FakeTaskController) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
 	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
 	stub := fake.TasksStub
 	fakeReturns := fake.tasksReturns
-	fake.recordInvocation("Tasks", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Tasks", []interface{}{arg1, arg2, arg3})
 	fake.tasksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -852,17 +850,17 @@ func (fake *FakeTaskController) TasksCallCount() int {
 	return len(fake.tasksArgsForCall)
 }
 
-func (fake *FakeTaskController) TasksCalls(stub func(context.Context, lager.Logger, string, string) ([]*models.Task, error)) {
+func (fake *FakeTaskController) TasksCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)) {
 	fake.tasksMutex.Lock()
 	defer fake.tasksMutex.Unlock()
 	fake.TasksStub = stub
 }
 
-func (fake *FakeTaskController) TasksArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+func (fake *FakeTaskController) TasksArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	argsForCall := fake.tasksArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeTaskController) TasksReturns(result1 []*models.Task, result2 error) {
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/audit"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
@@ -15,6 +16,7 @@ import (
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/serviceclient"
 	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/rep"
 	"github.com/gogo/protobuf/proto"
@@ -35,19 +37,39 @@ func New(
 	auctioneerClient auctioneer.Client,
 	repClientFactory rep.ClientFactory,
 	taskStatMetronNotifier metrics.TaskStatMetronNotifier,
+	encryptionController EncryptionController,
+	configReloader ConfigReloader,
+	actualLRPExplainer ActualLRPExplainer,
+	convergencePlanner ConvergencePlanner,
+	convergenceSafetyValve ConvergenceSafetyValve,
+	maintenanceController MaintenanceController,
+	cellCordonController CellCordonController,
+	disruptionBudgetController DisruptionBudgetController,
+	retirementReservations *controllers.RetirementReservations,
+	cellSummaryController CellSummaryController,
+	authorizationPolicy *middleware.AuthorizationPolicy,
+	rateLimiter *middleware.RateLimiter,
+	auditSink audit.Sink,
+	clock clock.Clock,
 	migrationsDone <-chan struct{},
 	exitChan chan struct{},
-) http.Handler {
-	pingHandler := NewPingHandler()
+) (http.Handler, func(Settings)) {
+	pingHandler := NewPingHandler(maintenanceController)
+	maintenanceHandler := NewMaintenanceHandler(maintenanceController, exitChan)
 	domainHandler := NewDomainHandler(db, exitChan)
 	actualLRPHandler := NewActualLRPHandler(db, exitChan)
+	actualLRPExplanationHandler := NewActualLRPExplanationHandler(actualLRPExplainer, exitChan)
+	convergencePlanHandler := NewConvergencePlanHandler(convergencePlanner, exitChan)
+	convergenceSafetyValveHandler := NewConvergenceSafetyValveHandler(convergenceSafetyValve)
 	actualLRPController := controllers.NewActualLRPLifecycleController(
-		db, db, db, db,
+		db, db, db, db, db,
 		auctioneerClient,
 		serviceClient,
 		repClientFactory,
 		actualHub,
 		actualLRPInstanceHub,
+		maintenanceController,
+		retirementReservations,
 	)
 	evacuationController := controllers.NewEvacuationController(
 		db, db, db, db,
@@ -55,79 +77,142 @@ func New(
 		actualHub,
 		actualLRPInstanceHub,
 	)
-	actualLRPLifecycleHandler := NewActualLRPLifecycleHandler(actualLRPController, exitChan)
-	evacuationHandler := NewEvacuationHandler(evacuationController, exitChan)
+	actualLRPLifecycleHandler := NewActualLRPLifecycleHandler(actualLRPController, db, exitChan)
+	evacuationHandler := NewEvacuationHandler(evacuationController, db, exitChan)
+	disruptionBudgetHandler := NewDisruptionBudgetHandler(disruptionBudgetController, exitChan)
 	desiredLRPHandler := NewDesiredLRPHandler(updateWorkers, db, db, desiredHub, actualHub, actualLRPInstanceHub, auctioneerClient, repClientFactory, serviceClient, exitChan)
-	taskController := controllers.NewTaskController(db, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory, taskHub, taskStatMetronNotifier, maxTaskPlacementRetries)
+	taskController := controllers.NewTaskController(db, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory, taskHub, taskStatMetronNotifier, maxTaskPlacementRetries, nil)
 	taskHandler := NewTaskHandler(taskController, exitChan)
 	lrpGroupEventsHandler := NewLRPGroupEventsHandler(desiredHub, actualHub)
 	taskEventsHandler := NewTaskEventHandler(taskHub)
 	lrpInstanceEventsHandler := NewLRPInstanceEventHandler(desiredHub, actualLRPInstanceHub)
-	cellsHandler := NewCellHandler(serviceClient, exitChan)
+	cellsHandler := NewCellHandler(serviceClient, cellCordonController, exitChan)
+	cellCordonHandler := NewCellCordonHandler(cellCordonController, exitChan)
+	cellSummaryHandler := NewCellSummaryHandler(cellSummaryController, exitChan)
+	encryptionHandler := NewEncryptionHandler(encryptionController, db, exitChan)
+	auditHandler := NewAuditHandler(db, exitChan)
+	configHandler := NewConfigHandler(configReloader)
 
-	actions := rata.Handlers{
+	requests := map[string]middleware.LoggableHandlerFunc{
 		// Ping
-		bbs.PingRoute_r0: middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, pingHandler.Ping), emitter),
+		bbs.PingRoute_r0: pingHandler.Ping,
 
 		// Domains
-		bbs.DomainsRoute_r0:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Domains), emitter)),
-		bbs.UpsertDomainRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, domainHandler.Upsert), emitter)),
+		bbs.DomainsRoute_r0:      domainHandler.Domains,
+		bbs.UpsertDomainRoute_r0: domainHandler.Upsert,
 
 		// Actual LRPs
-		bbs.ActualLRPsRoute_r0:                          route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPs), emitter)),
-		bbs.ActualLRPGroupsRoute_r0:                     route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroups), emitter)),                     // DEPRECATED
-		bbs.ActualLRPGroupsByProcessGuidRoute_r0:        route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroupsByProcessGuid), emitter)),        // DEPRECATED
-		bbs.ActualLRPGroupByProcessGuidAndIndexRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPHandler.ActualLRPGroupByProcessGuidAndIndex), emitter)), // DEPRECATED
+		bbs.ActualLRPsRoute_r0:                          actualLRPHandler.ActualLRPs,
+		bbs.ActualLRPGroupsRoute_r0:                     actualLRPHandler.ActualLRPGroups,                     // DEPRECATED
+		bbs.ActualLRPGroupsByProcessGuidRoute_r0:        actualLRPHandler.ActualLRPGroupsByProcessGuid,        // DEPRECATED
+		bbs.ActualLRPGroupByProcessGuidAndIndexRoute_r0: actualLRPHandler.ActualLRPGroupByProcessGuidAndIndex, // DEPRECATED
+		bbs.ExplainActualLRPRoute_r0:                    actualLRPExplanationHandler.ExplainActualLRP,
 
 		// Actual LRP Lifecycle
-		bbs.ClaimActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.ClaimActualLRP), emitter)),
-		bbs.StartActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.StartActualLRP), emitter)),
-		bbs.CrashActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.CrashActualLRP), emitter)),
-		bbs.RetireActualLRPRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RetireActualLRP), emitter)),
-		bbs.FailActualLRPRoute_r0:   route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.FailActualLRP), emitter)),
-		bbs.RemoveActualLRPRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, actualLRPLifecycleHandler.RemoveActualLRP), emitter)),
+		bbs.ClaimActualLRPRoute_r0:  actualLRPLifecycleHandler.ClaimActualLRP,
+		bbs.StartActualLRPRoute_r0:  actualLRPLifecycleHandler.StartActualLRP,
+		bbs.CrashActualLRPRoute_r0:  actualLRPLifecycleHandler.CrashActualLRP,
+		bbs.RetireActualLRPRoute_r0: RejectInMaintenance(maintenanceController, actualLRPLifecycleHandler.RetireActualLRP),
+		bbs.FailActualLRPRoute_r0:   actualLRPLifecycleHandler.FailActualLRP,
+		bbs.RemoveActualLRPRoute_r0: actualLRPLifecycleHandler.RemoveActualLRP,
 
 		// Evacuation
-		bbs.RemoveEvacuatingActualLRPRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.RemoveEvacuatingActualLRP), emitter)),
-		bbs.EvacuateClaimedActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateClaimedActualLRP), emitter)),
-		bbs.EvacuateCrashedActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateCrashedActualLRP), emitter)),
-		bbs.EvacuateStoppedActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateStoppedActualLRP), emitter)),
-		bbs.EvacuateRunningActualLRPRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, evacuationHandler.EvacuateRunningActualLRP), emitter)),
+		bbs.RemoveEvacuatingActualLRPRoute_r0: evacuationHandler.RemoveEvacuatingActualLRP,
+		bbs.EvacuateClaimedActualLRPRoute_r0:  evacuationHandler.EvacuateClaimedActualLRP,
+		bbs.EvacuateCrashedActualLRPRoute_r0:  evacuationHandler.EvacuateCrashedActualLRP,
+		bbs.EvacuateStoppedActualLRPRoute_r0:  evacuationHandler.EvacuateStoppedActualLRP,
+		bbs.EvacuateRunningActualLRPRoute_r0:  evacuationHandler.EvacuateRunningActualLRP,
 
 		// Desired LRPs
-		bbs.DesiredLRPsRoute_r3:               route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs), emitter)),
-		bbs.DesiredLRPByProcessGuidRoute_r3:   route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid), emitter)),
-		bbs.DesiredLRPsRoute_r2:               route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPs_r2), emitter)),             // DEPRECATED
-		bbs.DesiredLRPByProcessGuidRoute_r2:   route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPByProcessGuid_r2), emitter)), // DEPRECATED
-		bbs.DesiredLRPSchedulingInfosRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesiredLRPSchedulingInfos), emitter)),
-		bbs.DesireDesiredLRPRoute_r2:          route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.DesireDesiredLRP), emitter)),
-		bbs.UpdateDesiredLRPRoute_r0:          route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.UpdateDesiredLRP), emitter)),
-		bbs.RemoveDesiredLRPRoute_r0:          route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, desiredLRPHandler.RemoveDesiredLRP), emitter)),
+		bbs.DesiredLRPsRoute_r3:               desiredLRPHandler.DesiredLRPs,
+		bbs.DesiredLRPByProcessGuidRoute_r3:   desiredLRPHandler.DesiredLRPByProcessGuid,
+		bbs.DesiredLRPsRoute_r2:               desiredLRPHandler.DesiredLRPs_r2,             // DEPRECATED
+		bbs.DesiredLRPByProcessGuidRoute_r2:   desiredLRPHandler.DesiredLRPByProcessGuid_r2, // DEPRECATED
+		bbs.DesiredLRPSchedulingInfosRoute_r0: desiredLRPHandler.DesiredLRPSchedulingInfos,
+		bbs.DisruptionBudgetStatusRoute_r0:    disruptionBudgetHandler.DisruptionBudgetStatus,
+		bbs.DesireDesiredLRPRoute_r2:          RejectInMaintenance(maintenanceController, desiredLRPHandler.DesireDesiredLRP),
+		bbs.UpdateDesiredLRPRoute_r0:          RejectInMaintenance(maintenanceController, desiredLRPHandler.UpdateDesiredLRP),
+		bbs.RemoveDesiredLRPRoute_r0:          RejectInMaintenance(maintenanceController, desiredLRPHandler.RemoveDesiredLRP),
 
 		// Tasks
-		bbs.TasksRoute_r2:         route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks_r2), emitter)),      // DEPRECATED
-		bbs.TaskByGuidRoute_r2:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid_r2), emitter)), // DEPRECATED
-		bbs.TasksRoute_r3:         route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.Tasks), emitter)),
-		bbs.TaskByGuidRoute_r3:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.TaskByGuid), emitter)),
-		bbs.DesireTaskRoute_r2:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DesireTask), emitter)),
-		bbs.StartTaskRoute_r0:     route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.StartTask), emitter)),
-		bbs.CancelTaskRoute_r0:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CancelTask), emitter)),
-		bbs.FailTaskRoute_r0:      route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.FailTask), emitter)),
-		bbs.RejectTaskRoute_r0:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.RejectTask), emitter)),
-		bbs.CompleteTaskRoute_r0:  route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.CompleteTask), emitter)),
-		bbs.ResolvingTaskRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.ResolvingTask), emitter)),
-		bbs.DeleteTaskRoute_r0:    route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, taskHandler.DeleteTask), emitter)),
-
-		// Events
-		bbs.EventStreamRoute_r0:            route(middleware.LogWrap(logger, accessLogger, lrpGroupEventsHandler.Subscribe_r0)),    // DEPRECATED
-		bbs.TaskEventStreamRoute_r0:        route(middleware.LogWrap(logger, accessLogger, taskEventsHandler.Subscribe_r0)),        // DEPRECATED
-		bbs.LrpInstanceEventStreamRoute_r0: route(middleware.LogWrap(logger, accessLogger, lrpInstanceEventsHandler.Subscribe_r0)), // DEPRECATED
-		bbs.LRPGroupEventStreamRoute_r1:    route(middleware.LogWrap(logger, accessLogger, lrpGroupEventsHandler.Subscribe_r1)),
-		bbs.TaskEventStreamRoute_r1:        route(middleware.LogWrap(logger, accessLogger, taskEventsHandler.Subscribe_r1)),
-		bbs.LRPInstanceEventStreamRoute_r1: route(middleware.LogWrap(logger, accessLogger, lrpInstanceEventsHandler.Subscribe_r1)),
+		bbs.TasksRoute_r2:         taskHandler.Tasks_r2,      // DEPRECATED
+		bbs.TaskByGuidRoute_r2:    taskHandler.TaskByGuid_r2, // DEPRECATED
+		bbs.TasksRoute_r3:         taskHandler.Tasks,
+		bbs.TaskByGuidRoute_r3:    taskHandler.TaskByGuid,
+		bbs.DesireTaskRoute_r2:    RejectInMaintenance(maintenanceController, taskHandler.DesireTask),
+		bbs.StartTaskRoute_r0:     taskHandler.StartTask,
+		bbs.CancelTaskRoute_r0:    taskHandler.CancelTask,
+		bbs.FailTaskRoute_r0:      taskHandler.FailTask,
+		bbs.RejectTaskRoute_r0:    taskHandler.RejectTask,
+		bbs.CompleteTaskRoute_r0:  taskHandler.CompleteTask,
+		bbs.ResolvingTaskRoute_r0: taskHandler.ResolvingTask,
+		bbs.DeleteTaskRoute_r0:    taskHandler.DeleteTask,
 
 		// Cells
-		bbs.CellsRoute_r0: route(middleware.RecordLatency(middleware.LogWrap(logger, accessLogger, cellsHandler.Cells), emitter)),
+		bbs.CellsRoute_r0: cellsHandler.Cells,
+
+		// Cell Summaries
+		bbs.CellSummariesRoute_r0: cellSummaryHandler.CellSummaries,
+
+		// Cell Cordons
+		bbs.CellCordonsRoute_r0:  cellCordonHandler.CellCordons,
+		bbs.CordonCellRoute_r0:   cellCordonHandler.CordonCell,
+		bbs.DrainCellRoute_r0:    cellCordonHandler.DrainCell,
+		bbs.UncordonCellRoute_r0: cellCordonHandler.UncordonCell,
+
+		// Encryption
+		bbs.RotateEncryptionKeyRoute_r0: encryptionHandler.RotateEncryptionKey,
+		bbs.EncryptionStatusRoute_r0:    encryptionHandler.EncryptionStatus,
+		bbs.EncryptionKeyUsageRoute_r0:  encryptionHandler.EncryptionKeyUsage,
+
+		// Audit
+		bbs.AuditEntriesRoute_r0: auditHandler.AuditEntries,
+
+		// Config
+		bbs.ReloadConfigRoute_r0: configHandler.ReloadConfig,
+
+		// Convergence
+		bbs.ConvergencePlanRoute_r0:                convergencePlanHandler.ConvergencePlan,
+		bbs.ConvergenceSafetyValveRoute_r0:         convergenceSafetyValveHandler.ConvergenceSafetyValve,
+		bbs.OverrideConvergenceSafetyValveRoute_r0: convergenceSafetyValveHandler.OverrideConvergenceSafetyValve,
+
+		// Maintenance
+		bbs.MaintenanceModeRoute_r0:    maintenanceHandler.MaintenanceMode,
+		bbs.SetMaintenanceModeRoute_r0: maintenanceHandler.SetMaintenanceMode,
+	}
+
+	// event streams are long-lived, so they are left out of the request latency
+	eventStreams := map[string]middleware.LoggableHandlerFunc{
+		bbs.EventStreamRoute_r0:            lrpGroupEventsHandler.Subscribe_r0,    // DEPRECATED
+		bbs.TaskEventStreamRoute_r0:        taskEventsHandler.Subscribe_r0,        // DEPRECATED
+		bbs.LrpInstanceEventStreamRoute_r0: lrpInstanceEventsHandler.Subscribe_r0, // DEPRECATED
+		bbs.LRPGroupEventStreamRoute_r1:    lrpGroupEventsHandler.Subscribe_r1,
+		bbs.TaskEventStreamRoute_r1:        taskEventsHandler.Subscribe_r1,
+		bbs.LRPInstanceEventStreamRoute_r1: lrpInstanceEventsHandler.Subscribe_r1,
+	}
+
+	wrap := func(routeName string, handler middleware.LoggableHandlerFunc) http.HandlerFunc {
+		if auditSink != nil {
+			handler = Audit(clock, auditSink, db, routeName, handler)
+		}
+		if authorizationPolicy != nil {
+			handler = middleware.Authorize(authorizationPolicy, routeName, handler)
+		}
+		return middleware.LogWrap(logger, accessLogger, handler)
+	}
+
+	actions := rata.Handlers{}
+	for routeName, handler := range requests {
+		actions[routeName] = middleware.RecordLatency(wrap(routeName, handler), emitter)
+	}
+	for routeName, handler := range eventStreams {
+		actions[routeName] = wrap(routeName, handler)
+	}
+
+	if rateLimiter != nil {
+		for routeName, handler := range actions {
+			actions[routeName] = middleware.RateLimit(logger, rateLimiter, routeName, handler)
+		}
 	}
 
 	handler, err := rata.NewRouter(bbs.Routes, actions)
@@ -135,16 +220,17 @@ func New(
 		panic("unable to create router: " + err.Error())
 	}
 
+	applySettings := func(settings Settings) {
+		desiredLRPHandler.SetUpdateWorkers(settings.UpdateWorkers)
+		taskController.SetMaxRetries(settings.MaxTaskRetries)
+	}
+
 	return middleware.RecordRequestCount(
 		UnavailableWrap(handler,
 			migrationsDone,
 		),
 		emitter,
-	)
-}
-
-func route(f http.HandlerFunc) http.Handler {
-	return f
+	), applySettings
 }
 
 func parseRequest(logger lager.Logger, req *http.Request, request MessageValidator) error {
@@ -154,7 +240,7 @@ func parseRequest(logger lager.Logger, req *http.Request, request MessageValidat
 		return models.ErrUnknownError
 	}
 
-	err = request.Unmarshal(data)
+	err = middleware.UnmarshalRequest(req, data, request)
 	if err != nil {
 		logger.Error("failed-to-parse-request-body", err)
 		return models.ErrBadRequest
@@ -178,14 +264,14 @@ func exitIfUnrecoverable(logger lager.Logger, exitCh chan<- struct{}, err *model
 	}
 }
 
-func writeResponse(w http.ResponseWriter, message proto.Message) {
-	responseBytes, err := proto.Marshal(message)
+func writeResponse(w http.ResponseWriter, req *http.Request, message proto.Message) {
+	responseBytes, contentType, err := middleware.MarshalResponse(req, message)
 	if err != nil {
-		panic("Unable to encode Proto: " + err.Error())
+		panic("Unable to encode response: " + err.Error())
 	}
 
 	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
-	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(http.StatusOK)
 
 	w.Write(responseBytes)
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter -o fake_controllers/fake_maintenance_controller.go . MaintenanceController
+type MaintenanceController interface {
+	MaintenanceMode(ctx context.Context, logger lager.Logger) (*models.MaintenanceMode, error)
+	Enabled(ctx context.Context, logger lager.Logger) bool
+	SetMaintenanceMode(ctx context.Context, logger lager.Logger, enabled bool, reason string) (*models.MaintenanceMode, error)
+}
+
+type MaintenanceHandler struct {
+	controller MaintenanceController
+	exitChan   chan<- struct{}
+}
+
+func NewMaintenanceHandler(controller MaintenanceController, exitChan chan<- struct{}) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		controller: controller,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *MaintenanceHandler) MaintenanceMode(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("maintenance-mode")
+
+	response := &models.MaintenanceModeResponse{}
+	response.MaintenanceMode, err = h.controller.MaintenanceMode(req.Context(), logger)
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *MaintenanceHandler) SetMaintenanceMode(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	var err error
+	logger = logger.Session("set-maintenance-mode")
+
+	request := &models.SetMaintenanceModeRequest{}
+	response := &models.MaintenanceModeResponse{}
+
+	err = parseRequest(logger, req, request)
+	if err == nil {
+		// maintenance mode spans every domain
+		if allowedDomains(req) != nil {
+			err = models.ErrForbidden
+		} else {
+			response.MaintenanceMode, err = h.controller.SetMaintenanceMode(req.Context(), logger, request.Enabled, request.Reason)
+		}
+	}
+
+	response.Error = models.ConvertError(err)
+
+	writeResponse(w, req, response)
+	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+// RejectInMaintenance responds with an InMaintenance error while the BBS is in
+// maintenance mode. It wraps the handlers of the routes that create, retire or
+// auction work, inside LogWrap, so that rejected requests are logged and
+// timed like any other.
+func RejectInMaintenance(controller MaintenanceController, handler middleware.LoggableHandlerFunc) middleware.LoggableHandlerFunc {
+	return func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+		if controller.Enabled(r.Context(), logger) {
+			logger.Session("maintenance").Info("rejected", lager.Data{"request": r.URL.String()})
+			// every BBS response carries its error in field 1, so clients
+			// decode it as the response they expect
+			writeResponse(w, r, &models.UpsertDomainResponse{Error: models.ErrInMaintenance})
+			return
+		}
+
+		handler(logger, w, r)
+	}
+}
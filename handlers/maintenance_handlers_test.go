@@ -0,0 +1,186 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Maintenance Handlers", func() {
+	var (
+		logger                    *lagertest.TestLogger
+		fakeMaintenanceController *fake_controllers.FakeMaintenanceController
+		responseRecorder          *httptest.ResponseRecorder
+		handler                   *handlers.MaintenanceHandler
+		exitCh                    chan struct{}
+		mode                      *models.MaintenanceMode
+	)
+
+	BeforeEach(func() {
+		fakeMaintenanceController = new(fake_controllers.FakeMaintenanceController)
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		handler = handlers.NewMaintenanceHandler(fakeMaintenanceController, exitCh)
+
+		mode = &models.MaintenanceMode{Enabled: true, Reason: "database upgrade", UpdatedAt: 1234}
+		fakeMaintenanceController.MaintenanceModeReturns(mode, nil)
+		fakeMaintenanceController.SetMaintenanceModeReturns(mode, nil)
+	})
+
+	Describe("MaintenanceMode", func() {
+		JustBeforeEach(func() {
+			handler.MaintenanceMode(logger, responseRecorder, newTestRequest(""))
+		})
+
+		It("returns the maintenance mode", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.MaintenanceModeResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.MaintenanceMode).To(Equal(mode))
+		})
+
+		Context("when loading the mode fails", func() {
+			BeforeEach(func() {
+				fakeMaintenanceController.MaintenanceModeReturns(nil, errors.New("boom"))
+			})
+
+			It("responds with the error", func() {
+				response := &models.MaintenanceModeResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Message).To(Equal("boom"))
+			})
+		})
+	})
+
+	Describe("SetMaintenanceMode", func() {
+		var (
+			requestBody interface{}
+			request     *http.Request
+		)
+
+		BeforeEach(func() {
+			requestBody = &models.SetMaintenanceModeRequest{Enabled: true, Reason: "database upgrade"}
+			request = nil
+		})
+
+		JustBeforeEach(func() {
+			if request == nil {
+				request = newTestRequest(requestBody)
+			}
+			handler.SetMaintenanceMode(logger, responseRecorder, request)
+		})
+
+		It("sets the maintenance mode", func() {
+			Expect(fakeMaintenanceController.SetMaintenanceModeCallCount()).To(Equal(1))
+			_, _, enabled, reason := fakeMaintenanceController.SetMaintenanceModeArgsForCall(0)
+			Expect(enabled).To(BeTrue())
+			Expect(reason).To(Equal("database upgrade"))
+
+			response := &models.MaintenanceModeResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(BeNil())
+			Expect(response.MaintenanceMode).To(Equal(mode))
+		})
+
+		Context("when enabling without a reason", func() {
+			BeforeEach(func() {
+				requestBody = &models.SetMaintenanceModeRequest{Enabled: true}
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(fakeMaintenanceController.SetMaintenanceModeCallCount()).To(Equal(0))
+				response := &models.MaintenanceModeResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the client is restricted to domains", func() {
+			BeforeEach(func() {
+				request = newTestRequest(requestBody)
+				request = request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+			})
+
+			It("responds with a forbidden error", func() {
+				Expect(fakeMaintenanceController.SetMaintenanceModeCallCount()).To(Equal(0))
+				response := &models.MaintenanceModeResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("RejectInMaintenance", func() {
+		var wrapped middleware.LoggableHandlerFunc
+
+		BeforeEach(func() {
+			wrapped = handlers.RejectInMaintenance(fakeMaintenanceController, func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+			})
+		})
+
+		Context("when the BBS is in maintenance mode", func() {
+			BeforeEach(func() {
+				fakeMaintenanceController.EnabledReturns(true)
+			})
+
+			It("rejects the requests", func() {
+				wrapped(logger, responseRecorder, newTestRequest(""))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := &models.TaskLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(Equal(models.ErrInMaintenance))
+			})
+		})
+
+		Context("when the BBS is not in maintenance mode", func() {
+			It("passes the requests on", func() {
+				wrapped(logger, responseRecorder, newTestRequest(""))
+
+				Expect(responseRecorder.Code).To(Equal(http.StatusTeapot))
+			})
+		})
+	})
+})
+
+var _ = Describe("Ping Handler", func() {
+	var (
+		fakeMaintenanceController *fake_controllers.FakeMaintenanceController
+		responseRecorder          *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		fakeMaintenanceController = new(fake_controllers.FakeMaintenanceController)
+		fakeMaintenanceController.EnabledReturns(true)
+		responseRecorder = httptest.NewRecorder()
+
+		handler := handlers.NewPingHandler(fakeMaintenanceController)
+		handler.Ping(lagertest.NewTestLogger("test"), responseRecorder, newTestRequest(""))
+	})
+
+	It("reports the maintenance mode", func() {
+		response := &models.PingResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Available).To(BeTrue())
+		Expect(response.Maintenance).To(BeTrue())
+	})
+})
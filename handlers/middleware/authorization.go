@@ -0,0 +1,233 @@
+package middleware
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"github.com/tedsuo/rata"
+)
+
+// AllRoutes grants a role access to every route.
+const AllRoutes = "*"
+
+// AuthorizationPolicy maps client certificate identities to roles and roles
+// to the rata routes they may call.
+type AuthorizationPolicy struct {
+	Identities []IdentityRule      `json:"identities"`
+	Roles      map[string][]string `json:"roles"`
+}
+
+// IdentityRule grants Role to client certificates matching every non-empty
+// field. Fields are matched with path.Match patterns; SAN is matched against
+// the DNS names, email addresses, IP addresses and URIs of the certificate.
+// When Domains is set, the rule only grants access to records in those domains.
+type IdentityRule struct {
+	Role               string   `json:"role"`
+	CommonName         string   `json:"common_name,omitempty"`
+	OrganizationalUnit string   `json:"organizational_unit,omitempty"`
+	SAN                string   `json:"san,omitempty"`
+	Domains            []string `json:"domains,omitempty"`
+}
+
+type allowedDomainsKey struct{}
+
+// WithAllowedDomains restricts the request with the returned context to the
+// given domains.
+func WithAllowedDomains(ctx context.Context, domains []string) context.Context {
+	return context.WithValue(ctx, allowedDomainsKey{}, domains)
+}
+
+// AllowedDomains returns the domains a request is restricted to, or nil when
+// it may access every domain.
+func AllowedDomains(ctx context.Context) []string {
+	domains, _ := ctx.Value(allowedDomainsKey{}).([]string)
+	return domains
+}
+
+func LoadAuthorizationPolicy(policyPath string) (*AuthorizationPolicy, error) {
+	policyFile, err := os.Open(policyPath)
+	if err != nil {
+		return nil, err
+	}
+	defer policyFile.Close()
+
+	policy := &AuthorizationPolicy{}
+	err = json.NewDecoder(policyFile).Decode(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *AuthorizationPolicy) Validate(routes rata.Routes) error {
+	routeNames := map[string]bool{}
+	for _, route := range routes {
+		routeNames[route.Name] = true
+	}
+
+	for role, roleRoutes := range p.Roles {
+		for _, routeName := range roleRoutes {
+			if routeName != AllRoutes && !routeNames[routeName] {
+				return fmt.Errorf("Role %q allows unknown route %q", role, routeName)
+			}
+		}
+	}
+
+	for i, rule := range p.Identities {
+		if _, ok := p.Roles[rule.Role]; !ok {
+			return fmt.Errorf("Identity %d has unknown role %q", i, rule.Role)
+		}
+
+		if rule.CommonName == "" && rule.OrganizationalUnit == "" && rule.SAN == "" {
+			return fmt.Errorf("Identity %d must match a common_name, organizational_unit or san", i)
+		}
+
+		for _, pattern := range []string{rule.CommonName, rule.OrganizationalUnit, rule.SAN} {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("Identity %d has invalid pattern %q", i, pattern)
+			}
+		}
+
+		for _, domain := range rule.Domains {
+			if domain == "" {
+				return fmt.Errorf("Identity %d has an empty domain", i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RolesFor returns the roles of every identity rule the certificate matches.
+func (p *AuthorizationPolicy) RolesFor(cert *x509.Certificate) []string {
+	roles := []string{}
+	for _, rule := range p.Identities {
+		if rule.matches(cert) {
+			roles = append(roles, rule.Role)
+		}
+	}
+	return roles
+}
+
+// DomainsFor returns the domains the certificate is restricted to, or nil
+// when any rule it matches does not restrict domains.
+func (p *AuthorizationPolicy) DomainsFor(cert *x509.Certificate) []string {
+	var domains []string
+	for _, rule := range p.Identities {
+		if !rule.matches(cert) {
+			continue
+		}
+		if len(rule.Domains) == 0 {
+			return nil
+		}
+		domains = append(domains, rule.Domains...)
+	}
+	return domains
+}
+
+// Allows reports whether any of the roles may call the route.
+func (p *AuthorizationPolicy) Allows(roles []string, routeName string) bool {
+	for _, role := range roles {
+		for _, allowed := range p.Roles[role] {
+			if allowed == AllRoutes || allowed == routeName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (rule IdentityRule) matches(cert *x509.Certificate) bool {
+	if rule.CommonName != "" && !matchesAny(rule.CommonName, cert.Subject.CommonName) {
+		return false
+	}
+
+	if rule.OrganizationalUnit != "" && !matchesAny(rule.OrganizationalUnit, cert.Subject.OrganizationalUnit...) {
+		return false
+	}
+
+	if rule.SAN != "" {
+		sans := []string{}
+		sans = append(sans, cert.DNSNames...)
+		sans = append(sans, cert.EmailAddresses...)
+		for _, ip := range cert.IPAddresses {
+			sans = append(sans, ip.String())
+		}
+		for _, uri := range cert.URIs {
+			sans = append(sans, uri.String())
+		}
+		if !matchesAny(rule.SAN, sans...) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchesAny(pattern string, values ...string) bool {
+	for _, value := range values {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize only passes requests on to the handler when the client
+// certificate has a role that the policy allows to call the route. Requests
+// from certificates restricted to domains carry them in their context.
+func Authorize(policy *AuthorizationPolicy, routeName string, handler LoggableHandlerFunc) LoggableHandlerFunc {
+	return func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+		roles := []string{}
+		subject := ""
+		var domains []string
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			cert := r.TLS.PeerCertificates[0]
+			subject = cert.Subject.String()
+			roles = policy.RolesFor(cert)
+			domains = policy.DomainsFor(cert)
+		}
+
+		if !policy.Allows(roles, routeName) {
+			logger.Session("authorize").Info("forbidden", lager.Data{
+				"route":       routeName,
+				"subject":     subject,
+				"roles":       roles,
+				"remote_addr": r.RemoteAddr,
+			})
+			writeError(w, r, http.StatusForbidden, models.ErrForbidden)
+			return
+		}
+
+		if domains != nil {
+			r = r.WithContext(WithAllowedDomains(r.Context(), domains))
+		}
+
+		handler(logger, w, r)
+	}
+}
+
+// writeError responds with a message holding only the error. Every BBS
+// response carries its error in field 1, and in the "error" field of JSON
+// responses, so clients decode it as the response they expect.
+func writeError(w http.ResponseWriter, r *http.Request, statusCode int, bbsErr *models.Error) {
+	responseBytes, contentType, err := MarshalResponse(r, &models.UpsertDomainResponse{Error: bbsErr})
+	if err != nil {
+		panic("Unable to encode response: " + err.Error())
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(responseBytes)))
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+
+	w.Write(responseBytes)
+}
@@ -0,0 +1,262 @@
+package middleware_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Authorization", func() {
+	var (
+		policy *middleware.AuthorizationPolicy
+		routes rata.Routes
+	)
+
+	BeforeEach(func() {
+		routes = rata.Routes{
+			{Path: "/v1/claim", Method: "POST", Name: "ClaimActualLRP"},
+			{Path: "/v1/tasks", Method: "POST", Name: "Tasks"},
+			{Path: "/v1/tasks/delete", Method: "POST", Name: "DeleteTask"},
+		}
+
+		policy = &middleware.AuthorizationPolicy{
+			Identities: []middleware.IdentityRule{
+				{Role: "cell", OrganizationalUnit: "cell"},
+				{Role: "read-only", CommonName: "reader-*"},
+				{Role: "admin", SAN: "spiffe://cf/admin"},
+				{Role: "read-only", SAN: "10.0.0.1"},
+			},
+			Roles: map[string][]string{
+				"cell":      {"ClaimActualLRP", "Tasks"},
+				"read-only": {"Tasks"},
+				"admin":     {middleware.AllRoutes},
+			},
+		}
+	})
+
+	Describe("LoadAuthorizationPolicy", func() {
+		var policyPath string
+
+		BeforeEach(func() {
+			policyFile, err := ioutil.TempFile("", "authorization-policy")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = policyFile.WriteString(`{
+				"identities": [{"role": "cell", "organizational_unit": "cell"}],
+				"roles": {"cell": ["ClaimActualLRP"]}
+			}`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policyFile.Close()).To(Succeed())
+			policyPath = policyFile.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(policyPath)).To(Succeed())
+		})
+
+		It("parses the policy file", func() {
+			policy, err := middleware.LoadAuthorizationPolicy(policyPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).To(Equal(&middleware.AuthorizationPolicy{
+				Identities: []middleware.IdentityRule{{Role: "cell", OrganizationalUnit: "cell"}},
+				Roles:      map[string][]string{"cell": {"ClaimActualLRP"}},
+			}))
+		})
+
+		It("fails when the file does not exist", func() {
+			_, err := middleware.LoadAuthorizationPolicy("does-not-exist")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid policy", func() {
+			Expect(policy.Validate(routes)).To(Succeed())
+		})
+
+		It("rejects unknown routes", func() {
+			policy.Roles["cell"] = append(policy.Roles["cell"], "Bogus")
+			Expect(policy.Validate(routes)).To(MatchError(`Role "cell" allows unknown route "Bogus"`))
+		})
+
+		It("rejects identities with unknown roles", func() {
+			policy.Identities[0].Role = "bogus"
+			Expect(policy.Validate(routes)).To(MatchError(`Identity 0 has unknown role "bogus"`))
+		})
+
+		It("rejects identities that match everything", func() {
+			policy.Identities[0].OrganizationalUnit = ""
+			Expect(policy.Validate(routes)).To(MatchError("Identity 0 must match a common_name, organizational_unit or san"))
+		})
+
+		It("rejects invalid patterns", func() {
+			policy.Identities[1].CommonName = "reader-["
+			Expect(policy.Validate(routes)).To(MatchError(`Identity 1 has invalid pattern "reader-["`))
+		})
+	})
+
+	Describe("RolesFor", func() {
+		It("matches the organizational unit", func() {
+			cert := &x509.Certificate{Subject: pkix.Name{OrganizationalUnit: []string{"diego", "cell"}}}
+			Expect(policy.RolesFor(cert)).To(ConsistOf("cell"))
+		})
+
+		It("matches the common name against a pattern", func() {
+			cert := &x509.Certificate{Subject: pkix.Name{CommonName: "reader-1"}}
+			Expect(policy.RolesFor(cert)).To(ConsistOf("read-only"))
+		})
+
+		It("matches URI and IP subject alternative names", func() {
+			adminURI, err := url.Parse("spiffe://cf/admin")
+			Expect(err).NotTo(HaveOccurred())
+			cert := &x509.Certificate{URIs: []*url.URL{adminURI}, IPAddresses: []net.IP{net.ParseIP("10.0.0.1")}}
+			Expect(policy.RolesFor(cert)).To(ConsistOf("admin", "read-only"))
+		})
+
+		It("returns no roles for unknown identities", func() {
+			cert := &x509.Certificate{Subject: pkix.Name{CommonName: "stranger"}, DNSNames: []string{"stranger.example.com"}}
+			Expect(policy.RolesFor(cert)).To(BeEmpty())
+		})
+	})
+
+	Describe("DomainsFor", func() {
+		BeforeEach(func() {
+			policy.Identities = append(policy.Identities,
+				middleware.IdentityRule{Role: "read-only", CommonName: "tenant-a", Domains: []string{"domain-a"}},
+				middleware.IdentityRule{Role: "read-only", OrganizationalUnit: "tenant-b", Domains: []string{"domain-b"}},
+			)
+		})
+
+		It("returns nil for identities that are not restricted", func() {
+			cert := &x509.Certificate{Subject: pkix.Name{OrganizationalUnit: []string{"cell"}}}
+			Expect(policy.DomainsFor(cert)).To(BeNil())
+		})
+
+		It("returns the domains of the matching rules", func() {
+			cert := &x509.Certificate{Subject: pkix.Name{CommonName: "tenant-a", OrganizationalUnit: []string{"tenant-b"}}}
+			Expect(policy.DomainsFor(cert)).To(ConsistOf("domain-a", "domain-b"))
+		})
+
+		It("returns nil when any matching rule is not restricted", func() {
+			cert := &x509.Certificate{Subject: pkix.Name{CommonName: "tenant-a", OrganizationalUnit: []string{"cell"}}}
+			Expect(policy.DomainsFor(cert)).To(BeNil())
+		})
+
+		It("rejects empty domains", func() {
+			policy.Identities[4].Domains = []string{""}
+			Expect(policy.Validate(routes)).To(MatchError("Identity 4 has an empty domain"))
+		})
+	})
+
+	Describe("Authorize", func() {
+		var (
+			logger   *lagertest.TestLogger
+			called   bool
+			domains  []string
+			recorder *httptest.ResponseRecorder
+			request  *http.Request
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+			called = false
+			domains = nil
+			recorder = httptest.NewRecorder()
+
+			var err error
+			request, err = http.NewRequest("POST", "http://example.com/v1/claim", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		serve := func(routeName string, cert *x509.Certificate) {
+			if cert != nil {
+				request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+			}
+			handler := func(logger lager.Logger, w http.ResponseWriter, r *http.Request) {
+				called = true
+				domains = middleware.AllowedDomains(r.Context())
+			}
+			middleware.Authorize(policy, routeName, handler)(logger, recorder, request)
+		}
+
+		expectForbidden := func() {
+			Expect(called).To(BeFalse())
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/x-protobuf"))
+
+			response := &models.ActualLRPLifecycleResponse{}
+			Expect(response.Unmarshal(recorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(Equal(models.ErrForbidden))
+		}
+
+		It("passes requests from allowed roles to the handler", func() {
+			serve("ClaimActualLRP", &x509.Certificate{Subject: pkix.Name{OrganizationalUnit: []string{"cell"}}})
+			Expect(called).To(BeTrue())
+		})
+
+		It("does not restrict the domains of unrestricted identities", func() {
+			serve("ClaimActualLRP", &x509.Certificate{Subject: pkix.Name{OrganizationalUnit: []string{"cell"}}})
+			Expect(called).To(BeTrue())
+			Expect(domains).To(BeNil())
+		})
+
+		It("passes the domains of restricted identities to the handler", func() {
+			policy.Identities = append(policy.Identities, middleware.IdentityRule{Role: "read-only", CommonName: "tenant-a", Domains: []string{"domain-a"}})
+			serve("Tasks", &x509.Certificate{Subject: pkix.Name{CommonName: "tenant-a"}})
+			Expect(called).To(BeTrue())
+			Expect(domains).To(Equal([]string{"domain-a"}))
+		})
+
+		It("allows every route to roles with all routes", func() {
+			adminURI, err := url.Parse("spiffe://cf/admin")
+			Expect(err).NotTo(HaveOccurred())
+			serve("DeleteTask", &x509.Certificate{URIs: []*url.URL{adminURI}})
+			Expect(called).To(BeTrue())
+		})
+
+		It("forbids routes the role may not call", func() {
+			serve("ClaimActualLRP", &x509.Certificate{Subject: pkix.Name{CommonName: "reader-1"}})
+			expectForbidden()
+			Expect(logger).To(gbytes.Say("test.authorize.forbidden"))
+			Expect(logger).To(gbytes.Say(`"route":"ClaimActualLRP"`))
+		})
+
+		It("forbids clients without a known identity", func() {
+			serve("Tasks", &x509.Certificate{Subject: pkix.Name{CommonName: "stranger"}})
+			expectForbidden()
+		})
+
+		It("forbids requests without a client certificate", func() {
+			serve("Tasks", nil)
+			expectForbidden()
+		})
+
+		It("responds with JSON to clients that accept it", func() {
+			request.Header.Set("Accept", "application/json")
+			serve("Tasks", nil)
+
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			response := &models.TasksResponse{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), response)).To(Succeed())
+			Expect(response.Error).To(Equal(models.ErrForbidden))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/bbs"
+	"github.com/gogo/protobuf/proto"
+)
+
+// IsJSON reports whether the body of the request is JSON rather than
+// protobuf.
+func IsJSON(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(bbs.ContentTypeHeader))
+	return err == nil && mediaType == bbs.JSONContentType
+}
+
+// WantsJSON reports whether the response to the request should be JSON. The
+// first of JSON and protobuf listed in the Accept header wins, and when it
+// lists neither the response matches the body of the request.
+func WantsJSON(r *http.Request) bool {
+	for _, accepted := range strings.Split(r.Header.Get(bbs.AcceptHeader), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case bbs.JSONContentType:
+			return true
+		case bbs.ProtoContentType:
+			return false
+		}
+	}
+
+	return IsJSON(r)
+}
+
+// UnmarshalRequest decodes the body of the request as JSON or protobuf,
+// according to its Content-Type.
+func UnmarshalRequest(r *http.Request, body []byte, message proto.Message) error {
+	if IsJSON(r) {
+		return json.Unmarshal(body, message)
+	}
+	return proto.Unmarshal(body, message)
+}
+
+// MarshalResponse encodes the response to the request as JSON or protobuf,
+// according to WantsJSON, and returns it with its Content-Type.
+func MarshalResponse(r *http.Request, message proto.Message) ([]byte, string, error) {
+	if WantsJSON(r) {
+		body, err := json.Marshal(message)
+		return body, bbs.JSONContentType, err
+	}
+
+	body, err := proto.Marshal(message)
+	return body, bbs.ProtoContentType, err
+}
+
+// UnmarshalResponse decodes a response body written with the given
+// Content-Type.
+func UnmarshalResponse(contentType string, body []byte, message proto.Message) error {
+	if contentType == bbs.JSONContentType {
+		return json.Unmarshal(body, message)
+	}
+	return proto.Unmarshal(body, message)
+}
@@ -0,0 +1,115 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"github.com/gogo/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encoding", func() {
+	newRequest := func(contentType, accept string, body []byte) *http.Request {
+		request, err := http.NewRequest("POST", "http://example.com/v1/tasks", bytes.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		if contentType != "" {
+			request.Header.Set("Content-Type", contentType)
+		}
+		if accept != "" {
+			request.Header.Set("Accept", accept)
+		}
+		return request
+	}
+
+	DescribeTable("WantsJSON",
+		func(contentType, accept string, wantsJSON bool) {
+			Expect(middleware.WantsJSON(newRequest(contentType, accept, nil))).To(Equal(wantsJSON))
+		},
+		Entry("protobuf requests", "application/x-protobuf", "", false),
+		Entry("requests without a content type", "", "", false),
+		Entry("JSON requests", "application/json", "", true),
+		Entry("JSON requests with parameters", "application/json; charset=utf-8", "", true),
+		Entry("JSON requests accepting anything", "application/json", "*/*", true),
+		Entry("requests accepting JSON", "application/x-protobuf", "application/json", true),
+		Entry("requests preferring protobuf", "application/json", "application/x-protobuf, application/json", false),
+		Entry("requests preferring JSON", "", "text/html, application/json;q=0.9, application/x-protobuf", true),
+	)
+
+	// Every message must decode to the same value from JSON as from protobuf.
+	roundTrips := []proto.Message{
+		&models.DesireLRPRequest{DesiredLrp: model_helpers.NewValidDesiredLRP("some-guid")},
+		&models.DesireTaskRequest{TaskGuid: "some-guid", Domain: "some-domain", TaskDefinition: model_helpers.NewValidTaskDefinition()},
+		&models.ActualLRPsRequest{Domain: "some-domain", ProcessGuid: "some-guid"},
+		&models.UpdateDesiredLRPRequest{ProcessGuid: "some-guid", Update: &models.DesiredLRPUpdate{}},
+		&models.TasksResponse{Tasks: []*models.Task{model_helpers.NewValidTask("some-guid")}},
+		&models.ActualLRPsResponse{ActualLrps: []*models.ActualLRP{model_helpers.NewValidActualLRP("some-guid", 1)}},
+		&models.DesiredLRPResponse{DesiredLrp: model_helpers.NewValidDesiredLRP("some-guid")},
+		&models.TaskLifecycleResponse{Error: models.ErrResourceNotFound},
+	}
+	roundTrips[2].(*models.ActualLRPsRequest).SetIndex(0)
+	roundTrips[3].(*models.UpdateDesiredLRPRequest).Update.SetInstances(3)
+
+	newMessage := func(message proto.Message) proto.Message {
+		return reflect.New(reflect.TypeOf(message).Elem()).Interface().(proto.Message)
+	}
+
+	Describe("UnmarshalRequest", func() {
+		It("decodes JSON bodies to the same message as protobuf bodies", func() {
+			for _, message := range roundTrips {
+				protoBody, err := proto.Marshal(message)
+				Expect(err).NotTo(HaveOccurred())
+				fromProto := newMessage(message)
+				Expect(middleware.UnmarshalRequest(newRequest("application/x-protobuf", "", protoBody), protoBody, fromProto)).To(Succeed())
+
+				jsonBody, err := json.Marshal(message)
+				Expect(err).NotTo(HaveOccurred())
+				fromJSON := newMessage(message)
+				Expect(middleware.UnmarshalRequest(newRequest("application/json", "", jsonBody), jsonBody, fromJSON)).To(Succeed())
+
+				Expect(fromJSON).To(Equal(fromProto), "%T", message)
+				Expect(fromJSON).To(Equal(message), "%T", message)
+			}
+		})
+
+		It("fails on bodies that do not match the content type", func() {
+			body, err := proto.Marshal(roundTrips[0])
+			Expect(err).NotTo(HaveOccurred())
+			request := &models.DesireLRPRequest{}
+			Expect(middleware.UnmarshalRequest(newRequest("application/json", "", body), body, request)).NotTo(Succeed())
+		})
+	})
+
+	Describe("MarshalResponse", func() {
+		It("encodes JSON that decodes to the same message as the protobuf response", func() {
+			for _, message := range roundTrips {
+				protoBody, contentType, err := middleware.MarshalResponse(newRequest("", "", nil), message)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(contentType).To(Equal("application/x-protobuf"))
+				fromProto := newMessage(message)
+				Expect(middleware.UnmarshalResponse(contentType, protoBody, fromProto)).To(Succeed())
+
+				jsonBody, contentType, err := middleware.MarshalResponse(newRequest("", "application/json", nil), message)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(contentType).To(Equal("application/json"))
+				fromJSON := newMessage(message)
+				Expect(middleware.UnmarshalResponse(contentType, jsonBody, fromJSON)).To(Succeed())
+
+				Expect(fromJSON).To(Equal(fromProto), "%T", message)
+			}
+		})
+
+		It("encodes errors in the error field", func() {
+			body, _, err := middleware.MarshalResponse(newRequest("application/json", "", nil), &models.TaskLifecycleResponse{Error: models.ErrResourceNotFound})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"error": {"type": "ResourceNotFound", "message": "the requested resource could not be found"}}`))
+		})
+	})
+})
@@ -0,0 +1,316 @@
+package middleware
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/tedsuo/rata"
+)
+
+// RetryAfterHeader tells a rate limited client how many seconds to wait
+// before retrying.
+const RetryAfterHeader = "Retry-After"
+
+// bucketEvictionInterval is how often the limiter looks for idle buckets to
+// evict.
+const bucketEvictionInterval = time.Minute
+
+// RateLimitPolicy limits how often, and how many at once, clients may call
+// named groups of rata routes.
+type RateLimitPolicy struct {
+	RouteGroups map[string][]string `json:"route_groups"`
+	Limits      []RateLimitRule     `json:"limits"`
+}
+
+// RateLimitRule applies to requests to the routes of RouteGroup from client
+// certificates matching every non-empty identity field, which are matched as
+// in IdentityRule. A limit without identity fields applies to every client.
+//
+// RequestsPerSecond and Burst configure a token bucket, and MaxInFlight caps
+// the number of concurrent requests. Zero disables either. When PerClient is
+// set every certificate subject gets its own bucket and in-flight count,
+// otherwise all matching clients share them.
+type RateLimitRule struct {
+	RouteGroup         string  `json:"route_group"`
+	CommonName         string  `json:"common_name,omitempty"`
+	OrganizationalUnit string  `json:"organizational_unit,omitempty"`
+	SAN                string  `json:"san,omitempty"`
+	PerClient          bool    `json:"per_client,omitempty"`
+	RequestsPerSecond  float64 `json:"requests_per_second,omitempty"`
+	Burst              int     `json:"burst,omitempty"`
+	MaxInFlight        int     `json:"max_in_flight,omitempty"`
+}
+
+func LoadRateLimitPolicy(policyPath string) (*RateLimitPolicy, error) {
+	policyFile, err := os.Open(policyPath)
+	if err != nil {
+		return nil, err
+	}
+	defer policyFile.Close()
+
+	policy := &RateLimitPolicy{}
+	err = json.NewDecoder(policyFile).Decode(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *RateLimitPolicy) Validate(routes rata.Routes) error {
+	routeNames := map[string]bool{}
+	for _, route := range routes {
+		routeNames[route.Name] = true
+	}
+
+	for group, groupRoutes := range p.RouteGroups {
+		for _, routeName := range groupRoutes {
+			if routeName != AllRoutes && !routeNames[routeName] {
+				return fmt.Errorf("Route group %q has unknown route %q", group, routeName)
+			}
+		}
+	}
+
+	for i, limit := range p.Limits {
+		if _, ok := p.RouteGroups[limit.RouteGroup]; !ok {
+			return fmt.Errorf("Limit %d has unknown route group %q", i, limit.RouteGroup)
+		}
+
+		if limit.RequestsPerSecond < 0 || limit.Burst < 0 || limit.MaxInFlight < 0 {
+			return fmt.Errorf("Limit %d must not be negative", i)
+		}
+
+		if limit.RequestsPerSecond == 0 && limit.MaxInFlight == 0 {
+			return fmt.Errorf("Limit %d must set requests_per_second or max_in_flight", i)
+		}
+
+		for _, pattern := range []string{limit.CommonName, limit.OrganizationalUnit, limit.SAN} {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("Limit %d has invalid pattern %q", i, pattern)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (limit RateLimitRule) identityRule() IdentityRule {
+	return IdentityRule{
+		CommonName:         limit.CommonName,
+		OrganizationalUnit: limit.OrganizationalUnit,
+		SAN:                limit.SAN,
+	}
+}
+
+func (limit RateLimitRule) hasIdentity() bool {
+	return limit.CommonName != "" || limit.OrganizationalUnit != "" || limit.SAN != ""
+}
+
+// burst is the capacity of the token bucket, which defaults to one second of
+// requests.
+func (limit RateLimitRule) burst() float64 {
+	if limit.Burst > 0 {
+		return float64(limit.Burst)
+	}
+	return math.Max(1, math.Ceil(limit.RequestsPerSecond))
+}
+
+// refillWindow is how long an empty bucket takes to fill up again. A bucket
+// that has been idle for longer is no different from a new one.
+func (limit RateLimitRule) refillWindow() time.Duration {
+	if limit.RequestsPerSecond == 0 {
+		return 0
+	}
+	return time.Duration(limit.burst() / limit.RequestsPerSecond * float64(time.Second))
+}
+
+type bucketKey struct {
+	limit  int
+	client string
+}
+
+type bucket struct {
+	tokens   float64
+	updated  time.Time
+	lastUsed time.Time
+	inFlight int
+}
+
+// RateLimiter tracks the token buckets and in-flight requests of a
+// RateLimitPolicy. Buckets without requests in flight are evicted once they
+// have been idle for longer than their refill window, so per client limits
+// do not keep a bucket for every client ever seen.
+type RateLimiter struct {
+	policy      *RateLimitPolicy
+	clock       clock.Clock
+	lock        sync.Mutex
+	buckets     map[bucketKey]*bucket
+	lastEvicted time.Time
+}
+
+func NewRateLimiter(policy *RateLimitPolicy, clock clock.Clock) *RateLimiter {
+	return &RateLimiter{
+		policy:      policy,
+		clock:       clock,
+		buckets:     map[bucketKey]*bucket{},
+		lastEvicted: clock.Now(),
+	}
+}
+
+// BucketCount returns the number of buckets the limiter is tracking.
+func (l *RateLimiter) BucketCount() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return len(l.buckets)
+}
+
+// Acquire admits a request to the route from the certificate, which may be
+// nil, when every applicable limit allows it. The returned release func must
+// be called once the request completes. When the request is not admitted it
+// returns how long the client should wait before retrying.
+func (l *RateLimiter) Acquire(routeName string, cert *x509.Certificate) (func(), time.Duration, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock.Now()
+	if now.Sub(l.lastEvicted) >= bucketEvictionInterval {
+		l.evictIdleBuckets(now)
+	}
+
+	buckets := []*bucket{}
+	consumesToken := []bool{}
+	var retryAfter time.Duration
+
+	for i, limit := range l.policy.Limits {
+		if !l.applies(limit, routeName, cert) {
+			continue
+		}
+
+		key := bucketKey{limit: i}
+		if limit.PerClient && cert != nil {
+			key.client = cert.Subject.String()
+		}
+
+		b, ok := l.buckets[key]
+		if !ok {
+			b = &bucket{tokens: limit.burst(), updated: now}
+			l.buckets[key] = b
+		}
+		b.lastUsed = now
+
+		if limit.RequestsPerSecond > 0 {
+			elapsed := now.Sub(b.updated).Seconds()
+			b.tokens = math.Min(limit.burst(), b.tokens+elapsed*limit.RequestsPerSecond)
+			b.updated = now
+
+			if b.tokens < 1 {
+				wait := time.Duration((1 - b.tokens) / limit.RequestsPerSecond * float64(time.Second))
+				if wait > retryAfter {
+					retryAfter = wait
+				}
+			}
+		}
+
+		if limit.MaxInFlight > 0 && b.inFlight >= limit.MaxInFlight && retryAfter < time.Second {
+			retryAfter = time.Second
+		}
+
+		buckets = append(buckets, b)
+		consumesToken = append(consumesToken, limit.RequestsPerSecond > 0)
+	}
+
+	if retryAfter > 0 {
+		return nil, retryAfter, false
+	}
+
+	for i, b := range buckets {
+		if consumesToken[i] {
+			b.tokens--
+		}
+		b.inFlight++
+	}
+
+	released := false
+	return func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+
+		if released {
+			return
+		}
+		released = true
+
+		now := l.clock.Now()
+		for _, b := range buckets {
+			b.inFlight--
+			b.lastUsed = now
+		}
+	}, 0, true
+}
+
+func (l *RateLimiter) evictIdleBuckets(now time.Time) {
+	for key, b := range l.buckets {
+		if b.inFlight == 0 && now.Sub(b.lastUsed) > l.policy.Limits[key.limit].refillWindow() {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastEvicted = now
+}
+
+func (l *RateLimiter) applies(limit RateLimitRule, routeName string, cert *x509.Certificate) bool {
+	if limit.hasIdentity() && (cert == nil || !limit.identityRule().matches(cert)) {
+		return false
+	}
+
+	for _, groupRoute := range l.policy.RouteGroups[limit.RouteGroup] {
+		if groupRoute == AllRoutes || groupRoute == routeName {
+			return true
+		}
+	}
+	return false
+}
+
+// RateLimit only passes requests on to the handler when the limiter admits
+// them, and otherwise responds with status 429 and a Retry-After header.
+func RateLimit(logger lager.Logger, limiter *RateLimiter, routeName string, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var cert *x509.Certificate
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			cert = r.TLS.PeerCertificates[0]
+		}
+
+		release, retryAfter, ok := limiter.Acquire(routeName, cert)
+		if !ok {
+			subject := ""
+			if cert != nil {
+				subject = cert.Subject.String()
+			}
+			logger.Session("rate-limit").Info("too-many-requests", lager.Data{
+				"route":       routeName,
+				"subject":     subject,
+				"retry_after": retryAfter.String(),
+				"remote_addr": r.RemoteAddr,
+			})
+
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			w.Header().Set(RetryAfterHeader, strconv.Itoa(seconds))
+			writeError(w, r, http.StatusTooManyRequests, models.ErrTooManyRequests)
+			return
+		}
+		defer release()
+
+		handler.ServeHTTP(w, r)
+	}
+}
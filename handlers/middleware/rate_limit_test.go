@@ -0,0 +1,308 @@
+package middleware_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("RateLimit", func() {
+	var (
+		policy    *middleware.RateLimitPolicy
+		fakeClock *fakeclock.FakeClock
+		limiter   *middleware.RateLimiter
+		routes    rata.Routes
+		monitor   *x509.Certificate
+		cell      *x509.Certificate
+	)
+
+	BeforeEach(func() {
+		routes = rata.Routes{
+			{Path: "/v1/desired_lrps/list.r2", Method: "POST", Name: "DesiredLRPs"},
+			{Path: "/v1/tasks/list.r2", Method: "POST", Name: "Tasks"},
+			{Path: "/v1/actual_lrps/start.r1", Method: "POST", Name: "StartActualLRP"},
+		}
+
+		policy = &middleware.RateLimitPolicy{
+			RouteGroups: map[string][]string{
+				"reads":      {"DesiredLRPs", "Tasks"},
+				"heartbeats": {"StartActualLRP"},
+			},
+			Limits: []middleware.RateLimitRule{
+				{RouteGroup: "reads", PerClient: true, RequestsPerSecond: 1, Burst: 2},
+				{RouteGroup: "heartbeats", OrganizationalUnit: "cell", MaxInFlight: 1},
+			},
+		}
+
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		monitor = &x509.Certificate{Subject: pkix.Name{CommonName: "monitor"}}
+		cell = &x509.Certificate{Subject: pkix.Name{CommonName: "cell-1", OrganizationalUnit: []string{"cell"}}}
+	})
+
+	JustBeforeEach(func() {
+		limiter = middleware.NewRateLimiter(policy, fakeClock)
+	})
+
+	Describe("LoadRateLimitPolicy", func() {
+		var policyPath string
+
+		BeforeEach(func() {
+			policyFile, err := ioutil.TempFile("", "rate-limit-policy")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = policyFile.WriteString(`{
+				"route_groups": {"reads": ["DesiredLRPs"]},
+				"limits": [{"route_group": "reads", "common_name": "monitor", "per_client": true, "requests_per_second": 5, "burst": 10, "max_in_flight": 2}]
+			}`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policyFile.Close()).To(Succeed())
+			policyPath = policyFile.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(policyPath)).To(Succeed())
+		})
+
+		It("loads the policy", func() {
+			loaded, err := middleware.LoadRateLimitPolicy(policyPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded).To(Equal(&middleware.RateLimitPolicy{
+				RouteGroups: map[string][]string{"reads": {"DesiredLRPs"}},
+				Limits: []middleware.RateLimitRule{{
+					RouteGroup:        "reads",
+					CommonName:        "monitor",
+					PerClient:         true,
+					RequestsPerSecond: 5,
+					Burst:             10,
+					MaxInFlight:       2,
+				}},
+			}))
+		})
+
+		It("fails when the file does not exist", func() {
+			_, err := middleware.LoadRateLimitPolicy("/does/not/exist")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid policy", func() {
+			Expect(policy.Validate(routes)).To(Succeed())
+		})
+
+		It("rejects unknown routes", func() {
+			policy.RouteGroups["reads"] = []string{"Bogus"}
+			Expect(policy.Validate(routes)).To(MatchError(ContainSubstring(`unknown route "Bogus"`)))
+		})
+
+		It("rejects unknown route groups", func() {
+			policy.Limits[0].RouteGroup = "writes"
+			Expect(policy.Validate(routes)).To(MatchError(ContainSubstring(`unknown route group "writes"`)))
+		})
+
+		It("rejects negative limits", func() {
+			policy.Limits[0].Burst = -1
+			Expect(policy.Validate(routes)).To(MatchError(ContainSubstring("must not be negative")))
+		})
+
+		It("rejects limits that limit nothing", func() {
+			policy.Limits[1].MaxInFlight = 0
+			Expect(policy.Validate(routes)).To(MatchError(ContainSubstring("must set requests_per_second or max_in_flight")))
+		})
+
+		It("rejects invalid patterns", func() {
+			policy.Limits[1].OrganizationalUnit = "["
+			Expect(policy.Validate(routes)).To(MatchError(ContainSubstring("invalid pattern")))
+		})
+	})
+
+	Describe("Acquire", func() {
+		It("admits requests up to the burst", func() {
+			_, _, ok := limiter.Acquire("Tasks", monitor)
+			Expect(ok).To(BeTrue())
+			_, _, ok = limiter.Acquire("DesiredLRPs", monitor)
+			Expect(ok).To(BeTrue())
+
+			_, retryAfter, ok := limiter.Acquire("Tasks", monitor)
+			Expect(ok).To(BeFalse())
+			Expect(retryAfter).To(Equal(time.Second))
+		})
+
+		It("refills the bucket over time", func() {
+			limiter.Acquire("Tasks", monitor)
+			limiter.Acquire("Tasks", monitor)
+
+			fakeClock.Increment(500 * time.Millisecond)
+			_, retryAfter, ok := limiter.Acquire("Tasks", monitor)
+			Expect(ok).To(BeFalse())
+			Expect(retryAfter).To(Equal(500 * time.Millisecond))
+
+			fakeClock.Increment(500 * time.Millisecond)
+			_, _, ok = limiter.Acquire("Tasks", monitor)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("keeps a bucket per client when the limit is per client", func() {
+			limiter.Acquire("Tasks", monitor)
+			limiter.Acquire("Tasks", monitor)
+
+			_, _, ok := limiter.Acquire("Tasks", cell)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("shares a bucket between clients when the limit is not per client", func() {
+			policy.Limits[0].PerClient = false
+			limiter = middleware.NewRateLimiter(policy, fakeClock)
+
+			limiter.Acquire("Tasks", monitor)
+			limiter.Acquire("Tasks", monitor)
+
+			_, _, ok := limiter.Acquire("Tasks", cell)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("limits the requests in flight until they are released", func() {
+			release, _, ok := limiter.Acquire("StartActualLRP", cell)
+			Expect(ok).To(BeTrue())
+
+			_, retryAfter, ok := limiter.Acquire("StartActualLRP", cell)
+			Expect(ok).To(BeFalse())
+			Expect(retryAfter).To(Equal(time.Second))
+
+			release()
+			release()
+
+			release, _, ok = limiter.Acquire("StartActualLRP", cell)
+			Expect(ok).To(BeTrue())
+			_, _, ok = limiter.Acquire("StartActualLRP", cell)
+			Expect(ok).To(BeFalse())
+			release()
+		})
+
+		It("does not limit clients that do not match the identity of the limit", func() {
+			limiter.Acquire("StartActualLRP", monitor)
+			_, _, ok := limiter.Acquire("StartActualLRP", monitor)
+			Expect(ok).To(BeTrue())
+
+			_, _, ok = limiter.Acquire("StartActualLRP", nil)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("evicts buckets that have been idle for longer than their refill window", func() {
+			for _, name := range []string{"client-1", "client-2", "client-3"} {
+				release, _, ok := limiter.Acquire("Tasks", &x509.Certificate{Subject: pkix.Name{CommonName: name}})
+				Expect(ok).To(BeTrue())
+				release()
+			}
+			_, _, ok := limiter.Acquire("StartActualLRP", cell)
+			Expect(ok).To(BeTrue())
+			Expect(limiter.BucketCount()).To(Equal(4))
+
+			fakeClock.Increment(time.Minute)
+			_, _, ok = limiter.Acquire("Tasks", monitor)
+			Expect(ok).To(BeTrue())
+
+			By("keeping the bucket with a request in flight")
+			Expect(limiter.BucketCount()).To(Equal(2))
+			_, _, ok = limiter.Acquire("StartActualLRP", cell)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not evict buckets that are still refilling", func() {
+			policy.Limits[0].RequestsPerSecond = 0.01
+			limiter = middleware.NewRateLimiter(policy, fakeClock)
+
+			limiter.Acquire("Tasks", monitor)
+			limiter.Acquire("Tasks", monitor)
+
+			fakeClock.Increment(time.Minute)
+			_, _, ok := limiter.Acquire("Tasks", monitor)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not limit routes outside the route group", func() {
+			for i := 0; i < 5; i++ {
+				_, _, ok := limiter.Acquire("StartActualLRP", monitor)
+				Expect(ok).To(BeTrue())
+			}
+		})
+	})
+
+	Describe("the middleware", func() {
+		var (
+			logger   *lagertest.TestLogger
+			called   bool
+			recorder *httptest.ResponseRecorder
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+		})
+
+		serve := func(routeName string, cert *x509.Certificate) {
+			called = false
+			recorder = httptest.NewRecorder()
+			request, err := http.NewRequest("POST", "http://example.com/v1/tasks/list.r2", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+			})
+			middleware.RateLimit(logger, limiter, routeName, handler).ServeHTTP(recorder, request)
+		}
+
+		It("passes admitted requests to the handler", func() {
+			serve("Tasks", monitor)
+			Expect(called).To(BeTrue())
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("releases in-flight requests once the handler returns", func() {
+			serve("StartActualLRP", cell)
+			serve("StartActualLRP", cell)
+			Expect(called).To(BeTrue())
+		})
+
+		Context("when the client is over its limit", func() {
+			BeforeEach(func() {
+				policy.Limits[0].Burst = 1
+				policy.Limits[0].RequestsPerSecond = 0.4
+			})
+
+			JustBeforeEach(func() {
+				serve("Tasks", monitor)
+				serve("Tasks", monitor)
+			})
+
+			It("responds with too many requests and a retry hint", func() {
+				Expect(called).To(BeFalse())
+				Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+				Expect(recorder.Header().Get("Retry-After")).To(Equal("3"))
+
+				response := &models.TasksResponse{}
+				Expect(response.Unmarshal(recorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error).To(Equal(models.ErrTooManyRequests))
+			})
+
+			It("logs the rejection", func() {
+				Expect(logger).To(gbytes.Say("too-many-requests"))
+				Expect(logger).To(gbytes.Say("CN=monitor"))
+			})
+		})
+	})
+})
@@ -8,14 +8,18 @@ import (
 )
 
 type PingHandler struct {
+	maintenanceController MaintenanceController
 }
 
-func NewPingHandler() *PingHandler {
-	return &PingHandler{}
+func NewPingHandler(maintenanceController MaintenanceController) *PingHandler {
+	return &PingHandler{
+		maintenanceController: maintenanceController,
+	}
 }
 
 func (h *PingHandler) Ping(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	response := &models.PingResponse{}
 	response.Available = true
-	writeResponse(w, response)
+	response.Maintenance = h.maintenanceController.Enabled(req.Context(), logger)
+	writeResponse(w, req, response)
 }
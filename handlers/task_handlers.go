@@ -13,7 +13,7 @@ import (
 //go:generate counterfeiter -o fake_controllers/fake_task_controller.go . TaskController
 
 type TaskController interface {
-	Tasks(ctx context.Context, logger lager.Logger, domain, cellId string) ([]*models.Task, error)
+	Tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
 	TaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.Task, error)
 	DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
 	StartTask(ctx context.Context, logger lager.Logger, taskGuid, cellId string) (shouldStart bool, err error)
@@ -49,7 +49,7 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, targetVersion format.Vers
 	response := &models.TasksResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -58,7 +58,8 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, targetVersion format.Vers
 		return
 	}
 
-	tasks, err := h.controller.Tasks(req.Context(), logger, request.Domain, request.CellId)
+	filter := models.TaskFilter{Domain: request.Domain, Domains: allowedDomains(req), CellID: request.CellId}
+	tasks, err := h.controller.Tasks(req.Context(), logger, filter)
 
 	downgradedTasks := []*models.Task{}
 	for _, t := range tasks {
@@ -84,7 +85,7 @@ func (h *TaskHandler) commonTaskByGuid(logger lager.Logger, targetVersion format
 	response := &models.TaskResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -95,6 +96,9 @@ func (h *TaskHandler) commonTaskByGuid(logger lager.Logger, targetVersion format
 
 	var task *models.Task
 	task, err = h.controller.TaskByGuid(req.Context(), logger, request.TaskGuid)
+	if task != nil && !domainAllowed(req, task.Domain) {
+		task, err = nil, models.ErrResourceNotFound
+	}
 	if task != nil {
 		task = task.VersionDownTo(targetVersion)
 	}
@@ -119,7 +123,7 @@ func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -128,6 +132,11 @@ func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	if !domainAllowed(req, request.Domain) {
+		response.Error = models.ErrForbidden
+		return
+	}
+
 	err = h.controller.DesireTask(req.Context(), logger, request.TaskDefinition, request.TaskGuid, request.Domain)
 	response.Error = models.ConvertError(err)
 }
@@ -140,7 +149,7 @@ func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.StartTaskResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -149,6 +158,12 @@ func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	err = h.checkDomain(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	response.ShouldStart, err = h.controller.StartTask(req.Context(), logger, request.TaskGuid, request.CellId)
 	response.Error = models.ConvertError(err)
 }
@@ -160,7 +175,7 @@ func (h *TaskHandler) CancelTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
@@ -169,6 +184,12 @@ func (h *TaskHandler) CancelTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	err = h.checkDomain(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.CancelTask(req.Context(), logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
@@ -181,7 +202,7 @@ func (h *TaskHandler) FailTask(logger lager.Logger, w http.ResponseWriter, req *
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -190,6 +211,12 @@ func (h *TaskHandler) FailTask(logger lager.Logger, w http.ResponseWriter, req *
 		return
 	}
 
+	err = h.checkDomain(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.FailTask(req.Context(), logger, request.TaskGuid, request.FailureReason)
 	response.Error = models.ConvertError(err)
 }
@@ -202,7 +229,7 @@ func (h *TaskHandler) RejectTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -211,6 +238,12 @@ func (h *TaskHandler) RejectTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	err = h.checkDomain(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.RejectTask(req.Context(), logger, request.TaskGuid, request.RejectionReason)
 	response.Error = models.ConvertError(err)
 }
@@ -223,7 +256,7 @@ func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, r
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -232,6 +265,12 @@ func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, r
 		return
 	}
 
+	err = h.checkDomain(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.CompleteTask(req.Context(), logger, request.TaskGuid, request.CellId, request.Failed, request.FailureReason, request.Result)
 	response.Error = models.ConvertError(err)
 }
@@ -244,7 +283,7 @@ func (h *TaskHandler) ResolvingTask(logger lager.Logger, w http.ResponseWriter,
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -253,6 +292,12 @@ func (h *TaskHandler) ResolvingTask(logger lager.Logger, w http.ResponseWriter,
 		return
 	}
 
+	err = h.checkDomain(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.ResolvingTask(req.Context(), logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
@@ -265,7 +310,7 @@ func (h *TaskHandler) DeleteTask(logger lager.Logger, w http.ResponseWriter, req
 	response := &models.TaskLifecycleResponse{}
 
 	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
-	defer func() { writeResponse(w, response) }()
+	defer func() { writeResponse(w, req, response) }()
 
 	err = parseRequest(logger, req, request)
 	if err != nil {
@@ -274,6 +319,29 @@ func (h *TaskHandler) DeleteTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
+	err = h.checkDomain(logger, req, request.TaskGuid)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
 	err = h.controller.DeleteTask(req.Context(), logger, request.TaskGuid)
 	response.Error = models.ConvertError(err)
 }
+
+// checkDomain hides tasks outside of the domains the client is restricted to.
+func (h *TaskHandler) checkDomain(logger lager.Logger, req *http.Request, taskGuid string) error {
+	if allowedDomains(req) == nil {
+		return nil
+	}
+
+	task, err := h.controller.TaskByGuid(req.Context(), logger, taskGuid)
+	if err != nil {
+		return err
+	}
+
+	if !domainAllowed(req, task.Domain) {
+		return models.ErrResourceNotFound
+	}
+	return nil
+}
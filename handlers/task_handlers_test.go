@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	. "code.cloudfoundry.org/bbs/test_helpers"
@@ -90,9 +91,9 @@ var _ = Describe("Task Handlers", func() {
 
 			It("calls the controller with no filter", func() {
 				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-				Expect(actualDomain).To(Equal(domain))
-				Expect(actualCellId).To(Equal(cellId))
+				_, _, filter := controller.TasksArgsForCall(0)
+				Expect(filter.Domain).To(Equal(domain))
+				Expect(filter.CellID).To(Equal(cellId))
 			})
 
 			Context("when the tasks include image layers", func() {
@@ -129,9 +130,9 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a domain filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					_, _, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
 
@@ -142,9 +143,9 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a cell filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					_, _, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
 		})
@@ -221,9 +222,9 @@ var _ = Describe("Task Handlers", func() {
 
 			It("calls the controller with no filter", func() {
 				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-				Expect(actualDomain).To(Equal(domain))
-				Expect(actualCellId).To(Equal(cellId))
+				_, _, filter := controller.TasksArgsForCall(0)
+				Expect(filter.Domain).To(Equal(domain))
+				Expect(filter.CellID).To(Equal(cellId))
 			})
 
 			Context("and filtering by domain", func() {
@@ -233,9 +234,9 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a domain filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					_, _, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
 
@@ -246,9 +247,9 @@ var _ = Describe("Task Handlers", func() {
 
 				It("calls the controller with a cell filter", func() {
 					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					_, _, filter := controller.TasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
 		})
@@ -1026,4 +1027,62 @@ var _ = Describe("Task Handlers", func() {
 			})
 		})
 	})
+
+	Describe("when the client is restricted to domains", func() {
+		restrictedRequest := func(body interface{}) *http.Request {
+			request := newTestRequest(body)
+			return request.WithContext(middleware.WithAllowedDomains(request.Context(), []string{"domain-1"}))
+		}
+
+		It("only lists tasks in the allowed domains", func() {
+			handler.Tasks(logger, responseRecorder, restrictedRequest(&models.TasksRequest{}))
+
+			Expect(controller.TasksCallCount()).To(Equal(1))
+			_, _, filter := controller.TasksArgsForCall(0)
+			Expect(filter.Domains).To(Equal([]string{"domain-1"}))
+		})
+
+		It("hides tasks in other domains", func() {
+			controller.TaskByGuidReturns(&models.Task{TaskGuid: "task-guid", Domain: "domain-2"}, nil)
+			handler.TaskByGuid(logger, responseRecorder, restrictedRequest(&models.TaskByGuidRequest{TaskGuid: "task-guid"}))
+
+			response := models.TaskResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			Expect(response.Task).To(BeNil())
+		})
+
+		It("forbids desiring tasks in other domains", func() {
+			handler.DesireTask(logger, responseRecorder, restrictedRequest(&models.DesireTaskRequest{
+				TaskGuid:       "task-guid",
+				Domain:         "domain-2",
+				TaskDefinition: model_helpers.NewValidTaskDefinition(),
+			}))
+
+			Expect(controller.DesireTaskCallCount()).To(Equal(0))
+			response := &models.TaskLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrForbidden))
+		})
+
+		It("does not change tasks in other domains", func() {
+			controller.TaskByGuidReturns(&models.Task{TaskGuid: "task-guid", Domain: "domain-2"}, nil)
+			handler.CancelTask(logger, responseRecorder, restrictedRequest(&models.TaskGuidRequest{TaskGuid: "task-guid"}))
+
+			Expect(controller.CancelTaskCallCount()).To(Equal(0))
+			response := &models.TaskLifecycleResponse{}
+			err := response.Unmarshal(responseRecorder.Body.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("changes tasks in the allowed domains", func() {
+			controller.TaskByGuidReturns(&models.Task{TaskGuid: "task-guid", Domain: "domain-1"}, nil)
+			handler.CancelTask(logger, responseRecorder, restrictedRequest(&models.TaskGuidRequest{TaskGuid: "task-guid"}))
+
+			Expect(controller.CancelTaskCallCount()).To(Equal(1))
+		})
+	})
 })
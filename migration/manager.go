@@ -19,8 +19,16 @@ import (
 
 const (
 	migrationDuration = "MigrationDuration"
+
+	dataMigrationDuration      = "DataMigrationDuration"
+	dataMigrationsPending      = "DataMigrationsPending"
+	dataMigrationRowsProcessed = "DataMigrationRowsProcessed"
 )
 
+const DataMigrationBatchSize = 500
+
+var errDataMigrationInterrupted = errors.New("data migration interrupted")
+
 type Manager struct {
 	logger         lager.Logger
 	sqlDB          db.DB
@@ -93,7 +101,9 @@ func (m Manager) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	}
 
 	errorChan := make(chan error)
-	go m.performMigration(logger, version, maxMigrationVersion, errorChan, ready)
+	stop := make(chan struct{})
+	defer close(stop)
+	go m.performMigration(logger, version, maxMigrationVersion, errorChan, ready, stop)
 	defer logger.Info("exited")
 
 	select {
@@ -112,6 +122,7 @@ func (m *Manager) performMigration(
 	maxMigrationVersion int64,
 	errorChan chan error,
 	readyChan chan<- struct{},
+	stop <-chan struct{},
 ) {
 	migrateStart := m.clock.Now()
 	if version != maxMigrationVersion {
@@ -129,10 +140,7 @@ func (m *Manager) performMigration(
 					"migration_version": nextVersion,
 				})
 
-				currentMigration.SetCryptor(m.cryptor)
-				currentMigration.SetRawSQLDB(m.rawSQLDB)
-				currentMigration.SetClock(m.clock)
-				currentMigration.SetDBFlavor(m.databaseDriver)
+				m.prepare(currentMigration)
 
 				err := currentMigration.Up(m.logger.Session("migration"))
 				if err != nil {
@@ -140,6 +148,12 @@ func (m *Manager) performMigration(
 					return
 				}
 
+				err = m.registerDataMigration(logger, currentMigration)
+				if err != nil {
+					errorChan <- err
+					return
+				}
+
 				lastVersion = nextVersion
 				err = m.writeVersion(lastVersion)
 				if err != nil {
@@ -162,6 +176,133 @@ func (m *Manager) performMigration(
 	}
 
 	m.finish(logger, readyChan)
+
+	m.performDataMigrations(logger, stop)
+}
+
+// performDataMigrations runs the pending data migrations in version order
+// once the schema migrations have finished and the API is available. A
+// failing data migration is not fatal; it is retried from its last checkpoint
+// the next time the BBS starts.
+func (m *Manager) performDataMigrations(logger lager.Logger, stop <-chan struct{}) {
+	logger = logger.Session("data-migrations")
+
+	pending := []DataMigration{}
+	progress := map[int64]*db.DataMigrationProgress{}
+	for _, mig := range m.migrations {
+		dataMigration, ok := mig.(DataMigration)
+		if !ok {
+			continue
+		}
+
+		p, err := m.sqlDB.DataMigrationProgress(context.Background(), logger, mig.Version())
+		if err == models.ErrResourceNotFound {
+			// Up registers the data migration, so it was applied in full by
+			// a BBS that ran it synchronously.
+			continue
+		} else if err != nil {
+			logger.Error("failed-fetching-progress", err, lager.Data{"migration": mig.String()})
+			return
+		}
+
+		if p.Done {
+			continue
+		}
+		pending = append(pending, dataMigration)
+		progress[mig.Version()] = p
+	}
+
+	if len(pending) == 0 {
+		return
+	}
+
+	logger.Info("starting", lager.Data{"pending": len(pending)})
+	m.sendMetric(logger, dataMigrationsPending, len(pending))
+
+	for i, mig := range pending {
+		err := m.performDataMigration(logger, mig, progress[mig.Version()], stop)
+		if err != nil {
+			return
+		}
+		m.sendMetric(logger, dataMigrationsPending, len(pending)-i-1)
+	}
+
+	logger.Info("finished")
+}
+
+func (m *Manager) performDataMigration(logger lager.Logger, mig DataMigration, progress *db.DataMigrationProgress, stop <-chan struct{}) error {
+	logger = logger.Session("data-migration", lager.Data{"migration": mig.String()})
+	logger.Info("starting", lager.Data{"checkpoint": progress.Checkpoint, "rows_processed": progress.RowsProcessed})
+
+	m.prepare(mig)
+	start := m.clock.Now()
+
+	for {
+		select {
+		case <-stop:
+			logger.Info("interrupted", lager.Data{"checkpoint": progress.Checkpoint})
+			return errDataMigrationInterrupted
+		default:
+		}
+
+		checkpoint, rows, done, err := mig.MigrateBatch(logger, progress.Checkpoint, DataMigrationBatchSize)
+		if err != nil {
+			logger.Error("failed-migrating-batch", err, lager.Data{"checkpoint": progress.Checkpoint})
+			return err
+		}
+
+		progress.Checkpoint = checkpoint
+		progress.RowsProcessed += int64(rows)
+		progress.Done = done
+
+		err = m.sqlDB.SetDataMigrationProgress(context.Background(), logger, mig.Version(), progress)
+		if err != nil {
+			logger.Error("failed-saving-progress", err)
+			return err
+		}
+		m.sendMetric(logger, dataMigrationRowsProcessed, int(progress.RowsProcessed))
+
+		if done {
+			break
+		}
+	}
+
+	err := m.metronClient.SendDuration(dataMigrationDuration, m.clock.Since(start))
+	if err != nil {
+		logger.Error("failed-to-send-data-migration-duration-metric", err)
+	}
+
+	logger.Info("completed", lager.Data{"rows_processed": progress.RowsProcessed})
+	return nil
+}
+
+// registerDataMigration resets the progress of a data migration whose Up or
+// Down has just run, so that its batches run from the start.
+func (m *Manager) registerDataMigration(logger lager.Logger, mig Migration) error {
+	if _, ok := mig.(DataMigration); !ok {
+		return nil
+	}
+
+	err := m.sqlDB.SetDataMigrationProgress(context.Background(), logger, mig.Version(), &db.DataMigrationProgress{})
+	if err != nil {
+		logger.Error("failed-registering-data-migration", err, lager.Data{"migration": mig.String()})
+		return err
+	}
+	return nil
+}
+
+func (m *Manager) sendMetric(logger lager.Logger, name string, value int) {
+	err := m.metronClient.SendMetric(name, value)
+	if err != nil {
+		logger.Error("failed-to-send-metric", err, lager.Data{"metric": name})
+	}
+}
+
+func (m *Manager) prepare(mig Migration) {
+	mig.SetCryptor(m.cryptor)
+	mig.SetRawSQLDB(m.rawSQLDB)
+	mig.SetClock(m.clock)
+	mig.SetDBFlavor(m.databaseDriver)
 }
 
 func (m *Manager) finish(logger lager.Logger, ready chan<- struct{}) {
@@ -184,6 +325,167 @@ func (m *Manager) writeVersion(currentVersion int64) error {
 	})
 }
 
+type Direction string
+
+const (
+	DirectionUp   Direction = "up"
+	DirectionDown Direction = "down"
+)
+
+// Step is a single migration that has to run, in the given direction, to
+// reach a target version. SQL is empty if the migration cannot describe its
+// statements.
+type Step struct {
+	Migration string
+	Direction Direction
+	// Version is the stored version once the step has completed.
+	Version int64
+	SQL     []string
+
+	migration Migration
+}
+
+// Plan returns the ordered steps needed to move the stored version to
+// targetVersion, without running them. The target must be 0 or the version
+// of a known migration.
+func (m Manager) Plan(logger lager.Logger, targetVersion int64) ([]Step, error) {
+	logger = logger.Session("migration-plan", lager.Data{"target_version": targetVersion})
+
+	version, err := m.resolveStoredVersion(logger)
+	if err == models.ErrResourceNotFound {
+		version = 0
+	} else if err != nil {
+		return nil, err
+	}
+
+	return m.plan(version, targetVersion)
+}
+
+// MigrateTo migrates the database up or down to targetVersion and records
+// the version after every step. Migrating down fails before running anything
+// if one of the migrations to revert does not implement DownMigration.
+func (m Manager) MigrateTo(logger lager.Logger, targetVersion int64) error {
+	logger = logger.Session("migrate-to", lager.Data{"target_version": targetVersion})
+	logger.Info("starting")
+	defer logger.Info("finished")
+
+	if m.rawSQLDB == nil {
+		err := errors.New("no database configured")
+		logger.Error("no-database-configured", err)
+		return err
+	}
+
+	steps, err := m.Plan(logger, targetVersion)
+	if err != nil {
+		logger.Error("failed-to-plan", err)
+		return err
+	}
+
+	for _, step := range steps {
+		mig := step.migration
+		m.prepare(mig)
+
+		logger.Info("running-migration", lager.Data{
+			"migration": step.Migration,
+			"direction": step.Direction,
+		})
+		if step.Direction == DirectionUp {
+			err = mig.Up(logger.Session("migration"))
+		} else {
+			err = mig.(DownMigration).Down(logger.Session("migration"))
+		}
+		if err != nil {
+			logger.Error("failed-running-migration", err, lager.Data{"migration": step.Migration})
+			return err
+		}
+
+		err = m.registerDataMigration(logger, mig)
+		if err != nil {
+			return err
+		}
+
+		err = m.writeVersion(step.Version)
+		if err != nil {
+			return err
+		}
+		logger.Info("completed-migration", lager.Data{"current_version": step.Version})
+	}
+
+	return nil
+}
+
+func (m Manager) plan(version, targetVersion int64) ([]Step, error) {
+	if targetVersion != 0 && m.migrationIndex(targetVersion) < 0 {
+		return nil, fmt.Errorf("Unknown migration version %d", targetVersion)
+	}
+
+	steps := []Step{}
+	if version <= targetVersion {
+		for _, mig := range m.migrations {
+			if mig.Version() <= version || mig.Version() > targetVersion {
+				continue
+			}
+			steps = append(steps, Step{
+				Migration: mig.String(),
+				Direction: DirectionUp,
+				Version:   mig.Version(),
+				SQL:       m.sqlFor(mig, DirectionUp),
+				migration: mig,
+			})
+		}
+		return steps, nil
+	}
+
+	if version > 0 && m.migrationIndex(version) < 0 {
+		return nil, fmt.Errorf("Existing DB version (%d) is not a known migration version", version)
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mig := m.migrations[i]
+		if mig.Version() > version || mig.Version() <= targetVersion {
+			continue
+		}
+		if _, ok := mig.(DownMigration); !ok {
+			return nil, fmt.Errorf("Migration %s cannot be reverted", mig.String())
+		}
+
+		var previousVersion int64
+		if i > 0 {
+			previousVersion = m.migrations[i-1].Version()
+		}
+		steps = append(steps, Step{
+			Migration: mig.String(),
+			Direction: DirectionDown,
+			Version:   previousVersion,
+			SQL:       m.sqlFor(mig, DirectionDown),
+			migration: mig,
+		})
+	}
+	return steps, nil
+}
+
+func (m Manager) sqlFor(mig Migration, direction Direction) []string {
+	plannable, ok := mig.(PlannableMigration)
+	if !ok {
+		return nil
+	}
+
+	plannable.SetDBFlavor(m.databaseDriver)
+	if direction == DirectionUp {
+		return plannable.UpSQL()
+	}
+	return plannable.DownSQL()
+}
+
+func (m Manager) migrationIndex(version int64) int {
+	for i, mig := range m.migrations {
+		if mig.Version() == version {
+			return i
+		}
+	}
+	return -1
+}
+
 type Migrations []Migration
 
 func (m Migrations) Len() int           { return len(m) }
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/encryption/encryptionfakes"
@@ -249,6 +250,122 @@ var _ = Describe("Migration Manager", func() {
 			})
 		})
 
+		Context("when there are data migrations", func() {
+			var fakeDataMigration *migrationfakes.FakeDataMigration
+
+			BeforeEach(func() {
+				fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 99}, nil)
+				fakeMigration.VersionReturns(100)
+
+				fakeDataMigration = &migrationfakes.FakeDataMigration{}
+				fakeDataMigration.VersionReturns(101)
+				fakeDataMigration.MigrateBatchReturnsOnCall(0, "checkpoint-1", 500, false, nil)
+				fakeDataMigration.MigrateBatchReturnsOnCall(1, "checkpoint-2", 20, true, nil)
+
+				migrations = []migration.Migration{fakeMigration, fakeDataMigration}
+				fakeSQLDB.DataMigrationProgressReturns(&db.DataMigrationProgress{}, nil)
+			})
+
+			It("registers the data migration once its schema part has run", func() {
+				Eventually(migrationProcess.Ready()).Should(BeClosed())
+				Expect(fakeSQLDB.SetDataMigrationProgressCallCount()).To(BeNumerically(">=", 1))
+
+				_, _, version, progress := fakeSQLDB.SetDataMigrationProgressArgsForCall(0)
+				Expect(version).To(BeEquivalentTo(101))
+				Expect(progress).To(Equal(&db.DataMigrationProgress{}))
+			})
+
+			It("runs the schema part before signalling ready and migrates the rows in batches afterwards", func() {
+				Eventually(migrationProcess.Ready()).Should(BeClosed())
+				Expect(fakeDataMigration.UpCallCount()).To(Equal(1))
+
+				Eventually(fakeDataMigration.MigrateBatchCallCount).Should(Equal(2))
+				_, checkpoint, batchSize := fakeDataMigration.MigrateBatchArgsForCall(0)
+				Expect(checkpoint).To(BeEmpty())
+				Expect(batchSize).To(Equal(migration.DataMigrationBatchSize))
+				_, checkpoint, _ = fakeDataMigration.MigrateBatchArgsForCall(1)
+				Expect(checkpoint).To(Equal("checkpoint-1"))
+			})
+
+			It("checkpoints the progress after every batch", func() {
+				Eventually(fakeSQLDB.SetDataMigrationProgressCallCount).Should(Equal(3))
+
+				_, _, version, progress := fakeSQLDB.SetDataMigrationProgressArgsForCall(2)
+				Expect(version).To(BeEquivalentTo(101))
+				Expect(progress).To(Equal(&db.DataMigrationProgress{
+					Checkpoint:    "checkpoint-2",
+					RowsProcessed: 520,
+					Done:          true,
+				}))
+			})
+
+			It("reports the progress", func() {
+				Eventually(fakeSQLDB.SetDataMigrationProgressCallCount).Should(Equal(3))
+				Eventually(fakeMetronClient.SendMetricCallCount).Should(Equal(4))
+
+				metrics := map[string][]int{}
+				for i := 0; i < fakeMetronClient.SendMetricCallCount(); i++ {
+					name, value, _ := fakeMetronClient.SendMetricArgsForCall(i)
+					metrics[name] = append(metrics[name], value)
+				}
+				Expect(metrics).To(Equal(map[string][]int{
+					"DataMigrationsPending":      {1, 0},
+					"DataMigrationRowsProcessed": {500, 520},
+				}))
+			})
+
+			Context("when the data migration was interrupted before", func() {
+				BeforeEach(func() {
+					fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 101}, nil)
+					fakeSQLDB.DataMigrationProgressReturns(&db.DataMigrationProgress{Checkpoint: "checkpoint-1", RowsProcessed: 500}, nil)
+					fakeDataMigration.MigrateBatchReturnsOnCall(0, "checkpoint-2", 20, true, nil)
+				})
+
+				It("resumes from the stored checkpoint without running Up again", func() {
+					Eventually(fakeDataMigration.MigrateBatchCallCount).Should(Equal(1))
+					_, checkpoint, _ := fakeDataMigration.MigrateBatchArgsForCall(0)
+					Expect(checkpoint).To(Equal("checkpoint-1"))
+					Expect(fakeDataMigration.UpCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the data migration was applied before its batches were tracked", func() {
+				BeforeEach(func() {
+					fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 101}, nil)
+					fakeSQLDB.DataMigrationProgressReturns(nil, models.ErrResourceNotFound)
+				})
+
+				It("does not run it again", func() {
+					Eventually(migrationProcess.Ready()).Should(BeClosed())
+					Consistently(fakeDataMigration.MigrateBatchCallCount).Should(Equal(0))
+				})
+			})
+
+			Context("when the data migration has completed", func() {
+				BeforeEach(func() {
+					fakeSQLDB.DataMigrationProgressReturns(&db.DataMigrationProgress{Done: true}, nil)
+				})
+
+				It("does not run it", func() {
+					Eventually(migrationProcess.Ready()).Should(BeClosed())
+					Consistently(fakeDataMigration.MigrateBatchCallCount).Should(Equal(0))
+				})
+			})
+
+			Context("when a batch fails", func() {
+				BeforeEach(func() {
+					fakeDataMigration.MigrateBatchReturnsOnCall(1, "", 0, false, errors.New("boom"))
+				})
+
+				It("stops migrating without taking down the manager", func() {
+					Eventually(fakeDataMigration.MigrateBatchCallCount).Should(Equal(2))
+					Consistently(fakeDataMigration.MigrateBatchCallCount).Should(Equal(2))
+					Consistently(migrationProcess.Wait()).ShouldNot(Receive())
+					Expect(fakeSQLDB.SetDataMigrationProgressCallCount()).To(Equal(2))
+				})
+			})
+		})
+
 		Context("when there are no migrations", func() {
 			BeforeEach(func() {
 				migrations = []migration.Migration{}
@@ -307,3 +424,147 @@ var _ = Describe("Migration Manager", func() {
 		})
 	})
 })
+
+var _ = Describe("Migration Manager Plan and MigrateTo", func() {
+	var (
+		manager        migration.Manager
+		logger         *lagertest.TestLogger
+		fakeSQLDB      *dbfakes.FakeDB
+		migrations     []migration.Migration
+		migrationsDone chan struct{}
+
+		first  *migrationfakes.FakePlannableMigration
+		second *migrationfakes.FakeDownMigration
+		third  *migrationfakes.FakeDownMigration
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeSQLDB = &dbfakes.FakeDB{}
+		migrationsDone = make(chan struct{})
+
+		first = &migrationfakes.FakePlannableMigration{}
+		first.VersionReturns(100)
+		first.StringReturns("100_first")
+		first.UpSQLReturns([]string{"CREATE TABLE first"})
+
+		second = &migrationfakes.FakeDownMigration{}
+		second.VersionReturns(200)
+		second.StringReturns("200_second")
+
+		third = &migrationfakes.FakeDownMigration{}
+		third.VersionReturns(300)
+		third.StringReturns("300_third")
+
+		migrations = []migration.Migration{third, first, second}
+	})
+
+	JustBeforeEach(func() {
+		manager = migration.NewManager(logger, fakeSQLDB, &sql.DB{}, &encryptionfakes.FakeCryptor{}, migrations, migrationsDone, clock.NewClock(), "postgres", new(mfakes.FakeIngressClient))
+	})
+
+	Describe("Plan", func() {
+		Context("when migrating up", func() {
+			BeforeEach(func() {
+				fakeSQLDB.VersionReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("returns the pending migrations in order with their SQL", func() {
+				steps, err := manager.Plan(logger, 200)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(steps).To(HaveLen(2))
+
+				Expect(steps[0].Migration).To(Equal("100_first"))
+				Expect(steps[0].Direction).To(Equal(migration.DirectionUp))
+				Expect(steps[0].Version).To(BeEquivalentTo(100))
+				Expect(steps[0].SQL).To(Equal([]string{"CREATE TABLE first"}))
+				Expect(first.SetDBFlavorArgsForCall(0)).To(Equal("postgres"))
+
+				Expect(steps[1].Migration).To(Equal("200_second"))
+				Expect(steps[1].SQL).To(BeEmpty())
+			})
+
+			It("does not run or record anything", func() {
+				_, err := manager.Plan(logger, 300)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(first.UpCallCount()).To(Equal(0))
+				Expect(fakeSQLDB.SetVersionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when migrating down", func() {
+			BeforeEach(func() {
+				fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 300}, nil)
+			})
+
+			It("returns the migrations to revert in reverse order", func() {
+				steps, err := manager.Plan(logger, 100)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(steps).To(HaveLen(2))
+
+				Expect(steps[0].Migration).To(Equal("300_third"))
+				Expect(steps[0].Direction).To(Equal(migration.DirectionDown))
+				Expect(steps[0].Version).To(BeEquivalentTo(200))
+				Expect(steps[1].Migration).To(Equal("200_second"))
+				Expect(steps[1].Version).To(BeEquivalentTo(100))
+			})
+
+			It("fails when a migration cannot be reverted", func() {
+				_, err := manager.Plan(logger, 0)
+				Expect(err).To(MatchError(ContainSubstring("100_first cannot be reverted")))
+			})
+		})
+
+		It("fails when the target is not a known migration version", func() {
+			fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 100}, nil)
+			_, err := manager.Plan(logger, 150)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("MigrateTo", func() {
+		Context("when migrating down", func() {
+			BeforeEach(func() {
+				fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 300}, nil)
+			})
+
+			It("reverts the migrations and records the version after each one", func() {
+				Expect(manager.MigrateTo(logger, 100)).To(Succeed())
+
+				Expect(third.DownCallCount()).To(Equal(1))
+				Expect(second.DownCallCount()).To(Equal(1))
+				Expect(third.UpCallCount()).To(Equal(0))
+
+				Expect(fakeSQLDB.SetVersionCallCount()).To(Equal(2))
+				_, _, version := fakeSQLDB.SetVersionArgsForCall(0)
+				Expect(version.CurrentVersion).To(BeEquivalentTo(200))
+				_, _, version = fakeSQLDB.SetVersionArgsForCall(1)
+				Expect(version.CurrentVersion).To(BeEquivalentTo(100))
+			})
+
+			Context("when a migration fails to revert", func() {
+				BeforeEach(func() {
+					second.DownReturns(errors.New("boom"))
+				})
+
+				It("stops and keeps the version of the last successful step", func() {
+					Expect(manager.MigrateTo(logger, 100)).To(MatchError("boom"))
+					Expect(fakeSQLDB.SetVersionCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when migrating up", func() {
+			BeforeEach(func() {
+				fakeSQLDB.VersionReturns(&models.Version{CurrentVersion: 100}, nil)
+			})
+
+			It("runs the pending migrations up to the target", func() {
+				Expect(manager.MigrateTo(logger, 200)).To(Succeed())
+				Expect(second.UpCallCount()).To(Equal(1))
+				Expect(third.UpCallCount()).To(Equal(0))
+				Expect(first.UpCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -19,3 +19,35 @@ type Migration interface {
 	SetRawSQLDB(rawSQLDB *sql.DB)
 	SetDBFlavor(flavor string)
 }
+
+//go:generate counterfeiter -o migrationfakes/fake_down_migration.go . DownMigration
+
+// DownMigration is implemented by migrations that can revert the changes made
+// by Up, returning the schema to the previous migration version.
+type DownMigration interface {
+	Migration
+	Down(logger lager.Logger) error
+}
+
+//go:generate counterfeiter -o migrationfakes/fake_plannable_migration.go . PlannableMigration
+
+// PlannableMigration is implemented by migrations that can describe the SQL
+// they execute for the configured DB flavor without touching the database.
+type PlannableMigration interface {
+	Migration
+	UpSQL() []string
+	DownSQL() []string
+}
+
+//go:generate counterfeiter -o migrationfakes/fake_data_migration.go . DataMigration
+
+// DataMigration is implemented by migrations that rewrite existing rows in a
+// way the running BBS can tolerate. Up only performs the schema part, if any,
+// and blocks the API like any other migration. The rows are then migrated in
+// the background: MigrateBatch is called repeatedly with the checkpoint it
+// returned last, which is persisted so that the migration resumes where it
+// left off after a restart.
+type DataMigration interface {
+	Migration
+	MigrateBatch(logger lager.Logger, checkpoint string, batchSize int) (nextCheckpoint string, rowsProcessed int, done bool, err error)
+}
@@ -0,0 +1,531 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package migrationfakes
+
+import (
+	"database/sql"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+)
+
+type FakePlannableMigration struct {
+	DownSQLStub        func() []string
+	downSQLMutex       sync.RWMutex
+	downSQLArgsForCall []struct {
+	}
+	downSQLReturns struct {
+		result1 []string
+	}
+	downSQLReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	SetClockStub        func(clock.Clock)
+	setClockMutex       sync.RWMutex
+	setClockArgsForCall []struct {
+		arg1 clock.Clock
+	}
+	SetCryptorStub        func(encryption.Cryptor)
+	setCryptorMutex       sync.RWMutex
+	setCryptorArgsForCall []struct {
+		arg1 encryption.Cryptor
+	}
+	SetDBFlavorStub        func(string)
+	setDBFlavorMutex       sync.RWMutex
+	setDBFlavorArgsForCall []struct {
+		arg1 string
+	}
+	SetRawSQLDBStub        func(*sql.DB)
+	setRawSQLDBMutex       sync.RWMutex
+	setRawSQLDBArgsForCall []struct {
+		arg1 *sql.DB
+	}
+	StringStub        func() string
+	stringMutex       sync.RWMutex
+	stringArgsForCall []struct {
+	}
+	stringReturns struct {
+		result1 string
+	}
+	stringReturnsOnCall map[int]struct {
+		result1 string
+	}
+	UpStub        func(lager.Logger) error
+	upMutex       sync.RWMutex
+	upArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	upReturns struct {
+		result1 error
+	}
+	upReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpSQLStub        func() []string
+	upSQLMutex       sync.RWMutex
+	upSQLArgsForCall []struct {
+	}
+	upSQLReturns struct {
+		result1 []string
+	}
+	upSQLReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	VersionStub        func() int64
+	versionMutex       sync.RWMutex
+	versionArgsForCall []struct {
+	}
+	versionReturns struct {
+		result1 int64
+	}
+	versionReturnsOnCall map[int]struct {
+		result1 int64
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePlannableMigration) DownSQL() []string {
+	fake.downSQLMutex.Lock()
+	ret, specificReturn := fake.downSQLReturnsOnCall[len(fake.downSQLArgsForCall)]
+	fake.downSQLArgsForCall = append(fake.downSQLArgsForCall, struct {
+	}{})
+	stub := fake.DownSQLStub
+	fakeReturns := fake.downSQLReturns
+	fake.recordInvocation("DownSQL", []interface{}{})
+	fake.downSQLMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePlannableMigration) DownSQLCallCount() int {
+	fake.downSQLMutex.RLock()
+	defer fake.downSQLMutex.RUnlock()
+	return len(fake.downSQLArgsForCall)
+}
+
+func (fake *FakePlannableMigration) DownSQLCalls(stub func() []string) {
+	fake.downSQLMutex.Lock()
+	defer fake.downSQLMutex.Unlock()
+	fake.DownSQLStub = stub
+}
+
+func (fake *FakePlannableMigration) DownSQLReturns(result1 []string) {
+	fake.downSQLMutex.Lock()
+	defer fake.downSQLMutex.Unlock()
+	fake.DownSQLStub = nil
+	fake.downSQLReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) DownSQLReturnsOnCall(i int, result1 []string) {
+	fake.downSQLMutex.Lock()
+	defer fake.downSQLMutex.Unlock()
+	fake.DownSQLStub = nil
+	if fake.downSQLReturnsOnCall == nil {
+		fake.downSQLReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.downSQLReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) SetClock(arg1 clock.Clock) {
+	fake.setClockMutex.Lock()
+	fake.setClockArgsForCall = append(fake.setClockArgsForCall, struct {
+		arg1 clock.Clock
+	}{arg1})
+	stub := fake.SetClockStub
+	fake.recordInvocation("SetClock", []interface{}{arg1})
+	fake.setClockMutex.Unlock()
+	if stub != nil {
+		fake.SetClockStub(arg1)
+	}
+}
+
+func (fake *FakePlannableMigration) SetClockCallCount() int {
+	fake.setClockMutex.RLock()
+	defer fake.setClockMutex.RUnlock()
+	return len(fake.setClockArgsForCall)
+}
+
+func (fake *FakePlannableMigration) SetClockCalls(stub func(clock.Clock)) {
+	fake.setClockMutex.Lock()
+	defer fake.setClockMutex.Unlock()
+	fake.SetClockStub = stub
+}
+
+func (fake *FakePlannableMigration) SetClockArgsForCall(i int) clock.Clock {
+	fake.setClockMutex.RLock()
+	defer fake.setClockMutex.RUnlock()
+	argsForCall := fake.setClockArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePlannableMigration) SetCryptor(arg1 encryption.Cryptor) {
+	fake.setCryptorMutex.Lock()
+	fake.setCryptorArgsForCall = append(fake.setCryptorArgsForCall, struct {
+		arg1 encryption.Cryptor
+	}{arg1})
+	stub := fake.SetCryptorStub
+	fake.recordInvocation("SetCryptor", []interface{}{arg1})
+	fake.setCryptorMutex.Unlock()
+	if stub != nil {
+		fake.SetCryptorStub(arg1)
+	}
+}
+
+func (fake *FakePlannableMigration) SetCryptorCallCount() int {
+	fake.setCryptorMutex.RLock()
+	defer fake.setCryptorMutex.RUnlock()
+	return len(fake.setCryptorArgsForCall)
+}
+
+func (fake *FakePlannableMigration) SetCryptorCalls(stub func(encryption.Cryptor)) {
+	fake.setCryptorMutex.Lock()
+	defer fake.setCryptorMutex.Unlock()
+	fake.SetCryptorStub = stub
+}
+
+func (fake *FakePlannableMigration) SetCryptorArgsForCall(i int) encryption.Cryptor {
+	fake.setCryptorMutex.RLock()
+	defer fake.setCryptorMutex.RUnlock()
+	argsForCall := fake.setCryptorArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePlannableMigration) SetDBFlavor(arg1 string) {
+	fake.setDBFlavorMutex.Lock()
+	fake.setDBFlavorArgsForCall = append(fake.setDBFlavorArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetDBFlavorStub
+	fake.recordInvocation("SetDBFlavor", []interface{}{arg1})
+	fake.setDBFlavorMutex.Unlock()
+	if stub != nil {
+		fake.SetDBFlavorStub(arg1)
+	}
+}
+
+func (fake *FakePlannableMigration) SetDBFlavorCallCount() int {
+	fake.setDBFlavorMutex.RLock()
+	defer fake.setDBFlavorMutex.RUnlock()
+	return len(fake.setDBFlavorArgsForCall)
+}
+
+func (fake *FakePlannableMigration) SetDBFlavorCalls(stub func(string)) {
+	fake.setDBFlavorMutex.Lock()
+	defer fake.setDBFlavorMutex.Unlock()
+	fake.SetDBFlavorStub = stub
+}
+
+func (fake *FakePlannableMigration) SetDBFlavorArgsForCall(i int) string {
+	fake.setDBFlavorMutex.RLock()
+	defer fake.setDBFlavorMutex.RUnlock()
+	argsForCall := fake.setDBFlavorArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePlannableMigration) SetRawSQLDB(arg1 *sql.DB) {
+	fake.setRawSQLDBMutex.Lock()
+	fake.setRawSQLDBArgsForCall = append(fake.setRawSQLDBArgsForCall, struct {
+		arg1 *sql.DB
+	}{arg1})
+	stub := fake.SetRawSQLDBStub
+	fake.recordInvocation("SetRawSQLDB", []interface{}{arg1})
+	fake.setRawSQLDBMutex.Unlock()
+	if stub != nil {
+		fake.SetRawSQLDBStub(arg1)
+	}
+}
+
+func (fake *FakePlannableMigration) SetRawSQLDBCallCount() int {
+	fake.setRawSQLDBMutex.RLock()
+	defer fake.setRawSQLDBMutex.RUnlock()
+	return len(fake.setRawSQLDBArgsForCall)
+}
+
+func (fake *FakePlannableMigration) SetRawSQLDBCalls(stub func(*sql.DB)) {
+	fake.setRawSQLDBMutex.Lock()
+	defer fake.setRawSQLDBMutex.Unlock()
+	fake.SetRawSQLDBStub = stub
+}
+
+func (fake *FakePlannableMigration) SetRawSQLDBArgsForCall(i int) *sql.DB {
+	fake.setRawSQLDBMutex.RLock()
+	defer fake.setRawSQLDBMutex.RUnlock()
+	argsForCall := fake.setRawSQLDBArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePlannableMigration) String() string {
+	fake.stringMutex.Lock()
+	ret, specificReturn := fake.stringReturnsOnCall[len(fake.stringArgsForCall)]
+	fake.stringArgsForCall = append(fake.stringArgsForCall, struct {
+	}{})
+	stub := fake.StringStub
+	fakeReturns := fake.stringReturns
+	fake.recordInvocation("String", []interface{}{})
+	fake.stringMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePlannableMigration) StringCallCount() int {
+	fake.stringMutex.RLock()
+	defer fake.stringMutex.RUnlock()
+	return len(fake.stringArgsForCall)
+}
+
+func (fake *FakePlannableMigration) StringCalls(stub func() string) {
+	fake.stringMutex.Lock()
+	defer fake.stringMutex.Unlock()
+	fake.StringStub = stub
+}
+
+func (fake *FakePlannableMigration) StringReturns(result1 string) {
+	fake.stringMutex.Lock()
+	defer fake.stringMutex.Unlock()
+	fake.StringStub = nil
+	fake.stringReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) StringReturnsOnCall(i int, result1 string) {
+	fake.stringMutex.Lock()
+	defer fake.stringMutex.Unlock()
+	fake.StringStub = nil
+	if fake.stringReturnsOnCall == nil {
+		fake.stringReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.stringReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) Up(arg1 lager.Logger) error {
+	fake.upMutex.Lock()
+	ret, specificReturn := fake.upReturnsOnCall[len(fake.upArgsForCall)]
+	fake.upArgsForCall = append(fake.upArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.UpStub
+	fakeReturns := fake.upReturns
+	fake.recordInvocation("Up", []interface{}{arg1})
+	fake.upMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePlannableMigration) UpCallCount() int {
+	fake.upMutex.RLock()
+	defer fake.upMutex.RUnlock()
+	return len(fake.upArgsForCall)
+}
+
+func (fake *FakePlannableMigration) UpCalls(stub func(lager.Logger) error) {
+	fake.upMutex.Lock()
+	defer fake.upMutex.Unlock()
+	fake.UpStub = stub
+}
+
+func (fake *FakePlannableMigration) UpArgsForCall(i int) lager.Logger {
+	fake.upMutex.RLock()
+	defer fake.upMutex.RUnlock()
+	argsForCall := fake.upArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePlannableMigration) UpReturns(result1 error) {
+	fake.upMutex.Lock()
+	defer fake.upMutex.Unlock()
+	fake.UpStub = nil
+	fake.upReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) UpReturnsOnCall(i int, result1 error) {
+	fake.upMutex.Lock()
+	defer fake.upMutex.Unlock()
+	fake.UpStub = nil
+	if fake.upReturnsOnCall == nil {
+		fake.upReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) UpSQL() []string {
+	fake.upSQLMutex.Lock()
+	ret, specificReturn := fake.upSQLReturnsOnCall[len(fake.upSQLArgsForCall)]
+	fake.upSQLArgsForCall = append(fake.upSQLArgsForCall, struct {
+	}{})
+	stub := fake.UpSQLStub
+	fakeReturns := fake.upSQLReturns
+	fake.recordInvocation("UpSQL", []interface{}{})
+	fake.upSQLMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePlannableMigration) UpSQLCallCount() int {
+	fake.upSQLMutex.RLock()
+	defer fake.upSQLMutex.RUnlock()
+	return len(fake.upSQLArgsForCall)
+}
+
+func (fake *FakePlannableMigration) UpSQLCalls(stub func() []string) {
+	fake.upSQLMutex.Lock()
+	defer fake.upSQLMutex.Unlock()
+	fake.UpSQLStub = stub
+}
+
+func (fake *FakePlannableMigration) UpSQLReturns(result1 []string) {
+	fake.upSQLMutex.Lock()
+	defer fake.upSQLMutex.Unlock()
+	fake.UpSQLStub = nil
+	fake.upSQLReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) UpSQLReturnsOnCall(i int, result1 []string) {
+	fake.upSQLMutex.Lock()
+	defer fake.upSQLMutex.Unlock()
+	fake.UpSQLStub = nil
+	if fake.upSQLReturnsOnCall == nil {
+		fake.upSQLReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.upSQLReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) Version() int64 {
+	fake.versionMutex.Lock()
+	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
+	fake.versionArgsForCall = append(fake.versionArgsForCall, struct {
+	}{})
+	stub := fake.VersionStub
+	fakeReturns := fake.versionReturns
+	fake.recordInvocation("Version", []interface{}{})
+	fake.versionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePlannableMigration) VersionCallCount() int {
+	fake.versionMutex.RLock()
+	defer fake.versionMutex.RUnlock()
+	return len(fake.versionArgsForCall)
+}
+
+func (fake *FakePlannableMigration) VersionCalls(stub func() int64) {
+	fake.versionMutex.Lock()
+	defer fake.versionMutex.Unlock()
+	fake.VersionStub = stub
+}
+
+func (fake *FakePlannableMigration) VersionReturns(result1 int64) {
+	fake.versionMutex.Lock()
+	defer fake.versionMutex.Unlock()
+	fake.VersionStub = nil
+	fake.versionReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) VersionReturnsOnCall(i int, result1 int64) {
+	fake.versionMutex.Lock()
+	defer fake.versionMutex.Unlock()
+	fake.VersionStub = nil
+	if fake.versionReturnsOnCall == nil {
+		fake.versionReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.versionReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakePlannableMigration) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.downSQLMutex.RLock()
+	defer fake.downSQLMutex.RUnlock()
+	fake.setClockMutex.RLock()
+	defer fake.setClockMutex.RUnlock()
+	fake.setCryptorMutex.RLock()
+	defer fake.setCryptorMutex.RUnlock()
+	fake.setDBFlavorMutex.RLock()
+	defer fake.setDBFlavorMutex.RUnlock()
+	fake.setRawSQLDBMutex.RLock()
+	defer fake.setRawSQLDBMutex.RUnlock()
+	fake.stringMutex.RLock()
+	defer fake.stringMutex.RUnlock()
+	fake.upMutex.RLock()
+	defer fake.upMutex.RUnlock()
+	fake.upSQLMutex.RLock()
+	defer fake.upSQLMutex.RUnlock()
+	fake.versionMutex.RLock()
+	defer fake.versionMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePlannableMigration) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ migration.PlannableMigration = new(FakePlannableMigration)
@@ -35,6 +35,7 @@ type ActualLRPChange struct {
 
 type ActualLRPFilter struct {
 	Domain      string
+	Domains     []string
 	CellID      string
 	ProcessGuid string
 	Index       *int32
@@ -0,0 +1,42 @@
+package models
+
+// What the next convergence pass does with an ActualLRP.
+const (
+	ConvergenceActionNone             = "none"
+	ConvergenceActionCreateMissing    = "create_missing"
+	ConvergenceActionStartUnclaimed   = "start_unclaimed"
+	ConvergenceActionRestartCrashed   = "restart_crashed"
+	ConvergenceActionMarkSuspect      = "mark_suspect"
+	ConvergenceActionUnclaim          = "unclaim"
+	ConvergenceActionRemove           = "remove"
+	ConvergenceActionRestoreSuspect   = "restore_suspect"
+	ConvergenceActionRemoveSuspect    = "remove_suspect"
+	ConvergenceActionRemoveEvacuating = "remove_evacuating"
+	ConvergenceActionRetire           = "retire"
+)
+
+// The state of the auction placing an ActualLRP. The BBS does not track
+// auctions, so it is inferred from the ActualLRP.
+const (
+	AuctionStatusPending = "pending"
+	AuctionStatusFailed  = "failed"
+	AuctionStatusPlaced  = "placed"
+)
+
+func (request *ExplainActualLRPRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.Index < 0 {
+		validationError = validationError.Append(ErrInvalidField{"index"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
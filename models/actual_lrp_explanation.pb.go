@@ -0,0 +1,1871 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: actual_lrp_explanation.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ExplainActualLRPRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Index       int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
+}
+
+func (m *ExplainActualLRPRequest) Reset()      { *m = ExplainActualLRPRequest{} }
+func (*ExplainActualLRPRequest) ProtoMessage() {}
+func (*ExplainActualLRPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea1f57fec31d2aa, []int{0}
+}
+func (m *ExplainActualLRPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainActualLRPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainActualLRPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExplainActualLRPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainActualLRPRequest.Merge(m, src)
+}
+func (m *ExplainActualLRPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainActualLRPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainActualLRPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainActualLRPRequest proto.InternalMessageInfo
+
+func (m *ExplainActualLRPRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *ExplainActualLRPRequest) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ExplainActualLRPResponse struct {
+	Error       *Error                `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Explanation *ActualLRPExplanation `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+}
+
+func (m *ExplainActualLRPResponse) Reset()      { *m = ExplainActualLRPResponse{} }
+func (*ExplainActualLRPResponse) ProtoMessage() {}
+func (*ExplainActualLRPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea1f57fec31d2aa, []int{1}
+}
+func (m *ExplainActualLRPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainActualLRPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainActualLRPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExplainActualLRPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainActualLRPResponse.Merge(m, src)
+}
+func (m *ExplainActualLRPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainActualLRPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainActualLRPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainActualLRPResponse proto.InternalMessageInfo
+
+func (m *ExplainActualLRPResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ExplainActualLRPResponse) GetExplanation() *ActualLRPExplanation {
+	if m != nil {
+		return m.Explanation
+	}
+	return nil
+}
+
+type ActualLRPExplanation struct {
+	ProcessGuid       string                          `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Index             int32                           `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
+	Domain            string                          `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	Desired           bool                            `protobuf:"varint,4,opt,name=desired,proto3" json:"desired"`
+	DesiredInstances  int32                           `protobuf:"varint,5,opt,name=desired_instances,json=desiredInstances,proto3" json:"desired_instances"`
+	FreshDomain       bool                            `protobuf:"varint,6,opt,name=fresh_domain,json=freshDomain,proto3" json:"fresh_domain"`
+	Instances         []*ActualLRPInstanceExplanation `protobuf:"bytes,7,rep,name=instances,proto3" json:"instances,omitempty"`
+	ConvergenceAction string                          `protobuf:"bytes,8,opt,name=convergence_action,json=convergenceAction,proto3" json:"convergence_action"`
+	ConvergenceReason string                          `protobuf:"bytes,9,opt,name=convergence_reason,json=convergenceReason,proto3" json:"convergence_reason,omitempty"`
+	ExplainedAt       int64                           `protobuf:"varint,10,opt,name=explained_at,json=explainedAt,proto3" json:"explained_at"`
+	ConvergencePaused bool                            `protobuf:"varint,11,opt,name=convergence_paused,json=convergencePaused,proto3" json:"convergence_paused"`
+}
+
+func (m *ActualLRPExplanation) Reset()      { *m = ActualLRPExplanation{} }
+func (*ActualLRPExplanation) ProtoMessage() {}
+func (*ActualLRPExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea1f57fec31d2aa, []int{2}
+}
+func (m *ActualLRPExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPExplanation.Merge(m, src)
+}
+func (m *ActualLRPExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPExplanation proto.InternalMessageInfo
+
+func (m *ActualLRPExplanation) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *ActualLRPExplanation) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ActualLRPExplanation) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ActualLRPExplanation) GetDesired() bool {
+	if m != nil {
+		return m.Desired
+	}
+	return false
+}
+
+func (m *ActualLRPExplanation) GetDesiredInstances() int32 {
+	if m != nil {
+		return m.DesiredInstances
+	}
+	return 0
+}
+
+func (m *ActualLRPExplanation) GetFreshDomain() bool {
+	if m != nil {
+		return m.FreshDomain
+	}
+	return false
+}
+
+func (m *ActualLRPExplanation) GetInstances() []*ActualLRPInstanceExplanation {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+func (m *ActualLRPExplanation) GetConvergenceAction() string {
+	if m != nil {
+		return m.ConvergenceAction
+	}
+	return ""
+}
+
+func (m *ActualLRPExplanation) GetConvergenceReason() string {
+	if m != nil {
+		return m.ConvergenceReason
+	}
+	return ""
+}
+
+func (m *ActualLRPExplanation) GetExplainedAt() int64 {
+	if m != nil {
+		return m.ExplainedAt
+	}
+	return 0
+}
+
+func (m *ActualLRPExplanation) GetConvergencePaused() bool {
+	if m != nil {
+		return m.ConvergencePaused
+	}
+	return false
+}
+
+type ActualLRPInstanceExplanation struct {
+	ActualLrp         *ActualLRP `protobuf:"bytes,1,opt,name=actual_lrp,json=actualLrp,proto3" json:"actual_lrp,omitempty"`
+	CellPresent       bool       `protobuf:"varint,2,opt,name=cell_present,json=cellPresent,proto3" json:"cell_present"`
+	AuctionStatus     string     `protobuf:"bytes,3,opt,name=auction_status,json=auctionStatus,proto3" json:"auction_status,omitempty"`
+	RestartEligible   bool       `protobuf:"varint,4,opt,name=restart_eligible,json=restartEligible,proto3" json:"restart_eligible"`
+	NextRestartAt     int64      `protobuf:"varint,5,opt,name=next_restart_at,json=nextRestartAt,proto3" json:"next_restart_at,omitempty"`
+	RestartsExhausted bool       `protobuf:"varint,6,opt,name=restarts_exhausted,json=restartsExhausted,proto3" json:"restarts_exhausted"`
+	ConvergenceAction string     `protobuf:"bytes,7,opt,name=convergence_action,json=convergenceAction,proto3" json:"convergence_action"`
+	ConvergenceReason string     `protobuf:"bytes,8,opt,name=convergence_reason,json=convergenceReason,proto3" json:"convergence_reason,omitempty"`
+}
+
+func (m *ActualLRPInstanceExplanation) Reset()      { *m = ActualLRPInstanceExplanation{} }
+func (*ActualLRPInstanceExplanation) ProtoMessage() {}
+func (*ActualLRPInstanceExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fea1f57fec31d2aa, []int{3}
+}
+func (m *ActualLRPInstanceExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPInstanceExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPInstanceExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPInstanceExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPInstanceExplanation.Merge(m, src)
+}
+func (m *ActualLRPInstanceExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPInstanceExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPInstanceExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPInstanceExplanation proto.InternalMessageInfo
+
+func (m *ActualLRPInstanceExplanation) GetActualLrp() *ActualLRP {
+	if m != nil {
+		return m.ActualLrp
+	}
+	return nil
+}
+
+func (m *ActualLRPInstanceExplanation) GetCellPresent() bool {
+	if m != nil {
+		return m.CellPresent
+	}
+	return false
+}
+
+func (m *ActualLRPInstanceExplanation) GetAuctionStatus() string {
+	if m != nil {
+		return m.AuctionStatus
+	}
+	return ""
+}
+
+func (m *ActualLRPInstanceExplanation) GetRestartEligible() bool {
+	if m != nil {
+		return m.RestartEligible
+	}
+	return false
+}
+
+func (m *ActualLRPInstanceExplanation) GetNextRestartAt() int64 {
+	if m != nil {
+		return m.NextRestartAt
+	}
+	return 0
+}
+
+func (m *ActualLRPInstanceExplanation) GetRestartsExhausted() bool {
+	if m != nil {
+		return m.RestartsExhausted
+	}
+	return false
+}
+
+func (m *ActualLRPInstanceExplanation) GetConvergenceAction() string {
+	if m != nil {
+		return m.ConvergenceAction
+	}
+	return ""
+}
+
+func (m *ActualLRPInstanceExplanation) GetConvergenceReason() string {
+	if m != nil {
+		return m.ConvergenceReason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExplainActualLRPRequest)(nil), "models.ExplainActualLRPRequest")
+	proto.RegisterType((*ExplainActualLRPResponse)(nil), "models.ExplainActualLRPResponse")
+	proto.RegisterType((*ActualLRPExplanation)(nil), "models.ActualLRPExplanation")
+	proto.RegisterType((*ActualLRPInstanceExplanation)(nil), "models.ActualLRPInstanceExplanation")
+}
+
+func init() { proto.RegisterFile("actual_lrp_explanation.proto", fileDescriptor_fea1f57fec31d2aa) }
+
+var fileDescriptor_fea1f57fec31d2aa = []byte{
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x9b, 0x26, 0x6d, 0xd6, 0xbf, 0xfe, 0x9a, 0xac, 0x0a, 0x98, 0x2a, 0xd8, 0x51, 0x00,
+	0x29, 0x87, 0x92, 0xa2, 0x96, 0x33, 0xa8, 0x81, 0x08, 0x21, 0x55, 0xa2, 0x5a, 0x1e, 0xc0, 0xda,
+	0xd8, 0xd3, 0xd4, 0x92, 0xe3, 0x35, 0xde, 0x35, 0x0a, 0x27, 0x78, 0x04, 0xc4, 0x53, 0x70, 0xe5,
+	0x2d, 0x38, 0xf6, 0xd8, 0x93, 0x45, 0xd3, 0x0b, 0xf2, 0xa9, 0x8f, 0x80, 0xb2, 0x6b, 0x37, 0x4e,
+	0x5d, 0x38, 0x20, 0x38, 0x79, 0xe7, 0xfb, 0x66, 0xe7, 0xcf, 0x37, 0xb3, 0x46, 0x6d, 0xea, 0x88,
+	0x98, 0xfa, 0xb6, 0x1f, 0x85, 0x36, 0x4c, 0x43, 0x9f, 0x06, 0x54, 0x78, 0x2c, 0xe8, 0x87, 0x11,
+	0x13, 0x0c, 0xd7, 0x27, 0xcc, 0x05, 0x9f, 0x6f, 0x3f, 0x1a, 0x7b, 0xe2, 0x24, 0x1e, 0xf5, 0x1d,
+	0x36, 0xd9, 0x1d, 0xb3, 0x31, 0xdb, 0x95, 0xf4, 0x28, 0x3e, 0x96, 0x96, 0x34, 0xe4, 0x49, 0x5d,
+	0xdb, 0x6e, 0x2e, 0x82, 0x66, 0x88, 0x0e, 0x51, 0xc4, 0x22, 0x65, 0x74, 0x19, 0xba, 0x33, 0x9c,
+	0xa7, 0xf2, 0x82, 0x03, 0xe9, 0x77, 0x48, 0x8e, 0x08, 0xbc, 0x8d, 0x81, 0x0b, 0xbc, 0x8f, 0xfe,
+	0x0b, 0x23, 0xe6, 0x00, 0xe7, 0xf6, 0x38, 0xf6, 0x5c, 0x43, 0xeb, 0x68, 0xbd, 0xc6, 0xa0, 0x99,
+	0x26, 0xd6, 0x12, 0x4e, 0xf4, 0xcc, 0x7a, 0x19, 0x7b, 0x2e, 0xb6, 0x50, 0xcd, 0x0b, 0x5c, 0x98,
+	0x1a, 0x2b, 0x1d, 0xad, 0x57, 0x1b, 0x34, 0xd2, 0xc4, 0x52, 0x00, 0x51, 0x9f, 0xee, 0x07, 0x64,
+	0x94, 0x13, 0xf2, 0x90, 0x05, 0x1c, 0xf0, 0x7d, 0x54, 0x93, 0xb5, 0xc9, 0x54, 0xfa, 0xde, 0x46,
+	0x5f, 0xb5, 0xdc, 0x1f, 0xce, 0x41, 0xa2, 0x38, 0xfc, 0x14, 0xe9, 0x05, 0x71, 0x64, 0x1e, 0x7d,
+	0xaf, 0x9d, 0xbb, 0x5e, 0x05, 0x1d, 0x2e, 0x7c, 0x48, 0xf1, 0x42, 0xf7, 0x73, 0x0d, 0x6d, 0xdd,
+	0xe4, 0xf5, 0x6f, 0xfa, 0xc5, 0x3b, 0xa8, 0xee, 0xb2, 0x09, 0xf5, 0x02, 0xa3, 0x2a, 0xe3, 0x6d,
+	0xa5, 0x89, 0xd5, 0x54, 0xc8, 0x0e, 0x9b, 0x78, 0x02, 0x26, 0xa1, 0x78, 0x4f, 0x32, 0x1f, 0xfc,
+	0x10, 0xad, 0xb9, 0xc0, 0xbd, 0x08, 0x5c, 0x63, 0xb5, 0xa3, 0xf5, 0xd6, 0x07, 0x7a, 0x9a, 0x58,
+	0x39, 0x44, 0xf2, 0x03, 0x1e, 0xa0, 0x56, 0x76, 0xb4, 0xbd, 0x80, 0x0b, 0x1a, 0x38, 0xc0, 0x8d,
+	0x9a, 0xac, 0xe0, 0x56, 0x9a, 0x58, 0x65, 0x92, 0x34, 0x33, 0xe8, 0x55, 0x8e, 0xcc, 0xdb, 0x3d,
+	0x8e, 0x80, 0x9f, 0xd8, 0x59, 0x79, 0x75, 0x99, 0x4f, 0xb6, 0x5b, 0xc4, 0x89, 0x2e, 0xad, 0x17,
+	0xaa, 0xbe, 0x01, 0x6a, 0x2c, 0x12, 0xae, 0x75, 0xaa, 0x3d, 0x7d, 0xef, 0x41, 0x49, 0xfa, 0x3c,
+	0x47, 0x71, 0x04, 0x8b, 0x6b, 0x78, 0x88, 0xb0, 0xc3, 0x82, 0x77, 0x10, 0x8d, 0x21, 0x70, 0xc0,
+	0xa6, 0x8e, 0x9c, 0xe3, 0xba, 0x54, 0xe7, 0x76, 0x9a, 0x58, 0x37, 0xb0, 0xa4, 0x55, 0xc0, 0x0e,
+	0x24, 0x84, 0x5f, 0x2f, 0x87, 0x89, 0x80, 0x72, 0x16, 0x18, 0x0d, 0x19, 0xa6, 0x93, 0x26, 0x56,
+	0xbb, 0xcc, 0x16, 0x04, 0x2f, 0x06, 0x24, 0x92, 0x9c, 0x0b, 0x02, 0x6a, 0x33, 0xc1, 0xb5, 0xa9,
+	0x30, 0x50, 0x47, 0xeb, 0x55, 0x95, 0x20, 0x45, 0x9c, 0xe8, 0x57, 0xd6, 0x81, 0xb8, 0xde, 0x4c,
+	0x48, 0x63, 0x0e, 0xae, 0xa1, 0x4b, 0x2d, 0x4b, 0xcd, 0x28, 0x76, 0x29, 0xf7, 0x91, 0x84, 0xba,
+	0x5f, 0x57, 0x51, 0xfb, 0x77, 0xfa, 0xe1, 0xc7, 0x08, 0x2d, 0x1e, 0x72, 0xf6, 0x3e, 0x5a, 0x25,
+	0xe5, 0x49, 0x43, 0x39, 0x1d, 0x46, 0xe1, 0xbc, 0x1d, 0x07, 0x7c, 0xdf, 0x0e, 0x23, 0xe0, 0x10,
+	0x08, 0x63, 0x65, 0x31, 0xdf, 0x22, 0x4e, 0xf4, 0xb9, 0x75, 0xa4, 0x0c, 0xfc, 0x1c, 0xfd, 0x4f,
+	0x63, 0xa9, 0xaf, 0xcd, 0x05, 0x15, 0x31, 0xcf, 0xb6, 0xb6, 0x9d, 0x26, 0x96, 0xb1, 0xcc, 0x14,
+	0xc4, 0xdc, 0xc8, 0x98, 0x37, 0x92, 0xc0, 0xcf, 0x50, 0x33, 0x02, 0x2e, 0x68, 0x24, 0x6c, 0xf0,
+	0xbd, 0xb1, 0x37, 0xf2, 0x21, 0xdb, 0x66, 0xb9, 0xfc, 0xd7, 0x39, 0xb2, 0x99, 0x21, 0xc3, 0x0c,
+	0xc0, 0x43, 0xb4, 0x19, 0xc0, 0x54, 0xd8, 0xb9, 0x27, 0x15, 0x72, 0xb9, 0xab, 0x83, 0x7b, 0x69,
+	0x62, 0xdd, 0xbd, 0x46, 0x15, 0xeb, 0x98, 0x53, 0x44, 0x31, 0x6a, 0x36, 0x99, 0x1b, 0xb7, 0x61,
+	0x7a, 0x42, 0x63, 0x2e, 0xc0, 0x35, 0xea, 0x8b, 0xd9, 0x94, 0x59, 0xd2, 0xca, 0xb1, 0x61, 0x0e,
+	0xfd, 0x62, 0x5f, 0xd7, 0xfe, 0xce, 0xbe, 0xae, 0xff, 0xf1, 0xbe, 0x0e, 0x9e, 0x9c, 0x9e, 0x9b,
+	0x95, 0xb3, 0x73, 0xb3, 0x72, 0x79, 0x6e, 0x6a, 0x1f, 0x67, 0xa6, 0xf6, 0x65, 0x66, 0x56, 0xbe,
+	0xcd, 0x4c, 0xed, 0x74, 0x66, 0x6a, 0xdf, 0x67, 0xa6, 0xf6, 0x63, 0x66, 0x56, 0x2e, 0x67, 0xa6,
+	0xf6, 0xe9, 0xc2, 0xac, 0x9c, 0x5e, 0x98, 0x95, 0xb3, 0x0b, 0xb3, 0x32, 0xaa, 0xcb, 0xff, 0xfe,
+	0xfe, 0xcf, 0x01, 0x00, 0xa3, 0xce, 0xe5, 0xf1, 0x6d, 0x06, 0x00, 0x00,
+}
+
+func (this *ExplainActualLRPRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ExplainActualLRPRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExplainActualLRPResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ExplainActualLRPResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Explanation != nil {
+		s = append(s, "Explanation: "+fmt.Sprintf("%#v", this.Explanation)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPExplanation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&models.ActualLRPExplanation{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "Desired: "+fmt.Sprintf("%#v", this.Desired)+",\n")
+	s = append(s, "DesiredInstances: "+fmt.Sprintf("%#v", this.DesiredInstances)+",\n")
+	s = append(s, "FreshDomain: "+fmt.Sprintf("%#v", this.FreshDomain)+",\n")
+	if this.Instances != nil {
+		s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
+	}
+	s = append(s, "ConvergenceAction: "+fmt.Sprintf("%#v", this.ConvergenceAction)+",\n")
+	s = append(s, "ConvergenceReason: "+fmt.Sprintf("%#v", this.ConvergenceReason)+",\n")
+	s = append(s, "ExplainedAt: "+fmt.Sprintf("%#v", this.ExplainedAt)+",\n")
+	s = append(s, "ConvergencePaused: "+fmt.Sprintf("%#v", this.ConvergencePaused)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPInstanceExplanation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&models.ActualLRPInstanceExplanation{")
+	if this.ActualLrp != nil {
+		s = append(s, "ActualLrp: "+fmt.Sprintf("%#v", this.ActualLrp)+",\n")
+	}
+	s = append(s, "CellPresent: "+fmt.Sprintf("%#v", this.CellPresent)+",\n")
+	s = append(s, "AuctionStatus: "+fmt.Sprintf("%#v", this.AuctionStatus)+",\n")
+	s = append(s, "RestartEligible: "+fmt.Sprintf("%#v", this.RestartEligible)+",\n")
+	s = append(s, "NextRestartAt: "+fmt.Sprintf("%#v", this.NextRestartAt)+",\n")
+	s = append(s, "RestartsExhausted: "+fmt.Sprintf("%#v", this.RestartsExhausted)+",\n")
+	s = append(s, "ConvergenceAction: "+fmt.Sprintf("%#v", this.ConvergenceAction)+",\n")
+	s = append(s, "ConvergenceReason: "+fmt.Sprintf("%#v", this.ConvergenceReason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringActualLrpExplanation(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ExplainActualLRPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExplainActualLRPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExplainActualLRPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExplainActualLRPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExplainActualLRPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExplainActualLRPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Explanation != nil {
+		{
+			size := m.Explanation.Size()
+			i -= size
+			if _, err := m.Explanation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintActualLrpExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintActualLrpExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActualLRPExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConvergencePaused {
+		i--
+		if m.ConvergencePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExplainedAt != 0 {
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(m.ExplainedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ConvergenceReason) > 0 {
+		i -= len(m.ConvergenceReason)
+		copy(dAtA[i:], m.ConvergenceReason)
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(len(m.ConvergenceReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ConvergenceAction) > 0 {
+		i -= len(m.ConvergenceAction)
+		copy(dAtA[i:], m.ConvergenceAction)
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(len(m.ConvergenceAction)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Instances) > 0 {
+		for iNdEx := len(m.Instances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Instances[iNdEx].Size()
+				i -= size
+				if _, err := m.Instances[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintActualLrpExplanation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.FreshDomain {
+		i--
+		if m.FreshDomain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DesiredInstances != 0 {
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(m.DesiredInstances))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Desired {
+		i--
+		if m.Desired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActualLRPInstanceExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPInstanceExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPInstanceExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConvergenceReason) > 0 {
+		i -= len(m.ConvergenceReason)
+		copy(dAtA[i:], m.ConvergenceReason)
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(len(m.ConvergenceReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ConvergenceAction) > 0 {
+		i -= len(m.ConvergenceAction)
+		copy(dAtA[i:], m.ConvergenceAction)
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(len(m.ConvergenceAction)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RestartsExhausted {
+		i--
+		if m.RestartsExhausted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextRestartAt != 0 {
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(m.NextRestartAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RestartEligible {
+		i--
+		if m.RestartEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AuctionStatus) > 0 {
+		i -= len(m.AuctionStatus)
+		copy(dAtA[i:], m.AuctionStatus)
+		i = encodeVarintActualLrpExplanation(dAtA, i, uint64(len(m.AuctionStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CellPresent {
+		i--
+		if m.CellPresent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActualLrp != nil {
+		{
+			size := m.ActualLrp.Size()
+			i -= size
+			if _, err := m.ActualLrp.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintActualLrpExplanation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintActualLrpExplanation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovActualLrpExplanation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExplainActualLRPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovActualLrpExplanation(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *ExplainActualLRPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	if m.Explanation != nil {
+		l = m.Explanation.Size()
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	return n
+}
+
+func (m *ActualLRPExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovActualLrpExplanation(uint64(m.Index))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	if m.Desired {
+		n += 2
+	}
+	if m.DesiredInstances != 0 {
+		n += 1 + sovActualLrpExplanation(uint64(m.DesiredInstances))
+	}
+	if m.FreshDomain {
+		n += 2
+	}
+	if len(m.Instances) > 0 {
+		for _, e := range m.Instances {
+			l = e.Size()
+			n += 1 + l + sovActualLrpExplanation(uint64(l))
+		}
+	}
+	l = len(m.ConvergenceAction)
+	if l > 0 {
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	l = len(m.ConvergenceReason)
+	if l > 0 {
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	if m.ExplainedAt != 0 {
+		n += 1 + sovActualLrpExplanation(uint64(m.ExplainedAt))
+	}
+	if m.ConvergencePaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *ActualLRPInstanceExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrp != nil {
+		l = m.ActualLrp.Size()
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	if m.CellPresent {
+		n += 2
+	}
+	l = len(m.AuctionStatus)
+	if l > 0 {
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	if m.RestartEligible {
+		n += 2
+	}
+	if m.NextRestartAt != 0 {
+		n += 1 + sovActualLrpExplanation(uint64(m.NextRestartAt))
+	}
+	if m.RestartsExhausted {
+		n += 2
+	}
+	l = len(m.ConvergenceAction)
+	if l > 0 {
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	l = len(m.ConvergenceReason)
+	if l > 0 {
+		n += 1 + l + sovActualLrpExplanation(uint64(l))
+	}
+	return n
+}
+
+func sovActualLrpExplanation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozActualLrpExplanation(x uint64) (n int) {
+	return sovActualLrpExplanation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ExplainActualLRPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExplainActualLRPRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExplainActualLRPResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExplainActualLRPResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Explanation:` + strings.Replace(this.Explanation.String(), "ActualLRPExplanation", "ActualLRPExplanation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPExplanation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForInstances := "[]*ActualLRPInstanceExplanation{"
+	for _, f := range this.Instances {
+		repeatedStringForInstances += strings.Replace(f.String(), "ActualLRPInstanceExplanation", "ActualLRPInstanceExplanation", 1) + ","
+	}
+	repeatedStringForInstances += "}"
+	s := strings.Join([]string{`&ActualLRPExplanation{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`Desired:` + fmt.Sprintf("%v", this.Desired) + `,`,
+		`DesiredInstances:` + fmt.Sprintf("%v", this.DesiredInstances) + `,`,
+		`FreshDomain:` + fmt.Sprintf("%v", this.FreshDomain) + `,`,
+		`Instances:` + repeatedStringForInstances + `,`,
+		`ConvergenceAction:` + fmt.Sprintf("%v", this.ConvergenceAction) + `,`,
+		`ConvergenceReason:` + fmt.Sprintf("%v", this.ConvergenceReason) + `,`,
+		`ExplainedAt:` + fmt.Sprintf("%v", this.ExplainedAt) + `,`,
+		`ConvergencePaused:` + fmt.Sprintf("%v", this.ConvergencePaused) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPInstanceExplanation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPInstanceExplanation{`,
+		`ActualLrp:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrp), "ActualLRP", "ActualLRP", 1) + `,`,
+		`CellPresent:` + fmt.Sprintf("%v", this.CellPresent) + `,`,
+		`AuctionStatus:` + fmt.Sprintf("%v", this.AuctionStatus) + `,`,
+		`RestartEligible:` + fmt.Sprintf("%v", this.RestartEligible) + `,`,
+		`NextRestartAt:` + fmt.Sprintf("%v", this.NextRestartAt) + `,`,
+		`RestartsExhausted:` + fmt.Sprintf("%v", this.RestartsExhausted) + `,`,
+		`ConvergenceAction:` + fmt.Sprintf("%v", this.ConvergenceAction) + `,`,
+		`ConvergenceReason:` + fmt.Sprintf("%v", this.ConvergenceReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringActualLrpExplanation(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ExplainActualLRPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpExplanation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainActualLRPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainActualLRPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpExplanation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExplainActualLRPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpExplanation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainActualLRPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainActualLRPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explanation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Explanation == nil {
+				m.Explanation = &ActualLRPExplanation{}
+			}
+			if err := m.Explanation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpExplanation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpExplanation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desired = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredInstances", wireType)
+			}
+			m.DesiredInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreshDomain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FreshDomain = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instances = append(m.Instances, &ActualLRPInstanceExplanation{})
+			if err := m.Instances[len(m.Instances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergenceAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvergenceAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergenceReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvergenceReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplainedAt", wireType)
+			}
+			m.ExplainedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExplainedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergencePaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConvergencePaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpExplanation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPInstanceExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpExplanation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPInstanceExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPInstanceExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrp == nil {
+				m.ActualLrp = &ActualLRP{}
+			}
+			if err := m.ActualLrp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellPresent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CellPresent = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartEligible = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRestartAt", wireType)
+			}
+			m.NextRestartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRestartAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartsExhausted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartsExhausted = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergenceAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvergenceAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergenceReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvergenceReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpExplanation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpExplanation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipActualLrpExplanation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowActualLrpExplanation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActualLrpExplanation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthActualLrpExplanation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupActualLrpExplanation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthActualLrpExplanation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthActualLrpExplanation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowActualLrpExplanation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupActualLrpExplanation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -143,6 +143,48 @@ var _ = Describe("RestartCalculator", func() {
 		})
 	})
 
+	Describe("NextRestartTime", func() {
+		var calc models.RestartCalculator
+
+		BeforeEach(func() {
+			calc = models.NewRestartCalculator(3, 119*time.Second, 200)
+		})
+
+		It("is the crash time while restarts are immediate", func() {
+			next, ok := calc.NextRestartTime(1000, 2)
+			Expect(ok).To(BeTrue())
+			Expect(next).To(BeEquivalentTo(1000))
+		})
+
+		It("backs off exponentially up to the max backoff duration", func() {
+			next, ok := calc.NextRestartTime(0, 3)
+			Expect(ok).To(BeTrue())
+			Expect(next).To(Equal((30 * time.Second).Nanoseconds()))
+
+			next, ok = calc.NextRestartTime(0, 4)
+			Expect(ok).To(BeTrue())
+			Expect(next).To(Equal((60 * time.Second).Nanoseconds()))
+
+			next, ok = calc.NextRestartTime(0, 10)
+			Expect(ok).To(BeTrue())
+			Expect(next).To(Equal((119 * time.Second).Nanoseconds()))
+		})
+
+		It("agrees with ShouldRestart", func() {
+			for crashCount := int32(3); crashCount < 10; crashCount++ {
+				next, ok := calc.NextRestartTime(0, crashCount)
+				Expect(ok).To(BeTrue())
+				Expect(calc.ShouldRestart(next-1, 0, crashCount)).To(BeFalse())
+				Expect(calc.ShouldRestart(next, 0, crashCount)).To(BeTrue())
+			}
+		})
+
+		It("is never once the restart attempts are used up", func() {
+			_, ok := calc.NextRestartTime(0, 200)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Describe("Validate", func() {
 		It("the default values are valid", func() {
 			calc := models.NewDefaultRestartCalculator()
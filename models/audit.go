@@ -0,0 +1,27 @@
+package models
+
+type AuditEntryFilter struct {
+	Route      string
+	Identity   string
+	TargetGuid string
+	Since      int64
+	Limit      int
+}
+
+func (request *AuditEntriesRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Since < 0 {
+		validationError = validationError.Append(ErrInvalidField{"since"})
+	}
+
+	if request.Limit < 0 {
+		validationError = validationError.Append(ErrInvalidField{"limit"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Requests", func() {
+	Describe("AuditEntriesRequest", func() {
+		Describe("Validate", func() {
+			It("accepts an empty request", func() {
+				request := models.AuditEntriesRequest{}
+				Expect(request.Validate()).To(BeNil())
+			})
+
+			It("rejects a negative since and limit", func() {
+				request := models.AuditEntriesRequest{Since: -1, Limit: -1}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"since"}, models.ErrInvalidField{"limit"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package models
+
+import "fmt"
+
+// NewStreamedEvent wraps an event for the event streams of the BBS gRPC
+// service.
+func NewStreamedEvent(event Event) (*StreamedEvent, error) {
+	streamed := &StreamedEvent{}
+
+	switch event := event.(type) {
+	case *DesiredLRPCreatedEvent:
+		streamed.Event = &StreamedEvent_DesiredLrpCreated{DesiredLrpCreated: event}
+	case *DesiredLRPChangedEvent:
+		streamed.Event = &StreamedEvent_DesiredLrpChanged{DesiredLrpChanged: event}
+	case *DesiredLRPRemovedEvent:
+		streamed.Event = &StreamedEvent_DesiredLrpRemoved{DesiredLrpRemoved: event}
+	case *ActualLRPCreatedEvent:
+		streamed.Event = &StreamedEvent_ActualLrpCreated{ActualLrpCreated: event}
+	case *ActualLRPChangedEvent:
+		streamed.Event = &StreamedEvent_ActualLrpChanged{ActualLrpChanged: event}
+	case *ActualLRPRemovedEvent:
+		streamed.Event = &StreamedEvent_ActualLrpRemoved{ActualLrpRemoved: event}
+	case *ActualLRPCrashedEvent:
+		streamed.Event = &StreamedEvent_ActualLrpCrashed{ActualLrpCrashed: event}
+	case *ActualLRPInstanceCreatedEvent:
+		streamed.Event = &StreamedEvent_ActualLrpInstanceCreated{ActualLrpInstanceCreated: event}
+	case *ActualLRPInstanceChangedEvent:
+		streamed.Event = &StreamedEvent_ActualLrpInstanceChanged{ActualLrpInstanceChanged: event}
+	case *ActualLRPInstanceRemovedEvent:
+		streamed.Event = &StreamedEvent_ActualLrpInstanceRemoved{ActualLrpInstanceRemoved: event}
+	case *TaskCreatedEvent:
+		streamed.Event = &StreamedEvent_TaskCreated{TaskCreated: event}
+	case *TaskChangedEvent:
+		streamed.Event = &StreamedEvent_TaskChanged{TaskChanged: event}
+	case *TaskRemovedEvent:
+		streamed.Event = &StreamedEvent_TaskRemoved{TaskRemoved: event}
+	default:
+		return nil, fmt.Errorf("unknown event type: %T", event)
+	}
+
+	return streamed, nil
+}
+
+// ModelEvent returns the event wrapped by the StreamedEvent, or nil when it
+// holds none.
+func (e *StreamedEvent) ModelEvent() Event {
+	switch event := e.GetEvent().(type) {
+	case *StreamedEvent_DesiredLrpCreated:
+		return event.DesiredLrpCreated
+	case *StreamedEvent_DesiredLrpChanged:
+		return event.DesiredLrpChanged
+	case *StreamedEvent_DesiredLrpRemoved:
+		return event.DesiredLrpRemoved
+	case *StreamedEvent_ActualLrpCreated:
+		return event.ActualLrpCreated
+	case *StreamedEvent_ActualLrpChanged:
+		return event.ActualLrpChanged
+	case *StreamedEvent_ActualLrpRemoved:
+		return event.ActualLrpRemoved
+	case *StreamedEvent_ActualLrpCrashed:
+		return event.ActualLrpCrashed
+	case *StreamedEvent_ActualLrpInstanceCreated:
+		return event.ActualLrpInstanceCreated
+	case *StreamedEvent_ActualLrpInstanceChanged:
+		return event.ActualLrpInstanceChanged
+	case *StreamedEvent_ActualLrpInstanceRemoved:
+		return event.ActualLrpInstanceRemoved
+	case *StreamedEvent_TaskCreated:
+		return event.TaskCreated
+	case *StreamedEvent_TaskChanged:
+		return event.TaskChanged
+	case *StreamedEvent_TaskRemoved:
+		return event.TaskRemoved
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,3966 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bbs_service.proto
+
+package models
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type EmptyRequest struct {
+}
+
+func (m *EmptyRequest) Reset()      { *m = EmptyRequest{} }
+func (*EmptyRequest) ProtoMessage() {}
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17890cbba306084f, []int{0}
+}
+func (m *EmptyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmptyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmptyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmptyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyRequest.Merge(m, src)
+}
+func (m *EmptyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmptyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyRequest proto.InternalMessageInfo
+
+type StreamedEvent struct {
+	// Types that are valid to be assigned to Event:
+	//	*StreamedEvent_DesiredLrpCreated
+	//	*StreamedEvent_DesiredLrpChanged
+	//	*StreamedEvent_DesiredLrpRemoved
+	//	*StreamedEvent_ActualLrpCreated
+	//	*StreamedEvent_ActualLrpChanged
+	//	*StreamedEvent_ActualLrpRemoved
+	//	*StreamedEvent_ActualLrpCrashed
+	//	*StreamedEvent_ActualLrpInstanceCreated
+	//	*StreamedEvent_ActualLrpInstanceChanged
+	//	*StreamedEvent_ActualLrpInstanceRemoved
+	//	*StreamedEvent_TaskCreated
+	//	*StreamedEvent_TaskChanged
+	//	*StreamedEvent_TaskRemoved
+	Event isStreamedEvent_Event `protobuf_oneof:"event"`
+}
+
+func (m *StreamedEvent) Reset()      { *m = StreamedEvent{} }
+func (*StreamedEvent) ProtoMessage() {}
+func (*StreamedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17890cbba306084f, []int{1}
+}
+func (m *StreamedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamedEvent.Merge(m, src)
+}
+func (m *StreamedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamedEvent proto.InternalMessageInfo
+
+type isStreamedEvent_Event interface {
+	isStreamedEvent_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamedEvent_DesiredLrpCreated struct {
+	DesiredLrpCreated *DesiredLRPCreatedEvent `protobuf:"bytes,1,opt,name=desired_lrp_created,json=desiredLrpCreated,proto3,oneof" json:"desired_lrp_created,omitempty"`
+}
+type StreamedEvent_DesiredLrpChanged struct {
+	DesiredLrpChanged *DesiredLRPChangedEvent `protobuf:"bytes,2,opt,name=desired_lrp_changed,json=desiredLrpChanged,proto3,oneof" json:"desired_lrp_changed,omitempty"`
+}
+type StreamedEvent_DesiredLrpRemoved struct {
+	DesiredLrpRemoved *DesiredLRPRemovedEvent `protobuf:"bytes,3,opt,name=desired_lrp_removed,json=desiredLrpRemoved,proto3,oneof" json:"desired_lrp_removed,omitempty"`
+}
+type StreamedEvent_ActualLrpCreated struct {
+	ActualLrpCreated *ActualLRPCreatedEvent `protobuf:"bytes,4,opt,name=actual_lrp_created,json=actualLrpCreated,proto3,oneof" json:"actual_lrp_created,omitempty"`
+}
+type StreamedEvent_ActualLrpChanged struct {
+	ActualLrpChanged *ActualLRPChangedEvent `protobuf:"bytes,5,opt,name=actual_lrp_changed,json=actualLrpChanged,proto3,oneof" json:"actual_lrp_changed,omitempty"`
+}
+type StreamedEvent_ActualLrpRemoved struct {
+	ActualLrpRemoved *ActualLRPRemovedEvent `protobuf:"bytes,6,opt,name=actual_lrp_removed,json=actualLrpRemoved,proto3,oneof" json:"actual_lrp_removed,omitempty"`
+}
+type StreamedEvent_ActualLrpCrashed struct {
+	ActualLrpCrashed *ActualLRPCrashedEvent `protobuf:"bytes,7,opt,name=actual_lrp_crashed,json=actualLrpCrashed,proto3,oneof" json:"actual_lrp_crashed,omitempty"`
+}
+type StreamedEvent_ActualLrpInstanceCreated struct {
+	ActualLrpInstanceCreated *ActualLRPInstanceCreatedEvent `protobuf:"bytes,8,opt,name=actual_lrp_instance_created,json=actualLrpInstanceCreated,proto3,oneof" json:"actual_lrp_instance_created,omitempty"`
+}
+type StreamedEvent_ActualLrpInstanceChanged struct {
+	ActualLrpInstanceChanged *ActualLRPInstanceChangedEvent `protobuf:"bytes,9,opt,name=actual_lrp_instance_changed,json=actualLrpInstanceChanged,proto3,oneof" json:"actual_lrp_instance_changed,omitempty"`
+}
+type StreamedEvent_ActualLrpInstanceRemoved struct {
+	ActualLrpInstanceRemoved *ActualLRPInstanceRemovedEvent `protobuf:"bytes,10,opt,name=actual_lrp_instance_removed,json=actualLrpInstanceRemoved,proto3,oneof" json:"actual_lrp_instance_removed,omitempty"`
+}
+type StreamedEvent_TaskCreated struct {
+	TaskCreated *TaskCreatedEvent `protobuf:"bytes,11,opt,name=task_created,json=taskCreated,proto3,oneof" json:"task_created,omitempty"`
+}
+type StreamedEvent_TaskChanged struct {
+	TaskChanged *TaskChangedEvent `protobuf:"bytes,12,opt,name=task_changed,json=taskChanged,proto3,oneof" json:"task_changed,omitempty"`
+}
+type StreamedEvent_TaskRemoved struct {
+	TaskRemoved *TaskRemovedEvent `protobuf:"bytes,13,opt,name=task_removed,json=taskRemoved,proto3,oneof" json:"task_removed,omitempty"`
+}
+
+func (*StreamedEvent_DesiredLrpCreated) isStreamedEvent_Event()        {}
+func (*StreamedEvent_DesiredLrpChanged) isStreamedEvent_Event()        {}
+func (*StreamedEvent_DesiredLrpRemoved) isStreamedEvent_Event()        {}
+func (*StreamedEvent_ActualLrpCreated) isStreamedEvent_Event()         {}
+func (*StreamedEvent_ActualLrpChanged) isStreamedEvent_Event()         {}
+func (*StreamedEvent_ActualLrpRemoved) isStreamedEvent_Event()         {}
+func (*StreamedEvent_ActualLrpCrashed) isStreamedEvent_Event()         {}
+func (*StreamedEvent_ActualLrpInstanceCreated) isStreamedEvent_Event() {}
+func (*StreamedEvent_ActualLrpInstanceChanged) isStreamedEvent_Event() {}
+func (*StreamedEvent_ActualLrpInstanceRemoved) isStreamedEvent_Event() {}
+func (*StreamedEvent_TaskCreated) isStreamedEvent_Event()              {}
+func (*StreamedEvent_TaskChanged) isStreamedEvent_Event()              {}
+func (*StreamedEvent_TaskRemoved) isStreamedEvent_Event()              {}
+
+func (m *StreamedEvent) GetEvent() isStreamedEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetDesiredLrpCreated() *DesiredLRPCreatedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_DesiredLrpCreated); ok {
+		return x.DesiredLrpCreated
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetDesiredLrpChanged() *DesiredLRPChangedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_DesiredLrpChanged); ok {
+		return x.DesiredLrpChanged
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetDesiredLrpRemoved() *DesiredLRPRemovedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_DesiredLrpRemoved); ok {
+		return x.DesiredLrpRemoved
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetActualLrpCreated() *ActualLRPCreatedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_ActualLrpCreated); ok {
+		return x.ActualLrpCreated
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetActualLrpChanged() *ActualLRPChangedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_ActualLrpChanged); ok {
+		return x.ActualLrpChanged
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetActualLrpRemoved() *ActualLRPRemovedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_ActualLrpRemoved); ok {
+		return x.ActualLrpRemoved
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetActualLrpCrashed() *ActualLRPCrashedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_ActualLrpCrashed); ok {
+		return x.ActualLrpCrashed
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetActualLrpInstanceCreated() *ActualLRPInstanceCreatedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_ActualLrpInstanceCreated); ok {
+		return x.ActualLrpInstanceCreated
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetActualLrpInstanceChanged() *ActualLRPInstanceChangedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_ActualLrpInstanceChanged); ok {
+		return x.ActualLrpInstanceChanged
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetActualLrpInstanceRemoved() *ActualLRPInstanceRemovedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_ActualLrpInstanceRemoved); ok {
+		return x.ActualLrpInstanceRemoved
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetTaskCreated() *TaskCreatedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_TaskCreated); ok {
+		return x.TaskCreated
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetTaskChanged() *TaskChangedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_TaskChanged); ok {
+		return x.TaskChanged
+	}
+	return nil
+}
+
+func (m *StreamedEvent) GetTaskRemoved() *TaskRemovedEvent {
+	if x, ok := m.GetEvent().(*StreamedEvent_TaskRemoved); ok {
+		return x.TaskRemoved
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamedEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamedEvent_DesiredLrpCreated)(nil),
+		(*StreamedEvent_DesiredLrpChanged)(nil),
+		(*StreamedEvent_DesiredLrpRemoved)(nil),
+		(*StreamedEvent_ActualLrpCreated)(nil),
+		(*StreamedEvent_ActualLrpChanged)(nil),
+		(*StreamedEvent_ActualLrpRemoved)(nil),
+		(*StreamedEvent_ActualLrpCrashed)(nil),
+		(*StreamedEvent_ActualLrpInstanceCreated)(nil),
+		(*StreamedEvent_ActualLrpInstanceChanged)(nil),
+		(*StreamedEvent_ActualLrpInstanceRemoved)(nil),
+		(*StreamedEvent_TaskCreated)(nil),
+		(*StreamedEvent_TaskChanged)(nil),
+		(*StreamedEvent_TaskRemoved)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*EmptyRequest)(nil), "models.EmptyRequest")
+	proto.RegisterType((*StreamedEvent)(nil), "models.StreamedEvent")
+}
+
+func init() { proto.RegisterFile("bbs_service.proto", fileDescriptor_17890cbba306084f) }
+
+var fileDescriptor_17890cbba306084f = []byte{
+	// 1554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x73, 0xd3, 0x46,
+	0x14, 0xc7, 0x6d, 0x20, 0xfc, 0x58, 0x3b, 0x24, 0x11, 0x25, 0xb1, 0x9d, 0x20, 0x68, 0x98, 0x52,
+	0x98, 0x4e, 0x03, 0x93, 0x72, 0xe8, 0x85, 0x99, 0xc6, 0x8e, 0x09, 0x69, 0xc3, 0x34, 0xb5, 0x09,
+	0xed, 0x4c, 0xa7, 0xf5, 0xac, 0xa5, 0x8d, 0xa2, 0x22, 0x4b, 0x42, 0xbb, 0xf2, 0xe0, 0x4b, 0x87,
+	0x63, 0x8f, 0xfd, 0x33, 0xfa, 0x5f, 0xf4, 0xda, 0x23, 0x47, 0x8e, 0xc5, 0x5c, 0x7a, 0xe4, 0x4f,
+	0xe8, 0x68, 0xb5, 0xbf, 0x24, 0xad, 0x88, 0x4d, 0xa7, 0x37, 0xfb, 0x7d, 0xdf, 0xfb, 0xbc, 0x7d,
+	0x6f, 0xa5, 0xd5, 0xee, 0x82, 0x95, 0xe1, 0x10, 0x0f, 0x30, 0x8a, 0xc6, 0xae, 0x85, 0xb6, 0xc2,
+	0x28, 0x20, 0x81, 0x71, 0x7e, 0x14, 0xd8, 0xc8, 0xc3, 0xad, 0xcf, 0x1d, 0x97, 0x9c, 0xc4, 0xc3,
+	0x2d, 0x2b, 0x18, 0xdd, 0x75, 0x02, 0x27, 0xb8, 0x4b, 0xe5, 0x61, 0x7c, 0x4c, 0xff, 0xd1, 0x3f,
+	0xf4, 0x57, 0x1a, 0xd6, 0xda, 0x80, 0x16, 0x89, 0xa1, 0x37, 0xf0, 0xa2, 0x70, 0x80, 0x5e, 0x84,
+	0x1e, 0xf4, 0x21, 0x71, 0x03, 0x9f, 0xa9, 0x4d, 0x45, 0x8d, 0xd0, 0xf3, 0x18, 0x61, 0x82, 0x99,
+	0x54, 0x83, 0xb1, 0xed, 0x12, 0xf6, 0x67, 0xc5, 0x42, 0x9e, 0x37, 0xb0, 0x82, 0xc8, 0x16, 0xa1,
+	0x06, 0x35, 0xe1, 0x78, 0x34, 0x82, 0xd1, 0x84, 0xc7, 0x24, 0x36, 0x0e, 0xb8, 0x62, 0x05, 0xfe,
+	0xb1, 0xeb, 0x0c, 0x22, 0xe4, 0x05, 0xd0, 0x66, 0xc6, 0x55, 0x2b, 0xf0, 0xc7, 0x28, 0x72, 0x90,
+	0x6f, 0xa1, 0x41, 0x32, 0x1a, 0x66, 0x37, 0x55, 0x3b, 0x86, 0xc7, 0x88, 0x4c, 0x06, 0x63, 0xe8,
+	0x8d, 0x59, 0xf5, 0xad, 0x96, 0x8d, 0xb0, 0x1b, 0x21, 0x5b, 0x37, 0xd2, 0x35, 0xdb, 0xc5, 0x51,
+	0x1c, 0x26, 0x65, 0x0d, 0x86, 0xb1, 0xed, 0x20, 0x3e, 0xea, 0xba, 0x1d, 0x8c, 0xa0, 0xcb, 0x53,
+	0x2c, 0x23, 0xdf, 0x8a, 0x26, 0xa1, 0x52, 0xfd, 0x32, 0x1a, 0x43, 0x2b, 0x56, 0xfb, 0x51, 0x47,
+	0x63, 0xe4, 0x0b, 0xf0, 0x4a, 0x12, 0x4d, 0x90, 0x0f, 0x7d, 0x3e, 0x0b, 0x2d, 0x10, 0xba, 0xbe,
+	0xc3, 0x0b, 0x24, 0x10, 0x3f, 0xcb, 0x0d, 0x66, 0xf3, 0x32, 0xa8, 0x77, 0x47, 0x21, 0x99, 0xf4,
+	0x52, 0xf3, 0xe6, 0x9f, 0x17, 0xc1, 0x62, 0x9f, 0x44, 0x08, 0x8e, 0x90, 0xdd, 0x4d, 0xe0, 0xc6,
+	0x21, 0xb8, 0xa2, 0x16, 0x63, 0x45, 0x08, 0x12, 0x64, 0x37, 0xaa, 0x37, 0xaa, 0xb7, 0x6b, 0xdb,
+	0xe6, 0x56, 0x3a, 0xcd, 0x5b, 0xbb, 0xa9, 0xcb, 0x41, 0xef, 0xb0, 0x93, 0x3a, 0xd0, 0xe0, 0x47,
+	0x95, 0xde, 0x0a, 0x0b, 0x3e, 0x88, 0x42, 0xa6, 0x14, 0x88, 0x27, 0xd0, 0x77, 0x90, 0xdd, 0x38,
+	0x53, 0x4a, 0x4c, 0x1d, 0x74, 0xc4, 0x54, 0xc9, 0x13, 0x23, 0x34, 0x0a, 0xc6, 0xc8, 0x6e, 0x9c,
+	0x2d, 0x23, 0xf6, 0x52, 0x07, 0x0d, 0x91, 0x29, 0xc6, 0x63, 0x60, 0x28, 0xcf, 0x1a, 0x2f, 0xfa,
+	0x1c, 0x05, 0x5e, 0xe3, 0xc0, 0x1d, 0xea, 0x51, 0xac, 0x79, 0x39, 0x0d, 0x55, 0x4a, 0xce, 0xe1,
+	0x58, 0xc5, 0x0b, 0x65, 0xb8, 0x6c, 0xc1, 0x0a, 0x8e, 0xd5, 0x9b, 0xc5, 0xf1, 0x72, 0xcf, 0x97,
+	0xe0, 0x72, 0xd5, 0x4a, 0x5c, 0x59, 0xb1, 0x10, 0x9f, 0x20, 0xbb, 0x71, 0xa1, 0xb4, 0x58, 0xaa,
+	0xeb, 0x8a, 0xa5, 0x82, 0x71, 0x0c, 0xd6, 0x15, 0x9c, 0xeb, 0x63, 0x92, 0x3c, 0x91, 0xa2, 0x89,
+	0x17, 0x29, 0xf7, 0x93, 0x02, 0x77, 0x9f, 0x39, 0xe6, 0x9a, 0xd9, 0x10, 0xfc, 0x9c, 0x43, 0x69,
+	0x1e, 0xd6, 0xdd, 0x4b, 0xa7, 0xe5, 0xc9, 0x76, 0x59, 0x93, 0x87, 0x75, 0xbb, 0x24, 0x0f, 0x6f,
+	0x3b, 0x38, 0x25, 0x4f, 0xae, 0xfd, 0xc5, 0x3c, 0x7c, 0x1a, 0x1e, 0x80, 0x3a, 0x7d, 0x45, 0x79,
+	0xa3, 0x6a, 0x14, 0xdc, 0xe0, 0xe0, 0x27, 0x10, 0x3f, 0xcb, 0xf5, 0xa6, 0x46, 0xa4, 0x4d, 0x86,
+	0xb3, 0xfa, 0xeb, 0x9a, 0xf0, 0x6c, 0xc9, 0x35, 0x22, 0x6d, 0x22, 0x9c, 0x97, 0xb5, 0x58, 0x0c,
+	0xcf, 0x55, 0x52, 0x23, 0xd2, 0xd6, 0xbe, 0x00, 0x16, 0xe8, 0x62, 0xb4, 0xfd, 0xf2, 0x26, 0x38,
+	0xdb, 0x6e, 0xf7, 0x8d, 0x6d, 0x70, 0xee, 0xd0, 0xf5, 0x1d, 0xe3, 0x23, 0x4e, 0x50, 0xd7, 0x99,
+	0x96, 0xb0, 0x26, 0x3e, 0x3d, 0x84, 0xc3, 0xc0, 0xc7, 0xc8, 0xf8, 0x12, 0x5c, 0xd8, 0xa5, 0x6b,
+	0x20, 0x2e, 0x09, 0x5b, 0x13, 0xef, 0x72, 0xea, 0x26, 0x22, 0xf7, 0x41, 0xfd, 0x28, 0xc4, 0x28,
+	0x22, 0xa9, 0x60, 0xac, 0x73, 0x47, 0xd5, 0xca, 0x29, 0x1b, 0x7a, 0x91, 0xa1, 0x3a, 0x00, 0x88,
+	0x39, 0xc4, 0x46, 0xb3, 0x30, 0xaf, 0x98, 0x63, 0x5a, 0x3a, 0x89, 0x41, 0x8e, 0xc0, 0x72, 0x37,
+	0xf9, 0x7c, 0xb9, 0xbe, 0x10, 0x8d, 0xeb, 0xa2, 0xa4, 0x9c, 0xc2, 0x81, 0x37, 0xca, 0x1d, 0x18,
+	0xf6, 0x7b, 0xb0, 0x24, 0x8c, 0x7b, 0x51, 0x10, 0x87, 0xd8, 0x30, 0x0b, 0xa3, 0x48, 0x05, 0x0e,
+	0xbd, 0x5e, 0xaa, 0xa7, 0xcc, 0xcd, 0xb3, 0xbf, 0x9d, 0xa9, 0x1a, 0xcf, 0xc1, 0x46, 0x4e, 0x6f,
+	0x4f, 0x0e, 0xa3, 0xc0, 0x42, 0x18, 0xef, 0xc5, 0xae, 0x6d, 0x7c, 0x56, 0x42, 0xc9, 0x78, 0xcd,
+	0x97, 0xf2, 0x57, 0x70, 0x33, 0xab, 0x67, 0x58, 0x3b, 0xbe, 0xbd, 0xef, 0xdb, 0xe8, 0x85, 0xb1,
+	0xad, 0x87, 0x69, 0x9d, 0xf9, 0x00, 0x4a, 0x7a, 0x92, 0xcd, 0xdf, 0x07, 0x97, 0x3b, 0x1e, 0x74,
+	0x47, 0x72, 0x82, 0xc4, 0x5a, 0x97, 0xb5, 0x73, 0xea, 0x66, 0x81, 0x7a, 0xe0, 0x1e, 0x23, 0x6b,
+	0x62, 0x79, 0x48, 0x4c, 0x50, 0x1f, 0x5c, 0xee, 0x13, 0x18, 0x11, 0x0d, 0x34, 0x6b, 0x9f, 0x13,
+	0x4a, 0xd7, 0x56, 0xdd, 0x48, 0x33, 0xf6, 0x79, 0xa0, 0xdf, 0x81, 0xc5, 0x87, 0xd0, 0xf5, 0x24,
+	0x53, 0xbc, 0x15, 0x19, 0xf3, 0x3c, 0xc8, 0x23, 0xb0, 0x94, 0x2e, 0x07, 0x12, 0x2a, 0x66, 0x22,
+	0x27, 0xcc, 0x8d, 0x25, 0x6e, 0xa4, 0xc7, 0x66, 0x84, 0x79, 0xb0, 0x21, 0x68, 0xa6, 0x83, 0xea,
+	0xb2, 0x6d, 0x95, 0xef, 0xc8, 0x04, 0xb7, 0xb3, 0xe3, 0xd6, 0xb8, 0xf0, 0x54, 0x77, 0x66, 0xf0,
+	0x64, 0x19, 0x07, 0xa0, 0xc1, 0x64, 0x44, 0x9f, 0x30, 0x64, 0xcb, 0x84, 0x9f, 0x8a, 0x77, 0xbf,
+	0xc4, 0xa3, 0xb0, 0xea, 0x74, 0xc5, 0x6e, 0x50, 0x9b, 0x20, 0xfd, 0x18, 0xbf, 0x2f, 0x41, 0xce,
+	0x63, 0xce, 0x04, 0x7d, 0x12, 0x84, 0xe1, 0x7b, 0x13, 0xe4, 0x3d, 0xe6, 0x4c, 0xd0, 0x8b, 0x7d,
+	0x3f, 0x33, 0x27, 0x85, 0x04, 0x79, 0x8f, 0x59, 0x12, 0x3c, 0x04, 0x35, 0xb9, 0x0f, 0xc4, 0x46,
+	0xab, 0xb8, 0x39, 0x14, 0x2b, 0xe7, 0xba, 0x56, 0x63, 0x9c, 0x21, 0x68, 0x4a, 0x73, 0xdf, 0x3a,
+	0x41, 0x76, 0xec, 0xb9, 0xbe, 0xb3, 0xef, 0x1f, 0x07, 0xef, 0xa7, 0xde, 0x29, 0x6a, 0xb9, 0x70,
+	0x91, 0xe3, 0x27, 0xb0, 0x26, 0x9d, 0xb2, 0xeb, 0xf1, 0xad, 0x22, 0x45, 0xbb, 0x14, 0xb7, 0x74,
+	0x9b, 0x5f, 0xb1, 0x02, 0x2c, 0xa7, 0x56, 0xa9, 0x19, 0x8d, 0xac, 0xbf, 0xd2, 0xd4, 0x9b, 0x45,
+	0x52, 0xf1, 0x9d, 0xfa, 0x01, 0x2c, 0x1f, 0x85, 0x36, 0x24, 0x2a, 0xf2, 0xba, 0xfc, 0xda, 0x66,
+	0x95, 0x79, 0xc9, 0xe9, 0x0b, 0xa6, 0x23, 0xe7, 0x95, 0xb9, 0xc8, 0x0e, 0x58, 0xdd, 0x15, 0x27,
+	0xb2, 0x36, 0x3d, 0x90, 0xf5, 0x09, 0x24, 0x31, 0x36, 0xc4, 0x9e, 0x4e, 0xaf, 0xf3, 0x2c, 0xb7,
+	0x4e, 0x73, 0x63, 0x89, 0xee, 0x83, 0x85, 0x64, 0x17, 0xa5, 0xec, 0x6d, 0xe8, 0x5f, 0x8e, 0xb9,
+	0x9a, 0xb3, 0xb2, 0xa8, 0x07, 0x00, 0x24, 0x86, 0xf6, 0x84, 0xce, 0x7b, 0x53, 0x75, 0x4a, 0x6d,
+	0x85, 0x2d, 0x55, 0xba, 0x55, 0x13, 0xcf, 0x3b, 0x48, 0x8b, 0x4f, 0xac, 0x32, 0x5c, 0xda, 0x78,
+	0xf8, 0x35, 0x35, 0xbc, 0xd8, 0xa5, 0xaf, 0xc0, 0x25, 0xfa, 0x01, 0xa3, 0x98, 0x46, 0xe6, 0x9b,
+	0xa6, 0x52, 0x9a, 0x1a, 0x85, 0x11, 0x76, 0x01, 0xe8, 0x24, 0xdb, 0x5d, 0x8f, 0x22, 0xd6, 0xd4,
+	0x74, 0x6a, 0x19, 0xa7, 0x8c, 0x63, 0x0f, 0x5c, 0x4c, 0xbe, 0x4f, 0x59, 0x06, 0xb7, 0xcc, 0xc6,
+	0x48, 0x3f, 0xff, 0x0f, 0x01, 0xe8, 0xa1, 0x5f, 0x90, 0x45, 0xb2, 0x8d, 0x91, 0xb6, 0x19, 0x07,
+	0xf4, 0x35, 0xa8, 0x77, 0x82, 0x51, 0xe8, 0x21, 0x92, 0xb6, 0x58, 0xac, 0x1a, 0xaa, 0x75, 0xe6,
+	0xe2, 0x16, 0x7b, 0x08, 0x07, 0xde, 0xd8, 0xf5, 0x9d, 0xff, 0xd4, 0xa5, 0xdd, 0x64, 0xd6, 0xc5,
+	0x90, 0x3e, 0x94, 0xb2, 0x0f, 0x56, 0xfa, 0xf1, 0x10, 0x5b, 0x91, 0x3b, 0x44, 0x4f, 0x02, 0xba,
+	0xed, 0xc7, 0xc6, 0xaa, 0x5c, 0x5c, 0x93, 0xff, 0xed, 0x49, 0x07, 0x79, 0xde, 0xbe, 0x2d, 0x1f,
+	0xdf, 0xcc, 0xf5, 0x01, 0xed, 0xf5, 0xbd, 0xaa, 0x71, 0x00, 0x9a, 0x0a, 0x8a, 0x9f, 0x7c, 0x3e,
+	0x08, 0x79, 0x2f, 0x99, 0xbb, 0xab, 0x0a, 0x2d, 0x19, 0x3c, 0x23, 0xe9, 0x4f, 0x0d, 0xa5, 0x9c,
+	0xfb, 0x60, 0x21, 0x49, 0x75, 0x6a, 0x1c, 0x75, 0x52, 0x9a, 0xbb, 0x98, 0x18, 0xfa, 0xf4, 0x2e,
+	0xc9, 0x45, 0x65, 0xd1, 0xd7, 0xd4, 0x68, 0xe1, 0xac, 0xbc, 0x50, 0xb5, 0x44, 0xe8, 0xd0, 0x4b,
+	0xaa, 0x32, 0xc6, 0xba, 0xca, 0x60, 0xae, 0xea, 0x41, 0x25, 0x35, 0x25, 0xa2, 0x7c, 0x82, 0xa5,
+	0xad, 0xf0, 0x11, 0x90, 0x14, 0x01, 0xd9, 0x01, 0x97, 0x76, 0x23, 0xe8, 0xa6, 0x0c, 0xb9, 0xfa,
+	0x73, 0xd3, 0x2c, 0x88, 0x3d, 0x50, 0x3f, 0xf2, 0x2d, 0x39, 0x12, 0x79, 0xf6, 0x52, 0xac, 0xb3,
+	0x80, 0x7e, 0x06, 0x57, 0x7a, 0x01, 0x81, 0x04, 0x75, 0xc5, 0xd5, 0xd7, 0x37, 0x68, 0x62, 0x88,
+	0xcd, 0x9c, 0x46, 0x2c, 0xac, 0xf4, 0x5a, 0x1f, 0xf1, 0xaa, 0x2e, 0x4b, 0x81, 0xad, 0xf1, 0xfa,
+	0xbe, 0xcb, 0x93, 0x58, 0xce, 0x5f, 0xb0, 0x7e, 0x04, 0x46, 0x26, 0xc9, 0x11, 0x86, 0x0e, 0x32,
+	0x3e, 0x2e, 0xc6, 0x71, 0xad, 0xb0, 0x35, 0xd5, 0xb9, 0xc8, 0xd3, 0xec, 0x4e, 0x72, 0x9d, 0xd9,
+	0xf5, 0x09, 0x7d, 0xc0, 0x44, 0x47, 0x55, 0x6b, 0xe1, 0x34, 0x9b, 0x15, 0x19, 0xaa, 0x0d, 0xea,
+	0x3d, 0x7a, 0xa3, 0xd9, 0xa1, 0xd7, 0x9b, 0x25, 0xf5, 0x6e, 0xc8, 0xe5, 0x4f, 0xfa, 0x0a, 0x46,
+	0x0f, 0x2c, 0x75, 0xe4, 0x7d, 0xe7, 0xa1, 0x07, 0x7d, 0xb9, 0x01, 0xcf, 0x09, 0x85, 0x23, 0x60,
+	0x41, 0x67, 0xcc, 0xa7, 0x60, 0x55, 0x91, 0xfa, 0xf4, 0x0a, 0xf5, 0x29, 0xf4, 0xc6, 0xa8, 0x64,
+	0x84, 0xb7, 0x34, 0x40, 0x25, 0x4a, 0x79, 0x86, 0xcc, 0x6f, 0xc7, 0x28, 0x8a, 0x5c, 0x1b, 0xfd,
+	0x2f, 0xfc, 0x47, 0x60, 0xe9, 0xb1, 0xbc, 0x66, 0x7d, 0x1c, 0xd8, 0x65, 0x40, 0xd1, 0x81, 0x9c,
+	0xbb, 0xb2, 0xa3, 0x31, 0xfa, 0x88, 0xe4, 0x61, 0xe2, 0x09, 0x2a, 0x6a, 0xb3, 0x92, 0xdb, 0xf7,
+	0x5f, 0xbd, 0x31, 0x2b, 0xaf, 0xdf, 0x98, 0x95, 0x77, 0x6f, 0xcc, 0xea, 0xcb, 0xa9, 0x59, 0xfd,
+	0x63, 0x6a, 0x56, 0xfe, 0x9a, 0x9a, 0xd5, 0x57, 0x53, 0xb3, 0xfa, 0xf7, 0xd4, 0xac, 0xfe, 0x33,
+	0x35, 0x2b, 0xef, 0xa6, 0x66, 0xf5, 0xf7, 0xb7, 0x66, 0xe5, 0xd5, 0x5b, 0xb3, 0xf2, 0xfa, 0xad,
+	0x59, 0x19, 0x9e, 0xa7, 0x37, 0xc2, 0x5f, 0xfc, 0x3b, 0x00, 0x21, 0x82, 0x30, 0x7f, 0xcd, 0x17,
+	0x00, 0x00,
+}
+
+func (this *EmptyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.EmptyRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StreamedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 17)
+	s = append(s, "&models.StreamedEvent{")
+	if this.Event != nil {
+		s = append(s, "Event: "+fmt.Sprintf("%#v", this.Event)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StreamedEvent_DesiredLrpCreated) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_DesiredLrpCreated{` +
+		`DesiredLrpCreated:` + fmt.Sprintf("%#v", this.DesiredLrpCreated) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_DesiredLrpChanged) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_DesiredLrpChanged{` +
+		`DesiredLrpChanged:` + fmt.Sprintf("%#v", this.DesiredLrpChanged) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_DesiredLrpRemoved) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_DesiredLrpRemoved{` +
+		`DesiredLrpRemoved:` + fmt.Sprintf("%#v", this.DesiredLrpRemoved) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_ActualLrpCreated) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_ActualLrpCreated{` +
+		`ActualLrpCreated:` + fmt.Sprintf("%#v", this.ActualLrpCreated) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_ActualLrpChanged) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_ActualLrpChanged{` +
+		`ActualLrpChanged:` + fmt.Sprintf("%#v", this.ActualLrpChanged) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_ActualLrpRemoved) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_ActualLrpRemoved{` +
+		`ActualLrpRemoved:` + fmt.Sprintf("%#v", this.ActualLrpRemoved) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_ActualLrpCrashed) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_ActualLrpCrashed{` +
+		`ActualLrpCrashed:` + fmt.Sprintf("%#v", this.ActualLrpCrashed) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_ActualLrpInstanceCreated) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_ActualLrpInstanceCreated{` +
+		`ActualLrpInstanceCreated:` + fmt.Sprintf("%#v", this.ActualLrpInstanceCreated) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_ActualLrpInstanceChanged) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_ActualLrpInstanceChanged{` +
+		`ActualLrpInstanceChanged:` + fmt.Sprintf("%#v", this.ActualLrpInstanceChanged) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_ActualLrpInstanceRemoved) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_ActualLrpInstanceRemoved{` +
+		`ActualLrpInstanceRemoved:` + fmt.Sprintf("%#v", this.ActualLrpInstanceRemoved) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_TaskCreated) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_TaskCreated{` +
+		`TaskCreated:` + fmt.Sprintf("%#v", this.TaskCreated) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_TaskChanged) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_TaskChanged{` +
+		`TaskChanged:` + fmt.Sprintf("%#v", this.TaskChanged) + `}`}, ", ")
+	return s
+}
+func (this *StreamedEvent_TaskRemoved) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.StreamedEvent_TaskRemoved{` +
+		`TaskRemoved:` + fmt.Sprintf("%#v", this.TaskRemoved) + `}`}, ", ")
+	return s
+}
+func valueToGoStringBbsService(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BBSClient is the client API for BBS service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BBSClient interface {
+	Ping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	Domains(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DomainsResponse, error)
+	UpsertDomain(ctx context.Context, in *UpsertDomainRequest, opts ...grpc.CallOption) (*UpsertDomainResponse, error)
+	ActualLRPs(ctx context.Context, in *ActualLRPsRequest, opts ...grpc.CallOption) (*ActualLRPsResponse, error)
+	ExplainActualLRP(ctx context.Context, in *ExplainActualLRPRequest, opts ...grpc.CallOption) (*ExplainActualLRPResponse, error)
+	ActualLRPGroups(ctx context.Context, in *ActualLRPGroupsRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupsByProcessGuid(ctx context.Context, in *ActualLRPGroupsByProcessGuidRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, in *ActualLRPGroupByProcessGuidAndIndexRequest, opts ...grpc.CallOption) (*ActualLRPGroupResponse, error)
+	ClaimActualLRP(ctx context.Context, in *ClaimActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	StartActualLRP(ctx context.Context, in *StartActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	CrashActualLRP(ctx context.Context, in *CrashActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	FailActualLRP(ctx context.Context, in *FailActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	RemoveActualLRP(ctx context.Context, in *RemoveActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	RetireActualLRP(ctx context.Context, in *RetireActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	RemoveEvacuatingActualLRP(ctx context.Context, in *RemoveEvacuatingActualLRPRequest, opts ...grpc.CallOption) (*RemoveEvacuatingActualLRPResponse, error)
+	EvacuateClaimedActualLRP(ctx context.Context, in *EvacuateClaimedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateCrashedActualLRP(ctx context.Context, in *EvacuateCrashedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateStoppedActualLRP(ctx context.Context, in *EvacuateStoppedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateRunningActualLRP(ctx context.Context, in *EvacuateRunningActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	DesiredLRPs(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error)
+	DesiredLRPSchedulingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPSchedulingInfosResponse, error)
+	DesiredLRPByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPResponse, error)
+	DesireDesiredLRP(ctx context.Context, in *DesireLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	UpdateDesiredLRP(ctx context.Context, in *UpdateDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	RemoveDesiredLRP(ctx context.Context, in *RemoveDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	DisruptionBudgetStatus(ctx context.Context, in *DisruptionBudgetStatusRequest, opts ...grpc.CallOption) (*DisruptionBudgetStatusResponse, error)
+	Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	TaskByGuid(ctx context.Context, in *TaskByGuidRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	DesireTask(ctx context.Context, in *DesireTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error)
+	CancelTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	FailTask(ctx context.Context, in *FailTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	RejectTask(ctx context.Context, in *RejectTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	ResolvingTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	DeleteTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	SubscribeToEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_SubscribeToEventsClient, error)
+	SubscribeToInstanceEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_SubscribeToInstanceEventsClient, error)
+	SubscribeToTaskEvents(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (BBS_SubscribeToTaskEventsClient, error)
+	Cells(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CellsResponse, error)
+	CellSummaries(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CellSummariesResponse, error)
+	CellCordons(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CellCordonsResponse, error)
+	CordonCell(ctx context.Context, in *CordonCellRequest, opts ...grpc.CallOption) (*CellCordonResponse, error)
+	DrainCell(ctx context.Context, in *DrainCellRequest, opts ...grpc.CallOption) (*CellCordonResponse, error)
+	UncordonCell(ctx context.Context, in *UncordonCellRequest, opts ...grpc.CallOption) (*CellCordonResponse, error)
+	RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error)
+	EncryptionStatus(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EncryptionStatusResponse, error)
+	EncryptionKeyUsage(ctx context.Context, in *EncryptionKeyUsageRequest, opts ...grpc.CallOption) (*EncryptionKeyUsageResponse, error)
+	AuditEntries(ctx context.Context, in *AuditEntriesRequest, opts ...grpc.CallOption) (*AuditEntriesResponse, error)
+	ReloadConfig(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	ConvergencePlan(ctx context.Context, in *ConvergencePlanRequest, opts ...grpc.CallOption) (*ConvergencePlanResponse, error)
+	ConvergenceSafetyValve(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ConvergenceSafetyValveResponse, error)
+	OverrideConvergenceSafetyValve(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ConvergenceSafetyValveResponse, error)
+	MaintenanceMode(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*MaintenanceModeResponse, error)
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceModeResponse, error)
+}
+
+type bBSClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBBSClient(cc *grpc.ClientConn) BBSClient {
+	return &bBSClient{cc}
+}
+
+func (c *bBSClient) Ping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) Domains(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DomainsResponse, error) {
+	out := new(DomainsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/Domains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UpsertDomain(ctx context.Context, in *UpsertDomainRequest, opts ...grpc.CallOption) (*UpsertDomainResponse, error) {
+	out := new(UpsertDomainResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/UpsertDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPs(ctx context.Context, in *ActualLRPsRequest, opts ...grpc.CallOption) (*ActualLRPsResponse, error) {
+	out := new(ActualLRPsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ExplainActualLRP(ctx context.Context, in *ExplainActualLRPRequest, opts ...grpc.CallOption) (*ExplainActualLRPResponse, error) {
+	out := new(ExplainActualLRPResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ExplainActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) ActualLRPGroups(ctx context.Context, in *ActualLRPGroupsRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error) {
+	out := new(ActualLRPGroupsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) ActualLRPGroupsByProcessGuid(ctx context.Context, in *ActualLRPGroupsByProcessGuidRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error) {
+	out := new(ActualLRPGroupsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPGroupsByProcessGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, in *ActualLRPGroupByProcessGuidAndIndexRequest, opts ...grpc.CallOption) (*ActualLRPGroupResponse, error) {
+	out := new(ActualLRPGroupResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPGroupByProcessGuidAndIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ClaimActualLRP(ctx context.Context, in *ClaimActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ClaimActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) StartActualLRP(ctx context.Context, in *StartActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/StartActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CrashActualLRP(ctx context.Context, in *CrashActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CrashActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) FailActualLRP(ctx context.Context, in *FailActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/FailActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveActualLRP(ctx context.Context, in *RemoveActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RemoveActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RetireActualLRP(ctx context.Context, in *RetireActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RetireActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveEvacuatingActualLRP(ctx context.Context, in *RemoveEvacuatingActualLRPRequest, opts ...grpc.CallOption) (*RemoveEvacuatingActualLRPResponse, error) {
+	out := new(RemoveEvacuatingActualLRPResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RemoveEvacuatingActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EvacuateClaimedActualLRP(ctx context.Context, in *EvacuateClaimedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EvacuateClaimedActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EvacuateCrashedActualLRP(ctx context.Context, in *EvacuateCrashedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EvacuateCrashedActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EvacuateStoppedActualLRP(ctx context.Context, in *EvacuateStoppedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EvacuateStoppedActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EvacuateRunningActualLRP(ctx context.Context, in *EvacuateRunningActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EvacuateRunningActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPs(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error) {
+	out := new(DesiredLRPsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesiredLRPs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPSchedulingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPSchedulingInfosResponse, error) {
+	out := new(DesiredLRPSchedulingInfosResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesiredLRPSchedulingInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPResponse, error) {
+	out := new(DesiredLRPResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesiredLRPByProcessGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireDesiredLRP(ctx context.Context, in *DesireLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesireDesiredLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UpdateDesiredLRP(ctx context.Context, in *UpdateDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/UpdateDesiredLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveDesiredLRP(ctx context.Context, in *RemoveDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RemoveDesiredLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DisruptionBudgetStatus(ctx context.Context, in *DisruptionBudgetStatusRequest, opts ...grpc.CallOption) (*DisruptionBudgetStatusResponse, error) {
+	out := new(DisruptionBudgetStatusResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DisruptionBudgetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/Tasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) TaskByGuid(ctx context.Context, in *TaskByGuidRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/TaskByGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireTask(ctx context.Context, in *DesireTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesireTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error) {
+	out := new(StartTaskResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/StartTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CancelTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CancelTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) FailTask(ctx context.Context, in *FailTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/FailTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RejectTask(ctx context.Context, in *RejectTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RejectTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CompleteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ResolvingTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ResolvingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DeleteTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DeleteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) SubscribeToEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_SubscribeToEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BBS_serviceDesc.Streams[0], "/models.BBS/SubscribeToEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSSubscribeToEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_SubscribeToEventsClient interface {
+	Recv() (*StreamedEvent, error)
+	grpc.ClientStream
+}
+
+type bBSSubscribeToEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSSubscribeToEventsClient) Recv() (*StreamedEvent, error) {
+	m := new(StreamedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) SubscribeToInstanceEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_SubscribeToInstanceEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BBS_serviceDesc.Streams[1], "/models.BBS/SubscribeToInstanceEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSSubscribeToInstanceEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_SubscribeToInstanceEventsClient interface {
+	Recv() (*StreamedEvent, error)
+	grpc.ClientStream
+}
+
+type bBSSubscribeToInstanceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSSubscribeToInstanceEventsClient) Recv() (*StreamedEvent, error) {
+	m := new(StreamedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) SubscribeToTaskEvents(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (BBS_SubscribeToTaskEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BBS_serviceDesc.Streams[2], "/models.BBS/SubscribeToTaskEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSSubscribeToTaskEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_SubscribeToTaskEventsClient interface {
+	Recv() (*StreamedEvent, error)
+	grpc.ClientStream
+}
+
+type bBSSubscribeToTaskEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSSubscribeToTaskEventsClient) Recv() (*StreamedEvent, error) {
+	m := new(StreamedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) Cells(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CellsResponse, error) {
+	out := new(CellsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/Cells", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CellSummaries(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CellSummariesResponse, error) {
+	out := new(CellSummariesResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CellSummaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CellCordons(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CellCordonsResponse, error) {
+	out := new(CellCordonsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CellCordons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CordonCell(ctx context.Context, in *CordonCellRequest, opts ...grpc.CallOption) (*CellCordonResponse, error) {
+	out := new(CellCordonResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CordonCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DrainCell(ctx context.Context, in *DrainCellRequest, opts ...grpc.CallOption) (*CellCordonResponse, error) {
+	out := new(CellCordonResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DrainCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UncordonCell(ctx context.Context, in *UncordonCellRequest, opts ...grpc.CallOption) (*CellCordonResponse, error) {
+	out := new(CellCordonResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/UncordonCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error) {
+	out := new(RotateEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RotateEncryptionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EncryptionStatus(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EncryptionStatusResponse, error) {
+	out := new(EncryptionStatusResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EncryptionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EncryptionKeyUsage(ctx context.Context, in *EncryptionKeyUsageRequest, opts ...grpc.CallOption) (*EncryptionKeyUsageResponse, error) {
+	out := new(EncryptionKeyUsageResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EncryptionKeyUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) AuditEntries(ctx context.Context, in *AuditEntriesRequest, opts ...grpc.CallOption) (*AuditEntriesResponse, error) {
+	out := new(AuditEntriesResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/AuditEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ReloadConfig(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ConvergencePlan(ctx context.Context, in *ConvergencePlanRequest, opts ...grpc.CallOption) (*ConvergencePlanResponse, error) {
+	out := new(ConvergencePlanResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ConvergencePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ConvergenceSafetyValve(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ConvergenceSafetyValveResponse, error) {
+	out := new(ConvergenceSafetyValveResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ConvergenceSafetyValve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) OverrideConvergenceSafetyValve(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ConvergenceSafetyValveResponse, error) {
+	out := new(ConvergenceSafetyValveResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/OverrideConvergenceSafetyValve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) MaintenanceMode(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*MaintenanceModeResponse, error) {
+	out := new(MaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/MaintenanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*MaintenanceModeResponse, error) {
+	out := new(MaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/SetMaintenanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BBSServer is the server API for BBS service.
+type BBSServer interface {
+	Ping(context.Context, *EmptyRequest) (*PingResponse, error)
+	Domains(context.Context, *EmptyRequest) (*DomainsResponse, error)
+	UpsertDomain(context.Context, *UpsertDomainRequest) (*UpsertDomainResponse, error)
+	ActualLRPs(context.Context, *ActualLRPsRequest) (*ActualLRPsResponse, error)
+	ExplainActualLRP(context.Context, *ExplainActualLRPRequest) (*ExplainActualLRPResponse, error)
+	ActualLRPGroups(context.Context, *ActualLRPGroupsRequest) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupsByProcessGuid(context.Context, *ActualLRPGroupsByProcessGuidRequest) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupByProcessGuidAndIndex(context.Context, *ActualLRPGroupByProcessGuidAndIndexRequest) (*ActualLRPGroupResponse, error)
+	ClaimActualLRP(context.Context, *ClaimActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	StartActualLRP(context.Context, *StartActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	CrashActualLRP(context.Context, *CrashActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	FailActualLRP(context.Context, *FailActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	RemoveActualLRP(context.Context, *RemoveActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	RetireActualLRP(context.Context, *RetireActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	RemoveEvacuatingActualLRP(context.Context, *RemoveEvacuatingActualLRPRequest) (*RemoveEvacuatingActualLRPResponse, error)
+	EvacuateClaimedActualLRP(context.Context, *EvacuateClaimedActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateCrashedActualLRP(context.Context, *EvacuateCrashedActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateStoppedActualLRP(context.Context, *EvacuateStoppedActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateRunningActualLRP(context.Context, *EvacuateRunningActualLRPRequest) (*EvacuationResponse, error)
+	DesiredLRPs(context.Context, *DesiredLRPsRequest) (*DesiredLRPsResponse, error)
+	DesiredLRPSchedulingInfos(context.Context, *DesiredLRPsRequest) (*DesiredLRPSchedulingInfosResponse, error)
+	DesiredLRPByProcessGuid(context.Context, *DesiredLRPByProcessGuidRequest) (*DesiredLRPResponse, error)
+	DesireDesiredLRP(context.Context, *DesireLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	UpdateDesiredLRP(context.Context, *UpdateDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	RemoveDesiredLRP(context.Context, *RemoveDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	DisruptionBudgetStatus(context.Context, *DisruptionBudgetStatusRequest) (*DisruptionBudgetStatusResponse, error)
+	Tasks(context.Context, *TasksRequest) (*TasksResponse, error)
+	TaskByGuid(context.Context, *TaskByGuidRequest) (*TaskResponse, error)
+	DesireTask(context.Context, *DesireTaskRequest) (*TaskLifecycleResponse, error)
+	StartTask(context.Context, *StartTaskRequest) (*StartTaskResponse, error)
+	CancelTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	FailTask(context.Context, *FailTaskRequest) (*TaskLifecycleResponse, error)
+	RejectTask(context.Context, *RejectTaskRequest) (*TaskLifecycleResponse, error)
+	CompleteTask(context.Context, *CompleteTaskRequest) (*TaskLifecycleResponse, error)
+	ResolvingTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	DeleteTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	SubscribeToEvents(*EventsByCellId, BBS_SubscribeToEventsServer) error
+	SubscribeToInstanceEvents(*EventsByCellId, BBS_SubscribeToInstanceEventsServer) error
+	SubscribeToTaskEvents(*EmptyRequest, BBS_SubscribeToTaskEventsServer) error
+	Cells(context.Context, *EmptyRequest) (*CellsResponse, error)
+	CellSummaries(context.Context, *EmptyRequest) (*CellSummariesResponse, error)
+	CellCordons(context.Context, *EmptyRequest) (*CellCordonsResponse, error)
+	CordonCell(context.Context, *CordonCellRequest) (*CellCordonResponse, error)
+	DrainCell(context.Context, *DrainCellRequest) (*CellCordonResponse, error)
+	UncordonCell(context.Context, *UncordonCellRequest) (*CellCordonResponse, error)
+	RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error)
+	EncryptionStatus(context.Context, *EmptyRequest) (*EncryptionStatusResponse, error)
+	EncryptionKeyUsage(context.Context, *EncryptionKeyUsageRequest) (*EncryptionKeyUsageResponse, error)
+	AuditEntries(context.Context, *AuditEntriesRequest) (*AuditEntriesResponse, error)
+	ReloadConfig(context.Context, *EmptyRequest) (*ReloadConfigResponse, error)
+	ConvergencePlan(context.Context, *ConvergencePlanRequest) (*ConvergencePlanResponse, error)
+	ConvergenceSafetyValve(context.Context, *EmptyRequest) (*ConvergenceSafetyValveResponse, error)
+	OverrideConvergenceSafetyValve(context.Context, *EmptyRequest) (*ConvergenceSafetyValveResponse, error)
+	MaintenanceMode(context.Context, *EmptyRequest) (*MaintenanceModeResponse, error)
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*MaintenanceModeResponse, error)
+}
+
+// UnimplementedBBSServer can be embedded to have forward compatible implementations.
+type UnimplementedBBSServer struct {
+}
+
+func (*UnimplementedBBSServer) Ping(ctx context.Context, req *EmptyRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedBBSServer) Domains(ctx context.Context, req *EmptyRequest) (*DomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Domains not implemented")
+}
+func (*UnimplementedBBSServer) UpsertDomain(ctx context.Context, req *UpsertDomainRequest) (*UpsertDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDomain not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPs(ctx context.Context, req *ActualLRPsRequest) (*ActualLRPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPs not implemented")
+}
+func (*UnimplementedBBSServer) ExplainActualLRP(ctx context.Context, req *ExplainActualLRPRequest) (*ExplainActualLRPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPGroups(ctx context.Context, req *ActualLRPGroupsRequest) (*ActualLRPGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPGroups not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPGroupsByProcessGuid(ctx context.Context, req *ActualLRPGroupsByProcessGuidRequest) (*ActualLRPGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPGroupsByProcessGuid not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, req *ActualLRPGroupByProcessGuidAndIndexRequest) (*ActualLRPGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPGroupByProcessGuidAndIndex not implemented")
+}
+func (*UnimplementedBBSServer) ClaimActualLRP(ctx context.Context, req *ClaimActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) StartActualLRP(ctx context.Context, req *StartActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) CrashActualLRP(ctx context.Context, req *CrashActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrashActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) FailActualLRP(ctx context.Context, req *FailActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) RemoveActualLRP(ctx context.Context, req *RemoveActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) RetireActualLRP(ctx context.Context, req *RetireActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) RemoveEvacuatingActualLRP(ctx context.Context, req *RemoveEvacuatingActualLRPRequest) (*RemoveEvacuatingActualLRPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvacuatingActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) EvacuateClaimedActualLRP(ctx context.Context, req *EvacuateClaimedActualLRPRequest) (*EvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateClaimedActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) EvacuateCrashedActualLRP(ctx context.Context, req *EvacuateCrashedActualLRPRequest) (*EvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateCrashedActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) EvacuateStoppedActualLRP(ctx context.Context, req *EvacuateStoppedActualLRPRequest) (*EvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateStoppedActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) EvacuateRunningActualLRP(ctx context.Context, req *EvacuateRunningActualLRPRequest) (*EvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateRunningActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) DesiredLRPs(ctx context.Context, req *DesiredLRPsRequest) (*DesiredLRPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesiredLRPs not implemented")
+}
+func (*UnimplementedBBSServer) DesiredLRPSchedulingInfos(ctx context.Context, req *DesiredLRPsRequest) (*DesiredLRPSchedulingInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesiredLRPSchedulingInfos not implemented")
+}
+func (*UnimplementedBBSServer) DesiredLRPByProcessGuid(ctx context.Context, req *DesiredLRPByProcessGuidRequest) (*DesiredLRPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesiredLRPByProcessGuid not implemented")
+}
+func (*UnimplementedBBSServer) DesireDesiredLRP(ctx context.Context, req *DesireLRPRequest) (*DesiredLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesireDesiredLRP not implemented")
+}
+func (*UnimplementedBBSServer) UpdateDesiredLRP(ctx context.Context, req *UpdateDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDesiredLRP not implemented")
+}
+func (*UnimplementedBBSServer) RemoveDesiredLRP(ctx context.Context, req *RemoveDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDesiredLRP not implemented")
+}
+func (*UnimplementedBBSServer) DisruptionBudgetStatus(ctx context.Context, req *DisruptionBudgetStatusRequest) (*DisruptionBudgetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisruptionBudgetStatus not implemented")
+}
+func (*UnimplementedBBSServer) Tasks(ctx context.Context, req *TasksRequest) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tasks not implemented")
+}
+func (*UnimplementedBBSServer) TaskByGuid(ctx context.Context, req *TaskByGuidRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskByGuid not implemented")
+}
+func (*UnimplementedBBSServer) DesireTask(ctx context.Context, req *DesireTaskRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesireTask not implemented")
+}
+func (*UnimplementedBBSServer) StartTask(ctx context.Context, req *StartTaskRequest) (*StartTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTask not implemented")
+}
+func (*UnimplementedBBSServer) CancelTask(ctx context.Context, req *TaskGuidRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTask not implemented")
+}
+func (*UnimplementedBBSServer) FailTask(ctx context.Context, req *FailTaskRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailTask not implemented")
+}
+func (*UnimplementedBBSServer) RejectTask(ctx context.Context, req *RejectTaskRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTask not implemented")
+}
+func (*UnimplementedBBSServer) CompleteTask(ctx context.Context, req *CompleteTaskRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTask not implemented")
+}
+func (*UnimplementedBBSServer) ResolvingTask(ctx context.Context, req *TaskGuidRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvingTask not implemented")
+}
+func (*UnimplementedBBSServer) DeleteTask(ctx context.Context, req *TaskGuidRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (*UnimplementedBBSServer) SubscribeToEvents(req *EventsByCellId, srv BBS_SubscribeToEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToEvents not implemented")
+}
+func (*UnimplementedBBSServer) SubscribeToInstanceEvents(req *EventsByCellId, srv BBS_SubscribeToInstanceEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToInstanceEvents not implemented")
+}
+func (*UnimplementedBBSServer) SubscribeToTaskEvents(req *EmptyRequest, srv BBS_SubscribeToTaskEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToTaskEvents not implemented")
+}
+func (*UnimplementedBBSServer) Cells(ctx context.Context, req *EmptyRequest) (*CellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cells not implemented")
+}
+func (*UnimplementedBBSServer) CellSummaries(ctx context.Context, req *EmptyRequest) (*CellSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CellSummaries not implemented")
+}
+func (*UnimplementedBBSServer) CellCordons(ctx context.Context, req *EmptyRequest) (*CellCordonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CellCordons not implemented")
+}
+func (*UnimplementedBBSServer) CordonCell(ctx context.Context, req *CordonCellRequest) (*CellCordonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonCell not implemented")
+}
+func (*UnimplementedBBSServer) DrainCell(ctx context.Context, req *DrainCellRequest) (*CellCordonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainCell not implemented")
+}
+func (*UnimplementedBBSServer) UncordonCell(ctx context.Context, req *UncordonCellRequest) (*CellCordonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonCell not implemented")
+}
+func (*UnimplementedBBSServer) RotateEncryptionKey(ctx context.Context, req *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateEncryptionKey not implemented")
+}
+func (*UnimplementedBBSServer) EncryptionStatus(ctx context.Context, req *EmptyRequest) (*EncryptionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionStatus not implemented")
+}
+func (*UnimplementedBBSServer) EncryptionKeyUsage(ctx context.Context, req *EncryptionKeyUsageRequest) (*EncryptionKeyUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptionKeyUsage not implemented")
+}
+func (*UnimplementedBBSServer) AuditEntries(ctx context.Context, req *AuditEntriesRequest) (*AuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditEntries not implemented")
+}
+func (*UnimplementedBBSServer) ReloadConfig(ctx context.Context, req *EmptyRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (*UnimplementedBBSServer) ConvergencePlan(ctx context.Context, req *ConvergencePlanRequest) (*ConvergencePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvergencePlan not implemented")
+}
+func (*UnimplementedBBSServer) ConvergenceSafetyValve(ctx context.Context, req *EmptyRequest) (*ConvergenceSafetyValveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvergenceSafetyValve not implemented")
+}
+func (*UnimplementedBBSServer) OverrideConvergenceSafetyValve(ctx context.Context, req *EmptyRequest) (*ConvergenceSafetyValveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideConvergenceSafetyValve not implemented")
+}
+func (*UnimplementedBBSServer) MaintenanceMode(ctx context.Context, req *EmptyRequest) (*MaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceMode not implemented")
+}
+func (*UnimplementedBBSServer) SetMaintenanceMode(ctx context.Context, req *SetMaintenanceModeRequest) (*MaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+
+func RegisterBBSServer(s *grpc.Server, srv BBSServer) {
+	s.RegisterService(&_BBS_serviceDesc, srv)
+}
+
+func _BBS_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Ping(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_Domains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Domains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Domains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Domains(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UpsertDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UpsertDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UpsertDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UpsertDomain(ctx, req.(*UpsertDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPs(ctx, req.(*ActualLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ExplainActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ExplainActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ExplainActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ExplainActualLRP(ctx, req.(*ExplainActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroups(ctx, req.(*ActualLRPGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroupsByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupsByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroupsByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroupsByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroupsByProcessGuid(ctx, req.(*ActualLRPGroupsByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroupByProcessGuidAndIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupByProcessGuidAndIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroupByProcessGuidAndIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroupByProcessGuidAndIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroupByProcessGuidAndIndex(ctx, req.(*ActualLRPGroupByProcessGuidAndIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ClaimActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ClaimActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ClaimActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ClaimActualLRP(ctx, req.(*ClaimActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_StartActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).StartActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/StartActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).StartActualLRP(ctx, req.(*StartActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CrashActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrashActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CrashActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CrashActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CrashActualLRP(ctx, req.(*CrashActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_FailActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).FailActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/FailActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).FailActualLRP(ctx, req.(*FailActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveActualLRP(ctx, req.(*RemoveActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RetireActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RetireActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RetireActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RetireActualLRP(ctx, req.(*RetireActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveEvacuatingActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEvacuatingActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveEvacuatingActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveEvacuatingActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveEvacuatingActualLRP(ctx, req.(*RemoveEvacuatingActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EvacuateClaimedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateClaimedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EvacuateClaimedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EvacuateClaimedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EvacuateClaimedActualLRP(ctx, req.(*EvacuateClaimedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EvacuateCrashedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateCrashedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EvacuateCrashedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EvacuateCrashedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EvacuateCrashedActualLRP(ctx, req.(*EvacuateCrashedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EvacuateStoppedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateStoppedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EvacuateStoppedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EvacuateStoppedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EvacuateStoppedActualLRP(ctx, req.(*EvacuateStoppedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EvacuateRunningActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateRunningActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EvacuateRunningActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EvacuateRunningActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EvacuateRunningActualLRP(ctx, req.(*EvacuateRunningActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPs(ctx, req.(*DesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPSchedulingInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPSchedulingInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPSchedulingInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPSchedulingInfos(ctx, req.(*DesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPByProcessGuid(ctx, req.(*DesiredLRPByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireDesiredLRP(ctx, req.(*DesireLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UpdateDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDesiredLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UpdateDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UpdateDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UpdateDesiredLRP(ctx, req.(*UpdateDesiredLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDesiredLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveDesiredLRP(ctx, req.(*RemoveDesiredLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DisruptionBudgetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisruptionBudgetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DisruptionBudgetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DisruptionBudgetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DisruptionBudgetStatus(ctx, req.(*DisruptionBudgetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_Tasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Tasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Tasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Tasks(ctx, req.(*TasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_TaskByGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskByGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).TaskByGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/TaskByGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).TaskByGuid(ctx, req.(*TaskByGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireTask(ctx, req.(*DesireTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_StartTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).StartTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/StartTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).StartTask(ctx, req.(*StartTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CancelTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CancelTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_FailTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).FailTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/FailTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).FailTask(ctx, req.(*FailTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RejectTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RejectTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RejectTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RejectTask(ctx, req.(*RejectTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CompleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CompleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CompleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CompleteTask(ctx, req.(*CompleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ResolvingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ResolvingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ResolvingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ResolvingTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DeleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DeleteTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_SubscribeToEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsByCellId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).SubscribeToEvents(m, &bBSSubscribeToEventsServer{stream})
+}
+
+type BBS_SubscribeToEventsServer interface {
+	Send(*StreamedEvent) error
+	grpc.ServerStream
+}
+
+type bBSSubscribeToEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSSubscribeToEventsServer) Send(m *StreamedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_SubscribeToInstanceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsByCellId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).SubscribeToInstanceEvents(m, &bBSSubscribeToInstanceEventsServer{stream})
+}
+
+type BBS_SubscribeToInstanceEventsServer interface {
+	Send(*StreamedEvent) error
+	grpc.ServerStream
+}
+
+type bBSSubscribeToInstanceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSSubscribeToInstanceEventsServer) Send(m *StreamedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_SubscribeToTaskEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EmptyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).SubscribeToTaskEvents(m, &bBSSubscribeToTaskEventsServer{stream})
+}
+
+type BBS_SubscribeToTaskEventsServer interface {
+	Send(*StreamedEvent) error
+	grpc.ServerStream
+}
+
+type bBSSubscribeToTaskEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSSubscribeToTaskEventsServer) Send(m *StreamedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_Cells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Cells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Cells",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Cells(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CellSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CellSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CellSummaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CellSummaries(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CellCordons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CellCordons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CellCordons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CellCordons(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CordonCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CordonCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CordonCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CordonCell(ctx, req.(*CordonCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DrainCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DrainCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DrainCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DrainCell(ctx, req.(*DrainCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UncordonCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UncordonCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UncordonCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UncordonCell(ctx, req.(*UncordonCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RotateEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RotateEncryptionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RotateEncryptionKey(ctx, req.(*RotateEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EncryptionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EncryptionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EncryptionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EncryptionStatus(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EncryptionKeyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptionKeyUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EncryptionKeyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EncryptionKeyUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EncryptionKeyUsage(ctx, req.(*EncryptionKeyUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_AuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).AuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/AuditEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).AuditEntries(ctx, req.(*AuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ReloadConfig(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ConvergencePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvergencePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ConvergencePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ConvergencePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ConvergencePlan(ctx, req.(*ConvergencePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ConvergenceSafetyValve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ConvergenceSafetyValve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ConvergenceSafetyValve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ConvergenceSafetyValve(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_OverrideConvergenceSafetyValve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).OverrideConvergenceSafetyValve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/OverrideConvergenceSafetyValve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).OverrideConvergenceSafetyValve(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_MaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).MaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/MaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).MaintenanceMode(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/SetMaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BBS_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "models.BBS",
+	HandlerType: (*BBSServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _BBS_Ping_Handler,
+		},
+		{
+			MethodName: "Domains",
+			Handler:    _BBS_Domains_Handler,
+		},
+		{
+			MethodName: "UpsertDomain",
+			Handler:    _BBS_UpsertDomain_Handler,
+		},
+		{
+			MethodName: "ActualLRPs",
+			Handler:    _BBS_ActualLRPs_Handler,
+		},
+		{
+			MethodName: "ExplainActualLRP",
+			Handler:    _BBS_ExplainActualLRP_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroups",
+			Handler:    _BBS_ActualLRPGroups_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroupsByProcessGuid",
+			Handler:    _BBS_ActualLRPGroupsByProcessGuid_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroupByProcessGuidAndIndex",
+			Handler:    _BBS_ActualLRPGroupByProcessGuidAndIndex_Handler,
+		},
+		{
+			MethodName: "ClaimActualLRP",
+			Handler:    _BBS_ClaimActualLRP_Handler,
+		},
+		{
+			MethodName: "StartActualLRP",
+			Handler:    _BBS_StartActualLRP_Handler,
+		},
+		{
+			MethodName: "CrashActualLRP",
+			Handler:    _BBS_CrashActualLRP_Handler,
+		},
+		{
+			MethodName: "FailActualLRP",
+			Handler:    _BBS_FailActualLRP_Handler,
+		},
+		{
+			MethodName: "RemoveActualLRP",
+			Handler:    _BBS_RemoveActualLRP_Handler,
+		},
+		{
+			MethodName: "RetireActualLRP",
+			Handler:    _BBS_RetireActualLRP_Handler,
+		},
+		{
+			MethodName: "RemoveEvacuatingActualLRP",
+			Handler:    _BBS_RemoveEvacuatingActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateClaimedActualLRP",
+			Handler:    _BBS_EvacuateClaimedActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateCrashedActualLRP",
+			Handler:    _BBS_EvacuateCrashedActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateStoppedActualLRP",
+			Handler:    _BBS_EvacuateStoppedActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateRunningActualLRP",
+			Handler:    _BBS_EvacuateRunningActualLRP_Handler,
+		},
+		{
+			MethodName: "DesiredLRPs",
+			Handler:    _BBS_DesiredLRPs_Handler,
+		},
+		{
+			MethodName: "DesiredLRPSchedulingInfos",
+			Handler:    _BBS_DesiredLRPSchedulingInfos_Handler,
+		},
+		{
+			MethodName: "DesiredLRPByProcessGuid",
+			Handler:    _BBS_DesiredLRPByProcessGuid_Handler,
+		},
+		{
+			MethodName: "DesireDesiredLRP",
+			Handler:    _BBS_DesireDesiredLRP_Handler,
+		},
+		{
+			MethodName: "UpdateDesiredLRP",
+			Handler:    _BBS_UpdateDesiredLRP_Handler,
+		},
+		{
+			MethodName: "RemoveDesiredLRP",
+			Handler:    _BBS_RemoveDesiredLRP_Handler,
+		},
+		{
+			MethodName: "DisruptionBudgetStatus",
+			Handler:    _BBS_DisruptionBudgetStatus_Handler,
+		},
+		{
+			MethodName: "Tasks",
+			Handler:    _BBS_Tasks_Handler,
+		},
+		{
+			MethodName: "TaskByGuid",
+			Handler:    _BBS_TaskByGuid_Handler,
+		},
+		{
+			MethodName: "DesireTask",
+			Handler:    _BBS_DesireTask_Handler,
+		},
+		{
+			MethodName: "StartTask",
+			Handler:    _BBS_StartTask_Handler,
+		},
+		{
+			MethodName: "CancelTask",
+			Handler:    _BBS_CancelTask_Handler,
+		},
+		{
+			MethodName: "FailTask",
+			Handler:    _BBS_FailTask_Handler,
+		},
+		{
+			MethodName: "RejectTask",
+			Handler:    _BBS_RejectTask_Handler,
+		},
+		{
+			MethodName: "CompleteTask",
+			Handler:    _BBS_CompleteTask_Handler,
+		},
+		{
+			MethodName: "ResolvingTask",
+			Handler:    _BBS_ResolvingTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _BBS_DeleteTask_Handler,
+		},
+		{
+			MethodName: "Cells",
+			Handler:    _BBS_Cells_Handler,
+		},
+		{
+			MethodName: "CellSummaries",
+			Handler:    _BBS_CellSummaries_Handler,
+		},
+		{
+			MethodName: "CellCordons",
+			Handler:    _BBS_CellCordons_Handler,
+		},
+		{
+			MethodName: "CordonCell",
+			Handler:    _BBS_CordonCell_Handler,
+		},
+		{
+			MethodName: "DrainCell",
+			Handler:    _BBS_DrainCell_Handler,
+		},
+		{
+			MethodName: "UncordonCell",
+			Handler:    _BBS_UncordonCell_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKey",
+			Handler:    _BBS_RotateEncryptionKey_Handler,
+		},
+		{
+			MethodName: "EncryptionStatus",
+			Handler:    _BBS_EncryptionStatus_Handler,
+		},
+		{
+			MethodName: "EncryptionKeyUsage",
+			Handler:    _BBS_EncryptionKeyUsage_Handler,
+		},
+		{
+			MethodName: "AuditEntries",
+			Handler:    _BBS_AuditEntries_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _BBS_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "ConvergencePlan",
+			Handler:    _BBS_ConvergencePlan_Handler,
+		},
+		{
+			MethodName: "ConvergenceSafetyValve",
+			Handler:    _BBS_ConvergenceSafetyValve_Handler,
+		},
+		{
+			MethodName: "OverrideConvergenceSafetyValve",
+			Handler:    _BBS_OverrideConvergenceSafetyValve_Handler,
+		},
+		{
+			MethodName: "MaintenanceMode",
+			Handler:    _BBS_MaintenanceMode_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _BBS_SetMaintenanceMode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeToEvents",
+			Handler:       _BBS_SubscribeToEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeToInstanceEvents",
+			Handler:       _BBS_SubscribeToInstanceEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeToTaskEvents",
+			Handler:       _BBS_SubscribeToTaskEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bbs_service.proto",
+}
+
+func (m *EmptyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamedEvent_DesiredLrpCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_DesiredLrpCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DesiredLrpCreated != nil {
+		{
+			size := m.DesiredLrpCreated.Size()
+			i -= size
+			if _, err := m.DesiredLrpCreated.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_DesiredLrpChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_DesiredLrpChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DesiredLrpChanged != nil {
+		{
+			size := m.DesiredLrpChanged.Size()
+			i -= size
+			if _, err := m.DesiredLrpChanged.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_DesiredLrpRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_DesiredLrpRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DesiredLrpRemoved != nil {
+		{
+			size := m.DesiredLrpRemoved.Size()
+			i -= size
+			if _, err := m.DesiredLrpRemoved.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_ActualLrpCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_ActualLrpCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActualLrpCreated != nil {
+		{
+			size := m.ActualLrpCreated.Size()
+			i -= size
+			if _, err := m.ActualLrpCreated.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_ActualLrpChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_ActualLrpChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActualLrpChanged != nil {
+		{
+			size := m.ActualLrpChanged.Size()
+			i -= size
+			if _, err := m.ActualLrpChanged.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_ActualLrpRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_ActualLrpRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActualLrpRemoved != nil {
+		{
+			size := m.ActualLrpRemoved.Size()
+			i -= size
+			if _, err := m.ActualLrpRemoved.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_ActualLrpCrashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_ActualLrpCrashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActualLrpCrashed != nil {
+		{
+			size := m.ActualLrpCrashed.Size()
+			i -= size
+			if _, err := m.ActualLrpCrashed.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_ActualLrpInstanceCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_ActualLrpInstanceCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActualLrpInstanceCreated != nil {
+		{
+			size := m.ActualLrpInstanceCreated.Size()
+			i -= size
+			if _, err := m.ActualLrpInstanceCreated.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_ActualLrpInstanceChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_ActualLrpInstanceChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActualLrpInstanceChanged != nil {
+		{
+			size := m.ActualLrpInstanceChanged.Size()
+			i -= size
+			if _, err := m.ActualLrpInstanceChanged.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_ActualLrpInstanceRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_ActualLrpInstanceRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ActualLrpInstanceRemoved != nil {
+		{
+			size := m.ActualLrpInstanceRemoved.Size()
+			i -= size
+			if _, err := m.ActualLrpInstanceRemoved.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_TaskCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_TaskCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TaskCreated != nil {
+		{
+			size := m.TaskCreated.Size()
+			i -= size
+			if _, err := m.TaskCreated.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_TaskChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_TaskChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TaskChanged != nil {
+		{
+			size := m.TaskChanged.Size()
+			i -= size
+			if _, err := m.TaskChanged.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamedEvent_TaskRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent_TaskRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TaskRemoved != nil {
+		{
+			size := m.TaskRemoved.Size()
+			i -= size
+			if _, err := m.TaskRemoved.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBbsService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintBbsService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBbsService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EmptyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StreamedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *StreamedEvent_DesiredLrpCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DesiredLrpCreated != nil {
+		l = m.DesiredLrpCreated.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_DesiredLrpChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DesiredLrpChanged != nil {
+		l = m.DesiredLrpChanged.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_DesiredLrpRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DesiredLrpRemoved != nil {
+		l = m.DesiredLrpRemoved.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_ActualLrpCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpCreated != nil {
+		l = m.ActualLrpCreated.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_ActualLrpChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpChanged != nil {
+		l = m.ActualLrpChanged.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_ActualLrpRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpRemoved != nil {
+		l = m.ActualLrpRemoved.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_ActualLrpCrashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpCrashed != nil {
+		l = m.ActualLrpCrashed.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_ActualLrpInstanceCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpInstanceCreated != nil {
+		l = m.ActualLrpInstanceCreated.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_ActualLrpInstanceChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpInstanceChanged != nil {
+		l = m.ActualLrpInstanceChanged.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_ActualLrpInstanceRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActualLrpInstanceRemoved != nil {
+		l = m.ActualLrpInstanceRemoved.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_TaskCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskCreated != nil {
+		l = m.TaskCreated.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_TaskChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskChanged != nil {
+		l = m.TaskChanged.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+func (m *StreamedEvent_TaskRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskRemoved != nil {
+		l = m.TaskRemoved.Size()
+		n += 1 + l + sovBbsService(uint64(l))
+	}
+	return n
+}
+
+func sovBbsService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBbsService(x uint64) (n int) {
+	return sovBbsService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EmptyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmptyRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent{`,
+		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_DesiredLrpCreated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_DesiredLrpCreated{`,
+		`DesiredLrpCreated:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrpCreated), "DesiredLRPCreatedEvent", "DesiredLRPCreatedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_DesiredLrpChanged) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_DesiredLrpChanged{`,
+		`DesiredLrpChanged:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrpChanged), "DesiredLRPChangedEvent", "DesiredLRPChangedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_DesiredLrpRemoved) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_DesiredLrpRemoved{`,
+		`DesiredLrpRemoved:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrpRemoved), "DesiredLRPRemovedEvent", "DesiredLRPRemovedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_ActualLrpCreated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_ActualLrpCreated{`,
+		`ActualLrpCreated:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpCreated), "ActualLRPCreatedEvent", "ActualLRPCreatedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_ActualLrpChanged) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_ActualLrpChanged{`,
+		`ActualLrpChanged:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpChanged), "ActualLRPChangedEvent", "ActualLRPChangedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_ActualLrpRemoved) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_ActualLrpRemoved{`,
+		`ActualLrpRemoved:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpRemoved), "ActualLRPRemovedEvent", "ActualLRPRemovedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_ActualLrpCrashed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_ActualLrpCrashed{`,
+		`ActualLrpCrashed:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpCrashed), "ActualLRPCrashedEvent", "ActualLRPCrashedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_ActualLrpInstanceCreated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_ActualLrpInstanceCreated{`,
+		`ActualLrpInstanceCreated:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceCreated), "ActualLRPInstanceCreatedEvent", "ActualLRPInstanceCreatedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_ActualLrpInstanceChanged) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_ActualLrpInstanceChanged{`,
+		`ActualLrpInstanceChanged:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceChanged), "ActualLRPInstanceChangedEvent", "ActualLRPInstanceChangedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_ActualLrpInstanceRemoved) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_ActualLrpInstanceRemoved{`,
+		`ActualLrpInstanceRemoved:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpInstanceRemoved), "ActualLRPInstanceRemovedEvent", "ActualLRPInstanceRemovedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_TaskCreated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_TaskCreated{`,
+		`TaskCreated:` + strings.Replace(fmt.Sprintf("%v", this.TaskCreated), "TaskCreatedEvent", "TaskCreatedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_TaskChanged) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_TaskChanged{`,
+		`TaskChanged:` + strings.Replace(fmt.Sprintf("%v", this.TaskChanged), "TaskChangedEvent", "TaskChangedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent_TaskRemoved) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent_TaskRemoved{`,
+		`TaskRemoved:` + strings.Replace(fmt.Sprintf("%v", this.TaskRemoved), "TaskRemovedEvent", "TaskRemovedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringBbsService(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EmptyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBbsService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmptyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmptyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBbsService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBbsService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrpCreated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DesiredLRPCreatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_DesiredLrpCreated{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrpChanged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DesiredLRPChangedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_DesiredLrpChanged{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredLrpRemoved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DesiredLRPRemovedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_DesiredLrpRemoved{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpCreated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActualLRPCreatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_ActualLrpCreated{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpChanged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActualLRPChangedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_ActualLrpChanged{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpRemoved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActualLRPRemovedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_ActualLrpRemoved{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpCrashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActualLRPCrashedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_ActualLrpCrashed{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceCreated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActualLRPInstanceCreatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_ActualLrpInstanceCreated{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceChanged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActualLRPInstanceChangedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_ActualLrpInstanceChanged{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrpInstanceRemoved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActualLRPInstanceRemovedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_ActualLrpInstanceRemoved{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskCreated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TaskCreatedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_TaskCreated{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskChanged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TaskChangedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_TaskChanged{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRemoved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TaskRemovedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StreamedEvent_TaskRemoved{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBbsService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBbsService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBbsService(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBbsService
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBbsService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBbsService
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBbsService
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBbsService
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBbsService        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBbsService          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBbsService = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,27 @@
+package models
+
+func (request *CordonCellRequest) Validate() error {
+	return validateCellID(request.CellId)
+}
+
+func (request *DrainCellRequest) Validate() error {
+	return validateCellID(request.CellId)
+}
+
+func (request *UncordonCellRequest) Validate() error {
+	return validateCellID(request.CellId)
+}
+
+func validateCellID(cellID string) error {
+	var validationError ValidationError
+
+	if cellID == "" {
+		validationError = validationError.Append(ErrInvalidField{"cell_id"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
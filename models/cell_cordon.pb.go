@@ -0,0 +1,1708 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cell_cordon.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type CellCordon struct {
+	CellId    string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Draining  bool   `protobuf:"varint,2,opt,name=draining,proto3" json:"draining"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *CellCordon) Reset()      { *m = CellCordon{} }
+func (*CellCordon) ProtoMessage() {}
+func (*CellCordon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c93698e50d29f3, []int{0}
+}
+func (m *CellCordon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellCordon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellCordon.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellCordon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellCordon.Merge(m, src)
+}
+func (m *CellCordon) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellCordon) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellCordon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellCordon proto.InternalMessageInfo
+
+func (m *CellCordon) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CellCordon) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
+func (m *CellCordon) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CellCordon) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type CordonCellRequest struct {
+	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *CordonCellRequest) Reset()      { *m = CordonCellRequest{} }
+func (*CordonCellRequest) ProtoMessage() {}
+func (*CordonCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c93698e50d29f3, []int{1}
+}
+func (m *CordonCellRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CordonCellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CordonCellRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CordonCellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonCellRequest.Merge(m, src)
+}
+func (m *CordonCellRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CordonCellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonCellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonCellRequest proto.InternalMessageInfo
+
+func (m *CordonCellRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CordonCellRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type DrainCellRequest struct {
+	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *DrainCellRequest) Reset()      { *m = DrainCellRequest{} }
+func (*DrainCellRequest) ProtoMessage() {}
+func (*DrainCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c93698e50d29f3, []int{2}
+}
+func (m *DrainCellRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainCellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainCellRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainCellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainCellRequest.Merge(m, src)
+}
+func (m *DrainCellRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainCellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainCellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainCellRequest proto.InternalMessageInfo
+
+func (m *DrainCellRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *DrainCellRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type UncordonCellRequest struct {
+	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+}
+
+func (m *UncordonCellRequest) Reset()      { *m = UncordonCellRequest{} }
+func (*UncordonCellRequest) ProtoMessage() {}
+func (*UncordonCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c93698e50d29f3, []int{3}
+}
+func (m *UncordonCellRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UncordonCellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UncordonCellRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UncordonCellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UncordonCellRequest.Merge(m, src)
+}
+func (m *UncordonCellRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UncordonCellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UncordonCellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UncordonCellRequest proto.InternalMessageInfo
+
+func (m *UncordonCellRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+type CellCordonResponse struct {
+	Error  *Error      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Cordon *CellCordon `protobuf:"bytes,2,opt,name=cordon,proto3" json:"cordon,omitempty"`
+}
+
+func (m *CellCordonResponse) Reset()      { *m = CellCordonResponse{} }
+func (*CellCordonResponse) ProtoMessage() {}
+func (*CellCordonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c93698e50d29f3, []int{4}
+}
+func (m *CellCordonResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellCordonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellCordonResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellCordonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellCordonResponse.Merge(m, src)
+}
+func (m *CellCordonResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellCordonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellCordonResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellCordonResponse proto.InternalMessageInfo
+
+func (m *CellCordonResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CellCordonResponse) GetCordon() *CellCordon {
+	if m != nil {
+		return m.Cordon
+	}
+	return nil
+}
+
+type CellCordonsResponse struct {
+	Error   *Error        `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Cordons []*CellCordon `protobuf:"bytes,2,rep,name=cordons,proto3" json:"cordons,omitempty"`
+}
+
+func (m *CellCordonsResponse) Reset()      { *m = CellCordonsResponse{} }
+func (*CellCordonsResponse) ProtoMessage() {}
+func (*CellCordonsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c93698e50d29f3, []int{5}
+}
+func (m *CellCordonsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellCordonsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellCordonsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellCordonsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellCordonsResponse.Merge(m, src)
+}
+func (m *CellCordonsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellCordonsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellCordonsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellCordonsResponse proto.InternalMessageInfo
+
+func (m *CellCordonsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CellCordonsResponse) GetCordons() []*CellCordon {
+	if m != nil {
+		return m.Cordons
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CellCordon)(nil), "models.CellCordon")
+	proto.RegisterType((*CordonCellRequest)(nil), "models.CordonCellRequest")
+	proto.RegisterType((*DrainCellRequest)(nil), "models.DrainCellRequest")
+	proto.RegisterType((*UncordonCellRequest)(nil), "models.UncordonCellRequest")
+	proto.RegisterType((*CellCordonResponse)(nil), "models.CellCordonResponse")
+	proto.RegisterType((*CellCordonsResponse)(nil), "models.CellCordonsResponse")
+}
+
+func init() { proto.RegisterFile("cell_cordon.proto", fileDescriptor_01c93698e50d29f3) }
+
+var fileDescriptor_01c93698e50d29f3 = []byte{
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x3d, 0x8f, 0xd3, 0x40,
+	0x10, 0xf5, 0x26, 0xe0, 0xbb, 0x1b, 0x83, 0x74, 0xb7, 0x77, 0x85, 0x95, 0x62, 0x6d, 0x19, 0x0a,
+	0x0b, 0x05, 0x47, 0x0a, 0x48, 0x14, 0x54, 0x38, 0x50, 0xd0, 0x5a, 0xa2, 0x8e, 0x1c, 0x7b, 0xe3,
+	0x58, 0xb2, 0xbd, 0xc6, 0x5e, 0x17, 0x74, 0xfc, 0x04, 0x7e, 0x06, 0xff, 0x83, 0x86, 0x32, 0x65,
+	0x2a, 0x8b, 0x38, 0x0d, 0x72, 0x95, 0x9f, 0x80, 0xbc, 0x9b, 0xc4, 0x69, 0x10, 0x1f, 0xd2, 0x75,
+	0x33, 0x6f, 0xdf, 0xbc, 0x37, 0x4f, 0x3b, 0x70, 0x13, 0xd0, 0x24, 0x99, 0x07, 0xac, 0x08, 0x59,
+	0xe6, 0xe4, 0x05, 0xe3, 0x0c, 0xab, 0x29, 0x0b, 0x69, 0x52, 0x8e, 0x9e, 0x47, 0x31, 0x5f, 0x55,
+	0x0b, 0x27, 0x60, 0xe9, 0x24, 0x62, 0x11, 0x9b, 0x88, 0xe7, 0x45, 0xb5, 0x14, 0x9d, 0x68, 0x44,
+	0x25, 0xc7, 0x46, 0x1a, 0x2d, 0x0a, 0x56, 0xc8, 0xc6, 0xfa, 0x86, 0x00, 0x66, 0x34, 0x49, 0x66,
+	0x42, 0x18, 0x3f, 0x85, 0x0b, 0xe1, 0x13, 0x87, 0x3a, 0x32, 0x91, 0x7d, 0xe5, 0x6a, 0x6d, 0x6d,
+	0x1c, 0x21, 0x4f, 0xed, 0x8a, 0xf7, 0x21, 0xb6, 0xe1, 0x32, 0x2c, 0xfc, 0x38, 0x8b, 0xb3, 0x48,
+	0x1f, 0x98, 0xc8, 0xbe, 0x74, 0x1f, 0xb5, 0xb5, 0x71, 0xc2, 0xbc, 0x53, 0x85, 0xc7, 0xa0, 0x16,
+	0xd4, 0x2f, 0x59, 0xa6, 0x0f, 0x85, 0xdc, 0x5d, 0x5b, 0x1b, 0xd7, 0x12, 0x19, 0xb3, 0x34, 0xe6,
+	0x34, 0xcd, 0xf9, 0x27, 0xef, 0xc0, 0xc1, 0xaf, 0x00, 0xaa, 0x3c, 0xf4, 0x39, 0x0d, 0xe7, 0x3e,
+	0xd7, 0x1f, 0x98, 0xc8, 0x1e, 0xba, 0x7a, 0x5b, 0x1b, 0x77, 0x3d, 0x7a, 0x36, 0x75, 0x75, 0x40,
+	0xdf, 0x70, 0x2b, 0x82, 0x1b, 0x19, 0xa0, 0x8b, 0xe2, 0xd1, 0x8f, 0x15, 0x2d, 0xf9, 0x5f, 0x66,
+	0xe9, 0x37, 0x1c, 0xfc, 0x79, 0x43, 0x6b, 0x09, 0xd7, 0x6f, 0xbb, 0x6c, 0xf7, 0xed, 0xf3, 0x1a,
+	0x6e, 0x3f, 0x64, 0xc1, 0xff, 0x45, 0xb2, 0x28, 0xe0, 0xfe, 0x4b, 0x3d, 0x5a, 0xe6, 0x2c, 0x2b,
+	0x29, 0x7e, 0x02, 0x0f, 0xc5, 0xc7, 0x8b, 0x49, 0x6d, 0xfa, 0xd8, 0x91, 0xd7, 0xe3, 0xbc, 0xeb,
+	0x40, 0x4f, 0xbe, 0xe1, 0x67, 0xa0, 0x4a, 0x57, 0xb1, 0xa5, 0x36, 0xc5, 0x47, 0xd6, 0x99, 0xe0,
+	0x81, 0x61, 0xad, 0xe0, 0xb6, 0x47, 0xcb, 0x7f, 0xf3, 0x19, 0xc3, 0x85, 0x54, 0x29, 0xf5, 0x81,
+	0x39, 0xfc, 0x8d, 0xd1, 0x91, 0xe2, 0xbe, 0x5c, 0x6f, 0x89, 0xb2, 0xd9, 0x12, 0x65, 0xbf, 0x25,
+	0xe8, 0x73, 0x43, 0xd0, 0xd7, 0x86, 0x28, 0xdf, 0x1b, 0x82, 0xd6, 0x0d, 0x41, 0x3f, 0x1a, 0x82,
+	0x7e, 0x36, 0x44, 0xd9, 0x37, 0x04, 0x7d, 0xd9, 0x11, 0x65, 0xbd, 0x23, 0xca, 0x66, 0x47, 0x94,
+	0x85, 0x2a, 0x2e, 0xfc, 0xc5, 0xaf, 0x01, 0x00, 0x8f, 0x8f, 0xb8, 0xb3, 0x3a, 0x03, 0x00, 0x00,
+}
+
+func (this *CellCordon) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.CellCordon{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Draining: "+fmt.Sprintf("%#v", this.Draining)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CordonCellRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CordonCellRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DrainCellRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DrainCellRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UncordonCellRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.UncordonCellRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellCordonResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CellCordonResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Cordon != nil {
+		s = append(s, "Cordon: "+fmt.Sprintf("%#v", this.Cordon)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellCordonsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CellCordonsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Cordons != nil {
+		s = append(s, "Cordons: "+fmt.Sprintf("%#v", this.Cordons)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCellCordon(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *CellCordon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellCordon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellCordon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintCellCordon(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CordonCellRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CordonCellRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CordonCellRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainCellRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainCellRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainCellRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UncordonCellRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UncordonCellRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UncordonCellRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellCordon(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellCordonResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellCordonResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellCordonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cordon != nil {
+		{
+			size := m.Cordon.Size()
+			i -= size
+			if _, err := m.Cordon.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellCordon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellCordon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellCordonsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellCordonsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellCordonsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cordons) > 0 {
+		for iNdEx := len(m.Cordons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Cordons[iNdEx].Size()
+				i -= size
+				if _, err := m.Cordons[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintCellCordon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellCordon(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCellCordon(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCellCordon(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CellCordon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	if m.Draining {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovCellCordon(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *CordonCellRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainCellRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	return n
+}
+
+func (m *UncordonCellRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	return n
+}
+
+func (m *CellCordonResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	if m.Cordon != nil {
+		l = m.Cordon.Size()
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	return n
+}
+
+func (m *CellCordonsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCellCordon(uint64(l))
+	}
+	if len(m.Cordons) > 0 {
+		for _, e := range m.Cordons {
+			l = e.Size()
+			n += 1 + l + sovCellCordon(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCellCordon(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCellCordon(x uint64) (n int) {
+	return sovCellCordon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CellCordon) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellCordon{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CordonCellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CordonCellRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DrainCellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DrainCellRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UncordonCellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UncordonCellRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellCordonResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellCordonResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Cordon:` + strings.Replace(this.Cordon.String(), "CellCordon", "CellCordon", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellCordonsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCordons := "[]*CellCordon{"
+	for _, f := range this.Cordons {
+		repeatedStringForCordons += strings.Replace(f.String(), "CellCordon", "CellCordon", 1) + ","
+	}
+	repeatedStringForCordons += "}"
+	s := strings.Join([]string{`&CellCordonsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Cordons:` + repeatedStringForCordons + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCellCordon(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CellCordon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellCordon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellCordon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellCordon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CordonCellRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CordonCellRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CordonCellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellCordon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainCellRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainCellRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainCellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellCordon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UncordonCellRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UncordonCellRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UncordonCellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellCordon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellCordonResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellCordonResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellCordonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cordon == nil {
+				m.Cordon = &CellCordon{}
+			}
+			if err := m.Cordon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellCordon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellCordonsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellCordonsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellCordonsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cordons = append(m.Cordons, &CellCordon{})
+			if err := m.Cordons[len(m.Cordons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellCordon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellCordon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCellCordon(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCellCordon
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCellCordon
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCellCordon
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCellCordon
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCellCordon
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCellCordon        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCellCordon          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCellCordon = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cell_summary.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type CellActualLRPCounts struct {
+	Claimed    int32 `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed"`
+	Running    int32 `protobuf:"varint,2,opt,name=running,proto3" json:"running"`
+	Suspect    int32 `protobuf:"varint,3,opt,name=suspect,proto3" json:"suspect"`
+	Evacuating int32 `protobuf:"varint,4,opt,name=evacuating,proto3" json:"evacuating"`
+}
+
+func (m *CellActualLRPCounts) Reset()      { *m = CellActualLRPCounts{} }
+func (*CellActualLRPCounts) ProtoMessage() {}
+func (*CellActualLRPCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e360777f105de653, []int{0}
+}
+func (m *CellActualLRPCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellActualLRPCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellActualLRPCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellActualLRPCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellActualLRPCounts.Merge(m, src)
+}
+func (m *CellActualLRPCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellActualLRPCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellActualLRPCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellActualLRPCounts proto.InternalMessageInfo
+
+func (m *CellActualLRPCounts) GetClaimed() int32 {
+	if m != nil {
+		return m.Claimed
+	}
+	return 0
+}
+
+func (m *CellActualLRPCounts) GetRunning() int32 {
+	if m != nil {
+		return m.Running
+	}
+	return 0
+}
+
+func (m *CellActualLRPCounts) GetSuspect() int32 {
+	if m != nil {
+		return m.Suspect
+	}
+	return 0
+}
+
+func (m *CellActualLRPCounts) GetEvacuating() int32 {
+	if m != nil {
+		return m.Evacuating
+	}
+	return 0
+}
+
+type CellTaskCounts struct {
+	Running   int32 `protobuf:"varint,1,opt,name=running,proto3" json:"running"`
+	Completed int32 `protobuf:"varint,2,opt,name=completed,proto3" json:"completed"`
+	Resolving int32 `protobuf:"varint,3,opt,name=resolving,proto3" json:"resolving"`
+}
+
+func (m *CellTaskCounts) Reset()      { *m = CellTaskCounts{} }
+func (*CellTaskCounts) ProtoMessage() {}
+func (*CellTaskCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e360777f105de653, []int{1}
+}
+func (m *CellTaskCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellTaskCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellTaskCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellTaskCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellTaskCounts.Merge(m, src)
+}
+func (m *CellTaskCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellTaskCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellTaskCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellTaskCounts proto.InternalMessageInfo
+
+func (m *CellTaskCounts) GetRunning() int32 {
+	if m != nil {
+		return m.Running
+	}
+	return 0
+}
+
+func (m *CellTaskCounts) GetCompleted() int32 {
+	if m != nil {
+		return m.Completed
+	}
+	return 0
+}
+
+func (m *CellTaskCounts) GetResolving() int32 {
+	if m != nil {
+		return m.Resolving
+	}
+	return 0
+}
+
+type CellWorkload struct {
+	CellId     string               `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	ActualLrps *CellActualLRPCounts `protobuf:"bytes,2,opt,name=actual_lrps,json=actualLrps,proto3" json:"actual_lrps,omitempty"`
+	Tasks      *CellTaskCounts      `protobuf:"bytes,3,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Reserved   *CellCapacity        `protobuf:"bytes,4,opt,name=reserved,proto3" json:"reserved,omitempty"`
+}
+
+func (m *CellWorkload) Reset()      { *m = CellWorkload{} }
+func (*CellWorkload) ProtoMessage() {}
+func (*CellWorkload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e360777f105de653, []int{2}
+}
+func (m *CellWorkload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellWorkload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellWorkload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellWorkload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellWorkload.Merge(m, src)
+}
+func (m *CellWorkload) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellWorkload) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellWorkload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellWorkload proto.InternalMessageInfo
+
+func (m *CellWorkload) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CellWorkload) GetActualLrps() *CellActualLRPCounts {
+	if m != nil {
+		return m.ActualLrps
+	}
+	return nil
+}
+
+func (m *CellWorkload) GetTasks() *CellTaskCounts {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *CellWorkload) GetReserved() *CellCapacity {
+	if m != nil {
+		return m.Reserved
+	}
+	return nil
+}
+
+type CellSummary struct {
+	CellId                string               `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Zone                  string               `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone"`
+	PlacementTags         []string             `protobuf:"bytes,3,rep,name=placement_tags,json=placementTags,proto3" json:"placement_tags,omitempty"`
+	OptionalPlacementTags []string             `protobuf:"bytes,4,rep,name=optional_placement_tags,json=optionalPlacementTags,proto3" json:"optional_placement_tags,omitempty"`
+	Cordoned              bool                 `protobuf:"varint,5,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
+	Draining              bool                 `protobuf:"varint,6,opt,name=draining,proto3" json:"draining,omitempty"`
+	Capacity              *CellCapacity        `protobuf:"bytes,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Reserved              *CellCapacity        `protobuf:"bytes,8,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	ActualLrps            *CellActualLRPCounts `protobuf:"bytes,9,opt,name=actual_lrps,json=actualLrps,proto3" json:"actual_lrps,omitempty"`
+	Tasks                 *CellTaskCounts      `protobuf:"bytes,10,opt,name=tasks,proto3" json:"tasks,omitempty"`
+}
+
+func (m *CellSummary) Reset()      { *m = CellSummary{} }
+func (*CellSummary) ProtoMessage() {}
+func (*CellSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e360777f105de653, []int{3}
+}
+func (m *CellSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellSummary.Merge(m, src)
+}
+func (m *CellSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellSummary proto.InternalMessageInfo
+
+func (m *CellSummary) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CellSummary) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *CellSummary) GetPlacementTags() []string {
+	if m != nil {
+		return m.PlacementTags
+	}
+	return nil
+}
+
+func (m *CellSummary) GetOptionalPlacementTags() []string {
+	if m != nil {
+		return m.OptionalPlacementTags
+	}
+	return nil
+}
+
+func (m *CellSummary) GetCordoned() bool {
+	if m != nil {
+		return m.Cordoned
+	}
+	return false
+}
+
+func (m *CellSummary) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
+func (m *CellSummary) GetCapacity() *CellCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *CellSummary) GetReserved() *CellCapacity {
+	if m != nil {
+		return m.Reserved
+	}
+	return nil
+}
+
+func (m *CellSummary) GetActualLrps() *CellActualLRPCounts {
+	if m != nil {
+		return m.ActualLrps
+	}
+	return nil
+}
+
+func (m *CellSummary) GetTasks() *CellTaskCounts {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type ZoneSummary struct {
+	Zone              string               `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone"`
+	CellCount         int32                `protobuf:"varint,2,opt,name=cell_count,json=cellCount,proto3" json:"cell_count"`
+	CordonedCellCount int32                `protobuf:"varint,3,opt,name=cordoned_cell_count,json=cordonedCellCount,proto3" json:"cordoned_cell_count"`
+	Capacity          *CellCapacity        `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Reserved          *CellCapacity        `protobuf:"bytes,5,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	ActualLrps        *CellActualLRPCounts `protobuf:"bytes,6,opt,name=actual_lrps,json=actualLrps,proto3" json:"actual_lrps,omitempty"`
+	Tasks             *CellTaskCounts      `protobuf:"bytes,7,opt,name=tasks,proto3" json:"tasks,omitempty"`
+}
+
+func (m *ZoneSummary) Reset()      { *m = ZoneSummary{} }
+func (*ZoneSummary) ProtoMessage() {}
+func (*ZoneSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e360777f105de653, []int{4}
+}
+func (m *ZoneSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZoneSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneSummary.Merge(m, src)
+}
+func (m *ZoneSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneSummary proto.InternalMessageInfo
+
+func (m *ZoneSummary) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ZoneSummary) GetCellCount() int32 {
+	if m != nil {
+		return m.CellCount
+	}
+	return 0
+}
+
+func (m *ZoneSummary) GetCordonedCellCount() int32 {
+	if m != nil {
+		return m.CordonedCellCount
+	}
+	return 0
+}
+
+func (m *ZoneSummary) GetCapacity() *CellCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *ZoneSummary) GetReserved() *CellCapacity {
+	if m != nil {
+		return m.Reserved
+	}
+	return nil
+}
+
+func (m *ZoneSummary) GetActualLrps() *CellActualLRPCounts {
+	if m != nil {
+		return m.ActualLrps
+	}
+	return nil
+}
+
+func (m *ZoneSummary) GetTasks() *CellTaskCounts {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type CellSummariesResponse struct {
+	Error *Error         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Cells []*CellSummary `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	Zones []*ZoneSummary `protobuf:"bytes,3,rep,name=zones,proto3" json:"zones,omitempty"`
+}
+
+func (m *CellSummariesResponse) Reset()      { *m = CellSummariesResponse{} }
+func (*CellSummariesResponse) ProtoMessage() {}
+func (*CellSummariesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e360777f105de653, []int{5}
+}
+func (m *CellSummariesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellSummariesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellSummariesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellSummariesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellSummariesResponse.Merge(m, src)
+}
+func (m *CellSummariesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellSummariesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellSummariesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellSummariesResponse proto.InternalMessageInfo
+
+func (m *CellSummariesResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CellSummariesResponse) GetCells() []*CellSummary {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *CellSummariesResponse) GetZones() []*ZoneSummary {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CellActualLRPCounts)(nil), "models.CellActualLRPCounts")
+	proto.RegisterType((*CellTaskCounts)(nil), "models.CellTaskCounts")
+	proto.RegisterType((*CellWorkload)(nil), "models.CellWorkload")
+	proto.RegisterType((*CellSummary)(nil), "models.CellSummary")
+	proto.RegisterType((*ZoneSummary)(nil), "models.ZoneSummary")
+	proto.RegisterType((*CellSummariesResponse)(nil), "models.CellSummariesResponse")
+}
+
+func init() { proto.RegisterFile("cell_summary.proto", fileDescriptor_e360777f105de653) }
+
+var fileDescriptor_e360777f105de653 = []byte{
+	// 716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xcf, 0xec, 0xff, 0x9d, 0xd8, 0x82, 0x53, 0xdb, 0x86, 0x2a, 0xd9, 0xb2, 0x5a, 0x58, 0xb1,
+	0xdd, 0xca, 0x2a, 0x9e, 0xbc, 0xb8, 0x8b, 0x88, 0xd0, 0x43, 0x19, 0x0b, 0x82, 0x97, 0x65, 0x9a,
+	0x8c, 0x6b, 0x68, 0x92, 0x09, 0x33, 0x49, 0xa1, 0x9e, 0xfc, 0x08, 0xea, 0xa7, 0xf0, 0x33, 0xf8,
+	0x09, 0x3c, 0x16, 0x4f, 0x3d, 0x2d, 0x36, 0xbd, 0x94, 0x3d, 0x15, 0xfc, 0x02, 0x32, 0x93, 0x9d,
+	0x4d, 0x5a, 0x4a, 0x59, 0x7b, 0x59, 0xde, 0xfc, 0xde, 0xef, 0xbd, 0xbc, 0xfc, 0x7e, 0x6f, 0xb2,
+	0x10, 0x39, 0xd4, 0xf7, 0x87, 0x22, 0x09, 0x02, 0xc2, 0x8f, 0xba, 0x11, 0x67, 0x31, 0x43, 0xb5,
+	0x80, 0xb9, 0xd4, 0x17, 0x6b, 0x5b, 0x23, 0x2f, 0xfe, 0x94, 0xec, 0x77, 0x1d, 0x16, 0x6c, 0x8f,
+	0xd8, 0x88, 0x6d, 0xab, 0xf4, 0x7e, 0xf2, 0x51, 0x9d, 0xd4, 0x41, 0x45, 0x59, 0xd9, 0x9a, 0x29,
+	0x5b, 0x09, 0x7d, 0xa0, 0x9c, 0x33, 0x9e, 0x1d, 0xda, 0x3f, 0x01, 0x5c, 0x1a, 0x50, 0xdf, 0x7f,
+	0xe5, 0xc4, 0x09, 0xf1, 0x77, 0xf0, 0xee, 0x80, 0x25, 0x61, 0x2c, 0xd0, 0x06, 0xac, 0x3b, 0x3e,
+	0xf1, 0x02, 0xea, 0x5a, 0x60, 0x1d, 0x74, 0xaa, 0x7d, 0x73, 0x32, 0x6e, 0x69, 0x08, 0xeb, 0x40,
+	0xd2, 0x78, 0x12, 0x86, 0x5e, 0x38, 0xb2, 0x4a, 0x39, 0x6d, 0x0a, 0x61, 0x1d, 0x48, 0x9a, 0x48,
+	0x44, 0x44, 0x9d, 0xd8, 0x2a, 0xe7, 0xb4, 0x29, 0x84, 0x75, 0x80, 0xba, 0x10, 0xd2, 0x43, 0xe2,
+	0x24, 0x24, 0x96, 0x0d, 0x2b, 0x8a, 0xb9, 0x38, 0x19, 0xb7, 0x0a, 0x28, 0x2e, 0xc4, 0xed, 0x6f,
+	0x00, 0x2e, 0xca, 0xe1, 0xf7, 0x88, 0x38, 0xc8, 0xe7, 0xd6, 0x03, 0x81, 0x1b, 0x06, 0x7a, 0x02,
+	0x9b, 0x0e, 0x0b, 0x22, 0x9f, 0xc6, 0xd4, 0x9d, 0x4e, 0xbe, 0x30, 0x19, 0xb7, 0x72, 0x10, 0xe7,
+	0xa1, 0x24, 0x73, 0x2a, 0x98, 0x7f, 0x28, 0xbb, 0x96, 0x73, 0xf2, 0x0c, 0xc4, 0x79, 0xd8, 0xfe,
+	0x0d, 0xe0, 0x1d, 0x39, 0xd3, 0x7b, 0xc6, 0x0f, 0x7c, 0x46, 0x5c, 0xf4, 0x08, 0xd6, 0x95, 0x91,
+	0x5e, 0xa6, 0x64, 0x73, 0xaa, 0x64, 0x06, 0xe1, 0x9a, 0x0c, 0xde, 0xba, 0xe8, 0x25, 0x34, 0x89,
+	0xb2, 0x60, 0xe8, 0xf3, 0x48, 0xa8, 0x91, 0xcc, 0xde, 0xfd, 0x6e, 0x66, 0x77, 0xf7, 0x1a, 0x87,
+	0x30, 0xcc, 0xf8, 0x3b, 0x3c, 0x12, 0x68, 0x13, 0x56, 0x63, 0x22, 0x0e, 0x84, 0x9a, 0xce, 0xec,
+	0xad, 0x14, 0xeb, 0x72, 0x71, 0x70, 0x46, 0x42, 0x4f, 0x61, 0x83, 0x53, 0x41, 0xf9, 0x21, 0x75,
+	0x95, 0xc8, 0x66, 0xef, 0x5e, 0xb1, 0x60, 0x40, 0x22, 0xe2, 0x78, 0xf1, 0x11, 0x9e, 0xb1, 0xda,
+	0xe7, 0x65, 0x68, 0xca, 0xd4, 0xbb, 0x6c, 0x19, 0xe7, 0x7c, 0xa7, 0x07, 0xb0, 0xf2, 0x99, 0x85,
+	0x54, 0xbd, 0x4c, 0xb3, 0xdf, 0x98, 0x8c, 0x5b, 0xea, 0x8c, 0xd5, 0x2f, 0xda, 0x80, 0x8b, 0x91,
+	0x4f, 0x1c, 0x1a, 0xd0, 0x30, 0x1e, 0xc6, 0x64, 0x24, 0x87, 0x2f, 0x77, 0x9a, 0x78, 0x61, 0x86,
+	0xee, 0x91, 0x91, 0x40, 0x2f, 0xe0, 0x2a, 0x8b, 0x62, 0x8f, 0x85, 0xc4, 0x1f, 0x5e, 0xe1, 0x57,
+	0x14, 0x7f, 0x59, 0xa7, 0x77, 0x2f, 0xd5, 0xf5, 0x60, 0xc3, 0x61, 0xdc, 0x65, 0x21, 0x75, 0xad,
+	0xea, 0x3a, 0xe8, 0x34, 0xfa, 0x2b, 0x93, 0x71, 0x0b, 0x69, 0x6c, 0x93, 0x05, 0x5e, 0x4c, 0x83,
+	0x48, 0xbe, 0xa6, 0xc6, 0x64, 0x8d, 0xcb, 0x89, 0xa7, 0xb6, 0xa7, 0x96, 0xd7, 0x68, 0xac, 0x58,
+	0xa3, 0x31, 0x29, 0xa6, 0x33, 0x15, 0xcc, 0xaa, 0xdf, 0x24, 0xa6, 0x66, 0x5d, 0x92, 0xbf, 0x31,
+	0x8f, 0xfc, 0x57, 0x97, 0xa3, 0x79, 0xcb, 0xe5, 0x80, 0x73, 0x2c, 0x47, 0xfb, 0x6f, 0x09, 0x9a,
+	0x1f, 0x58, 0x48, 0xb5, 0xd5, 0xda, 0x44, 0x70, 0xad, 0x89, 0x5b, 0x10, 0x2a, 0xd7, 0x1d, 0xd9,
+	0xc3, 0x2a, 0xe5, 0x37, 0x36, 0x47, 0x71, 0x53, 0xc6, 0xea, 0x21, 0xe8, 0x0d, 0x5c, 0xd2, 0x62,
+	0x0f, 0x0b, 0x75, 0xd9, 0x9d, 0x5a, 0x9d, 0x8c, 0x5b, 0xd7, 0xa5, 0xf1, 0x5d, 0x0d, 0x0e, 0x66,
+	0x8d, 0x8a, 0xaa, 0x57, 0xfe, 0x5b, 0xf5, 0xea, 0x6d, 0x54, 0xaf, 0xdd, 0x52, 0xf5, 0xfa, 0x3c,
+	0xaa, 0x7f, 0x07, 0x70, 0x39, 0xbf, 0x60, 0x1e, 0x15, 0x98, 0x8a, 0x88, 0x85, 0x82, 0xa2, 0x87,
+	0xb0, 0xaa, 0xbe, 0xd7, 0xca, 0x00, 0xb3, 0xb7, 0xa0, 0xfb, 0xbc, 0x96, 0x20, 0xce, 0x72, 0xe8,
+	0x31, 0xac, 0xaa, 0x2f, 0xbc, 0x55, 0x5a, 0x2f, 0x77, 0xcc, 0xde, 0x52, 0xf1, 0x61, 0x53, 0x23,
+	0x71, 0xc6, 0x90, 0x54, 0xe9, 0x5c, 0x76, 0xdb, 0x0a, 0xd4, 0x82, 0xe7, 0x38, 0x63, 0xf4, 0x9f,
+	0x1f, 0x9f, 0xda, 0xc6, 0xc9, 0xa9, 0x6d, 0x5c, 0x9c, 0xda, 0xe0, 0x4b, 0x6a, 0x83, 0x1f, 0xa9,
+	0x6d, 0xfc, 0x4a, 0x6d, 0x70, 0x9c, 0xda, 0xe0, 0x4f, 0x6a, 0x83, 0xf3, 0xd4, 0x36, 0x2e, 0x52,
+	0x1b, 0x7c, 0x3d, 0xb3, 0x8d, 0xe3, 0x33, 0xdb, 0x38, 0x39, 0xb3, 0x8d, 0xfd, 0x9a, 0xfa, 0x63,
+	0x79, 0xf6, 0x6f, 0x00, 0x24, 0x2f, 0xc8, 0xc9, 0xbf, 0x06, 0x00, 0x00,
+}
+
+func (this *CellActualLRPCounts) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.CellActualLRPCounts{")
+	s = append(s, "Claimed: "+fmt.Sprintf("%#v", this.Claimed)+",\n")
+	s = append(s, "Running: "+fmt.Sprintf("%#v", this.Running)+",\n")
+	s = append(s, "Suspect: "+fmt.Sprintf("%#v", this.Suspect)+",\n")
+	s = append(s, "Evacuating: "+fmt.Sprintf("%#v", this.Evacuating)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellTaskCounts) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.CellTaskCounts{")
+	s = append(s, "Running: "+fmt.Sprintf("%#v", this.Running)+",\n")
+	s = append(s, "Completed: "+fmt.Sprintf("%#v", this.Completed)+",\n")
+	s = append(s, "Resolving: "+fmt.Sprintf("%#v", this.Resolving)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellWorkload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.CellWorkload{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	if this.ActualLrps != nil {
+		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
+	}
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	}
+	if this.Reserved != nil {
+		s = append(s, "Reserved: "+fmt.Sprintf("%#v", this.Reserved)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellSummary) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&models.CellSummary{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Zone: "+fmt.Sprintf("%#v", this.Zone)+",\n")
+	s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
+	s = append(s, "OptionalPlacementTags: "+fmt.Sprintf("%#v", this.OptionalPlacementTags)+",\n")
+	s = append(s, "Cordoned: "+fmt.Sprintf("%#v", this.Cordoned)+",\n")
+	s = append(s, "Draining: "+fmt.Sprintf("%#v", this.Draining)+",\n")
+	if this.Capacity != nil {
+		s = append(s, "Capacity: "+fmt.Sprintf("%#v", this.Capacity)+",\n")
+	}
+	if this.Reserved != nil {
+		s = append(s, "Reserved: "+fmt.Sprintf("%#v", this.Reserved)+",\n")
+	}
+	if this.ActualLrps != nil {
+		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
+	}
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ZoneSummary) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.ZoneSummary{")
+	s = append(s, "Zone: "+fmt.Sprintf("%#v", this.Zone)+",\n")
+	s = append(s, "CellCount: "+fmt.Sprintf("%#v", this.CellCount)+",\n")
+	s = append(s, "CordonedCellCount: "+fmt.Sprintf("%#v", this.CordonedCellCount)+",\n")
+	if this.Capacity != nil {
+		s = append(s, "Capacity: "+fmt.Sprintf("%#v", this.Capacity)+",\n")
+	}
+	if this.Reserved != nil {
+		s = append(s, "Reserved: "+fmt.Sprintf("%#v", this.Reserved)+",\n")
+	}
+	if this.ActualLrps != nil {
+		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
+	}
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellSummariesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.CellSummariesResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Cells != nil {
+		s = append(s, "Cells: "+fmt.Sprintf("%#v", this.Cells)+",\n")
+	}
+	if this.Zones != nil {
+		s = append(s, "Zones: "+fmt.Sprintf("%#v", this.Zones)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCellSummary(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *CellActualLRPCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellActualLRPCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellActualLRPCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evacuating != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.Evacuating))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Suspect != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.Suspect))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Running != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.Running))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Claimed != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.Claimed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellTaskCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellTaskCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellTaskCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resolving != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.Resolving))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Completed != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.Completed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Running != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.Running))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellWorkload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellWorkload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellWorkload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reserved != nil {
+		{
+			size := m.Reserved.Size()
+			i -= size
+			if _, err := m.Reserved.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Tasks != nil {
+		{
+			size := m.Tasks.Size()
+			i -= size
+			if _, err := m.Tasks.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActualLrps != nil {
+		{
+			size := m.ActualLrps.Size()
+			i -= size
+			if _, err := m.ActualLrps.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellSummary(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tasks != nil {
+		{
+			size := m.Tasks.Size()
+			i -= size
+			if _, err := m.Tasks.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ActualLrps != nil {
+		{
+			size := m.ActualLrps.Size()
+			i -= size
+			if _, err := m.ActualLrps.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Reserved != nil {
+		{
+			size := m.Reserved.Size()
+			i -= size
+			if _, err := m.Reserved.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Capacity != nil {
+		{
+			size := m.Capacity.Size()
+			i -= size
+			if _, err := m.Capacity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Cordoned {
+		i--
+		if m.Cordoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OptionalPlacementTags) > 0 {
+		for iNdEx := len(m.OptionalPlacementTags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionalPlacementTags[iNdEx])
+			copy(dAtA[i:], m.OptionalPlacementTags[iNdEx])
+			i = encodeVarintCellSummary(dAtA, i, uint64(len(m.OptionalPlacementTags[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PlacementTags) > 0 {
+		for iNdEx := len(m.PlacementTags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlacementTags[iNdEx])
+			copy(dAtA[i:], m.PlacementTags[iNdEx])
+			i = encodeVarintCellSummary(dAtA, i, uint64(len(m.PlacementTags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintCellSummary(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellSummary(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tasks != nil {
+		{
+			size := m.Tasks.Size()
+			i -= size
+			if _, err := m.Tasks.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ActualLrps != nil {
+		{
+			size := m.ActualLrps.Size()
+			i -= size
+			if _, err := m.ActualLrps.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reserved != nil {
+		{
+			size := m.Reserved.Size()
+			i -= size
+			if _, err := m.Reserved.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Capacity != nil {
+		{
+			size := m.Capacity.Size()
+			i -= size
+			if _, err := m.Capacity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CordonedCellCount != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.CordonedCellCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CellCount != 0 {
+		i = encodeVarintCellSummary(dAtA, i, uint64(m.CellCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintCellSummary(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellSummariesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellSummariesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellSummariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Zones[iNdEx].Size()
+				i -= size
+				if _, err := m.Zones[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintCellSummary(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Cells) > 0 {
+		for iNdEx := len(m.Cells) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Cells[iNdEx].Size()
+				i -= size
+				if _, err := m.Cells[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintCellSummary(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintCellSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCellSummary(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCellSummary(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CellActualLRPCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimed != 0 {
+		n += 1 + sovCellSummary(uint64(m.Claimed))
+	}
+	if m.Running != 0 {
+		n += 1 + sovCellSummary(uint64(m.Running))
+	}
+	if m.Suspect != 0 {
+		n += 1 + sovCellSummary(uint64(m.Suspect))
+	}
+	if m.Evacuating != 0 {
+		n += 1 + sovCellSummary(uint64(m.Evacuating))
+	}
+	return n
+}
+
+func (m *CellTaskCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Running != 0 {
+		n += 1 + sovCellSummary(uint64(m.Running))
+	}
+	if m.Completed != 0 {
+		n += 1 + sovCellSummary(uint64(m.Completed))
+	}
+	if m.Resolving != 0 {
+		n += 1 + sovCellSummary(uint64(m.Resolving))
+	}
+	return n
+}
+
+func (m *CellWorkload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.ActualLrps != nil {
+		l = m.ActualLrps.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.Tasks != nil {
+		l = m.Tasks.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.Reserved != nil {
+		l = m.Reserved.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	return n
+}
+
+func (m *CellSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if len(m.PlacementTags) > 0 {
+		for _, s := range m.PlacementTags {
+			l = len(s)
+			n += 1 + l + sovCellSummary(uint64(l))
+		}
+	}
+	if len(m.OptionalPlacementTags) > 0 {
+		for _, s := range m.OptionalPlacementTags {
+			l = len(s)
+			n += 1 + l + sovCellSummary(uint64(l))
+		}
+	}
+	if m.Cordoned {
+		n += 2
+	}
+	if m.Draining {
+		n += 2
+	}
+	if m.Capacity != nil {
+		l = m.Capacity.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.Reserved != nil {
+		l = m.Reserved.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.ActualLrps != nil {
+		l = m.ActualLrps.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.Tasks != nil {
+		l = m.Tasks.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	return n
+}
+
+func (m *ZoneSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.CellCount != 0 {
+		n += 1 + sovCellSummary(uint64(m.CellCount))
+	}
+	if m.CordonedCellCount != 0 {
+		n += 1 + sovCellSummary(uint64(m.CordonedCellCount))
+	}
+	if m.Capacity != nil {
+		l = m.Capacity.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.Reserved != nil {
+		l = m.Reserved.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.ActualLrps != nil {
+		l = m.ActualLrps.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if m.Tasks != nil {
+		l = m.Tasks.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	return n
+}
+
+func (m *CellSummariesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCellSummary(uint64(l))
+	}
+	if len(m.Cells) > 0 {
+		for _, e := range m.Cells {
+			l = e.Size()
+			n += 1 + l + sovCellSummary(uint64(l))
+		}
+	}
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovCellSummary(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCellSummary(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCellSummary(x uint64) (n int) {
+	return sovCellSummary(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CellActualLRPCounts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellActualLRPCounts{`,
+		`Claimed:` + fmt.Sprintf("%v", this.Claimed) + `,`,
+		`Running:` + fmt.Sprintf("%v", this.Running) + `,`,
+		`Suspect:` + fmt.Sprintf("%v", this.Suspect) + `,`,
+		`Evacuating:` + fmt.Sprintf("%v", this.Evacuating) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellTaskCounts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellTaskCounts{`,
+		`Running:` + fmt.Sprintf("%v", this.Running) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`Resolving:` + fmt.Sprintf("%v", this.Resolving) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellWorkload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellWorkload{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`ActualLrps:` + strings.Replace(this.ActualLrps.String(), "CellActualLRPCounts", "CellActualLRPCounts", 1) + `,`,
+		`Tasks:` + strings.Replace(this.Tasks.String(), "CellTaskCounts", "CellTaskCounts", 1) + `,`,
+		`Reserved:` + strings.Replace(fmt.Sprintf("%v", this.Reserved), "CellCapacity", "CellCapacity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellSummary{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Zone:` + fmt.Sprintf("%v", this.Zone) + `,`,
+		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
+		`OptionalPlacementTags:` + fmt.Sprintf("%v", this.OptionalPlacementTags) + `,`,
+		`Cordoned:` + fmt.Sprintf("%v", this.Cordoned) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
+		`Capacity:` + strings.Replace(fmt.Sprintf("%v", this.Capacity), "CellCapacity", "CellCapacity", 1) + `,`,
+		`Reserved:` + strings.Replace(fmt.Sprintf("%v", this.Reserved), "CellCapacity", "CellCapacity", 1) + `,`,
+		`ActualLrps:` + strings.Replace(this.ActualLrps.String(), "CellActualLRPCounts", "CellActualLRPCounts", 1) + `,`,
+		`Tasks:` + strings.Replace(this.Tasks.String(), "CellTaskCounts", "CellTaskCounts", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ZoneSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ZoneSummary{`,
+		`Zone:` + fmt.Sprintf("%v", this.Zone) + `,`,
+		`CellCount:` + fmt.Sprintf("%v", this.CellCount) + `,`,
+		`CordonedCellCount:` + fmt.Sprintf("%v", this.CordonedCellCount) + `,`,
+		`Capacity:` + strings.Replace(fmt.Sprintf("%v", this.Capacity), "CellCapacity", "CellCapacity", 1) + `,`,
+		`Reserved:` + strings.Replace(fmt.Sprintf("%v", this.Reserved), "CellCapacity", "CellCapacity", 1) + `,`,
+		`ActualLrps:` + strings.Replace(this.ActualLrps.String(), "CellActualLRPCounts", "CellActualLRPCounts", 1) + `,`,
+		`Tasks:` + strings.Replace(this.Tasks.String(), "CellTaskCounts", "CellTaskCounts", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellSummariesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCells := "[]*CellSummary{"
+	for _, f := range this.Cells {
+		repeatedStringForCells += strings.Replace(f.String(), "CellSummary", "CellSummary", 1) + ","
+	}
+	repeatedStringForCells += "}"
+	repeatedStringForZones := "[]*ZoneSummary{"
+	for _, f := range this.Zones {
+		repeatedStringForZones += strings.Replace(f.String(), "ZoneSummary", "ZoneSummary", 1) + ","
+	}
+	repeatedStringForZones += "}"
+	s := strings.Join([]string{`&CellSummariesResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Cells:` + repeatedStringForCells + `,`,
+		`Zones:` + repeatedStringForZones + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCellSummary(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CellActualLRPCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellActualLRPCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellActualLRPCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			m.Claimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			m.Running = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Running |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspect", wireType)
+			}
+			m.Suspect = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Suspect |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evacuating", wireType)
+			}
+			m.Evacuating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Evacuating |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellTaskCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellTaskCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellTaskCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			m.Running = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Running |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			m.Completed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Completed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolving", wireType)
+			}
+			m.Resolving = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolving |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellWorkload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellWorkload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellWorkload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrps == nil {
+				m.ActualLrps = &CellActualLRPCounts{}
+			}
+			if err := m.ActualLrps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tasks == nil {
+				m.Tasks = &CellTaskCounts{}
+			}
+			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reserved == nil {
+				m.Reserved = &CellCapacity{}
+			}
+			if err := m.Reserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementTags = append(m.PlacementTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalPlacementTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalPlacementTags = append(m.OptionalPlacementTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cordoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capacity == nil {
+				m.Capacity = &CellCapacity{}
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reserved == nil {
+				m.Reserved = &CellCapacity{}
+			}
+			if err := m.Reserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrps == nil {
+				m.ActualLrps = &CellActualLRPCounts{}
+			}
+			if err := m.ActualLrps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tasks == nil {
+				m.Tasks = &CellTaskCounts{}
+			}
+			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZoneSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellCount", wireType)
+			}
+			m.CellCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CellCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CordonedCellCount", wireType)
+			}
+			m.CordonedCellCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CordonedCellCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capacity == nil {
+				m.Capacity = &CellCapacity{}
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reserved == nil {
+				m.Reserved = &CellCapacity{}
+			}
+			if err := m.Reserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLrps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActualLrps == nil {
+				m.ActualLrps = &CellActualLRPCounts{}
+			}
+			if err := m.ActualLrps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tasks == nil {
+				m.Tasks = &CellTaskCounts{}
+			}
+			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellSummariesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellSummariesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellSummariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cells", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cells = append(m.Cells, &CellSummary{})
+			if err := m.Cells[len(m.Cells)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, &ZoneSummary{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCellSummary(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCellSummary
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCellSummary
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCellSummary
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCellSummary
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCellSummary
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCellSummary        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCellSummary          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCellSummary = fmt.Errorf("proto: unexpected end of group")
+)
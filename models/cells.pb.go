@@ -3,15 +3,16 @@
 
 package models
 
-import proto "github.com/gogo/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-
-import strings "strings"
-import reflect "reflect"
-
-import io "io"
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -33,7 +34,7 @@ type CellCapacity struct {
 func (m *CellCapacity) Reset()      { *m = CellCapacity{} }
 func (*CellCapacity) ProtoMessage() {}
 func (*CellCapacity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cells_92c392031bec7a86, []int{0}
+	return fileDescriptor_842e821272d22ff7, []int{0}
 }
 func (m *CellCapacity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -43,15 +44,15 @@ func (m *CellCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CellCapacity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *CellCapacity) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CellCapacity.Merge(dst, src)
+func (m *CellCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellCapacity.Merge(m, src)
 }
 func (m *CellCapacity) XXX_Size() int {
 	return m.Size()
@@ -92,12 +93,14 @@ type CellPresence struct {
 	PlacementTags         []string      `protobuf:"bytes,6,rep,name=placement_tags,json=placementTags,proto3" json:"placement_tags,omitempty"`
 	OptionalPlacementTags []string      `protobuf:"bytes,7,rep,name=optional_placement_tags,json=optionalPlacementTags,proto3" json:"optional_placement_tags,omitempty"`
 	RepUrl                string        `protobuf:"bytes,8,opt,name=rep_url,json=repUrl,proto3" json:"rep_url"`
+	Cordoned              bool          `protobuf:"varint,9,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
+	Draining              bool          `protobuf:"varint,10,opt,name=draining,proto3" json:"draining,omitempty"`
 }
 
 func (m *CellPresence) Reset()      { *m = CellPresence{} }
 func (*CellPresence) ProtoMessage() {}
 func (*CellPresence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cells_92c392031bec7a86, []int{1}
+	return fileDescriptor_842e821272d22ff7, []int{1}
 }
 func (m *CellPresence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,15 +110,15 @@ func (m *CellPresence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return xxx_messageInfo_CellPresence.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *CellPresence) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CellPresence.Merge(dst, src)
+func (m *CellPresence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellPresence.Merge(m, src)
 }
 func (m *CellPresence) XXX_Size() int {
 	return m.Size()
@@ -182,6 +185,20 @@ func (m *CellPresence) GetRepUrl() string {
 	return ""
 }
 
+func (m *CellPresence) GetCordoned() bool {
+	if m != nil {
+		return m.Cordoned
+	}
+	return false
+}
+
+func (m *CellPresence) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
 type Provider struct {
 	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
 	Properties []string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
@@ -190,7 +207,7 @@ type Provider struct {
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cells_92c392031bec7a86, []int{2}
+	return fileDescriptor_842e821272d22ff7, []int{2}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,15 +217,15 @@ func (m *Provider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Provider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Provider) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Provider.Merge(dst, src)
+func (m *Provider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Provider.Merge(m, src)
 }
 func (m *Provider) XXX_Size() int {
 	return m.Size()
@@ -241,7 +258,7 @@ type CellsResponse struct {
 func (m *CellsResponse) Reset()      { *m = CellsResponse{} }
 func (*CellsResponse) ProtoMessage() {}
 func (*CellsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cells_92c392031bec7a86, []int{3}
+	return fileDescriptor_842e821272d22ff7, []int{3}
 }
 func (m *CellsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,15 +268,15 @@ func (m *CellsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return xxx_messageInfo_CellsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *CellsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CellsResponse.Merge(dst, src)
+func (m *CellsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellsResponse.Merge(m, src)
 }
 func (m *CellsResponse) XXX_Size() int {
 	return m.Size()
@@ -290,6 +307,50 @@ func init() {
 	proto.RegisterType((*Provider)(nil), "models.Provider")
 	proto.RegisterType((*CellsResponse)(nil), "models.CellsResponse")
 }
+
+func init() { proto.RegisterFile("cells.proto", fileDescriptor_842e821272d22ff7) }
+
+var fileDescriptor_842e821272d22ff7 = []byte{
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0xcd, 0x6e, 0xd4, 0x3c,
+	0x14, 0x1d, 0x7f, 0xed, 0x4c, 0x33, 0xce, 0x37, 0x6d, 0x65, 0xf1, 0x13, 0x55, 0xc8, 0x19, 0x0d,
+	0x45, 0x1a, 0x55, 0x30, 0xad, 0x06, 0xc4, 0x9e, 0xa9, 0x90, 0x60, 0x51, 0xa9, 0xb2, 0x60, 0x1d,
+	0xf2, 0xe3, 0x06, 0x8b, 0x24, 0xb6, 0x6c, 0x17, 0xa9, 0xac, 0x58, 0xf0, 0x00, 0x7d, 0x0c, 0x1e,
+	0x85, 0x65, 0x97, 0x5d, 0x45, 0x34, 0xdd, 0xa0, 0xac, 0xfa, 0x08, 0xc8, 0xce, 0xa4, 0x13, 0x66,
+	0x13, 0xdd, 0x7b, 0xee, 0x39, 0xf6, 0xbd, 0xf7, 0xc4, 0xd0, 0x8d, 0x69, 0x96, 0xa9, 0x99, 0x90,
+	0x5c, 0x73, 0x34, 0xc8, 0x79, 0x42, 0x33, 0xb5, 0xf7, 0x22, 0x65, 0xfa, 0xf3, 0x79, 0x34, 0x8b,
+	0x79, 0x7e, 0x98, 0xf2, 0x94, 0x1f, 0xda, 0x72, 0x74, 0x7e, 0x66, 0x33, 0x9b, 0xd8, 0xa8, 0x91,
+	0xed, 0xb9, 0x54, 0x4a, 0x2e, 0x9b, 0x64, 0x72, 0x09, 0xe0, 0xff, 0xc7, 0x34, 0xcb, 0x8e, 0x43,
+	0x11, 0xc6, 0x4c, 0x5f, 0xa0, 0x03, 0x38, 0xcc, 0x69, 0xce, 0xe5, 0x45, 0x90, 0x47, 0x1e, 0x18,
+	0x83, 0x69, 0x7f, 0x31, 0xaa, 0x4b, 0x7f, 0x05, 0x12, 0xa7, 0x09, 0x4f, 0x22, 0xb4, 0x0f, 0xb7,
+	0x12, 0xa6, 0xbe, 0x18, 0xe6, 0x7f, 0x96, 0xe9, 0xd6, 0xa5, 0xdf, 0x42, 0x64, 0x60, 0x82, 0x93,
+	0x08, 0xcd, 0x20, 0x8c, 0x79, 0xa1, 0x43, 0x56, 0x50, 0xa9, 0xbc, 0x0d, 0x4b, 0xdc, 0xae, 0x4b,
+	0xbf, 0x83, 0x92, 0x4e, 0x3c, 0xf9, 0xb1, 0xd9, 0xb4, 0x74, 0x2a, 0xa9, 0xa2, 0x45, 0x4c, 0xcd,
+	0x35, 0x66, 0xec, 0x80, 0x25, 0xb6, 0xa1, 0x61, 0x73, 0xcd, 0x12, 0x22, 0x03, 0x13, 0xbc, 0x4f,
+	0xd0, 0x11, 0x74, 0x25, 0x15, 0x41, 0x98, 0x24, 0x92, 0x2a, 0x65, 0x1b, 0x1a, 0x2e, 0x76, 0xea,
+	0xd2, 0xef, 0xc2, 0x04, 0x4a, 0x2a, 0xde, 0x34, 0x31, 0x7a, 0x02, 0x37, 0xbf, 0xf1, 0x82, 0xda,
+	0x96, 0x86, 0x0b, 0xa7, 0x2e, 0x7d, 0x9b, 0x13, 0xfb, 0x45, 0x47, 0xd0, 0x89, 0x97, 0x4b, 0xf1,
+	0x36, 0xc7, 0x60, 0xea, 0xce, 0x1f, 0xcc, 0x9a, 0x85, 0xcf, 0xba, 0x0b, 0x23, 0xf7, 0x2c, 0x14,
+	0xc0, 0x5d, 0xc9, 0xb9, 0x3e, 0x53, 0x81, 0x90, 0xfc, 0x2b, 0x4b, 0xcc, 0xb8, 0xfd, 0xf1, 0xc6,
+	0xd4, 0x9d, 0xef, 0xb6, 0xca, 0xd3, 0x65, 0x61, 0x31, 0xa9, 0x4b, 0x1f, 0xaf, 0xb1, 0x83, 0x8c,
+	0x29, 0xfd, 0x9c, 0xe7, 0x4c, 0xd3, 0x5c, 0xe8, 0x0b, 0xb2, 0xd3, 0xd4, 0x5b, 0x8d, 0x42, 0xcf,
+	0xe0, 0xb6, 0xc8, 0xc2, 0x98, 0xe6, 0xb4, 0xd0, 0x81, 0x0e, 0x53, 0xe5, 0x0d, 0xc6, 0x1b, 0xd3,
+	0x21, 0x19, 0xdd, 0xa3, 0x1f, 0xc2, 0x54, 0xa1, 0xd7, 0xf0, 0x31, 0x17, 0x9a, 0xf1, 0x22, 0xcc,
+	0x82, 0x35, 0xfe, 0x96, 0xe5, 0x3f, 0x6c, 0xcb, 0xa7, 0xff, 0xe8, 0xf6, 0xe1, 0x96, 0x59, 0xd5,
+	0xb9, 0xcc, 0x3c, 0x67, 0xb5, 0xe7, 0x25, 0x44, 0x06, 0x92, 0x8a, 0x8f, 0x32, 0x43, 0x73, 0xe8,
+	0xc4, 0x5c, 0x26, 0xbc, 0xa0, 0x89, 0x37, 0x1c, 0x83, 0xa9, 0xb3, 0x78, 0x54, 0x97, 0x3e, 0x6a,
+	0xb1, 0x4e, 0xff, 0xf7, 0x3c, 0xa3, 0x49, 0x64, 0xc8, 0x0a, 0x56, 0xa4, 0x1e, 0x5c, 0x69, 0x5a,
+	0xac, 0xab, 0x69, 0xb1, 0xc9, 0x3b, 0xe8, 0xb4, 0x93, 0x1b, 0xa7, 0x8a, 0x30, 0xa7, 0x1e, 0x58,
+	0x39, 0x65, 0x72, 0x62, 0xbf, 0x08, 0x43, 0x28, 0x24, 0x17, 0x54, 0x6a, 0x46, 0x8d, 0xf1, 0x66,
+	0xc4, 0x0e, 0x32, 0xf9, 0x04, 0x47, 0xc6, 0x31, 0x45, 0xa8, 0x12, 0xbc, 0x50, 0x14, 0x3d, 0x85,
+	0x7d, 0xfb, 0x06, 0xec, 0x79, 0xee, 0x7c, 0xd4, 0xba, 0xf3, 0xd6, 0x80, 0xa4, 0xa9, 0xa1, 0x03,
+	0xd8, 0xb7, 0x8f, 0xcd, 0x1e, 0xb8, 0x66, 0x7e, 0xfb, 0x6b, 0x92, 0x86, 0xb2, 0x78, 0x75, 0x75,
+	0x83, 0x7b, 0xd7, 0x37, 0xb8, 0x77, 0x77, 0x83, 0xc1, 0xf7, 0x0a, 0x83, 0x9f, 0x15, 0x06, 0xbf,
+	0x2a, 0x0c, 0xae, 0x2a, 0x0c, 0x7e, 0x57, 0x18, 0xfc, 0xa9, 0x70, 0xef, 0xae, 0xc2, 0xe0, 0xf2,
+	0x16, 0xf7, 0xae, 0x6e, 0x71, 0xef, 0xfa, 0x16, 0xf7, 0xa2, 0x81, 0x7d, 0x82, 0x2f, 0xff, 0x0e,
+	0x00, 0xbf, 0xf8, 0xee, 0xd3, 0xd5, 0x03, 0x00, 0x00,
+}
+
 func (this *CellCapacity) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -378,6 +439,12 @@ func (this *CellPresence) Equal(that interface{}) bool {
 	if this.RepUrl != that1.RepUrl {
 		return false
 	}
+	if this.Cordoned != that1.Cordoned {
+		return false
+	}
+	if this.Draining != that1.Draining {
+		return false
+	}
 	return true
 }
 func (this *Provider) Equal(that interface{}) bool {
@@ -460,7 +527,7 @@ func (this *CellPresence) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 14)
 	s = append(s, "&models.CellPresence{")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
 	s = append(s, "RepAddress: "+fmt.Sprintf("%#v", this.RepAddress)+",\n")
@@ -474,6 +541,8 @@ func (this *CellPresence) GoString() string {
 	s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
 	s = append(s, "OptionalPlacementTags: "+fmt.Sprintf("%#v", this.OptionalPlacementTags)+",\n")
 	s = append(s, "RepUrl: "+fmt.Sprintf("%#v", this.RepUrl)+",\n")
+	s = append(s, "Cordoned: "+fmt.Sprintf("%#v", this.Cordoned)+",\n")
+	s = append(s, "Draining: "+fmt.Sprintf("%#v", this.Draining)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -514,7 +583,7 @@ func valueToGoStringCells(v interface{}, typ string) string {
 func (m *CellCapacity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -522,32 +591,37 @@ func (m *CellCapacity) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CellCapacity) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MemoryMb != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(m.MemoryMb))
+	if m.Containers != 0 {
+		i = encodeVarintCells(dAtA, i, uint64(m.Containers))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.DiskMb != 0 {
-		dAtA[i] = 0x10
-		i++
 		i = encodeVarintCells(dAtA, i, uint64(m.DiskMb))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Containers != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(m.Containers))
+	if m.MemoryMb != 0 {
+		i = encodeVarintCells(dAtA, i, uint64(m.MemoryMb))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *CellPresence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -555,93 +629,114 @@ func (m *CellPresence) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CellPresence) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellPresence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CellId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(len(m.CellId)))
-		i += copy(dAtA[i:], m.CellId)
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.RepAddress) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(len(m.RepAddress)))
-		i += copy(dAtA[i:], m.RepAddress)
+	if m.Cordoned {
+		i--
+		if m.Cordoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Zone) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(len(m.Zone)))
-		i += copy(dAtA[i:], m.Zone)
+	if len(m.RepUrl) > 0 {
+		i -= len(m.RepUrl)
+		copy(dAtA[i:], m.RepUrl)
+		i = encodeVarintCells(dAtA, i, uint64(len(m.RepUrl)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Capacity != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(m.Capacity.Size()))
-		n1, err := m.Capacity.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.OptionalPlacementTags) > 0 {
+		for iNdEx := len(m.OptionalPlacementTags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionalPlacementTags[iNdEx])
+			copy(dAtA[i:], m.OptionalPlacementTags[iNdEx])
+			i = encodeVarintCells(dAtA, i, uint64(len(m.OptionalPlacementTags[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PlacementTags) > 0 {
+		for iNdEx := len(m.PlacementTags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlacementTags[iNdEx])
+			copy(dAtA[i:], m.PlacementTags[iNdEx])
+			i = encodeVarintCells(dAtA, i, uint64(len(m.PlacementTags[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
-		i += n1
 	}
 	if len(m.RootfsProviders) > 0 {
-		for _, msg := range m.RootfsProviders {
+		for iNdEx := len(m.RootfsProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RootfsProviders[iNdEx].Size()
+				i -= size
+				if _, err := m.RootfsProviders[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintCells(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCells(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
+		}
+	}
+	if m.Capacity != nil {
+		{
+			size := m.Capacity.Size()
+			i -= size
+			if _, err := m.Capacity.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i += n
+			i = encodeVarintCells(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.PlacementTags) > 0 {
-		for _, s := range m.PlacementTags {
-			dAtA[i] = 0x32
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintCells(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.OptionalPlacementTags) > 0 {
-		for _, s := range m.OptionalPlacementTags {
-			dAtA[i] = 0x3a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if len(m.RepAddress) > 0 {
+		i -= len(m.RepAddress)
+		copy(dAtA[i:], m.RepAddress)
+		i = encodeVarintCells(dAtA, i, uint64(len(m.RepAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.RepUrl) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(len(m.RepUrl)))
-		i += copy(dAtA[i:], m.RepUrl)
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCells(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func (m *Provider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -649,38 +744,38 @@ func (m *Provider) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
 	if len(m.Properties) > 0 {
-		for _, s := range m.Properties {
+		for iNdEx := len(m.Properties) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Properties[iNdEx])
+			copy(dAtA[i:], m.Properties[iNdEx])
+			i = encodeVarintCells(dAtA, i, uint64(len(m.Properties[iNdEx])))
+			i--
 			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCells(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *CellsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -688,43 +783,54 @@ func (m *CellsResponse) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *CellsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCells(dAtA, i, uint64(m.Error.Size()))
-		n2, err := m.Error.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
 	if len(m.Cells) > 0 {
-		for _, msg := range m.Cells {
+		for iNdEx := len(m.Cells) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Cells[iNdEx].Size()
+				i -= size
+				if _, err := m.Cells[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintCells(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCells(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
+		}
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i += n
+			i = encodeVarintCells(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintCells(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCells(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *CellCapacity) Size() (n int) {
 	if m == nil {
@@ -788,6 +894,12 @@ func (m *CellPresence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCells(uint64(l))
 	}
+	if m.Cordoned {
+		n += 2
+	}
+	if m.Draining {
+		n += 2
+	}
 	return n
 }
 
@@ -830,14 +942,7 @@ func (m *CellsResponse) Size() (n int) {
 }
 
 func sovCells(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozCells(x uint64) (n int) {
 	return sovCells(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -858,15 +963,22 @@ func (this *CellPresence) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRootfsProviders := "[]*Provider{"
+	for _, f := range this.RootfsProviders {
+		repeatedStringForRootfsProviders += strings.Replace(f.String(), "Provider", "Provider", 1) + ","
+	}
+	repeatedStringForRootfsProviders += "}"
 	s := strings.Join([]string{`&CellPresence{`,
 		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
 		`RepAddress:` + fmt.Sprintf("%v", this.RepAddress) + `,`,
 		`Zone:` + fmt.Sprintf("%v", this.Zone) + `,`,
-		`Capacity:` + strings.Replace(fmt.Sprintf("%v", this.Capacity), "CellCapacity", "CellCapacity", 1) + `,`,
-		`RootfsProviders:` + strings.Replace(fmt.Sprintf("%v", this.RootfsProviders), "Provider", "Provider", 1) + `,`,
+		`Capacity:` + strings.Replace(this.Capacity.String(), "CellCapacity", "CellCapacity", 1) + `,`,
+		`RootfsProviders:` + repeatedStringForRootfsProviders + `,`,
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
 		`OptionalPlacementTags:` + fmt.Sprintf("%v", this.OptionalPlacementTags) + `,`,
 		`RepUrl:` + fmt.Sprintf("%v", this.RepUrl) + `,`,
+		`Cordoned:` + fmt.Sprintf("%v", this.Cordoned) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -886,9 +998,14 @@ func (this *CellsResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForCells := "[]*CellPresence{"
+	for _, f := range this.Cells {
+		repeatedStringForCells += strings.Replace(f.String(), "CellPresence", "CellPresence", 1) + ","
+	}
+	repeatedStringForCells += "}"
 	s := strings.Join([]string{`&CellsResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
-		`Cells:` + strings.Replace(fmt.Sprintf("%v", this.Cells), "CellPresence", "CellPresence", 1) + `,`,
+		`Cells:` + repeatedStringForCells + `,`,
 		`}`,
 	}, "")
 	return s
@@ -916,7 +1033,7 @@ func (m *CellCapacity) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -944,7 +1061,7 @@ func (m *CellCapacity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemoryMb |= (int32(b) & 0x7F) << shift
+				m.MemoryMb |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -963,7 +1080,7 @@ func (m *CellCapacity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DiskMb |= (int32(b) & 0x7F) << shift
+				m.DiskMb |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -982,7 +1099,7 @@ func (m *CellCapacity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Containers |= (int32(b) & 0x7F) << shift
+				m.Containers |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -993,7 +1110,7 @@ func (m *CellCapacity) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCells
 			}
 			if (iNdEx + skippy) > l {
@@ -1023,7 +1140,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -1051,7 +1168,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1061,6 +1178,9 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1080,7 +1200,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1090,6 +1210,9 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1109,7 +1232,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1119,6 +1242,9 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1138,7 +1264,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1147,6 +1273,9 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1171,7 +1300,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1180,6 +1309,9 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1202,7 +1334,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1212,6 +1344,9 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1231,7 +1366,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1241,6 +1376,9 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1260,7 +1398,7 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1270,18 +1408,61 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cordoned = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCells(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCells
 			}
 			if (iNdEx + skippy) > l {
@@ -1311,7 +1492,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -1339,7 +1520,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1349,6 +1530,9 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1368,7 +1552,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1378,6 +1562,9 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1389,7 +1576,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCells
 			}
 			if (iNdEx + skippy) > l {
@@ -1419,7 +1606,7 @@ func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -1447,7 +1634,7 @@ func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1456,6 +1643,9 @@ func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1480,7 +1670,7 @@ func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1489,6 +1679,9 @@ func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCells
 			}
 			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCells
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1503,7 +1696,7 @@ func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCells
 			}
 			if (iNdEx + skippy) > l {
@@ -1521,6 +1714,7 @@ func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 func skipCells(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1552,10 +1746,8 @@ func skipCells(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -1572,94 +1764,34 @@ func skipCells(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthCells
 			}
-			return iNdEx, nil
+			iNdEx += length
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowCells
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipCells(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCells
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCells
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthCells = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowCells   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthCells        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCells          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCells = fmt.Errorf("proto: unexpected end of group")
 )
-
-func init() { proto.RegisterFile("cells.proto", fileDescriptor_cells_92c392031bec7a86) }
-
-var fileDescriptor_cells_92c392031bec7a86 = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x4f, 0xe8, 0x9a, 0xb5, 0x0e, 0xdd, 0x26, 0x0b, 0x44, 0x35, 0x21, 0xa7, 0x2a, 0x43, 0xaa,
-	0x26, 0xe8, 0xa6, 0x81, 0xb8, 0xd3, 0x09, 0x09, 0x0e, 0x93, 0x26, 0x0b, 0xce, 0x21, 0x7f, 0xde,
-	0x4a, 0x44, 0x12, 0x5b, 0xb6, 0x8b, 0x54, 0x4e, 0x7c, 0x84, 0x7d, 0x00, 0x3e, 0x00, 0x1f, 0x85,
-	0x63, 0x8f, 0x3b, 0x45, 0x34, 0xbd, 0xa0, 0x9c, 0xf6, 0x11, 0x90, 0x9d, 0x66, 0x2b, 0xbd, 0x58,
-	0xbf, 0xf7, 0x7b, 0xbf, 0x67, 0x3f, 0xff, 0x9e, 0x8d, 0xdc, 0x08, 0xd2, 0x54, 0x8e, 0xb9, 0x60,
-	0x8a, 0x61, 0x27, 0x63, 0x31, 0xa4, 0xf2, 0xf0, 0xe5, 0x34, 0x51, 0x5f, 0x66, 0xe1, 0x38, 0x62,
-	0xd9, 0xc9, 0x94, 0x4d, 0xd9, 0x89, 0x49, 0x87, 0xb3, 0x2b, 0x13, 0x99, 0xc0, 0xa0, 0xba, 0xec,
-	0xd0, 0x05, 0x21, 0x98, 0xa8, 0x83, 0xe1, 0xb5, 0x8d, 0x1e, 0x9e, 0x43, 0x9a, 0x9e, 0x07, 0x3c,
-	0x88, 0x12, 0x35, 0xc7, 0xc7, 0xa8, 0x9b, 0x41, 0xc6, 0xc4, 0xdc, 0xcf, 0xc2, 0xbe, 0x3d, 0xb0,
-	0x47, 0xed, 0x49, 0xaf, 0x2a, 0xbc, 0x7b, 0x92, 0x76, 0x6a, 0x78, 0x11, 0xe2, 0x23, 0xb4, 0x1b,
-	0x27, 0xf2, 0xab, 0x56, 0x3e, 0x30, 0x4a, 0xb7, 0x2a, 0xbc, 0x86, 0xa2, 0x8e, 0x06, 0x17, 0x21,
-	0x1e, 0x23, 0x14, 0xb1, 0x5c, 0x05, 0x49, 0x0e, 0x42, 0xf6, 0x5b, 0x46, 0xb8, 0x57, 0x15, 0xde,
-	0x06, 0x4b, 0x37, 0xf0, 0xf0, 0x67, 0xab, 0x6e, 0xe9, 0x52, 0x80, 0x84, 0x3c, 0x02, 0x7d, 0x8c,
-	0xbe, 0xb6, 0x9f, 0xc4, 0xa6, 0xa1, 0x6e, 0x7d, 0xcc, 0x9a, 0xa2, 0x8e, 0x06, 0x1f, 0x62, 0x7c,
-	0x8a, 0x5c, 0x01, 0xdc, 0x0f, 0xe2, 0x58, 0x80, 0x94, 0xa6, 0xa1, 0xee, 0x64, 0xbf, 0x2a, 0xbc,
-	0x4d, 0x9a, 0x22, 0x01, 0xfc, 0x6d, 0x8d, 0xf1, 0x53, 0xb4, 0xf3, 0x9d, 0xe5, 0x60, 0x5a, 0xea,
-	0x4e, 0x3a, 0x55, 0xe1, 0x99, 0x98, 0x9a, 0x15, 0x9f, 0xa2, 0x4e, 0xb4, 0x36, 0xa5, 0xbf, 0x33,
-	0xb0, 0x47, 0xee, 0xd9, 0xa3, 0x71, 0x6d, 0xf8, 0x78, 0xd3, 0x30, 0x7a, 0xa7, 0xc2, 0x3e, 0x3a,
-	0x10, 0x8c, 0xa9, 0x2b, 0xe9, 0x73, 0xc1, 0xbe, 0x25, 0xb1, 0xbe, 0x6e, 0x7b, 0xd0, 0x1a, 0xb9,
-	0x67, 0x07, 0x4d, 0xe5, 0xe5, 0x3a, 0x31, 0x19, 0x56, 0x85, 0x47, 0xb6, 0xd4, 0x7e, 0x9a, 0x48,
-	0xf5, 0x82, 0x65, 0x89, 0x82, 0x8c, 0xab, 0x39, 0xdd, 0xaf, 0xf3, 0x4d, 0x8d, 0xc4, 0xcf, 0xd1,
-	0x1e, 0x4f, 0x83, 0x08, 0x32, 0xc8, 0x95, 0xaf, 0x82, 0xa9, 0xec, 0x3b, 0x83, 0xd6, 0xa8, 0x4b,
-	0x7b, 0x77, 0xec, 0xc7, 0x60, 0x2a, 0xf1, 0x1b, 0xf4, 0x84, 0x71, 0x95, 0xb0, 0x3c, 0x48, 0xfd,
-	0x2d, 0xfd, 0xae, 0xd1, 0x3f, 0x6e, 0xd2, 0x97, 0xff, 0xd5, 0x1d, 0xa1, 0x5d, 0x6d, 0xd5, 0x4c,
-	0xa4, 0xfd, 0xce, 0xbd, 0xcf, 0x6b, 0x8a, 0x3a, 0x02, 0xf8, 0x27, 0x91, 0x0e, 0xdf, 0xa3, 0x4e,
-	0xd3, 0x91, 0x76, 0x30, 0x0f, 0x32, 0x58, 0x8f, 0xc5, 0x38, 0xa8, 0x63, 0x6a, 0x56, 0x4c, 0x10,
-	0xe2, 0x82, 0x71, 0x10, 0x2a, 0x01, 0x3d, 0x10, 0x7d, 0xf4, 0x06, 0x33, 0xfc, 0x8c, 0x7a, 0xda,
-	0x49, 0x49, 0x41, 0x72, 0x96, 0x4b, 0xc0, 0xcf, 0x50, 0xdb, 0xbc, 0x4d, 0xb3, 0x9f, 0x7b, 0xd6,
-	0x6b, 0x5c, 0x7b, 0xa7, 0x49, 0x5a, 0xe7, 0xf0, 0x31, 0x6a, 0x9b, 0x4f, 0x60, 0x36, 0xdc, 0x1a,
-	0x4a, 0xf3, 0x64, 0x68, 0x2d, 0x99, 0xbc, 0x5e, 0x2c, 0x89, 0x75, 0xb3, 0x24, 0xd6, 0xed, 0x92,
-	0xd8, 0x3f, 0x4a, 0x62, 0xff, 0x2a, 0x89, 0xfd, 0xbb, 0x24, 0xf6, 0xa2, 0x24, 0xf6, 0x9f, 0x92,
-	0xd8, 0x7f, 0x4b, 0x62, 0xdd, 0x96, 0xc4, 0xbe, 0x5e, 0x11, 0x6b, 0xb1, 0x22, 0xd6, 0xcd, 0x8a,
-	0x58, 0xa1, 0x63, 0xbe, 0xc6, 0xab, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x12, 0x8c, 0x77, 0x8d,
-	0x6d, 0x03, 0x00, 0x00,
-}
@@ -0,0 +1,1013 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: config_reload.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ConfigChange struct {
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *ConfigChange) Reset()      { *m = ConfigChange{} }
+func (*ConfigChange) ProtoMessage() {}
+func (*ConfigChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fce3c0818ce75a17, []int{0}
+}
+func (m *ConfigChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigChange.Merge(m, src)
+}
+func (m *ConfigChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigChange proto.InternalMessageInfo
+
+func (m *ConfigChange) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ConfigChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *ConfigChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+type ConfigReloadReport struct {
+	Applied  []*ConfigChange `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	Rejected []*ConfigChange `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (m *ConfigReloadReport) Reset()      { *m = ConfigReloadReport{} }
+func (*ConfigReloadReport) ProtoMessage() {}
+func (*ConfigReloadReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fce3c0818ce75a17, []int{1}
+}
+func (m *ConfigReloadReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigReloadReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfigReloadReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfigReloadReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigReloadReport.Merge(m, src)
+}
+func (m *ConfigReloadReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigReloadReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigReloadReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigReloadReport proto.InternalMessageInfo
+
+func (m *ConfigReloadReport) GetApplied() []*ConfigChange {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *ConfigReloadReport) GetRejected() []*ConfigChange {
+	if m != nil {
+		return m.Rejected
+	}
+	return nil
+}
+
+type ReloadConfigResponse struct {
+	Error  *Error              `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Report *ConfigReloadReport `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (m *ReloadConfigResponse) Reset()      { *m = ReloadConfigResponse{} }
+func (*ReloadConfigResponse) ProtoMessage() {}
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fce3c0818ce75a17, []int{2}
+}
+func (m *ReloadConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReloadConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReloadConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReloadConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadConfigResponse.Merge(m, src)
+}
+func (m *ReloadConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReloadConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadConfigResponse proto.InternalMessageInfo
+
+func (m *ReloadConfigResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ReloadConfigResponse) GetReport() *ConfigReloadReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConfigChange)(nil), "models.ConfigChange")
+	proto.RegisterType((*ConfigReloadReport)(nil), "models.ConfigReloadReport")
+	proto.RegisterType((*ReloadConfigResponse)(nil), "models.ReloadConfigResponse")
+}
+
+func init() { proto.RegisterFile("config_reload.proto", fileDescriptor_fce3c0818ce75a17) }
+
+var fileDescriptor_fce3c0818ce75a17 = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x14, 0x86, 0x63, 0x10, 0x5c, 0x70, 0xee, 0x5d, 0x0c, 0xd2, 0x45, 0x0c, 0xe6, 0x8a, 0xbb, 0x30,
+	0xb4, 0xa1, 0x4a, 0x79, 0x02, 0x50, 0x5f, 0x20, 0x43, 0x57, 0x14, 0xc8, 0x21, 0xa4, 0x72, 0x72,
+	0x22, 0x13, 0x40, 0xdd, 0xfa, 0x08, 0xdd, 0xfb, 0x02, 0x7d, 0x94, 0x8e, 0x8c, 0x4c, 0xa8, 0x98,
+	0xa5, 0x62, 0xe2, 0x11, 0xaa, 0xd8, 0x40, 0xe9, 0xd2, 0x29, 0x39, 0xbf, 0xff, 0xcf, 0xe7, 0x9c,
+	0xdf, 0xb4, 0x36, 0xc6, 0x64, 0x12, 0x85, 0x43, 0x09, 0x02, 0xfd, 0xc0, 0x49, 0x25, 0x66, 0xc8,
+	0xca, 0x31, 0x06, 0x20, 0x66, 0xcd, 0xeb, 0x30, 0xca, 0xa6, 0xf3, 0x91, 0x33, 0xc6, 0xb8, 0x1b,
+	0x62, 0x88, 0x5d, 0x7d, 0x3c, 0x9a, 0x4f, 0x74, 0xa5, 0x0b, 0xfd, 0x67, 0xb0, 0xa6, 0x0d, 0x52,
+	0xa2, 0x34, 0x45, 0xfb, 0x85, 0xd0, 0xdf, 0x03, 0x7d, 0xf7, 0x60, 0xea, 0x27, 0x21, 0xb0, 0x16,
+	0x2d, 0x4d, 0x22, 0x10, 0x41, 0x83, 0xfc, 0x23, 0x9d, 0x6a, 0xbf, 0xba, 0xdf, 0xb4, 0x8c, 0xe0,
+	0x99, 0x0f, 0xeb, 0xd1, 0x2a, 0x8a, 0x60, 0xb8, 0xf0, 0xc5, 0x1c, 0x1a, 0x05, 0x6d, 0xfa, 0xbb,
+	0xdf, 0xb4, 0x6a, 0x67, 0xf1, 0x0a, 0xe3, 0x28, 0x83, 0x38, 0xcd, 0x1e, 0xbd, 0x0a, 0x8a, 0xe0,
+	0x3e, 0xd7, 0x72, 0x2a, 0x81, 0xe5, 0x91, 0x2a, 0x7e, 0x51, 0x67, 0xf1, 0x92, 0x4a, 0x60, 0xa9,
+	0xa9, 0xf6, 0x82, 0x32, 0x33, 0x9c, 0xa7, 0xf7, 0xf6, 0x20, 0x45, 0x99, 0x31, 0x87, 0xfe, 0xf2,
+	0xd3, 0x54, 0x44, 0x90, 0x0f, 0x59, 0xec, 0xd8, 0x6e, 0xdd, 0x31, 0x49, 0x38, 0x97, 0x9b, 0x78,
+	0x27, 0x13, 0xbb, 0xa1, 0x15, 0x09, 0x0f, 0x30, 0xce, 0x20, 0x68, 0x14, 0x7e, 0x00, 0xce, 0xae,
+	0x36, 0xd2, 0xba, 0xe9, 0x78, 0xea, 0x3e, 0x4b, 0x31, 0x99, 0x01, 0xfb, 0x4f, 0x4b, 0x3a, 0x3c,
+	0x1d, 0x8e, 0xed, 0xfe, 0x39, 0x5d, 0x73, 0x97, 0x8b, 0x9e, 0x39, 0x63, 0x2e, 0x2d, 0x4b, 0x3d,
+	0xa8, 0x4e, 0xc7, 0x76, 0x9b, 0xdf, 0x9b, 0x5d, 0xae, 0xe2, 0x1d, 0x9d, 0xfd, 0xde, 0x6a, 0xcb,
+	0xad, 0xf5, 0x96, 0x5b, 0x87, 0x2d, 0x27, 0x4f, 0x8a, 0x93, 0x57, 0xc5, 0xad, 0x37, 0xc5, 0xc9,
+	0x4a, 0x71, 0xf2, 0xae, 0x38, 0xf9, 0x50, 0xdc, 0x3a, 0x28, 0x4e, 0x9e, 0x77, 0xdc, 0x5a, 0xed,
+	0xb8, 0xb5, 0xde, 0x71, 0x6b, 0x54, 0xd6, 0x6f, 0x78, 0xfb, 0x39, 0x00, 0x38, 0xc6, 0xa9, 0xdd,
+	0x1e, 0x02, 0x00, 0x00,
+}
+
+func (this *ConfigChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ConfigChange{")
+	s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	s = append(s, "OldValue: "+fmt.Sprintf("%#v", this.OldValue)+",\n")
+	s = append(s, "NewValue: "+fmt.Sprintf("%#v", this.NewValue)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConfigReloadReport) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ConfigReloadReport{")
+	if this.Applied != nil {
+		s = append(s, "Applied: "+fmt.Sprintf("%#v", this.Applied)+",\n")
+	}
+	if this.Rejected != nil {
+		s = append(s, "Rejected: "+fmt.Sprintf("%#v", this.Rejected)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReloadConfigResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ReloadConfigResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Report != nil {
+		s = append(s, "Report: "+fmt.Sprintf("%#v", this.Report)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringConfigReload(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ConfigChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintConfigReload(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintConfigReload(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintConfigReload(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigReloadReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigReloadReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigReloadReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rejected) > 0 {
+		for iNdEx := len(m.Rejected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rejected[iNdEx].Size()
+				i -= size
+				if _, err := m.Rejected[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintConfigReload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Applied) > 0 {
+		for iNdEx := len(m.Applied) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Applied[iNdEx].Size()
+				i -= size
+				if _, err := m.Applied[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintConfigReload(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size := m.Report.Size()
+			i -= size
+			if _, err := m.Report.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConfigReload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConfigReload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConfigReload(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConfigReload(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConfigChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovConfigReload(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovConfigReload(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovConfigReload(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigReloadReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applied) > 0 {
+		for _, e := range m.Applied {
+			l = e.Size()
+			n += 1 + l + sovConfigReload(uint64(l))
+		}
+	}
+	if len(m.Rejected) > 0 {
+		for _, e := range m.Rejected {
+			l = e.Size()
+			n += 1 + l + sovConfigReload(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReloadConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovConfigReload(uint64(l))
+	}
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovConfigReload(uint64(l))
+	}
+	return n
+}
+
+func sovConfigReload(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConfigReload(x uint64) (n int) {
+	return sovConfigReload(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ConfigChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfigChange{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`OldValue:` + fmt.Sprintf("%v", this.OldValue) + `,`,
+		`NewValue:` + fmt.Sprintf("%v", this.NewValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigReloadReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForApplied := "[]*ConfigChange{"
+	for _, f := range this.Applied {
+		repeatedStringForApplied += strings.Replace(f.String(), "ConfigChange", "ConfigChange", 1) + ","
+	}
+	repeatedStringForApplied += "}"
+	repeatedStringForRejected := "[]*ConfigChange{"
+	for _, f := range this.Rejected {
+		repeatedStringForRejected += strings.Replace(f.String(), "ConfigChange", "ConfigChange", 1) + ","
+	}
+	repeatedStringForRejected += "}"
+	s := strings.Join([]string{`&ConfigReloadReport{`,
+		`Applied:` + repeatedStringForApplied + `,`,
+		`Rejected:` + repeatedStringForRejected + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReloadConfigResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReloadConfigResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Report:` + strings.Replace(this.Report.String(), "ConfigReloadReport", "ConfigReloadReport", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringConfigReload(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ConfigChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigReload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigReload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigReloadReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigReload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigReloadReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigReloadReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applied = append(m.Applied, &ConfigChange{})
+			if err := m.Applied[len(m.Applied)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejected = append(m.Rejected, &ConfigChange{})
+			if err := m.Rejected[len(m.Rejected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigReload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigReload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReloadConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReloadConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &ConfigReloadReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigReload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfigReload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConfigReload(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConfigReload
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConfigReload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConfigReload
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConfigReload
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConfigReload
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConfigReload        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConfigReload          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConfigReload = fmt.Errorf("proto: unexpected end of group")
+)
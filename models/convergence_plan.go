@@ -0,0 +1,50 @@
+package models
+
+// DefaultConvergencePlanSampleSize is the number of keys or guids listed for
+// each step of a convergence plan when the request does not set one.
+const DefaultConvergencePlanSampleSize = 10
+
+func (request *ConvergencePlanRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.SampleSize < 0 {
+		validationError = validationError.Append(ErrInvalidField{"sample_size"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// NewActualLRPKeySample counts the keys and keeps the first sampleSize of
+// them.
+func NewActualLRPKeySample(keys []*ActualLRPKey, sampleSize int) *ActualLRPKeySample {
+	sample := &ActualLRPKeySample{Count: int32(len(keys))}
+	if len(keys) > sampleSize {
+		keys = keys[:sampleSize]
+	}
+	sample.Samples = append(sample.Samples, keys...)
+	return sample
+}
+
+// HasIndex reports whether one of the sampled keys has the index.
+func (s *ActualLRPKeySample) HasIndex(index int32) bool {
+	for _, key := range s.GetSamples() {
+		if key.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
+// NewTaskGuidSample counts the guids and keeps the first sampleSize of them.
+func NewTaskGuidSample(guids []string, sampleSize int) *TaskGuidSample {
+	sample := &TaskGuidSample{Count: int32(len(guids))}
+	if len(guids) > sampleSize {
+		guids = guids[:sampleSize]
+	}
+	sample.TaskGuids = append(sample.TaskGuids, guids...)
+	return sample
+}
@@ -0,0 +1,2979 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: convergence_plan.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ConvergencePlanRequest struct {
+	SampleSize int32 `protobuf:"varint,1,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size"`
+}
+
+func (m *ConvergencePlanRequest) Reset()      { *m = ConvergencePlanRequest{} }
+func (*ConvergencePlanRequest) ProtoMessage() {}
+func (*ConvergencePlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5fdfb99b316404a, []int{0}
+}
+func (m *ConvergencePlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvergencePlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvergencePlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvergencePlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvergencePlanRequest.Merge(m, src)
+}
+func (m *ConvergencePlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvergencePlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvergencePlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvergencePlanRequest proto.InternalMessageInfo
+
+func (m *ConvergencePlanRequest) GetSampleSize() int32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+type ConvergencePlanResponse struct {
+	Error *Error           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Plan  *ConvergencePlan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+}
+
+func (m *ConvergencePlanResponse) Reset()      { *m = ConvergencePlanResponse{} }
+func (*ConvergencePlanResponse) ProtoMessage() {}
+func (*ConvergencePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5fdfb99b316404a, []int{1}
+}
+func (m *ConvergencePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvergencePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvergencePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvergencePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvergencePlanResponse.Merge(m, src)
+}
+func (m *ConvergencePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvergencePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvergencePlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvergencePlanResponse proto.InternalMessageInfo
+
+func (m *ConvergencePlanResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ConvergencePlanResponse) GetPlan() *ConvergencePlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+type ConvergencePlan struct {
+	PlannedAt         int64                `protobuf:"varint,1,opt,name=planned_at,json=plannedAt,proto3" json:"planned_at"`
+	CellCount         int32                `protobuf:"varint,2,opt,name=cell_count,json=cellCount,proto3" json:"cell_count"`
+	MissingCellIds    []string             `protobuf:"bytes,3,rep,name=missing_cell_ids,json=missingCellIds,proto3" json:"missing_cell_ids,omitempty"`
+	ExpiredDomains    []string             `protobuf:"bytes,4,rep,name=expired_domains,json=expiredDomains,proto3" json:"expired_domains,omitempty"`
+	LRPs              *LRPConvergencePlan  `protobuf:"bytes,5,opt,name=lrps,proto3" json:"lrps,omitempty"`
+	Tasks             *TaskConvergencePlan `protobuf:"bytes,6,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	ConvergencePaused bool                 `protobuf:"varint,7,opt,name=convergence_paused,json=convergencePaused,proto3" json:"convergence_paused"`
+}
+
+func (m *ConvergencePlan) Reset()      { *m = ConvergencePlan{} }
+func (*ConvergencePlan) ProtoMessage() {}
+func (*ConvergencePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5fdfb99b316404a, []int{2}
+}
+func (m *ConvergencePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvergencePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvergencePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvergencePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvergencePlan.Merge(m, src)
+}
+func (m *ConvergencePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvergencePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvergencePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvergencePlan proto.InternalMessageInfo
+
+func (m *ConvergencePlan) GetPlannedAt() int64 {
+	if m != nil {
+		return m.PlannedAt
+	}
+	return 0
+}
+
+func (m *ConvergencePlan) GetCellCount() int32 {
+	if m != nil {
+		return m.CellCount
+	}
+	return 0
+}
+
+func (m *ConvergencePlan) GetMissingCellIds() []string {
+	if m != nil {
+		return m.MissingCellIds
+	}
+	return nil
+}
+
+func (m *ConvergencePlan) GetExpiredDomains() []string {
+	if m != nil {
+		return m.ExpiredDomains
+	}
+	return nil
+}
+
+func (m *ConvergencePlan) GetLRPs() *LRPConvergencePlan {
+	if m != nil {
+		return m.LRPs
+	}
+	return nil
+}
+
+func (m *ConvergencePlan) GetTasks() *TaskConvergencePlan {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *ConvergencePlan) GetConvergencePaused() bool {
+	if m != nil {
+		return m.ConvergencePaused
+	}
+	return false
+}
+
+type LRPConvergencePlan struct {
+	MissingInstancesToCreate    *ActualLRPKeySample `protobuf:"bytes,1,opt,name=missing_instances_to_create,json=missingInstancesToCreate,proto3" json:"missing_instances_to_create,omitempty"`
+	InstancesToStart            *ActualLRPKeySample `protobuf:"bytes,2,opt,name=instances_to_start,json=instancesToStart,proto3" json:"instances_to_start,omitempty"`
+	InstancesToRetire           *ActualLRPKeySample `protobuf:"bytes,3,opt,name=instances_to_retire,json=instancesToRetire,proto3" json:"instances_to_retire,omitempty"`
+	InstancesToMarkSuspect      *ActualLRPKeySample `protobuf:"bytes,4,opt,name=instances_to_mark_suspect,json=instancesToMarkSuspect,proto3" json:"instances_to_mark_suspect,omitempty"`
+	InstancesToUnclaim          *ActualLRPKeySample `protobuf:"bytes,5,opt,name=instances_to_unclaim,json=instancesToUnclaim,proto3" json:"instances_to_unclaim,omitempty"`
+	SuspectInstancesToRestore   *ActualLRPKeySample `protobuf:"bytes,6,opt,name=suspect_instances_to_restore,json=suspectInstancesToRestore,proto3" json:"suspect_instances_to_restore,omitempty"`
+	SuspectInstancesToRemove    *ActualLRPKeySample `protobuf:"bytes,7,opt,name=suspect_instances_to_remove,json=suspectInstancesToRemove,proto3" json:"suspect_instances_to_remove,omitempty"`
+	EvacuatingInstancesToRemove *ActualLRPKeySample `protobuf:"bytes,8,opt,name=evacuating_instances_to_remove,json=evacuatingInstancesToRemove,proto3" json:"evacuating_instances_to_remove,omitempty"`
+	DrainingInstancesToReplace  *ActualLRPKeySample `protobuf:"bytes,9,opt,name=draining_instances_to_replace,json=drainingInstancesToReplace,proto3" json:"draining_instances_to_replace,omitempty"`
+	DrainingInstancesDelayed    *ActualLRPKeySample `protobuf:"bytes,10,opt,name=draining_instances_delayed,json=drainingInstancesDelayed,proto3" json:"draining_instances_delayed,omitempty"`
+}
+
+func (m *LRPConvergencePlan) Reset()      { *m = LRPConvergencePlan{} }
+func (*LRPConvergencePlan) ProtoMessage() {}
+func (*LRPConvergencePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5fdfb99b316404a, []int{3}
+}
+func (m *LRPConvergencePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LRPConvergencePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LRPConvergencePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LRPConvergencePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LRPConvergencePlan.Merge(m, src)
+}
+func (m *LRPConvergencePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *LRPConvergencePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_LRPConvergencePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LRPConvergencePlan proto.InternalMessageInfo
+
+func (m *LRPConvergencePlan) GetMissingInstancesToCreate() *ActualLRPKeySample {
+	if m != nil {
+		return m.MissingInstancesToCreate
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetInstancesToStart() *ActualLRPKeySample {
+	if m != nil {
+		return m.InstancesToStart
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetInstancesToRetire() *ActualLRPKeySample {
+	if m != nil {
+		return m.InstancesToRetire
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetInstancesToMarkSuspect() *ActualLRPKeySample {
+	if m != nil {
+		return m.InstancesToMarkSuspect
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetInstancesToUnclaim() *ActualLRPKeySample {
+	if m != nil {
+		return m.InstancesToUnclaim
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetSuspectInstancesToRestore() *ActualLRPKeySample {
+	if m != nil {
+		return m.SuspectInstancesToRestore
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetSuspectInstancesToRemove() *ActualLRPKeySample {
+	if m != nil {
+		return m.SuspectInstancesToRemove
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetEvacuatingInstancesToRemove() *ActualLRPKeySample {
+	if m != nil {
+		return m.EvacuatingInstancesToRemove
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetDrainingInstancesToReplace() *ActualLRPKeySample {
+	if m != nil {
+		return m.DrainingInstancesToReplace
+	}
+	return nil
+}
+
+func (m *LRPConvergencePlan) GetDrainingInstancesDelayed() *ActualLRPKeySample {
+	if m != nil {
+		return m.DrainingInstancesDelayed
+	}
+	return nil
+}
+
+type TaskConvergencePlan struct {
+	ExpiredPendingTasksToFail   *TaskGuidSample `protobuf:"bytes,1,opt,name=expired_pending_tasks_to_fail,json=expiredPendingTasksToFail,proto3" json:"expired_pending_tasks_to_fail,omitempty"`
+	PendingTasksToKick          *TaskGuidSample `protobuf:"bytes,2,opt,name=pending_tasks_to_kick,json=pendingTasksToKick,proto3" json:"pending_tasks_to_kick,omitempty"`
+	TasksWithMissingCellsToFail *TaskGuidSample `protobuf:"bytes,3,opt,name=tasks_with_missing_cells_to_fail,json=tasksWithMissingCellsToFail,proto3" json:"tasks_with_missing_cells_to_fail,omitempty"`
+	ResolvingTasksToDemote      *TaskGuidSample `protobuf:"bytes,4,opt,name=resolving_tasks_to_demote,json=resolvingTasksToDemote,proto3" json:"resolving_tasks_to_demote,omitempty"`
+	CompletedTasksToDelete      *TaskGuidSample `protobuf:"bytes,5,opt,name=completed_tasks_to_delete,json=completedTasksToDelete,proto3" json:"completed_tasks_to_delete,omitempty"`
+	CompletedTasksToKick        *TaskGuidSample `protobuf:"bytes,6,opt,name=completed_tasks_to_kick,json=completedTasksToKick,proto3" json:"completed_tasks_to_kick,omitempty"`
+	InvalidTasksToDelete        *TaskGuidSample `protobuf:"bytes,7,opt,name=invalid_tasks_to_delete,json=invalidTasksToDelete,proto3" json:"invalid_tasks_to_delete,omitempty"`
+}
+
+func (m *TaskConvergencePlan) Reset()      { *m = TaskConvergencePlan{} }
+func (*TaskConvergencePlan) ProtoMessage() {}
+func (*TaskConvergencePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5fdfb99b316404a, []int{4}
+}
+func (m *TaskConvergencePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskConvergencePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskConvergencePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskConvergencePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskConvergencePlan.Merge(m, src)
+}
+func (m *TaskConvergencePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskConvergencePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskConvergencePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskConvergencePlan proto.InternalMessageInfo
+
+func (m *TaskConvergencePlan) GetExpiredPendingTasksToFail() *TaskGuidSample {
+	if m != nil {
+		return m.ExpiredPendingTasksToFail
+	}
+	return nil
+}
+
+func (m *TaskConvergencePlan) GetPendingTasksToKick() *TaskGuidSample {
+	if m != nil {
+		return m.PendingTasksToKick
+	}
+	return nil
+}
+
+func (m *TaskConvergencePlan) GetTasksWithMissingCellsToFail() *TaskGuidSample {
+	if m != nil {
+		return m.TasksWithMissingCellsToFail
+	}
+	return nil
+}
+
+func (m *TaskConvergencePlan) GetResolvingTasksToDemote() *TaskGuidSample {
+	if m != nil {
+		return m.ResolvingTasksToDemote
+	}
+	return nil
+}
+
+func (m *TaskConvergencePlan) GetCompletedTasksToDelete() *TaskGuidSample {
+	if m != nil {
+		return m.CompletedTasksToDelete
+	}
+	return nil
+}
+
+func (m *TaskConvergencePlan) GetCompletedTasksToKick() *TaskGuidSample {
+	if m != nil {
+		return m.CompletedTasksToKick
+	}
+	return nil
+}
+
+func (m *TaskConvergencePlan) GetInvalidTasksToDelete() *TaskGuidSample {
+	if m != nil {
+		return m.InvalidTasksToDelete
+	}
+	return nil
+}
+
+type ActualLRPKeySample struct {
+	Count   int32           `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Samples []*ActualLRPKey `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *ActualLRPKeySample) Reset()      { *m = ActualLRPKeySample{} }
+func (*ActualLRPKeySample) ProtoMessage() {}
+func (*ActualLRPKeySample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5fdfb99b316404a, []int{5}
+}
+func (m *ActualLRPKeySample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPKeySample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPKeySample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPKeySample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPKeySample.Merge(m, src)
+}
+func (m *ActualLRPKeySample) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPKeySample) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPKeySample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPKeySample proto.InternalMessageInfo
+
+func (m *ActualLRPKeySample) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ActualLRPKeySample) GetSamples() []*ActualLRPKey {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+type TaskGuidSample struct {
+	Count     int32    `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	TaskGuids []string `protobuf:"bytes,2,rep,name=task_guids,json=taskGuids,proto3" json:"task_guids,omitempty"`
+}
+
+func (m *TaskGuidSample) Reset()      { *m = TaskGuidSample{} }
+func (*TaskGuidSample) ProtoMessage() {}
+func (*TaskGuidSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5fdfb99b316404a, []int{6}
+}
+func (m *TaskGuidSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskGuidSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskGuidSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskGuidSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskGuidSample.Merge(m, src)
+}
+func (m *TaskGuidSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskGuidSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskGuidSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskGuidSample proto.InternalMessageInfo
+
+func (m *TaskGuidSample) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TaskGuidSample) GetTaskGuids() []string {
+	if m != nil {
+		return m.TaskGuids
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConvergencePlanRequest)(nil), "models.ConvergencePlanRequest")
+	proto.RegisterType((*ConvergencePlanResponse)(nil), "models.ConvergencePlanResponse")
+	proto.RegisterType((*ConvergencePlan)(nil), "models.ConvergencePlan")
+	proto.RegisterType((*LRPConvergencePlan)(nil), "models.LRPConvergencePlan")
+	proto.RegisterType((*TaskConvergencePlan)(nil), "models.TaskConvergencePlan")
+	proto.RegisterType((*ActualLRPKeySample)(nil), "models.ActualLRPKeySample")
+	proto.RegisterType((*TaskGuidSample)(nil), "models.TaskGuidSample")
+}
+
+func init() { proto.RegisterFile("convergence_plan.proto", fileDescriptor_e5fdfb99b316404a) }
+
+var fileDescriptor_e5fdfb99b316404a = []byte{
+	// 945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0x2d, 0xff, 0x4a, 0xfc, 0x32, 0x24, 0xe9, 0x36, 0x38, 0x1b, 0x87, 0xc8, 0x1e, 0x73,
+	0xc0, 0x33, 0x4c, 0x5d, 0x28, 0x1c, 0xb8, 0xd6, 0x49, 0x81, 0xb4, 0xc9, 0x8c, 0x51, 0xd2, 0xa1,
+	0x0c, 0xd3, 0xd1, 0x6c, 0xa4, 0xad, 0xb3, 0x63, 0x59, 0x12, 0xda, 0x95, 0xa1, 0x3d, 0xf1, 0x27,
+	0xf0, 0x67, 0xf0, 0xa7, 0x70, 0xcc, 0xb1, 0x17, 0x3c, 0xc4, 0xb9, 0x30, 0x39, 0xe5, 0xc4, 0x89,
+	0x03, 0xa3, 0x5d, 0x29, 0x96, 0x6c, 0x63, 0x9d, 0xbc, 0xfb, 0xdd, 0xf7, 0xfd, 0xbc, 0xf7, 0x56,
+	0xab, 0xb5, 0xa0, 0x6e, 0x79, 0xee, 0x98, 0x06, 0x03, 0xea, 0x5a, 0xd4, 0xf4, 0x1d, 0xe2, 0x76,
+	0xfd, 0xc0, 0x13, 0x1e, 0xaa, 0x8e, 0x3c, 0x9b, 0x3a, 0xbc, 0xf1, 0x68, 0xc0, 0xc4, 0x65, 0x78,
+	0xd1, 0xb5, 0xbc, 0xd1, 0xe3, 0x81, 0x37, 0xf0, 0x1e, 0xcb, 0xe5, 0x8b, 0xf0, 0x8d, 0x9c, 0xc9,
+	0x89, 0x1c, 0x29, 0x5b, 0x63, 0x9b, 0x58, 0x22, 0x24, 0x8e, 0xe9, 0x04, 0x7e, 0xac, 0x6c, 0xd0,
+	0x20, 0xf0, 0x02, 0x35, 0x69, 0x3f, 0x87, 0xfa, 0xe1, 0x2c, 0x5f, 0xdf, 0x21, 0xae, 0x41, 0x7f,
+	0x0a, 0x29, 0x17, 0xe8, 0x33, 0xd8, 0xe0, 0x64, 0xe4, 0x3b, 0xd4, 0xe4, 0xec, 0x1d, 0xc5, 0x5a,
+	0x4b, 0xeb, 0x54, 0x7a, 0x5b, 0xb7, 0x93, 0x66, 0x5a, 0x36, 0x40, 0x4d, 0xce, 0xd8, 0x3b, 0xda,
+	0x1e, 0xc2, 0xee, 0x02, 0x8b, 0xfb, 0x9e, 0xcb, 0x29, 0xfa, 0x18, 0x2a, 0x32, 0xab, 0xc4, 0x6c,
+	0x3c, 0xf9, 0xa0, 0xab, 0x9a, 0xe9, 0x3e, 0x8b, 0x44, 0x43, 0xad, 0xa1, 0x4f, 0xa1, 0x1c, 0xf5,
+	0x8b, 0x8b, 0x32, 0x66, 0x37, 0x89, 0x99, 0x67, 0xca, 0xa0, 0xf6, 0x3f, 0x45, 0xd8, 0x9a, 0x5b,
+	0x41, 0x8f, 0x00, 0xa2, 0x35, 0x97, 0xda, 0x26, 0x11, 0x32, 0x55, 0xa9, 0xb7, 0x79, 0x3b, 0x69,
+	0xa6, 0x54, 0xa3, 0x16, 0x8f, 0x9f, 0x8a, 0x28, 0xdc, 0xa2, 0x8e, 0x63, 0x5a, 0x5e, 0xe8, 0x0a,
+	0x99, 0xb5, 0xa2, 0xc2, 0x67, 0xaa, 0x51, 0x8b, 0xc6, 0x87, 0xd1, 0x10, 0x75, 0x60, 0x7b, 0xc4,
+	0x38, 0x67, 0xee, 0xc0, 0x94, 0x01, 0xcc, 0xe6, 0xb8, 0xd4, 0x2a, 0x75, 0x6a, 0xc6, 0x66, 0xac,
+	0x1f, 0x52, 0xc7, 0x39, 0xb6, 0x39, 0xfa, 0x04, 0xb6, 0xe8, 0x2f, 0x3e, 0x0b, 0xa8, 0x6d, 0xda,
+	0xde, 0x88, 0x30, 0x97, 0xe3, 0xb2, 0x0a, 0x8c, 0xe5, 0x23, 0xa5, 0xa2, 0xaf, 0xa0, 0xec, 0x04,
+	0x3e, 0xc7, 0x15, 0xd9, 0x71, 0x23, 0xe9, 0xf8, 0xc4, 0xe8, 0xcf, 0xb5, 0xd6, 0x5b, 0x9f, 0x4e,
+	0x9a, 0xe5, 0x13, 0xa3, 0xcf, 0x0d, 0xe9, 0x40, 0x9f, 0x43, 0x45, 0x10, 0x3e, 0xe4, 0xb8, 0x2a,
+	0xad, 0xfb, 0x89, 0xf5, 0x9c, 0xf0, 0xe1, 0xfc, 0x86, 0xa9, 0x48, 0xf4, 0x0c, 0x50, 0xe6, 0x68,
+	0x91, 0x90, 0x53, 0x1b, 0xaf, 0xb5, 0xb4, 0xce, 0x7a, 0xaf, 0x7e, 0x3b, 0x69, 0x2e, 0x59, 0x35,
+	0x1e, 0xa4, 0xb4, 0xbe, 0x94, 0xda, 0xff, 0x56, 0x01, 0x2d, 0x16, 0x88, 0x7e, 0x80, 0xfd, 0x64,
+	0x77, 0x98, 0xcb, 0x05, 0x71, 0x2d, 0xca, 0x4d, 0xe1, 0x99, 0x56, 0x40, 0x89, 0xa0, 0x58, 0xcb,
+	0x76, 0xf8, 0x54, 0x1e, 0xca, 0x13, 0xa3, 0xff, 0x82, 0xbe, 0x3d, 0x93, 0x27, 0xc8, 0xc0, 0xb1,
+	0xfd, 0x38, 0x71, 0x9f, 0x7b, 0x87, 0xd2, 0x8b, 0xbe, 0x05, 0x94, 0x41, 0x72, 0x41, 0x02, 0x81,
+	0x8b, 0xb9, 0xc4, 0x6d, 0x36, 0x43, 0x9d, 0x45, 0x1e, 0xf4, 0x1c, 0x1e, 0x66, 0x48, 0x01, 0x15,
+	0x2c, 0xa0, 0xb8, 0x94, 0x8b, 0x7a, 0x90, 0x42, 0x19, 0xd2, 0x84, 0x5e, 0xc2, 0x5e, 0x86, 0x35,
+	0x22, 0xc1, 0xd0, 0xe4, 0x21, 0xf7, 0xa9, 0x25, 0x70, 0x39, 0x97, 0x58, 0x4f, 0x11, 0x4f, 0x49,
+	0x30, 0x3c, 0x53, 0x4e, 0x74, 0x02, 0x3b, 0x19, 0x6c, 0xe8, 0x5a, 0x0e, 0x61, 0x23, 0x5c, 0xc9,
+	0x25, 0xa2, 0x14, 0xf1, 0xa5, 0x72, 0xa1, 0x1f, 0xe1, 0xa3, 0xb8, 0x24, 0x73, 0xae, 0x71, 0x2e,
+	0xbc, 0x80, 0xe2, 0x6a, 0x2e, 0x75, 0x2f, 0xf6, 0x1f, 0xa7, 0x37, 0x40, 0x9a, 0xa3, 0x47, 0xfe,
+	0x3f, 0xf0, 0x91, 0x37, 0xa6, 0x78, 0x2d, 0x97, 0x8d, 0x97, 0xb1, 0x23, 0x2f, 0x32, 0x41, 0xa7,
+	0x63, 0x62, 0x85, 0x44, 0x2c, 0x1c, 0xa8, 0x98, 0xbe, 0x9e, 0x4b, 0xdf, 0x9f, 0x11, 0x16, 0x13,
+	0xbc, 0x86, 0x03, 0x3b, 0x20, 0xcc, 0x5d, 0x82, 0xf7, 0x1d, 0x62, 0x51, 0x5c, 0xcb, 0xe5, 0x37,
+	0x12, 0x40, 0x86, 0x2e, 0xdd, 0xe8, 0x15, 0x34, 0x96, 0xe0, 0x6d, 0xea, 0x90, 0xb7, 0xd4, 0xc6,
+	0x90, 0xbf, 0x33, 0x0b, 0xec, 0x23, 0xe5, 0x6d, 0xff, 0x59, 0x86, 0x87, 0x4b, 0x5e, 0x72, 0xf4,
+	0x0a, 0x0e, 0x92, 0x3b, 0xc7, 0xa7, 0xae, 0x1d, 0x25, 0x96, 0xaf, 0x7d, 0xd4, 0xd3, 0x1b, 0xc2,
+	0x9c, 0xf8, 0x0d, 0xac, 0xa7, 0x2f, 0x8a, 0x6f, 0x42, 0x66, 0x27, 0x8f, 0x39, 0x36, 0xf7, 0x95,
+	0x37, 0x5a, 0xe5, 0xe7, 0xde, 0xd7, 0x84, 0x39, 0xe8, 0x18, 0x3e, 0x5c, 0x20, 0x0e, 0x99, 0x35,
+	0xc4, 0xc5, 0x95, 0x44, 0xe4, 0x67, 0x50, 0x2f, 0x98, 0x35, 0x44, 0xaf, 0xa1, 0xa5, 0x10, 0x3f,
+	0x33, 0x71, 0x69, 0xa6, 0x6f, 0xd3, 0x59, 0x9d, 0xa5, 0x95, 0xd4, 0x7d, 0xe9, 0xff, 0x9e, 0x89,
+	0xcb, 0xd3, 0xd9, 0x9d, 0x9b, 0x54, 0xfa, 0x1d, 0xec, 0x05, 0x94, 0x7b, 0xce, 0x38, 0x53, 0xab,
+	0x4d, 0x47, 0x9e, 0xa0, 0xb8, 0xbc, 0x92, 0x5b, 0xbf, 0x37, 0xc6, 0xf5, 0x1e, 0x49, 0x57, 0x84,
+	0xb4, 0xbc, 0x28, 0x42, 0x50, 0x3b, 0x8d, 0x8c, 0x04, 0x5c, 0x59, 0x8d, 0xbc, 0x37, 0xde, 0x23,
+	0xa3, 0x19, 0x3a, 0x85, 0xdd, 0x25, 0x48, 0xb9, 0xa3, 0xd5, 0x95, 0xc0, 0x9d, 0x79, 0xa0, 0xdc,
+	0xd3, 0x53, 0xd8, 0x65, 0xee, 0x98, 0x38, 0x6c, 0xb1, 0xbe, 0xb5, 0xd5, 0xb8, 0xd8, 0x96, 0xa9,
+	0xae, 0x4d, 0x01, 0x2d, 0x9e, 0x47, 0xd4, 0x84, 0x8a, 0xfa, 0x97, 0x54, 0x9f, 0x01, 0xb5, 0xdb,
+	0x49, 0x53, 0x09, 0x86, 0xfa, 0x41, 0x5d, 0x58, 0x53, 0x5f, 0x02, 0x1c, 0x17, 0x5b, 0xa5, 0xce,
+	0xc6, 0x93, 0x9d, 0x65, 0xa7, 0xdb, 0x48, 0x82, 0xda, 0x7d, 0xd8, 0xcc, 0x96, 0x93, 0x9f, 0xe2,
+	0x00, 0x20, 0x6a, 0xd0, 0x1c, 0x84, 0xcc, 0x56, 0x59, 0x6a, 0x46, 0x4d, 0xc4, 0x10, 0xde, 0xfb,
+	0xf2, 0xea, 0x5a, 0x2f, 0xbc, 0xbf, 0xd6, 0x0b, 0x77, 0xd7, 0xba, 0xf6, 0xeb, 0x54, 0xd7, 0x7e,
+	0x9f, 0xea, 0x85, 0x3f, 0xa6, 0xba, 0x76, 0x35, 0xd5, 0xb5, 0xbf, 0xa6, 0xba, 0xf6, 0xf7, 0x54,
+	0x2f, 0xdc, 0x4d, 0x75, 0xed, 0xb7, 0x1b, 0xbd, 0x70, 0x75, 0xa3, 0x17, 0xde, 0xdf, 0xe8, 0x85,
+	0x8b, 0xaa, 0xfc, 0x0c, 0xfa, 0xe2, 0xbf, 0x01, 0x00, 0x0d, 0x5d, 0x1d, 0xb3, 0x76, 0x09, 0x00,
+	0x00,
+}
+
+func (this *ConvergencePlanRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ConvergencePlanRequest{")
+	s = append(s, "SampleSize: "+fmt.Sprintf("%#v", this.SampleSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvergencePlanResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ConvergencePlanResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Plan != nil {
+		s = append(s, "Plan: "+fmt.Sprintf("%#v", this.Plan)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvergencePlan) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.ConvergencePlan{")
+	s = append(s, "PlannedAt: "+fmt.Sprintf("%#v", this.PlannedAt)+",\n")
+	s = append(s, "CellCount: "+fmt.Sprintf("%#v", this.CellCount)+",\n")
+	s = append(s, "MissingCellIds: "+fmt.Sprintf("%#v", this.MissingCellIds)+",\n")
+	s = append(s, "ExpiredDomains: "+fmt.Sprintf("%#v", this.ExpiredDomains)+",\n")
+	if this.LRPs != nil {
+		s = append(s, "LRPs: "+fmt.Sprintf("%#v", this.LRPs)+",\n")
+	}
+	if this.Tasks != nil {
+		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
+	}
+	s = append(s, "ConvergencePaused: "+fmt.Sprintf("%#v", this.ConvergencePaused)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LRPConvergencePlan) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&models.LRPConvergencePlan{")
+	if this.MissingInstancesToCreate != nil {
+		s = append(s, "MissingInstancesToCreate: "+fmt.Sprintf("%#v", this.MissingInstancesToCreate)+",\n")
+	}
+	if this.InstancesToStart != nil {
+		s = append(s, "InstancesToStart: "+fmt.Sprintf("%#v", this.InstancesToStart)+",\n")
+	}
+	if this.InstancesToRetire != nil {
+		s = append(s, "InstancesToRetire: "+fmt.Sprintf("%#v", this.InstancesToRetire)+",\n")
+	}
+	if this.InstancesToMarkSuspect != nil {
+		s = append(s, "InstancesToMarkSuspect: "+fmt.Sprintf("%#v", this.InstancesToMarkSuspect)+",\n")
+	}
+	if this.InstancesToUnclaim != nil {
+		s = append(s, "InstancesToUnclaim: "+fmt.Sprintf("%#v", this.InstancesToUnclaim)+",\n")
+	}
+	if this.SuspectInstancesToRestore != nil {
+		s = append(s, "SuspectInstancesToRestore: "+fmt.Sprintf("%#v", this.SuspectInstancesToRestore)+",\n")
+	}
+	if this.SuspectInstancesToRemove != nil {
+		s = append(s, "SuspectInstancesToRemove: "+fmt.Sprintf("%#v", this.SuspectInstancesToRemove)+",\n")
+	}
+	if this.EvacuatingInstancesToRemove != nil {
+		s = append(s, "EvacuatingInstancesToRemove: "+fmt.Sprintf("%#v", this.EvacuatingInstancesToRemove)+",\n")
+	}
+	if this.DrainingInstancesToReplace != nil {
+		s = append(s, "DrainingInstancesToReplace: "+fmt.Sprintf("%#v", this.DrainingInstancesToReplace)+",\n")
+	}
+	if this.DrainingInstancesDelayed != nil {
+		s = append(s, "DrainingInstancesDelayed: "+fmt.Sprintf("%#v", this.DrainingInstancesDelayed)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskConvergencePlan) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.TaskConvergencePlan{")
+	if this.ExpiredPendingTasksToFail != nil {
+		s = append(s, "ExpiredPendingTasksToFail: "+fmt.Sprintf("%#v", this.ExpiredPendingTasksToFail)+",\n")
+	}
+	if this.PendingTasksToKick != nil {
+		s = append(s, "PendingTasksToKick: "+fmt.Sprintf("%#v", this.PendingTasksToKick)+",\n")
+	}
+	if this.TasksWithMissingCellsToFail != nil {
+		s = append(s, "TasksWithMissingCellsToFail: "+fmt.Sprintf("%#v", this.TasksWithMissingCellsToFail)+",\n")
+	}
+	if this.ResolvingTasksToDemote != nil {
+		s = append(s, "ResolvingTasksToDemote: "+fmt.Sprintf("%#v", this.ResolvingTasksToDemote)+",\n")
+	}
+	if this.CompletedTasksToDelete != nil {
+		s = append(s, "CompletedTasksToDelete: "+fmt.Sprintf("%#v", this.CompletedTasksToDelete)+",\n")
+	}
+	if this.CompletedTasksToKick != nil {
+		s = append(s, "CompletedTasksToKick: "+fmt.Sprintf("%#v", this.CompletedTasksToKick)+",\n")
+	}
+	if this.InvalidTasksToDelete != nil {
+		s = append(s, "InvalidTasksToDelete: "+fmt.Sprintf("%#v", this.InvalidTasksToDelete)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPKeySample) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPKeySample{")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.Samples != nil {
+		s = append(s, "Samples: "+fmt.Sprintf("%#v", this.Samples)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskGuidSample) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.TaskGuidSample{")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "TaskGuids: "+fmt.Sprintf("%#v", this.TaskGuids)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringConvergencePlan(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ConvergencePlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvergencePlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvergencePlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SampleSize != 0 {
+		i = encodeVarintConvergencePlan(dAtA, i, uint64(m.SampleSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvergencePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvergencePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvergencePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Plan != nil {
+		{
+			size := m.Plan.Size()
+			i -= size
+			if _, err := m.Plan.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvergencePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvergencePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvergencePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConvergencePaused {
+		i--
+		if m.ConvergencePaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Tasks != nil {
+		{
+			size := m.Tasks.Size()
+			i -= size
+			if _, err := m.Tasks.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LRPs != nil {
+		{
+			size := m.LRPs.Size()
+			i -= size
+			if _, err := m.LRPs.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExpiredDomains) > 0 {
+		for iNdEx := len(m.ExpiredDomains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExpiredDomains[iNdEx])
+			copy(dAtA[i:], m.ExpiredDomains[iNdEx])
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(len(m.ExpiredDomains[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MissingCellIds) > 0 {
+		for iNdEx := len(m.MissingCellIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingCellIds[iNdEx])
+			copy(dAtA[i:], m.MissingCellIds[iNdEx])
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(len(m.MissingCellIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CellCount != 0 {
+		i = encodeVarintConvergencePlan(dAtA, i, uint64(m.CellCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PlannedAt != 0 {
+		i = encodeVarintConvergencePlan(dAtA, i, uint64(m.PlannedAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LRPConvergencePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LRPConvergencePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LRPConvergencePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrainingInstancesDelayed != nil {
+		{
+			size := m.DrainingInstancesDelayed.Size()
+			i -= size
+			if _, err := m.DrainingInstancesDelayed.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.DrainingInstancesToReplace != nil {
+		{
+			size := m.DrainingInstancesToReplace.Size()
+			i -= size
+			if _, err := m.DrainingInstancesToReplace.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.EvacuatingInstancesToRemove != nil {
+		{
+			size := m.EvacuatingInstancesToRemove.Size()
+			i -= size
+			if _, err := m.EvacuatingInstancesToRemove.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SuspectInstancesToRemove != nil {
+		{
+			size := m.SuspectInstancesToRemove.Size()
+			i -= size
+			if _, err := m.SuspectInstancesToRemove.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SuspectInstancesToRestore != nil {
+		{
+			size := m.SuspectInstancesToRestore.Size()
+			i -= size
+			if _, err := m.SuspectInstancesToRestore.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InstancesToUnclaim != nil {
+		{
+			size := m.InstancesToUnclaim.Size()
+			i -= size
+			if _, err := m.InstancesToUnclaim.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.InstancesToMarkSuspect != nil {
+		{
+			size := m.InstancesToMarkSuspect.Size()
+			i -= size
+			if _, err := m.InstancesToMarkSuspect.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InstancesToRetire != nil {
+		{
+			size := m.InstancesToRetire.Size()
+			i -= size
+			if _, err := m.InstancesToRetire.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InstancesToStart != nil {
+		{
+			size := m.InstancesToStart.Size()
+			i -= size
+			if _, err := m.InstancesToStart.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MissingInstancesToCreate != nil {
+		{
+			size := m.MissingInstancesToCreate.Size()
+			i -= size
+			if _, err := m.MissingInstancesToCreate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskConvergencePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskConvergencePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskConvergencePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidTasksToDelete != nil {
+		{
+			size := m.InvalidTasksToDelete.Size()
+			i -= size
+			if _, err := m.InvalidTasksToDelete.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CompletedTasksToKick != nil {
+		{
+			size := m.CompletedTasksToKick.Size()
+			i -= size
+			if _, err := m.CompletedTasksToKick.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CompletedTasksToDelete != nil {
+		{
+			size := m.CompletedTasksToDelete.Size()
+			i -= size
+			if _, err := m.CompletedTasksToDelete.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ResolvingTasksToDemote != nil {
+		{
+			size := m.ResolvingTasksToDemote.Size()
+			i -= size
+			if _, err := m.ResolvingTasksToDemote.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TasksWithMissingCellsToFail != nil {
+		{
+			size := m.TasksWithMissingCellsToFail.Size()
+			i -= size
+			if _, err := m.TasksWithMissingCellsToFail.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PendingTasksToKick != nil {
+		{
+			size := m.PendingTasksToKick.Size()
+			i -= size
+			if _, err := m.PendingTasksToKick.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExpiredPendingTasksToFail != nil {
+		{
+			size := m.ExpiredPendingTasksToFail.Size()
+			i -= size
+			if _, err := m.ExpiredPendingTasksToFail.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActualLRPKeySample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPKeySample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPKeySample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for iNdEx := len(m.Samples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Samples[iNdEx].Size()
+				i -= size
+				if _, err := m.Samples[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintConvergencePlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintConvergencePlan(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskGuidSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskGuidSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskGuidSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskGuids) > 0 {
+		for iNdEx := len(m.TaskGuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TaskGuids[iNdEx])
+			copy(dAtA[i:], m.TaskGuids[iNdEx])
+			i = encodeVarintConvergencePlan(dAtA, i, uint64(len(m.TaskGuids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintConvergencePlan(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConvergencePlan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConvergencePlan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConvergencePlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SampleSize != 0 {
+		n += 1 + sovConvergencePlan(uint64(m.SampleSize))
+	}
+	return n
+}
+
+func (m *ConvergencePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	return n
+}
+
+func (m *ConvergencePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlannedAt != 0 {
+		n += 1 + sovConvergencePlan(uint64(m.PlannedAt))
+	}
+	if m.CellCount != 0 {
+		n += 1 + sovConvergencePlan(uint64(m.CellCount))
+	}
+	if len(m.MissingCellIds) > 0 {
+		for _, s := range m.MissingCellIds {
+			l = len(s)
+			n += 1 + l + sovConvergencePlan(uint64(l))
+		}
+	}
+	if len(m.ExpiredDomains) > 0 {
+		for _, s := range m.ExpiredDomains {
+			l = len(s)
+			n += 1 + l + sovConvergencePlan(uint64(l))
+		}
+	}
+	if m.LRPs != nil {
+		l = m.LRPs.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.Tasks != nil {
+		l = m.Tasks.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.ConvergencePaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *LRPConvergencePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissingInstancesToCreate != nil {
+		l = m.MissingInstancesToCreate.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.InstancesToStart != nil {
+		l = m.InstancesToStart.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.InstancesToRetire != nil {
+		l = m.InstancesToRetire.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.InstancesToMarkSuspect != nil {
+		l = m.InstancesToMarkSuspect.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.InstancesToUnclaim != nil {
+		l = m.InstancesToUnclaim.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.SuspectInstancesToRestore != nil {
+		l = m.SuspectInstancesToRestore.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.SuspectInstancesToRemove != nil {
+		l = m.SuspectInstancesToRemove.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.EvacuatingInstancesToRemove != nil {
+		l = m.EvacuatingInstancesToRemove.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.DrainingInstancesToReplace != nil {
+		l = m.DrainingInstancesToReplace.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.DrainingInstancesDelayed != nil {
+		l = m.DrainingInstancesDelayed.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskConvergencePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiredPendingTasksToFail != nil {
+		l = m.ExpiredPendingTasksToFail.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.PendingTasksToKick != nil {
+		l = m.PendingTasksToKick.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.TasksWithMissingCellsToFail != nil {
+		l = m.TasksWithMissingCellsToFail.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.ResolvingTasksToDemote != nil {
+		l = m.ResolvingTasksToDemote.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.CompletedTasksToDelete != nil {
+		l = m.CompletedTasksToDelete.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.CompletedTasksToKick != nil {
+		l = m.CompletedTasksToKick.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	if m.InvalidTasksToDelete != nil {
+		l = m.InvalidTasksToDelete.Size()
+		n += 1 + l + sovConvergencePlan(uint64(l))
+	}
+	return n
+}
+
+func (m *ActualLRPKeySample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovConvergencePlan(uint64(m.Count))
+	}
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovConvergencePlan(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskGuidSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovConvergencePlan(uint64(m.Count))
+	}
+	if len(m.TaskGuids) > 0 {
+		for _, s := range m.TaskGuids {
+			l = len(s)
+			n += 1 + l + sovConvergencePlan(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovConvergencePlan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConvergencePlan(x uint64) (n int) {
+	return sovConvergencePlan(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ConvergencePlanRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergencePlanRequest{`,
+		`SampleSize:` + fmt.Sprintf("%v", this.SampleSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvergencePlanResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergencePlanResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Plan:` + strings.Replace(this.Plan.String(), "ConvergencePlan", "ConvergencePlan", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvergencePlan) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergencePlan{`,
+		`PlannedAt:` + fmt.Sprintf("%v", this.PlannedAt) + `,`,
+		`CellCount:` + fmt.Sprintf("%v", this.CellCount) + `,`,
+		`MissingCellIds:` + fmt.Sprintf("%v", this.MissingCellIds) + `,`,
+		`ExpiredDomains:` + fmt.Sprintf("%v", this.ExpiredDomains) + `,`,
+		`LRPs:` + strings.Replace(this.LRPs.String(), "LRPConvergencePlan", "LRPConvergencePlan", 1) + `,`,
+		`Tasks:` + strings.Replace(this.Tasks.String(), "TaskConvergencePlan", "TaskConvergencePlan", 1) + `,`,
+		`ConvergencePaused:` + fmt.Sprintf("%v", this.ConvergencePaused) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LRPConvergencePlan) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LRPConvergencePlan{`,
+		`MissingInstancesToCreate:` + strings.Replace(this.MissingInstancesToCreate.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`InstancesToStart:` + strings.Replace(this.InstancesToStart.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`InstancesToRetire:` + strings.Replace(this.InstancesToRetire.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`InstancesToMarkSuspect:` + strings.Replace(this.InstancesToMarkSuspect.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`InstancesToUnclaim:` + strings.Replace(this.InstancesToUnclaim.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`SuspectInstancesToRestore:` + strings.Replace(this.SuspectInstancesToRestore.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`SuspectInstancesToRemove:` + strings.Replace(this.SuspectInstancesToRemove.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`EvacuatingInstancesToRemove:` + strings.Replace(this.EvacuatingInstancesToRemove.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`DrainingInstancesToReplace:` + strings.Replace(this.DrainingInstancesToReplace.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`DrainingInstancesDelayed:` + strings.Replace(this.DrainingInstancesDelayed.String(), "ActualLRPKeySample", "ActualLRPKeySample", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskConvergencePlan) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskConvergencePlan{`,
+		`ExpiredPendingTasksToFail:` + strings.Replace(this.ExpiredPendingTasksToFail.String(), "TaskGuidSample", "TaskGuidSample", 1) + `,`,
+		`PendingTasksToKick:` + strings.Replace(this.PendingTasksToKick.String(), "TaskGuidSample", "TaskGuidSample", 1) + `,`,
+		`TasksWithMissingCellsToFail:` + strings.Replace(this.TasksWithMissingCellsToFail.String(), "TaskGuidSample", "TaskGuidSample", 1) + `,`,
+		`ResolvingTasksToDemote:` + strings.Replace(this.ResolvingTasksToDemote.String(), "TaskGuidSample", "TaskGuidSample", 1) + `,`,
+		`CompletedTasksToDelete:` + strings.Replace(this.CompletedTasksToDelete.String(), "TaskGuidSample", "TaskGuidSample", 1) + `,`,
+		`CompletedTasksToKick:` + strings.Replace(this.CompletedTasksToKick.String(), "TaskGuidSample", "TaskGuidSample", 1) + `,`,
+		`InvalidTasksToDelete:` + strings.Replace(this.InvalidTasksToDelete.String(), "TaskGuidSample", "TaskGuidSample", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPKeySample) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSamples := "[]*ActualLRPKey{"
+	for _, f := range this.Samples {
+		repeatedStringForSamples += strings.Replace(fmt.Sprintf("%v", f), "ActualLRPKey", "ActualLRPKey", 1) + ","
+	}
+	repeatedStringForSamples += "}"
+	s := strings.Join([]string{`&ActualLRPKeySample{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Samples:` + repeatedStringForSamples + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskGuidSample) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskGuidSample{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`TaskGuids:` + fmt.Sprintf("%v", this.TaskGuids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringConvergencePlan(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ConvergencePlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergencePlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergencePlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergencePlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleSize", wireType)
+			}
+			m.SampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergencePlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvergencePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergencePlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergencePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergencePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &ConvergencePlan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergencePlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvergencePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergencePlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergencePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergencePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlannedAt", wireType)
+			}
+			m.PlannedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlannedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellCount", wireType)
+			}
+			m.CellCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CellCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingCellIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingCellIds = append(m.MissingCellIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredDomains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiredDomains = append(m.ExpiredDomains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LRPs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LRPs == nil {
+				m.LRPs = &LRPConvergencePlan{}
+			}
+			if err := m.LRPs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tasks == nil {
+				m.Tasks = &TaskConvergencePlan{}
+			}
+			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvergencePaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConvergencePaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergencePlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LRPConvergencePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergencePlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LRPConvergencePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LRPConvergencePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingInstancesToCreate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MissingInstancesToCreate == nil {
+				m.MissingInstancesToCreate = &ActualLRPKeySample{}
+			}
+			if err := m.MissingInstancesToCreate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstancesToStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstancesToStart == nil {
+				m.InstancesToStart = &ActualLRPKeySample{}
+			}
+			if err := m.InstancesToStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstancesToRetire", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstancesToRetire == nil {
+				m.InstancesToRetire = &ActualLRPKeySample{}
+			}
+			if err := m.InstancesToRetire.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstancesToMarkSuspect", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstancesToMarkSuspect == nil {
+				m.InstancesToMarkSuspect = &ActualLRPKeySample{}
+			}
+			if err := m.InstancesToMarkSuspect.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstancesToUnclaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstancesToUnclaim == nil {
+				m.InstancesToUnclaim = &ActualLRPKeySample{}
+			}
+			if err := m.InstancesToUnclaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectInstancesToRestore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SuspectInstancesToRestore == nil {
+				m.SuspectInstancesToRestore = &ActualLRPKeySample{}
+			}
+			if err := m.SuspectInstancesToRestore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspectInstancesToRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SuspectInstancesToRemove == nil {
+				m.SuspectInstancesToRemove = &ActualLRPKeySample{}
+			}
+			if err := m.SuspectInstancesToRemove.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvacuatingInstancesToRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvacuatingInstancesToRemove == nil {
+				m.EvacuatingInstancesToRemove = &ActualLRPKeySample{}
+			}
+			if err := m.EvacuatingInstancesToRemove.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainingInstancesToReplace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DrainingInstancesToReplace == nil {
+				m.DrainingInstancesToReplace = &ActualLRPKeySample{}
+			}
+			if err := m.DrainingInstancesToReplace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainingInstancesDelayed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DrainingInstancesDelayed == nil {
+				m.DrainingInstancesDelayed = &ActualLRPKeySample{}
+			}
+			if err := m.DrainingInstancesDelayed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergencePlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskConvergencePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergencePlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskConvergencePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskConvergencePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredPendingTasksToFail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiredPendingTasksToFail == nil {
+				m.ExpiredPendingTasksToFail = &TaskGuidSample{}
+			}
+			if err := m.ExpiredPendingTasksToFail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTasksToKick", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingTasksToKick == nil {
+				m.PendingTasksToKick = &TaskGuidSample{}
+			}
+			if err := m.PendingTasksToKick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksWithMissingCellsToFail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TasksWithMissingCellsToFail == nil {
+				m.TasksWithMissingCellsToFail = &TaskGuidSample{}
+			}
+			if err := m.TasksWithMissingCellsToFail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvingTasksToDemote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolvingTasksToDemote == nil {
+				m.ResolvingTasksToDemote = &TaskGuidSample{}
+			}
+			if err := m.ResolvingTasksToDemote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedTasksToDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletedTasksToDelete == nil {
+				m.CompletedTasksToDelete = &TaskGuidSample{}
+			}
+			if err := m.CompletedTasksToDelete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedTasksToKick", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletedTasksToKick == nil {
+				m.CompletedTasksToKick = &TaskGuidSample{}
+			}
+			if err := m.CompletedTasksToKick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidTasksToDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InvalidTasksToDelete == nil {
+				m.InvalidTasksToDelete = &TaskGuidSample{}
+			}
+			if err := m.InvalidTasksToDelete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergencePlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPKeySample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergencePlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPKeySample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPKeySample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, &ActualLRPKey{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergencePlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskGuidSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergencePlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskGuidSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskGuidSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuids = append(m.TaskGuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergencePlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergencePlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConvergencePlan(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConvergencePlan
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConvergencePlan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConvergencePlan
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConvergencePlan
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConvergencePlan
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConvergencePlan        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConvergencePlan          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConvergencePlan = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1173 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: convergence_safety_valve.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ConvergenceSafetyValveResponse struct {
+	Error  *Error                        `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Status *ConvergenceSafetyValveStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *ConvergenceSafetyValveResponse) Reset()      { *m = ConvergenceSafetyValveResponse{} }
+func (*ConvergenceSafetyValveResponse) ProtoMessage() {}
+func (*ConvergenceSafetyValveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde64fb036f295d5, []int{0}
+}
+func (m *ConvergenceSafetyValveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvergenceSafetyValveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvergenceSafetyValveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvergenceSafetyValveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvergenceSafetyValveResponse.Merge(m, src)
+}
+func (m *ConvergenceSafetyValveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvergenceSafetyValveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvergenceSafetyValveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvergenceSafetyValveResponse proto.InternalMessageInfo
+
+func (m *ConvergenceSafetyValveResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ConvergenceSafetyValveResponse) GetStatus() *ConvergenceSafetyValveStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type ConvergenceSafetyValveStatus struct {
+	MaxMissingCellPercent int32                          `protobuf:"varint,1,opt,name=max_missing_cell_percent,json=maxMissingCellPercent,proto3" json:"max_missing_cell_percent"`
+	ConfirmPasses         int32                          `protobuf:"varint,2,opt,name=confirm_passes,json=confirmPasses,proto3" json:"confirm_passes"`
+	Valves                []*ConvergenceSafetyValveState `protobuf:"bytes,3,rep,name=valves,proto3" json:"valves,omitempty"`
+}
+
+func (m *ConvergenceSafetyValveStatus) Reset()      { *m = ConvergenceSafetyValveStatus{} }
+func (*ConvergenceSafetyValveStatus) ProtoMessage() {}
+func (*ConvergenceSafetyValveStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde64fb036f295d5, []int{1}
+}
+func (m *ConvergenceSafetyValveStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvergenceSafetyValveStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvergenceSafetyValveStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvergenceSafetyValveStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvergenceSafetyValveStatus.Merge(m, src)
+}
+func (m *ConvergenceSafetyValveStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvergenceSafetyValveStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvergenceSafetyValveStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvergenceSafetyValveStatus proto.InternalMessageInfo
+
+func (m *ConvergenceSafetyValveStatus) GetMaxMissingCellPercent() int32 {
+	if m != nil {
+		return m.MaxMissingCellPercent
+	}
+	return 0
+}
+
+func (m *ConvergenceSafetyValveStatus) GetConfirmPasses() int32 {
+	if m != nil {
+		return m.ConfirmPasses
+	}
+	return 0
+}
+
+func (m *ConvergenceSafetyValveStatus) GetValves() []*ConvergenceSafetyValveState {
+	if m != nil {
+		return m.Valves
+	}
+	return nil
+}
+
+type ConvergenceSafetyValveState struct {
+	Convergence        string   `protobuf:"bytes,1,opt,name=convergence,proto3" json:"convergence"`
+	Tripped            bool     `protobuf:"varint,2,opt,name=tripped,proto3" json:"tripped"`
+	MissingCellIds     []string `protobuf:"bytes,3,rep,name=missing_cell_ids,json=missingCellIds,proto3" json:"missing_cell_ids,omitempty"`
+	MissingCellPercent int32    `protobuf:"varint,4,opt,name=missing_cell_percent,json=missingCellPercent,proto3" json:"missing_cell_percent"`
+	TrippedPasses      int32    `protobuf:"varint,5,opt,name=tripped_passes,json=trippedPasses,proto3" json:"tripped_passes"`
+	Overridden         bool     `protobuf:"varint,6,opt,name=overridden,proto3" json:"overridden"`
+	AcceptedCellCount  int32    `protobuf:"varint,7,opt,name=accepted_cell_count,json=acceptedCellCount,proto3" json:"accepted_cell_count"`
+}
+
+func (m *ConvergenceSafetyValveState) Reset()      { *m = ConvergenceSafetyValveState{} }
+func (*ConvergenceSafetyValveState) ProtoMessage() {}
+func (*ConvergenceSafetyValveState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde64fb036f295d5, []int{2}
+}
+func (m *ConvergenceSafetyValveState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvergenceSafetyValveState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvergenceSafetyValveState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvergenceSafetyValveState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvergenceSafetyValveState.Merge(m, src)
+}
+func (m *ConvergenceSafetyValveState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvergenceSafetyValveState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvergenceSafetyValveState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvergenceSafetyValveState proto.InternalMessageInfo
+
+func (m *ConvergenceSafetyValveState) GetConvergence() string {
+	if m != nil {
+		return m.Convergence
+	}
+	return ""
+}
+
+func (m *ConvergenceSafetyValveState) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
+	}
+	return false
+}
+
+func (m *ConvergenceSafetyValveState) GetMissingCellIds() []string {
+	if m != nil {
+		return m.MissingCellIds
+	}
+	return nil
+}
+
+func (m *ConvergenceSafetyValveState) GetMissingCellPercent() int32 {
+	if m != nil {
+		return m.MissingCellPercent
+	}
+	return 0
+}
+
+func (m *ConvergenceSafetyValveState) GetTrippedPasses() int32 {
+	if m != nil {
+		return m.TrippedPasses
+	}
+	return 0
+}
+
+func (m *ConvergenceSafetyValveState) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
+	}
+	return false
+}
+
+func (m *ConvergenceSafetyValveState) GetAcceptedCellCount() int32 {
+	if m != nil {
+		return m.AcceptedCellCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ConvergenceSafetyValveResponse)(nil), "models.ConvergenceSafetyValveResponse")
+	proto.RegisterType((*ConvergenceSafetyValveStatus)(nil), "models.ConvergenceSafetyValveStatus")
+	proto.RegisterType((*ConvergenceSafetyValveState)(nil), "models.ConvergenceSafetyValveState")
+}
+
+func init() { proto.RegisterFile("convergence_safety_valve.proto", fileDescriptor_cde64fb036f295d5) }
+
+var fileDescriptor_cde64fb036f295d5 = []byte{
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0xcf, 0x58, 0xdb, 0x75, 0xa7, 0xb4, 0xea, 0xa8, 0x18, 0xd6, 0x65, 0xb2, 0x74, 0x15, 0x7a,
+	0x31, 0x8b, 0xab, 0x17, 0xd1, 0x53, 0x8a, 0x88, 0x82, 0xb0, 0xcc, 0xa2, 0xd7, 0x90, 0x26, 0xaf,
+	0x31, 0x90, 0x64, 0xc2, 0x4c, 0x5a, 0xd6, 0x9b, 0xe0, 0x17, 0xf0, 0x2b, 0x78, 0xf3, 0xa3, 0x78,
+	0xec, 0x71, 0x4f, 0xc1, 0xa6, 0x17, 0x09, 0x08, 0xfb, 0x11, 0xa4, 0x93, 0x84, 0x66, 0x25, 0xae,
+	0xb7, 0xf7, 0xfb, 0xf3, 0xf2, 0xde, 0xfb, 0x0d, 0xc1, 0xd4, 0xe5, 0xf1, 0x02, 0x84, 0x0f, 0xb1,
+	0x0b, 0xb6, 0x74, 0x66, 0x90, 0x7e, 0xb2, 0x17, 0x4e, 0xb8, 0x00, 0x33, 0x11, 0x3c, 0xe5, 0xa4,
+	0x17, 0x71, 0x0f, 0x42, 0xb9, 0xf7, 0xd8, 0x0f, 0xd2, 0x8f, 0xf3, 0xa9, 0xe9, 0xf2, 0xe8, 0xc8,
+	0xe7, 0x3e, 0x3f, 0x52, 0xf2, 0x74, 0x3e, 0x53, 0x48, 0x01, 0x55, 0x95, 0x6d, 0x7b, 0x7d, 0x10,
+	0x82, 0x8b, 0x12, 0x8c, 0xbe, 0x20, 0x4c, 0x27, 0xdb, 0x31, 0xa7, 0x6a, 0xca, 0x87, 0xcd, 0x10,
+	0x06, 0x32, 0xe1, 0xb1, 0x04, 0x72, 0x88, 0xbb, 0xaa, 0x43, 0x47, 0x07, 0x68, 0xdc, 0x3f, 0x1e,
+	0x98, 0xe5, 0x58, 0xf3, 0xd5, 0x86, 0x64, 0xa5, 0x46, 0x5e, 0xe2, 0x9e, 0x4c, 0x9d, 0x74, 0x2e,
+	0xf5, 0x6b, 0xca, 0xf5, 0xb0, 0x76, 0xb5, 0x7f, 0xfc, 0x54, 0x79, 0x59, 0xd5, 0x33, 0xfa, 0x8d,
+	0xf0, 0xfe, 0x55, 0x46, 0xf2, 0x1e, 0xeb, 0x91, 0x73, 0x66, 0x47, 0x81, 0x94, 0x41, 0xec, 0xdb,
+	0x2e, 0x84, 0xa1, 0x9d, 0x80, 0x70, 0x21, 0x4e, 0xd5, 0x5a, 0x5d, 0x6b, 0xbf, 0xc8, 0x8c, 0x7f,
+	0x7a, 0xd8, 0xbd, 0xc8, 0x39, 0x7b, 0x57, 0x0a, 0x13, 0x08, 0xc3, 0x93, 0x92, 0x26, 0xcf, 0xf1,
+	0xd0, 0xe5, 0xf1, 0x2c, 0x10, 0x91, 0x9d, 0x38, 0x52, 0x42, 0xb9, 0x7d, 0xd7, 0x22, 0x45, 0x66,
+	0xfc, 0xa5, 0xb0, 0x41, 0x85, 0x4f, 0x14, 0x24, 0x2f, 0x70, 0x4f, 0xbd, 0x85, 0xd4, 0x3b, 0x07,
+	0x9d, 0x71, 0xff, 0xf8, 0xf0, 0xff, 0x07, 0x03, 0xab, 0x5a, 0x46, 0xdf, 0x3a, 0xf8, 0xc1, 0x15,
+	0x3e, 0xf2, 0x04, 0xf7, 0x1b, 0x6f, 0xaf, 0x2e, 0xdc, 0xb5, 0x6e, 0x16, 0x99, 0xd1, 0xa4, 0x59,
+	0x13, 0x90, 0x47, 0x78, 0x27, 0x15, 0x41, 0x92, 0x80, 0xa7, 0x6e, 0xb8, 0x61, 0xf5, 0x8b, 0xcc,
+	0xa8, 0x29, 0x56, 0x17, 0x64, 0x8c, 0x6f, 0x5d, 0x0a, 0x28, 0xf0, 0xca, 0x03, 0x76, 0xd9, 0x30,
+	0xda, 0xe6, 0xf3, 0xc6, 0x93, 0xe4, 0x2d, 0xbe, 0xdb, 0x1a, 0xf7, 0x75, 0x95, 0x90, 0x5e, 0x64,
+	0x46, 0xab, 0xce, 0x48, 0xd4, 0x9a, 0x73, 0xb5, 0x40, 0x9d, 0x73, 0x77, 0x9b, 0xf3, 0x65, 0x85,
+	0x0d, 0x2a, 0x5c, 0xe5, 0x6c, 0x62, 0xcc, 0x17, 0x20, 0x44, 0xe0, 0x79, 0x10, 0xeb, 0x3d, 0x75,
+	0xda, 0xb0, 0xc8, 0x8c, 0x06, 0xcb, 0x1a, 0x35, 0x79, 0x8d, 0xef, 0x38, 0xae, 0x0b, 0x49, 0x0a,
+	0x5e, 0xb9, 0x97, 0xcb, 0xe7, 0x71, 0xaa, 0xef, 0xa8, 0x79, 0xf7, 0x8b, 0xcc, 0x68, 0x93, 0xd9,
+	0xed, 0x9a, 0xdc, 0x6c, 0x3d, 0xd9, 0x50, 0xd6, 0xb3, 0xe5, 0x8a, 0x6a, 0xe7, 0x2b, 0xaa, 0x5d,
+	0xac, 0x28, 0xfa, 0x9c, 0x53, 0xf4, 0x3d, 0xa7, 0xda, 0x8f, 0x9c, 0xa2, 0x65, 0x4e, 0xd1, 0xcf,
+	0x9c, 0xa2, 0x5f, 0x39, 0xd5, 0x2e, 0x72, 0x8a, 0xbe, 0xae, 0xa9, 0xb6, 0x5c, 0x53, 0xed, 0x7c,
+	0x4d, 0xb5, 0x69, 0x4f, 0xfd, 0x56, 0x4f, 0xff, 0x0c, 0x00, 0x65, 0x59, 0x2d, 0xab, 0xbc, 0x03,
+	0x00, 0x00,
+}
+
+func (this *ConvergenceSafetyValveResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ConvergenceSafetyValveResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Status != nil {
+		s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvergenceSafetyValveStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ConvergenceSafetyValveStatus{")
+	s = append(s, "MaxMissingCellPercent: "+fmt.Sprintf("%#v", this.MaxMissingCellPercent)+",\n")
+	s = append(s, "ConfirmPasses: "+fmt.Sprintf("%#v", this.ConfirmPasses)+",\n")
+	if this.Valves != nil {
+		s = append(s, "Valves: "+fmt.Sprintf("%#v", this.Valves)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ConvergenceSafetyValveState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.ConvergenceSafetyValveState{")
+	s = append(s, "Convergence: "+fmt.Sprintf("%#v", this.Convergence)+",\n")
+	s = append(s, "Tripped: "+fmt.Sprintf("%#v", this.Tripped)+",\n")
+	s = append(s, "MissingCellIds: "+fmt.Sprintf("%#v", this.MissingCellIds)+",\n")
+	s = append(s, "MissingCellPercent: "+fmt.Sprintf("%#v", this.MissingCellPercent)+",\n")
+	s = append(s, "TrippedPasses: "+fmt.Sprintf("%#v", this.TrippedPasses)+",\n")
+	s = append(s, "Overridden: "+fmt.Sprintf("%#v", this.Overridden)+",\n")
+	s = append(s, "AcceptedCellCount: "+fmt.Sprintf("%#v", this.AcceptedCellCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringConvergenceSafetyValve(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ConvergenceSafetyValveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvergenceSafetyValveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvergenceSafetyValveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size := m.Status.Size()
+			i -= size
+			if _, err := m.Status.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvergenceSafetyValveStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvergenceSafetyValveStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvergenceSafetyValveStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valves) > 0 {
+		for iNdEx := len(m.Valves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Valves[iNdEx].Size()
+				i -= size
+				if _, err := m.Valves[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ConfirmPasses != 0 {
+		i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(m.ConfirmPasses))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMissingCellPercent != 0 {
+		i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(m.MaxMissingCellPercent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConvergenceSafetyValveState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvergenceSafetyValveState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvergenceSafetyValveState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcceptedCellCount != 0 {
+		i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(m.AcceptedCellCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TrippedPasses != 0 {
+		i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(m.TrippedPasses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissingCellPercent != 0 {
+		i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(m.MissingCellPercent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MissingCellIds) > 0 {
+		for iNdEx := len(m.MissingCellIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingCellIds[iNdEx])
+			copy(dAtA[i:], m.MissingCellIds[iNdEx])
+			i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(len(m.MissingCellIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Convergence) > 0 {
+		i -= len(m.Convergence)
+		copy(dAtA[i:], m.Convergence)
+		i = encodeVarintConvergenceSafetyValve(dAtA, i, uint64(len(m.Convergence)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConvergenceSafetyValve(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConvergenceSafetyValve(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConvergenceSafetyValveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovConvergenceSafetyValve(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovConvergenceSafetyValve(uint64(l))
+	}
+	return n
+}
+
+func (m *ConvergenceSafetyValveStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMissingCellPercent != 0 {
+		n += 1 + sovConvergenceSafetyValve(uint64(m.MaxMissingCellPercent))
+	}
+	if m.ConfirmPasses != 0 {
+		n += 1 + sovConvergenceSafetyValve(uint64(m.ConfirmPasses))
+	}
+	if len(m.Valves) > 0 {
+		for _, e := range m.Valves {
+			l = e.Size()
+			n += 1 + l + sovConvergenceSafetyValve(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConvergenceSafetyValveState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Convergence)
+	if l > 0 {
+		n += 1 + l + sovConvergenceSafetyValve(uint64(l))
+	}
+	if m.Tripped {
+		n += 2
+	}
+	if len(m.MissingCellIds) > 0 {
+		for _, s := range m.MissingCellIds {
+			l = len(s)
+			n += 1 + l + sovConvergenceSafetyValve(uint64(l))
+		}
+	}
+	if m.MissingCellPercent != 0 {
+		n += 1 + sovConvergenceSafetyValve(uint64(m.MissingCellPercent))
+	}
+	if m.TrippedPasses != 0 {
+		n += 1 + sovConvergenceSafetyValve(uint64(m.TrippedPasses))
+	}
+	if m.Overridden {
+		n += 2
+	}
+	if m.AcceptedCellCount != 0 {
+		n += 1 + sovConvergenceSafetyValve(uint64(m.AcceptedCellCount))
+	}
+	return n
+}
+
+func sovConvergenceSafetyValve(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConvergenceSafetyValve(x uint64) (n int) {
+	return sovConvergenceSafetyValve(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ConvergenceSafetyValveResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergenceSafetyValveResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "ConvergenceSafetyValveStatus", "ConvergenceSafetyValveStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvergenceSafetyValveStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForValves := "[]*ConvergenceSafetyValveState{"
+	for _, f := range this.Valves {
+		repeatedStringForValves += strings.Replace(f.String(), "ConvergenceSafetyValveState", "ConvergenceSafetyValveState", 1) + ","
+	}
+	repeatedStringForValves += "}"
+	s := strings.Join([]string{`&ConvergenceSafetyValveStatus{`,
+		`MaxMissingCellPercent:` + fmt.Sprintf("%v", this.MaxMissingCellPercent) + `,`,
+		`ConfirmPasses:` + fmt.Sprintf("%v", this.ConfirmPasses) + `,`,
+		`Valves:` + repeatedStringForValves + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConvergenceSafetyValveState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConvergenceSafetyValveState{`,
+		`Convergence:` + fmt.Sprintf("%v", this.Convergence) + `,`,
+		`Tripped:` + fmt.Sprintf("%v", this.Tripped) + `,`,
+		`MissingCellIds:` + fmt.Sprintf("%v", this.MissingCellIds) + `,`,
+		`MissingCellPercent:` + fmt.Sprintf("%v", this.MissingCellPercent) + `,`,
+		`TrippedPasses:` + fmt.Sprintf("%v", this.TrippedPasses) + `,`,
+		`Overridden:` + fmt.Sprintf("%v", this.Overridden) + `,`,
+		`AcceptedCellCount:` + fmt.Sprintf("%v", this.AcceptedCellCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringConvergenceSafetyValve(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ConvergenceSafetyValveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergenceSafetyValve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergenceSafetyValveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergenceSafetyValveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ConvergenceSafetyValveStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergenceSafetyValve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvergenceSafetyValveStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergenceSafetyValve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergenceSafetyValveStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergenceSafetyValveStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissingCellPercent", wireType)
+			}
+			m.MaxMissingCellPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissingCellPercent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmPasses", wireType)
+			}
+			m.ConfirmPasses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmPasses |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valves = append(m.Valves, &ConvergenceSafetyValveState{})
+			if err := m.Valves[len(m.Valves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergenceSafetyValve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConvergenceSafetyValveState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConvergenceSafetyValve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvergenceSafetyValveState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvergenceSafetyValveState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Convergence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Convergence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingCellIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingCellIds = append(m.MissingCellIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingCellPercent", wireType)
+			}
+			m.MissingCellPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingCellPercent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedPasses", wireType)
+			}
+			m.TrippedPasses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedPasses |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overridden = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCellCount", wireType)
+			}
+			m.AcceptedCellCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptedCellCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConvergenceSafetyValve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConvergenceSafetyValve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConvergenceSafetyValve(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConvergenceSafetyValve
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConvergenceSafetyValve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConvergenceSafetyValve
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConvergenceSafetyValve
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConvergenceSafetyValve
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConvergenceSafetyValve        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConvergenceSafetyValve          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConvergenceSafetyValve = fmt.Errorf("proto: unexpected end of group")
+)
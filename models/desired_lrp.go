@@ -21,6 +21,7 @@ type DesiredLRPChange struct {
 
 type DesiredLRPFilter struct {
 	Domain       string
+	Domains      []string
 	ProcessGuids []string
 }
 
@@ -78,6 +79,7 @@ func NewDesiredLRP(schedInfo DesiredLRPSchedulingInfo, runInfo DesiredLRPRunInfo
 		ImageLayers:                   runInfo.ImageLayers,
 		MetricTags:                    runInfo.MetricTags,
 		Sidecars:                      runInfo.Sidecars,
+		DisruptionBudget:              schedInfo.DisruptionBudget,
 	}
 }
 
@@ -226,7 +228,7 @@ func (d *DesiredLRP) DesiredLRPSchedulingInfo() DesiredLRPSchedulingInfo {
 		volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
 	}
 
-	return NewDesiredLRPSchedulingInfo(
+	schedulingInfo := NewDesiredLRPSchedulingInfo(
 		d.DesiredLRPKey(),
 		d.Annotation,
 		d.Instances,
@@ -236,6 +238,10 @@ func (d *DesiredLRP) DesiredLRPSchedulingInfo() DesiredLRPSchedulingInfo {
 		&volumePlacement,
 		d.PlacementTags,
 	)
+	if d.DisruptionBudget.IsSet() {
+		schedulingInfo.DisruptionBudget = d.DisruptionBudget
+	}
+	return schedulingInfo
 }
 
 func (d *DesiredLRP) DesiredLRPRunInfo(createdAt time.Time) DesiredLRPRunInfo {
@@ -323,6 +329,10 @@ func (desired DesiredLRP) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"max_pids"})
 	}
 
+	if err := desired.DisruptionBudget.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
 	totalRoutesLength := 0
 	if desired.Routes != nil {
 		for _, value := range *desired.Routes {
@@ -364,6 +374,10 @@ func (desired *DesiredLRPUpdate) Validate() error {
 		}
 	}
 
+	if err := desired.DisruptionBudget.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
 	return validationError.ToError()
 }
 
@@ -390,9 +404,10 @@ func (desired DesiredLRPUpdate) AnnotationExists() bool {
 }
 
 type internalDesiredLRPUpdate struct {
-	Instances  *int32  `json:"instances,omitempty"`
-	Routes     *Routes `json:"routes,omitempty"`
-	Annotation *string `json:"annotation,omitempty"`
+	Instances        *int32            `json:"instances,omitempty"`
+	Routes           *Routes           `json:"routes,omitempty"`
+	Annotation       *string           `json:"annotation,omitempty"`
+	DisruptionBudget *DisruptionBudget `json:"disruption_budget,omitempty"`
 }
 
 func (desired *DesiredLRPUpdate) UnmarshalJSON(data []byte) error {
@@ -408,6 +423,7 @@ func (desired *DesiredLRPUpdate) UnmarshalJSON(data []byte) error {
 	if update.Annotation != nil {
 		desired.SetAnnotation(*update.Annotation)
 	}
+	desired.DisruptionBudget = update.DisruptionBudget
 
 	return nil
 }
@@ -423,6 +439,7 @@ func (desired DesiredLRPUpdate) MarshalJSON() ([]byte, error) {
 		a := desired.GetAnnotation()
 		update.Annotation = &a
 	}
+	update.DisruptionBudget = desired.DisruptionBudget
 	return json.Marshal(update)
 }
 
@@ -479,6 +496,13 @@ func (s *DesiredLRPSchedulingInfo) ApplyUpdate(update *DesiredLRPUpdate) {
 	if update.AnnotationExists() {
 		s.Annotation = update.GetAnnotation()
 	}
+	if update.DisruptionBudget != nil {
+		// an empty budget removes the budget
+		s.DisruptionBudget = nil
+		if update.DisruptionBudget.IsSet() {
+			s.DisruptionBudget = update.DisruptionBudget
+		}
+	}
 	s.ModificationTag.Increment()
 }
 
@@ -499,6 +523,10 @@ func (s DesiredLRPSchedulingInfo) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"annotation"})
 	}
 
+	if err := s.DisruptionBudget.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
 	return validationError.ToError()
 }
 
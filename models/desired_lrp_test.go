@@ -446,6 +446,20 @@ var _ = Describe("DesiredLRP", func() {
 			schedulingInfo.ApplyUpdate(update)
 			Expect(schedulingInfo).To(Equal(expectedSchedulingInfo))
 		})
+
+		It("updates the disruption budget", func() {
+			update := &models.DesiredLRPUpdate{
+				DisruptionBudget: &models.DisruptionBudget{MaxUnavailable: 1},
+			}
+
+			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+			schedulingInfo.ApplyUpdate(update)
+			Expect(schedulingInfo.DisruptionBudget).To(Equal(&models.DisruptionBudget{MaxUnavailable: 1}))
+
+			update.DisruptionBudget = &models.DisruptionBudget{}
+			schedulingInfo.ApplyUpdate(update)
+			Expect(schedulingInfo.DisruptionBudget).To(BeNil())
+		})
 	})
 
 	Describe("VersionDownTo", func() {
@@ -1248,6 +1262,11 @@ var _ = Describe("DesiredLRPUpdate", func() {
 			desiredLRPUpdate.SetAnnotation(largeString)
 			assertDesiredLRPValidationFailsWithMessage(desiredLRPUpdate, "annotation")
 		})
+
+		It("validates the disruption budget", func() {
+			update := models.DesiredLRPUpdate{DisruptionBudget: &models.DisruptionBudget{MinAvailable: -1}}
+			assertDesiredLRPValidationFailsWithMessage(update, "min_available")
+		})
 	})
 
 	Describe("serialization", func() {
@@ -1269,6 +1288,16 @@ var _ = Describe("DesiredLRPUpdate", func() {
 			Expect(json.Unmarshal([]byte(expectedJSON), &testV)).To(Succeed())
 			Expect(testV).To(Equal(desiredLRPUpdate))
 		})
+
+		It("includes the disruption budget", func() {
+			update := models.DesiredLRPUpdate{DisruptionBudget: &models.DisruptionBudget{MinAvailable: 2}}
+			updateJSON := `{"disruption_budget": {"min_available": 2}}`
+			Expect(json.Marshal(update)).To(MatchJSON(updateJSON))
+
+			var testV models.DesiredLRPUpdate
+			Expect(json.Unmarshal([]byte(updateJSON), &testV)).To(Succeed())
+			Expect(testV).To(Equal(update))
+		})
 	})
 })
 
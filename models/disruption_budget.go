@@ -0,0 +1,129 @@
+package models
+
+import "sort"
+
+// IsSet reports whether the budget limits disruptions. An empty budget is the
+// same as no budget.
+func (b *DisruptionBudget) IsSet() bool {
+	return b != nil && (b.MinAvailable > 0 || b.MaxUnavailable > 0)
+}
+
+func (b *DisruptionBudget) Validate() error {
+	if b == nil {
+		return nil
+	}
+
+	var validationError ValidationError
+
+	if b.MinAvailable < 0 {
+		validationError = validationError.Append(ErrInvalidField{"min_available"})
+	}
+
+	if b.MaxUnavailable < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_unavailable"})
+	}
+
+	if b.MinAvailable > 0 && b.MaxUnavailable > 0 {
+		validationError = validationError.Append(ErrInvalidField{"disruption_budget"})
+	}
+
+	return validationError.ToError()
+}
+
+// AllowedDisruptions returns how many of the available instances can be
+// disrupted voluntarily. Without a budget, all of them can.
+func (b *DisruptionBudget) AllowedDisruptions(instances, available int32) int32 {
+	if !b.IsSet() {
+		return available
+	}
+
+	var allowed int32
+	if b.MinAvailable > 0 {
+		allowed = available - b.MinAvailable
+	} else {
+		allowed = b.MaxUnavailable - (instances - available)
+	}
+
+	if allowed < 0 {
+		return 0
+	}
+	return allowed
+}
+
+// AvailableInstances counts the desired indices of an LRP that have a RUNNING
+// ordinary or evacuating instance. An index with a suspect instance is being
+// replaced and does not count.
+func AvailableInstances(lrps []*ActualLRP, instances int32) int32 {
+	running := map[int32]bool{}
+	replaced := map[int32]bool{}
+	for _, lrp := range lrps {
+		if lrp.Index >= instances {
+			continue
+		}
+		switch {
+		case lrp.Presence == ActualLRP_Suspect:
+			replaced[lrp.Index] = true
+		case lrp.State == ActualLRPStateRunning:
+			running[lrp.Index] = true
+		}
+	}
+
+	var available int32
+	for index := range running {
+		if !replaced[index] {
+			available++
+		}
+	}
+	return available
+}
+
+// DrainMoves picks the desired indices of an LRP whose RUNNING ordinary
+// instances on draining cells are replaced now, lowest index first. Without a
+// budget one index moves at a time; with a budget, as many as it allows, and
+// the indices beyond that are delayed.
+func DrainMoves(lrps []*ActualLRP, instances int32, budget *DisruptionBudget, draining func(cellID string) bool) (moves, delayed []int32) {
+	replaced := map[int32]bool{}
+	for _, lrp := range lrps {
+		if lrp.Presence == ActualLRP_Suspect {
+			replaced[lrp.Index] = true
+		}
+	}
+
+	candidates := []int32{}
+	for _, lrp := range lrps {
+		if lrp.Presence != ActualLRP_Ordinary || lrp.State != ActualLRPStateRunning || lrp.Index >= instances {
+			continue
+		}
+		if replaced[lrp.Index] || !draining(lrp.CellId) {
+			continue
+		}
+		candidates = append(candidates, lrp.Index)
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	var allowed int32
+	switch {
+	case budget.IsSet():
+		allowed = budget.AllowedDisruptions(instances, AvailableInstances(lrps, instances))
+	case len(replaced) == 0:
+		allowed = 1
+	}
+
+	if int(allowed) >= len(candidates) {
+		return candidates, nil
+	}
+	if !budget.IsSet() {
+		return candidates[:allowed], nil
+	}
+	return candidates[:allowed], candidates[allowed:]
+}
+
+func (request *DisruptionBudgetStatusRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	return validationError.ToError()
+}
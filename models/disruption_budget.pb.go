@@ -0,0 +1,1513 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: disruption_budget.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type DisruptionBudget struct {
+	MinAvailable   int32 `protobuf:"varint,1,opt,name=min_available,json=minAvailable,proto3" json:"min_available,omitempty"`
+	MaxUnavailable int32 `protobuf:"varint,2,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable,omitempty"`
+}
+
+func (m *DisruptionBudget) Reset()      { *m = DisruptionBudget{} }
+func (*DisruptionBudget) ProtoMessage() {}
+func (*DisruptionBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f347b7244ee2dbb, []int{0}
+}
+func (m *DisruptionBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisruptionBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisruptionBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisruptionBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisruptionBudget.Merge(m, src)
+}
+func (m *DisruptionBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisruptionBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisruptionBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisruptionBudget proto.InternalMessageInfo
+
+func (m *DisruptionBudget) GetMinAvailable() int32 {
+	if m != nil {
+		return m.MinAvailable
+	}
+	return 0
+}
+
+func (m *DisruptionBudget) GetMaxUnavailable() int32 {
+	if m != nil {
+		return m.MaxUnavailable
+	}
+	return 0
+}
+
+type DisruptionBudgetStatus struct {
+	ProcessGuid            string            `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Budget                 *DisruptionBudget `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+	DesiredInstances       int32             `protobuf:"varint,3,opt,name=desired_instances,json=desiredInstances,proto3" json:"desired_instances"`
+	AvailableInstances     int32             `protobuf:"varint,4,opt,name=available_instances,json=availableInstances,proto3" json:"available_instances"`
+	AllowedDisruptions     int32             `protobuf:"varint,5,opt,name=allowed_disruptions,json=allowedDisruptions,proto3" json:"allowed_disruptions"`
+	DelayedDrainingIndices []int32           `protobuf:"varint,6,rep,packed,name=delayed_draining_indices,json=delayedDrainingIndices,proto3" json:"delayed_draining_indices,omitempty"`
+	ReservedInstanceGuids  []string          `protobuf:"bytes,7,rep,name=reserved_instance_guids,json=reservedInstanceGuids,proto3" json:"reserved_instance_guids,omitempty"`
+}
+
+func (m *DisruptionBudgetStatus) Reset()      { *m = DisruptionBudgetStatus{} }
+func (*DisruptionBudgetStatus) ProtoMessage() {}
+func (*DisruptionBudgetStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f347b7244ee2dbb, []int{1}
+}
+func (m *DisruptionBudgetStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisruptionBudgetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisruptionBudgetStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisruptionBudgetStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisruptionBudgetStatus.Merge(m, src)
+}
+func (m *DisruptionBudgetStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisruptionBudgetStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisruptionBudgetStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisruptionBudgetStatus proto.InternalMessageInfo
+
+func (m *DisruptionBudgetStatus) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *DisruptionBudgetStatus) GetBudget() *DisruptionBudget {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+func (m *DisruptionBudgetStatus) GetDesiredInstances() int32 {
+	if m != nil {
+		return m.DesiredInstances
+	}
+	return 0
+}
+
+func (m *DisruptionBudgetStatus) GetAvailableInstances() int32 {
+	if m != nil {
+		return m.AvailableInstances
+	}
+	return 0
+}
+
+func (m *DisruptionBudgetStatus) GetAllowedDisruptions() int32 {
+	if m != nil {
+		return m.AllowedDisruptions
+	}
+	return 0
+}
+
+func (m *DisruptionBudgetStatus) GetDelayedDrainingIndices() []int32 {
+	if m != nil {
+		return m.DelayedDrainingIndices
+	}
+	return nil
+}
+
+func (m *DisruptionBudgetStatus) GetReservedInstanceGuids() []string {
+	if m != nil {
+		return m.ReservedInstanceGuids
+	}
+	return nil
+}
+
+type DisruptionBudgetStatusRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+}
+
+func (m *DisruptionBudgetStatusRequest) Reset()      { *m = DisruptionBudgetStatusRequest{} }
+func (*DisruptionBudgetStatusRequest) ProtoMessage() {}
+func (*DisruptionBudgetStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f347b7244ee2dbb, []int{2}
+}
+func (m *DisruptionBudgetStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisruptionBudgetStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisruptionBudgetStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisruptionBudgetStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisruptionBudgetStatusRequest.Merge(m, src)
+}
+func (m *DisruptionBudgetStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisruptionBudgetStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisruptionBudgetStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisruptionBudgetStatusRequest proto.InternalMessageInfo
+
+func (m *DisruptionBudgetStatusRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+type DisruptionBudgetStatusResponse struct {
+	Error  *Error                  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Status *DisruptionBudgetStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *DisruptionBudgetStatusResponse) Reset()      { *m = DisruptionBudgetStatusResponse{} }
+func (*DisruptionBudgetStatusResponse) ProtoMessage() {}
+func (*DisruptionBudgetStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f347b7244ee2dbb, []int{3}
+}
+func (m *DisruptionBudgetStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisruptionBudgetStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisruptionBudgetStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisruptionBudgetStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisruptionBudgetStatusResponse.Merge(m, src)
+}
+func (m *DisruptionBudgetStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisruptionBudgetStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisruptionBudgetStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisruptionBudgetStatusResponse proto.InternalMessageInfo
+
+func (m *DisruptionBudgetStatusResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DisruptionBudgetStatusResponse) GetStatus() *DisruptionBudgetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DisruptionBudget)(nil), "models.DisruptionBudget")
+	proto.RegisterType((*DisruptionBudgetStatus)(nil), "models.DisruptionBudgetStatus")
+	proto.RegisterType((*DisruptionBudgetStatusRequest)(nil), "models.DisruptionBudgetStatusRequest")
+	proto.RegisterType((*DisruptionBudgetStatusResponse)(nil), "models.DisruptionBudgetStatusResponse")
+}
+
+func init() { proto.RegisterFile("disruption_budget.proto", fileDescriptor_9f347b7244ee2dbb) }
+
+var fileDescriptor_9f347b7244ee2dbb = []byte{
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x13, 0xba, 0x16, 0xcd, 0xdd, 0xa0, 0x18, 0x6d, 0x0d, 0x43, 0x73, 0x4a, 0x11, 0xa8,
+	0x07, 0xe8, 0xd0, 0x86, 0x38, 0x43, 0x34, 0xfe, 0xec, 0x1a, 0xe0, 0x88, 0x42, 0x5a, 0x9b, 0x60,
+	0x29, 0xb1, 0x8b, 0xed, 0x8c, 0xed, 0x80, 0xc4, 0x47, 0xe0, 0xc8, 0x81, 0x0f, 0xc0, 0x47, 0xe1,
+	0xd8, 0xe3, 0x4e, 0x11, 0x4d, 0x2f, 0x28, 0xa7, 0x7d, 0x04, 0x54, 0x27, 0x6d, 0xd3, 0x6e, 0xbd,
+	0xec, 0xd6, 0xf7, 0x79, 0x9e, 0xfc, 0xfa, 0xfa, 0xf5, 0x6b, 0xd0, 0xc4, 0x54, 0x8a, 0x78, 0xa0,
+	0x28, 0x67, 0x5e, 0x2f, 0xc6, 0x01, 0x51, 0xdd, 0x81, 0xe0, 0x8a, 0xc3, 0x5a, 0xc4, 0x31, 0x09,
+	0xe5, 0xce, 0xe3, 0x80, 0xaa, 0xcf, 0x71, 0xaf, 0xdb, 0xe7, 0xd1, 0x5e, 0xc0, 0x03, 0xbe, 0xa7,
+	0xed, 0x5e, 0xfc, 0x49, 0x57, 0xba, 0xd0, 0xbf, 0xf2, 0xcf, 0x76, 0xea, 0x44, 0x08, 0x2e, 0xf2,
+	0xa2, 0xfd, 0xcb, 0x04, 0x8d, 0xc3, 0x19, 0xdf, 0xd1, 0x78, 0xf8, 0x1c, 0x6c, 0x46, 0x94, 0x79,
+	0xfe, 0xb1, 0x4f, 0x43, 0xbf, 0x17, 0x12, 0xcb, 0x6c, 0x99, 0x9d, 0xaa, 0x73, 0x37, 0x4b, 0xec,
+	0xe6, 0x82, 0xf1, 0x88, 0x47, 0x54, 0x91, 0x68, 0xa0, 0x4e, 0xdd, 0x8d, 0x88, 0xb2, 0x17, 0x53,
+	0x1d, 0xbe, 0x02, 0x37, 0x23, 0xff, 0xc4, 0x8b, 0xd9, 0x9c, 0x71, 0x4d, 0x33, 0x76, 0xb3, 0xc4,
+	0xbe, 0xb3, 0x64, 0x95, 0x28, 0x37, 0x22, 0xff, 0xe4, 0xfd, 0xdc, 0x69, 0xff, 0x5c, 0x03, 0xdb,
+	0xcb, 0xed, 0xbd, 0x55, 0xbe, 0x8a, 0x25, 0x3c, 0x00, 0x1b, 0x03, 0xc1, 0xfb, 0x44, 0x4a, 0x2f,
+	0x88, 0x29, 0xd6, 0x3d, 0xae, 0x3b, 0x8d, 0x2c, 0xb1, 0x17, 0x74, 0xb7, 0x5e, 0x54, 0xaf, 0x63,
+	0x8a, 0xe1, 0x13, 0x50, 0xcb, 0x47, 0xa8, 0xdb, 0xa9, 0xef, 0x5b, 0xdd, 0x7c, 0x86, 0xdd, 0xe5,
+	0x3f, 0x71, 0x8b, 0x1c, 0x74, 0xc0, 0x2d, 0x4c, 0x24, 0x15, 0x04, 0x7b, 0x94, 0x49, 0xe5, 0xb3,
+	0x3e, 0x91, 0x56, 0x45, 0x9f, 0x65, 0x2b, 0x4b, 0xec, 0x8b, 0xa6, 0xdb, 0x28, 0xa4, 0xa3, 0xa9,
+	0x02, 0xdf, 0x80, 0xdb, 0xb3, 0x23, 0x95, 0x28, 0x6b, 0x9a, 0xd2, 0xcc, 0x12, 0xfb, 0x32, 0xdb,
+	0x85, 0x33, 0x71, 0x91, 0x14, 0x86, 0xfc, 0x2b, 0xc1, 0xde, 0x7c, 0x2b, 0xa4, 0x55, 0x2d, 0x91,
+	0x2e, 0xda, 0x2e, 0x2c, 0xc4, 0xf9, 0x21, 0x25, 0xfc, 0x08, 0x2c, 0x4c, 0x42, 0xff, 0x74, 0x12,
+	0x15, 0x3e, 0x65, 0x94, 0x05, 0x1e, 0x65, 0x98, 0x4e, 0x1a, 0xab, 0xb5, 0x2a, 0x9d, 0xaa, 0xf3,
+	0x30, 0x4b, 0xec, 0xf6, 0xaa, 0x4c, 0xe9, 0xce, 0xb6, 0x8b, 0xcc, 0x61, 0x11, 0x39, 0xca, 0x13,
+	0xf0, 0x03, 0x68, 0x0a, 0x22, 0x89, 0x38, 0x2e, 0x4d, 0x47, 0x5f, 0x89, 0xb4, 0xae, 0xb7, 0x2a,
+	0x9d, 0x75, 0xe7, 0x41, 0x96, 0xd8, 0xf7, 0x56, 0x44, 0x4a, 0xfc, 0xad, 0x69, 0x64, 0x3a, 0x86,
+	0xc9, 0x4d, 0xca, 0xf6, 0x3b, 0xb0, 0x7b, 0xf9, 0x66, 0xb8, 0xe4, 0x4b, 0x4c, 0xa4, 0xba, 0xd2,
+	0x82, 0xb4, 0xbf, 0x01, 0xb4, 0x8a, 0x2a, 0x07, 0x9c, 0x49, 0x02, 0xef, 0x83, 0xaa, 0x7e, 0x40,
+	0x9a, 0x57, 0xdf, 0xdf, 0x9c, 0x6e, 0xd0, 0xcb, 0x89, 0xe8, 0xe6, 0x1e, 0x7c, 0x06, 0x6a, 0x52,
+	0x7f, 0x56, 0xec, 0x19, 0x5a, 0xb5, 0x67, 0x05, 0xbc, 0x48, 0x3b, 0x4f, 0x87, 0x23, 0x64, 0x9c,
+	0x8d, 0x90, 0x71, 0x3e, 0x42, 0xe6, 0xf7, 0x14, 0x99, 0xbf, 0x53, 0x64, 0xfe, 0x49, 0x91, 0x39,
+	0x4c, 0x91, 0xf9, 0x37, 0x45, 0xe6, 0xbf, 0x14, 0x19, 0xe7, 0x29, 0x32, 0x7f, 0x8c, 0x91, 0x31,
+	0x1c, 0x23, 0xe3, 0x6c, 0x8c, 0x8c, 0x5e, 0x4d, 0xbf, 0xe5, 0x83, 0xff, 0x03, 0x00, 0xfb, 0x75,
+	0x10, 0xdd, 0x2a, 0x04, 0x00, 0x00,
+}
+
+func (this *DisruptionBudget) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DisruptionBudget)
+	if !ok {
+		that2, ok := that.(DisruptionBudget)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinAvailable != that1.MinAvailable {
+		return false
+	}
+	if this.MaxUnavailable != that1.MaxUnavailable {
+		return false
+	}
+	return true
+}
+func (this *DisruptionBudgetStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DisruptionBudgetStatus)
+	if !ok {
+		that2, ok := that.(DisruptionBudgetStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if !this.Budget.Equal(that1.Budget) {
+		return false
+	}
+	if this.DesiredInstances != that1.DesiredInstances {
+		return false
+	}
+	if this.AvailableInstances != that1.AvailableInstances {
+		return false
+	}
+	if this.AllowedDisruptions != that1.AllowedDisruptions {
+		return false
+	}
+	if len(this.DelayedDrainingIndices) != len(that1.DelayedDrainingIndices) {
+		return false
+	}
+	for i := range this.DelayedDrainingIndices {
+		if this.DelayedDrainingIndices[i] != that1.DelayedDrainingIndices[i] {
+			return false
+		}
+	}
+	if len(this.ReservedInstanceGuids) != len(that1.ReservedInstanceGuids) {
+		return false
+	}
+	for i := range this.ReservedInstanceGuids {
+		if this.ReservedInstanceGuids[i] != that1.ReservedInstanceGuids[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DisruptionBudgetStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DisruptionBudgetStatusRequest)
+	if !ok {
+		that2, ok := that.(DisruptionBudgetStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	return true
+}
+func (this *DisruptionBudgetStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DisruptionBudgetStatusResponse)
+	if !ok {
+		that2, ok := that.(DisruptionBudgetStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Status.Equal(that1.Status) {
+		return false
+	}
+	return true
+}
+func (this *DisruptionBudget) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DisruptionBudget{")
+	s = append(s, "MinAvailable: "+fmt.Sprintf("%#v", this.MinAvailable)+",\n")
+	s = append(s, "MaxUnavailable: "+fmt.Sprintf("%#v", this.MaxUnavailable)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DisruptionBudgetStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.DisruptionBudgetStatus{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	if this.Budget != nil {
+		s = append(s, "Budget: "+fmt.Sprintf("%#v", this.Budget)+",\n")
+	}
+	s = append(s, "DesiredInstances: "+fmt.Sprintf("%#v", this.DesiredInstances)+",\n")
+	s = append(s, "AvailableInstances: "+fmt.Sprintf("%#v", this.AvailableInstances)+",\n")
+	s = append(s, "AllowedDisruptions: "+fmt.Sprintf("%#v", this.AllowedDisruptions)+",\n")
+	s = append(s, "DelayedDrainingIndices: "+fmt.Sprintf("%#v", this.DelayedDrainingIndices)+",\n")
+	s = append(s, "ReservedInstanceGuids: "+fmt.Sprintf("%#v", this.ReservedInstanceGuids)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DisruptionBudgetStatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DisruptionBudgetStatusRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DisruptionBudgetStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DisruptionBudgetStatusResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Status != nil {
+		s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDisruptionBudget(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DisruptionBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisruptionBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisruptionBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUnavailable != 0 {
+		i = encodeVarintDisruptionBudget(dAtA, i, uint64(m.MaxUnavailable))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinAvailable != 0 {
+		i = encodeVarintDisruptionBudget(dAtA, i, uint64(m.MinAvailable))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisruptionBudgetStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisruptionBudgetStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisruptionBudgetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReservedInstanceGuids) > 0 {
+		for iNdEx := len(m.ReservedInstanceGuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedInstanceGuids[iNdEx])
+			copy(dAtA[i:], m.ReservedInstanceGuids[iNdEx])
+			i = encodeVarintDisruptionBudget(dAtA, i, uint64(len(m.ReservedInstanceGuids[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelayedDrainingIndices) > 0 {
+		dAtA2 := make([]byte, len(m.DelayedDrainingIndices)*10)
+		var j1 int
+		for _, num1 := range m.DelayedDrainingIndices {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDisruptionBudget(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AllowedDisruptions != 0 {
+		i = encodeVarintDisruptionBudget(dAtA, i, uint64(m.AllowedDisruptions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AvailableInstances != 0 {
+		i = encodeVarintDisruptionBudget(dAtA, i, uint64(m.AvailableInstances))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DesiredInstances != 0 {
+		i = encodeVarintDisruptionBudget(dAtA, i, uint64(m.DesiredInstances))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Budget != nil {
+		{
+			size := m.Budget.Size()
+			i -= size
+			if _, err := m.Budget.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDisruptionBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDisruptionBudget(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisruptionBudgetStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisruptionBudgetStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisruptionBudgetStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDisruptionBudget(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisruptionBudgetStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisruptionBudgetStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisruptionBudgetStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size := m.Status.Size()
+			i -= size
+			if _, err := m.Status.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDisruptionBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDisruptionBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDisruptionBudget(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDisruptionBudget(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DisruptionBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinAvailable != 0 {
+		n += 1 + sovDisruptionBudget(uint64(m.MinAvailable))
+	}
+	if m.MaxUnavailable != 0 {
+		n += 1 + sovDisruptionBudget(uint64(m.MaxUnavailable))
+	}
+	return n
+}
+
+func (m *DisruptionBudgetStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDisruptionBudget(uint64(l))
+	}
+	if m.Budget != nil {
+		l = m.Budget.Size()
+		n += 1 + l + sovDisruptionBudget(uint64(l))
+	}
+	if m.DesiredInstances != 0 {
+		n += 1 + sovDisruptionBudget(uint64(m.DesiredInstances))
+	}
+	if m.AvailableInstances != 0 {
+		n += 1 + sovDisruptionBudget(uint64(m.AvailableInstances))
+	}
+	if m.AllowedDisruptions != 0 {
+		n += 1 + sovDisruptionBudget(uint64(m.AllowedDisruptions))
+	}
+	if len(m.DelayedDrainingIndices) > 0 {
+		l = 0
+		for _, e := range m.DelayedDrainingIndices {
+			l += sovDisruptionBudget(uint64(e))
+		}
+		n += 1 + sovDisruptionBudget(uint64(l)) + l
+	}
+	if len(m.ReservedInstanceGuids) > 0 {
+		for _, s := range m.ReservedInstanceGuids {
+			l = len(s)
+			n += 1 + l + sovDisruptionBudget(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DisruptionBudgetStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDisruptionBudget(uint64(l))
+	}
+	return n
+}
+
+func (m *DisruptionBudgetStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDisruptionBudget(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovDisruptionBudget(uint64(l))
+	}
+	return n
+}
+
+func sovDisruptionBudget(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDisruptionBudget(x uint64) (n int) {
+	return sovDisruptionBudget(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DisruptionBudget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DisruptionBudget{`,
+		`MinAvailable:` + fmt.Sprintf("%v", this.MinAvailable) + `,`,
+		`MaxUnavailable:` + fmt.Sprintf("%v", this.MaxUnavailable) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DisruptionBudgetStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DisruptionBudgetStatus{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Budget:` + strings.Replace(this.Budget.String(), "DisruptionBudget", "DisruptionBudget", 1) + `,`,
+		`DesiredInstances:` + fmt.Sprintf("%v", this.DesiredInstances) + `,`,
+		`AvailableInstances:` + fmt.Sprintf("%v", this.AvailableInstances) + `,`,
+		`AllowedDisruptions:` + fmt.Sprintf("%v", this.AllowedDisruptions) + `,`,
+		`DelayedDrainingIndices:` + fmt.Sprintf("%v", this.DelayedDrainingIndices) + `,`,
+		`ReservedInstanceGuids:` + fmt.Sprintf("%v", this.ReservedInstanceGuids) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DisruptionBudgetStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DisruptionBudgetStatusRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DisruptionBudgetStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DisruptionBudgetStatusResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "DisruptionBudgetStatus", "DisruptionBudgetStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDisruptionBudget(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DisruptionBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDisruptionBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisruptionBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisruptionBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAvailable", wireType)
+			}
+			m.MinAvailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAvailable |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			}
+			m.MaxUnavailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnavailable |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDisruptionBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisruptionBudgetStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDisruptionBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisruptionBudgetStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisruptionBudgetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Budget == nil {
+				m.Budget = &DisruptionBudget{}
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredInstances", wireType)
+			}
+			m.DesiredInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableInstances", wireType)
+			}
+			m.AvailableInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDisruptions", wireType)
+			}
+			m.AllowedDisruptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowedDisruptions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDisruptionBudget
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DelayedDrainingIndices = append(m.DelayedDrainingIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDisruptionBudget
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDisruptionBudget
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDisruptionBudget
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DelayedDrainingIndices) == 0 {
+					m.DelayedDrainingIndices = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDisruptionBudget
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DelayedDrainingIndices = append(m.DelayedDrainingIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedDrainingIndices", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedInstanceGuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedInstanceGuids = append(m.ReservedInstanceGuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDisruptionBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisruptionBudgetStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDisruptionBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisruptionBudgetStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisruptionBudgetStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDisruptionBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisruptionBudgetStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDisruptionBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisruptionBudgetStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisruptionBudgetStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &DisruptionBudgetStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDisruptionBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDisruptionBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDisruptionBudget(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDisruptionBudget
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDisruptionBudget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDisruptionBudget
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDisruptionBudget
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDisruptionBudget
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDisruptionBudget        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDisruptionBudget          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDisruptionBudget = fmt.Errorf("proto: unexpected end of group")
+)
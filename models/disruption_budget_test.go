@@ -0,0 +1,116 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DisruptionBudget", func() {
+	DescribeTable("Validate",
+		func(budget *models.DisruptionBudget, expectedErr string) {
+			err := budget.Validate()
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expectedErr))
+			}
+		},
+		Entry("no budget", nil, ""),
+		Entry("min available", &models.DisruptionBudget{MinAvailable: 2}, ""),
+		Entry("max unavailable", &models.DisruptionBudget{MaxUnavailable: 1}, ""),
+		Entry("negative min available", &models.DisruptionBudget{MinAvailable: -1}, "min_available"),
+		Entry("negative max unavailable", &models.DisruptionBudget{MaxUnavailable: -1}, "max_unavailable"),
+		Entry("both limits", &models.DisruptionBudget{MinAvailable: 2, MaxUnavailable: 1}, "disruption_budget"),
+	)
+
+	DescribeTable("AllowedDisruptions",
+		func(budget *models.DisruptionBudget, instances, available, expected int) {
+			Expect(budget.AllowedDisruptions(int32(instances), int32(available))).To(BeEquivalentTo(expected))
+		},
+		Entry("no budget", nil, 3, 2, 2),
+		Entry("empty budget", &models.DisruptionBudget{}, 3, 2, 2),
+		Entry("min available", &models.DisruptionBudget{MinAvailable: 2}, 5, 4, 2),
+		Entry("min available reached", &models.DisruptionBudget{MinAvailable: 2}, 5, 1, 0),
+		Entry("max unavailable", &models.DisruptionBudget{MaxUnavailable: 2}, 5, 4, 1),
+		Entry("max unavailable reached", &models.DisruptionBudget{MaxUnavailable: 1}, 5, 3, 0),
+	)
+
+	Describe("AvailableInstances", func() {
+		It("counts the desired indices with a running instance that is not being replaced", func() {
+			lrps := []*models.ActualLRP{
+				{ActualLRPKey: models.ActualLRPKey{Index: 0}, State: models.ActualLRPStateRunning, Presence: models.ActualLRP_Ordinary},
+				{ActualLRPKey: models.ActualLRPKey{Index: 0}, State: models.ActualLRPStateRunning, Presence: models.ActualLRP_Evacuating},
+				{ActualLRPKey: models.ActualLRPKey{Index: 1}, State: models.ActualLRPStateUnclaimed, Presence: models.ActualLRP_Ordinary},
+				{ActualLRPKey: models.ActualLRPKey{Index: 1}, State: models.ActualLRPStateRunning, Presence: models.ActualLRP_Suspect},
+				{ActualLRPKey: models.ActualLRPKey{Index: 2}, State: models.ActualLRPStateCrashed, Presence: models.ActualLRP_Ordinary},
+				{ActualLRPKey: models.ActualLRPKey{Index: 3}, State: models.ActualLRPStateRunning, Presence: models.ActualLRP_Ordinary},
+				{ActualLRPKey: models.ActualLRPKey{Index: 4}, State: models.ActualLRPStateRunning, Presence: models.ActualLRP_Ordinary},
+			}
+			Expect(models.AvailableInstances(lrps, 4)).To(BeEquivalentTo(2))
+		})
+	})
+
+	Describe("DrainMoves", func() {
+		var lrps []*models.ActualLRP
+
+		draining := func(cellID string) bool { return cellID == "draining-cell" }
+
+		running := func(index int32, cellID string) *models.ActualLRP {
+			return &models.ActualLRP{
+				ActualLRPKey:         models.ActualLRPKey{Index: index},
+				ActualLRPInstanceKey: models.ActualLRPInstanceKey{CellId: cellID},
+				State:                models.ActualLRPStateRunning,
+				Presence:             models.ActualLRP_Ordinary,
+			}
+		}
+
+		BeforeEach(func() {
+			lrps = []*models.ActualLRP{
+				running(2, "draining-cell"),
+				running(0, "draining-cell"),
+				running(1, "draining-cell"),
+				running(3, "other-cell"),
+			}
+		})
+
+		It("moves one index at a time without a budget", func() {
+			moves, delayed := models.DrainMoves(lrps, 4, nil, draining)
+			Expect(moves).To(Equal([]int32{0}))
+			Expect(delayed).To(BeEmpty())
+		})
+
+		It("moves nothing while an index is being replaced without a budget", func() {
+			suspect := running(0, "draining-cell")
+			suspect.Presence = models.ActualLRP_Suspect
+			lrps[1] = suspect
+
+			moves, delayed := models.DrainMoves(lrps, 4, nil, draining)
+			Expect(moves).To(BeEmpty())
+			Expect(delayed).To(BeEmpty())
+		})
+
+		It("moves as many indices as the budget allows and delays the rest", func() {
+			moves, delayed := models.DrainMoves(lrps, 4, &models.DisruptionBudget{MaxUnavailable: 2}, draining)
+			Expect(moves).To(Equal([]int32{0, 1}))
+			Expect(delayed).To(Equal([]int32{2}))
+		})
+
+		It("counts the indices being replaced against the budget", func() {
+			suspect := running(0, "draining-cell")
+			suspect.Presence = models.ActualLRP_Suspect
+			lrps[1] = suspect
+
+			moves, delayed := models.DrainMoves(lrps, 4, &models.DisruptionBudget{MinAvailable: 3}, draining)
+			Expect(moves).To(BeEmpty())
+			Expect(delayed).To(Equal([]int32{1, 2}))
+		})
+
+		It("does not move indices beyond the desired instances", func() {
+			moves, _ := models.DrainMoves(lrps, 2, &models.DisruptionBudget{MaxUnavailable: 5}, draining)
+			Expect(moves).To(Equal([]int32{0, 1}))
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package models
+
+func (request *RotateEncryptionKeyRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.KeyLabel == "" {
+		return validationError.Append(ErrInvalidField{"key_label"})
+	}
+
+	return nil
+}
+
+func (request *EncryptionKeyUsageRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.KeyLabel == "" {
+		return validationError.Append(ErrInvalidField{"key_label"})
+	}
+
+	return nil
+}
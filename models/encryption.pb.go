@@ -0,0 +1,2268 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: encryption.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type EncryptionStatus_State int32
+
+const (
+	EncryptionIdle      EncryptionStatus_State = 0
+	EncryptionRunning   EncryptionStatus_State = 1
+	EncryptionCompleted EncryptionStatus_State = 2
+	EncryptionFailed    EncryptionStatus_State = 3
+)
+
+var EncryptionStatus_State_name = map[int32]string{
+	0: "Idle",
+	1: "Running",
+	2: "Completed",
+	3: "Failed",
+}
+
+var EncryptionStatus_State_value = map[string]int32{
+	"Idle":      0,
+	"Running":   1,
+	"Completed": 2,
+	"Failed":    3,
+}
+
+func (EncryptionStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8293a649ce9418c6, []int{1, 0}
+}
+
+type EncryptionTableProgress struct {
+	Table     string `protobuf:"bytes,1,opt,name=table,proto3" json:"table"`
+	Total     int32  `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	Processed int32  `protobuf:"varint,3,opt,name=processed,proto3" json:"processed"`
+	Attempts  int32  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts"`
+	Done      bool   `protobuf:"varint,5,opt,name=done,proto3" json:"done"`
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *EncryptionTableProgress) Reset()      { *m = EncryptionTableProgress{} }
+func (*EncryptionTableProgress) ProtoMessage() {}
+func (*EncryptionTableProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293a649ce9418c6, []int{0}
+}
+func (m *EncryptionTableProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionTableProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptionTableProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptionTableProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionTableProgress.Merge(m, src)
+}
+func (m *EncryptionTableProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionTableProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionTableProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionTableProgress proto.InternalMessageInfo
+
+func (m *EncryptionTableProgress) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *EncryptionTableProgress) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *EncryptionTableProgress) GetProcessed() int32 {
+	if m != nil {
+		return m.Processed
+	}
+	return 0
+}
+
+func (m *EncryptionTableProgress) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *EncryptionTableProgress) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *EncryptionTableProgress) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type EncryptionStatus struct {
+	State          EncryptionStatus_State     `protobuf:"varint,1,opt,name=state,proto3,enum=models.EncryptionStatus_State" json:"state"`
+	ActiveKeyLabel string                     `protobuf:"bytes,2,opt,name=active_key_label,json=activeKeyLabel,proto3" json:"active_key_label"`
+	StoredKeyLabel string                     `protobuf:"bytes,3,opt,name=stored_key_label,json=storedKeyLabel,proto3" json:"stored_key_label"`
+	Tables         []*EncryptionTableProgress `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	StartedAt      int64                      `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt    int64                      `protobuf:"varint,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (m *EncryptionStatus) Reset()      { *m = EncryptionStatus{} }
+func (*EncryptionStatus) ProtoMessage() {}
+func (*EncryptionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293a649ce9418c6, []int{1}
+}
+func (m *EncryptionStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptionStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionStatus.Merge(m, src)
+}
+func (m *EncryptionStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionStatus proto.InternalMessageInfo
+
+func (m *EncryptionStatus) GetState() EncryptionStatus_State {
+	if m != nil {
+		return m.State
+	}
+	return EncryptionIdle
+}
+
+func (m *EncryptionStatus) GetActiveKeyLabel() string {
+	if m != nil {
+		return m.ActiveKeyLabel
+	}
+	return ""
+}
+
+func (m *EncryptionStatus) GetStoredKeyLabel() string {
+	if m != nil {
+		return m.StoredKeyLabel
+	}
+	return ""
+}
+
+func (m *EncryptionStatus) GetTables() []*EncryptionTableProgress {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *EncryptionStatus) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *EncryptionStatus) GetCompletedAt() int64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+type EncryptionStatusResponse struct {
+	Error  *Error            `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Status *EncryptionStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *EncryptionStatusResponse) Reset()      { *m = EncryptionStatusResponse{} }
+func (*EncryptionStatusResponse) ProtoMessage() {}
+func (*EncryptionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293a649ce9418c6, []int{2}
+}
+func (m *EncryptionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionStatusResponse.Merge(m, src)
+}
+func (m *EncryptionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionStatusResponse proto.InternalMessageInfo
+
+func (m *EncryptionStatusResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *EncryptionStatusResponse) GetStatus() *EncryptionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type RotateEncryptionKeyRequest struct {
+	KeyLabel string `protobuf:"bytes,1,opt,name=key_label,json=keyLabel,proto3" json:"key_label"`
+}
+
+func (m *RotateEncryptionKeyRequest) Reset()      { *m = RotateEncryptionKeyRequest{} }
+func (*RotateEncryptionKeyRequest) ProtoMessage() {}
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293a649ce9418c6, []int{3}
+}
+func (m *RotateEncryptionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateEncryptionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateEncryptionKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateEncryptionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateEncryptionKeyRequest.Merge(m, src)
+}
+func (m *RotateEncryptionKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateEncryptionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateEncryptionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateEncryptionKeyRequest proto.InternalMessageInfo
+
+func (m *RotateEncryptionKeyRequest) GetKeyLabel() string {
+	if m != nil {
+		return m.KeyLabel
+	}
+	return ""
+}
+
+type RotateEncryptionKeyResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RotateEncryptionKeyResponse) Reset()      { *m = RotateEncryptionKeyResponse{} }
+func (*RotateEncryptionKeyResponse) ProtoMessage() {}
+func (*RotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293a649ce9418c6, []int{4}
+}
+func (m *RotateEncryptionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateEncryptionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateEncryptionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateEncryptionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateEncryptionKeyResponse.Merge(m, src)
+}
+func (m *RotateEncryptionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateEncryptionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateEncryptionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateEncryptionKeyResponse proto.InternalMessageInfo
+
+func (m *RotateEncryptionKeyResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type EncryptionKeyUsageRequest struct {
+	KeyLabel string `protobuf:"bytes,1,opt,name=key_label,json=keyLabel,proto3" json:"key_label"`
+}
+
+func (m *EncryptionKeyUsageRequest) Reset()      { *m = EncryptionKeyUsageRequest{} }
+func (*EncryptionKeyUsageRequest) ProtoMessage() {}
+func (*EncryptionKeyUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293a649ce9418c6, []int{5}
+}
+func (m *EncryptionKeyUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionKeyUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptionKeyUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptionKeyUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionKeyUsageRequest.Merge(m, src)
+}
+func (m *EncryptionKeyUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionKeyUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionKeyUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionKeyUsageRequest proto.InternalMessageInfo
+
+func (m *EncryptionKeyUsageRequest) GetKeyLabel() string {
+	if m != nil {
+		return m.KeyLabel
+	}
+	return ""
+}
+
+type EncryptionKeyUsageResponse struct {
+	Error       *Error           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	RowsByTable map[string]int32 `protobuf:"bytes,2,rep,name=rows_by_table,json=rowsByTable,proto3" json:"rows_by_table,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *EncryptionKeyUsageResponse) Reset()      { *m = EncryptionKeyUsageResponse{} }
+func (*EncryptionKeyUsageResponse) ProtoMessage() {}
+func (*EncryptionKeyUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293a649ce9418c6, []int{6}
+}
+func (m *EncryptionKeyUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptionKeyUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptionKeyUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptionKeyUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionKeyUsageResponse.Merge(m, src)
+}
+func (m *EncryptionKeyUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptionKeyUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionKeyUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionKeyUsageResponse proto.InternalMessageInfo
+
+func (m *EncryptionKeyUsageResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *EncryptionKeyUsageResponse) GetRowsByTable() map[string]int32 {
+	if m != nil {
+		return m.RowsByTable
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("models.EncryptionStatus_State", EncryptionStatus_State_name, EncryptionStatus_State_value)
+	proto.RegisterType((*EncryptionTableProgress)(nil), "models.EncryptionTableProgress")
+	proto.RegisterType((*EncryptionStatus)(nil), "models.EncryptionStatus")
+	proto.RegisterType((*EncryptionStatusResponse)(nil), "models.EncryptionStatusResponse")
+	proto.RegisterType((*RotateEncryptionKeyRequest)(nil), "models.RotateEncryptionKeyRequest")
+	proto.RegisterType((*RotateEncryptionKeyResponse)(nil), "models.RotateEncryptionKeyResponse")
+	proto.RegisterType((*EncryptionKeyUsageRequest)(nil), "models.EncryptionKeyUsageRequest")
+	proto.RegisterType((*EncryptionKeyUsageResponse)(nil), "models.EncryptionKeyUsageResponse")
+	proto.RegisterMapType((map[string]int32)(nil), "models.EncryptionKeyUsageResponse.RowsByTableEntry")
+}
+
+func init() { proto.RegisterFile("encryption.proto", fileDescriptor_8293a649ce9418c6) }
+
+var fileDescriptor_8293a649ce9418c6 = []byte{
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0xb5, 0x93, 0x26, 0xbf, 0x78, 0xd2, 0x46, 0xfe, 0x2d, 0x41, 0x35, 0x06, 0x6c, 0x63, 0x24,
+	0x14, 0xf1, 0x27, 0x45, 0x29, 0x52, 0x11, 0x87, 0xa2, 0x06, 0x95, 0x3f, 0x2a, 0x07, 0xb4, 0x80,
+	0x38, 0x46, 0x4e, 0xb2, 0x84, 0xa8, 0x8e, 0x37, 0xf5, 0x6e, 0x5a, 0xf9, 0xc6, 0x95, 0x9c, 0x90,
+	0x38, 0xe7, 0xce, 0x47, 0xe1, 0xd8, 0x63, 0xc5, 0x21, 0xa2, 0x29, 0x07, 0x94, 0x53, 0x3f, 0x02,
+	0xf2, 0x6e, 0xb0, 0xdb, 0xb4, 0x1c, 0xca, 0x25, 0xbb, 0xf3, 0xe6, 0xcd, 0x64, 0xf7, 0xbd, 0xf1,
+	0x82, 0x4e, 0x82, 0x56, 0x18, 0xf5, 0x79, 0x97, 0x06, 0xd5, 0x7e, 0x48, 0x39, 0x45, 0xf9, 0x1e,
+	0x6d, 0x13, 0x9f, 0x99, 0xf7, 0x3a, 0x5d, 0xfe, 0x61, 0xd0, 0xac, 0xb6, 0x68, 0x6f, 0xa5, 0x43,
+	0x3b, 0x74, 0x45, 0xa4, 0x9b, 0x83, 0xf7, 0x22, 0x12, 0x81, 0xd8, 0xc9, 0x32, 0xb3, 0x48, 0xc2,
+	0x90, 0x86, 0x32, 0x70, 0x3f, 0x65, 0x60, 0x79, 0x33, 0x69, 0xfc, 0xc6, 0x6b, 0xfa, 0xe4, 0x55,
+	0x48, 0x3b, 0x21, 0x61, 0x0c, 0xd9, 0x90, 0xe3, 0x31, 0x60, 0xa8, 0x8e, 0x5a, 0xd1, 0xea, 0xda,
+	0x74, 0x6c, 0x4b, 0x00, 0xcb, 0x45, 0x10, 0x28, 0xf7, 0x7c, 0x23, 0xe3, 0xa8, 0x95, 0xdc, 0x8c,
+	0x10, 0x03, 0x58, 0x2e, 0xe8, 0x0e, 0x68, 0xfd, 0x90, 0xb6, 0x08, 0x63, 0xa4, 0x6d, 0x64, 0x05,
+	0x69, 0x69, 0x3a, 0xb6, 0x53, 0x10, 0xa7, 0x5b, 0x54, 0x81, 0x82, 0xc7, 0x39, 0xe9, 0xf5, 0x39,
+	0x33, 0x16, 0x04, 0x77, 0x71, 0x3a, 0xb6, 0x13, 0x0c, 0x27, 0x3b, 0x74, 0x0d, 0x16, 0xda, 0x34,
+	0x20, 0x46, 0xce, 0x51, 0x2b, 0x85, 0x7a, 0x61, 0x3a, 0xb6, 0x45, 0x8c, 0xc5, 0x2f, 0x5a, 0x03,
+	0xf0, 0x3d, 0xc6, 0x1b, 0xe2, 0x9a, 0x46, 0x5e, 0x9c, 0xdd, 0x98, 0x8e, 0xed, 0x72, 0x8a, 0xde,
+	0xa5, 0xbd, 0xae, 0xe8, 0x15, 0x61, 0x2d, 0x46, 0x37, 0x63, 0xd0, 0xfd, 0x99, 0x05, 0x3d, 0xd5,
+	0xe2, 0x35, 0xf7, 0xf8, 0x80, 0xa1, 0xc7, 0x90, 0x63, 0xdc, 0xe3, 0x52, 0x84, 0x52, 0xcd, 0xaa,
+	0x4a, 0xd1, 0xab, 0xf3, 0xc4, 0x6a, 0xbc, 0x10, 0xa9, 0x81, 0x28, 0xc0, 0x72, 0x41, 0xeb, 0xa0,
+	0x7b, 0x2d, 0xde, 0xdd, 0x25, 0x8d, 0x6d, 0x12, 0x35, 0x7c, 0xaf, 0x49, 0xa4, 0x5e, 0x5a, 0xbd,
+	0x3c, 0x1d, 0xdb, 0x67, 0x72, 0xb8, 0x24, 0x91, 0x2d, 0x12, 0xbd, 0x8c, 0xe3, 0xb8, 0x9e, 0x71,
+	0x1a, 0x92, 0xf6, 0x89, 0xfa, 0x6c, 0x5a, 0x3f, 0x9f, 0xc3, 0x25, 0x89, 0x24, 0xf5, 0x6b, 0x90,
+	0x17, 0x6e, 0xc5, 0xa2, 0x66, 0x2b, 0xc5, 0x9a, 0x7d, 0xf6, 0x06, 0xa7, 0x6c, 0xc7, 0x33, 0x3a,
+	0xba, 0x0e, 0xc0, 0xb8, 0x17, 0x72, 0xd2, 0x6e, 0x78, 0x5c, 0x68, 0x9d, 0xc5, 0xda, 0x0c, 0xd9,
+	0xe0, 0xe8, 0x06, 0x2c, 0xb6, 0x68, 0xaf, 0xef, 0x93, 0x19, 0x21, 0x2f, 0x08, 0xc5, 0x04, 0xdb,
+	0xe0, 0xee, 0x17, 0x15, 0x72, 0x42, 0x96, 0xd8, 0xb1, 0x17, 0x6d, 0x9f, 0xe8, 0x8a, 0x89, 0x86,
+	0x23, 0xa7, 0x94, 0xfe, 0x75, 0x8c, 0x22, 0x17, 0xfe, 0xc3, 0x83, 0x20, 0xe8, 0x06, 0x1d, 0x5d,
+	0x35, 0x2f, 0x0f, 0x47, 0xce, 0xff, 0x29, 0x61, 0x96, 0x40, 0xb7, 0x40, 0x7b, 0xf2, 0xa7, 0xb5,
+	0x9e, 0x31, 0x97, 0x87, 0x23, 0xe7, 0x52, 0xca, 0x4a, 0x52, 0xc8, 0x81, 0xfc, 0x53, 0xaf, 0xeb,
+	0x93, 0xb6, 0x9e, 0x35, 0xcb, 0xc3, 0x91, 0x73, 0xc2, 0x51, 0x89, 0xbb, 0x3b, 0x60, 0xcc, 0x9b,
+	0x87, 0x09, 0xeb, 0xd3, 0x80, 0x11, 0x74, 0x13, 0x72, 0x72, 0x6c, 0x62, 0xb7, 0x8b, 0xb5, 0xa5,
+	0x44, 0xab, 0x18, 0xc4, 0x32, 0x87, 0xee, 0x43, 0x9e, 0x89, 0x32, 0xe1, 0x63, 0xb1, 0x66, 0xfc,
+	0x6d, 0x26, 0xf0, 0x8c, 0xe7, 0x3e, 0x07, 0x13, 0xd3, 0x58, 0x88, 0x94, 0xb1, 0x45, 0x22, 0x4c,
+	0x76, 0x06, 0x84, 0x71, 0x74, 0x1b, 0xb4, 0xd4, 0x5a, 0xf9, 0xad, 0x89, 0xaf, 0x24, 0xf5, 0xb4,
+	0xb0, 0x3d, 0x73, 0xd3, 0xad, 0xc3, 0xd5, 0x73, 0x3b, 0x5d, 0xe0, 0xfc, 0xee, 0x33, 0xb8, 0x72,
+	0xaa, 0xfa, 0x2d, 0xf3, 0x3a, 0xe4, 0x5f, 0x0e, 0xf3, 0x5d, 0x05, 0xf3, 0xbc, 0x4e, 0x17, 0x11,
+	0xf3, 0x1d, 0x2c, 0x85, 0x74, 0x8f, 0x35, 0x9a, 0x51, 0x43, 0x3e, 0x36, 0x19, 0x31, 0xa5, 0xab,
+	0x67, 0x35, 0x9d, 0xef, 0x5f, 0xc5, 0x74, 0x8f, 0xd5, 0x23, 0x31, 0xbc, 0x9b, 0x01, 0x0f, 0x23,
+	0x5c, 0x0c, 0x53, 0xc4, 0x5c, 0x07, 0x7d, 0x9e, 0x80, 0x74, 0xc8, 0x6e, 0x93, 0x48, 0x5e, 0x0b,
+	0xc7, 0x5b, 0x54, 0x86, 0xdc, 0xae, 0xe7, 0x0f, 0x88, 0x7c, 0xc2, 0xb0, 0x0c, 0x1e, 0x65, 0x1e,
+	0xaa, 0xf5, 0x07, 0xfb, 0x87, 0x96, 0x72, 0x70, 0x68, 0x29, 0xc7, 0x87, 0x96, 0xfa, 0x71, 0x62,
+	0xa9, 0x5f, 0x27, 0x96, 0xf2, 0x6d, 0x62, 0xa9, 0xfb, 0x13, 0x4b, 0xfd, 0x31, 0xb1, 0xd4, 0x5f,
+	0x13, 0x4b, 0x39, 0x9e, 0x58, 0xea, 0xe7, 0x23, 0x4b, 0xd9, 0x3f, 0xb2, 0x94, 0x83, 0x23, 0x4b,
+	0x69, 0xe6, 0xc5, 0xb3, 0xba, 0xfa, 0x7b, 0x00, 0x13, 0x9e, 0x53, 0xc8, 0xae, 0x05, 0x00, 0x00,
+}
+
+func (x EncryptionStatus_State) String() string {
+	s, ok := EncryptionStatus_State_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *EncryptionTableProgress) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.EncryptionTableProgress{")
+	s = append(s, "Table: "+fmt.Sprintf("%#v", this.Table)+",\n")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	s = append(s, "Processed: "+fmt.Sprintf("%#v", this.Processed)+",\n")
+	s = append(s, "Attempts: "+fmt.Sprintf("%#v", this.Attempts)+",\n")
+	s = append(s, "Done: "+fmt.Sprintf("%#v", this.Done)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EncryptionStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.EncryptionStatus{")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "ActiveKeyLabel: "+fmt.Sprintf("%#v", this.ActiveKeyLabel)+",\n")
+	s = append(s, "StoredKeyLabel: "+fmt.Sprintf("%#v", this.StoredKeyLabel)+",\n")
+	if this.Tables != nil {
+		s = append(s, "Tables: "+fmt.Sprintf("%#v", this.Tables)+",\n")
+	}
+	s = append(s, "StartedAt: "+fmt.Sprintf("%#v", this.StartedAt)+",\n")
+	s = append(s, "CompletedAt: "+fmt.Sprintf("%#v", this.CompletedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EncryptionStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.EncryptionStatusResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Status != nil {
+		s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RotateEncryptionKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RotateEncryptionKeyRequest{")
+	s = append(s, "KeyLabel: "+fmt.Sprintf("%#v", this.KeyLabel)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RotateEncryptionKeyResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RotateEncryptionKeyResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EncryptionKeyUsageRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.EncryptionKeyUsageRequest{")
+	s = append(s, "KeyLabel: "+fmt.Sprintf("%#v", this.KeyLabel)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EncryptionKeyUsageResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.EncryptionKeyUsageResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	keysForRowsByTable := make([]string, 0, len(this.RowsByTable))
+	for k, _ := range this.RowsByTable {
+		keysForRowsByTable = append(keysForRowsByTable, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRowsByTable)
+	mapStringForRowsByTable := "map[string]int32{"
+	for _, k := range keysForRowsByTable {
+		mapStringForRowsByTable += fmt.Sprintf("%#v: %#v,", k, this.RowsByTable[k])
+	}
+	mapStringForRowsByTable += "}"
+	if this.RowsByTable != nil {
+		s = append(s, "RowsByTable: "+mapStringForRowsByTable+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEncryption(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EncryptionTableProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionTableProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptionTableProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintEncryption(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintEncryption(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Processed != 0 {
+		i = encodeVarintEncryption(dAtA, i, uint64(m.Processed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintEncryption(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintEncryption(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedAt != 0 {
+		i = encodeVarintEncryption(dAtA, i, uint64(m.CompletedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintEncryption(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Tables[iNdEx].Size()
+				i -= size
+				if _, err := m.Tables[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEncryption(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StoredKeyLabel) > 0 {
+		i -= len(m.StoredKeyLabel)
+		copy(dAtA[i:], m.StoredKeyLabel)
+		i = encodeVarintEncryption(dAtA, i, uint64(len(m.StoredKeyLabel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActiveKeyLabel) > 0 {
+		i -= len(m.ActiveKeyLabel)
+		copy(dAtA[i:], m.ActiveKeyLabel)
+		i = encodeVarintEncryption(dAtA, i, uint64(len(m.ActiveKeyLabel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintEncryption(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size := m.Status.Size()
+			i -= size
+			if _, err := m.Status.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEncryption(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEncryption(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateEncryptionKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateEncryptionKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateEncryptionKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyLabel) > 0 {
+		i -= len(m.KeyLabel)
+		copy(dAtA[i:], m.KeyLabel)
+		i = encodeVarintEncryption(dAtA, i, uint64(len(m.KeyLabel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateEncryptionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateEncryptionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateEncryptionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEncryption(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptionKeyUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionKeyUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptionKeyUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyLabel) > 0 {
+		i -= len(m.KeyLabel)
+		copy(dAtA[i:], m.KeyLabel)
+		i = encodeVarintEncryption(dAtA, i, uint64(len(m.KeyLabel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptionKeyUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptionKeyUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptionKeyUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RowsByTable) > 0 {
+		for k := range m.RowsByTable {
+			v := m.RowsByTable[k]
+			baseI := i
+			i = encodeVarintEncryption(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEncryption(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEncryption(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEncryption(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEncryption(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEncryption(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EncryptionTableProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovEncryption(uint64(m.Total))
+	}
+	if m.Processed != 0 {
+		n += 1 + sovEncryption(uint64(m.Processed))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovEncryption(uint64(m.Attempts))
+	}
+	if m.Done {
+		n += 2
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptionStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovEncryption(uint64(m.State))
+	}
+	l = len(m.ActiveKeyLabel)
+	if l > 0 {
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	l = len(m.StoredKeyLabel)
+	if l > 0 {
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovEncryption(uint64(l))
+		}
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovEncryption(uint64(m.StartedAt))
+	}
+	if m.CompletedAt != 0 {
+		n += 1 + sovEncryption(uint64(m.CompletedAt))
+	}
+	return n
+}
+
+func (m *EncryptionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	return n
+}
+
+func (m *RotateEncryptionKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyLabel)
+	if l > 0 {
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	return n
+}
+
+func (m *RotateEncryptionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptionKeyUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyLabel)
+	if l > 0 {
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	return n
+}
+
+func (m *EncryptionKeyUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovEncryption(uint64(l))
+	}
+	if len(m.RowsByTable) > 0 {
+		for k, v := range m.RowsByTable {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEncryption(uint64(len(k))) + 1 + sovEncryption(uint64(v))
+			n += mapEntrySize + 1 + sovEncryption(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovEncryption(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEncryption(x uint64) (n int) {
+	return sovEncryption(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EncryptionTableProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionTableProgress{`,
+		`Table:` + fmt.Sprintf("%v", this.Table) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Processed:` + fmt.Sprintf("%v", this.Processed) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`Done:` + fmt.Sprintf("%v", this.Done) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTables := "[]*EncryptionTableProgress{"
+	for _, f := range this.Tables {
+		repeatedStringForTables += strings.Replace(f.String(), "EncryptionTableProgress", "EncryptionTableProgress", 1) + ","
+	}
+	repeatedStringForTables += "}"
+	s := strings.Join([]string{`&EncryptionStatus{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`ActiveKeyLabel:` + fmt.Sprintf("%v", this.ActiveKeyLabel) + `,`,
+		`StoredKeyLabel:` + fmt.Sprintf("%v", this.StoredKeyLabel) + `,`,
+		`Tables:` + repeatedStringForTables + `,`,
+		`StartedAt:` + fmt.Sprintf("%v", this.StartedAt) + `,`,
+		`CompletedAt:` + fmt.Sprintf("%v", this.CompletedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionStatusResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "EncryptionStatus", "EncryptionStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RotateEncryptionKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RotateEncryptionKeyRequest{`,
+		`KeyLabel:` + fmt.Sprintf("%v", this.KeyLabel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RotateEncryptionKeyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RotateEncryptionKeyResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionKeyUsageRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncryptionKeyUsageRequest{`,
+		`KeyLabel:` + fmt.Sprintf("%v", this.KeyLabel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EncryptionKeyUsageResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRowsByTable := make([]string, 0, len(this.RowsByTable))
+	for k, _ := range this.RowsByTable {
+		keysForRowsByTable = append(keysForRowsByTable, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRowsByTable)
+	mapStringForRowsByTable := "map[string]int32{"
+	for _, k := range keysForRowsByTable {
+		mapStringForRowsByTable += fmt.Sprintf("%v: %v,", k, this.RowsByTable[k])
+	}
+	mapStringForRowsByTable += "}"
+	s := strings.Join([]string{`&EncryptionKeyUsageResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`RowsByTable:` + mapStringForRowsByTable + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEncryption(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EncryptionTableProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionTableProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionTableProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+			m.Processed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= EncryptionStatus_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveKeyLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveKeyLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredKeyLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredKeyLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, &EncryptionTableProgress{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			m.CompletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &EncryptionStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateEncryptionKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateEncryptionKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateEncryptionKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateEncryptionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateEncryptionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateEncryptionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionKeyUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionKeyUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionKeyUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionKeyUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryption
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionKeyUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionKeyUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsByTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowsByTable == nil {
+				m.RowsByTable = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEncryption
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEncryption
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEncryption
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEncryption
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEncryption
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEncryption(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEncryption
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RowsByTable[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryption(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryption
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEncryption(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEncryption
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEncryption
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEncryption
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEncryption
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEncryption
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEncryption        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEncryption          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEncryption = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encryption Requests", func() {
+	Describe("RotateEncryptionKeyRequest", func() {
+		Describe("Validate", func() {
+			It("requires a key label", func() {
+				request := models.RotateEncryptionKeyRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"key_label"}))
+
+				request.KeyLabel = "some-label"
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+
+	Describe("EncryptionKeyUsageRequest", func() {
+		Describe("Validate", func() {
+			It("requires a key label", func() {
+				request := models.EncryptionKeyUsageRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"key_label"}))
+
+				request.KeyLabel = "some-label"
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+})
@@ -3,17 +3,17 @@
 
 package models
 
-import proto "github.com/gogo/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import _ "github.com/gogo/protobuf/gogoproto"
-
-import strconv "strconv"
-
-import strings "strings"
-import reflect "reflect"
-
-import io "io"
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -54,6 +54,10 @@ const (
 	Error_Unrecoverable              Error_Type = 29
 	Error_LockCollision              Error_Type = 30
 	Error_Timeout                    Error_Type = 31
+	Error_Forbidden                  Error_Type = 32
+	Error_TooManyRequests            Error_Type = 33
+	Error_InMaintenance              Error_Type = 34
+	Error_DisruptionBudgetExceeded   Error_Type = 35
 )
 
 var Error_Type_name = map[int32]string{
@@ -82,7 +86,12 @@ var Error_Type_name = map[int32]string{
 	29: "Unrecoverable",
 	30: "LockCollision",
 	31: "Timeout",
+	32: "Forbidden",
+	33: "TooManyRequests",
+	34: "InMaintenance",
+	35: "DisruptionBudgetExceeded",
 }
+
 var Error_Type_value = map[string]int32{
 	"UnknownError":               0,
 	"InvalidRecord":              3,
@@ -109,10 +118,14 @@ var Error_Type_value = map[string]int32{
 	"Unrecoverable":              29,
 	"LockCollision":              30,
 	"Timeout":                    31,
+	"Forbidden":                  32,
+	"TooManyRequests":            33,
+	"InMaintenance":              34,
+	"DisruptionBudgetExceeded":   35,
 }
 
 func (Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_error_10f950b40b22cf16, []int{0, 0}
+	return fileDescriptor_0579b252106fcf4a, []int{0, 0}
 }
 
 type Error struct {
@@ -123,7 +136,7 @@ type Error struct {
 func (m *Error) Reset()      { *m = Error{} }
 func (*Error) ProtoMessage() {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_error_10f950b40b22cf16, []int{0}
+	return fileDescriptor_0579b252106fcf4a, []int{0}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,15 +146,15 @@ func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return xxx_messageInfo_Error.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
 			return nil, err
 		}
 		return b[:n], nil
 	}
 }
-func (dst *Error) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Error.Merge(dst, src)
+func (m *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(m, src)
 }
 func (m *Error) XXX_Size() int {
 	return m.Size()
@@ -167,9 +180,56 @@ func (m *Error) GetMessage() string {
 }
 
 func init() {
-	proto.RegisterType((*Error)(nil), "models.Error")
 	proto.RegisterEnum("models.Error_Type", Error_Type_name, Error_Type_value)
+	proto.RegisterType((*Error)(nil), "models.Error")
 }
+
+func init() { proto.RegisterFile("error.proto", fileDescriptor_0579b252106fcf4a) }
+
+var fileDescriptor_0579b252106fcf4a = []byte{
+	// 639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0xf9, 0x4c, 0x30, 0x13, 0x7e, 0x2e, 0x43, 0x3e, 0x08, 0x81, 0x1a, 0xea, 0xaa, 0x12,
+	0x9b, 0x86, 0xaa, 0xed, 0x0b, 0x34, 0x3f, 0x20, 0x2a, 0xfe, 0x64, 0x92, 0x07, 0x98, 0x78, 0x6e,
+	0xc2, 0x08, 0x67, 0x26, 0x9d, 0x19, 0xa7, 0xd0, 0x55, 0x1f, 0xa1, 0xaf, 0xd0, 0x5d, 0x9f, 0xa2,
+	0xeb, 0x2e, 0x59, 0xb2, 0x42, 0x25, 0x6c, 0x2a, 0x56, 0x3c, 0x42, 0x65, 0x27, 0x20, 0x24, 0xd8,
+	0x58, 0x33, 0xe7, 0xdc, 0x7b, 0x7c, 0xce, 0xf5, 0x35, 0x29, 0xa0, 0xd6, 0x4a, 0x57, 0xfa, 0x5a,
+	0x59, 0x45, 0xf3, 0x3d, 0xc5, 0x31, 0x36, 0xe5, 0x37, 0x5d, 0x61, 0x4f, 0x92, 0x76, 0x25, 0x52,
+	0xbd, 0xad, 0xae, 0xea, 0xaa, 0xad, 0x8c, 0x6e, 0x27, 0x9d, 0xec, 0x96, 0x5d, 0xb2, 0xd3, 0xa8,
+	0x2d, 0xf8, 0x91, 0x27, 0x93, 0x8d, 0x54, 0x86, 0xbe, 0x25, 0xae, 0x3d, 0xef, 0x63, 0xc9, 0xd9,
+	0x70, 0x36, 0xe7, 0xde, 0xd1, 0xca, 0x48, 0xaf, 0x92, 0x91, 0x95, 0xe6, 0x79, 0x1f, 0xab, 0xde,
+	0xed, 0xd5, 0x7a, 0x56, 0x13, 0x66, 0x4f, 0xfa, 0x9a, 0x4c, 0xf5, 0xd0, 0x18, 0xd6, 0xc5, 0xd2,
+	0xc4, 0x86, 0xb3, 0x39, 0x5d, 0x2d, 0xdc, 0x5e, 0xad, 0xdf, 0x43, 0xe1, 0xfd, 0x21, 0xf8, 0x35,
+	0x49, 0xdc, 0xb4, 0x9f, 0x02, 0x99, 0x69, 0xc9, 0x53, 0xa9, 0xbe, 0xc8, 0x4c, 0x14, 0x72, 0x74,
+	0x81, 0xcc, 0xee, 0xca, 0x01, 0x8b, 0x05, 0x0f, 0x31, 0x52, 0x9a, 0xc3, 0x7f, 0x94, 0x92, 0xb9,
+	0x07, 0xe8, 0x73, 0x82, 0xc6, 0x82, 0x4b, 0x17, 0xc9, 0xfc, 0x03, 0x66, 0xfa, 0x4a, 0x1a, 0x84,
+	0x49, 0x5a, 0x26, 0x4b, 0x63, 0xf0, 0x68, 0x9c, 0x70, 0x7f, 0xf4, 0x42, 0xc8, 0xd3, 0x79, 0x52,
+	0x18, 0x73, 0x9f, 0x8e, 0x0f, 0x0f, 0x60, 0x8a, 0x96, 0x48, 0x71, 0x9b, 0x89, 0x18, 0x79, 0x53,
+	0x1d, 0xf6, 0x51, 0x36, 0xe4, 0x00, 0x63, 0xd5, 0x47, 0xf0, 0x1e, 0xc9, 0x1c, 0x5b, 0x66, 0xb1,
+	0xa9, 0x99, 0x34, 0xc2, 0x0a, 0x25, 0x61, 0x9a, 0x16, 0x09, 0x84, 0x68, 0x54, 0xa2, 0x23, 0xac,
+	0x29, 0xd9, 0x89, 0x45, 0x64, 0xa1, 0x90, 0x3a, 0xbc, 0x47, 0x1b, 0x67, 0xc2, 0x58, 0x03, 0x33,
+	0x8f, 0x2b, 0x0f, 0x94, 0xdd, 0x56, 0x89, 0xe4, 0x30, 0x9b, 0xda, 0x08, 0x55, 0x62, 0x51, 0x8f,
+	0xf2, 0xce, 0xd1, 0x35, 0x52, 0xfa, 0x18, 0xd9, 0x84, 0xc5, 0x7b, 0xe1, 0x51, 0x8d, 0x49, 0xa9,
+	0x6c, 0x15, 0x6b, 0x31, 0x13, 0x3d, 0xe4, 0x30, 0xff, 0x2c, 0x7b, 0x6c, 0x99, 0xb6, 0xc8, 0x01,
+	0x9e, 0xef, 0xd5, 0xcc, 0x9c, 0x20, 0x87, 0x05, 0xba, 0x4a, 0x96, 0x9f, 0xb0, 0xa3, 0xc4, 0x40,
+	0x9f, 0x6d, 0x0d, 0xb1, 0xa7, 0x06, 0xc8, 0x61, 0x91, 0xfa, 0xa4, 0xfc, 0x84, 0x6d, 0xc9, 0x68,
+	0x6c, 0xeb, 0xff, 0x74, 0x42, 0x61, 0x22, 0xa5, 0x90, 0xdd, 0x43, 0x59, 0x17, 0x9d, 0x0e, 0x6a,
+	0x94, 0xb6, 0x86, 0x71, 0x0c, 0xa5, 0x74, 0x16, 0x3b, 0xad, 0xdd, 0xfa, 0x0e, 0x4a, 0xd4, 0x2c,
+	0x9b, 0x5a, 0x39, 0x4d, 0x5d, 0x47, 0x83, 0x5a, 0xb0, 0x58, 0x7c, 0x45, 0x58, 0xa5, 0x33, 0xc4,
+	0xab, 0x23, 0xe3, 0xb1, 0x8a, 0x4e, 0x61, 0x2d, 0xfd, 0xe6, 0x2d, 0xa9, 0x31, 0x52, 0x03, 0xd4,
+	0xac, 0x1d, 0x23, 0xbc, 0x48, 0xa1, 0x3d, 0x15, 0x9d, 0xd6, 0x54, 0x1c, 0x0b, 0x93, 0x8a, 0xf8,
+	0xb4, 0x40, 0xa6, 0x9a, 0xa2, 0x87, 0x2a, 0xb1, 0xb0, 0x4e, 0x67, 0xc9, 0xf4, 0xb6, 0xd2, 0x6d,
+	0xc1, 0x39, 0x4a, 0xd8, 0x48, 0xd7, 0xa1, 0xa9, 0xd4, 0x3e, 0x93, 0xe7, 0xe3, 0x15, 0x31, 0xf0,
+	0x72, 0xb4, 0x4a, 0xfb, 0x4c, 0x48, 0x8b, 0x92, 0xc9, 0x08, 0x21, 0x48, 0x63, 0xd7, 0x85, 0xd1,
+	0x49, 0x3f, 0x35, 0x56, 0x4d, 0x78, 0x17, 0x6d, 0xe3, 0x2c, 0x42, 0xe4, 0xc8, 0xe1, 0x55, 0xe0,
+	0x7a, 0x0e, 0x38, 0x81, 0xeb, 0x4d, 0xc0, 0x44, 0xe0, 0x7a, 0x04, 0x48, 0xe0, 0x7a, 0x45, 0x28,
+	0x06, 0xae, 0xb7, 0x04, 0x4b, 0x81, 0xeb, 0x2d, 0xc3, 0x72, 0xe0, 0x7a, 0x2b, 0xb0, 0x52, 0xfd,
+	0x70, 0x71, 0xed, 0x3b, 0x97, 0xd7, 0x7e, 0xee, 0xee, 0xda, 0x77, 0xbe, 0x0d, 0x7d, 0xe7, 0xe7,
+	0xd0, 0xcf, 0xfd, 0x1e, 0xfa, 0xce, 0xc5, 0xd0, 0x77, 0xfe, 0x0c, 0x7d, 0xe7, 0xef, 0xd0, 0xcf,
+	0xdd, 0x0d, 0x7d, 0xe7, 0xfb, 0x8d, 0x9f, 0xbb, 0xb8, 0xf1, 0x73, 0x97, 0x37, 0x7e, 0xae, 0x9d,
+	0xcf, 0x7e, 0xb0, 0xf7, 0xff, 0x06, 0x00, 0x80, 0x9b, 0xbe, 0xad, 0xa6, 0x03, 0x00, 0x00,
+}
+
 func (x Error_Type) String() string {
 	s, ok := Error_Type_name[int32(x)]
 	if ok {
@@ -199,7 +259,7 @@ func valueToGoStringError(v interface{}, typ string) string {
 func (m *Error) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -207,32 +267,40 @@ func (m *Error) Marshal() (dAtA []byte, err error) {
 }
 
 func (m *Error) MarshalTo(dAtA []byte) (int, error) {
-	var i int
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintError(dAtA, i, uint64(m.Type))
-	}
 	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
 		i = encodeVarintError(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintError(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return i, nil
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintError(dAtA []byte, offset int, v uint64) int {
+	offset -= sovError(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
-	return offset + 1
+	return base
 }
 func (m *Error) Size() (n int) {
 	if m == nil {
@@ -251,14 +319,7 @@ func (m *Error) Size() (n int) {
 }
 
 func sovError(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozError(x uint64) (n int) {
 	return sovError(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -297,7 +358,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
+			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
@@ -325,7 +386,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (Error_Type(b) & 0x7F) << shift
+				m.Type |= Error_Type(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -344,7 +405,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -354,6 +415,9 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthError
 			}
 			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthError
+			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -365,7 +429,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthError
 			}
 			if (iNdEx + skippy) > l {
@@ -383,6 +447,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 func skipError(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -414,10 +479,8 @@ func skipError(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -434,96 +497,34 @@ func skipError(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			iNdEx += length
 			if length < 0 {
 				return 0, ErrInvalidLengthError
 			}
-			return iNdEx, nil
+			iNdEx += length
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowError
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipError(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupError
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthError
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthError = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowError   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthError        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowError          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupError = fmt.Errorf("proto: unexpected end of group")
 )
-
-func init() { proto.RegisterFile("error.proto", fileDescriptor_error_10f950b40b22cf16) }
-
-var fileDescriptor_error_10f950b40b22cf16 = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x4e, 0xdb, 0x4c,
-	0x14, 0xb5, 0xf9, 0x0c, 0x98, 0x09, 0x3f, 0x97, 0x21, 0x1f, 0x84, 0x40, 0x07, 0x64, 0xa9, 0x12,
-	0x9b, 0x86, 0xaa, 0xed, 0x0b, 0x34, 0x09, 0x20, 0x2a, 0x0a, 0xc8, 0x24, 0x0f, 0x30, 0xb1, 0x6f,
-	0xc2, 0x88, 0xc9, 0x4c, 0x3a, 0x1e, 0xa7, 0xa5, 0xab, 0x3e, 0x42, 0x1f, 0xa3, 0x8f, 0xd2, 0x45,
-	0x17, 0x2c, 0x59, 0xa1, 0x62, 0x36, 0x2d, 0x2b, 0x1e, 0xa1, 0xb2, 0x13, 0x10, 0x12, 0x6c, 0xac,
-	0x7b, 0xcf, 0xb9, 0xe7, 0xf8, 0x9e, 0x6b, 0x99, 0x94, 0xd0, 0x18, 0x6d, 0x6a, 0x03, 0xa3, 0xad,
-	0xa6, 0x53, 0x7d, 0x1d, 0xa3, 0x4c, 0xaa, 0xaf, 0x7a, 0xc2, 0x9e, 0xa6, 0x9d, 0x5a, 0xa4, 0xfb,
-	0xdb, 0x3d, 0xdd, 0xd3, 0xdb, 0x05, 0xdd, 0x49, 0xbb, 0x45, 0x57, 0x34, 0x45, 0x35, 0x92, 0x05,
-	0xbf, 0x26, 0xc9, 0xe4, 0x4e, 0x6e, 0x43, 0x5f, 0x13, 0xcf, 0x9e, 0x0f, 0xb0, 0xe2, 0x6e, 0xba,
-	0x5b, 0xf3, 0x6f, 0x68, 0x6d, 0xe4, 0x57, 0x2b, 0xc8, 0x5a, 0xeb, 0x7c, 0x80, 0x75, 0xff, 0xf6,
-	0x6a, 0xa3, 0x98, 0x09, 0x8b, 0x27, 0x7d, 0x49, 0xa6, 0xfb, 0x98, 0x24, 0xbc, 0x87, 0x95, 0x89,
-	0x4d, 0x77, 0x6b, 0xa6, 0x5e, 0xba, 0xbd, 0xda, 0xb8, 0x87, 0xc2, 0xfb, 0x22, 0xf8, 0xeb, 0x11,
-	0x2f, 0xd7, 0x53, 0x20, 0xb3, 0x6d, 0x75, 0xa6, 0xf4, 0x67, 0x55, 0x98, 0x82, 0x43, 0x17, 0xc9,
-	0xdc, 0xbe, 0x1a, 0x72, 0x29, 0xe2, 0x10, 0x23, 0x6d, 0x62, 0xf8, 0x8f, 0x52, 0x32, 0xff, 0x00,
-	0x7d, 0x4a, 0x31, 0xb1, 0xe0, 0xd1, 0x25, 0xb2, 0xf0, 0x80, 0x25, 0x03, 0xad, 0x12, 0x84, 0x49,
-	0x5a, 0x25, 0xcb, 0x63, 0xf0, 0x78, 0x9c, 0xf0, 0xe3, 0xe8, 0x85, 0x30, 0x45, 0x17, 0x48, 0x69,
-	0xcc, 0x7d, 0x38, 0x39, 0x3a, 0x84, 0x69, 0x5a, 0x21, 0xe5, 0x5d, 0x2e, 0x24, 0xc6, 0x2d, 0x7d,
-	0x34, 0x40, 0xb5, 0xa3, 0x86, 0x28, 0xf5, 0x00, 0xc1, 0x7f, 0x64, 0x73, 0x62, 0xb9, 0xc5, 0x96,
-	0xe1, 0x2a, 0x11, 0x56, 0x68, 0x05, 0x33, 0xb4, 0x4c, 0x20, 0xc4, 0x44, 0xa7, 0x26, 0xc2, 0x86,
-	0x56, 0x5d, 0x29, 0x22, 0x0b, 0xa5, 0x7c, 0xc3, 0x7b, 0x74, 0xe7, 0x8b, 0x48, 0x6c, 0x02, 0xb3,
-	0x8f, 0x27, 0x0f, 0xb5, 0xdd, 0xd5, 0xa9, 0x8a, 0x61, 0x2e, 0x5f, 0x23, 0xd4, 0xa9, 0x45, 0x33,
-	0xca, 0x3b, 0x4f, 0xd7, 0x49, 0xe5, 0x7d, 0x64, 0x53, 0x2e, 0x0f, 0xc2, 0xe3, 0x06, 0x57, 0x4a,
-	0xdb, 0x3a, 0x36, 0x24, 0x17, 0x7d, 0x8c, 0x61, 0xe1, 0x59, 0xf6, 0xc4, 0x72, 0x63, 0x31, 0x06,
-	0x78, 0x5e, 0x6b, 0x78, 0x72, 0x8a, 0x31, 0x2c, 0xd2, 0x35, 0xb2, 0xf2, 0x84, 0x1d, 0x25, 0x06,
-	0xfa, 0xac, 0x34, 0xc4, 0xbe, 0x1e, 0x62, 0x0c, 0x4b, 0x94, 0x91, 0xea, 0x13, 0xb6, 0xad, 0xa2,
-	0xf1, 0x5a, 0xff, 0xe7, 0x17, 0x0a, 0x53, 0xa5, 0x84, 0xea, 0x1d, 0xa9, 0xa6, 0xe8, 0x76, 0xd1,
-	0xa0, 0xb2, 0x0d, 0x94, 0x12, 0x2a, 0xf9, 0x2d, 0xf6, 0xda, 0xfb, 0xcd, 0x3d, 0x54, 0x68, 0x78,
-	0x71, 0xb5, 0x6a, 0x9e, 0xba, 0x89, 0x09, 0x1a, 0xc1, 0xa5, 0xf8, 0x8a, 0xb0, 0x46, 0x67, 0x89,
-	0xdf, 0x44, 0x1e, 0x4b, 0x1d, 0x9d, 0xc1, 0x7a, 0xfe, 0xcd, 0xdb, 0xca, 0x60, 0xa4, 0x87, 0x68,
-	0x78, 0x47, 0x22, 0xbc, 0xc8, 0xa1, 0x03, 0x1d, 0x9d, 0x35, 0xb4, 0x94, 0x22, 0xc9, 0x4d, 0x18,
-	0x2d, 0x91, 0xe9, 0x96, 0xe8, 0xa3, 0x4e, 0x2d, 0x6c, 0x04, 0x9e, 0xef, 0x82, 0x1b, 0x78, 0xfe,
-	0x04, 0x4c, 0x04, 0x9e, 0x4f, 0x80, 0x04, 0x9e, 0x5f, 0x86, 0x72, 0xe0, 0xf9, 0xcb, 0xb0, 0x1c,
-	0x78, 0xfe, 0x0a, 0xac, 0x04, 0x9e, 0xbf, 0x0a, 0xab, 0xf5, 0x77, 0x17, 0xd7, 0xcc, 0xbd, 0xbc,
-	0x66, 0xce, 0xdd, 0x35, 0x73, 0xbf, 0x65, 0xcc, 0xfd, 0x91, 0x31, 0xe7, 0x67, 0xc6, 0xdc, 0x8b,
-	0x8c, 0xb9, 0xbf, 0x33, 0xe6, 0xfe, 0xc9, 0x98, 0x73, 0x97, 0x31, 0xf7, 0xfb, 0x0d, 0x73, 0x2e,
-	0x6e, 0x98, 0x73, 0x79, 0xc3, 0x9c, 0xce, 0x54, 0xf1, 0x2f, 0xbc, 0xfd, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0xf2, 0xb4, 0xa0, 0xf2, 0x51, 0x03, 0x00, 0x00,
-}
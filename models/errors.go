@@ -143,6 +143,26 @@ var (
 		Type:    Error_LockCollision,
 		Message: "lock already exists",
 	}
+
+	ErrForbidden = &Error{
+		Type:    Error_Forbidden,
+		Message: "the client is not allowed to make this request",
+	}
+
+	ErrTooManyRequests = &Error{
+		Type:    Error_TooManyRequests,
+		Message: "the client has made too many requests",
+	}
+
+	ErrInMaintenance = &Error{
+		Type:    Error_InMaintenance,
+		Message: "the BBS is in maintenance mode and does not accept new scheduling work",
+	}
+
+	ErrDisruptionBudgetExceeded = &Error{
+		Type:    Error_DisruptionBudgetExceeded,
+		Message: "the disruption budget of the LRP allows no more disruptions",
+	}
 )
 
 type ErrInvalidField struct {
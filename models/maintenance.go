@@ -0,0 +1,15 @@
+package models
+
+func (request *SetMaintenanceModeRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Enabled && request.Reason == "" {
+		validationError = validationError.Append(ErrInvalidField{"reason"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,939 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maintenance.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type MaintenanceMode struct {
+	Enabled   bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *MaintenanceMode) Reset()      { *m = MaintenanceMode{} }
+func (*MaintenanceMode) ProtoMessage() {}
+func (*MaintenanceMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{0}
+}
+func (m *MaintenanceMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceMode.Merge(m, src)
+}
+func (m *MaintenanceMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceMode proto.InternalMessageInfo
+
+func (m *MaintenanceMode) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MaintenanceMode) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MaintenanceMode) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type SetMaintenanceModeRequest struct {
+	Enabled bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *SetMaintenanceModeRequest) Reset()      { *m = SetMaintenanceModeRequest{} }
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{1}
+}
+func (m *SetMaintenanceModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMaintenanceModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetMaintenanceModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetMaintenanceModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMaintenanceModeRequest.Merge(m, src)
+}
+func (m *SetMaintenanceModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMaintenanceModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMaintenanceModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMaintenanceModeRequest proto.InternalMessageInfo
+
+func (m *SetMaintenanceModeRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *SetMaintenanceModeRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MaintenanceModeResponse struct {
+	Error           *Error           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	MaintenanceMode *MaintenanceMode `protobuf:"bytes,2,opt,name=maintenance_mode,json=maintenanceMode,proto3" json:"maintenance_mode,omitempty"`
+}
+
+func (m *MaintenanceModeResponse) Reset()      { *m = MaintenanceModeResponse{} }
+func (*MaintenanceModeResponse) ProtoMessage() {}
+func (*MaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{2}
+}
+func (m *MaintenanceModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceModeResponse.Merge(m, src)
+}
+func (m *MaintenanceModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceModeResponse proto.InternalMessageInfo
+
+func (m *MaintenanceModeResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *MaintenanceModeResponse) GetMaintenanceMode() *MaintenanceMode {
+	if m != nil {
+		return m.MaintenanceMode
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MaintenanceMode)(nil), "models.MaintenanceMode")
+	proto.RegisterType((*SetMaintenanceModeRequest)(nil), "models.SetMaintenanceModeRequest")
+	proto.RegisterType((*MaintenanceModeResponse)(nil), "models.MaintenanceModeResponse")
+}
+
+func init() { proto.RegisterFile("maintenance.proto", fileDescriptor_6053ae89a3b3f561) }
+
+var fileDescriptor_6053ae89a3b3f561 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x91, 0xb1, 0x4e, 0x02, 0x41,
+	0x10, 0x86, 0x77, 0x25, 0xa2, 0xec, 0xc5, 0x80, 0x17, 0x12, 0x4e, 0x8a, 0x81, 0x60, 0x4c, 0x28,
+	0xf0, 0x48, 0xd0, 0xc4, 0xda, 0x4b, 0x2c, 0x69, 0xce, 0x07, 0x20, 0x77, 0xdc, 0x88, 0x24, 0xdc,
+	0xee, 0x79, 0xb7, 0x57, 0xd8, 0x19, 0x9f, 0xc0, 0x37, 0xb0, 0xf5, 0x51, 0x2c, 0x29, 0xa9, 0x88,
+	0x2c, 0x8d, 0xa1, 0xe2, 0x11, 0x0c, 0x7b, 0x10, 0x10, 0x6b, 0xbb, 0x9d, 0x6f, 0xfe, 0x99, 0x7f,
+	0xb2, 0x3f, 0x3b, 0x0d, 0xbd, 0x21, 0x97, 0xc8, 0x3d, 0xde, 0x47, 0x3b, 0x8a, 0x85, 0x14, 0x66,
+	0x3e, 0x14, 0x01, 0x8e, 0x92, 0xea, 0xe5, 0x60, 0x28, 0x1f, 0x53, 0xdf, 0xee, 0x8b, 0xb0, 0x3d,
+	0x10, 0x03, 0xd1, 0xd6, 0x6d, 0x3f, 0x7d, 0xd0, 0x95, 0x2e, 0xf4, 0x2b, 0x1b, 0xab, 0x1a, 0x18,
+	0xc7, 0x22, 0xce, 0x8a, 0xc6, 0x3b, 0x65, 0xc5, 0xee, 0x76, 0x73, 0x57, 0x04, 0x68, 0x5e, 0xb0,
+	0x23, 0xe4, 0x9e, 0x3f, 0xc2, 0xc0, 0xa2, 0x75, 0xda, 0x3c, 0x76, 0x8c, 0xc5, 0xb4, 0xb6, 0x41,
+	0xee, 0xe6, 0x61, 0xb6, 0x58, 0x3e, 0x46, 0x2f, 0x11, 0xdc, 0x3a, 0xa8, 0xd3, 0x66, 0xc1, 0x29,
+	0x2f, 0xa6, 0xb5, 0x52, 0x46, 0x5a, 0x22, 0x1c, 0x4a, 0x0c, 0x23, 0xf9, 0xec, 0xae, 0x35, 0xe6,
+	0x0d, 0x63, 0x69, 0x14, 0x78, 0x12, 0x83, 0x9e, 0x27, 0xad, 0x5c, 0x9d, 0x36, 0x73, 0x8e, 0xb5,
+	0x98, 0xd6, 0xca, 0x5b, 0xba, 0x33, 0x55, 0x58, 0xd3, 0x5b, 0xd9, 0x88, 0xd8, 0xd9, 0x3d, 0xca,
+	0xbd, 0x1b, 0x5d, 0x7c, 0x4a, 0x31, 0x91, 0xff, 0x72, 0x6a, 0xe3, 0x95, 0xb2, 0xca, 0x1f, 0xbf,
+	0x24, 0x12, 0x3c, 0x41, 0xf3, 0x9c, 0x1d, 0xea, 0xef, 0xd3, 0x76, 0x46, 0xe7, 0xc4, 0xce, 0x32,
+	0xb0, 0xef, 0x56, 0xd0, 0xcd, 0x7a, 0xa6, 0xc3, 0x4a, 0x3b, 0x69, 0xf5, 0x56, 0x12, 0x6d, 0x6c,
+	0x74, 0x2a, 0x1b, 0xfd, 0xfe, 0xfe, 0x62, 0xf8, 0x1b, 0x38, 0xd7, 0xe3, 0x19, 0x90, 0xc9, 0x0c,
+	0xc8, 0x72, 0x06, 0xf4, 0x45, 0x01, 0xfd, 0x50, 0x40, 0x3e, 0x15, 0xd0, 0xb1, 0x02, 0xfa, 0xa5,
+	0x80, 0x7e, 0x2b, 0x20, 0x4b, 0x05, 0xf4, 0x6d, 0x0e, 0x64, 0x3c, 0x07, 0x32, 0x99, 0x03, 0xf1,
+	0xf3, 0x3a, 0xd5, 0xab, 0x9f, 0x01, 0x00, 0x45, 0xca, 0x2f, 0xf4, 0x2e, 0x02, 0x00, 0x00,
+}
+
+func (this *MaintenanceMode) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.MaintenanceMode{")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetMaintenanceModeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.SetMaintenanceModeRequest{")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MaintenanceModeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.MaintenanceModeResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.MaintenanceMode != nil {
+		s = append(s, "MaintenanceMode: "+fmt.Sprintf("%#v", this.MaintenanceMode)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMaintenance(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *MaintenanceMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintMaintenance(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMaintenance(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMaintenanceModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMaintenanceModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMaintenanceModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMaintenance(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaintenanceMode != nil {
+		{
+			size := m.MaintenanceMode.Size()
+			i -= size
+			if _, err := m.MaintenanceMode.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaintenance(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaintenance(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMaintenance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMaintenance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MaintenanceMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMaintenance(uint64(l))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovMaintenance(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *SetMaintenanceModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMaintenance(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovMaintenance(uint64(l))
+	}
+	if m.MaintenanceMode != nil {
+		l = m.MaintenanceMode.Size()
+		n += 1 + l + sovMaintenance(uint64(l))
+	}
+	return n
+}
+
+func sovMaintenance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMaintenance(x uint64) (n int) {
+	return sovMaintenance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *MaintenanceMode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceMode{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetMaintenanceModeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetMaintenanceModeRequest{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceModeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceModeResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`MaintenanceMode:` + strings.Replace(this.MaintenanceMode.String(), "MaintenanceMode", "MaintenanceMode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMaintenance(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *MaintenanceMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaintenance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaintenance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetMaintenanceModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaintenance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMaintenanceModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMaintenanceModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaintenance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaintenance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaintenanceMode == nil {
+				m.MaintenanceMode = &MaintenanceMode{}
+			}
+			if err := m.MaintenanceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaintenance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaintenance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMaintenance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMaintenance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMaintenance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMaintenance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMaintenance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMaintenance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMaintenance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMaintenance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMaintenance = fmt.Errorf("proto: unexpected end of group")
+)
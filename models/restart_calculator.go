@@ -66,20 +66,28 @@ func (r RestartCalculator) Validate() error {
 }
 
 func (r RestartCalculator) ShouldRestart(now, crashedAt int64, crashCount int32) bool {
+	if crashCount < r.ImmediateRestarts {
+		return true
+	}
+
+	nextRestartTime, ok := r.NextRestartTime(crashedAt, crashCount)
+	return ok && nextRestartTime <= now
+}
+
+// NextRestartTime returns when an ActualLRP that crashed at crashedAt becomes
+// eligible to restart, or false when it has used up its restart attempts.
+func (r RestartCalculator) NextRestartTime(crashedAt int64, crashCount int32) (int64, bool) {
 	switch {
 	case crashCount < r.ImmediateRestarts:
-		return true
+		return crashedAt, true
 
 	case crashCount < r.MaxRestartAttempts:
 		backoffDuration := exponentialBackoff(crashCount-r.ImmediateRestarts, r.MaxBackoffCount)
 		if backoffDuration > r.MaxBackoffDuration {
 			backoffDuration = r.MaxBackoffDuration
 		}
-		nextRestartTime := crashedAt + backoffDuration.Nanoseconds()
-		if nextRestartTime <= now {
-			return true
-		}
+		return crashedAt + backoffDuration.Nanoseconds(), true
 	}
 
-	return false
+	return 0, false
 }
@@ -18,8 +18,9 @@ type TaskChange struct {
 }
 
 type TaskFilter struct {
-	Domain string
-	CellID string
+	Domain  string
+	Domains []string
+	CellID  string
 }
 
 func (t *Task) LagerData() lager.Data {
@@ -0,0 +1,175 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tedsuo/rata"
+)
+
+//go:generate go run ../cmd/bbs-openapi -output ../doc/openapi.json
+
+const (
+	Version = "3.0.3"
+
+	EventStreamContentType = "text/event-stream"
+
+	errorResponseSchema = "ErrorResponse"
+)
+
+// Generate describes the routes as an indented OpenAPI document.
+func Generate(routes rata.Routes, messages map[string]bbs.RouteMessage) ([]byte, error) {
+	document, err := Build(routes, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(payload, '\n'), nil
+}
+
+// Build describes the routes, using the messages to derive the schemas of
+// their request and response bodies. Every route must have messages.
+func Build(routes rata.Routes, messages map[string]bbs.RouteMessage) (*Document, error) {
+	builder := newSchemaBuilder()
+
+	errorSchema, err := builder.schema(reflect.TypeOf(models.Error{}))
+	if err != nil {
+		return nil, err
+	}
+	builder.schemas[errorResponseSchema] = &Schema{
+		Type:        "object",
+		Description: "The body of responses rejected before reaching the route, holding only the error.",
+		Properties:  map[string]*Schema{"error": errorSchema},
+	}
+
+	document := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "BBS API",
+			Description: "Every route accepts and returns protobuf messages, or their JSON encoding when the request asks for it. Failures other than those listed are reported in the error field of the response.",
+			Version:     "v1",
+		},
+		Paths: map[string]PathItem{},
+	}
+
+	for _, route := range routes {
+		message, ok := messages[route.Name]
+		if !ok {
+			return nil, fmt.Errorf("route %s has no messages", route.Name)
+		}
+
+		operation, err := buildOperation(builder, route, message)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %s", route.Name, err)
+		}
+
+		method := strings.ToLower(route.Method)
+		pathItem, ok := document.Paths[route.Path]
+		if !ok {
+			pathItem = PathItem{}
+			document.Paths[route.Path] = pathItem
+		}
+		if _, ok := pathItem[method]; ok {
+			return nil, fmt.Errorf("route %s: %s %s is already described", route.Name, route.Method, route.Path)
+		}
+		pathItem[method] = operation
+	}
+
+	document.Components.Schemas = builder.schemas
+	return document, nil
+}
+
+func buildOperation(builder *schemaBuilder, route rata.Route, message bbs.RouteMessage) (*Operation, error) {
+	operation := &Operation{
+		OperationID: route.Name,
+		Deprecated:  message.Deprecated,
+		Responses:   map[string]*Response{},
+	}
+
+	if message.Request != nil {
+		schema, err := builder.messageRef(message.Request)
+		if err != nil {
+			return nil, err
+		}
+		operation.RequestBody = &RequestBody{Content: messageContent(schema, protobufDescription(message.Request))}
+	}
+
+	if message.EventStream() {
+		events := []*Schema{}
+		eventTypes := []string{}
+		for _, event := range message.Events {
+			schema, err := builder.messageRef(event)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, schema)
+			eventTypes = append(eventTypes, event.EventType())
+		}
+		sort.Strings(eventTypes)
+
+		operation.Responses["200"] = &Response{
+			Description: fmt.Sprintf("Server-sent events named %s. Their data is the base64 encoded protobuf event, or the JSON event when the request asks for JSON.", strings.Join(eventTypes, ", ")),
+			Content: map[string]MediaType{
+				EventStreamContentType: {Schema: &Schema{OneOf: events}},
+			},
+		}
+	} else {
+		schema, err := builder.messageRef(message.Response)
+		if err != nil {
+			return nil, err
+		}
+		operation.Responses["200"] = &Response{
+			Description: "The response, holding an error when the request failed.",
+			Content:     messageContent(schema, protobufDescription(message.Response)),
+		}
+	}
+
+	errorResponse := &Schema{Ref: "#/components/schemas/" + errorResponseSchema}
+	operation.Responses["403"] = &Response{
+		Description: "The client is not allowed to call the route.",
+		Content:     messageContent(errorResponse, "A protobuf encoded response holding only the error."),
+	}
+	operation.Responses["429"] = &Response{
+		Description: "Too many requests are in flight.",
+		Headers: map[string]*Header{
+			middleware.RetryAfterHeader: {
+				Description: "Seconds to wait before retrying.",
+				Schema:      &Schema{Type: "integer", Format: "int32"},
+			},
+		},
+		Content: messageContent(errorResponse, "A protobuf encoded response holding only the error."),
+	}
+	operation.Responses["503"] = &Response{
+		Description: http.StatusText(http.StatusServiceUnavailable) + ": the BBS is not the active instance.",
+	}
+
+	return operation, nil
+}
+
+func messageContent(schema *Schema, protobufDescription string) map[string]MediaType {
+	return map[string]MediaType{
+		bbs.JSONContentType: {Schema: schema},
+		bbs.ProtoContentType: {Schema: &Schema{
+			Type:        "string",
+			Format:      "binary",
+			Description: protobufDescription,
+		}},
+	}
+}
+
+func protobufDescription(message proto.Message) string {
+	return fmt.Sprintf("A protobuf encoded models.%s.", reflect.TypeOf(message).Elem().Name())
+}
@@ -0,0 +1,112 @@
+package openapi_test
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/openapi"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generate", func() {
+	It("matches the checked in document", func() {
+		expected, err := ioutil.ReadFile("../doc/openapi.json")
+		Expect(err).NotTo(HaveOccurred())
+
+		document, err := openapi.Generate(bbs.Routes, bbs.RouteMessages)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(document)).To(Equal(string(expected)), "doc/openapi.json is stale, run go generate ./openapi")
+	})
+})
+
+var _ = Describe("Build", func() {
+	var document *openapi.Document
+
+	BeforeEach(func() {
+		var err error
+		document, err = openapi.Build(bbs.Routes, bbs.RouteMessages)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("describes every route", func() {
+		for _, route := range bbs.Routes {
+			Expect(document.Paths).To(HaveKey(route.Path))
+			Expect(document.Paths[route.Path]).To(HaveKey(map[string]string{"GET": "get", "POST": "post"}[route.Method]))
+		}
+	})
+
+	It("marks deprecated routes", func() {
+		Expect(document.Paths["/v1/desired_lrps/list.r2"]["post"].Deprecated).To(BeTrue())
+		Expect(document.Paths["/v1/events"]["get"].Deprecated).To(BeTrue())
+		Expect(document.Paths["/v1/desired_lrps/list.r3"]["post"].Deprecated).To(BeFalse())
+	})
+
+	It("references the request and response messages", func() {
+		operation := document.Paths["/v1/tasks/desire.r2"]["post"]
+		Expect(operation.OperationID).To(Equal(bbs.DesireTaskRoute_r2))
+		Expect(operation.RequestBody.Content[bbs.JSONContentType].Schema.Ref).To(Equal("#/components/schemas/DesireTaskRequest"))
+		Expect(operation.Responses["200"].Content[bbs.JSONContentType].Schema.Ref).To(Equal("#/components/schemas/TaskLifecycleResponse"))
+		Expect(operation.Responses["200"].Content).To(HaveKey(bbs.ProtoContentType))
+	})
+
+	It("describes event streams as server-sent events", func() {
+		operation := document.Paths["/v1/events/tasks.r1"]["post"]
+		Expect(operation.RequestBody).To(BeNil())
+
+		content := operation.Responses["200"].Content
+		Expect(content).To(HaveKey(openapi.EventStreamContentType))
+		Expect(content[openapi.EventStreamContentType].Schema.OneOf).To(ConsistOf(
+			&openapi.Schema{Ref: "#/components/schemas/TaskCreatedEvent"},
+			&openapi.Schema{Ref: "#/components/schemas/TaskChangedEvent"},
+			&openapi.Schema{Ref: "#/components/schemas/TaskRemovedEvent"},
+		))
+	})
+
+	It("describes enums by the names they marshal to", func() {
+		task := document.Components.Schemas["Task"]
+		Expect(task.Properties["state"].Type).To(Equal("string"))
+		Expect(task.Properties["state"].Enum).To(ContainElement("Running"))
+	})
+
+	It("flattens embedded messages", func() {
+		desiredLRP := document.Components.Schemas["DesiredLRP"]
+		Expect(desiredLRP.Properties).To(HaveKey("process_guid"))
+		Expect(desiredLRP.Properties).NotTo(HaveKey("DesiredLRPKey"))
+	})
+
+	It("describes messages with their own JSON encoding by their shape", func() {
+		update := document.Components.Schemas["DesiredLRPUpdate"]
+		Expect(update.Properties).To(HaveKey("instances"))
+		Expect(update.Properties).To(HaveKey("annotation"))
+		Expect(update.Properties).NotTo(HaveKey("Instances"))
+	})
+
+	Context("when a route has no messages", func() {
+		It("errors", func() {
+			routes := append(rata.Routes{}, bbs.Routes...)
+			routes = append(routes, rata.Route{Path: "/v1/new", Method: "POST", Name: "New"})
+
+			_, err := openapi.Build(routes, bbs.RouteMessages)
+			Expect(err).To(MatchError(ContainSubstring("route New has no messages")))
+		})
+	})
+
+	Context("when two routes share a method and path", func() {
+		It("errors", func() {
+			routes := rata.Routes{
+				{Path: "/v1/ping", Method: "POST", Name: bbs.PingRoute_r0},
+				{Path: "/v1/ping", Method: "POST", Name: bbs.CellsRoute_r0},
+			}
+
+			_, err := openapi.Build(routes, map[string]bbs.RouteMessage{
+				bbs.PingRoute_r0:  {Response: &models.PingResponse{}},
+				bbs.CellsRoute_r0: {Response: &models.CellsResponse{}},
+			})
+			Expect(err).To(MatchError(ContainSubstring("is already described")))
+		})
+	})
+})
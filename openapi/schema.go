@@ -0,0 +1,219 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/gogo/protobuf/proto"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	modelsPackagePath = reflect.TypeOf(models.Error{}).PkgPath()
+)
+
+// jsonShapes stand in for the messages with their own JSON encoding, which
+// reflection cannot see. They mirror the structs those encodings marshal.
+var jsonShapes = map[reflect.Type]interface{}{
+	reflect.TypeOf(models.ActualLRPsRequest{}): struct {
+		Domain      string `json:"domain"`
+		CellId      string `json:"cell_id"`
+		ProcessGuid string `json:"process_guid"`
+		Index       *int32 `json:"index,omitempty"`
+	}{},
+	reflect.TypeOf(models.DesiredLRPUpdate{}): struct {
+		Instances        *int32                   `json:"instances,omitempty"`
+		Routes           *models.Routes           `json:"routes,omitempty"`
+		Annotation       *string                  `json:"annotation,omitempty"`
+		DisruptionBudget *models.DisruptionBudget `json:"disruption_budget,omitempty"`
+	}{},
+	reflect.TypeOf(models.ResourceLimits{}): struct {
+		Nofile *uint64 `json:"nofile,omitempty"`
+		Nproc  *uint64 `json:"nproc,omitempty"`
+	}{},
+}
+
+// schemaBuilder derives JSON schemas from the Go types of the messages, as
+// encoding/json sees them, and collects a component for every struct.
+type schemaBuilder struct {
+	schemas map[string]*Schema
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{schemas: map[string]*Schema{}}
+}
+
+func (b *schemaBuilder) messageRef(message proto.Message) (*Schema, error) {
+	return b.schema(reflect.TypeOf(message))
+}
+
+func (b *schemaBuilder) schema(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == rawMessageType {
+		return &Schema{}, nil
+	}
+
+	if _, ok := jsonShapes[t]; ok {
+		return b.structRef(t)
+	}
+
+	if enum, ok := b.enum(t); ok {
+		return enum, nil
+	}
+
+	if implementsJSONMarshaler(t) {
+		return nil, fmt.Errorf("%s has its own JSON encoding but no entry in jsonShapes", t)
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Int32, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}, nil
+	case reflect.Int, reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}, nil
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+		items, err := b.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		values, err := b.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return b.structRef(t)
+	}
+
+	return nil, fmt.Errorf("cannot describe %s of kind %s", t, t.Kind())
+}
+
+// enum describes protobuf enums as the names they marshal to in JSON, or as
+// their numbers when they have no JSON encoding of their own.
+func (b *schemaBuilder) enum(t reflect.Type) (*Schema, bool) {
+	if t.Kind() != reflect.Int32 || t.PkgPath() != modelsPackagePath {
+		return nil, false
+	}
+
+	values := proto.EnumValueMap("models." + t.Name())
+	if values == nil {
+		return nil, false
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return values[names[i]] < values[names[j]] })
+
+	schema := &Schema{}
+	if implementsJSONMarshaler(t) {
+		schema.Type = "string"
+		for _, name := range names {
+			schema.Enum = append(schema.Enum, name)
+		}
+	} else {
+		schema.Type = "integer"
+		schema.Format = "int32"
+		descriptions := []string{}
+		for _, name := range names {
+			schema.Enum = append(schema.Enum, values[name])
+			descriptions = append(descriptions, fmt.Sprintf("%d: %s", values[name], name))
+		}
+		schema.Description = strings.Join(descriptions, ", ")
+	}
+
+	return schema, true
+}
+
+func (b *schemaBuilder) structRef(t reflect.Type) (*Schema, error) {
+	ref := &Schema{Ref: "#/components/schemas/" + t.Name()}
+	if _, ok := b.schemas[t.Name()]; ok {
+		return ref, nil
+	}
+
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	// register before describing the fields, so recursive messages end
+	b.schemas[t.Name()] = schema
+
+	shape := t
+	if jsonShape, ok := jsonShapes[t]; ok {
+		shape = reflect.TypeOf(jsonShape)
+	}
+
+	err := b.addProperties(schema, shape)
+	if err != nil {
+		return nil, err
+	}
+
+	return ref, nil
+}
+
+// addProperties adds a property for every field encoding/json marshals,
+// including those of embedded structs without a name of their own.
+func (b *schemaBuilder) addProperties(schema *Schema, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				err := b.addProperties(schema, embedded)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property, err := b.schema(field.Type)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %s", t.Name(), field.Name, err)
+		}
+		schema.Properties[name] = property
+	}
+
+	return nil
+}
+
+func implementsJSONMarshaler(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType)
+}
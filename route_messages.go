@@ -0,0 +1,144 @@
+package bbs
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/gogo/protobuf/proto"
+)
+
+// RouteMessage describes the body of the requests to a route and of its
+// responses. Routes without a Request take an empty body, and event streams
+// respond with server-sent Events rather than a Response.
+type RouteMessage struct {
+	Request    proto.Message
+	Response   proto.Message
+	Events     []models.Event
+	Deprecated bool
+}
+
+// EventStream reports whether the route responds with server-sent events.
+func (m RouteMessage) EventStream() bool {
+	return len(m.Events) > 0
+}
+
+var lrpGroupEvents = []models.Event{
+	&models.DesiredLRPCreatedEvent{},
+	&models.DesiredLRPChangedEvent{},
+	&models.DesiredLRPRemovedEvent{},
+	&models.ActualLRPCreatedEvent{},
+	&models.ActualLRPChangedEvent{},
+	&models.ActualLRPRemovedEvent{},
+	&models.ActualLRPCrashedEvent{},
+}
+
+var lrpInstanceEvents = []models.Event{
+	&models.DesiredLRPCreatedEvent{},
+	&models.DesiredLRPChangedEvent{},
+	&models.DesiredLRPRemovedEvent{},
+	&models.ActualLRPInstanceCreatedEvent{},
+	&models.ActualLRPInstanceChangedEvent{},
+	&models.ActualLRPInstanceRemovedEvent{},
+	&models.ActualLRPCrashedEvent{},
+}
+
+var taskEvents = []models.Event{
+	&models.TaskCreatedEvent{},
+	&models.TaskChangedEvent{},
+	&models.TaskRemovedEvent{},
+}
+
+var RouteMessages = map[string]RouteMessage{
+	// Ping
+	PingRoute_r0: {Response: &models.PingResponse{}},
+
+	// Domains
+	DomainsRoute_r0:      {Response: &models.DomainsResponse{}},
+	UpsertDomainRoute_r0: {Request: &models.UpsertDomainRequest{}, Response: &models.UpsertDomainResponse{}},
+
+	// Actual LRPs
+	ActualLRPsRoute_r0:                          {Request: &models.ActualLRPsRequest{}, Response: &models.ActualLRPsResponse{}},
+	ActualLRPGroupsRoute_r0:                     {Request: &models.ActualLRPGroupsRequest{}, Response: &models.ActualLRPGroupsResponse{}, Deprecated: true},
+	ActualLRPGroupsByProcessGuidRoute_r0:        {Request: &models.ActualLRPGroupsByProcessGuidRequest{}, Response: &models.ActualLRPGroupsResponse{}, Deprecated: true},
+	ActualLRPGroupByProcessGuidAndIndexRoute_r0: {Request: &models.ActualLRPGroupByProcessGuidAndIndexRequest{}, Response: &models.ActualLRPGroupResponse{}, Deprecated: true},
+	ExplainActualLRPRoute_r0:                    {Request: &models.ExplainActualLRPRequest{}, Response: &models.ExplainActualLRPResponse{}},
+
+	// Actual LRP Lifecycle
+	ClaimActualLRPRoute_r0:  {Request: &models.ClaimActualLRPRequest{}, Response: &models.ActualLRPLifecycleResponse{}},
+	StartActualLRPRoute_r0:  {Request: &models.StartActualLRPRequest{}, Response: &models.ActualLRPLifecycleResponse{}},
+	CrashActualLRPRoute_r0:  {Request: &models.CrashActualLRPRequest{}, Response: &models.ActualLRPLifecycleResponse{}},
+	FailActualLRPRoute_r0:   {Request: &models.FailActualLRPRequest{}, Response: &models.ActualLRPLifecycleResponse{}},
+	RemoveActualLRPRoute_r0: {Request: &models.RemoveActualLRPRequest{}, Response: &models.ActualLRPLifecycleResponse{}},
+	RetireActualLRPRoute_r0: {Request: &models.RetireActualLRPRequest{}, Response: &models.ActualLRPLifecycleResponse{}},
+
+	// Evacuation
+	RemoveEvacuatingActualLRPRoute_r0: {Request: &models.RemoveEvacuatingActualLRPRequest{}, Response: &models.RemoveEvacuatingActualLRPResponse{}},
+	EvacuateClaimedActualLRPRoute_r0:  {Request: &models.EvacuateClaimedActualLRPRequest{}, Response: &models.EvacuationResponse{}},
+	EvacuateCrashedActualLRPRoute_r0:  {Request: &models.EvacuateCrashedActualLRPRequest{}, Response: &models.EvacuationResponse{}},
+	EvacuateStoppedActualLRPRoute_r0:  {Request: &models.EvacuateStoppedActualLRPRequest{}, Response: &models.EvacuationResponse{}},
+	EvacuateRunningActualLRPRoute_r0:  {Request: &models.EvacuateRunningActualLRPRequest{}, Response: &models.EvacuationResponse{}},
+
+	// Desired LRPs
+	DesiredLRPsRoute_r3:               {Request: &models.DesiredLRPsRequest{}, Response: &models.DesiredLRPsResponse{}},
+	DesiredLRPSchedulingInfosRoute_r0: {Request: &models.DesiredLRPsRequest{}, Response: &models.DesiredLRPSchedulingInfosResponse{}},
+	DesiredLRPByProcessGuidRoute_r3:   {Request: &models.DesiredLRPByProcessGuidRequest{}, Response: &models.DesiredLRPResponse{}},
+	DesiredLRPsRoute_r2:               {Request: &models.DesiredLRPsRequest{}, Response: &models.DesiredLRPsResponse{}, Deprecated: true},
+	DesiredLRPByProcessGuidRoute_r2:   {Request: &models.DesiredLRPByProcessGuidRequest{}, Response: &models.DesiredLRPResponse{}, Deprecated: true},
+	DisruptionBudgetStatusRoute_r0:    {Request: &models.DisruptionBudgetStatusRequest{}, Response: &models.DisruptionBudgetStatusResponse{}},
+
+	// Desire LRP Lifecycle
+	DesireDesiredLRPRoute_r2: {Request: &models.DesireLRPRequest{}, Response: &models.DesiredLRPLifecycleResponse{}},
+	UpdateDesiredLRPRoute_r0: {Request: &models.UpdateDesiredLRPRequest{}, Response: &models.DesiredLRPLifecycleResponse{}},
+	RemoveDesiredLRPRoute_r0: {Request: &models.RemoveDesiredLRPRequest{}, Response: &models.DesiredLRPLifecycleResponse{}},
+
+	// Tasks
+	TasksRoute_r3:         {Request: &models.TasksRequest{}, Response: &models.TasksResponse{}},
+	TaskByGuidRoute_r3:    {Request: &models.TaskByGuidRequest{}, Response: &models.TaskResponse{}},
+	DesireTaskRoute_r2:    {Request: &models.DesireTaskRequest{}, Response: &models.TaskLifecycleResponse{}},
+	StartTaskRoute_r0:     {Request: &models.StartTaskRequest{}, Response: &models.StartTaskResponse{}},
+	CancelTaskRoute_r0:    {Request: &models.TaskGuidRequest{}, Response: &models.TaskLifecycleResponse{}},
+	FailTaskRoute_r0:      {Request: &models.FailTaskRequest{}, Response: &models.TaskLifecycleResponse{}, Deprecated: true},
+	RejectTaskRoute_r0:    {Request: &models.RejectTaskRequest{}, Response: &models.TaskLifecycleResponse{}},
+	CompleteTaskRoute_r0:  {Request: &models.CompleteTaskRequest{}, Response: &models.TaskLifecycleResponse{}},
+	ResolvingTaskRoute_r0: {Request: &models.TaskGuidRequest{}, Response: &models.TaskLifecycleResponse{}},
+	DeleteTaskRoute_r0:    {Request: &models.TaskGuidRequest{}, Response: &models.TaskLifecycleResponse{}},
+	TasksRoute_r2:         {Request: &models.TasksRequest{}, Response: &models.TasksResponse{}, Deprecated: true},
+	TaskByGuidRoute_r2:    {Request: &models.TaskByGuidRequest{}, Response: &models.TaskResponse{}, Deprecated: true},
+
+	// Event Streaming
+	LRPGroupEventStreamRoute_r1:    {Request: &models.EventsByCellId{}, Events: lrpGroupEvents, Deprecated: true},
+	TaskEventStreamRoute_r1:        {Events: taskEvents},
+	LRPInstanceEventStreamRoute_r1: {Request: &models.EventsByCellId{}, Events: lrpInstanceEvents},
+	EventStreamRoute_r0:            {Request: &models.EventsByCellId{}, Events: lrpGroupEvents, Deprecated: true},
+	TaskEventStreamRoute_r0:        {Events: taskEvents, Deprecated: true},
+	LrpInstanceEventStreamRoute_r0: {Request: &models.EventsByCellId{}, Events: lrpInstanceEvents, Deprecated: true},
+
+	// Cell Presence
+	CellsRoute_r0: {Response: &models.CellsResponse{}},
+
+	// Cell Summaries
+	CellSummariesRoute_r0: {Response: &models.CellSummariesResponse{}},
+
+	// Cell Cordons
+	CellCordonsRoute_r0:  {Response: &models.CellCordonsResponse{}},
+	CordonCellRoute_r0:   {Request: &models.CordonCellRequest{}, Response: &models.CellCordonResponse{}},
+	DrainCellRoute_r0:    {Request: &models.DrainCellRequest{}, Response: &models.CellCordonResponse{}},
+	UncordonCellRoute_r0: {Request: &models.UncordonCellRequest{}, Response: &models.CellCordonResponse{}},
+
+	// Encryption
+	RotateEncryptionKeyRoute_r0: {Request: &models.RotateEncryptionKeyRequest{}, Response: &models.RotateEncryptionKeyResponse{}},
+	EncryptionStatusRoute_r0:    {Response: &models.EncryptionStatusResponse{}},
+	EncryptionKeyUsageRoute_r0:  {Request: &models.EncryptionKeyUsageRequest{}, Response: &models.EncryptionKeyUsageResponse{}},
+
+	// Audit
+	AuditEntriesRoute_r0: {Request: &models.AuditEntriesRequest{}, Response: &models.AuditEntriesResponse{}},
+
+	// Config
+	ReloadConfigRoute_r0: {Response: &models.ReloadConfigResponse{}},
+
+	ConvergencePlanRoute_r0:                {Request: &models.ConvergencePlanRequest{}, Response: &models.ConvergencePlanResponse{}},
+	ConvergenceSafetyValveRoute_r0:         {Response: &models.ConvergenceSafetyValveResponse{}},
+	OverrideConvergenceSafetyValveRoute_r0: {Response: &models.ConvergenceSafetyValveResponse{}},
+
+	// Maintenance
+	MaintenanceModeRoute_r0:    {Response: &models.MaintenanceModeResponse{}},
+	SetMaintenanceModeRoute_r0: {Request: &models.SetMaintenanceModeRequest{}, Response: &models.MaintenanceModeResponse{}},
+}
@@ -0,0 +1,33 @@
+package bbs_test
+
+import (
+	"code.cloudfoundry.org/bbs"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RouteMessages", func() {
+	It("describes the messages of every route", func() {
+		for _, route := range bbs.Routes {
+			Expect(bbs.RouteMessages).To(HaveKey(route.Name), "route %s has no entry in RouteMessages", route.Name)
+		}
+	})
+
+	It("only describes known routes", func() {
+		for name := range bbs.RouteMessages {
+			_, found := bbs.Routes.FindRouteByName(name)
+			Expect(found).To(BeTrue(), "RouteMessages describes unknown route %s", name)
+		}
+	})
+
+	It("gives every route other than event streams a response", func() {
+		for name, messages := range bbs.RouteMessages {
+			if messages.EventStream() {
+				Expect(messages.Response).To(BeNil(), "event stream %s has a response message", name)
+			} else {
+				Expect(messages.Response).NotTo(BeNil(), "route %s has no response message", name)
+			}
+		}
+	})
+})
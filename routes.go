@@ -15,6 +15,7 @@ const (
 	ActualLRPGroupsRoute_r0                     = "ActualLRPGroups"                      // DEPRECATED
 	ActualLRPGroupsByProcessGuidRoute_r0        = "ActualLRPGroupsByProcessGuid"         // DEPRECATED
 	ActualLRPGroupByProcessGuidAndIndexRoute_r0 = "ActualLRPGroupsByProcessGuidAndIndex" // DEPRECATED
+	ExplainActualLRPRoute_r0                    = "ExplainActualLRP"
 
 	// Actual LRP Lifecycle
 	ClaimActualLRPRoute_r0  = "ClaimActualLRP"
@@ -37,6 +38,7 @@ const (
 	DesiredLRPByProcessGuidRoute_r3   = "DesiredLRPByProcessGuid"
 	DesiredLRPsRoute_r2               = "DesiredLRPs_r2"             // DEPRECATED
 	DesiredLRPByProcessGuidRoute_r2   = "DesiredLRPByProcessGuid_r2" // DEPRECATED
+	DisruptionBudgetStatusRoute_r0    = "DisruptionBudgetStatus"
 
 	// Desire LRP Lifecycle
 	DesireDesiredLRPRoute_r2 = "DesireDesiredLRP"
@@ -67,6 +69,35 @@ const (
 
 	// Cell Presence
 	CellsRoute_r0 = "Cells"
+
+	// Cell Summaries
+	CellSummariesRoute_r0 = "CellSummaries"
+
+	// Cell Cordons
+	CellCordonsRoute_r0  = "CellCordons"
+	CordonCellRoute_r0   = "CordonCell"
+	DrainCellRoute_r0    = "DrainCell"
+	UncordonCellRoute_r0 = "UncordonCell"
+
+	// Encryption
+	RotateEncryptionKeyRoute_r0 = "RotateEncryptionKey"
+	EncryptionStatusRoute_r0    = "EncryptionStatus"
+	EncryptionKeyUsageRoute_r0  = "EncryptionKeyUsage"
+
+	// Audit
+	AuditEntriesRoute_r0 = "AuditEntries"
+
+	// Config
+	ReloadConfigRoute_r0 = "ReloadConfig"
+
+	// Convergence
+	ConvergencePlanRoute_r0                = "ConvergencePlan"
+	ConvergenceSafetyValveRoute_r0         = "ConvergenceSafetyValve"
+	OverrideConvergenceSafetyValveRoute_r0 = "OverrideConvergenceSafetyValve"
+
+	// Maintenance
+	MaintenanceModeRoute_r0    = "MaintenanceMode"
+	SetMaintenanceModeRoute_r0 = "SetMaintenanceMode"
 )
 
 var Routes = rata.Routes{
@@ -82,6 +113,7 @@ var Routes = rata.Routes{
 	{Path: "/v1/actual_lrp_groups/list", Method: "POST", Name: ActualLRPGroupsRoute_r0},                                              // DEPRECATED
 	{Path: "/v1/actual_lrp_groups/list_by_process_guid", Method: "POST", Name: ActualLRPGroupsByProcessGuidRoute_r0},                 // DEPRECATED
 	{Path: "/v1/actual_lrp_groups/get_by_process_guid_and_index", Method: "POST", Name: ActualLRPGroupByProcessGuidAndIndexRoute_r0}, // DEPRECATED
+	{Path: "/v1/actual_lrps/explain", Method: "POST", Name: ExplainActualLRPRoute_r0},
 
 	// Actual LRP Lifecycle
 	{Path: "/v1/actual_lrps/claim", Method: "POST", Name: ClaimActualLRPRoute_r0},
@@ -105,6 +137,7 @@ var Routes = rata.Routes{
 	{Path: "/v1/desired_lrps/get_by_process_guid.r3", Method: "POST", Name: DesiredLRPByProcessGuidRoute_r3},
 	{Path: "/v1/desired_lrps/list.r2", Method: "POST", Name: DesiredLRPsRoute_r2},                            // DEPRECATED
 	{Path: "/v1/desired_lrps/get_by_process_guid.r2", Method: "POST", Name: DesiredLRPByProcessGuidRoute_r2}, // DEPRECATED
+	{Path: "/v1/desired_lrps/disruption_budget", Method: "POST", Name: DisruptionBudgetStatusRoute_r0},
 
 	// Desire LPR Lifecycle
 	{Path: "/v1/desired_lrp/desire.r2", Method: "POST", Name: DesireDesiredLRPRoute_r2},
@@ -137,4 +170,33 @@ var Routes = rata.Routes{
 
 	// Cells
 	{Path: "/v1/cells/list.r1", Method: "POST", Name: CellsRoute_r0},
+
+	// Cell Summaries
+	{Path: "/v1/cells/summaries/list", Method: "POST", Name: CellSummariesRoute_r0},
+
+	// Cell Cordons
+	{Path: "/v1/cells/cordons/list", Method: "POST", Name: CellCordonsRoute_r0},
+	{Path: "/v1/cells/cordon", Method: "POST", Name: CordonCellRoute_r0},
+	{Path: "/v1/cells/drain", Method: "POST", Name: DrainCellRoute_r0},
+	{Path: "/v1/cells/uncordon", Method: "POST", Name: UncordonCellRoute_r0},
+
+	// Encryption
+	{Path: "/v1/encryption/rotate", Method: "POST", Name: RotateEncryptionKeyRoute_r0},
+	{Path: "/v1/encryption/status", Method: "POST", Name: EncryptionStatusRoute_r0},
+	{Path: "/v1/encryption/key_usage", Method: "POST", Name: EncryptionKeyUsageRoute_r0},
+
+	// Audit
+	{Path: "/v1/audit_entries/list", Method: "POST", Name: AuditEntriesRoute_r0},
+
+	// Config
+	{Path: "/v1/config/reload", Method: "POST", Name: ReloadConfigRoute_r0},
+
+	// Convergence
+	{Path: "/v1/convergence/plan", Method: "POST", Name: ConvergencePlanRoute_r0},
+	{Path: "/v1/convergence/safety_valve", Method: "POST", Name: ConvergenceSafetyValveRoute_r0},
+	{Path: "/v1/convergence/safety_valve/override", Method: "POST", Name: OverrideConvergenceSafetyValveRoute_r0},
+
+	// Maintenance
+	{Path: "/v1/maintenance", Method: "POST", Name: MaintenanceModeRoute_r0},
+	{Path: "/v1/maintenance/set", Method: "POST", Name: SetMaintenanceModeRoute_r0},
 }
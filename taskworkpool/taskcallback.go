@@ -3,8 +3,8 @@ package taskworkpool
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
+	"net"
 	"net/http"
 	"os"
 	"regexp"
@@ -36,15 +36,20 @@ type TaskCompletionWorkPool struct {
 	httpClient       *http.Client
 }
 
-func New(logger lager.Logger, maxWorkers int, cbHandler CompletedTaskHandler, tlsConfig *tls.Config, requestTimeout time.Duration) *TaskCompletionWorkPool {
+// DialTLSContextFunc dials the TLS connections of task completion callbacks.
+type DialTLSContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+func New(logger lager.Logger, maxWorkers int, cbHandler CompletedTaskHandler, dialTLSContext DialTLSContextFunc, requestTimeout time.Duration) *TaskCompletionWorkPool {
 	if cbHandler == nil {
 		panic("callbackHandler cannot be nil")
 	}
 
 	httpClient := cfhttp.NewClient(
-		cfhttp.WithTLSConfig(tlsConfig),
 		cfhttp.WithRequestTimeout(requestTimeout),
 	)
+	if transport, ok := httpClient.Transport.(*http.Transport); ok {
+		transport.DialTLSContext = dialTLSContext
+	}
 
 	return &TaskCompletionWorkPool{
 		logger:          logger.Session("task-completion-workpool"),
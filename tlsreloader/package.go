@@ -0,0 +1 @@
+package tlsreloader // import "code.cloudfoundry.org/bbs/tlsreloader"
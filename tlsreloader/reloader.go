@@ -0,0 +1,252 @@
+package tlsreloader
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	loggingclient "code.cloudfoundry.org/diego-logging-client"
+	"code.cloudfoundry.org/lager"
+)
+
+const (
+	tlsReloadsMetric        = "TLSReloads"
+	tlsReloadFailuresMetric = "TLSReloadFailures"
+
+	DefaultInterval = time.Minute
+)
+
+var ErrNoCACertificates = errors.New("CA file contains no certificates")
+
+// Reloader watches a certificate, key and CA bundle on disk. The TLS configs
+// it builds always use the most recently loaded ones, so rotated files take
+// effect on new connections without a restart.
+type Reloader struct {
+	logger       lager.Logger
+	name         string
+	certFile     string
+	keyFile      string
+	caFile       string
+	clock        clock.Clock
+	interval     time.Duration
+	metronClient loggingclient.IngressClient
+
+	lock     sync.RWMutex
+	checksum []byte
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+	onReload []func()
+}
+
+// New loads the files, failing if they are invalid. The CA file is optional.
+func New(
+	logger lager.Logger,
+	name, certFile, keyFile, caFile string,
+	clock clock.Clock,
+	interval time.Duration,
+	metronClient loggingclient.IngressClient,
+) (*Reloader, error) {
+	r := &Reloader{
+		logger:       logger.Session("tls-reloader", lager.Data{"name": name}),
+		name:         name,
+		certFile:     certFile,
+		keyFile:      keyFile,
+		caFile:       caFile,
+		clock:        clock,
+		interval:     interval,
+		metronClient: metronClient,
+	}
+
+	_, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Reloader) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger
+	logger.Info("starting", lager.Data{"interval": r.interval.String()})
+	defer logger.Info("exited")
+
+	ticker := r.clock.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+		case <-ticker.C():
+			r.Reload()
+		}
+	}
+}
+
+// Reload loads the files again when their contents have changed. When they
+// are invalid it keeps using the previously loaded certificates.
+func (r *Reloader) Reload() error {
+	logger := r.logger.Session("reload")
+
+	reloaded, err := r.load()
+	if err != nil {
+		logger.Error("failed-to-reload", err)
+		r.metronClient.IncrementCounter(tlsReloadFailuresMetric)
+		return err
+	}
+
+	if !reloaded {
+		return nil
+	}
+
+	cert := r.Certificate()
+	data := lager.Data{}
+	if cert.Leaf != nil {
+		data["subject"] = cert.Leaf.Subject.String()
+		data["not_after"] = cert.Leaf.NotAfter
+	}
+	logger.Info("reloaded", data)
+	r.metronClient.IncrementCounter(tlsReloadsMetric)
+
+	r.lock.RLock()
+	callbacks := r.onReload
+	r.lock.RUnlock()
+	for _, callback := range callbacks {
+		callback()
+	}
+
+	return nil
+}
+
+func (r *Reloader) load() (bool, error) {
+	certPEM, err := ioutil.ReadFile(r.certFile)
+	if err != nil {
+		return false, err
+	}
+	keyPEM, err := ioutil.ReadFile(r.keyFile)
+	if err != nil {
+		return false, err
+	}
+	var caPEM []byte
+	if r.caFile != "" {
+		caPEM, err = ioutil.ReadFile(r.caFile)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	hash := sha256.New()
+	for _, contents := range [][]byte{certPEM, keyPEM, caPEM} {
+		hash.Write(contents)
+		hash.Write([]byte{0})
+	}
+	checksum := hash.Sum(nil)
+
+	r.lock.RLock()
+	unchanged := bytes.Equal(checksum, r.checksum)
+	r.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return false, err
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return false, err
+	}
+
+	var caPool *x509.CertPool
+	if r.caFile != "" {
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return false, ErrNoCACertificates
+		}
+	}
+
+	r.lock.Lock()
+	r.checksum = checksum
+	r.cert = &cert
+	r.caPool = caPool
+	r.lock.Unlock()
+
+	return true, nil
+}
+
+// OnReload registers a callback to run after every successful reload.
+func (r *Reloader) OnReload(callback func()) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.onReload = append(r.onReload, callback)
+}
+
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.cert
+}
+
+// CAPool returns the loaded CA bundle, or nil when there is no CA file.
+func (r *Reloader) CAPool() *x509.CertPool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.caPool
+}
+
+// ServerConfig returns a copy of base that presents the current certificate
+// and, when there is a CA file, verifies client certificates against the
+// current CA bundle.
+func (r *Reloader) ServerConfig(base *tls.Config) *tls.Config {
+	config := base.Clone()
+	config.Certificates = nil
+	config.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return r.Certificate(), nil
+	}
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		clientConfig := base.Clone()
+		clientConfig.Certificates = []tls.Certificate{*r.Certificate()}
+		if caPool := r.CAPool(); caPool != nil {
+			clientConfig.ClientCAs = caPool
+		}
+		return clientConfig, nil
+	}
+	return config
+}
+
+// ClientConfig returns a copy of base that presents the current certificate
+// and, when there is a CA file, verifies servers against the CA bundle loaded
+// at the time of the call.
+func (r *Reloader) ClientConfig(base *tls.Config) *tls.Config {
+	config := base.Clone()
+	config.Certificates = nil
+	config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return r.Certificate(), nil
+	}
+	if caPool := r.CAPool(); caPool != nil {
+		config.RootCAs = caPool
+	}
+	return config
+}
+
+// DialTLSContext returns a dial function for http.Transport that builds a
+// ClientConfig for each connection. RootCAs cannot change once a config is in
+// use, so this is how new connections pick up a rotated CA bundle while
+// keeping the standard verification of the dialed host name or IP address.
+func (r *Reloader) DialTLSContext(base *tls.Config) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialer := &tls.Dialer{Config: r.ClientConfig(base)}
+		return dialer.DialContext(ctx, network, addr)
+	}
+}
@@ -0,0 +1,276 @@
+package tlsreloader_test
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/bbs/tlsreloader"
+	"code.cloudfoundry.org/clock/fakeclock"
+	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Reloader", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeClock        *fakeclock.FakeClock
+		fakeMetronClient *mfakes.FakeIngressClient
+		tempDir          string
+		certFile         string
+		keyFile          string
+		caFile           string
+		ca               *certAuthority
+		reloader         *tlsreloader.Reloader
+	)
+
+	writeFiles := func(ca *certAuthority, commonName string, serial int64) {
+		certPEM, keyPEM := ca.issue(commonName, serial)
+		Expect(ioutil.WriteFile(certFile, certPEM, 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(keyFile, keyPEM, 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(caFile, ca.pem, 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		fakeMetronClient = new(mfakes.FakeIngressClient)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "tlsreloader")
+		Expect(err).NotTo(HaveOccurred())
+		certFile = filepath.Join(tempDir, "server.crt")
+		keyFile = filepath.Join(tempDir, "server.key")
+		caFile = filepath.Join(tempDir, "ca.crt")
+
+		ca = newCertAuthority("ca-1")
+		writeFiles(ca, "server", 1)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		reloader, err = tlsreloader.New(logger, "bbs", certFile, keyFile, caFile, fakeClock, time.Minute, fakeMetronClient)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	It("loads the certificate and CA bundle", func() {
+		Expect(reloader.Certificate().Leaf.Subject.CommonName).To(Equal("server"))
+		Expect(reloader.CAPool()).NotTo(BeNil())
+	})
+
+	Describe("New", func() {
+		It("fails when the files are invalid", func() {
+			Expect(ioutil.WriteFile(keyFile, []byte("garbage"), 0600)).To(Succeed())
+			_, err := tlsreloader.New(logger, "bbs", certFile, keyFile, caFile, fakeClock, time.Minute, fakeMetronClient)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the CA file has no certificates", func() {
+			Expect(ioutil.WriteFile(caFile, []byte("garbage"), 0600)).To(Succeed())
+			_, err := tlsreloader.New(logger, "bbs", certFile, keyFile, caFile, fakeClock, time.Minute, fakeMetronClient)
+			Expect(err).To(Equal(tlsreloader.ErrNoCACertificates))
+		})
+
+		It("does not require a CA file", func() {
+			reloader, err := tlsreloader.New(logger, "bbs", certFile, keyFile, "", fakeClock, time.Minute, fakeMetronClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reloader.CAPool()).To(BeNil())
+		})
+	})
+
+	Describe("Reload", func() {
+		var callbacks int
+
+		JustBeforeEach(func() {
+			callbacks = 0
+			reloader.OnReload(func() { callbacks++ })
+		})
+
+		It("does nothing when the files have not changed", func() {
+			Expect(reloader.Reload()).To(Succeed())
+			Expect(callbacks).To(Equal(0))
+			Expect(fakeMetronClient.IncrementCounterCallCount()).To(Equal(0))
+		})
+
+		Context("when the files change", func() {
+			JustBeforeEach(func() {
+				writeFiles(ca, "rotated", 2)
+				Expect(reloader.Reload()).To(Succeed())
+			})
+
+			It("swaps in the new certificate", func() {
+				Expect(reloader.Certificate().Leaf.Subject.CommonName).To(Equal("rotated"))
+			})
+
+			It("runs the callbacks", func() {
+				Expect(callbacks).To(Equal(1))
+			})
+
+			It("logs and counts the reload", func() {
+				Expect(logger).To(gbytes.Say("reloaded"))
+				Expect(fakeMetronClient.IncrementCounterCallCount()).To(Equal(1))
+				Expect(fakeMetronClient.IncrementCounterArgsForCall(0)).To(Equal("TLSReloads"))
+			})
+		})
+
+		Context("when the new files are invalid", func() {
+			JustBeforeEach(func() {
+				Expect(ioutil.WriteFile(certFile, []byte("garbage"), 0600)).To(Succeed())
+			})
+
+			It("keeps the previous certificate", func() {
+				Expect(reloader.Reload()).NotTo(Succeed())
+				Expect(reloader.Certificate().Leaf.Subject.CommonName).To(Equal("server"))
+				Expect(callbacks).To(Equal(0))
+			})
+
+			It("logs and counts the failure", func() {
+				reloader.Reload()
+				Expect(logger).To(gbytes.Say("failed-to-reload"))
+				Expect(fakeMetronClient.IncrementCounterArgsForCall(0)).To(Equal("TLSReloadFailures"))
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		JustBeforeEach(func() {
+			process = ifrit.Invoke(reloader)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		It("reloads the files every interval", func() {
+			writeFiles(ca, "rotated", 2)
+			fakeClock.WaitForWatcherAndIncrement(time.Minute)
+			Eventually(func() string {
+				return reloader.Certificate().Leaf.Subject.CommonName
+			}).Should(Equal("rotated"))
+		})
+	})
+
+	Describe("TLS configs", func() {
+		var (
+			clientDir      string
+			clientReloader *tlsreloader.Reloader
+			listener       net.Listener
+			base           *tls.Config
+		)
+
+		BeforeEach(func() {
+			var err error
+			clientDir, err = ioutil.TempDir("", "tlsreloader-client")
+			Expect(err).NotTo(HaveOccurred())
+
+			base = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, MinVersion: tls.VersionTLS12}
+		})
+
+		JustBeforeEach(func() {
+			certPEM, keyPEM := ca.issue("client", 10)
+			Expect(ioutil.WriteFile(filepath.Join(clientDir, "client.crt"), certPEM, 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(clientDir, "client.key"), keyPEM, 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(clientDir, "ca.crt"), ca.pem, 0600)).To(Succeed())
+
+			var err error
+			clientReloader, err = tlsreloader.New(logger, "client",
+				filepath.Join(clientDir, "client.crt"),
+				filepath.Join(clientDir, "client.key"),
+				filepath.Join(clientDir, "ca.crt"),
+				fakeClock, time.Minute, fakeMetronClient,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			listener, err = tls.Listen("tcp", "127.0.0.1:0", reloader.ServerConfig(base))
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						return
+					}
+					conn.(*tls.Conn).Handshake()
+					conn.Close()
+				}
+			}()
+		})
+
+		AfterEach(func() {
+			listener.Close()
+			Expect(os.RemoveAll(clientDir)).To(Succeed())
+		})
+
+		dial := func() (*tls.Conn, error) {
+			return tls.Dial("tcp", listener.Addr().String(), clientReloader.ClientConfig(&tls.Config{}))
+		}
+
+		dialContext := func(dialTLSContext func(context.Context, string, string) (net.Conn, error)) (*tls.Conn, error) {
+			conn, err := dialTLSContext(context.Background(), "tcp", listener.Addr().String())
+			if err != nil {
+				return nil, err
+			}
+			return conn.(*tls.Conn), nil
+		}
+
+		It("authenticates both sides with the loaded certificates", func() {
+			conn, err := dial()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conn.ConnectionState().PeerCertificates[0].Subject.CommonName).To(Equal("server"))
+			conn.Close()
+		})
+
+		It("uses rotated certificates and CAs for new connections", func() {
+			dialTLSContext := clientReloader.DialTLSContext(&tls.Config{})
+
+			newCA := newCertAuthority("ca-2")
+			writeFiles(newCA, "rotated", 3)
+			Expect(reloader.Reload()).To(Succeed())
+
+			_, err := dialContext(dialTLSContext)
+			Expect(err).To(HaveOccurred())
+
+			certPEM, keyPEM := newCA.issue("client", 11)
+			Expect(ioutil.WriteFile(filepath.Join(clientDir, "client.crt"), certPEM, 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(clientDir, "client.key"), keyPEM, 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(clientDir, "ca.crt"), newCA.pem, 0600)).To(Succeed())
+			Expect(clientReloader.Reload()).To(Succeed())
+
+			conn, err := dialContext(dialTLSContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conn.ConnectionState().PeerCertificates[0].Subject.CommonName).To(Equal("rotated"))
+			conn.Close()
+		})
+
+		Context("when the server certificate is not valid for the dialed IP address", func() {
+			BeforeEach(func() {
+				certPEM, keyPEM := ca.issueForIP("server", 4, net.ParseIP("10.0.0.1"))
+				Expect(ioutil.WriteFile(certFile, certPEM, 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(keyFile, keyPEM, 0600)).To(Succeed())
+			})
+
+			It("rejects the server", func() {
+				_, err := dial()
+				Expect(err).To(HaveOccurred())
+
+				_, err = dialContext(clientReloader.DialTLSContext(&tls.Config{}))
+				Expect(err).To(MatchError(ContainSubstring("127.0.0.1")))
+			})
+		})
+	})
+})